@@ -0,0 +1,152 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KindBGPFilter     = "BGPFilter"
+	KindBGPFilterList = "BGPFilterList"
+)
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BGPFilterList is a list of BGPFilter resources.
+type BGPFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Items []BGPFilter `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BGPFilter contains ordered rules that accept or reject the routes imported from, or
+// exported to, the BGP peers that reference it.  BGPFilter is globally-scoped (i.e. not
+// Namespaced).
+type BGPFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec BGPFilterSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// BGPFilterSpec contains the IPv4 and IPv6 filter rules of the BGP Filter.  Within each list,
+// the rules are evaluated in order and the first matching rule decides whether the route is
+// accepted or rejected.  Routes that match no rule fall through to the next filter that the
+// BGPPeer references, and then to Calico's default import or export behaviour.
+type BGPFilterSpec struct {
+	// The ordered set of IPv4 BGPFilter rules acting on exporting routes to a peer.
+	ExportV4 []BGPFilterRuleV4 `json:"exportV4,omitempty" validate:"omitempty,dive"`
+
+	// The ordered set of IPv4 BGPFilter rules acting on importing routes from a peer.
+	ImportV4 []BGPFilterRuleV4 `json:"importV4,omitempty" validate:"omitempty,dive"`
+
+	// The ordered set of IPv6 BGPFilter rules acting on exporting routes to a peer.
+	ExportV6 []BGPFilterRuleV6 `json:"exportV6,omitempty" validate:"omitempty,dive"`
+
+	// The ordered set of IPv6 BGPFilter rules acting on importing routes from a peer.
+	ImportV6 []BGPFilterRuleV6 `json:"importV6,omitempty" validate:"omitempty,dive"`
+}
+
+// BGPFilterRuleV4 defines a BGP filter rule consisting of a single IPv4 CIDR, an optional
+// prefix length range, a match operator and an action.  A rule without a CIDR matches every
+// route.
+type BGPFilterRuleV4 struct {
+	// +optional
+	CIDR string `json:"cidr,omitempty" validate:"omitempty,netv4"`
+
+	// +optional
+	PrefixLength *BGPFilterPrefixLengthV4 `json:"prefixLength,omitempty" validate:"omitempty"`
+
+	// +optional
+	MatchOperator BGPFilterMatchOperator `json:"matchOperator,omitempty" validate:"omitempty,bgpFilterMatchOperator"`
+
+	Action BGPFilterAction `json:"action" validate:"bgpFilterAction"`
+}
+
+// BGPFilterRuleV6 defines a BGP filter rule consisting of a single IPv6 CIDR, an optional
+// prefix length range, a match operator and an action.  A rule without a CIDR matches every
+// route.
+type BGPFilterRuleV6 struct {
+	// +optional
+	CIDR string `json:"cidr,omitempty" validate:"omitempty,netv6"`
+
+	// +optional
+	PrefixLength *BGPFilterPrefixLengthV6 `json:"prefixLength,omitempty" validate:"omitempty"`
+
+	// +optional
+	MatchOperator BGPFilterMatchOperator `json:"matchOperator,omitempty" validate:"omitempty,bgpFilterMatchOperator"`
+
+	Action BGPFilterAction `json:"action" validate:"bgpFilterAction"`
+}
+
+// BGPFilterPrefixLengthV4 restricts an "In" or "NotIn" match to the routes, within the rule's
+// CIDR, whose prefix length is in the given (inclusive) range.
+type BGPFilterPrefixLengthV4 struct {
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=32
+	Min *int32 `json:"min,omitempty" validate:"omitempty,bgpFilterPrefixLengthV4"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=32
+	Max *int32 `json:"max,omitempty" validate:"omitempty,bgpFilterPrefixLengthV4"`
+}
+
+// BGPFilterPrefixLengthV6 restricts an "In" or "NotIn" match to the routes, within the rule's
+// CIDR, whose prefix length is in the given (inclusive) range.
+type BGPFilterPrefixLengthV6 struct {
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=128
+	Min *int32 `json:"min,omitempty" validate:"omitempty,bgpFilterPrefixLengthV6"`
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=128
+	Max *int32 `json:"max,omitempty" validate:"omitempty,bgpFilterPrefixLengthV6"`
+}
+
+type BGPFilterMatchOperator string
+
+const (
+	// MatchOperatorEqual matches routes for exactly the CIDR.
+	MatchOperatorEqual BGPFilterMatchOperator = "Equal"
+	// MatchOperatorNotEqual matches routes for anything but exactly the CIDR.
+	MatchOperatorNotEqual BGPFilterMatchOperator = "NotEqual"
+	// MatchOperatorIn matches routes for the CIDR and any more specific prefixes within it.
+	MatchOperatorIn BGPFilterMatchOperator = "In"
+	// MatchOperatorNotIn matches routes outside the CIDR.
+	MatchOperatorNotIn BGPFilterMatchOperator = "NotIn"
+)
+
+type BGPFilterAction string
+
+const (
+	FilterActionAccept BGPFilterAction = "Accept"
+	FilterActionReject BGPFilterAction = "Reject"
+)
+
+// NewBGPFilter creates a new (zeroed) BGPFilter struct with the TypeMetadata initialised to the
+// current version.
+func NewBGPFilter() *BGPFilter {
+	return &BGPFilter{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindBGPFilter,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
@@ -100,6 +100,10 @@ type BGPPeerSpec struct {
 	// This removes BGP loop prevention and should only be used if absolutely necesssary.
 	// +optional
 	NumAllowedLocalASNumbers *int32 `json:"numAllowedLocalASNumbers,omitempty"`
+	// The ordered set of BGPFilters applied on this BGP peer.  The filters are applied in
+	// order, before Calico's default import and export behaviour.
+	// +optional
+	Filters []string `json:"filters,omitempty" validate:"omitempty,dive,name"`
 }
 
 type SourceAddress string
@@ -49,6 +49,9 @@ const (
 	KindFelixConfigurationList = "FelixConfigurationList"
 	IptablesBackendLegacy      = "Legacy"
 	IptablesBackendNFTables    = "NFT"
+	// IptablesBackendNFTablesNative programs the dataplane through Felix's native nftables
+	// backend, which uses nftables tables and sets directly rather than iptables-nft.
+	IptablesBackendNFTablesNative = "NFTNative"
 )
 
 // +kubebuilder:validation:Enum=DoNothing;Enable;Disable
@@ -122,6 +125,7 @@ type FelixConfigurationSpec struct {
 	IpsetsRefreshInterval *metav1.Duration `json:"ipsetsRefreshInterval,omitempty" configv1timescale:"seconds"`
 	MaxIpsetSize          *int             `json:"maxIpsetSize,omitempty"`
 	// IptablesBackend specifies which backend of iptables will be used. The default is legacy.
+	// NFTNative programs the dataplane using native nftables tables and sets instead of iptables.
 	IptablesBackend *IptablesBackend `json:"iptablesBackend,omitempty" validate:"omitempty,iptablesBackend"`
 
 	// XDPRefreshInterval is the period at which Felix re-checks all XDP state to ensure that no
@@ -206,6 +210,12 @@ type FelixConfigurationSpec struct {
 	VXLANPort *int `json:"vxlanPort,omitempty"`
 	VXLANVNI  *int `json:"vxlanVNI,omitempty"`
 
+	// VXLANEnabledV6 overrides whether Felix should create the IPv6 VXLAN tunnel device (vxlan-v6.calico) for IPv6
+	// VXLAN networking. Optional as Felix determines this based on the existing IPv6 pools. [Default: nil (unset)]
+	VXLANEnabledV6 *bool `json:"vxlanEnabledV6,omitempty"`
+	// VXLANMTUV6 is the MTU to set on the IPv6 VXLAN tunnel device. See Configuring MTU [Default: 1430]
+	VXLANMTUV6 *int `json:"vxlanMTUV6,omitempty"`
+
 	// AllowVXLANPacketsFromWorkloads controls whether Felix will add a rule to drop VXLAN encapsulated traffic
 	// from workloads [Default: false]
 	// +optional
@@ -306,6 +316,49 @@ type FelixConfigurationSpec struct {
 	// the tunneled traffic be accepted at calico nodes.
 	ExternalNodesCIDRList *[]string `json:"externalNodesList,omitempty"`
 
+	// DNSSnoopingEnabled controls whether Felix learns the IP addresses of the domain names in policy rules by
+	// snooping on the DNS responses sent to local workloads.  Even when enabled, DNS responses are only copied to
+	// Felix while there are policy rules that match on domain names.  If disabled, rules that match on domain names
+	// match no traffic.  Not supported in BPF mode. [Default: true]
+	DNSSnoopingEnabled *bool `json:"dnsSnoopingEnabled,omitempty"`
+
+	// DNSTrustedServers is the list of DNS servers whose responses Felix uses to learn the IP addresses of the
+	// domain names in policy rules.  Each entry is an IP address or CIDR, or a Kubernetes service, as
+	// "k8s-service:[<namespace>/]<name>", whose cluster IPs and endpoints are trusted; the namespace defaults to
+	// kube-system.  If empty, no responses are used. [Default: k8s-service:kube-dns]
+	DNSTrustedServers *[]string `json:"dnsTrustedServers,omitempty" validate:"omitempty,dive,dnsServer"`
+
+	// DNSExtraTTL is extra time that Felix keeps a learned domain name to IP address mapping after the TTL of the
+	// DNS record has expired, so that connections started just before the expiry are still allowed. [Default: 0s]
+	DNSExtraTTL *metav1.Duration `json:"dnsExtraTTL,omitempty" configv1timescale:"seconds"`
+
+	// FlowLogsEnabled enables Felix's flow logs, which record the connections that policy was applied to, the
+	// verdict, and the policy rule that decided it. [Default: false]
+	FlowLogsEnabled *bool `json:"flowLogsEnabled,omitempty"`
+	// FlowLogsFlushInterval is the period over which flow logs are aggregated before they are written out.
+	// [Default: 300s]
+	FlowLogsFlushInterval *metav1.Duration `json:"flowLogsFlushInterval,omitempty" configv1timescale:"seconds"`
+	// FlowLogsFileEnabled controls whether flow logs are written to a file in FlowLogsFileDirectory.
+	// [Default: true]
+	FlowLogsFileEnabled *bool `json:"flowLogsFileEnabled,omitempty"`
+	// FlowLogsFileDirectory is the directory that flow logs files are written to.
+	// [Default: /var/log/calico/flowlogs]
+	FlowLogsFileDirectory string `json:"flowLogsFileDirectory,omitempty"`
+	// FlowLogsFileMaxFiles is the number of rotated flow logs files to keep. [Default: 5]
+	FlowLogsFileMaxFiles *int `json:"flowLogsFileMaxFiles,omitempty"`
+	// FlowLogsFileMaxFileSizeMB is the size, in MB, at which the flow logs file is rotated. [Default: 100]
+	FlowLogsFileMaxFileSizeMB *int `json:"flowLogsFileMaxFileSizeMB,omitempty"`
+
+	// CaptureDir is the directory that the files of PacketCaptures are written to, in a subdirectory
+	// per PacketCapture. [Default: /var/log/calico/pcap]
+	CaptureDir string `json:"captureDir,omitempty"`
+	// CaptureMaxSizeBytes is the size at which a PacketCapture's file for an endpoint is rotated.
+	// [Default: 10000000]
+	CaptureMaxSizeBytes *int `json:"captureMaxSizeBytes,omitempty" validate:"omitempty,gt=0"`
+	// CaptureMaxFiles is the number of files, including the one being written, that are kept per
+	// PacketCapture and endpoint; the oldest file is deleted when a new file is started. [Default: 2]
+	CaptureMaxFiles *int `json:"captureMaxFiles,omitempty" validate:"omitempty,gt=0"`
+
 	DebugMemoryProfilePath          string           `json:"debugMemoryProfilePath,omitempty"`
 	DebugDisableLogDropping         *bool            `json:"debugDisableLogDropping,omitempty"`
 	DebugSimulateCalcGraphHangAfter *metav1.Duration `json:"debugSimulateCalcGraphHangAfter,omitempty" configv1timescale:"seconds"`
@@ -409,18 +462,49 @@ type FelixConfigurationSpec struct {
 
 	// WireguardEnabled controls whether Wireguard is enabled. [Default: false]
 	WireguardEnabled *bool `json:"wireguardEnabled,omitempty"`
+	// WireguardEnabledV6 controls whether Wireguard is enabled for IPv6 (encapsulating IPv6 traffic over an IPv6 underlay network). [Default: false]
+	WireguardEnabledV6 *bool `json:"wireguardEnabledV6,omitempty"`
 	// WireguardListeningPort controls the listening port used by Wireguard. [Default: 51820]
 	WireguardListeningPort *int `json:"wireguardListeningPort,omitempty" validate:"omitempty,gt=0,lte=65535"`
+	// WireguardListeningPortV6 controls the listening port used by IPv6 Wireguard. [Default: 51821]
+	WireguardListeningPortV6 *int `json:"wireguardListeningPortV6,omitempty" validate:"omitempty,gt=0,lte=65535"`
 	// WireguardRoutingRulePriority controls the priority value to use for the Wireguard routing rule. [Default: 99]
 	WireguardRoutingRulePriority *int `json:"wireguardRoutingRulePriority,omitempty" validate:"omitempty,gt=0,lt=32766"`
 	// WireguardInterfaceName specifies the name to use for the Wireguard interface. [Default: wg.calico]
 	WireguardInterfaceName string `json:"wireguardInterfaceName,omitempty" validate:"omitempty,interface"`
+	// WireguardInterfaceNameV6 specifies the name to use for the IPv6 Wireguard interface. [Default: wg-v6.cali]
+	WireguardInterfaceNameV6 string `json:"wireguardInterfaceNameV6,omitempty" validate:"omitempty,interface"`
 	// WireguardMTU controls the MTU on the Wireguard interface. See Configuring MTU [Default: 1420]
 	WireguardMTU *int `json:"wireguardMTU,omitempty"`
+	// WireguardMTUV6 controls the MTU on the IPv6 Wireguard interface. See Configuring MTU [Default: 1420]
+	WireguardMTUV6 *int `json:"wireguardMTUV6,omitempty"`
 	// WireguardHostEncryptionEnabled controls whether Wireguard host-to-host encryption is enabled. [Default: false]
 	WireguardHostEncryptionEnabled *bool `json:"wireguardHostEncryptionEnabled,omitempty"`
 	// WireguardKeepAlive controls Wireguard PersistentKeepalive option. Set 0 to disable. [Default: 0]
 	WireguardPersistentKeepAlive *metav1.Duration `json:"wireguardKeepAlive,omitempty"`
+	// WireguardKeyRotationInterval controls how often Felix rotates the Wireguard private key of each node. The new
+	// public key is published ahead of the switch over, and the node only switches over once all of its peers have
+	// applied the new key, or after waiting 10 minutes for them. Set 0 to disable. [Default: 0]
+	WireguardKeyRotationInterval *metav1.Duration `json:"wireguardKeyRotationInterval,omitempty" configv1timescale:"seconds"`
+
+	// EgressGatewayEnabled controls whether Felix routes the off-cluster traffic of workloads that have an egress
+	// gateway selector via the selected egress gateway pods. [Default: false]
+	EgressGatewayEnabled *bool `json:"egressGatewayEnabled,omitempty"`
+	// EgressGatewayRoutingRulePriority controls the priority value to use for the egress gateway routing rules.
+	// [Default: 102]
+	EgressGatewayRoutingRulePriority *int `json:"egressGatewayRoutingRulePriority,omitempty" validate:"omitempty,gt=0,lt=32766"`
+	// EgressGatewayRoutingTables is the number of routing tables, taken from RouteTableRanges, that Felix reserves
+	// for egress gateway routes.  Felix uses a routing table for each distinct set of egress gateways. [Default: 32]
+	EgressGatewayRoutingTables *int `json:"egressGatewayRoutingTables,omitempty" validate:"omitempty,gt=0,lte=250"`
+	// EgressGatewayHealthPort is the port on which Felix probes egress gateway pods with an HTTP GET to
+	// /readiness.  Gateways that fail the probe are removed from the routes.  Set to 0 to disable the
+	// probes, in which case Felix routes to every gateway pod that the selector matches. [Default: 8080]
+	EgressGatewayHealthPort *int `json:"egressGatewayHealthPort,omitempty" validate:"omitempty,gte=0,lte=65535"`
+	// EgressGatewayPollInterval is the period at which Felix probes egress gateway pods. [Default: 10s]
+	EgressGatewayPollInterval *metav1.Duration `json:"egressGatewayPollInterval,omitempty" configv1timescale:"seconds"`
+	// EgressGatewayPollFailureCount is the number of consecutive failed probes after which Felix removes an
+	// egress gateway from the routes. [Default: 3]
+	EgressGatewayPollFailureCount *int `json:"egressGatewayPollFailureCount,omitempty" validate:"omitempty,gt=0"`
 
 	// Set source-destination-check on AWS EC2 instances. Accepted value must be one of "DoNothing", "Enable" or "Disable".
 	// [Default: DoNothing]
@@ -85,6 +85,14 @@ type IPPoolSpec struct {
 	// AllowedUse controls what the IP pool will be used for.  If not specified or empty, defaults to
 	// ["Tunnel", "Workload"] for back-compatibility
 	AllowedUses []IPPoolAllowedUse `json:"allowedUses,omitempty" validate:"omitempty"`
+
+	// MaxIPsPerNamespace, if non-zero, is the maximum number of IPs from this pool that can be
+	// assigned to the workloads in each namespace.
+	MaxIPsPerNamespace int `json:"maxIPsPerNamespace,omitempty" validate:"omitempty,gte=0"`
+
+	// MaxIPsPerNode, if non-zero, is the maximum number of IPs from this pool that can be
+	// assigned to the workloads on each node.
+	MaxIPsPerNode int `json:"maxIPsPerNode,omitempty" validate:"omitempty,gte=0"`
 }
 
 type IPPoolAllowedUse string
@@ -102,6 +102,11 @@ type NodeControllerConfig struct {
 	// Set to 0 to disable IP garbage collection. [Default: 15m]
 	// +optional
 	LeakGracePeriod *metav1.Duration `json:"leakGracePeriod,omitempty"`
+
+	// IPPoolUtilizationWarningThreshold is the percentage of an IP pool's addresses that can be in use, or of
+	// its blocks that can be allocated, before the controller generates a warning event for the pool. Set to 0 to disable the events. [Default: 90]
+	// +optional
+	IPPoolUtilizationWarningThreshold *int `json:"ipPoolUtilizationWarningThreshold,omitempty" validate:"omitempty,gte=0,lte=100"`
 }
 
 type AutoHostEndpointConfig struct {
@@ -0,0 +1,140 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KindPacketCapture     = "PacketCapture"
+	KindPacketCaptureList = "PacketCaptureList"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PacketCaptureList is a list of PacketCapture resources.
+type PacketCaptureList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Items []PacketCapture `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PacketCapture captures the traffic of the workload endpoints that it selects, within its
+// namespace, into pcap files on the endpoints' nodes.  Capturing continues until the
+// PacketCapture is deleted.
+type PacketCapture struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec   PacketCaptureSpec   `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	Status PacketCaptureStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// PacketCaptureSpec contains the specification for a PacketCapture resource.
+type PacketCaptureSpec struct {
+	// The selector is an expression used to pick out the endpoints, in the namespace of the
+	// packet capture, whose traffic is captured.  The selector is matched against the labels
+	// of the workload endpoints and those that they inherit from their namespace and service
+	// account.  An empty selector selects every endpoint in the namespace.
+	// +optional
+	Selector string `json:"selector,omitempty" validate:"selector"`
+
+	// Filter is an optional BPF filter expression, in the syntax used by tcpdump, that limits
+	// the capture to the matching packets.  Felix supports a subset of the syntax: the "ip",
+	// "ip6", "arp", "tcp", "udp", "sctp", "icmp" and "icmp6" protocols and the "host", "net",
+	// "port" and "portrange" primitives, optionally qualified by "src" or "dst", combined with
+	// "and", "or", "not" and parentheses.  An empty filter captures every packet.
+	// +optional
+	Filter string `json:"filter,omitempty"`
+}
+
+// PacketCaptureStatus describes the capture files written on each node.
+// No validation needed for status since it is updated by Calico.
+type PacketCaptureStatus struct {
+	// Nodes holds the status of the capture on each node that hosts a selected endpoint.
+	Nodes []PacketCaptureNodeStatus `json:"nodes,omitempty"`
+}
+
+// PacketCaptureNodeStatus describes the capture on one node.
+type PacketCaptureNodeStatus struct {
+	// Node is the name of the node.
+	Node string `json:"node"`
+
+	// Directory is the directory, on the node, that holds the capture files.
+	Directory string `json:"directory,omitempty"`
+
+	// LastUpdated is the time at which Felix last updated the status for the node.
+	// +nullable
+	LastUpdated metav1.Time `json:"lastUpdated,omitempty"`
+
+	// Endpoints holds the status of the capture of each of the node's selected endpoints.
+	Endpoints []PacketCaptureEndpointStatus `json:"endpoints,omitempty"`
+}
+
+// PacketCaptureEndpointStatus describes the capture of one workload endpoint.
+type PacketCaptureEndpointStatus struct {
+	// Endpoint is the name of the workload endpoint.
+	Endpoint string `json:"endpoint"`
+
+	// InterfaceName is the name of the host interface of the endpoint that is captured.
+	InterfaceName string `json:"interfaceName,omitempty"`
+
+	// State is the state of the capture.
+	State PacketCaptureState `json:"state,omitempty"`
+
+	// Error describes why the capture failed, if State is Error.
+	Error string `json:"error,omitempty"`
+
+	// Files lists the capture files of the endpoint, oldest first.  The last file is the one
+	// being written while the capture is running.
+	Files []PacketCaptureFile `json:"files,omitempty"`
+}
+
+// PacketCaptureFile describes a capture file.
+type PacketCaptureFile struct {
+	// Name is the name of the file within the node's capture directory.
+	Name string `json:"name"`
+
+	// Size is the size of the file in bytes.
+	Size int64 `json:"size"`
+}
+
+type PacketCaptureState string
+
+const (
+	// PacketCaptureStateCapturing indicates that packets are being captured.
+	PacketCaptureStateCapturing PacketCaptureState = "Capturing"
+	// PacketCaptureStateStopped indicates that the endpoint is no longer selected or no longer
+	// exists; its capture files are kept until the PacketCapture is deleted.
+	PacketCaptureStateStopped PacketCaptureState = "Stopped"
+	// PacketCaptureStateError indicates that the capture failed.
+	PacketCaptureStateError PacketCaptureState = "Error"
+)
+
+// NewPacketCapture creates a new (zeroed) PacketCapture struct with the TypeMetadata initialised
+// to the current version.
+func NewPacketCapture() *PacketCapture {
+	return &PacketCapture{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindPacketCapture,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
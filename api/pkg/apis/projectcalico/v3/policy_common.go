@@ -177,6 +177,16 @@ type EntityRule struct {
 	// ServiceAccounts is an optional field that restricts the rule to only apply to traffic that originates from (or
 	// terminates at) a pod running as a matching service account.
 	ServiceAccounts *ServiceAccountMatch `json:"serviceAccounts,omitempty" validate:"omitempty"`
+
+	// Domains is an optional field, valid for egress Allow rules only, that restricts the rule to
+	// apply to traffic that terminates at an IP address that one of the given domain names
+	// resolved to.  Felix learns those IP addresses by snooping the DNS responses sent to local
+	// workloads, and forgets them when the TTL of the DNS record expires.
+	//
+	// Domains can only be specified in the destination of a rule, and cannot be specified on
+	// the same rule as Selector, NotSelector, NamespaceSelector, Nets, NotNets, ServiceAccounts or
+	// Services.
+	Domains []string `json:"domains,omitempty" validate:"omitempty,dive,domain"`
 }
 
 type ServiceMatch struct {
@@ -208,6 +218,24 @@ const (
 	Pass         = "Pass"
 )
 
+// StagedAction is the action to take on the enforced policy when a staged policy is promoted.
+type StagedAction string
+
+const (
+	// StagedActionSet creates or updates the enforced policy to match the staged policy.
+	StagedActionSet StagedAction = "Set"
+	// StagedActionDelete deletes the enforced policy with the same name as the staged policy.
+	StagedActionDelete StagedAction = "Delete"
+)
+
+// stagedActionOrDefault returns the supplied staged action, or StagedActionSet if it is empty.
+func stagedActionOrDefault(action StagedAction) StagedAction {
+	if action == "" {
+		return StagedActionSet
+	}
+	return action
+}
+
 type RuleMetadata struct {
 	// Annotations is a set of key value pairs that give extra information about the rule
 	Annotations map[string]string `json:"annotations,omitempty"`
@@ -57,6 +57,18 @@ type ProfileSpec struct {
 	// referencing this profile.  If labels configured on the endpoint have keys matching those
 	// labels inherited from the profile, the endpoint label values take precedence.
 	LabelsToApply map[string]string `json:"labelsToApply,omitempty" validate:"omitempty,labels"`
+	// EgressGateway selects the egress gateways that the off-cluster traffic of endpoints referencing
+	// this profile is routed through, unless the endpoint specifies its own egress gateways.
+	EgressGateway *EgressGatewaySpec `json:"egressGateway,omitempty" validate:"omitempty"`
+}
+
+// EgressGatewaySpec selects the egress gateway pods for a workload.
+type EgressGatewaySpec struct {
+	// Selector is an expression used to pick out the egress gateway pods by their labels.
+	Selector string `json:"selector,omitempty" validate:"selector"`
+	// NamespaceSelector is an expression used to pick out the namespaces that the egress gateway pods
+	// run in.  If empty, the gateways must be in the same namespace as the workload.
+	NamespaceSelector string `json:"namespaceSelector,omitempty" validate:"selector"`
 }
 
 // NewProfile creates a new (zeroed) Profile struct with the TypeMetadata initialised to the current
@@ -48,6 +48,16 @@ var (
 		&NetworkSetList{},
 		&CalicoNodeStatus{},
 		&CalicoNodeStatusList{},
+		&Tier{},
+		&TierList{},
+		&BGPFilter{},
+		&BGPFilterList{},
+		&PacketCapture{},
+		&PacketCaptureList{},
+		&StagedNetworkPolicy{},
+		&StagedNetworkPolicyList{},
+		&StagedGlobalNetworkPolicy{},
+		&StagedGlobalNetworkPolicyList{},
 	}
 )
 
@@ -0,0 +1,112 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KindStagedGlobalNetworkPolicy     = "StagedGlobalNetworkPolicy"
+	KindStagedGlobalNetworkPolicyList = "StagedGlobalNetworkPolicyList"
+)
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// StagedGlobalNetworkPolicyList is a list of StagedGlobalNetworkPolicy resources.
+type StagedGlobalNetworkPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Items []StagedGlobalNetworkPolicy `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// StagedGlobalNetworkPolicy is a GlobalNetworkPolicy that is evaluated by the dataplane but
+// never changes the verdict for a packet.  Its rules are only used to count the packets that
+// they match, so that the effect of a policy change can be checked before the policy is
+// promoted to an enforced GlobalNetworkPolicy.
+type StagedGlobalNetworkPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec StagedGlobalNetworkPolicySpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+}
+
+type StagedGlobalNetworkPolicySpec struct {
+	// The staged action.  If this is omitted, the default is Set.
+	StagedAction StagedAction `json:"stagedAction,omitempty" validate:"omitempty,stagedAction"`
+	// The name of the tier that this policy belongs to.  If this is omitted, the default
+	// tier (name is "default") is assumed.
+	Tier string `json:"tier,omitempty" validate:"omitempty,name"`
+	// Order is an optional field that specifies the order in which the policy is applied.
+	// See GlobalNetworkPolicySpec.Order.
+	Order *float64 `json:"order,omitempty"`
+	// The ordered set of ingress rules.
+	Ingress []Rule `json:"ingress,omitempty" validate:"omitempty,dive"`
+	// The ordered set of egress rules.
+	Egress []Rule `json:"egress,omitempty" validate:"omitempty,dive"`
+	// The selector is an expression used to pick pick out the endpoints that the policy should
+	// be applied to.  See GlobalNetworkPolicySpec.Selector.
+	Selector string `json:"selector,omitempty" validate:"selector"`
+	// Types indicates whether this policy applies to ingress, or to egress, or to both.
+	// See GlobalNetworkPolicySpec.Types.
+	Types []PolicyType `json:"types,omitempty" validate:"omitempty,dive,policyType"`
+	// DoNotTrack indicates whether packets matched by the rules in this policy should go through
+	// the data plane's connection tracking, such as Linux conntrack.
+	DoNotTrack bool `json:"doNotTrack,omitempty"`
+	// PreDNAT indicates to apply the rules in this policy before any DNAT.
+	PreDNAT bool `json:"preDNAT,omitempty"`
+	// ApplyOnForward indicates to apply the rules in this policy on forward traffic.
+	ApplyOnForward bool `json:"applyOnForward,omitempty"`
+	// ServiceAccountSelector is an optional field for an expression used to select a pod based on service accounts.
+	ServiceAccountSelector string `json:"serviceAccountSelector,omitempty" validate:"selector"`
+	// NamespaceSelector is an optional field for an expression used to select a pod based on namespaces.
+	NamespaceSelector string `json:"namespaceSelector,omitempty" validate:"selector"`
+}
+
+// NewStagedGlobalNetworkPolicy creates a new (zeroed) StagedGlobalNetworkPolicy struct with the
+// TypeMetadata initialised to the current version.
+func NewStagedGlobalNetworkPolicy() *StagedGlobalNetworkPolicy {
+	return &StagedGlobalNetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindStagedGlobalNetworkPolicy,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
+
+// ConvertStagedGlobalPolicyToEnforced returns the staged action and the GlobalNetworkPolicy
+// that would be enforced if the supplied StagedGlobalNetworkPolicy were promoted.
+func ConvertStagedGlobalPolicyToEnforced(staged *StagedGlobalNetworkPolicy) (StagedAction, *GlobalNetworkPolicy) {
+	enforced := NewGlobalNetworkPolicy()
+	enforced.ObjectMeta = staged.ObjectMeta
+	enforced.Spec.Tier = staged.Spec.Tier
+	enforced.Spec.Order = staged.Spec.Order
+	enforced.Spec.Ingress = staged.Spec.Ingress
+	enforced.Spec.Egress = staged.Spec.Egress
+	enforced.Spec.Selector = staged.Spec.Selector
+	enforced.Spec.Types = staged.Spec.Types
+	enforced.Spec.DoNotTrack = staged.Spec.DoNotTrack
+	enforced.Spec.PreDNAT = staged.Spec.PreDNAT
+	enforced.Spec.ApplyOnForward = staged.Spec.ApplyOnForward
+	enforced.Spec.ServiceAccountSelector = staged.Spec.ServiceAccountSelector
+	enforced.Spec.NamespaceSelector = staged.Spec.NamespaceSelector
+	return stagedActionOrDefault(staged.Spec.StagedAction), enforced
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KindStagedNetworkPolicy     = "StagedNetworkPolicy"
+	KindStagedNetworkPolicyList = "StagedNetworkPolicyList"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// StagedNetworkPolicyList is a list of StagedNetworkPolicy objects.
+type StagedNetworkPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Items []StagedNetworkPolicy `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// StagedNetworkPolicy is a NetworkPolicy that is evaluated by the dataplane but never
+// changes the verdict for a packet.  Its rules are only used to count the packets that
+// they match, so that the effect of a policy change can be checked before the policy is
+// promoted to an enforced NetworkPolicy.
+type StagedNetworkPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	Spec StagedNetworkPolicySpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+}
+
+type StagedNetworkPolicySpec struct {
+	// The staged action.  If this is omitted, the default is Set.
+	StagedAction StagedAction `json:"stagedAction,omitempty" validate:"omitempty,stagedAction"`
+	// The name of the tier that this policy belongs to.  If this is omitted, the default
+	// tier (name is "default") is assumed.
+	Tier string `json:"tier,omitempty" validate:"omitempty,name"`
+	// Order is an optional field that specifies the order in which the policy is applied.
+	// See NetworkPolicySpec.Order.
+	Order *float64 `json:"order,omitempty"`
+	// The ordered set of ingress rules.
+	Ingress []Rule `json:"ingress,omitempty" validate:"omitempty,dive"`
+	// The ordered set of egress rules.
+	Egress []Rule `json:"egress,omitempty" validate:"omitempty,dive"`
+	// The selector is an expression used to pick pick out the endpoints that the policy should
+	// be applied to.  See NetworkPolicySpec.Selector.
+	Selector string `json:"selector,omitempty" validate:"selector"`
+	// Types indicates whether this policy applies to ingress, or to egress, or to both.
+	// See NetworkPolicySpec.Types.
+	Types []PolicyType `json:"types,omitempty" validate:"omitempty,dive,policyType"`
+
+	// ServiceAccountSelector is an optional field for an expression used to select a pod based on service accounts.
+	ServiceAccountSelector string `json:"serviceAccountSelector,omitempty" validate:"selector"`
+}
+
+// NewStagedNetworkPolicy creates a new (zeroed) StagedNetworkPolicy struct with the TypeMetadata
+// initialised to the current version.
+func NewStagedNetworkPolicy() *StagedNetworkPolicy {
+	return &StagedNetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       KindStagedNetworkPolicy,
+			APIVersion: GroupVersionCurrent,
+		},
+	}
+}
+
+// ConvertStagedPolicyToEnforced returns the staged action and the NetworkPolicy that
+// would be enforced if the supplied StagedNetworkPolicy were promoted.
+func ConvertStagedPolicyToEnforced(staged *StagedNetworkPolicy) (StagedAction, *NetworkPolicy) {
+	enforced := NewNetworkPolicy()
+	enforced.ObjectMeta = staged.ObjectMeta
+	enforced.Spec.Tier = staged.Spec.Tier
+	enforced.Spec.Order = staged.Spec.Order
+	enforced.Spec.Ingress = staged.Spec.Ingress
+	enforced.Spec.Egress = staged.Spec.Egress
+	enforced.Spec.Selector = staged.Spec.Selector
+	enforced.Spec.Types = staged.Spec.Types
+	enforced.Spec.ServiceAccountSelector = staged.Spec.ServiceAccountSelector
+	return stagedActionOrDefault(staged.Spec.StagedAction), enforced
+}
@@ -169,6 +169,204 @@ func (in *BGPDaemonStatus) DeepCopy() *BGPDaemonStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilter) DeepCopyInto(out *BGPFilter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilter.
+func (in *BGPFilter) DeepCopy() *BGPFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BGPFilter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterList) DeepCopyInto(out *BGPFilterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BGPFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterList.
+func (in *BGPFilterList) DeepCopy() *BGPFilterList {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BGPFilterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterPrefixLengthV4) DeepCopyInto(out *BGPFilterPrefixLengthV4) {
+	*out = *in
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterPrefixLengthV4.
+func (in *BGPFilterPrefixLengthV4) DeepCopy() *BGPFilterPrefixLengthV4 {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterPrefixLengthV4)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterPrefixLengthV6) DeepCopyInto(out *BGPFilterPrefixLengthV6) {
+	*out = *in
+	if in.Min != nil {
+		in, out := &in.Min, &out.Min
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Max != nil {
+		in, out := &in.Max, &out.Max
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterPrefixLengthV6.
+func (in *BGPFilterPrefixLengthV6) DeepCopy() *BGPFilterPrefixLengthV6 {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterPrefixLengthV6)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterRuleV4) DeepCopyInto(out *BGPFilterRuleV4) {
+	*out = *in
+	if in.PrefixLength != nil {
+		in, out := &in.PrefixLength, &out.PrefixLength
+		*out = new(BGPFilterPrefixLengthV4)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterRuleV4.
+func (in *BGPFilterRuleV4) DeepCopy() *BGPFilterRuleV4 {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterRuleV4)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterRuleV6) DeepCopyInto(out *BGPFilterRuleV6) {
+	*out = *in
+	if in.PrefixLength != nil {
+		in, out := &in.PrefixLength, &out.PrefixLength
+		*out = new(BGPFilterPrefixLengthV6)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterRuleV6.
+func (in *BGPFilterRuleV6) DeepCopy() *BGPFilterRuleV6 {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterRuleV6)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BGPFilterSpec) DeepCopyInto(out *BGPFilterSpec) {
+	*out = *in
+	if in.ExportV4 != nil {
+		in, out := &in.ExportV4, &out.ExportV4
+		*out = make([]BGPFilterRuleV4, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImportV4 != nil {
+		in, out := &in.ImportV4, &out.ImportV4
+		*out = make([]BGPFilterRuleV4, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExportV6 != nil {
+		in, out := &in.ExportV6, &out.ExportV6
+		*out = make([]BGPFilterRuleV6, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImportV6 != nil {
+		in, out := &in.ImportV6, &out.ImportV6
+		*out = make([]BGPFilterRuleV6, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BGPFilterSpec.
+func (in *BGPFilterSpec) DeepCopy() *BGPFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BGPFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BGPPassword) DeepCopyInto(out *BGPPassword) {
 	*out = *in
@@ -268,6 +466,11 @@ func (in *BGPPeerSpec) DeepCopyInto(out *BGPPeerSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Filters != nil {
+		in, out := &in.Filters, &out.Filters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -645,6 +848,22 @@ func (in *ControllersConfig) DeepCopy() *ControllersConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressGatewaySpec) DeepCopyInto(out *EgressGatewaySpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressGatewaySpec.
+func (in *EgressGatewaySpec) DeepCopy() *EgressGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EndpointPort) DeepCopyInto(out *EndpointPort) {
 	*out = *in
@@ -695,6 +914,11 @@ func (in *EntityRule) DeepCopyInto(out *EntityRule) {
 		*out = new(ServiceAccountMatch)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Domains != nil {
+		in, out := &in.Domains, &out.Domains
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -876,6 +1100,16 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.VXLANEnabledV6 != nil {
+		in, out := &in.VXLANEnabledV6, &out.VXLANEnabledV6
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VXLANMTUV6 != nil {
+		in, out := &in.VXLANMTUV6, &out.VXLANMTUV6
+		*out = new(int)
+		**out = **in
+	}
 	if in.AllowVXLANPacketsFromWorkloads != nil {
 		in, out := &in.AllowVXLANPacketsFromWorkloads, &out.AllowVXLANPacketsFromWorkloads
 		*out = new(bool)
@@ -1022,6 +1256,60 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 			copy(*out, *in)
 		}
 	}
+	if in.DNSSnoopingEnabled != nil {
+		in, out := &in.DNSSnoopingEnabled, &out.DNSSnoopingEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DNSTrustedServers != nil {
+		in, out := &in.DNSTrustedServers, &out.DNSTrustedServers
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.DNSExtraTTL != nil {
+		in, out := &in.DNSExtraTTL, &out.DNSExtraTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FlowLogsEnabled != nil {
+		in, out := &in.FlowLogsEnabled, &out.FlowLogsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FlowLogsFlushInterval != nil {
+		in, out := &in.FlowLogsFlushInterval, &out.FlowLogsFlushInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FlowLogsFileEnabled != nil {
+		in, out := &in.FlowLogsFileEnabled, &out.FlowLogsFileEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FlowLogsFileMaxFiles != nil {
+		in, out := &in.FlowLogsFileMaxFiles, &out.FlowLogsFileMaxFiles
+		*out = new(int)
+		**out = **in
+	}
+	if in.FlowLogsFileMaxFileSizeMB != nil {
+		in, out := &in.FlowLogsFileMaxFileSizeMB, &out.FlowLogsFileMaxFileSizeMB
+		*out = new(int)
+		**out = **in
+	}
+	if in.CaptureMaxSizeBytes != nil {
+		in, out := &in.CaptureMaxSizeBytes, &out.CaptureMaxSizeBytes
+		*out = new(int)
+		**out = **in
+	}
+	if in.CaptureMaxFiles != nil {
+		in, out := &in.CaptureMaxFiles, &out.CaptureMaxFiles
+		*out = new(int)
+		**out = **in
+	}
 	if in.DebugDisableLogDropping != nil {
 		in, out := &in.DebugDisableLogDropping, &out.DebugDisableLogDropping
 		*out = new(bool)
@@ -1141,11 +1429,21 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.WireguardEnabledV6 != nil {
+		in, out := &in.WireguardEnabledV6, &out.WireguardEnabledV6
+		*out = new(bool)
+		**out = **in
+	}
 	if in.WireguardListeningPort != nil {
 		in, out := &in.WireguardListeningPort, &out.WireguardListeningPort
 		*out = new(int)
 		**out = **in
 	}
+	if in.WireguardListeningPortV6 != nil {
+		in, out := &in.WireguardListeningPortV6, &out.WireguardListeningPortV6
+		*out = new(int)
+		**out = **in
+	}
 	if in.WireguardRoutingRulePriority != nil {
 		in, out := &in.WireguardRoutingRulePriority, &out.WireguardRoutingRulePriority
 		*out = new(int)
@@ -1156,6 +1454,11 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.WireguardMTUV6 != nil {
+		in, out := &in.WireguardMTUV6, &out.WireguardMTUV6
+		*out = new(int)
+		**out = **in
+	}
 	if in.WireguardHostEncryptionEnabled != nil {
 		in, out := &in.WireguardHostEncryptionEnabled, &out.WireguardHostEncryptionEnabled
 		*out = new(bool)
@@ -1166,6 +1469,41 @@ func (in *FelixConfigurationSpec) DeepCopyInto(out *FelixConfigurationSpec) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.WireguardKeyRotationInterval != nil {
+		in, out := &in.WireguardKeyRotationInterval, &out.WireguardKeyRotationInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.EgressGatewayEnabled != nil {
+		in, out := &in.EgressGatewayEnabled, &out.EgressGatewayEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EgressGatewayRoutingRulePriority != nil {
+		in, out := &in.EgressGatewayRoutingRulePriority, &out.EgressGatewayRoutingRulePriority
+		*out = new(int)
+		**out = **in
+	}
+	if in.EgressGatewayRoutingTables != nil {
+		in, out := &in.EgressGatewayRoutingTables, &out.EgressGatewayRoutingTables
+		*out = new(int)
+		**out = **in
+	}
+	if in.EgressGatewayHealthPort != nil {
+		in, out := &in.EgressGatewayHealthPort, &out.EgressGatewayHealthPort
+		*out = new(int)
+		**out = **in
+	}
+	if in.EgressGatewayPollInterval != nil {
+		in, out := &in.EgressGatewayPollInterval, &out.EgressGatewayPollInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.EgressGatewayPollFailureCount != nil {
+		in, out := &in.EgressGatewayPollFailureCount, &out.EgressGatewayPollFailureCount
+		*out = new(int)
+		**out = **in
+	}
 	if in.AWSSrcDstCheck != nil {
 		in, out := &in.AWSSrcDstCheck, &out.AWSSrcDstCheck
 		*out = new(AWSSrcDstCheckOption)
@@ -2044,6 +2382,11 @@ func (in *NodeControllerConfig) DeepCopyInto(out *NodeControllerConfig) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.IPPoolUtilizationWarningThreshold != nil {
+		in, out := &in.IPPoolUtilizationWarningThreshold, &out.IPPoolUtilizationWarningThreshold
+		*out = new(int)
+		**out = **in
+	}
 	return
 }
 
@@ -2058,38 +2401,199 @@ func (in *NodeControllerConfig) DeepCopy() *NodeControllerConfig {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PolicyControllerConfig) DeepCopyInto(out *PolicyControllerConfig) {
+func (in *PacketCapture) DeepCopyInto(out *PacketCapture) {
 	*out = *in
-	if in.ReconcilerPeriod != nil {
-		in, out := &in.ReconcilerPeriod, &out.ReconcilerPeriod
-		*out = new(v1.Duration)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyControllerConfig.
-func (in *PolicyControllerConfig) DeepCopy() *PolicyControllerConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCapture.
+func (in *PacketCapture) DeepCopy() *PacketCapture {
 	if in == nil {
 		return nil
 	}
-	out := new(PolicyControllerConfig)
+	out := new(PacketCapture)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PacketCapture) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrefixAdvertisement) DeepCopyInto(out *PrefixAdvertisement) {
+func (in *PacketCaptureEndpointStatus) DeepCopyInto(out *PacketCaptureEndpointStatus) {
 	*out = *in
-	if in.Communities != nil {
-		in, out := &in.Communities, &out.Communities
-		*out = make([]string, len(*in))
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]PacketCaptureFile, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefixAdvertisement.
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureEndpointStatus.
+func (in *PacketCaptureEndpointStatus) DeepCopy() *PacketCaptureEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureFile) DeepCopyInto(out *PacketCaptureFile) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureFile.
+func (in *PacketCaptureFile) DeepCopy() *PacketCaptureFile {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureList) DeepCopyInto(out *PacketCaptureList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PacketCapture, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureList.
+func (in *PacketCaptureList) DeepCopy() *PacketCaptureList {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PacketCaptureList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureNodeStatus) DeepCopyInto(out *PacketCaptureNodeStatus) {
+	*out = *in
+	in.LastUpdated.DeepCopyInto(&out.LastUpdated)
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]PacketCaptureEndpointStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureNodeStatus.
+func (in *PacketCaptureNodeStatus) DeepCopy() *PacketCaptureNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureSpec) DeepCopyInto(out *PacketCaptureSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureSpec.
+func (in *PacketCaptureSpec) DeepCopy() *PacketCaptureSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PacketCaptureStatus) DeepCopyInto(out *PacketCaptureStatus) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]PacketCaptureNodeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PacketCaptureStatus.
+func (in *PacketCaptureStatus) DeepCopy() *PacketCaptureStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PacketCaptureStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyControllerConfig) DeepCopyInto(out *PolicyControllerConfig) {
+	*out = *in
+	if in.ReconcilerPeriod != nil {
+		in, out := &in.ReconcilerPeriod, &out.ReconcilerPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyControllerConfig.
+func (in *PolicyControllerConfig) DeepCopy() *PolicyControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrefixAdvertisement) DeepCopyInto(out *PrefixAdvertisement) {
+	*out = *in
+	if in.Communities != nil {
+		in, out := &in.Communities, &out.Communities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefixAdvertisement.
 func (in *PrefixAdvertisement) DeepCopy() *PrefixAdvertisement {
 	if in == nil {
 		return nil
@@ -2183,6 +2687,11 @@ func (in *ProfileSpec) DeepCopyInto(out *ProfileSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.EgressGateway != nil {
+		in, out := &in.EgressGateway, &out.EgressGateway
+		*out = new(EgressGatewaySpec)
+		**out = **in
+	}
 	return
 }
 
@@ -2446,6 +2955,292 @@ func (in *ServiceMatch) DeepCopy() *ServiceMatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StagedGlobalNetworkPolicy) DeepCopyInto(out *StagedGlobalNetworkPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StagedGlobalNetworkPolicy.
+func (in *StagedGlobalNetworkPolicy) DeepCopy() *StagedGlobalNetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(StagedGlobalNetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StagedGlobalNetworkPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StagedGlobalNetworkPolicyList) DeepCopyInto(out *StagedGlobalNetworkPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StagedGlobalNetworkPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StagedGlobalNetworkPolicyList.
+func (in *StagedGlobalNetworkPolicyList) DeepCopy() *StagedGlobalNetworkPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(StagedGlobalNetworkPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StagedGlobalNetworkPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StagedGlobalNetworkPolicySpec) DeepCopyInto(out *StagedGlobalNetworkPolicySpec) {
+	*out = *in
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]Rule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]Rule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]PolicyType, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StagedGlobalNetworkPolicySpec.
+func (in *StagedGlobalNetworkPolicySpec) DeepCopy() *StagedGlobalNetworkPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StagedGlobalNetworkPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StagedNetworkPolicy) DeepCopyInto(out *StagedNetworkPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StagedNetworkPolicy.
+func (in *StagedNetworkPolicy) DeepCopy() *StagedNetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(StagedNetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StagedNetworkPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StagedNetworkPolicyList) DeepCopyInto(out *StagedNetworkPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]StagedNetworkPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StagedNetworkPolicyList.
+func (in *StagedNetworkPolicyList) DeepCopy() *StagedNetworkPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(StagedNetworkPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *StagedNetworkPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StagedNetworkPolicySpec) DeepCopyInto(out *StagedNetworkPolicySpec) {
+	*out = *in
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = new(float64)
+		**out = **in
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]Rule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]Rule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Types != nil {
+		in, out := &in.Types, &out.Types
+		*out = make([]PolicyType, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StagedNetworkPolicySpec.
+func (in *StagedNetworkPolicySpec) DeepCopy() *StagedNetworkPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(StagedNetworkPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tier) DeepCopyInto(out *Tier) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tier.
+func (in *Tier) DeepCopy() *Tier {
+	if in == nil {
+		return nil
+	}
+	out := new(Tier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Tier) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TierList) DeepCopyInto(out *TierList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Tier, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TierList.
+func (in *TierList) DeepCopy() *TierList {
+	if in == nil {
+		return nil
+	}
+	out := new(TierList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TierList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TierSpec) DeepCopyInto(out *TierSpec) {
+	*out = *in
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = new(float64)
+		**out = **in
+	}
+	if in.DefaultAction != nil {
+		in, out := &in.DefaultAction, &out.DefaultAction
+		*out = new(Action)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TierSpec.
+func (in *TierSpec) DeepCopy() *TierSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TierSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadEndpointControllerConfig) DeepCopyInto(out *WorkloadEndpointControllerConfig) {
 	*out = *in
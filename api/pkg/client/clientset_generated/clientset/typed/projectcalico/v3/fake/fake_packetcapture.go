@@ -0,0 +1,128 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakePacketCaptures implements PacketCaptureInterface
+type FakePacketCaptures struct {
+	Fake *FakeProjectcalicoV3
+	ns   string
+}
+
+var packetcapturesResource = schema.GroupVersionResource{Group: "projectcalico.org", Version: "v3", Resource: "packetcaptures"}
+
+var packetcapturesKind = schema.GroupVersionKind{Group: "projectcalico.org", Version: "v3", Kind: "PacketCapture"}
+
+// Get takes name of the packetCapture, and returns the corresponding packetCapture object, and an error if there is any.
+func (c *FakePacketCaptures) Get(ctx context.Context, name string, options v1.GetOptions) (result *v3.PacketCapture, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(packetcapturesResource, c.ns, name), &v3.PacketCapture{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v3.PacketCapture), err
+}
+
+// List takes label and field selectors, and returns the list of PacketCaptures that match those selectors.
+func (c *FakePacketCaptures) List(ctx context.Context, opts v1.ListOptions) (result *v3.PacketCaptureList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(packetcapturesResource, packetcapturesKind, c.ns, opts), &v3.PacketCaptureList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v3.PacketCaptureList{ListMeta: obj.(*v3.PacketCaptureList).ListMeta}
+	for _, item := range obj.(*v3.PacketCaptureList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested packetCaptures.
+func (c *FakePacketCaptures) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(packetcapturesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a packetCapture and creates it.  Returns the server's representation of the packetCapture, and an error, if there is any.
+func (c *FakePacketCaptures) Create(ctx context.Context, packetCapture *v3.PacketCapture, opts v1.CreateOptions) (result *v3.PacketCapture, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(packetcapturesResource, c.ns, packetCapture), &v3.PacketCapture{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v3.PacketCapture), err
+}
+
+// Update takes the representation of a packetCapture and updates it. Returns the server's representation of the packetCapture, and an error, if there is any.
+func (c *FakePacketCaptures) Update(ctx context.Context, packetCapture *v3.PacketCapture, opts v1.UpdateOptions) (result *v3.PacketCapture, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(packetcapturesResource, c.ns, packetCapture), &v3.PacketCapture{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v3.PacketCapture), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakePacketCaptures) UpdateStatus(ctx context.Context, packetCapture *v3.PacketCapture, opts v1.UpdateOptions) (*v3.PacketCapture, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(packetcapturesResource, "status", c.ns, packetCapture), &v3.PacketCapture{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v3.PacketCapture), err
+}
+
+// Delete takes name of the packetCapture and deletes it. Returns an error if one occurs.
+func (c *FakePacketCaptures) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(packetcapturesResource, c.ns, name, opts), &v3.PacketCapture{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakePacketCaptures) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(packetcapturesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v3.PacketCaptureList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched packetCapture.
+func (c *FakePacketCaptures) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v3.PacketCapture, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(packetcapturesResource, c.ns, name, pt, data, subresources...), &v3.PacketCapture{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v3.PacketCapture), err
+}
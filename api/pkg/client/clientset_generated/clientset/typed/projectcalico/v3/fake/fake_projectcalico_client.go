@@ -18,6 +18,10 @@ func (c *FakeProjectcalicoV3) BGPConfigurations() v3.BGPConfigurationInterface {
 	return &FakeBGPConfigurations{c}
 }
 
+func (c *FakeProjectcalicoV3) BGPFilters() v3.BGPFilterInterface {
+	return &FakeBGPFilters{c}
+}
+
 func (c *FakeProjectcalicoV3) BGPPeers() v3.BGPPeerInterface {
 	return &FakeBGPPeers{c}
 }
@@ -66,10 +70,26 @@ func (c *FakeProjectcalicoV3) NetworkSets(namespace string) v3.NetworkSetInterfa
 	return &FakeNetworkSets{c, namespace}
 }
 
+func (c *FakeProjectcalicoV3) PacketCaptures(namespace string) v3.PacketCaptureInterface {
+	return &FakePacketCaptures{c, namespace}
+}
+
 func (c *FakeProjectcalicoV3) Profiles() v3.ProfileInterface {
 	return &FakeProfiles{c}
 }
 
+func (c *FakeProjectcalicoV3) StagedGlobalNetworkPolicies() v3.StagedGlobalNetworkPolicyInterface {
+	return &FakeStagedGlobalNetworkPolicies{c}
+}
+
+func (c *FakeProjectcalicoV3) StagedNetworkPolicies(namespace string) v3.StagedNetworkPolicyInterface {
+	return &FakeStagedNetworkPolicies{c, namespace}
+}
+
+func (c *FakeProjectcalicoV3) Tiers() v3.TierInterface {
+	return &FakeTiers{c}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeProjectcalicoV3) RESTClient() rest.Interface {
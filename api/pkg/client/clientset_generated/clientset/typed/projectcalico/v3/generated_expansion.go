@@ -6,6 +6,8 @@ package v3
 
 type BGPConfigurationExpansion interface{}
 
+type BGPFilterExpansion interface{}
+
 type BGPPeerExpansion interface{}
 
 type CalicoNodeStatusExpansion interface{}
@@ -30,4 +32,12 @@ type NetworkPolicyExpansion interface{}
 
 type NetworkSetExpansion interface{}
 
+type PacketCaptureExpansion interface{}
+
 type ProfileExpansion interface{}
+
+type StagedGlobalNetworkPolicyExpansion interface{}
+
+type StagedNetworkPolicyExpansion interface{}
+
+type TierExpansion interface{}
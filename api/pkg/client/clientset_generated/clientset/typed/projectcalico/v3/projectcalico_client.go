@@ -15,6 +15,7 @@ import (
 type ProjectcalicoV3Interface interface {
 	RESTClient() rest.Interface
 	BGPConfigurationsGetter
+	BGPFiltersGetter
 	BGPPeersGetter
 	CalicoNodeStatusesGetter
 	ClusterInformationsGetter
@@ -27,7 +28,11 @@ type ProjectcalicoV3Interface interface {
 	KubeControllersConfigurationsGetter
 	NetworkPoliciesGetter
 	NetworkSetsGetter
+	PacketCapturesGetter
 	ProfilesGetter
+	StagedGlobalNetworkPoliciesGetter
+	StagedNetworkPoliciesGetter
+	TiersGetter
 }
 
 // ProjectcalicoV3Client is used to interact with features provided by the projectcalico.org group.
@@ -39,6 +44,10 @@ func (c *ProjectcalicoV3Client) BGPConfigurations() BGPConfigurationInterface {
 	return newBGPConfigurations(c)
 }
 
+func (c *ProjectcalicoV3Client) BGPFilters() BGPFilterInterface {
+	return newBGPFilters(c)
+}
+
 func (c *ProjectcalicoV3Client) BGPPeers() BGPPeerInterface {
 	return newBGPPeers(c)
 }
@@ -87,10 +96,26 @@ func (c *ProjectcalicoV3Client) NetworkSets(namespace string) NetworkSetInterfac
 	return newNetworkSets(c, namespace)
 }
 
+func (c *ProjectcalicoV3Client) PacketCaptures(namespace string) PacketCaptureInterface {
+	return newPacketCaptures(c, namespace)
+}
+
 func (c *ProjectcalicoV3Client) Profiles() ProfileInterface {
 	return newProfiles(c)
 }
 
+func (c *ProjectcalicoV3Client) StagedGlobalNetworkPolicies() StagedGlobalNetworkPolicyInterface {
+	return newStagedGlobalNetworkPolicies(c)
+}
+
+func (c *ProjectcalicoV3Client) StagedNetworkPolicies(namespace string) StagedNetworkPolicyInterface {
+	return newStagedNetworkPolicies(c, namespace)
+}
+
+func (c *ProjectcalicoV3Client) Tiers() TierInterface {
+	return newTiers(c)
+}
+
 // NewForConfig creates a new ProjectcalicoV3Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
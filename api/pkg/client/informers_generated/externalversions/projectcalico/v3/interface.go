@@ -12,6 +12,8 @@ import (
 type Interface interface {
 	// BGPConfigurations returns a BGPConfigurationInformer.
 	BGPConfigurations() BGPConfigurationInformer
+	// BGPFilters returns a BGPFilterInformer.
+	BGPFilters() BGPFilterInformer
 	// BGPPeers returns a BGPPeerInformer.
 	BGPPeers() BGPPeerInformer
 	// CalicoNodeStatuses returns a CalicoNodeStatusInformer.
@@ -36,8 +38,16 @@ type Interface interface {
 	NetworkPolicies() NetworkPolicyInformer
 	// NetworkSets returns a NetworkSetInformer.
 	NetworkSets() NetworkSetInformer
+	// PacketCaptures returns a PacketCaptureInformer.
+	PacketCaptures() PacketCaptureInformer
 	// Profiles returns a ProfileInformer.
 	Profiles() ProfileInformer
+	// StagedGlobalNetworkPolicies returns a StagedGlobalNetworkPolicyInformer.
+	StagedGlobalNetworkPolicies() StagedGlobalNetworkPolicyInformer
+	// StagedNetworkPolicies returns a StagedNetworkPolicyInformer.
+	StagedNetworkPolicies() StagedNetworkPolicyInformer
+	// Tiers returns a TierInformer.
+	Tiers() TierInformer
 }
 
 type version struct {
@@ -56,6 +66,11 @@ func (v *version) BGPConfigurations() BGPConfigurationInformer {
 	return &bGPConfigurationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// BGPFilters returns a BGPFilterInformer.
+func (v *version) BGPFilters() BGPFilterInformer {
+	return &bGPFilterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // BGPPeers returns a BGPPeerInformer.
 func (v *version) BGPPeers() BGPPeerInformer {
 	return &bGPPeerInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -116,7 +131,27 @@ func (v *version) NetworkSets() NetworkSetInformer {
 	return &networkSetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// PacketCaptures returns a PacketCaptureInformer.
+func (v *version) PacketCaptures() PacketCaptureInformer {
+	return &packetCaptureInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Profiles returns a ProfileInformer.
 func (v *version) Profiles() ProfileInformer {
 	return &profileInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
+
+// StagedGlobalNetworkPolicies returns a StagedGlobalNetworkPolicyInformer.
+func (v *version) StagedGlobalNetworkPolicies() StagedGlobalNetworkPolicyInformer {
+	return &stagedGlobalNetworkPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// StagedNetworkPolicies returns a StagedNetworkPolicyInformer.
+func (v *version) StagedNetworkPolicies() StagedNetworkPolicyInformer {
+	return &stagedNetworkPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Tiers returns a TierInformer.
+func (v *version) Tiers() TierInformer {
+	return &tierInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
@@ -8,6 +8,10 @@ package v3
 // BGPConfigurationLister.
 type BGPConfigurationListerExpansion interface{}
 
+// BGPFilterListerExpansion allows custom methods to be added to
+// BGPFilterLister.
+type BGPFilterListerExpansion interface{}
+
 // BGPPeerListerExpansion allows custom methods to be added to
 // BGPPeerLister.
 type BGPPeerListerExpansion interface{}
@@ -64,6 +68,30 @@ type NetworkSetListerExpansion interface{}
 // NetworkSetNamespaceLister.
 type NetworkSetNamespaceListerExpansion interface{}
 
+// PacketCaptureListerExpansion allows custom methods to be added to
+// PacketCaptureLister.
+type PacketCaptureListerExpansion interface{}
+
+// PacketCaptureNamespaceListerExpansion allows custom methods to be added to
+// PacketCaptureNamespaceLister.
+type PacketCaptureNamespaceListerExpansion interface{}
+
 // ProfileListerExpansion allows custom methods to be added to
 // ProfileLister.
 type ProfileListerExpansion interface{}
+
+// StagedGlobalNetworkPolicyListerExpansion allows custom methods to be added to
+// StagedGlobalNetworkPolicyLister.
+type StagedGlobalNetworkPolicyListerExpansion interface{}
+
+// StagedNetworkPolicyListerExpansion allows custom methods to be added to
+// StagedNetworkPolicyLister.
+type StagedNetworkPolicyListerExpansion interface{}
+
+// StagedNetworkPolicyNamespaceListerExpansion allows custom methods to be added to
+// StagedNetworkPolicyNamespaceLister.
+type StagedNetworkPolicyNamespaceListerExpansion interface{}
+
+// TierListerExpansion allows custom methods to be added to
+// TierLister.
+type TierListerExpansion interface{}
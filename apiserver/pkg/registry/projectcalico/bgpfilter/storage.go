@@ -0,0 +1,93 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+package bgpfilter
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+
+	calico "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/server"
+)
+
+// rest implements a RESTStorage for API services against etcd
+type REST struct {
+	*genericregistry.Store
+	shortNames []string
+}
+
+func (r *REST) ShortNames() []string {
+	return r.shortNames
+}
+
+func (r *REST) Categories() []string {
+	return []string{""}
+}
+
+// EmptyObject returns an empty instance
+func EmptyObject() runtime.Object {
+	return &calico.BGPFilter{}
+}
+
+// NewList returns a new shell of a binding list
+func NewList() runtime.Object {
+	return &calico.BGPFilterList{}
+}
+
+// NewREST returns a RESTStorage object that will work against API services.
+func NewREST(scheme *runtime.Scheme, opts server.Options) (*REST, error) {
+	strategy := NewStrategy(scheme)
+
+	prefix := "/" + opts.ResourcePrefix()
+	// We adapt the store's keyFunc so that we can use it with the StorageDecorator
+	// without making any assumptions about where objects are stored in etcd
+	keyFunc := func(obj runtime.Object) (string, error) {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return "", err
+		}
+		return registry.NoNamespaceKeyFunc(
+			genericapirequest.NewContext(),
+			prefix,
+			accessor.GetName(),
+		)
+	}
+	storageInterface, dFunc, err := opts.GetStorage(
+		prefix,
+		keyFunc,
+		strategy,
+		func() runtime.Object { return &calico.BGPFilter{} },
+		func() runtime.Object { return &calico.BGPFilterList{} },
+		GetAttrs,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	store := &genericregistry.Store{
+		NewFunc:     func() runtime.Object { return &calico.BGPFilter{} },
+		NewListFunc: func() runtime.Object { return &calico.BGPFilterList{} },
+		KeyRootFunc: opts.KeyRootFunc(false),
+		KeyFunc:     opts.KeyFunc(false),
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*calico.BGPFilter).Name, nil
+		},
+		PredicateFunc:            MatchBGPFilter,
+		DefaultQualifiedResource: calico.Resource("bgpfilters"),
+
+		CreateStrategy:          strategy,
+		UpdateStrategy:          strategy,
+		DeleteStrategy:          strategy,
+		EnableGarbageCollection: true,
+
+		Storage:     storageInterface,
+		DestroyFunc: dFunc,
+	}
+
+	return &REST{store, opts.ShortNames}, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.package globalpolicy
+
+package packetcapture
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+
+	calico "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/server"
+)
+
+// rest implements a RESTStorage for API services against etcd
+type REST struct {
+	*genericregistry.Store
+	shortNames []string
+}
+
+func (r *REST) ShortNames() []string {
+	return r.shortNames
+}
+
+func (r *REST) Categories() []string {
+	return []string{""}
+}
+
+// EmptyObject returns an empty instance
+func EmptyObject() runtime.Object {
+	return &calico.PacketCapture{}
+}
+
+// NewList returns a new shell of a binding list
+func NewList() runtime.Object {
+	return &calico.PacketCaptureList{}
+}
+
+// NewREST returns a RESTStorage object that will work against API services.
+func NewREST(scheme *runtime.Scheme, opts server.Options) (*REST, error) {
+	strategy := NewStrategy(scheme)
+
+	prefix := "/" + opts.ResourcePrefix()
+	// We adapt the store's keyFunc so that we can use it with the StorageDecorator
+	// without making any assumptions about where objects are stored in etcd
+	keyFunc := func(obj runtime.Object) (string, error) {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return "", err
+		}
+		return registry.NamespaceKeyFunc(genericapirequest.WithNamespace(genericapirequest.NewContext(), accessor.GetNamespace()), prefix, accessor.GetName())
+	}
+	storageInterface, dFunc, err := opts.GetStorage(
+		prefix,
+		keyFunc,
+		strategy,
+		func() runtime.Object { return &calico.PacketCapture{} },
+		func() runtime.Object { return &calico.PacketCaptureList{} },
+		GetAttrs,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	store := &genericregistry.Store{
+		NewFunc:     func() runtime.Object { return &calico.PacketCapture{} },
+		NewListFunc: func() runtime.Object { return &calico.PacketCaptureList{} },
+		KeyRootFunc: opts.KeyRootFunc(true),
+		KeyFunc:     opts.KeyFunc(true),
+		ObjectNameFunc: func(obj runtime.Object) (string, error) {
+			return obj.(*calico.PacketCapture).Name, nil
+		},
+		PredicateFunc:            MatchPacketCapture,
+		DefaultQualifiedResource: calico.Resource("packetcaptures"),
+
+		CreateStrategy:          strategy,
+		UpdateStrategy:          strategy,
+		DeleteStrategy:          strategy,
+		EnableGarbageCollection: true,
+
+		Storage:     storageInterface,
+		DestroyFunc: dFunc,
+	}
+
+	return &REST{store, opts.ShortNames}, nil
+}
@@ -25,6 +25,7 @@ import (
 	calico "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 
 	calicobgpconfiguration "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/bgpconfiguration"
+	calicobgpfilter "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/bgpfilter"
 	calicobgppeer "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/bgppeer"
 	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/caliconodestatus"
 	calicoclusterinformation "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/clusterinformation"
@@ -37,8 +38,12 @@ import (
 	calicokubecontrollersconfig "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/kubecontrollersconfig"
 	calicopolicy "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/networkpolicy"
 	caliconetworkset "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/networkset"
+	calicopacketcapture "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/packetcapture"
 	calicoprofile "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/profile"
 	"github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/server"
+	calicostagedgpolicy "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/stagedglobalnetworkpolicy"
+	calicostagedpolicy "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/stagednetworkpolicy"
+	calicotier "github.com/projectcalico/calico/apiserver/pkg/registry/projectcalico/tier"
 	calicostorage "github.com/projectcalico/calico/apiserver/pkg/storage/calico"
 	"github.com/projectcalico/calico/apiserver/pkg/storage/etcd"
 )
@@ -77,6 +82,50 @@ func (p RESTStorageProvider) NewV3Storage(
 		[]string{"cnp", "caliconetworkpolicy", "caliconetworkpolicies"},
 	)
 
+	stagedPolicyRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("stagednetworkpolicies"))
+	if err != nil {
+		return nil, err
+	}
+	stagedPolicyOpts := server.NewOptions(
+		etcd.Options{
+			RESTOptions:   stagedPolicyRESTOptions,
+			Capacity:      1000,
+			ObjectType:    calicostagedpolicy.EmptyObject(),
+			ScopeStrategy: calicostagedpolicy.NewStrategy(scheme),
+			NewListFunc:   calicostagedpolicy.NewList,
+			GetAttrsFunc:  calicostagedpolicy.GetAttrs,
+			Trigger:       nil,
+		},
+		calicostorage.Options{
+			RESTOptions: stagedPolicyRESTOptions,
+		},
+		p.StorageType,
+		authorizer,
+		[]string{"snp", "snps"},
+	)
+
+	stagedGPolicyRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("stagedglobalnetworkpolicies"))
+	if err != nil {
+		return nil, err
+	}
+	stagedGPolicyOpts := server.NewOptions(
+		etcd.Options{
+			RESTOptions:   stagedGPolicyRESTOptions,
+			Capacity:      1000,
+			ObjectType:    calicostagedgpolicy.EmptyObject(),
+			ScopeStrategy: calicostagedgpolicy.NewStrategy(scheme),
+			NewListFunc:   calicostagedgpolicy.NewList,
+			GetAttrsFunc:  calicostagedgpolicy.GetAttrs,
+			Trigger:       nil,
+		},
+		calicostorage.Options{
+			RESTOptions: stagedGPolicyRESTOptions,
+		},
+		p.StorageType,
+		authorizer,
+		[]string{"sgnp", "sgnps"},
+	)
+
 	networksetRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("networksets"))
 	if err != nil {
 		return nil, err
@@ -99,6 +148,28 @@ func (p RESTStorageProvider) NewV3Storage(
 		[]string{"netsets"},
 	)
 
+	packetCaptureRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("packetcaptures"))
+	if err != nil {
+		return nil, err
+	}
+	packetCaptureOpts := server.NewOptions(
+		etcd.Options{
+			RESTOptions:   packetCaptureRESTOptions,
+			Capacity:      1000,
+			ObjectType:    calicopacketcapture.EmptyObject(),
+			ScopeStrategy: calicopacketcapture.NewStrategy(scheme),
+			NewListFunc:   calicopacketcapture.NewList,
+			GetAttrsFunc:  calicopacketcapture.GetAttrs,
+			Trigger:       nil,
+		},
+		calicostorage.Options{
+			RESTOptions: packetCaptureRESTOptions,
+		},
+		p.StorageType,
+		authorizer,
+		[]string{"pcap", "pcaps"},
+	)
+
 	gpolicyRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("globalnetworkpolicies"))
 	if err != nil {
 		return nil, err
@@ -209,6 +280,28 @@ func (p RESTStorageProvider) NewV3Storage(
 		[]string{},
 	)
 
+	tierRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("tiers"))
+	if err != nil {
+		return nil, err
+	}
+	tierOpts := server.NewOptions(
+		etcd.Options{
+			RESTOptions:   tierRESTOptions,
+			Capacity:      10,
+			ObjectType:    calicotier.EmptyObject(),
+			ScopeStrategy: calicotier.NewStrategy(scheme),
+			NewListFunc:   calicotier.NewList,
+			GetAttrsFunc:  calicotier.GetAttrs,
+			Trigger:       nil,
+		},
+		calicostorage.Options{
+			RESTOptions: tierRESTOptions,
+		},
+		p.StorageType,
+		authorizer,
+		[]string{},
+	)
+
 	bgpConfigurationRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("bgpconfigurations"))
 	if err != nil {
 		return nil, err
@@ -253,6 +346,28 @@ func (p RESTStorageProvider) NewV3Storage(
 		[]string{},
 	)
 
+	bgpFilterRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("bgpfilters"))
+	if err != nil {
+		return nil, err
+	}
+	bgpFilterOpts := server.NewOptions(
+		etcd.Options{
+			RESTOptions:   bgpFilterRESTOptions,
+			Capacity:      1000,
+			ObjectType:    calicobgpfilter.EmptyObject(),
+			ScopeStrategy: calicobgpfilter.NewStrategy(scheme),
+			NewListFunc:   calicobgpfilter.NewList,
+			GetAttrsFunc:  calicobgpfilter.GetAttrs,
+			Trigger:       nil,
+		},
+		calicostorage.Options{
+			RESTOptions: bgpFilterRESTOptions,
+		},
+		p.StorageType,
+		authorizer,
+		[]string{},
+	)
+
 	profileRESTOptions, err := restOptionsGetter.GetRESTOptions(calico.Resource("profiles"))
 	if err != nil {
 		return nil, err
@@ -366,13 +481,18 @@ func (p RESTStorageProvider) NewV3Storage(
 	storage := map[string]rest.Storage{}
 	storage["networkpolicies"] = rESTInPeace(calicopolicy.NewREST(scheme, *policyOpts))
 	storage["globalnetworkpolicies"] = rESTInPeace(calicogpolicy.NewREST(scheme, *gpolicyOpts))
+	storage["stagednetworkpolicies"] = rESTInPeace(calicostagedpolicy.NewREST(scheme, *stagedPolicyOpts))
+	storage["stagedglobalnetworkpolicies"] = rESTInPeace(calicostagedgpolicy.NewREST(scheme, *stagedGPolicyOpts))
 	storage["globalnetworksets"] = rESTInPeace(calicognetworkset.NewREST(scheme, *gNetworkSetOpts))
 	storage["networksets"] = rESTInPeace(caliconetworkset.NewREST(scheme, *networksetOpts))
+	storage["packetcaptures"] = rESTInPeace(calicopacketcapture.NewREST(scheme, *packetCaptureOpts))
 	storage["hostendpoints"] = rESTInPeace(calicohostendpoint.NewREST(scheme, *hostEndpointOpts))
 	storage["ippools"] = rESTInPeace(calicoippool.NewREST(scheme, *ipPoolSetOpts))
 	storage["ipreservations"] = rESTInPeace(calicoipreservation.NewREST(scheme, *ipReservationSetOpts))
+	storage["tiers"] = rESTInPeace(calicotier.NewREST(scheme, *tierOpts))
 	storage["bgpconfigurations"] = rESTInPeace(calicobgpconfiguration.NewREST(scheme, *bgpConfigurationOpts))
 	storage["bgppeers"] = rESTInPeace(calicobgppeer.NewREST(scheme, *bgpPeerOpts))
+	storage["bgpfilters"] = rESTInPeace(calicobgpfilter.NewREST(scheme, *bgpFilterOpts))
 	storage["profiles"] = rESTInPeace(calicoprofile.NewREST(scheme, *profileOpts))
 	storage["felixconfigurations"] = rESTInPeace(calicofelixconfig.NewREST(scheme, *felixConfigOpts))
 	storage["clusterinformations"] = rESTInPeace(calicoclusterinformation.NewREST(scheme, *clusterInformationOpts))
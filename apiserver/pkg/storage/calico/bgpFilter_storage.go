@@ -0,0 +1,113 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+package calico
+
+import (
+	"reflect"
+
+	"golang.org/x/net/context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/storage"
+	etcd "k8s.io/apiserver/pkg/storage/etcd3"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+
+	aapi "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+	"github.com/projectcalico/calico/libcalico-go/lib/watch"
+)
+
+// NewBGPFilterStorage creates a new libcalico-based storage.Interface implementation for BGPFilters
+func NewBGPFilterStorage(opts Options) (registry.DryRunnableStorage, factory.DestroyFunc) {
+	c := CreateClientFromConfig()
+	createFn := func(ctx context.Context, c clientv3.Interface, obj resourceObject, opts clientOpts) (resourceObject, error) {
+		oso := opts.(options.SetOptions)
+		res := obj.(*api.BGPFilter)
+		return c.BGPFilters().Create(ctx, res, oso)
+	}
+	updateFn := func(ctx context.Context, c clientv3.Interface, obj resourceObject, opts clientOpts) (resourceObject, error) {
+		oso := opts.(options.SetOptions)
+		res := obj.(*api.BGPFilter)
+		return c.BGPFilters().Update(ctx, res, oso)
+	}
+	getFn := func(ctx context.Context, c clientv3.Interface, ns string, name string, opts clientOpts) (resourceObject, error) {
+		ogo := opts.(options.GetOptions)
+		return c.BGPFilters().Get(ctx, name, ogo)
+	}
+	deleteFn := func(ctx context.Context, c clientv3.Interface, ns string, name string, opts clientOpts) (resourceObject, error) {
+		odo := opts.(options.DeleteOptions)
+		return c.BGPFilters().Delete(ctx, name, odo)
+	}
+	listFn := func(ctx context.Context, c clientv3.Interface, opts clientOpts) (resourceListObject, error) {
+		olo := opts.(options.ListOptions)
+		return c.BGPFilters().List(ctx, olo)
+	}
+	watchFn := func(ctx context.Context, c clientv3.Interface, opts clientOpts) (watch.Interface, error) {
+		olo := opts.(options.ListOptions)
+		return c.BGPFilters().Watch(ctx, olo)
+	}
+	dryRunnableStorage := registry.DryRunnableStorage{Storage: &resourceStore{
+		client:            c,
+		codec:             opts.RESTOptions.StorageConfig.Codec,
+		versioner:         etcd.APIObjectVersioner{},
+		aapiType:          reflect.TypeOf(aapi.BGPFilter{}),
+		aapiListType:      reflect.TypeOf(aapi.BGPFilterList{}),
+		libCalicoType:     reflect.TypeOf(api.BGPFilter{}),
+		libCalicoListType: reflect.TypeOf(api.BGPFilterList{}),
+		isNamespaced:      false,
+		create:            createFn,
+		update:            updateFn,
+		get:               getFn,
+		delete:            deleteFn,
+		list:              listFn,
+		watch:             watchFn,
+		resourceName:      "BGPFilter",
+		converter:         BGPFilterConverter{},
+	}, Codec: opts.RESTOptions.StorageConfig.Codec}
+	return dryRunnableStorage, func() {}
+}
+
+type BGPFilterConverter struct {
+}
+
+func (gc BGPFilterConverter) convertToLibcalico(aapiObj runtime.Object) resourceObject {
+	aapiBGPFilter := aapiObj.(*aapi.BGPFilter)
+	lcgBGPFilter := &api.BGPFilter{}
+	lcgBGPFilter.TypeMeta = aapiBGPFilter.TypeMeta
+	lcgBGPFilter.ObjectMeta = aapiBGPFilter.ObjectMeta
+	lcgBGPFilter.Kind = api.KindBGPFilter
+	lcgBGPFilter.APIVersion = api.GroupVersionCurrent
+	lcgBGPFilter.Spec = aapiBGPFilter.Spec
+	return lcgBGPFilter
+}
+
+func (gc BGPFilterConverter) convertToAAPI(libcalicoObject resourceObject, aapiObj runtime.Object) {
+	lcgBGPFilter := libcalicoObject.(*api.BGPFilter)
+	aapiBGPFilter := aapiObj.(*aapi.BGPFilter)
+	aapiBGPFilter.Spec = lcgBGPFilter.Spec
+	aapiBGPFilter.TypeMeta = lcgBGPFilter.TypeMeta
+	aapiBGPFilter.ObjectMeta = lcgBGPFilter.ObjectMeta
+}
+
+func (gc BGPFilterConverter) convertToAAPIList(libcalicoListObject resourceListObject, aapiListObj runtime.Object, pred storage.SelectionPredicate) {
+	lcgBGPFilterList := libcalicoListObject.(*api.BGPFilterList)
+	aapiBGPFilterList := aapiListObj.(*aapi.BGPFilterList)
+	if libcalicoListObject == nil {
+		aapiBGPFilterList.Items = []aapi.BGPFilter{}
+		return
+	}
+	aapiBGPFilterList.TypeMeta = lcgBGPFilterList.TypeMeta
+	aapiBGPFilterList.ListMeta = lcgBGPFilterList.ListMeta
+	for _, item := range lcgBGPFilterList.Items {
+		aapiBGPFilter := aapi.BGPFilter{}
+		gc.convertToAAPI(&item, &aapiBGPFilter)
+		if matched, err := pred.Matches(&aapiBGPFilter); err == nil && matched {
+			aapiBGPFilterList.Items = append(aapiBGPFilterList.Items, aapiBGPFilter)
+		}
+	}
+}
@@ -44,6 +44,16 @@ func convertToAAPI(libcalicoObject runtime.Object) (res runtime.Object) {
 		aapiPolicy := &aapi.GlobalNetworkPolicy{}
 		GlobalNetworkPolicyConverter{}.convertToAAPI(lcgPolicy, aapiPolicy)
 		return aapiPolicy
+	case *api.StagedNetworkPolicy:
+		lcgPolicy := libcalicoObject.(*api.StagedNetworkPolicy)
+		aapiPolicy := &aapi.StagedNetworkPolicy{}
+		StagedNetworkPolicyConverter{}.convertToAAPI(lcgPolicy, aapiPolicy)
+		return aapiPolicy
+	case *api.StagedGlobalNetworkPolicy:
+		lcgPolicy := libcalicoObject.(*api.StagedGlobalNetworkPolicy)
+		aapiPolicy := &aapi.StagedGlobalNetworkPolicy{}
+		StagedGlobalNetworkPolicyConverter{}.convertToAAPI(lcgPolicy, aapiPolicy)
+		return aapiPolicy
 	case *api.GlobalNetworkSet:
 		lcgNetworkSet := libcalicoObject.(*api.GlobalNetworkSet)
 		aapiNetworkSet := &aapi.GlobalNetworkSet{}
@@ -54,6 +64,11 @@ func convertToAAPI(libcalicoObject runtime.Object) (res runtime.Object) {
 		aapiNetworkSet := &aapi.NetworkSet{}
 		NetworkSetConverter{}.convertToAAPI(lcgNetworkSet, aapiNetworkSet)
 		return aapiNetworkSet
+	case *api.PacketCapture:
+		lcgPacketCapture := libcalicoObject.(*api.PacketCapture)
+		aapiPacketCapture := &aapi.PacketCapture{}
+		PacketCaptureConverter{}.convertToAAPI(lcgPacketCapture, aapiPacketCapture)
+		return aapiPacketCapture
 	case *api.HostEndpoint:
 		lcg := libcalicoObject.(*api.HostEndpoint)
 		aapi := &aapi.HostEndpoint{}
@@ -69,6 +84,11 @@ func convertToAAPI(libcalicoObject runtime.Object) (res runtime.Object) {
 		aapi := &aapi.IPReservation{}
 		IPReservationConverter{}.convertToAAPI(lcg, aapi)
 		return aapi
+	case *api.Tier:
+		lcg := libcalicoObject.(*api.Tier)
+		aapi := &aapi.Tier{}
+		TierConverter{}.convertToAAPI(lcg, aapi)
+		return aapi
 	case *api.BGPConfiguration:
 		lcg := libcalicoObject.(*api.BGPConfiguration)
 		aapi := &aapi.BGPConfiguration{}
@@ -79,6 +99,11 @@ func convertToAAPI(libcalicoObject runtime.Object) (res runtime.Object) {
 		aapi := &aapi.BGPPeer{}
 		BGPPeerConverter{}.convertToAAPI(lcg, aapi)
 		return aapi
+	case *api.BGPFilter:
+		lcg := libcalicoObject.(*api.BGPFilter)
+		aapi := &aapi.BGPFilter{}
+		BGPFilterConverter{}.convertToAAPI(lcg, aapi)
+		return aapi
 	case *api.Profile:
 		lcg := libcalicoObject.(*api.Profile)
 		aapi := &aapi.Profile{}
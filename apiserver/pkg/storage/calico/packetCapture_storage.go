@@ -0,0 +1,116 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+package calico
+
+import (
+	"reflect"
+
+	"golang.org/x/net/context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/storage"
+	etcd "k8s.io/apiserver/pkg/storage/etcd3"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+
+	aapi "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+	"github.com/projectcalico/calico/libcalico-go/lib/watch"
+)
+
+// NewPacketCaptureStorage creates a new libcalico-based storage.Interface implementation for PacketCaptures
+func NewPacketCaptureStorage(opts Options) (registry.DryRunnableStorage, factory.DestroyFunc) {
+	c := CreateClientFromConfig()
+	createFn := func(ctx context.Context, c clientv3.Interface, obj resourceObject, opts clientOpts) (resourceObject, error) {
+		oso := opts.(options.SetOptions)
+		res := obj.(*api.PacketCapture)
+		return c.PacketCaptures().Create(ctx, res, oso)
+	}
+	updateFn := func(ctx context.Context, c clientv3.Interface, obj resourceObject, opts clientOpts) (resourceObject, error) {
+		oso := opts.(options.SetOptions)
+		res := obj.(*api.PacketCapture)
+		return c.PacketCaptures().Update(ctx, res, oso)
+	}
+	getFn := func(ctx context.Context, c clientv3.Interface, ns string, name string, opts clientOpts) (resourceObject, error) {
+		ogo := opts.(options.GetOptions)
+		return c.PacketCaptures().Get(ctx, ns, name, ogo)
+	}
+	deleteFn := func(ctx context.Context, c clientv3.Interface, ns string, name string, opts clientOpts) (resourceObject, error) {
+		odo := opts.(options.DeleteOptions)
+		return c.PacketCaptures().Delete(ctx, ns, name, odo)
+	}
+	listFn := func(ctx context.Context, c clientv3.Interface, opts clientOpts) (resourceListObject, error) {
+		olo := opts.(options.ListOptions)
+		return c.PacketCaptures().List(ctx, olo)
+	}
+	watchFn := func(ctx context.Context, c clientv3.Interface, opts clientOpts) (watch.Interface, error) {
+		olo := opts.(options.ListOptions)
+		return c.PacketCaptures().Watch(ctx, olo)
+	}
+	// TODO(doublek): Inject codec, client for nicer testing.
+	dryRunnableStorage := registry.DryRunnableStorage{Storage: &resourceStore{
+		client:            c,
+		codec:             opts.RESTOptions.StorageConfig.Codec,
+		versioner:         etcd.APIObjectVersioner{},
+		aapiType:          reflect.TypeOf(aapi.PacketCapture{}),
+		aapiListType:      reflect.TypeOf(aapi.PacketCaptureList{}),
+		libCalicoType:     reflect.TypeOf(api.PacketCapture{}),
+		libCalicoListType: reflect.TypeOf(api.PacketCaptureList{}),
+		isNamespaced:      true,
+		create:            createFn,
+		update:            updateFn,
+		get:               getFn,
+		delete:            deleteFn,
+		list:              listFn,
+		watch:             watchFn,
+		resourceName:      "PacketCapture",
+		converter:         PacketCaptureConverter{},
+	}, Codec: opts.RESTOptions.StorageConfig.Codec}
+	return dryRunnableStorage, func() {}
+}
+
+type PacketCaptureConverter struct {
+}
+
+func (gc PacketCaptureConverter) convertToLibcalico(aapiObj runtime.Object) resourceObject {
+	aapiPacketCapture := aapiObj.(*aapi.PacketCapture)
+	lcgPacketCapture := &api.PacketCapture{}
+	lcgPacketCapture.TypeMeta = aapiPacketCapture.TypeMeta
+	lcgPacketCapture.ObjectMeta = aapiPacketCapture.ObjectMeta
+	lcgPacketCapture.Kind = api.KindPacketCapture
+	lcgPacketCapture.APIVersion = api.GroupVersionCurrent
+	lcgPacketCapture.Spec = aapiPacketCapture.Spec
+	lcgPacketCapture.Status = aapiPacketCapture.Status
+	return lcgPacketCapture
+}
+
+func (gc PacketCaptureConverter) convertToAAPI(libcalicoObject resourceObject, aapiObj runtime.Object) {
+	lcgPacketCapture := libcalicoObject.(*api.PacketCapture)
+	aapiPacketCapture := aapiObj.(*aapi.PacketCapture)
+	aapiPacketCapture.Spec = lcgPacketCapture.Spec
+	aapiPacketCapture.Status = lcgPacketCapture.Status
+	aapiPacketCapture.TypeMeta = lcgPacketCapture.TypeMeta
+	aapiPacketCapture.ObjectMeta = lcgPacketCapture.ObjectMeta
+}
+
+func (gc PacketCaptureConverter) convertToAAPIList(libcalicoListObject resourceListObject, aapiListObj runtime.Object, pred storage.SelectionPredicate) {
+	lcgPacketCaptureList := libcalicoListObject.(*api.PacketCaptureList)
+	aapiPacketCaptureList := aapiListObj.(*aapi.PacketCaptureList)
+	if libcalicoListObject == nil {
+		aapiPacketCaptureList.Items = []aapi.PacketCapture{}
+		return
+	}
+	aapiPacketCaptureList.TypeMeta = lcgPacketCaptureList.TypeMeta
+	aapiPacketCaptureList.ListMeta = lcgPacketCaptureList.ListMeta
+	for _, item := range lcgPacketCaptureList.Items {
+		aapiPacketCapture := aapi.PacketCapture{}
+		gc.convertToAAPI(&item, &aapiPacketCapture)
+		if matched, err := pred.Matches(&aapiPacketCapture); err == nil && matched {
+			aapiPacketCaptureList.Items = append(aapiPacketCaptureList.Items, aapiPacketCapture)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+package calico
+
+import (
+	"reflect"
+
+	"golang.org/x/net/context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/storage"
+	etcd "k8s.io/apiserver/pkg/storage/etcd3"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+
+	aapi "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+	"github.com/projectcalico/calico/libcalico-go/lib/watch"
+)
+
+// NewStagedGlobalNetworkPolicyStorage creates a new libcalico-based storage.Interface implementation for StagedGlobalNetworkPolicies
+func NewStagedGlobalNetworkPolicyStorage(opts Options) (registry.DryRunnableStorage, factory.DestroyFunc) {
+	c := CreateClientFromConfig()
+	createFn := func(ctx context.Context, c clientv3.Interface, obj resourceObject, opts clientOpts) (resourceObject, error) {
+		oso := opts.(options.SetOptions)
+		res := obj.(*api.StagedGlobalNetworkPolicy)
+		return c.StagedGlobalNetworkPolicies().Create(ctx, res, oso)
+	}
+	updateFn := func(ctx context.Context, c clientv3.Interface, obj resourceObject, opts clientOpts) (resourceObject, error) {
+		oso := opts.(options.SetOptions)
+		res := obj.(*api.StagedGlobalNetworkPolicy)
+		return c.StagedGlobalNetworkPolicies().Update(ctx, res, oso)
+	}
+	getFn := func(ctx context.Context, c clientv3.Interface, ns string, name string, opts clientOpts) (resourceObject, error) {
+		ogo := opts.(options.GetOptions)
+		return c.StagedGlobalNetworkPolicies().Get(ctx, name, ogo)
+	}
+	deleteFn := func(ctx context.Context, c clientv3.Interface, ns string, name string, opts clientOpts) (resourceObject, error) {
+		odo := opts.(options.DeleteOptions)
+		return c.StagedGlobalNetworkPolicies().Delete(ctx, name, odo)
+	}
+	listFn := func(ctx context.Context, c clientv3.Interface, opts clientOpts) (resourceListObject, error) {
+		olo := opts.(options.ListOptions)
+		return c.StagedGlobalNetworkPolicies().List(ctx, olo)
+	}
+	watchFn := func(ctx context.Context, c clientv3.Interface, opts clientOpts) (watch.Interface, error) {
+		olo := opts.(options.ListOptions)
+		return c.StagedGlobalNetworkPolicies().Watch(ctx, olo)
+	}
+	// TODO(doublek): Inject codec, client for nicer testing.
+	dryRunnableStorage := registry.DryRunnableStorage{Storage: &resourceStore{
+		client:            c,
+		codec:             opts.RESTOptions.StorageConfig.Codec,
+		versioner:         etcd.APIObjectVersioner{},
+		aapiType:          reflect.TypeOf(aapi.StagedGlobalNetworkPolicy{}),
+		aapiListType:      reflect.TypeOf(aapi.StagedGlobalNetworkPolicyList{}),
+		libCalicoType:     reflect.TypeOf(api.StagedGlobalNetworkPolicy{}),
+		libCalicoListType: reflect.TypeOf(api.StagedGlobalNetworkPolicyList{}),
+		isNamespaced:      false,
+		create:            createFn,
+		update:            updateFn,
+		get:               getFn,
+		delete:            deleteFn,
+		list:              listFn,
+		watch:             watchFn,
+		resourceName:      "StagedGlobalNetworkPolicy",
+		converter:         StagedGlobalNetworkPolicyConverter{},
+	}, Codec: opts.RESTOptions.StorageConfig.Codec}
+	return dryRunnableStorage, func() {}
+}
+
+type StagedGlobalNetworkPolicyConverter struct {
+}
+
+func (gc StagedGlobalNetworkPolicyConverter) convertToLibcalico(aapiObj runtime.Object) resourceObject {
+	aapiStagedGlobalNetworkPolicy := aapiObj.(*aapi.StagedGlobalNetworkPolicy)
+	lcgStagedGlobalNetworkPolicy := &api.StagedGlobalNetworkPolicy{}
+	lcgStagedGlobalNetworkPolicy.TypeMeta = aapiStagedGlobalNetworkPolicy.TypeMeta
+	lcgStagedGlobalNetworkPolicy.ObjectMeta = aapiStagedGlobalNetworkPolicy.ObjectMeta
+	lcgStagedGlobalNetworkPolicy.Kind = api.KindStagedGlobalNetworkPolicy
+	lcgStagedGlobalNetworkPolicy.APIVersion = api.GroupVersionCurrent
+	lcgStagedGlobalNetworkPolicy.Spec = aapiStagedGlobalNetworkPolicy.Spec
+	return lcgStagedGlobalNetworkPolicy
+}
+
+func (gc StagedGlobalNetworkPolicyConverter) convertToAAPI(libcalicoObject resourceObject, aapiObj runtime.Object) {
+	lcgStagedGlobalNetworkPolicy := libcalicoObject.(*api.StagedGlobalNetworkPolicy)
+	aapiStagedGlobalNetworkPolicy := aapiObj.(*aapi.StagedGlobalNetworkPolicy)
+	aapiStagedGlobalNetworkPolicy.Spec = lcgStagedGlobalNetworkPolicy.Spec
+	aapiStagedGlobalNetworkPolicy.TypeMeta = lcgStagedGlobalNetworkPolicy.TypeMeta
+	aapiStagedGlobalNetworkPolicy.ObjectMeta = lcgStagedGlobalNetworkPolicy.ObjectMeta
+}
+
+func (gc StagedGlobalNetworkPolicyConverter) convertToAAPIList(libcalicoListObject resourceListObject, aapiListObj runtime.Object, pred storage.SelectionPredicate) {
+	lcgStagedGlobalNetworkPolicyList := libcalicoListObject.(*api.StagedGlobalNetworkPolicyList)
+	aapiStagedGlobalNetworkPolicyList := aapiListObj.(*aapi.StagedGlobalNetworkPolicyList)
+	if libcalicoListObject == nil {
+		aapiStagedGlobalNetworkPolicyList.Items = []aapi.StagedGlobalNetworkPolicy{}
+		return
+	}
+	aapiStagedGlobalNetworkPolicyList.TypeMeta = lcgStagedGlobalNetworkPolicyList.TypeMeta
+	aapiStagedGlobalNetworkPolicyList.ListMeta = lcgStagedGlobalNetworkPolicyList.ListMeta
+	for _, item := range lcgStagedGlobalNetworkPolicyList.Items {
+		aapiStagedGlobalNetworkPolicy := aapi.StagedGlobalNetworkPolicy{}
+		gc.convertToAAPI(&item, &aapiStagedGlobalNetworkPolicy)
+		if matched, err := pred.Matches(&aapiStagedGlobalNetworkPolicy); err == nil && matched {
+			aapiStagedGlobalNetworkPolicyList.Items = append(aapiStagedGlobalNetworkPolicyList.Items, aapiStagedGlobalNetworkPolicy)
+		}
+	}
+}
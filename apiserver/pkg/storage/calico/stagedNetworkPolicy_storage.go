@@ -0,0 +1,113 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+package calico
+
+import (
+	"reflect"
+
+	"golang.org/x/net/context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/storage"
+	etcd "k8s.io/apiserver/pkg/storage/etcd3"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+
+	aapi "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+	"github.com/projectcalico/calico/libcalico-go/lib/watch"
+)
+
+// NewStagedNetworkPolicyStorage creates a new libcalico-based storage.Interface implementation for StagedNetworkPolicies
+func NewStagedNetworkPolicyStorage(opts Options) (registry.DryRunnableStorage, factory.DestroyFunc) {
+	c := CreateClientFromConfig()
+	createFn := func(ctx context.Context, c clientv3.Interface, obj resourceObject, opts clientOpts) (resourceObject, error) {
+		oso := opts.(options.SetOptions)
+		res := obj.(*api.StagedNetworkPolicy)
+		return c.StagedNetworkPolicies().Create(ctx, res, oso)
+	}
+	updateFn := func(ctx context.Context, c clientv3.Interface, obj resourceObject, opts clientOpts) (resourceObject, error) {
+		oso := opts.(options.SetOptions)
+		res := obj.(*api.StagedNetworkPolicy)
+		return c.StagedNetworkPolicies().Update(ctx, res, oso)
+	}
+	getFn := func(ctx context.Context, c clientv3.Interface, ns string, name string, opts clientOpts) (resourceObject, error) {
+		ogo := opts.(options.GetOptions)
+		return c.StagedNetworkPolicies().Get(ctx, ns, name, ogo)
+	}
+	deleteFn := func(ctx context.Context, c clientv3.Interface, ns string, name string, opts clientOpts) (resourceObject, error) {
+		odo := opts.(options.DeleteOptions)
+		return c.StagedNetworkPolicies().Delete(ctx, ns, name, odo)
+	}
+	listFn := func(ctx context.Context, c clientv3.Interface, opts clientOpts) (resourceListObject, error) {
+		olo := opts.(options.ListOptions)
+		return c.StagedNetworkPolicies().List(ctx, olo)
+	}
+	watchFn := func(ctx context.Context, c clientv3.Interface, opts clientOpts) (watch.Interface, error) {
+		olo := opts.(options.ListOptions)
+		return c.StagedNetworkPolicies().Watch(ctx, olo)
+	}
+	dryRunnableStorage := registry.DryRunnableStorage{Storage: &resourceStore{
+		client:            c,
+		codec:             opts.RESTOptions.StorageConfig.Codec,
+		versioner:         APIObjectVersioner{&etcd.APIObjectVersioner{}},
+		aapiType:          reflect.TypeOf(aapi.StagedNetworkPolicy{}),
+		aapiListType:      reflect.TypeOf(aapi.StagedNetworkPolicyList{}),
+		libCalicoType:     reflect.TypeOf(api.StagedNetworkPolicy{}),
+		libCalicoListType: reflect.TypeOf(api.StagedNetworkPolicyList{}),
+		isNamespaced:      true,
+		create:            createFn,
+		update:            updateFn,
+		get:               getFn,
+		delete:            deleteFn,
+		list:              listFn,
+		watch:             watchFn,
+		resourceName:      "StagedNetworkPolicy",
+		converter:         StagedNetworkPolicyConverter{},
+	}, Codec: opts.RESTOptions.StorageConfig.Codec}
+	return dryRunnableStorage, func() {}
+}
+
+type StagedNetworkPolicyConverter struct {
+}
+
+func (rc StagedNetworkPolicyConverter) convertToLibcalico(aapiObj runtime.Object) resourceObject {
+	aapiPolicy := aapiObj.(*aapi.StagedNetworkPolicy)
+	lcgPolicy := &api.StagedNetworkPolicy{}
+	lcgPolicy.TypeMeta = aapiPolicy.TypeMeta
+	lcgPolicy.ObjectMeta = aapiPolicy.ObjectMeta
+	lcgPolicy.Kind = api.KindStagedNetworkPolicy
+	lcgPolicy.APIVersion = api.GroupVersionCurrent
+	lcgPolicy.Spec = aapiPolicy.Spec
+	return lcgPolicy
+}
+
+func (rc StagedNetworkPolicyConverter) convertToAAPI(libcalicoObject resourceObject, aapiObj runtime.Object) {
+	lcgPolicy := libcalicoObject.(*api.StagedNetworkPolicy)
+	aapiPolicy := aapiObj.(*aapi.StagedNetworkPolicy)
+	aapiPolicy.Spec = lcgPolicy.Spec
+	aapiPolicy.TypeMeta = lcgPolicy.TypeMeta
+	aapiPolicy.ObjectMeta = lcgPolicy.ObjectMeta
+}
+
+func (rc StagedNetworkPolicyConverter) convertToAAPIList(libcalicoListObject resourceListObject, aapiListObj runtime.Object, pred storage.SelectionPredicate) {
+	lcgPolicyList := libcalicoListObject.(*api.StagedNetworkPolicyList)
+	aapiPolicyList := aapiListObj.(*aapi.StagedNetworkPolicyList)
+	if libcalicoListObject == nil {
+		aapiPolicyList.Items = []aapi.StagedNetworkPolicy{}
+		return
+	}
+	aapiPolicyList.TypeMeta = lcgPolicyList.TypeMeta
+	aapiPolicyList.ListMeta = lcgPolicyList.ListMeta
+	for _, item := range lcgPolicyList.Items {
+		aapiPolicy := aapi.StagedNetworkPolicy{}
+		rc.convertToAAPI(&item, &aapiPolicy)
+		if matched, err := pred.Matches(&aapiPolicy); err == nil && matched {
+			aapiPolicyList.Items = append(aapiPolicyList.Items, aapiPolicy)
+		}
+	}
+}
@@ -17,20 +17,30 @@ func NewStorage(opts Options) (registry.DryRunnableStorage, factory.DestroyFunc)
 		return NewNetworkPolicyStorage(opts)
 	case "projectcalico.org/globalnetworkpolicies":
 		return NewGlobalNetworkPolicyStorage(opts)
+	case "projectcalico.org/stagednetworkpolicies":
+		return NewStagedNetworkPolicyStorage(opts)
+	case "projectcalico.org/stagedglobalnetworkpolicies":
+		return NewStagedGlobalNetworkPolicyStorage(opts)
 	case "projectcalico.org/globalnetworksets":
 		return NewGlobalNetworkSetStorage(opts)
 	case "projectcalico.org/networksets":
 		return NewNetworkSetStorage(opts)
+	case "projectcalico.org/packetcaptures":
+		return NewPacketCaptureStorage(opts)
 	case "projectcalico.org/hostendpoints":
 		return NewHostEndpointStorage(opts)
 	case "projectcalico.org/ippools":
 		return NewIPPoolStorage(opts)
 	case "projectcalico.org/ipreservations":
 		return NewIPReservationStorage(opts)
+	case "projectcalico.org/tiers":
+		return NewTierStorage(opts)
 	case "projectcalico.org/bgpconfigurations":
 		return NewBGPConfigurationStorage(opts)
 	case "projectcalico.org/bgppeers":
 		return NewBGPPeerStorage(opts)
+	case "projectcalico.org/bgpfilters":
+		return NewBGPFilterStorage(opts)
 	case "projectcalico.org/profiles":
 		return NewProfileStorage(opts)
 	case "projectcalico.org/felixconfigurations":
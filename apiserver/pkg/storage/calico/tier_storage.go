@@ -0,0 +1,113 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+package calico
+
+import (
+	"reflect"
+
+	"golang.org/x/net/context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/storage"
+	etcd "k8s.io/apiserver/pkg/storage/etcd3"
+	"k8s.io/apiserver/pkg/storage/storagebackend/factory"
+
+	aapi "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+	"github.com/projectcalico/calico/libcalico-go/lib/watch"
+)
+
+// NewTierStorage creates a new libcalico-based storage.Interface implementation for Tiers
+func NewTierStorage(opts Options) (registry.DryRunnableStorage, factory.DestroyFunc) {
+	c := CreateClientFromConfig()
+	createFn := func(ctx context.Context, c clientv3.Interface, obj resourceObject, opts clientOpts) (resourceObject, error) {
+		oso := opts.(options.SetOptions)
+		res := obj.(*api.Tier)
+		return c.Tiers().Create(ctx, res, oso)
+	}
+	updateFn := func(ctx context.Context, c clientv3.Interface, obj resourceObject, opts clientOpts) (resourceObject, error) {
+		oso := opts.(options.SetOptions)
+		res := obj.(*api.Tier)
+		return c.Tiers().Update(ctx, res, oso)
+	}
+	getFn := func(ctx context.Context, c clientv3.Interface, ns string, name string, opts clientOpts) (resourceObject, error) {
+		ogo := opts.(options.GetOptions)
+		return c.Tiers().Get(ctx, name, ogo)
+	}
+	deleteFn := func(ctx context.Context, c clientv3.Interface, ns string, name string, opts clientOpts) (resourceObject, error) {
+		odo := opts.(options.DeleteOptions)
+		return c.Tiers().Delete(ctx, name, odo)
+	}
+	listFn := func(ctx context.Context, c clientv3.Interface, opts clientOpts) (resourceListObject, error) {
+		olo := opts.(options.ListOptions)
+		return c.Tiers().List(ctx, olo)
+	}
+	watchFn := func(ctx context.Context, c clientv3.Interface, opts clientOpts) (watch.Interface, error) {
+		olo := opts.(options.ListOptions)
+		return c.Tiers().Watch(ctx, olo)
+	}
+	dryRunnableStorage := registry.DryRunnableStorage{Storage: &resourceStore{
+		client:            c,
+		codec:             opts.RESTOptions.StorageConfig.Codec,
+		versioner:         etcd.APIObjectVersioner{},
+		aapiType:          reflect.TypeOf(aapi.Tier{}),
+		aapiListType:      reflect.TypeOf(aapi.TierList{}),
+		libCalicoType:     reflect.TypeOf(api.Tier{}),
+		libCalicoListType: reflect.TypeOf(api.TierList{}),
+		isNamespaced:      false,
+		create:            createFn,
+		update:            updateFn,
+		get:               getFn,
+		delete:            deleteFn,
+		list:              listFn,
+		watch:             watchFn,
+		resourceName:      "Tier",
+		converter:         TierConverter{},
+	}, Codec: opts.RESTOptions.StorageConfig.Codec}
+	return dryRunnableStorage, func() {}
+}
+
+type TierConverter struct {
+}
+
+func (gc TierConverter) convertToLibcalico(aapiObj runtime.Object) resourceObject {
+	aapiTier := aapiObj.(*aapi.Tier)
+	lcgTier := &api.Tier{}
+	lcgTier.TypeMeta = aapiTier.TypeMeta
+	lcgTier.ObjectMeta = aapiTier.ObjectMeta
+	lcgTier.Kind = api.KindTier
+	lcgTier.APIVersion = api.GroupVersionCurrent
+	lcgTier.Spec = aapiTier.Spec
+	return lcgTier
+}
+
+func (gc TierConverter) convertToAAPI(libcalicoObject resourceObject, aapiObj runtime.Object) {
+	lcgTier := libcalicoObject.(*api.Tier)
+	aapiTier := aapiObj.(*aapi.Tier)
+	aapiTier.Spec = lcgTier.Spec
+	aapiTier.TypeMeta = lcgTier.TypeMeta
+	aapiTier.ObjectMeta = lcgTier.ObjectMeta
+}
+
+func (gc TierConverter) convertToAAPIList(libcalicoListObject resourceListObject, aapiListObj runtime.Object, pred storage.SelectionPredicate) {
+	lcgTierList := libcalicoListObject.(*api.TierList)
+	aapiTierList := aapiListObj.(*aapi.TierList)
+	if libcalicoListObject == nil {
+		aapiTierList.Items = []aapi.Tier{}
+		return
+	}
+	aapiTierList.TypeMeta = lcgTierList.TypeMeta
+	aapiTierList.ListMeta = lcgTierList.ListMeta
+	for _, item := range lcgTierList.Items {
+		aapiTier := aapi.Tier{}
+		gc.convertToAAPI(&item, &aapiTier)
+		if matched, err := pred.Matches(&aapiTier); err == nil && matched {
+			aapiTierList.Items = append(aapiTierList.Items, aapiTier)
+		}
+	}
+}
@@ -41,8 +41,11 @@ func main() {
     get          Get a resource identified by file, directory, stdin or resource type and
                  name.
     label        Add or update labels of resources.
+    promote      Promote a staged policy to an enforced policy.
     convert      Convert config files between different API versions.
     ipam         IP address management.
+    policy       Policy analysis.
+    trace        Report the policy and rule that decide a flow.
     node         Calico node management.
     version      Display the version of this binary.
     datastore    Calico datastore management.
@@ -121,6 +124,8 @@ Description:
 			err = commands.Get(args)
 		case "label":
 			err = commands.Label(args)
+		case "promote":
+			err = commands.Promote(args)
 		case "convert":
 			err = commands.Convert(args)
 		case "version":
@@ -129,6 +134,10 @@ Description:
 			err = commands.Node(args)
 		case "ipam":
 			err = commands.IPAM(args)
+		case "policy":
+			err = commands.Policy(args)
+		case "trace":
+			err = commands.Trace(args)
 		case "datastore":
 			err = commands.Datastore(args)
 		default:
@@ -64,6 +64,7 @@ Description:
   Valid resource types are:
 
     * bgpConfiguration
+    * bgpFilter
     * bgpPeer
     * felixConfiguration
     * globalNetworkPolicy
@@ -74,8 +75,12 @@ Description:
     * kubeControllersConfiguration
     * networkPolicy
     * networkSet
+    * packetCapture
     * node
     * profile
+    * stagedGlobalNetworkPolicy
+    * stagedNetworkPolicy
+    * tier
     * workloadEndpoint
 
   When applying a resource:
@@ -380,3 +380,42 @@ func handleNamespace(resource resourcemgr.ResourceObject, rm resourcemgr.Resourc
 
 	return nil
 }
+
+// LoadResources loads the resources from the file or directory given by the --filename
+// argument and fills in their namespaces from the --namespace argument, in the same way
+// as the resource management commands, without sending them to the datastore.
+func LoadResources(args map[string]interface{}) ([]resourcemgr.ResourceObject, error) {
+	var resources []resourcemgr.ResourceObject
+	err := file.Iter(args, func(modifiedArgs map[string]interface{}) error {
+		modifiedFilename := modifiedArgs["--filename"].(string)
+
+		r, err := resourcemgr.CreateResourcesFromFile(modifiedFilename)
+		if err != nil {
+			return err
+		}
+
+		converted, err := convertToSliceOfResources(r)
+		if err != nil {
+			return err
+		}
+		if len(converted) == 0 {
+			return fmt.Errorf("No resources specified in file %s", modifiedFilename)
+		}
+
+		resources = append(resources, converted...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("No resources specified in directory %s", args["--filename"])
+	}
+
+	for _, r := range resources {
+		if err := handleNamespace(r, resourcemgr.GetResourceManager(r), args); err != nil {
+			return nil, err
+		}
+	}
+	return resources, nil
+}
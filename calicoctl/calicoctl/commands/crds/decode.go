@@ -46,6 +46,13 @@ func CalicoCRDs() ([]*v1.CustomResourceDefinition, error) {
 	}
 	crds = append(crds, &bgpPeer)
 
+	bgpFilter := v1.CustomResourceDefinition{}
+	err = yaml.Unmarshal([]byte(bgpfilters), &bgpFilter)
+	if err != nil {
+		return crds, err
+	}
+	crds = append(crds, &bgpFilter)
+
 	blockAffinity := v1.CustomResourceDefinition{}
 	err = yaml.Unmarshal([]byte(blockaffinities), &blockAffinity)
 	if err != nil {
@@ -144,5 +151,33 @@ func CalicoCRDs() ([]*v1.CustomResourceDefinition, error) {
 	}
 	crds = append(crds, &netset)
 
+	packetCapture := v1.CustomResourceDefinition{}
+	err = yaml.Unmarshal([]byte(packetcaptures), &packetCapture)
+	if err != nil {
+		return crds, err
+	}
+	crds = append(crds, &packetCapture)
+
+	stagedGlobalPolicy := v1.CustomResourceDefinition{}
+	err = yaml.Unmarshal([]byte(stagedglobalnetworkpolicies), &stagedGlobalPolicy)
+	if err != nil {
+		return crds, err
+	}
+	crds = append(crds, &stagedGlobalPolicy)
+
+	stagedPolicy := v1.CustomResourceDefinition{}
+	err = yaml.Unmarshal([]byte(stagednetworkpolicies), &stagedPolicy)
+	if err != nil {
+		return crds, err
+	}
+	crds = append(crds, &stagedPolicy)
+
+	tier := v1.CustomResourceDefinition{}
+	err = yaml.Unmarshal([]byte(tiers), &tier)
+	if err != nil {
+		return crds, err
+	}
+	crds = append(crds, &tier)
+
 	return crds, nil
 }
@@ -66,6 +66,7 @@ Description:
   Valid resource types are:
 
     * bgpConfiguration
+    * bgpFilter
     * bgpPeer
     * felixConfiguration
     * globalNetworkPolicy
@@ -76,8 +77,12 @@ Description:
     * kubeControllersConfiguration
     * networkPolicy
     * networkSet
+    * packetCapture
     * node
     * profile
+    * stagedGlobalNetworkPolicy
+    * stagedNetworkPolicy
+    * tier
     * workloadEndpoint
 
   Attempting to create a resource that already exists is treated as a
@@ -44,6 +44,7 @@ import (
 var allV3Resources []string = []string{
 	"ippools",
 	"bgppeers",
+	"bgpfilters",
 	"globalnetworkpolicies",
 	"globalnetworksets",
 	"heps",
@@ -63,6 +64,7 @@ var resourceDisplayMap map[string]string = map[string]string{
 	"ippools":                "IPPools",
 	"bgpconfig":              "BGPConfigurations",
 	"bgppeers":               "BGPPeers",
+	"bgpfilters":             "BGPFilters",
 	"clusterinfos":           "ClusterInformations",
 	"felixconfigs":           "FelixConfigurations",
 	"globalnetworkpolicies":  "GlobalNetworkPolicies",
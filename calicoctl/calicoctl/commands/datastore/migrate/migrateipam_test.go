@@ -212,6 +212,21 @@ func (c *MockIPAMClient) IPReservations() client.IPReservationInterface {
 	return nil
 }
 
+func (c *MockIPAMClient) Tiers() client.TierInterface {
+	// DO NOTHING
+	return nil
+}
+
+func (c *MockIPAMClient) StagedGlobalNetworkPolicies() client.StagedGlobalNetworkPolicyInterface {
+	// DO NOTHING
+	return nil
+}
+
+func (c *MockIPAMClient) StagedNetworkPolicies() client.StagedNetworkPolicyInterface {
+	// DO NOTHING
+	return nil
+}
+
 func (c *MockIPAMClient) Profiles() client.ProfileInterface {
 	// DO NOTHING
 	return nil
@@ -227,6 +242,11 @@ func (c *MockIPAMClient) NetworkSets() client.NetworkSetInterface {
 	return nil
 }
 
+func (c *MockIPAMClient) PacketCaptures() client.PacketCaptureInterface {
+	// DO NOTHING
+	return nil
+}
+
 func (c *MockIPAMClient) HostEndpoints() client.HostEndpointInterface {
 	// DO NOTHING
 	return nil
@@ -242,6 +262,11 @@ func (c *MockIPAMClient) BGPPeers() client.BGPPeerInterface {
 	return nil
 }
 
+func (c *MockIPAMClient) BGPFilters() client.BGPFilterInterface {
+	// DO NOTHING
+	return nil
+}
+
 func (c *MockIPAMClient) IPAM() ipam.Interface {
 	// DO NOTHING
 	return nil
@@ -35,6 +35,9 @@ func IPAM(args []string) error {
     show             Show details of a Calico configuration,
                      assigned IP address, or of overall IP usage.
     configure        Configure IPAM
+    split            Replace an IP pool with new IP pools.
+    export           Export the IPAM data of the cluster to a file.
+    import           Import IPAM data exported from another cluster.
 
 Options:
   -h --help      Show this screen.
@@ -73,6 +76,12 @@ Description:
 		return ipam.Show(args)
 	case "configure":
 		return ipam.Configure(args)
+	case "split":
+		return ipam.Split(args)
+	case "export":
+		return ipam.Export(args, VERSION)
+	case "import":
+		return ipam.Import(args)
 	default:
 		fmt.Println(doc)
 	}
@@ -48,12 +48,16 @@ import (
 func Check(args []string, version string) error {
 	doc := constants.DatastoreIntro + `Usage:
   <BINARY_NAME> ipam check [--config=<CONFIG>] [--show-all-ips] [--show-problem-ips] [-o <FILE>] [--allow-version-mismatch]
+  <BINARY_NAME> ipam check --repair [--dry-run] [--force] [--config=<CONFIG>] [--show-all-ips] [--show-problem-ips] [--allow-version-mismatch]
 
 Options:
   -h --help                    Show this screen.
   -o --output=<FILE>           Path to output report file.
      --show-all-ips            Print all IPs that are checked.
      --show-problem-ips        Print all IPs that are leaked or not allocated properly.
+     --repair                  Repair the problems that are found.
+     --dry-run                 Print the repairs that would be made without making them.
+     --force                   Repair even if the data store is not locked.
   -c --config=<CONFIG>         Path to the file containing connection configuration in
                                YAML or JSON format.
                                [default: ` + constants.DefaultConfigPath + `]
@@ -61,6 +65,16 @@ Options:
 
 Description:
   The ipam check command checks the integrity of the IPAM datastructures against Kubernetes.
+
+  With --repair, the command also fixes the problems that it finds: it releases
+  leaked IPs, deletes orphaned IPAM handles, removes empty blocks that are affine
+  to nodes that no longer exist and reconciles the block affinities with the
+  blocks that they refer to.  It prints the planned repairs before making them;
+  with --dry-run, it only prints the plan.  Only one repair can run at a time.
+
+  Since addresses that are being assigned can look leaked for a short time, the
+  data store should be locked while repairing.  Either lock the data store with
+  '<BINARY_NAME> datastore migrate lock' or re-run with --force.
 `
 	// Replace all instances of BINARY_NAME with the name of the binary.
 	name, _ := util.NameAndDescription()
@@ -111,9 +125,25 @@ Description:
 	if arg := parsedArgs["--output"]; arg != nil {
 		outFile = arg.(string)
 	}
+	repair := parsedArgs["--repair"].(bool)
+	dryRun := parsedArgs["--dry-run"].(bool)
+	force := parsedArgs["--force"].(bool)
+
+	if repair && !dryRun {
+		// Stop other repairs from running at the same time.  We take the lock before running the check so
+		// that the repairs are based on what we read while holding it.
+		unlock, err := lockRepair(ctx, client)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+	}
 
 	// Build the checker.
 	checker := NewIPAMChecker(kubeClient, client, bc, showAllIPs, showProblemIPs, outFile, version)
+	checker.repair = repair
+	checker.dryRun = dryRun
+	checker.force = force
 	return checker.checkIPAM(ctx)
 }
 
@@ -130,6 +160,7 @@ func NewIPAMChecker(k8sClient kubernetes.Interface,
 		allocationsByPod:  map[string][]*Allocation{},
 
 		inUseIPs: map[string][]ownerRecord{},
+		nodes:    map[string]bool{},
 
 		k8sClient:     k8sClient,
 		v3Client:      v3Client,
@@ -149,6 +180,13 @@ type IPAMChecker struct {
 	allocationsByPod  map[string][]*Allocation
 	inUseIPs          map[string][]ownerRecord
 
+	// blocks, handles and affinities hold the IPAM resources that we loaded; nodes holds the names of the
+	// nodes that exist.
+	blocks     []*model.KVPair
+	handles    []*model.KVPair
+	affinities []*model.KVPair
+	nodes      map[string]bool
+
 	clusterType         string
 	clusterInfoRevision string
 	datastoreLocked     bool
@@ -161,6 +199,12 @@ type IPAMChecker struct {
 	showAllIPs     bool
 	showProblemIPs bool
 
+	// repair is true if we should fix the problems that we find.  With dryRun, we only print the plan; without
+	// force, we refuse to repair an unlocked data store.
+	repair bool
+	dryRun bool
+	force  bool
+
 	version string
 	outFile string
 }
@@ -187,6 +231,7 @@ func (c *IPAMChecker) checkIPAM(ctx context.Context) error {
 			return fmt.Errorf("failed to list IPAM blocks: %w", err)
 		}
 		fmt.Printf("Found %d IPAM blocks.\n", len(blocks.KVPairs))
+		c.blocks = blocks.KVPairs
 
 		for _, kvp := range blocks.KVPairs {
 			b := kvp.Value.(*model.AllocationBlock)
@@ -206,6 +251,26 @@ func (c *IPAMChecker) checkIPAM(ctx context.Context) error {
 		fmt.Printf("IPAM blocks record %d allocations.\n", numAllocs)
 		fmt.Println()
 	}
+	{
+		fmt.Println("Loading all IPAM handles...")
+		handles, err := c.backendClient.List(ctx, model.IPAMHandleListOptions{}, "")
+		if err != nil {
+			return fmt.Errorf("failed to list IPAM handles: %w", err)
+		}
+		c.handles = handles.KVPairs
+		fmt.Printf("Found %d IPAM handles.\n", len(c.handles))
+		fmt.Println()
+	}
+	{
+		fmt.Println("Loading all IPAM block affinities...")
+		affinities, err := c.backendClient.List(ctx, model.BlockAffinityListOptions{}, "")
+		if err != nil {
+			return fmt.Errorf("failed to list IPAM block affinities: %w", err)
+		}
+		c.affinities = affinities.KVPairs
+		fmt.Printf("Found %d IPAM block affinities.\n", len(c.affinities))
+		fmt.Println()
+	}
 	var activeIPPools []*cnet.IPNet
 	{
 		fmt.Println("Loading all IPAM pools...")
@@ -236,6 +301,7 @@ func (c *IPAMChecker) checkIPAM(ctx context.Context) error {
 		}
 		numNodeIPs := 0
 		for _, n := range nodes.Items {
+			c.nodes[n.Name] = true
 			ips, err := getNodeIPs(n)
 			if err != nil {
 				return err
@@ -297,15 +363,21 @@ func (c *IPAMChecker) checkIPAM(ctx context.Context) error {
 	}
 
 	numProblems := 0
+	var plan repairPlan
 	var allocatedButNotInUseIPs []string
 	{
 		fmt.Printf("Scanning for IPs that are allocated but not actually in use...\n")
 		for ip, allocs := range c.allocations {
 			if _, ok := c.inUseIPs[ip]; !ok {
-				if c.showProblemIPs {
-					for _, alloc := range allocs {
+				for _, alloc := range allocs {
+					if c.showProblemIPs {
 						fmt.Printf("  %s leaked; attrs %v\n", ip, alloc.GetAttrString())
 					}
+					plan.leakedIPs = append(plan.leakedIPs, ipam.ReleaseOptions{
+						Handle:         alloc.Handle,
+						Address:        ip,
+						SequenceNumber: alloc.SequenceNumber,
+					})
 				}
 				allocatedButNotInUseIPs = append(allocatedButNotInUseIPs, ip)
 			}
@@ -359,15 +431,155 @@ func (c *IPAMChecker) checkIPAM(ctx context.Context) error {
 		fmt.Println()
 	}
 
+	{
+		fmt.Printf("Scanning for IPAM handles that are not used by any allocation...\n")
+		plan.orphanedHandles = c.findOrphanedHandles()
+		if c.showProblemIPs {
+			for _, kvp := range plan.orphanedHandles {
+				fmt.Printf("  Handle %s is orphaned.\n", kvp.Key.(model.IPAMHandleKey).HandleID)
+			}
+		}
+		numProblems += len(plan.orphanedHandles)
+		fmt.Printf("Found %d IPAM handles that are not used by any allocation.\n", len(plan.orphanedHandles))
+		fmt.Println()
+	}
+
+	{
+		fmt.Printf("Scanning for block affinities that don't match their blocks...\n")
+		plan.missingAffinities, plan.danglingAffinities = c.findAffinityProblems()
+		if c.showProblemIPs {
+			for _, key := range plan.missingAffinities {
+				fmt.Printf("  Block %s is affine to node %s but has no block affinity.\n", key.CIDR, key.Host)
+			}
+			for _, kvp := range plan.danglingAffinities {
+				key := kvp.Key.(model.BlockAffinityKey)
+				fmt.Printf("  Block affinity of %s to node %s doesn't match any block.\n", key.CIDR, key.Host)
+			}
+		}
+		numProblems += len(plan.missingAffinities)
+		numProblems += len(plan.danglingAffinities)
+		fmt.Printf("Found %d blocks that are affine to a node but have no block affinity.\n", len(plan.missingAffinities))
+		fmt.Printf("Found %d block affinities that don't match any block.\n", len(plan.danglingAffinities))
+		fmt.Println()
+	}
+
+	{
+		fmt.Printf("Scanning for empty blocks that are affine to nodes that no longer exist...\n")
+		plan.staleBlocks = c.findStaleBlocks()
+		if c.showProblemIPs {
+			for _, b := range plan.staleBlocks {
+				fmt.Printf("  Block %s is affine to missing node %s.\n", b.CIDR, hostAffinity(b))
+			}
+		}
+		numProblems += len(plan.staleBlocks)
+		fmt.Printf("Found %d blocks that are affine to missing nodes and have no IPs in use.\n", len(plan.staleBlocks))
+		fmt.Println()
+	}
+
 	fmt.Printf("Check complete; found %d problems.\n", numProblems)
 
 	if c.outFile != "" {
 		// Print out a machine readable report.
 		c.printReport()
 	}
+
+	if c.repair {
+		fmt.Println()
+		return c.repairIPAM(ctx, plan)
+	}
 	return nil
 }
 
+// findOrphanedHandles returns the IPAM handles that aren't used by any allocation.
+func (c *IPAMChecker) findOrphanedHandles() []*model.KVPair {
+	// Use the blocks' attributes rather than our Allocations because the latter don't record reserved handles.
+	inUse := map[string]bool{}
+	for _, kvp := range c.blocks {
+		b := kvp.Value.(*model.AllocationBlock)
+		for _, attrIdx := range b.Allocations {
+			if attrIdx == nil || *attrIdx >= len(b.Attributes) {
+				continue
+			}
+			if h := b.Attributes[*attrIdx].AttrPrimary; h != nil {
+				inUse[*h] = true
+			}
+		}
+	}
+
+	var orphaned []*model.KVPair
+	for _, kvp := range c.handles {
+		if !inUse[kvp.Key.(model.IPAMHandleKey).HandleID] {
+			orphaned = append(orphaned, kvp)
+		}
+	}
+	return orphaned
+}
+
+// findAffinityProblems compares the blocks with the block affinities.  It returns the affinities that are
+// missing for blocks that are affine to a node, and the affinities whose block doesn't exist or is affine to
+// a different node.
+func (c *IPAMChecker) findAffinityProblems() (missing []model.BlockAffinityKey, dangling []*model.KVPair) {
+	blocksByCIDR := map[string]*model.AllocationBlock{}
+	for _, kvp := range c.blocks {
+		b := kvp.Value.(*model.AllocationBlock)
+		blocksByCIDR[b.CIDR.String()] = b
+	}
+
+	hasAffinity := map[string]bool{}
+	for _, kvp := range c.affinities {
+		key := kvp.Key.(model.BlockAffinityKey)
+		b, ok := blocksByCIDR[key.CIDR.String()]
+		if !ok || hostAffinity(b) != key.Host {
+			dangling = append(dangling, kvp)
+			continue
+		}
+		hasAffinity[key.CIDR.String()] = true
+	}
+
+	for _, kvp := range c.blocks {
+		b := kvp.Value.(*model.AllocationBlock)
+		if node := hostAffinity(b); node != "" && !hasAffinity[b.CIDR.String()] {
+			missing = append(missing, model.BlockAffinityKey{CIDR: b.CIDR, Host: node})
+		}
+	}
+	return
+}
+
+// findStaleBlocks returns the blocks that are affine to nodes that no longer exist and that have no IPs in
+// use, i.e. that will be empty once their leaked IPs have been released.
+func (c *IPAMChecker) findStaleBlocks() []*model.AllocationBlock {
+	var stale []*model.AllocationBlock
+	for _, kvp := range c.blocks {
+		b := kvp.Value.(*model.AllocationBlock)
+		node := hostAffinity(b)
+		if node == "" || c.nodes[node] {
+			continue
+		}
+		inUse := false
+		for ord, attrIdx := range b.Allocations {
+			if attrIdx == nil {
+				continue
+			}
+			if _, ok := c.inUseIPs[b.OrdinalToIP(ord).String()]; ok {
+				inUse = true
+				break
+			}
+		}
+		if !inUse {
+			stale = append(stale, b)
+		}
+	}
+	return stale
+}
+
+// hostAffinity returns the node that the block is affine to, or "" if it isn't affine to a node.
+func hostAffinity(b *model.AllocationBlock) string {
+	if b.Affinity == nil || !strings.HasPrefix(*b.Affinity, "host:") {
+		return ""
+	}
+	return strings.TrimPrefix(*b.Affinity, "host:")
+}
+
 func getWEPIPs(w apiv3.WorkloadEndpoint) ([]string, error) {
 	var ips []string
 	for _, a := range w.Spec.IPNetworks {
@@ -502,6 +714,14 @@ func getNodeIPs(n apiv3.Node) ([]string, error) {
 		}
 		ips = append(ips, ip)
 	}
+	if n.Spec.IPv6VXLANTunnelAddr != "" {
+		ip, err := normaliseIP(n.Spec.IPv6VXLANTunnelAddr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse IPv6VXLANTunnelAddr (%s) of node %s: %w",
+				n.Spec.IPv6VXLANTunnelAddr, n.Name, err)
+		}
+		ips = append(ips, ip)
+	}
 	if n.Spec.Wireguard != nil && n.Spec.Wireguard.InterfaceIPv4Address != "" {
 		ip, err := normaliseIP(n.Spec.Wireguard.InterfaceIPv4Address)
 		if err != nil {
@@ -510,6 +730,14 @@ func getNodeIPs(n apiv3.Node) ([]string, error) {
 		}
 		ips = append(ips, ip)
 	}
+	if n.Spec.Wireguard != nil && n.Spec.Wireguard.InterfaceIPv6Address != "" {
+		ip, err := normaliseIP(n.Spec.Wireguard.InterfaceIPv6Address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse Wireguard.InterfaceIPv6Address (%s) of node %s: %w",
+				n.Spec.Wireguard.InterfaceIPv6Address, n.Name, err)
+		}
+		ips = append(ips, ip)
+	}
 	if n.Spec.BGP != nil && n.Spec.BGP.IPv4IPIPTunnelAddr != "" {
 		ip, err := normaliseIP(n.Spec.BGP.IPv4IPIPTunnelAddr)
 		if err != nil {
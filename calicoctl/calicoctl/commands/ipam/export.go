@@ -0,0 +1,251 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	docopt "github.com/docopt/docopt-go"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/clientmgr"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/common"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/constants"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/util"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+)
+
+const (
+	// IPAMExportKind and IPAMExportVersion identify the format of the file written by "ipam export".  The
+	// version must be changed if the format changes in a way that older versions of "ipam import" can't read.
+	IPAMExportKind    = "IPAMExport"
+	IPAMExportVersion = "v1"
+)
+
+// IPAMExport holds the IPAM data of a cluster, as written by "ipam export" and read by "ipam import".
+type IPAMExport struct {
+	Kind    string `json:"kind"`
+	Version string `json:"version"`
+
+	// Metadata about the cluster and calicoctl that produced the export.
+	CalicoctlVersion string `json:"calicoctlVersion"`
+	ClusterGUID      string `json:"clusterGUID"`
+	DatastoreLocked  bool   `json:"datastoreLocked"`
+
+	// Pools are the IP pools of the exported cluster.  They are only used to explain validation failures;
+	// the blocks are validated against the pools of the cluster that they are imported into.
+	Pools []ExportedPool `json:"pools"`
+
+	Blocks         []*model.AllocationBlock `json:"blocks"`
+	Affinities     []ExportedAffinity       `json:"affinities"`
+	Handles        []ExportedHandle         `json:"handles"`
+	IPReservations []apiv3.IPReservation    `json:"ipReservations"`
+}
+
+type ExportedPool struct {
+	Name      string `json:"name"`
+	CIDR      string `json:"cidr"`
+	BlockSize int    `json:"blockSize"`
+}
+
+type ExportedAffinity struct {
+	CIDR  string                   `json:"cidr"`
+	Host  string                   `json:"host"`
+	State model.BlockAffinityState `json:"state"`
+}
+
+type ExportedHandle struct {
+	HandleID string         `json:"handleID"`
+	Block    map[string]int `json:"block"`
+}
+
+// Export writes the IPAM data of the cluster to a file.
+func Export(args []string, version string) error {
+	doc := constants.DatastoreIntro + `Usage:
+  <BINARY_NAME> ipam export [--output=<FILE>] [--config=<CONFIG>] [--allow-version-mismatch]
+
+Options:
+  -h --help                    Show this screen.
+  -o --output=<FILE>           Path to the file to write the export to.  If not
+                               specified, the export is written to stdout.
+  -c --config=<CONFIG>         Path to the file containing connection configuration in
+                               YAML or JSON format.
+                               [default: ` + constants.DefaultConfigPath + `]
+     --allow-version-mismatch  Allow client and cluster versions mismatch.
+
+Description:
+  The ipam export command writes the IPAM blocks, block affinities, handles and
+  IP reservations of the cluster to a file, so that they can be restored into
+  another cluster with '<BINARY_NAME> ipam import'.  The import reserves the exported
+  IPs in the new cluster.  IPAM records the owner of each IP by its handle,
+  which is derived from the workload's container ID, so only workloads that
+  keep running with the same handles keep their IPs.  A pod that is recreated
+  can't keep its IP: it gets a new handle and a new IP, and kube-controllers
+  releases the old pod's IP once the data store of the new cluster is unlocked.
+
+  The data store should be locked with '<BINARY_NAME> datastore migrate lock'
+  while exporting so that no IPs are assigned or released during the export.
+`
+	// Replace all instances of BINARY_NAME with the name of the binary.
+	name, _ := util.NameAndDescription()
+	doc = strings.ReplaceAll(doc, "<BINARY_NAME>", name)
+
+	parsedArgs, err := docopt.ParseArgs(doc, args, "")
+	if err != nil {
+		return fmt.Errorf("Invalid option: 'calicoctl %s'. Use flag '--help' to read about a specific subcommand.", strings.Join(args, " "))
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	err = common.CheckVersionMismatch(parsedArgs["--config"], parsedArgs["--allow-version-mismatch"])
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	// Create a new backend client from env vars.
+	cf := parsedArgs["--config"].(string)
+	client, err := clientmgr.NewClient(cf)
+	if err != nil {
+		return err
+	}
+
+	// Get the backend client.
+	type accessor interface {
+		Backend() bapi.Client
+	}
+	bc := client.(accessor).Backend()
+
+	export, err := exportIPAM(ctx, client, bc)
+	if err != nil {
+		return err
+	}
+	export.CalicoctlVersion = version
+	if !export.DatastoreLocked {
+		fmt.Fprintln(os.Stderr, "WARNING: Data store is not locked; IPs may have been assigned or released during the export.")
+	}
+
+	bytes, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return err
+	}
+	if arg := parsedArgs["--output"]; arg != nil {
+		if err := ioutil.WriteFile(arg.(string), bytes, 0600); err != nil {
+			return err
+		}
+		fmt.Printf("Exported %d IPAM blocks, %d block affinities, %d handles and %d IP reservations.\n",
+			len(export.Blocks), len(export.Affinities), len(export.Handles), len(export.IPReservations))
+		return nil
+	}
+	fmt.Printf("%s\n", string(bytes))
+	return nil
+}
+
+// exportIPAM reads the IPAM data from the datastore.
+func exportIPAM(ctx context.Context, c clientv3.Interface, bc bapi.Client) (*IPAMExport, error) {
+	clusterInfo, err := c.ClusterInformation().Get(ctx, "default", options.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	pools, err := c.IPPools().List(ctx, options.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IP pools: %w", err)
+	}
+	blocks, err := bc.List(ctx, model.BlockListOptions{}, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IPAM blocks: %w", err)
+	}
+	affinities, err := bc.List(ctx, model.BlockAffinityListOptions{}, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IPAM block affinities: %w", err)
+	}
+	handles, err := bc.List(ctx, model.IPAMHandleListOptions{}, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IPAM handles: %w", err)
+	}
+	reservations, err := c.IPReservations().List(ctx, options.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IP reservations: %w", err)
+	}
+
+	export := newIPAMExport(pools.Items, blocks.KVPairs, affinities.KVPairs, handles.KVPairs, reservations.Items)
+	export.ClusterGUID = clusterInfo.Spec.ClusterGUID
+	export.DatastoreLocked = clusterInfo.Spec.DatastoreReady != nil && !*clusterInfo.Spec.DatastoreReady
+	return export, nil
+}
+
+// newIPAMExport converts the datastore's IPAM data to the export format.
+func newIPAMExport(
+	pools []apiv3.IPPool,
+	blocks, affinities, handles []*model.KVPair,
+	reservations []apiv3.IPReservation,
+) *IPAMExport {
+	export := &IPAMExport{
+		Kind:    IPAMExportKind,
+		Version: IPAMExportVersion,
+	}
+	for _, p := range pools {
+		export.Pools = append(export.Pools, ExportedPool{Name: p.Name, CIDR: p.Spec.CIDR, BlockSize: p.Spec.BlockSize})
+	}
+	// Skip the resources that are being deleted; the Deleted flags are only set while a deletion is in progress.
+	for _, kvp := range blocks {
+		if b := kvp.Value.(*model.AllocationBlock); !b.Deleted {
+			export.Blocks = append(export.Blocks, b)
+		}
+	}
+	for _, kvp := range affinities {
+		key := kvp.Key.(model.BlockAffinityKey)
+		aff := kvp.Value.(*model.BlockAffinity)
+		if aff.Deleted {
+			continue
+		}
+		export.Affinities = append(export.Affinities, ExportedAffinity{
+			CIDR:  key.CIDR.String(),
+			Host:  key.Host,
+			State: aff.State,
+		})
+	}
+	for _, kvp := range handles {
+		handle := kvp.Value.(*model.IPAMHandle)
+		if handle.Deleted {
+			continue
+		}
+		export.Handles = append(export.Handles, ExportedHandle{
+			HandleID: kvp.Key.(model.IPAMHandleKey).HandleID,
+			Block:    handle.Block,
+		})
+	}
+	for _, r := range reservations {
+		// Only keep the parts of the metadata that make sense in another cluster.
+		exported := apiv3.NewIPReservation()
+		exported.Name = r.Name
+		exported.Labels = r.Labels
+		exported.Annotations = r.Annotations
+		exported.Spec = r.Spec
+		export.IPReservations = append(export.IPReservations, *exported)
+	}
+	return export
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipam
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/ipam"
+	"github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+var _ = Describe("IPAM export and import", func() {
+	var export *IPAMExport
+	var pools []apiv3.IPPool
+
+	newPool := func(name, cidr string, blockSize int) apiv3.IPPool {
+		p := apiv3.NewIPPool()
+		p.Name = name
+		p.Spec.CIDR = cidr
+		p.Spec.BlockSize = blockSize
+		return *p
+	}
+
+	BeforeEach(func() {
+		pools = []apiv3.IPPool{newPool("pool1", "10.0.0.0/24", 30)}
+
+		reservation := apiv3.NewIPReservation()
+		reservation.Name = "reserved"
+		reservation.ResourceVersion = "1234"
+		reservation.Spec.ReservedCIDRs = []string{"10.0.0.128/25"}
+
+		deletedBlock := newBlock("10.0.0.12/30", "")
+		deletedBlock.Value.(*model.AllocationBlock).Deleted = true
+
+		export = newIPAMExport(
+			pools,
+			[]*model.KVPair{newBlock("10.0.0.0/30", "node1", "handle1"), deletedBlock},
+			[]*model.KVPair{newAffinity("10.0.0.0/30", "node1")},
+			[]*model.KVPair{{
+				Key:   model.IPAMHandleKey{HandleID: "handle1"},
+				Value: &model.IPAMHandle{HandleID: "handle1", Block: map[string]int{"10.0.0.0/30": 1}},
+			}},
+			[]apiv3.IPReservation{*reservation},
+		)
+	})
+
+	It("should convert the IPAM data to the export format", func() {
+		Expect(export.Kind).To(Equal(IPAMExportKind))
+		Expect(export.Version).To(Equal(IPAMExportVersion))
+		Expect(export.Pools).To(Equal([]ExportedPool{{Name: "pool1", CIDR: "10.0.0.0/24", BlockSize: 30}}))
+		Expect(export.Blocks).To(HaveLen(1), "Deleted block should be skipped")
+		Expect(export.Blocks[0].CIDR).To(Equal(net.MustParseCIDR("10.0.0.0/30")))
+		Expect(export.Affinities).To(Equal([]ExportedAffinity{
+			{CIDR: "10.0.0.0/30", Host: "node1", State: model.StateConfirmed},
+		}))
+		Expect(export.Handles).To(Equal([]ExportedHandle{
+			{HandleID: "handle1", Block: map[string]int{"10.0.0.0/30": 1}},
+		}))
+		Expect(export.IPReservations).To(HaveLen(1))
+		Expect(export.IPReservations[0].Name).To(Equal("reserved"))
+		Expect(export.IPReservations[0].ResourceVersion).To(BeEmpty())
+	})
+
+	It("should round trip through JSON", func() {
+		bytes, err := json.Marshal(export)
+		Expect(err).NotTo(HaveOccurred())
+		var decoded IPAMExport
+		Expect(json.Unmarshal(bytes, &decoded)).To(Succeed())
+		Expect(decoded.Blocks[0].CIDR).To(Equal(export.Blocks[0].CIDR))
+		Expect(decoded.Blocks[0].Attributes).To(Equal(export.Blocks[0].Attributes))
+		Expect(decoded.Affinities).To(Equal(export.Affinities))
+		Expect(decoded.Handles).To(Equal(export.Handles))
+	})
+
+	It("should accept pools that contain the blocks with the same block size", func() {
+		Expect(findPoolProblems(export, pools)).To(BeEmpty())
+	})
+
+	It("should reject blocks that aren't in a pool", func() {
+		problems := findPoolProblems(export, []apiv3.IPPool{newPool("other", "10.1.0.0/24", 30)})
+		Expect(problems).To(ConsistOf(
+			ContainSubstring("Block 10.0.0.0/30 is not in any IP pool (it was exported from IP pool pool1"),
+		))
+	})
+
+	It("should reject blocks in a pool with a different block size", func() {
+		problems := findPoolProblems(export, []apiv3.IPPool{newPool("pool1", "10.0.0.0/24", 26)})
+		Expect(problems).To(ConsistOf(ContainSubstring("which has block size 26")))
+	})
+
+	It("should reject affinities for blocks that aren't in the export", func() {
+		export.Affinities = append(export.Affinities, ExportedAffinity{CIDR: "10.0.0.4/30", Host: "node2"})
+		problems := findPoolProblems(export, pools)
+		Expect(problems).To(ConsistOf(ContainSubstring("10.0.0.4/30 to node node2")))
+	})
+
+	It("should find resources that already exist in the cluster", func() {
+		existingReservation := apiv3.NewIPReservation()
+		existingReservation.Name = "reserved"
+		problems, numImported := findImportConflicts(
+			export,
+			[]*model.KVPair{newBlock("10.0.0.0/29", ""), newBlock("10.0.0.8/30", "")},
+			[]*model.KVPair{newHandle("handle1"), newHandle("handle2")},
+			[]apiv3.IPReservation{*existingReservation},
+		)
+		Expect(problems).To(ConsistOf(
+			"Block 10.0.0.0/30 overlaps existing block 10.0.0.0/29.",
+			"Handle handle1 already exists.",
+			"IP reservation reserved already exists.",
+		))
+		Expect(numImported).To(BeZero())
+	})
+
+	It("should find no conflicts in an empty cluster", func() {
+		problems, numImported := findImportConflicts(export, nil, nil, nil)
+		Expect(problems).To(BeEmpty())
+		Expect(numImported).To(BeZero())
+	})
+
+	It("should accept resources that an earlier import wrote", func() {
+		// As read back from the datastore, with empty rather than nil slices.
+		block := newBlock("10.0.0.0/30", "node1", "handle1")
+		block.Value.(*model.AllocationBlock).Unallocated = []int{}
+		existingReservation := export.IPReservations[0]
+		existingReservation.ResourceVersion = "5678"
+		problems, numImported := findImportConflicts(
+			export,
+			[]*model.KVPair{block},
+			[]*model.KVPair{{
+				Key:   model.IPAMHandleKey{HandleID: "handle1"},
+				Value: &model.IPAMHandle{HandleID: "handle1", Block: map[string]int{"10.0.0.0/30": 1}},
+			}},
+			[]apiv3.IPReservation{existingReservation},
+		)
+		Expect(problems).To(BeEmpty())
+		Expect(numImported).To(Equal(3))
+	})
+
+	It("should count the pod IPs whose nodes or pods don't exist", func() {
+		block := newBlock("10.0.0.4/30", "node1", "handle1", "handle2", "handle3", "handle4").Value.(*model.AllocationBlock)
+		for i, attrs := range []map[string]string{
+			{ipam.AttributeNode: "node1", ipam.AttributeNamespace: "ns1", ipam.AttributePod: "pod1"},
+			{ipam.AttributeNode: "node1", ipam.AttributeNamespace: "ns1", ipam.AttributePod: "pod2"},
+			{ipam.AttributeNode: "node2", ipam.AttributeNamespace: "ns1", ipam.AttributePod: "pod3"},
+			{ipam.AttributeNode: "node2", ipam.AttributeType: ipam.AttributeTypeVXLAN},
+		} {
+			block.Attributes[i].AttrSecondary = attrs
+		}
+		export.Blocks = append(export.Blocks, block)
+
+		missingNode, missingPod := countUnownedAllocations(export, map[string]bool{"node1": true}, map[string]bool{"ns1/pod1": true})
+		Expect(missingNode).To(Equal(1))
+		Expect(missingPod).To(Equal(1))
+
+		By("not checking the pods if they can't be listed")
+		missingNode, missingPod = countUnownedAllocations(export, map[string]bool{"node1": true}, nil)
+		Expect(missingNode).To(Equal(1))
+		Expect(missingPod).To(BeZero())
+	})
+
+	It("should reject a block with the same CIDR but different allocations", func() {
+		problems, _ := findImportConflicts(export, []*model.KVPair{newBlock("10.0.0.0/30", "node1", "handle2")}, nil, nil)
+		Expect(problems).To(ConsistOf("Block 10.0.0.0/30 already exists with different allocations."))
+	})
+})
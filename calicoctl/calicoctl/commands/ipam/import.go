@@ -0,0 +1,474 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strings"
+
+	docopt "github.com/docopt/docopt-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/clientmgr"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/common"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/constants"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/util"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/k8s"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/ipam"
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+)
+
+// Import restores the IPAM data written by Export into the cluster.
+func Import(args []string) error {
+	doc := constants.DatastoreIntro + `Usage:
+  <BINARY_NAME> ipam import --filename=<FILE> [--dry-run] [--force] [--config=<CONFIG>] [--allow-version-mismatch]
+
+Options:
+  -h --help                    Show this screen.
+  -f --filename=<FILE>         Path to the file written by '<BINARY_NAME> ipam export'.
+     --dry-run                 Validate the file against the cluster without importing it.
+     --force                   Import even if the data store is not locked.
+  -c --config=<CONFIG>         Path to the file containing connection configuration in
+                               YAML or JSON format.
+                               [default: ` + constants.DefaultConfigPath + `]
+     --allow-version-mismatch  Allow client and cluster versions mismatch.
+
+Description:
+  The ipam import command restores the IPAM data written by '<BINARY_NAME> ipam export'
+  into a new cluster.  Every exported block must be within one of the cluster's IP
+  pools, with the same block size, and none of the exported blocks, handles or IP
+  reservations may already exist in the cluster, unless they were written by an
+  earlier import of the same file.
+
+  The data store of the new cluster should be locked with
+  '<BINARY_NAME> datastore migrate lock' while importing, so that no IPs are
+  assigned while the import runs.  Either lock the data store or re-run with
+  --force.
+
+  If the import fails part way through, it deletes the data that it wrote.  If
+  that fails too, re-run the same import to write the rest; data that was
+  already imported is skipped.
+
+  Once the data store is unlocked, kube-controllers releases the imported IPs of
+  pods, and of nodes, that don't exist in the new cluster.  The validation lists
+  how many of the exported IPs that applies to.
+`
+	// Replace all instances of BINARY_NAME with the name of the binary.
+	name, _ := util.NameAndDescription()
+	doc = strings.ReplaceAll(doc, "<BINARY_NAME>", name)
+
+	parsedArgs, err := docopt.ParseArgs(doc, args, "")
+	if err != nil {
+		return fmt.Errorf("Invalid option: 'calicoctl %s'. Use flag '--help' to read about a specific subcommand.", strings.Join(args, " "))
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	err = common.CheckVersionMismatch(parsedArgs["--config"], parsedArgs["--allow-version-mismatch"])
+	if err != nil {
+		return err
+	}
+
+	// Load the export.
+	bytes, err := ioutil.ReadFile(parsedArgs["--filename"].(string))
+	if err != nil {
+		return err
+	}
+	export := IPAMExport{}
+	if err := json.Unmarshal(bytes, &export); err != nil {
+		return fmt.Errorf("Failed to parse IPAM export: %w", err)
+	}
+	if export.Kind != IPAMExportKind || export.Version != IPAMExportVersion {
+		return fmt.Errorf("Unsupported IPAM export: kind %q version %q, expected kind %q version %q",
+			export.Kind, export.Version, IPAMExportKind, IPAMExportVersion)
+	}
+
+	ctx := context.Background()
+
+	// Create a new backend client from env vars.
+	cf := parsedArgs["--config"].(string)
+	client, err := clientmgr.NewClient(cf)
+	if err != nil {
+		return err
+	}
+
+	// Get the backend client.
+	type accessor interface {
+		Backend() bapi.Client
+	}
+	bc := client.(accessor).Backend()
+
+	dryRun := parsedArgs["--dry-run"].(bool)
+	force := parsedArgs["--force"].(bool)
+
+	clusterInfo, err := client.ClusterInformation().Get(ctx, "default", options.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if clusterInfo.Spec.DatastoreReady == nil || *clusterInfo.Spec.DatastoreReady {
+		if !dryRun && !force {
+			return fmt.Errorf("The data store is not locked.  Either lock the data store with "+
+				"'%s datastore migrate lock' or re-run with --force.", name)
+		}
+		fmt.Println("WARNING: Data store is not locked; IPs may be assigned while importing.")
+	}
+	if !dryRun {
+		// Stop IPAM repairs, which would release the IPs that we're importing, and other imports from
+		// running at the same time.
+		unlock, err := lockRepair(ctx, client)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+	}
+
+	fmt.Printf("Validating %d IPAM blocks, %d block affinities, %d handles and %d IP reservations...\n",
+		len(export.Blocks), len(export.Affinities), len(export.Handles), len(export.IPReservations))
+	if err := validateImport(ctx, client, bc, &export); err != nil {
+		return err
+	}
+	if dryRun {
+		fmt.Println("Validation succeeded; dry run, nothing imported.")
+		return nil
+	}
+
+	if err := importIPAM(ctx, client, bc, &export); err != nil {
+		return err
+	}
+	fmt.Println("Import complete.")
+	return nil
+}
+
+// validateImport checks the export against the cluster's IP pools and existing IPAM data.
+func validateImport(ctx context.Context, c clientv3.Interface, bc bapi.Client, export *IPAMExport) error {
+	pools, err := c.IPPools().List(ctx, options.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list IP pools: %w", err)
+	}
+	problems := findPoolProblems(export, pools.Items)
+
+	blocks, err := bc.List(ctx, model.BlockListOptions{}, "")
+	if err != nil {
+		return fmt.Errorf("failed to list IPAM blocks: %w", err)
+	}
+	handles, err := bc.List(ctx, model.IPAMHandleListOptions{}, "")
+	if err != nil {
+		return fmt.Errorf("failed to list IPAM handles: %w", err)
+	}
+	reservations, err := c.IPReservations().List(ctx, options.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list IP reservations: %w", err)
+	}
+	conflicts, numImported := findImportConflicts(export, blocks.KVPairs, handles.KVPairs, reservations.Items)
+	problems = append(problems, conflicts...)
+
+	if len(problems) > 0 {
+		for _, p := range problems {
+			fmt.Printf("  %s\n", p)
+		}
+		return fmt.Errorf("Found %d problems with the IPAM export; nothing imported.", len(problems))
+	}
+	if numImported > 0 {
+		fmt.Printf("%d of the blocks, handles and IP reservations were already imported; they will be skipped.\n",
+			numImported)
+	}
+
+	// kube-controllers garbage collects the IPs of pods that don't exist, so warn about those.
+	nodes, err := c.Nodes().List(ctx, options.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list nodes: %w", err)
+	}
+	nodeNames := map[string]bool{}
+	for _, n := range nodes.Items {
+		nodeNames[n.Name] = true
+	}
+	var podNames map[string]bool
+	if kc, ok := bc.(*k8s.KubeClient); ok {
+		pods, err := kc.ClientSet.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to list pods: %w", err)
+		}
+		podNames = map[string]bool{}
+		for _, p := range pods.Items {
+			podNames[p.Namespace+"/"+p.Name] = true
+		}
+	}
+	missingNode, missingPod := countUnownedAllocations(export, nodeNames, podNames)
+	if missingNode > 0 {
+		fmt.Printf("WARNING: %d of the exported IPs belong to nodes that don't exist in this cluster; kube-controllers "+
+			"will release them once the data store is unlocked.\n", missingNode)
+	}
+	if missingPod > 0 {
+		fmt.Printf("WARNING: %d of the exported IPs belong to pods that don't exist in this cluster; kube-controllers "+
+			"will release them once the data store is unlocked, unless the pods are created with the same names.\n",
+			missingPod)
+	}
+	return nil
+}
+
+// countUnownedAllocations counts the exported pod IPs whose node, or failing that whose pod, is missing from the
+// given sets of names.  Pod names are "<namespace>/<name>"; if pods is nil, pods aren't checked.
+func countUnownedAllocations(export *IPAMExport, nodes, pods map[string]bool) (missingNode, missingPod int) {
+	for _, b := range export.Blocks {
+		for _, attrIdx := range b.Allocations {
+			if attrIdx == nil || *attrIdx >= len(b.Attributes) {
+				continue
+			}
+			attrs := b.Attributes[*attrIdx].AttrSecondary
+			node, ns, pod := attrs[ipam.AttributeNode], attrs[ipam.AttributeNamespace], attrs[ipam.AttributePod]
+			if ns == "" || pod == "" {
+				// Not a pod IP, which kube-controllers leaves alone.
+				continue
+			}
+			if node != "" && !nodes[node] {
+				missingNode++
+			} else if pods != nil && !pods[ns+"/"+pod] {
+				missingPod++
+			}
+		}
+	}
+	return
+}
+
+// findPoolProblems checks that each exported block is within one of the pools, with the pool's block size,
+// and that each affinity refers to an exported block.
+func findPoolProblems(export *IPAMExport, pools []apiv3.IPPool) []string {
+	var problems []string
+	blockCIDRs := map[string]bool{}
+	for _, b := range export.Blocks {
+		blockCIDRs[b.CIDR.String()] = true
+		blockSize, _ := b.CIDR.Mask.Size()
+
+		var pool *apiv3.IPPool
+		for i := range pools {
+			_, poolNet, err := cnet.ParseCIDR(pools[i].Spec.CIDR)
+			if err == nil && poolNet.Covers(b.CIDR.IPNet) {
+				pool = &pools[i]
+				break
+			}
+		}
+		if pool == nil {
+			problems = append(problems, fmt.Sprintf("Block %s is not in any IP pool%s.",
+				b.CIDR, exportedPoolHint(export, b.CIDR)))
+		} else if pool.Spec.BlockSize != blockSize {
+			problems = append(problems, fmt.Sprintf("Block %s is in IP pool %s, which has block size %d.",
+				b.CIDR, pool.Name, pool.Spec.BlockSize))
+		}
+	}
+	for _, a := range export.Affinities {
+		if !blockCIDRs[a.CIDR] {
+			problems = append(problems, fmt.Sprintf("Block affinity of %s to node %s refers to a block that isn't in the export.",
+				a.CIDR, a.Host))
+		}
+	}
+	return problems
+}
+
+// exportedPoolHint describes the exported pool that contains the given block, if any.
+func exportedPoolHint(export *IPAMExport, cidr cnet.IPNet) string {
+	for _, p := range export.Pools {
+		_, poolNet, err := cnet.ParseCIDR(p.CIDR)
+		if err == nil && poolNet.Covers(cidr.IPNet) {
+			return fmt.Sprintf(" (it was exported from IP pool %s %s with block size %d)", p.Name, p.CIDR, p.BlockSize)
+		}
+	}
+	return ""
+}
+
+// findImportConflicts checks that none of the exported blocks, handles and IP reservations already exist,
+// unless they are identical to the exported ones, in which case an earlier run of the import wrote them.  It
+// returns the conflicts and the number of resources that were already imported.
+func findImportConflicts(
+	export *IPAMExport,
+	blocks, handles []*model.KVPair,
+	reservations []apiv3.IPReservation,
+) (problems []string, numImported int) {
+	for _, b := range export.Blocks {
+		for _, kvp := range blocks {
+			existing := kvp.Value.(*model.AllocationBlock)
+			if !existing.CIDR.IsNetOverlap(b.CIDR.IPNet) {
+				continue
+			}
+			if existing.CIDR.String() != b.CIDR.String() {
+				problems = append(problems, fmt.Sprintf("Block %s overlaps existing block %s.", b.CIDR, existing.CIDR))
+			} else if !reflect.DeepEqual(importedBlockContents(existing), importedBlockContents(b)) {
+				problems = append(problems, fmt.Sprintf("Block %s already exists with different allocations.", b.CIDR))
+			} else {
+				numImported++
+			}
+		}
+	}
+	existingHandles := map[string]map[string]int{}
+	for _, kvp := range handles {
+		existingHandles[kvp.Key.(model.IPAMHandleKey).HandleID] = kvp.Value.(*model.IPAMHandle).Block
+	}
+	for _, h := range export.Handles {
+		existing, ok := existingHandles[h.HandleID]
+		if !ok {
+			continue
+		}
+		if (len(existing) == 0 && len(h.Block) == 0) || reflect.DeepEqual(existing, h.Block) {
+			numImported++
+		} else {
+			problems = append(problems, fmt.Sprintf("Handle %s already exists.", h.HandleID))
+		}
+	}
+	existingReservations := map[string]apiv3.IPReservationSpec{}
+	for _, r := range reservations {
+		existingReservations[r.Name] = r.Spec
+	}
+	for _, r := range export.IPReservations {
+		existing, ok := existingReservations[r.Name]
+		if !ok {
+			continue
+		}
+		if reflect.DeepEqual(existing, r.Spec) {
+			numImported++
+		} else {
+			problems = append(problems, fmt.Sprintf("IP reservation %s already exists.", r.Name))
+		}
+	}
+	return
+}
+
+// importedBlockContents returns the parts of a block that the import writes, with empty slices and maps
+// normalised to nil, so that a block that has been through the datastore can be compared with the exported
+// block.
+func importedBlockContents(b *model.AllocationBlock) model.AllocationBlock {
+	c := model.AllocationBlock{
+		CIDR:     b.CIDR,
+		Affinity: b.Affinity,
+	}
+	if len(b.Allocations) > 0 {
+		c.Allocations = b.Allocations
+	}
+	if len(b.Unallocated) > 0 {
+		c.Unallocated = b.Unallocated
+	}
+	if len(b.SequenceNumberForAllocation) > 0 {
+		c.SequenceNumberForAllocation = b.SequenceNumberForAllocation
+	}
+	for _, a := range b.Attributes {
+		if len(a.AttrSecondary) == 0 {
+			a.AttrSecondary = nil
+		}
+		c.Attributes = append(c.Attributes, a)
+	}
+	return c
+}
+
+// importIPAM writes the export to the datastore.  It skips the resources that already exist: validateImport
+// has checked that they are identical to the exported ones, so an earlier, interrupted import wrote them.  If a
+// write fails, it deletes the resources that it has written, leaving those that an earlier import wrote.
+//
+// The resources are written in the same order as IPAM writes them when it assigns an IP, so that an
+// interrupted import doesn't leave blocks that refer to missing handles: IP reservations first so that IPAM
+// doesn't assign the reserved IPs, then handles, blocks and finally block affinities.
+func importIPAM(ctx context.Context, c clientv3.Interface, bc bapi.Client, export *IPAMExport) error {
+	numSkipped := 0
+	// undos holds a function for each resource that we've written, which deletes it.
+	var undos []func() error
+	rollback := func(err error, what string) error {
+		fmt.Printf("Failed to create %s; deleting the %d resources that the import wrote...\n", what, len(undos))
+		numFailed := 0
+		for i := len(undos) - 1; i >= 0; i-- {
+			if err := undos[i](); err != nil && !isNotExist(err) {
+				fmt.Printf("  %v\n", err)
+				numFailed++
+			}
+		}
+		if numFailed > 0 {
+			fmt.Printf("Failed to delete %d resources; re-run the import to write the rest.\n", numFailed)
+		}
+		return fmt.Errorf("failed to create %s: %w", what, err)
+	}
+	create := func(kvp *model.KVPair) error {
+		created, err := bc.Create(ctx, kvp)
+		if _, ok := err.(cerrors.ErrorResourceAlreadyExists); ok {
+			numSkipped++
+			return nil
+		} else if err != nil {
+			return rollback(err, fmt.Sprint(kvp.Key))
+		}
+		undos = append(undos, func() error {
+			_, err := bc.DeleteKVP(ctx, created)
+			return err
+		})
+		return nil
+	}
+
+	for i := range export.IPReservations {
+		r := export.IPReservations[i]
+		created, err := c.IPReservations().Create(ctx, &r, options.SetOptions{})
+		if _, ok := err.(cerrors.ErrorResourceAlreadyExists); ok {
+			numSkipped++
+			continue
+		} else if err != nil {
+			return rollback(err, "IP reservation "+r.Name)
+		}
+		undos = append(undos, func() error {
+			_, err := c.IPReservations().Delete(ctx, created.Name, options.DeleteOptions{ResourceVersion: created.ResourceVersion})
+			return err
+		})
+	}
+	for _, h := range export.Handles {
+		err := create(&model.KVPair{
+			Key:   model.IPAMHandleKey{HandleID: h.HandleID},
+			Value: &model.IPAMHandle{HandleID: h.HandleID, Block: h.Block},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, b := range export.Blocks {
+		err := create(&model.KVPair{
+			Key:   model.BlockKey{CIDR: b.CIDR},
+			Value: b,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, a := range export.Affinities {
+		_, cidr, err := cnet.ParseCIDR(a.CIDR)
+		if err != nil {
+			return rollback(err, "block affinity of "+a.CIDR)
+		}
+		err = create(&model.KVPair{
+			Key:   model.BlockAffinityKey{CIDR: *cidr, Host: a.Host},
+			Value: &model.BlockAffinity{State: a.State},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	fmt.Printf("Imported %d IPAM blocks, %d block affinities, %d handles and %d IP reservations "+
+		"(%d resources written, %d already imported).\n",
+		len(export.Blocks), len(export.Affinities), len(export.Handles), len(export.IPReservations),
+		len(undos), numSkipped)
+	return nil
+}
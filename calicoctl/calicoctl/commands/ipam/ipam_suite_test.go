@@ -0,0 +1,24 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+package ipam
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+
+	"github.com/onsi/ginkgo/reporters"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/testutils"
+)
+
+func init() {
+	testutils.HookLogrusForGinkgo()
+}
+
+func TestIPAM(t *testing.T) {
+	RegisterFailHandler(Fail)
+	junitReporter := reporters.NewJUnitReporter("../../../report/ipam_suite.xml")
+	RunSpecsWithDefaultAndCustomReporters(t, "IPAM Suite", []Reporter{junitReporter})
+}
@@ -0,0 +1,247 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipam
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/ipam"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+)
+
+const (
+	// repairLockAnnotation is the ClusterInformation annotation that records the IPAM repair, or import, in
+	// progress.
+	repairLockAnnotation = "projectcalico.org/ipam-repair-lock"
+
+	// repairLockTimeout is how long the lock is held for.  It expires so that a repair that was killed
+	// doesn't block the next one forever.
+	repairLockTimeout = 30 * time.Minute
+)
+
+// repairPlan holds the changes that fix the problems found by the IPAM check.
+type repairPlan struct {
+	// leakedIPs are the allocations that aren't in use.  The handle and sequence number make sure that
+	// we don't release an IP that has been reassigned since we read its block.
+	leakedIPs []ipam.ReleaseOptions
+
+	// missingAffinities are the block affinities to create for blocks that are affine to a node.
+	missingAffinities []model.BlockAffinityKey
+
+	// danglingAffinities are the block affinities whose block doesn't exist or is affine to another node.
+	danglingAffinities []*model.KVPair
+
+	// staleBlocks are the blocks that are affine to nodes that no longer exist and that have no IPs in use.
+	staleBlocks []*model.AllocationBlock
+
+	// orphanedHandles are the IPAM handles that aren't used by any allocation.
+	orphanedHandles []*model.KVPair
+}
+
+func (p *repairPlan) numRepairs() int {
+	return len(p.leakedIPs) + len(p.missingAffinities) + len(p.danglingAffinities) +
+		len(p.staleBlocks) + len(p.orphanedHandles)
+}
+
+// print prints the repairs in the order that they are made.
+func (p *repairPlan) print() {
+	fmt.Printf("Planned %d repairs:\n", p.numRepairs())
+	for _, kvp := range p.danglingAffinities {
+		key := kvp.Key.(model.BlockAffinityKey)
+		fmt.Printf("  Delete block affinity of %s to node %s\n", key.CIDR, key.Host)
+	}
+	for _, key := range p.missingAffinities {
+		fmt.Printf("  Create block affinity of %s to node %s\n", key.CIDR, key.Host)
+	}
+	sort.Slice(p.leakedIPs, func(i, j int) bool {
+		return p.leakedIPs[i].Address < p.leakedIPs[j].Address
+	})
+	for _, opts := range p.leakedIPs {
+		fmt.Printf("  Release leaked IP %s (handle %q)\n", opts.Address, opts.Handle)
+	}
+	for _, b := range p.staleBlocks {
+		fmt.Printf("  Release empty block %s affine to missing node %s\n", b.CIDR, hostAffinity(b))
+	}
+	for _, kvp := range p.orphanedHandles {
+		fmt.Printf("  Delete orphaned handle %s\n", kvp.Key.(model.IPAMHandleKey).HandleID)
+	}
+}
+
+// repairIPAM prints the plan and, unless this is a dry run, makes the repairs.
+func (c *IPAMChecker) repairIPAM(ctx context.Context, plan repairPlan) error {
+	if plan.numRepairs() == 0 {
+		fmt.Println("No repairs needed.")
+		return nil
+	}
+	plan.print()
+	fmt.Println()
+	if c.dryRun {
+		fmt.Println("Dry run; no repairs made.")
+		return nil
+	}
+
+	// Addresses that are being assigned look leaked for a short time, so we expect the data store to
+	// be locked, as for "ipam release --from-report".
+	if !c.datastoreLocked {
+		if !c.force {
+			return fmt.Errorf("Data store is not locked. Either lock the data store, or re-run with --force.")
+		}
+		fmt.Println("WARNING: Data store is not locked. Ignoring due to --force option")
+	}
+
+	fmt.Println("Repairing IPAM...")
+	numFailed := 0
+	failed := func(err error, format string, args ...interface{}) {
+		numFailed++
+		fmt.Printf("  Failed to "+format+": %v\n", append(args, err)...)
+	}
+
+	// Fix up the block affinities first: releasing a stale block requires its affinity to exist.
+	for _, kvp := range plan.danglingAffinities {
+		key := kvp.Key.(model.BlockAffinityKey)
+		if _, err := c.backendClient.DeleteKVP(ctx, kvp); err != nil && !isNotExist(err) {
+			failed(err, "delete block affinity of %s to node %s", key.CIDR, key.Host)
+		}
+	}
+	for _, key := range plan.missingAffinities {
+		kvp := &model.KVPair{
+			Key:   key,
+			Value: &model.BlockAffinity{State: model.StateConfirmed},
+		}
+		if _, err := c.backendClient.Create(ctx, kvp); err != nil {
+			if _, ok := err.(cerrors.ErrorResourceAlreadyExists); !ok {
+				failed(err, "create block affinity of %s to node %s", key.CIDR, key.Host)
+			}
+		}
+	}
+
+	if len(plan.leakedIPs) > 0 {
+		unallocated, err := c.v3Client.IPAM().ReleaseIPs(ctx, plan.leakedIPs...)
+		if err != nil {
+			failed(err, "release %d leaked IPs", len(plan.leakedIPs))
+		} else if len(unallocated) != 0 {
+			fmt.Printf("  %d leaked IPs were no longer allocated.\n", len(unallocated))
+		}
+	}
+
+	// Releasing the affinity of an empty block deletes the block.  The IPAM client re-reads the block
+	// and refuses to release it if it is no longer empty.
+	for _, b := range plan.staleBlocks {
+		if err := c.v3Client.IPAM().ReleaseBlockAffinity(ctx, b, true); err != nil {
+			failed(err, "release block %s", b.CIDR)
+		}
+	}
+
+	// The deletions are compare-and-delete so we don't delete a handle that has been used since we read it.
+	for _, kvp := range plan.orphanedHandles {
+		if _, err := c.backendClient.DeleteKVP(ctx, kvp); err != nil && !isNotExist(err) {
+			failed(err, "delete handle %s", kvp.Key.(model.IPAMHandleKey).HandleID)
+		}
+	}
+
+	if numFailed > 0 {
+		return fmt.Errorf("%d of %d repairs failed; re-run the check to see the remaining problems", numFailed, plan.numRepairs())
+	}
+	fmt.Println("Repair complete.")
+	return nil
+}
+
+func isNotExist(err error) bool {
+	_, ok := err.(cerrors.ErrorResourceDoesNotExist)
+	return ok
+}
+
+// repairLock is the value of the repair lock annotation.
+type repairLock struct {
+	Holder  string    `json:"holder"`
+	Expires time.Time `json:"expires"`
+}
+
+// lockRepair takes the lock that stops IPAM repairs and imports from running concurrently.  The lock is an annotation
+// on the ClusterInformation, which we add with a compare-and-swap so that only one of two repairs that
+// start at the same time gets it.  It returns a function that releases the lock.
+func lockRepair(ctx context.Context, c clientv3.Interface) (func(), error) {
+	clusterInfo, err := c.ClusterInformation().Get(ctx, "default", options.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if value, ok := clusterInfo.Annotations[repairLockAnnotation]; ok {
+		var current repairLock
+		if err := json.Unmarshal([]byte(value), &current); err == nil && time.Now().Before(current.Expires) {
+			return nil, fmt.Errorf("Another IPAM repair or import (%s) is in progress; its lock expires at %s.",
+				current.Holder, current.Expires.Format(time.RFC3339))
+		}
+		fmt.Println("Taking over expired IPAM repair lock.")
+	}
+
+	hostname, _ := os.Hostname()
+	lock := repairLock{
+		Holder:  fmt.Sprintf("%s/%d", hostname, os.Getpid()),
+		Expires: time.Now().Add(repairLockTimeout),
+	}
+	value, err := json.Marshal(lock)
+	if err != nil {
+		return nil, err
+	}
+	if clusterInfo.Annotations == nil {
+		clusterInfo.Annotations = map[string]string{}
+	}
+	clusterInfo.Annotations[repairLockAnnotation] = string(value)
+	if _, err := c.ClusterInformation().Update(ctx, clusterInfo, options.SetOptions{}); err != nil {
+		if _, ok := err.(cerrors.ErrorResourceUpdateConflict); ok {
+			return nil, fmt.Errorf("Another IPAM repair or import may be in progress; failed to take the lock: %w", err)
+		}
+		return nil, fmt.Errorf("Error updating ClusterInformation to take the IPAM repair lock: %w", err)
+	}
+
+	return func() {
+		if err := unlockRepair(ctx, c, lock.Holder); err != nil {
+			fmt.Printf("WARNING: Failed to release the IPAM repair lock, it will expire at %s: %v\n",
+				lock.Expires.Format(time.RFC3339), err)
+		}
+	}, nil
+}
+
+// unlockRepair removes the repair lock annotation, if it is still held by the given holder.
+func unlockRepair(ctx context.Context, c clientv3.Interface, holder string) error {
+	var err error
+	for i := 0; i < 5; i++ {
+		clusterInfo, getErr := c.ClusterInformation().Get(ctx, "default", options.GetOptions{})
+		if getErr != nil {
+			err = getErr
+			continue
+		}
+		var current repairLock
+		if value, ok := clusterInfo.Annotations[repairLockAnnotation]; !ok {
+			return nil
+		} else if json.Unmarshal([]byte(value), &current) != nil || current.Holder != holder {
+			// Our lock expired and another repair took it over.
+			return nil
+		}
+		delete(clusterInfo.Annotations, repairLockAnnotation)
+		if _, err = c.ClusterInformation().Update(ctx, clusterInfo, options.SetOptions{}); err == nil {
+			return nil
+		}
+	}
+	return err
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipam
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+// newBlock returns a block that is affine to the given node (if any), with one allocation per handle.
+func newBlock(cidr, node string, handles ...string) *model.KVPair {
+	b := &model.AllocationBlock{
+		CIDR: net.MustParseCIDR(cidr),
+	}
+	if node != "" {
+		affinity := "host:" + node
+		b.Affinity = &affinity
+	}
+	for i := range handles {
+		attrIdx := i
+		b.Allocations = append(b.Allocations, &attrIdx)
+		b.Attributes = append(b.Attributes, model.AllocationAttribute{AttrPrimary: &handles[i]})
+	}
+	return &model.KVPair{Key: model.BlockKey{CIDR: b.CIDR}, Value: b}
+}
+
+func newAffinity(cidr, node string) *model.KVPair {
+	return &model.KVPair{
+		Key:   model.BlockAffinityKey{CIDR: net.MustParseCIDR(cidr), Host: node},
+		Value: &model.BlockAffinity{State: model.StateConfirmed},
+	}
+}
+
+func newHandle(id string) *model.KVPair {
+	return &model.KVPair{
+		Key:   model.IPAMHandleKey{HandleID: id},
+		Value: &model.IPAMHandle{HandleID: id},
+	}
+}
+
+var _ = Describe("IPAM check repairs", func() {
+	var checker *IPAMChecker
+
+	BeforeEach(func() {
+		checker = NewIPAMChecker(nil, nil, nil, false, false, "", "test")
+	})
+
+	// addBlocks records the blocks and their allocations, as checkIPAM does when loading them.
+	addBlocks := func(blocks ...*model.KVPair) {
+		for _, kvp := range blocks {
+			checker.blocks = append(checker.blocks, kvp)
+			b := kvp.Value.(*model.AllocationBlock)
+			for ord := range b.Allocations {
+				checker.recordAllocation(b, ord)
+			}
+		}
+	}
+
+	It("should find handles that aren't used by any allocation", func() {
+		addBlocks(newBlock("10.0.0.0/30", "node1", "handle1"))
+		checker.handles = []*model.KVPair{newHandle("handle1"), newHandle("handle2")}
+
+		orphaned := checker.findOrphanedHandles()
+		Expect(orphaned).To(HaveLen(1))
+		Expect(orphaned[0].Key).To(Equal(model.IPAMHandleKey{HandleID: "handle2"}))
+	})
+
+	It("should find affinities that don't match their blocks", func() {
+		addBlocks(
+			newBlock("10.0.0.0/30", "node1"),
+			newBlock("10.0.0.4/30", "node2"),
+			newBlock("10.0.0.8/30", ""),
+		)
+		checker.affinities = []*model.KVPair{
+			newAffinity("10.0.0.0/30", "node1"),
+			// Affine to a different node to its block.
+			newAffinity("10.0.0.0/30", "node3"),
+			// Block has no affinity.
+			newAffinity("10.0.0.8/30", "node1"),
+			// Block doesn't exist.
+			newAffinity("10.0.0.12/30", "node1"),
+		}
+
+		missing, dangling := checker.findAffinityProblems()
+		Expect(missing).To(Equal([]model.BlockAffinityKey{
+			{CIDR: net.MustParseCIDR("10.0.0.4/30"), Host: "node2"},
+		}))
+		Expect(dangling).To(Equal(checker.affinities[1:]))
+	})
+
+	It("should find empty blocks that are affine to missing nodes", func() {
+		checker.nodes["node1"] = true
+		addBlocks(
+			newBlock("10.0.0.0/30", "node1"),
+			// The only IP in this block is leaked.
+			newBlock("10.0.0.4/30", "node2", "leaked"),
+			newBlock("10.0.0.8/30", "node3", "in-use"),
+			newBlock("10.0.0.12/30", ""),
+		)
+		checker.recordInUseIP("10.0.0.8", nil, "Workload(default/pod)")
+
+		stale := checker.findStaleBlocks()
+		Expect(stale).To(HaveLen(1))
+		Expect(stale[0].CIDR).To(Equal(net.MustParseCIDR("10.0.0.4/30")))
+	})
+
+	It("should count the planned repairs", func() {
+		plan := repairPlan{
+			missingAffinities: []model.BlockAffinityKey{{CIDR: net.MustParseCIDR("10.0.0.4/30"), Host: "node2"}},
+			orphanedHandles:   []*model.KVPair{newHandle("handle1"), newHandle("handle2")},
+		}
+		Expect(plan.numRepairs()).To(Equal(3))
+	})
+})
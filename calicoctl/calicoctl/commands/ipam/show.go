@@ -20,6 +20,7 @@ import (
 	"math"
 	"os"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/olekukonko/tablewriter"
@@ -215,6 +216,52 @@ func showBlockUtilization(ctx context.Context, ipamClient ipam.Interface, showBl
 	return nil
 }
 
+func showQuotaUtilization(ctx context.Context, ipamClient ipam.Interface) error {
+	usage, err := ipamClient.GetUtilization(ctx, ipam.GetUtilizationArgs{})
+	if err != nil {
+		return err
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"IP POOL", "QUOTA", "NAME", "IPS IN USE", "LIMIT"})
+	genRows := func(pool, scope string, inUse map[string]int, limit int) [][]string {
+		var names []string
+		for name := range inUse {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		var rows [][]string
+		for _, name := range names {
+			rows = append(rows, []string{
+				pool,
+				scope,
+				name,
+				fmt.Sprintf("%d (%.f%%)", inUse[name], 100*float64(inUse[name])/float64(limit)),
+				fmt.Sprintf("%d", limit),
+			})
+		}
+		return rows
+	}
+
+	numQuotas := 0
+	for _, poolUse := range usage {
+		if poolUse.MaxIPsPerNamespace > 0 {
+			numQuotas++
+			table.AppendBulk(genRows(poolUse.Name, "Per namespace", poolUse.NamespaceIPsInUse, poolUse.MaxIPsPerNamespace))
+		}
+		if poolUse.MaxIPsPerNode > 0 {
+			numQuotas++
+			table.AppendBulk(genRows(poolUse.Name, "Per node", poolUse.NodeIPsInUse, poolUse.MaxIPsPerNode))
+		}
+	}
+	if numQuotas == 0 {
+		fmt.Println("No IP pools have IP quotas configured.")
+		return nil
+	}
+	table.Render()
+
+	return nil
+}
+
 func showConfiguration(ctx context.Context, ipamClient ipam.Interface) error {
 	ipamConfig, err := ipamClient.GetIPAMConfig(ctx)
 	if err != nil {
@@ -246,13 +293,15 @@ func showConfiguration(ctx context.Context, ipamClient ipam.Interface) error {
 // IPAM takes keyword with an IP address then calls the subcommands.
 func Show(args []string) error {
 	doc := constants.DatastoreIntro + `Usage:
-  <BINARY_NAME> ipam show [--ip=<IP> | --show-blocks | --show-borrowed | --show-configuration] [--config=<CONFIG>] [--allow-version-mismatch]
+  <BINARY_NAME> ipam show [--ip=<IP> | --show-blocks | --show-borrowed | --show-quotas | --show-configuration] [--config=<CONFIG>] [--allow-version-mismatch]
 
 Options:
   -h --help                    Show this screen.
      --ip=<IP>                 Report whether this specific IP address is in use.
      --show-blocks             Show detailed information for IP blocks as well as pools.
      --show-borrowed           Show detailed information for "borrowed" IP addresses.
+     --show-quotas             Show the IPs in use by each namespace and node in the IP
+                               pools that have IP quotas.
      --show-configuration      Show current Calico IPAM configuration.
   -c --config=<CONFIG>         Path to the file containing connection configuration in
                                YAML or JSON format.
@@ -301,6 +350,7 @@ Description:
 	passedIP := parsedArgs["--ip"]
 	showBlocks := parsedArgs["--show-blocks"].(bool)
 	showBorrowed := parsedArgs["--show-borrowed"].(bool)
+	showQuotas := parsedArgs["--show-quotas"].(bool)
 	configuration := parsedArgs["--show-configuration"].(bool)
 
 	if passedIP != nil {
@@ -309,6 +359,8 @@ Description:
 		return showBlockUtilization(ctx, ipamClient, true)
 	} else if showBorrowed {
 		return showBorrowedDetails(ctx, ippoolClient, bc)
+	} else if showQuotas {
+		return showQuotaUtilization(ctx, ipamClient)
 	} else if configuration {
 		return showConfiguration(ctx, ipamClient)
 	}
@@ -0,0 +1,319 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipam
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	docopt "github.com/docopt/docopt-go"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/clientmgr"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/common"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/constants"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/util"
+	"github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/ipam"
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+)
+
+// splitFromAnnotation is the annotation on a replacement IP pool that names the pool that it replaces.
+const splitFromAnnotation = "projectcalico.org/ipam-split-from"
+
+// Split replaces an IP pool with new IP pools as workloads are recreated.
+func Split(args []string) error {
+	doc := constants.DatastoreIntro + `Usage:
+  <BINARY_NAME> ipam split --pool=<POOL> --cidr=<CIDR>... [--block-size=<SIZE>] [--config=<CONFIG>] [--allow-version-mismatch]
+  <BINARY_NAME> ipam split --pool=<POOL> --status [--config=<CONFIG>] [--allow-version-mismatch]
+  <BINARY_NAME> ipam split --pool=<POOL> --finish [--config=<CONFIG>] [--allow-version-mismatch]
+
+Options:
+  -h --help                    Show this screen.
+     --pool=<POOL>             Name of the IP pool to replace.
+     --cidr=<CIDR>             CIDR of a replacement IP pool.  May be specified more
+                               than once to create several replacement pools.
+     --block-size=<SIZE>       Block size of the replacement IP pools.  Defaults to the
+                               block size of the IP pool that they replace.
+     --status                  Report how many IPs have moved to the replacement pools.
+     --finish                  Delete the replaced IP pool once none of its IPs are in use.
+  -c --config=<CONFIG>         Path to the file containing connection configuration in
+                               YAML or JSON format.
+                               [default: ` + constants.DefaultConfigPath + `]
+     --allow-version-mismatch  Allow client and cluster versions mismatch.
+
+Description:
+  The ipam split command replaces an IP pool with one or more new IP pools, for
+  example to make more addresses available or to change the block size, which
+  can't be changed in place.  The replacement takes three steps:
+
+  1. '<BINARY_NAME> ipam split --pool=<POOL> --cidr=<CIDR>...' creates a replacement
+     pool for each CIDR, with the same settings as the old pool, and then disables
+     the old pool so that no more IPs are assigned from its blocks.  Existing
+     workloads keep their IPs.  IP quotas apply to each replacement pool
+     separately.
+  2. As workloads are recreated, they are assigned IPs from the replacement pools.
+     '<BINARY_NAME> ipam split --pool=<POOL> --status' reports the progress.
+  3. Once none of the old pool's IPs are in use, '<BINARY_NAME> ipam split
+     --pool=<POOL> --finish' deletes the old pool and its blocks.
+`
+	// Replace all instances of BINARY_NAME with the name of the binary.
+	name, _ := util.NameAndDescription()
+	doc = strings.ReplaceAll(doc, "<BINARY_NAME>", name)
+
+	parsedArgs, err := docopt.ParseArgs(doc, args, "")
+	if err != nil {
+		return fmt.Errorf("Invalid option: 'calicoctl %s'. Use flag '--help' to read about a specific subcommand.", strings.Join(args, " "))
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+
+	err = common.CheckVersionMismatch(parsedArgs["--config"], parsedArgs["--allow-version-mismatch"])
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	// Create a new backend client from env vars.
+	cf := parsedArgs["--config"].(string)
+	client, err := clientmgr.NewClient(cf)
+	if err != nil {
+		return err
+	}
+
+	poolName := parsedArgs["--pool"].(string)
+	switch {
+	case parsedArgs["--status"].(bool):
+		return splitStatus(ctx, client, poolName)
+	case parsedArgs["--finish"].(bool):
+		return finishSplit(ctx, client, poolName)
+	}
+
+	blockSize := 0
+	if arg := parsedArgs["--block-size"]; arg != nil {
+		blockSize, err = strconv.Atoi(arg.(string))
+		if err != nil {
+			return fmt.Errorf("Invalid block size: %s", arg)
+		}
+	}
+	return startSplit(ctx, client, poolName, parsedArgs["--cidr"].([]string), blockSize)
+}
+
+// startSplit creates the replacement pools and then disables the old pool.  Disabled pools are excluded from
+// the IPAM client's enabled pools, so no more IPs are assigned from the old pool's blocks, but the existing
+// IPs stay valid until they are released.  It can be re-run if it fails part way through.
+func startSplit(ctx context.Context, c clientv3.Interface, poolName string, cidrs []string, blockSize int) error {
+	pool, err := c.IPPools().Get(ctx, poolName, options.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	// Create the replacement pools first, so that there's always somewhere to assign IPs from.
+	for i, cidr := range cidrs {
+		replacement, err := newReplacementPool(pool, fmt.Sprintf("%s-split-%d", pool.Name, i+1), cidr, blockSize)
+		if err != nil {
+			return err
+		}
+		_, err = c.IPPools().Create(ctx, replacement, options.SetOptions{})
+		if _, ok := err.(cerrors.ErrorResourceAlreadyExists); ok {
+			// Check that the pool is the one that an earlier run created.
+			existing, err := c.IPPools().Get(ctx, replacement.Name, options.GetOptions{})
+			if err != nil {
+				return err
+			}
+			if existing.Annotations[splitFromAnnotation] != pool.Name || existing.Spec.CIDR != replacement.Spec.CIDR {
+				return fmt.Errorf("IP pool %s already exists and is not a replacement for %s with CIDR %s",
+					replacement.Name, pool.Name, replacement.Spec.CIDR)
+			}
+			fmt.Printf("Replacement IP pool %s (%s) already exists.\n", replacement.Name, replacement.Spec.CIDR)
+			continue
+		} else if err != nil {
+			return fmt.Errorf("Error creating replacement IP pool %s: %w", replacement.Name, err)
+		}
+		fmt.Printf("Created replacement IP pool %s (%s).\n", replacement.Name, replacement.Spec.CIDR)
+	}
+
+	if !pool.Spec.Disabled {
+		pool.Spec.Disabled = true
+		if _, err := c.IPPools().Update(ctx, pool, options.SetOptions{}); err != nil {
+			return fmt.Errorf("Error disabling IP pool %s: %w", pool.Name, err)
+		}
+	}
+	fmt.Printf("Disabled IP pool %s; no more IPs will be assigned from it.\n", pool.Name)
+
+	name, _ := util.NameAndDescription()
+	fmt.Printf("Workloads move to the replacement pools as they are recreated.  "+
+		"Run '%s ipam split --pool=%s --status' to check progress.\n", name, pool.Name)
+	return nil
+}
+
+// newReplacementPool returns a pool with the given name and CIDR and the settings of the old pool.  If
+// blockSize is 0, the old pool's block size is used.  The replacement copies every field of the old pool's
+// spec other than those derived from the CIDR, so that settings added to IP pools later are copied too,
+// but it is always enabled.
+func newReplacementPool(old *apiv3.IPPool, name, cidr string, blockSize int) (*apiv3.IPPool, error) {
+	_, oldNet, err := cnet.ParseCIDR(old.Spec.CIDR)
+	if err != nil {
+		return nil, err
+	}
+	_, newNet, err := cnet.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid CIDR %s: %w", cidr, err)
+	}
+	if newNet.Version() != oldNet.Version() {
+		return nil, fmt.Errorf("CIDR %s is not the same IP version as IP pool %s (%s)", cidr, old.Name, old.Spec.CIDR)
+	}
+	if blockSize == 0 {
+		blockSize = old.Spec.BlockSize
+	}
+
+	pool := apiv3.NewIPPool()
+	pool.Name = name
+	pool.Annotations = map[string]string{splitFromAnnotation: old.Name}
+	old.Spec.DeepCopyInto(&pool.Spec)
+	pool.Spec.CIDR = newNet.String()
+	pool.Spec.BlockSize = blockSize
+	pool.Spec.Disabled = false
+	// The APIv1 fields are for internal use only and can't be set.
+	pool.Spec.IPIP = nil
+	pool.Spec.NATOutgoingV1 = false
+	return pool, nil
+}
+
+// splitStatus reports how many IPs are still in use in the old pool and how many have been assigned from the
+// replacement pools.
+func splitStatus(ctx context.Context, c clientv3.Interface, poolName string) error {
+	pool, err := c.IPPools().Get(ctx, poolName, options.GetOptions{})
+	if err != nil {
+		return err
+	}
+	replacements, err := replacementPools(ctx, c, poolName)
+	if err != nil {
+		return err
+	}
+	if len(replacements) == 0 {
+		return fmt.Errorf("IP pool %s is not being split", poolName)
+	}
+
+	names := []string{pool.Name}
+	for _, p := range replacements {
+		names = append(names, p.Name)
+	}
+	usage, err := c.IPAM().GetUtilization(ctx, ipam.GetUtilizationArgs{Pools: names})
+	if err != nil {
+		return err
+	}
+	usageByPool := map[string]*ipam.PoolUtilization{}
+	for _, u := range usage {
+		usageByPool[u.Name] = u
+	}
+
+	remaining := poolIPsInUse(usageByPool[pool.Name])
+	state := "enabled"
+	if pool.Spec.Disabled {
+		state = "disabled"
+	}
+	fmt.Printf("IP pool %s (%s, %s): %d IPs in use in %d blocks.\n",
+		pool.Name, pool.Spec.CIDR, state, remaining, poolNumBlocks(usageByPool[pool.Name]))
+	moved := 0
+	for _, p := range replacements {
+		inUse := poolIPsInUse(usageByPool[p.Name])
+		fmt.Printf("Replacement IP pool %s (%s): %d IPs in use in %d blocks.\n",
+			p.Name, p.Spec.CIDR, inUse, poolNumBlocks(usageByPool[p.Name]))
+		moved += inUse
+	}
+	if total := moved + remaining; total > 0 {
+		fmt.Printf("%d of %d IPs (%d%%) are in the replacement pools.\n", moved, total, 100*moved/total)
+	}
+
+	name, _ := util.NameAndDescription()
+	if remaining == 0 {
+		fmt.Printf("None of IP pool %s's IPs are in use.  Run '%s ipam split --pool=%s --finish' to delete it.\n",
+			pool.Name, name, pool.Name)
+	} else {
+		fmt.Printf("The remaining IPs move to the replacement pools as their workloads are recreated.\n")
+	}
+	return nil
+}
+
+// finishSplit deletes the old pool, which also releases its empty blocks, once none of its IPs are in use.
+func finishSplit(ctx context.Context, c clientv3.Interface, poolName string) error {
+	pool, err := c.IPPools().Get(ctx, poolName, options.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if !pool.Spec.Disabled {
+		return fmt.Errorf("IP pool %s is still enabled; start the split with --cidr first", poolName)
+	}
+
+	usage, err := c.IPAM().GetUtilization(ctx, ipam.GetUtilizationArgs{Pools: []string{pool.Name}})
+	if err != nil {
+		return err
+	}
+	for _, u := range usage {
+		if inUse := poolIPsInUse(u); inUse > 0 {
+			return fmt.Errorf("IP pool %s still has %d IPs in use; run with --status to check progress", poolName, inUse)
+		}
+	}
+
+	// The pool is disabled, so no IPs can have been assigned since we checked.  Use the revision that we
+	// read so that we don't delete the pool if someone has re-enabled it.
+	_, err = c.IPPools().Delete(ctx, pool.Name, options.DeleteOptions{ResourceVersion: pool.ResourceVersion})
+	if err != nil {
+		return fmt.Errorf("Error deleting IP pool %s: %w", pool.Name, err)
+	}
+	fmt.Printf("Deleted IP pool %s.\n", pool.Name)
+	return nil
+}
+
+// replacementPools returns the pools that were created to replace the given pool.
+func replacementPools(ctx context.Context, c clientv3.Interface, poolName string) ([]apiv3.IPPool, error) {
+	pools, err := c.IPPools().List(ctx, options.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var replacements []apiv3.IPPool
+	for _, p := range pools.Items {
+		if p.Annotations[splitFromAnnotation] == poolName {
+			replacements = append(replacements, p)
+		}
+	}
+	return replacements, nil
+}
+
+func poolIPsInUse(u *ipam.PoolUtilization) int {
+	if u == nil {
+		return 0
+	}
+	inUse := 0
+	for _, b := range u.Blocks {
+		inUse += b.Capacity - b.Available
+	}
+	return inUse
+}
+
+func poolNumBlocks(u *ipam.PoolUtilization) int {
+	if u == nil {
+		return 0
+	}
+	return len(u.Blocks)
+}
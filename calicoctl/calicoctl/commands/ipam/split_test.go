@@ -0,0 +1,97 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipam
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/ipam"
+)
+
+var _ = Describe("IPAM split", func() {
+	var old *apiv3.IPPool
+
+	BeforeEach(func() {
+		old = apiv3.NewIPPool()
+		old.Name = "default-ipv4-ippool"
+		old.Spec = apiv3.IPPoolSpec{
+			CIDR:               "192.168.0.0/24",
+			VXLANMode:          apiv3.VXLANModeAlways,
+			IPIPMode:           apiv3.IPIPModeNever,
+			NATOutgoing:        true,
+			Disabled:           true,
+			DisableBGPExport:   true,
+			BlockSize:          26,
+			NodeSelector:       "all()",
+			AllowedUses:        []apiv3.IPPoolAllowedUse{apiv3.IPPoolAllowedUseWorkload},
+			MaxIPsPerNamespace: 100,
+			MaxIPsPerNode:      50,
+		}
+	})
+
+	It("should copy the old pool's settings to the replacement pool", func() {
+		pool, err := newReplacementPool(old, "default-ipv4-ippool-split-1", "10.96.0.0/12", 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pool.Name).To(Equal("default-ipv4-ippool-split-1"))
+		Expect(pool.Annotations).To(Equal(map[string]string{splitFromAnnotation: "default-ipv4-ippool"}))
+		Expect(pool.Spec).To(Equal(apiv3.IPPoolSpec{
+			CIDR:               "10.96.0.0/12",
+			VXLANMode:          apiv3.VXLANModeAlways,
+			IPIPMode:           apiv3.IPIPModeNever,
+			NATOutgoing:        true,
+			DisableBGPExport:   true,
+			BlockSize:          26,
+			NodeSelector:       "all()",
+			AllowedUses:        []apiv3.IPPoolAllowedUse{apiv3.IPPoolAllowedUseWorkload},
+			MaxIPsPerNamespace: 100,
+			MaxIPsPerNode:      50,
+		}))
+	})
+
+	It("should not share the old pool's slices or copy the APIv1 fields", func() {
+		old.Spec.IPIP = &apiv3.IPIPConfiguration{Enabled: true}
+		old.Spec.NATOutgoingV1 = true
+		pool, err := newReplacementPool(old, "split", "10.96.0.0/12", 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pool.Spec.IPIP).To(BeNil())
+		Expect(pool.Spec.NATOutgoingV1).To(BeFalse())
+		pool.Spec.AllowedUses[0] = apiv3.IPPoolAllowedUseTunnel
+		Expect(old.Spec.AllowedUses).To(Equal([]apiv3.IPPoolAllowedUse{apiv3.IPPoolAllowedUseWorkload}))
+	})
+
+	It("should use the given block size and normalise the CIDR", func() {
+		pool, err := newReplacementPool(old, "split", "10.96.0.1/12", 28)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pool.Spec.CIDR).To(Equal("10.96.0.0/12"))
+		Expect(pool.Spec.BlockSize).To(Equal(28))
+	})
+
+	It("should reject a CIDR of a different IP version", func() {
+		_, err := newReplacementPool(old, "split", "fd00::/64", 0)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should count the IPs in use in a pool's blocks", func() {
+		Expect(poolIPsInUse(nil)).To(Equal(0))
+		Expect(poolIPsInUse(&ipam.PoolUtilization{Blocks: []ipam.BlockUtilization{
+			{Capacity: 64, Available: 60},
+			{Capacity: 64, Available: 64},
+			{Capacity: 64, Available: 0},
+		}})).To(Equal(68))
+	})
+})
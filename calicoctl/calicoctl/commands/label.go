@@ -72,6 +72,7 @@ Description:
   that can be labeled are:
 
     * bgpConfiguration
+    * bgpFilter
     * bgpPeer
     * felixConfiguration
     * globalNetworkPolicy
@@ -82,7 +83,11 @@ Description:
     * kubeControllersConfiguration
     * networkPolicy
     * node
+    * packetCapture
     * profile
+    * stagedGlobalNetworkPolicy
+    * stagedNetworkPolicy
+    * tier
     * workloadEndpoint
 
   The resource type is case insensitive and may be pluralized.
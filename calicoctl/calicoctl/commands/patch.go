@@ -61,6 +61,7 @@ Description:
   Valid resource types are:
 
     * bgpConfiguration
+    * bgpFilter
     * bgpPeer
     * felixConfiguration
     * globalNetworkPolicy
@@ -71,8 +72,12 @@ Description:
     * kubeControllersConfiguration
     * networkPolicy
     * networkSet
+    * packetCapture
     * node
     * profile
+    * stagedGlobalNetworkPolicy
+    * stagedNetworkPolicy
+    * tier
     * workloadEndpoint
 
   The resource type is case insensitive and may be pluralized.
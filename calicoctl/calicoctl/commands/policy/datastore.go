@@ -0,0 +1,179 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/calicoctl/calicoctl/resourcemgr"
+	"github.com/projectcalico/calico/libcalico-go/lib/apiconfig"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/syncersv1/felixsyncer"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/syncersv1/updateprocessors"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/watchersyncer"
+	validator "github.com/projectcalico/calico/libcalico-go/lib/validator/v3"
+)
+
+// syncerRecorder records the updates from a syncer and signals when the syncer is in sync.
+type syncerRecorder struct {
+	lock    sync.Mutex
+	updates []bapi.Update
+	inSync  chan struct{}
+	closed  bool
+}
+
+func (r *syncerRecorder) OnStatusUpdated(status bapi.SyncStatus) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if status == bapi.InSync && !r.closed {
+		close(r.inSync)
+		r.closed = true
+	}
+}
+
+func (r *syncerRecorder) OnUpdates(updates []bapi.Update) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.updates = append(r.updates, updates...)
+}
+
+// DatastoreUpdates reads the current endpoints, namespaces, policies and related resources from
+// the datastore, in the v1 data model that Felix's syncer produces.
+func DatastoreUpdates(ctx context.Context, cfg *apiconfig.CalicoAPIConfig, bc bapi.Client) ([]bapi.Update, error) {
+	rec := &syncerRecorder{inSync: make(chan struct{})}
+	syncer := felixsyncer.New(bc, cfg.Spec, rec, true)
+	syncer.Start()
+	defer syncer.Stop()
+
+	select {
+	case <-rec.inSync:
+	case <-ctx.Done():
+		return nil, fmt.Errorf("timed out reading resources from the datastore: %v", ctx.Err())
+	}
+
+	rec.lock.Lock()
+	defer rec.lock.Unlock()
+	log.WithField("numUpdates", len(rec.updates)).Info("Read resources from the datastore")
+	return rec.updates, nil
+}
+
+// ResourceUpdates converts resources loaded from a file into the updates that Felix's syncer
+// would produce if they were applied to the datastore.  The resources are defaulted and
+// validated in the same way as the Calico client does before storing them.
+//
+// Staged policies are converted to the policy that they would enforce if promoted, so that
+// their effect can be previewed before promoting them.
+func ResourceUpdates(resources []resourcemgr.ResourceObject) ([]bapi.Update, error) {
+	var updates []bapi.Update
+	for _, r := range resources {
+		var processor watchersyncer.SyncerUpdateProcessor
+		var deleted bool
+		switch res := r.(type) {
+		case *api.NetworkPolicy:
+			np := res.DeepCopy()
+			defaultPolicyTypesField(np.Spec.Ingress, np.Spec.Egress, &np.Spec.Types)
+			r = np
+			processor = updateprocessors.NewNetworkPolicyUpdateProcessor()
+		case *api.GlobalNetworkPolicy:
+			gnp := res.DeepCopy()
+			defaultPolicyTypesField(gnp.Spec.Ingress, gnp.Spec.Egress, &gnp.Spec.Types)
+			r = gnp
+			processor = updateprocessors.NewGlobalNetworkPolicyUpdateProcessor()
+		case *api.StagedNetworkPolicy:
+			stagedAction, np := api.ConvertStagedPolicyToEnforced(res)
+			defaultPolicyTypesField(np.Spec.Ingress, np.Spec.Egress, &np.Spec.Types)
+			r = np
+			deleted = stagedAction == api.StagedActionDelete
+			processor = updateprocessors.NewNetworkPolicyUpdateProcessor()
+		case *api.StagedGlobalNetworkPolicy:
+			stagedAction, gnp := api.ConvertStagedGlobalPolicyToEnforced(res)
+			defaultPolicyTypesField(gnp.Spec.Ingress, gnp.Spec.Egress, &gnp.Spec.Types)
+			r = gnp
+			deleted = stagedAction == api.StagedActionDelete
+			processor = updateprocessors.NewGlobalNetworkPolicyUpdateProcessor()
+		case *api.Tier:
+			processor = updateprocessors.NewTierUpdateProcessor()
+		case *api.NetworkSet:
+			processor = updateprocessors.NewNetworkSetUpdateProcessor()
+		case *api.GlobalNetworkSet:
+			processor = updateprocessors.NewGlobalNetworkSetUpdateProcessor()
+		default:
+			return nil, fmt.Errorf("resources of kind %s are not supported, only policies, staged policies, tiers and network sets are",
+				r.GetObjectKind().GroupVersionKind().Kind)
+		}
+		if err := validator.Validate(r); err != nil {
+			return nil, err
+		}
+		switch p := r.(type) {
+		case *api.NetworkPolicy:
+			p.Name = convertPolicyNameForStorage(p.Name)
+		case *api.GlobalNetworkPolicy:
+			p.Name = convertPolicyNameForStorage(p.Name)
+		}
+
+		kvps, err := processor.Process(&model.KVPair{
+			Key: model.ResourceKey{
+				Kind:      r.GetObjectKind().GroupVersionKind().Kind,
+				Name:      r.GetObjectMeta().GetName(),
+				Namespace: r.GetObjectMeta().GetNamespace(),
+			},
+			Value: r,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %s %s: %v", r.GetObjectKind().GroupVersionKind().Kind, r.GetObjectMeta().GetName(), err)
+		}
+		for _, kvp := range kvps {
+			updateType := bapi.UpdateTypeKVUpdated
+			if deleted {
+				kvp.Value = nil
+				updateType = bapi.UpdateTypeKVDeleted
+			}
+			updates = append(updates, bapi.Update{KVPair: *kvp, UpdateType: updateType})
+		}
+	}
+	return updates, nil
+}
+
+// defaultPolicyTypesField defaults the Types field of a policy in the same way as the Calico
+// client does when the policy is stored.
+func defaultPolicyTypesField(ingressRules, egressRules []api.Rule, types *[]api.PolicyType) {
+	if len(*types) != 0 {
+		return
+	}
+	if len(egressRules) == 0 {
+		*types = []api.PolicyType{api.PolicyTypeIngress}
+	} else if len(ingressRules) == 0 {
+		*types = []api.PolicyType{api.PolicyTypeEgress}
+	} else {
+		*types = []api.PolicyType{api.PolicyTypeIngress, api.PolicyTypeEgress}
+	}
+}
+
+// convertPolicyNameForStorage returns the name that the Calico client stores a policy under,
+// which, for policies in the default tier, is prefixed with the tier name.
+func convertPolicyNameForStorage(name string) string {
+	if strings.HasPrefix(name, "knp.") || strings.HasPrefix(name, "ossg.") || strings.Contains(name, ".") {
+		return name
+	}
+	return api.DefaultTierName + "." + name
+}
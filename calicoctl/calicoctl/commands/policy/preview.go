@@ -0,0 +1,477 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/docopt/docopt-go"
+	"github.com/olekukonko/tablewriter"
+
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/clientmgr"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/common"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/constants"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/util"
+	"github.com/projectcalico/calico/felix/ip"
+	"github.com/projectcalico/calico/felix/policysim"
+	"github.com/projectcalico/calico/felix/proto"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+)
+
+const (
+	// previewSrcPort is the source port of the simulated flows.
+	previewSrcPort = 50000
+	// previewDefaultPort is the port that is probed when none of the previewed rules
+	// mention a port.
+	previewDefaultPort = 80
+
+	protoICMP   = 1
+	protoTCP    = 6
+	protoICMPv6 = 58
+
+	icmpEchoRequest   = 8
+	icmpv6EchoRequest = 128
+)
+
+// Preview shows the effect that applying policies would have, without applying them.
+func Preview(args []string) error {
+	doc := constants.DatastoreIntro + `Usage:
+  <BINARY_NAME> policy preview --filename=<FILENAME> [--namespace=<NS>] [--config=<CONFIG>]
+                [--context=<context>] [--allow-version-mismatch]
+
+Examples:
+  # Preview the effect of applying the policies in policy.yaml.
+  <BINARY_NAME> policy preview -f ./policy.yaml
+
+Options:
+  -h --help                    Show this screen.
+  -f --filename=<FILENAME>     Filename to use to load the resources.  If set to
+                               "-" loads from stdin. If filename is a directory,
+                               this command is invoked for each .json .yaml and
+                               .yml file within that directory, recursively.
+  -n --namespace=<NS>          Namespace of the namespaced resources in the file
+                               that do not specify one.  Uses the default
+                               namespace if not specified.
+  -c --config=<CONFIG>         Path to the file containing connection
+                               configuration in YAML or JSON format.
+                               [default: ` + constants.DefaultConfigPath + `]
+     --context=<context>       The name of the kubeconfig context to use.
+     --allow-version-mismatch  Allow client and cluster versions mismatch.
+
+Description:
+  The policy preview command shows what would change if the policies in the
+  given file were applied, without applying them.  It loads the current
+  endpoints, namespaces and policies from the datastore and calculates the
+  policy for every endpoint in the same way as Felix, both with and without the
+  policies from the file.  It then prints:
+
+    * the workload and host endpoints that each policy newly selects, or no
+      longer selects
+    * the simulated flows to and from those endpoints that change verdict,
+      along with the policy and rule that decides each flow once the file is
+      applied.
+
+  The file may contain NetworkPolicy, GlobalNetworkPolicy, StagedNetworkPolicy,
+  StagedGlobalNetworkPolicy, Tier, NetworkSet and GlobalNetworkSet resources.
+  Staged policies are previewed as the policy that they would enforce if they
+  were promoted.
+
+  The simulated flows run between the selected endpoints and every other
+  endpoint, and the CIDRs named in the rules of the previewed policies.  Each
+  flow uses the protocols and destination ports named in those rules and the
+  named ports of its destination endpoint; ICMP flows are echo requests.  If
+  none of the rules names a protocol with ports, TCP port 80 is used.
+`
+	// Replace all instances of BINARY_NAME with the name of the binary.
+	name, _ := util.NameAndDescription()
+	doc = strings.ReplaceAll(doc, "<BINARY_NAME>", name)
+
+	parsedArgs, err := docopt.ParseArgs(doc, args, "")
+	if err != nil {
+		return fmt.Errorf("Invalid option: 'calicoctl %s'. Use flag '--help' to read about a specific subcommand.", strings.Join(args, " "))
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+	if context := parsedArgs["--context"]; context != nil {
+		os.Setenv("K8S_CURRENT_CONTEXT", context.(string))
+	}
+
+	err = common.CheckVersionMismatch(parsedArgs["--config"], parsedArgs["--allow-version-mismatch"])
+	if err != nil {
+		return err
+	}
+
+	resources, err := common.LoadResources(parsedArgs)
+	if err != nil {
+		return fmt.Errorf("Failed to load resources: %v", err)
+	}
+	fileUpdates, err := ResourceUpdates(resources)
+	if err != nil {
+		return fmt.Errorf("Invalid resource in file: %v", err)
+	}
+
+	cf := parsedArgs["--config"].(string)
+	cfg, err := clientmgr.LoadClientConfig(cf)
+	if err != nil {
+		return err
+	}
+	client, err := clientmgr.NewClientFromConfig(cfg)
+	if err != nil {
+		return err
+	}
+	type accessor interface {
+		Backend() bapi.Client
+	}
+	bc := client.(accessor).Backend()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	datastoreUpdates, err := DatastoreUpdates(ctx, cfg, bc)
+	if err != nil {
+		return err
+	}
+
+	before := policysim.New()
+	before.OnUpdates(datastoreUpdates)
+	after := policysim.New()
+	after.OnUpdates(datastoreUpdates)
+	after.OnUpdates(fileUpdates)
+
+	var policyIDs []proto.PolicyID
+	for _, upd := range fileUpdates {
+		if key, ok := upd.Key.(model.PolicyKey); ok {
+			policyIDs = append(policyIDs, proto.PolicyID{Tier: key.Tier, Name: key.Name})
+		}
+	}
+
+	selectionChanges, affected := previewSelection(before, after, policyIDs)
+	printSelectionChanges(selectionChanges)
+	fmt.Println()
+
+	probes := previewProbes(before, after, policyIDs)
+	externalAddrs := previewExternalAddrs(before, after, policyIDs)
+	flowChanges := previewFlows(before, after, affected, externalAddrs, probes)
+	printFlowChanges(flowChanges)
+	return nil
+}
+
+type selectionChange struct {
+	policy   proto.PolicyID
+	endpoint *policysim.Endpoint
+	selected bool
+}
+
+// previewSelection returns the changes to the endpoints that each policy selects, and the
+// endpoints that are affected by those changes.
+func previewSelection(before, after *policysim.Simulator, policyIDs []proto.PolicyID) ([]selectionChange, []*policysim.Endpoint) {
+	var changes []selectionChange
+	affectedKeys := map[string]bool{}
+	var affected []*policysim.Endpoint
+	addAffected := func(ep *policysim.Endpoint) {
+		if !affectedKeys[ep.Key.String()] {
+			affectedKeys[ep.Key.String()] = true
+			affected = append(affected, ep)
+		}
+	}
+
+	for _, id := range policyIDs {
+		selectedBefore := map[string]bool{}
+		for _, ep := range before.EndpointsSelectedBy(id) {
+			selectedBefore[ep.Key.String()] = true
+		}
+		selectedAfter := map[string]bool{}
+		for _, ep := range after.EndpointsSelectedBy(id) {
+			selectedAfter[ep.Key.String()] = true
+			addAffected(ep)
+			if !selectedBefore[ep.Key.String()] {
+				changes = append(changes, selectionChange{policy: id, endpoint: ep, selected: true})
+			}
+		}
+		for _, ep := range before.EndpointsSelectedBy(id) {
+			if !selectedAfter[ep.Key.String()] {
+				changes = append(changes, selectionChange{policy: id, endpoint: ep, selected: false})
+				// Look the endpoint up in the new state, so that its flows are evaluated
+				// against both.
+				for _, a := range ep.Addrs {
+					if afterEP := after.EndpointByAddr(a); afterEP != nil {
+						addAffected(afterEP)
+						break
+					}
+				}
+			}
+		}
+	}
+	return changes, affected
+}
+
+type probe struct {
+	protocol uint8
+	port     uint16
+}
+
+// previewProbes returns the protocols and destination ports named by the rules of the
+// given policies, in either state.
+func previewProbes(before, after *policysim.Simulator, policyIDs []proto.PolicyID) []probe {
+	seen := map[probe]bool{}
+	var probes []probe
+	add := func(p probe) {
+		if !seen[p] {
+			seen[p] = true
+			probes = append(probes, p)
+		}
+	}
+	for _, rule := range previewRules(before, after, policyIDs) {
+		protocol := uint8(protoTCP)
+		if rule.Protocol != nil {
+			switch p := rule.Protocol.NumberOrName.(type) {
+			case *proto.Protocol_Number:
+				protocol = uint8(p.Number)
+			case *proto.Protocol_Name:
+				if num, ok := policysim.ProtocolNumber(p.Name); ok {
+					protocol = num
+				}
+			}
+		}
+		switch protocol {
+		case protoICMP:
+			add(probe{protocol: protocol, port: icmpEchoRequest})
+		case protoICMPv6:
+			add(probe{protocol: protocol, port: icmpv6EchoRequest})
+		default:
+			for _, r := range rule.DstPorts {
+				add(probe{protocol: protocol, port: uint16(r.First)})
+			}
+		}
+	}
+	if len(probes) == 0 {
+		add(probe{protocol: protoTCP, port: previewDefaultPort})
+	}
+	return probes
+}
+
+// previewExternalAddrs returns an address from each CIDR named in the rules of the given
+// policies, in either state.
+func previewExternalAddrs(before, after *policysim.Simulator, policyIDs []proto.PolicyID) []ip.Addr {
+	seen := map[ip.Addr]bool{}
+	var addrs []ip.Addr
+	for _, rule := range previewRules(before, after, policyIDs) {
+		for _, nets := range [][]string{rule.SrcNet, rule.DstNet} {
+			for _, n := range nets {
+				cidr, err := ip.ParseCIDROrIP(n)
+				if err != nil {
+					continue
+				}
+				addr := cidr.Addr()
+				if !seen[addr] && before.EndpointByAddr(addr) == nil && after.EndpointByAddr(addr) == nil {
+					seen[addr] = true
+					addrs = append(addrs, addr)
+				}
+			}
+		}
+	}
+	return addrs
+}
+
+// previewRules returns the rules of the given policies in both states.
+func previewRules(before, after *policysim.Simulator, policyIDs []proto.PolicyID) []*proto.Rule {
+	var rules []*proto.Rule
+	for _, sim := range []*policysim.Simulator{before, after} {
+		for _, id := range policyIDs {
+			if p := sim.Policy(id); p != nil {
+				rules = append(rules, p.InboundRules...)
+				rules = append(rules, p.OutboundRules...)
+			}
+		}
+	}
+	return rules
+}
+
+type flowChange struct {
+	src, dst      string
+	packet        policysim.Packet
+	before, after policysim.FlowResult
+}
+
+// previewFlows evaluates the flows to and from the affected endpoints in both states and
+// returns those that change verdict.
+func previewFlows(before, after *policysim.Simulator, affected []*policysim.Endpoint, externalAddrs []ip.Addr, probes []probe) []flowChange {
+	type peer struct {
+		name  string
+		addrs []ip.Addr
+		ports []model.EndpointPort
+	}
+	var peers []peer
+	for _, ep := range after.Endpoints() {
+		peers = append(peers, peer{name: endpointName(ep), addrs: ep.Addrs, ports: ep.Ports})
+	}
+	for _, addr := range externalAddrs {
+		peers = append(peers, peer{name: addr.String(), addrs: []ip.Addr{addr}})
+	}
+
+	var changes []flowChange
+	seen := map[policysim.Packet]bool{}
+	evaluate := func(src, dst peer) {
+		srcAddr, dstAddr := pickAddrs(src.addrs, dst.addrs)
+		if srcAddr == nil || srcAddr == dstAddr {
+			return
+		}
+		dstProbes := append([]probe{}, probes...)
+		for _, p := range dst.ports {
+			if num, ok := policysim.ProtocolNumber(p.Protocol.String()); ok {
+				dstProbes = append(dstProbes, probe{protocol: num, port: p.Port})
+			}
+		}
+		for _, pr := range dstProbes {
+			pkt := policysim.Packet{SrcAddr: srcAddr, DstAddr: dstAddr, Protocol: pr.protocol}
+			switch pr.protocol {
+			case protoICMP, protoICMPv6:
+				pkt.ICMPType = uint8(pr.port)
+			default:
+				pkt.SrcPort = previewSrcPort
+				pkt.DstPort = pr.port
+			}
+			if seen[pkt] {
+				continue
+			}
+			seen[pkt] = true
+			resultBefore := before.EvaluateFlow(pkt)
+			resultAfter := after.EvaluateFlow(pkt)
+			if resultBefore.Allowed() != resultAfter.Allowed() {
+				changes = append(changes, flowChange{
+					src: src.name, dst: dst.name, packet: pkt, before: resultBefore, after: resultAfter,
+				})
+			}
+		}
+	}
+	for _, ep := range affected {
+		self := peer{name: endpointName(ep), addrs: ep.Addrs, ports: ep.Ports}
+		for _, other := range peers {
+			evaluate(self, other)
+			evaluate(other, self)
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].src != changes[j].src {
+			return changes[i].src < changes[j].src
+		}
+		return changes[i].dst < changes[j].dst
+	})
+	return changes
+}
+
+// pickAddrs picks a source and destination address of the same IP version, preferring IPv4.
+func pickAddrs(srcAddrs, dstAddrs []ip.Addr) (ip.Addr, ip.Addr) {
+	for _, version := range []uint8{4, 6} {
+		var src, dst ip.Addr
+		for _, a := range srcAddrs {
+			if a.Version() == version {
+				src = a
+				break
+			}
+		}
+		for _, a := range dstAddrs {
+			if a.Version() == version {
+				dst = a
+				break
+			}
+		}
+		if src != nil && dst != nil {
+			return src, dst
+		}
+	}
+	return nil, nil
+}
+
+// endpointName returns the name to display for an endpoint.
+func endpointName(ep *policysim.Endpoint) string {
+	switch key := ep.Key.(type) {
+	case model.WorkloadEndpointKey:
+		return fmt.Sprintf("%s (%s)", key.WorkloadID, key.EndpointID)
+	case model.HostEndpointKey:
+		return "hostendpoint/" + key.EndpointID
+	}
+	return ep.Key.String()
+}
+
+func endpointAddrs(ep *policysim.Endpoint) string {
+	var addrs []string
+	for _, a := range ep.Addrs {
+		addrs = append(addrs, a.String())
+	}
+	return strings.Join(addrs, ", ")
+}
+
+func printSelectionChanges(changes []selectionChange) {
+	if len(changes) == 0 {
+		fmt.Println("No endpoints are newly selected or deselected.")
+		return
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"TIER", "POLICY", "ENDPOINT", "ADDRESSES", "CHANGE"})
+	for _, c := range changes {
+		change := "no longer selected"
+		if c.selected {
+			change = "newly selected"
+		}
+		table.Append([]string{c.policy.Tier, c.policy.Name, endpointName(c.endpoint), endpointAddrs(c.endpoint), change})
+	}
+	table.Render()
+}
+
+func printFlowChanges(changes []flowChange) {
+	if len(changes) == 0 {
+		fmt.Println("No simulated flows change verdict.")
+		return
+	}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"SOURCE", "DESTINATION", "PROTOCOL", "PORT", "BEFORE", "AFTER", "DECIDED BY"})
+	for _, c := range changes {
+		port := fmt.Sprint(c.packet.DstPort)
+		if c.packet.Protocol == protoICMP || c.packet.Protocol == protoICMPv6 {
+			port = fmt.Sprintf("type %d", c.packet.ICMPType)
+		}
+		decidedBy := ""
+		if v := c.after.Decision(); v != nil {
+			decidedBy = v.Reason
+		}
+		table.Append([]string{
+			c.src, c.dst, protocolDisplayName(c.packet.Protocol), port,
+			verdictName(c.before), verdictName(c.after), decidedBy,
+		})
+	}
+	table.Render()
+}
+
+func verdictName(r policysim.FlowResult) string {
+	if r.Allowed() {
+		return string(policysim.ActionAllow)
+	}
+	return string(policysim.ActionDeny)
+}
+
+func protocolDisplayName(num uint8) string {
+	if name := policysim.ProtocolName(num); name != "" {
+		return strings.ToUpper(name)
+	}
+	return fmt.Sprint(num)
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/projectcalico/api/pkg/lib/numorstring"
+
+	"github.com/projectcalico/calico/calicoctl/calicoctl/resourcemgr"
+	"github.com/projectcalico/calico/felix/policysim"
+	"github.com/projectcalico/calico/felix/proto"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+var _ = Describe("Policy preview", func() {
+	tcp := numorstring.ProtocolFromString("TCP")
+	tcpV1 := numorstring.ProtocolFromStringV1("tcp")
+
+	allowClient := api.NewNetworkPolicy()
+	allowClient.Name = "allow-client"
+	allowClient.Namespace = "ns1"
+	allowClient.Spec.Selector = "app == 'server'"
+	allowClient.Spec.Ingress = []api.Rule{{
+		Action:      api.Allow,
+		Protocol:    &tcp,
+		Source:      api.EntityRule{Selector: "app == 'client'"},
+		Destination: api.EntityRule{Ports: []numorstring.Port{numorstring.SinglePort(8080)}},
+	}}
+
+	datastoreUpdates := func() []bapi.Update {
+		update := func(key model.Key, value interface{}) bapi.Update {
+			return bapi.Update{KVPair: model.KVPair{Key: key, Value: value}, UpdateType: bapi.UpdateTypeKVNew}
+		}
+		wep := func(name, addr string) bapi.Update {
+			return update(model.WorkloadEndpointKey{
+				Hostname:       "node1",
+				OrchestratorID: "k8s",
+				WorkloadID:     "ns1/" + name,
+				EndpointID:     "eth0",
+			}, &model.WorkloadEndpoint{
+				State:      "active",
+				Name:       "cali" + name,
+				IPv4Nets:   []net.IPNet{net.MustParseCIDR(addr + "/32")},
+				Labels:     map[string]string{"app": name, "projectcalico.org/namespace": "ns1"},
+				ProfileIDs: []string{"kns.ns1"},
+				Ports:      []model.EndpointPort{{Name: "metrics", Protocol: tcpV1, Port: 9090}},
+			})
+		}
+		profileKey := model.ProfileKey{Name: "kns.ns1"}
+		return []bapi.Update{
+			update(model.ProfileRulesKey{ProfileKey: profileKey}, &model.ProfileRules{
+				InboundRules:  []model.Rule{{Action: "allow"}},
+				OutboundRules: []model.Rule{{Action: "allow"}},
+			}),
+			update(model.ProfileLabelsKey{ProfileKey: profileKey}, map[string]string{
+				"pcns.projectcalico.org/name": "ns1",
+			}),
+			wep("client", "10.65.0.1"),
+			wep("server", "10.65.0.2"),
+			wep("other", "10.65.0.3"),
+		}
+	}
+
+	It("should convert resources to the updates that Felix would receive", func() {
+		staged := api.NewStagedGlobalNetworkPolicy()
+		staged.Name = "deny-all"
+		staged.Spec.StagedAction = api.StagedActionDelete
+
+		updates, err := ResourceUpdates([]resourcemgr.ResourceObject{allowClient, staged})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updates).To(HaveLen(2))
+
+		Expect(updates[0].Key).To(Equal(model.PolicyKey{Tier: "default", Name: "ns1/default.allow-client"}))
+		Expect(updates[0].UpdateType).To(Equal(bapi.UpdateTypeKVUpdated))
+		Expect(updates[0].Value.(*model.Policy).Types).To(Equal([]string{"ingress"}))
+
+		Expect(updates[1].Key).To(Equal(model.PolicyKey{Tier: "default", Name: "default.deny-all"}))
+		Expect(updates[1].UpdateType).To(Equal(bapi.UpdateTypeKVDeleted))
+		Expect(updates[1].Value).To(BeNil())
+	})
+
+	It("should reject resources that it can't preview", func() {
+		_, err := ResourceUpdates([]resourcemgr.ResourceObject{api.NewIPPool()})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should report the newly selected endpoints and the flows that change verdict", func() {
+		fileUpdates, err := ResourceUpdates([]resourcemgr.ResourceObject{allowClient})
+		Expect(err).NotTo(HaveOccurred())
+
+		before := policysim.New()
+		before.OnUpdates(datastoreUpdates())
+		after := policysim.New()
+		after.OnUpdates(datastoreUpdates())
+		after.OnUpdates(fileUpdates)
+		policyIDs := []proto.PolicyID{{Tier: "default", Name: "ns1/default.allow-client"}}
+
+		changes, affected := previewSelection(before, after, policyIDs)
+		Expect(changes).To(HaveLen(1))
+		Expect(changes[0].selected).To(BeTrue())
+		Expect(endpointName(changes[0].endpoint)).To(Equal("ns1/server (eth0)"))
+		Expect(affected).To(HaveLen(1))
+
+		probes := previewProbes(before, after, policyIDs)
+		Expect(probes).To(Equal([]probe{{protocol: protoTCP, port: 8080}}))
+
+		flows := previewFlows(before, after, affected, nil, probes)
+		var summaries []string
+		for _, f := range flows {
+			Expect(f.before.Allowed()).To(BeTrue())
+			Expect(f.after.Allowed()).To(BeFalse())
+			Expect(f.after.Decision().Tier).To(Equal("default"))
+			summaries = append(summaries, f.src+" -> "+f.dst+" "+protocolDisplayName(f.packet.Protocol))
+		}
+		// The client is still allowed to port 8080; everything else to the server is now
+		// denied, including the metrics named port.
+		Expect(summaries).To(ConsistOf(
+			"ns1/client (eth0) -> ns1/server (eth0) TCP",
+			"ns1/other (eth0) -> ns1/server (eth0) TCP",
+			"ns1/other (eth0) -> ns1/server (eth0) TCP",
+		))
+		for _, f := range flows {
+			if f.src == "ns1/client (eth0)" {
+				Expect(f.packet.DstPort).To(BeEquivalentTo(9090))
+			}
+		}
+	})
+})
@@ -0,0 +1,342 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docopt/docopt-go"
+	"github.com/olekukonko/tablewriter"
+
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/argutils"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/clientmgr"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/common"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/constants"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/util"
+	"github.com/projectcalico/calico/felix/ip"
+	"github.com/projectcalico/calico/felix/policysim"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+)
+
+// Trace reports the policy and rule that decide a flow between two endpoints.
+func Trace(args []string) error {
+	doc := constants.DatastoreIntro + `Usage:
+  <BINARY_NAME> trace --src=<SRC> --dst=<DST> [--protocol=<PROTOCOL>] [--port=<PORT>]
+                [--src-port=<PORT>] [--icmp-type=<TYPE>] [--icmp-code=<CODE>]
+                [--namespace=<NS>] [--filename=<FILENAME>] [--config=<CONFIG>]
+                [--context=<context>] [--allow-version-mismatch]
+
+Examples:
+  # Trace a TCP connection from a pod to port 8080 of another pod.
+  <BINARY_NAME> trace --src=dev/frontend-5d8f --dst=prod/backend-7c9b --port=8080
+
+  # Trace DNS traffic from a pod to an external resolver.
+  <BINARY_NAME> trace --src=frontend-5d8f -n dev --dst=8.8.8.8 --protocol=udp --port=53
+
+Options:
+  -h --help                    Show this screen.
+     --src=<SRC>               The source of the flow: a workload, as
+                               <namespace>/<name> or <name>, a host endpoint, as
+                               hostendpoint/<name>, or an IP address.
+     --dst=<DST>               The destination of the flow, in the same format as
+                               --src.
+     --protocol=<PROTOCOL>     The protocol of the flow, as a name or a number.
+                               [default: tcp]
+     --port=<PORT>             The destination port of the flow.  Required for
+                               TCP, UDP and SCTP.
+     --src-port=<PORT>         The source port of the flow.  [default: 50000]
+     --icmp-type=<TYPE>        The ICMP type of the flow.  [default: 8]
+     --icmp-code=<CODE>        The ICMP code of the flow.  [default: 0]
+  -n --namespace=<NS>          Namespace of workloads given as <name>, and of the
+                               namespaced resources in --filename that do not
+                               specify one.  Uses the default namespace if not
+                               specified.
+  -f --filename=<FILENAME>     Trace the flow as if the policies in the given
+                               file, directory or stdin ("-") were applied.
+  -c --config=<CONFIG>         Path to the file containing connection
+                               configuration in YAML or JSON format.
+                               [default: ` + constants.DefaultConfigPath + `]
+     --context=<context>       The name of the kubeconfig context to use.
+     --allow-version-mismatch  Allow client and cluster versions mismatch.
+
+Description:
+  The trace command reports whether Calico policy allows a flow, and the exact
+  policy and rule that decides it in each direction.  It loads the current
+  endpoints, namespaces, profiles and policies from the datastore, calculates
+  the policy for the source and destination in the same way as Felix, and
+  follows the flow through the policy that applies to it, in order:
+
+    * on the source host, the source workload's egress policy and profiles,
+      followed by the applyOnForward egress policy of the host's host endpoint;
+      or, for traffic from a host, its host endpoint's doNotTrack and normal
+      egress policy
+    * on the destination host, the doNotTrack, preDNAT and then applyOnForward
+      ingress policy of the host's host endpoint (or its normal ingress policy
+      for traffic to the host itself), followed by the destination workload's
+      ingress policy and profiles.
+
+  Host endpoint policy does not apply to traffic between endpoints on the same
+  host.  Where a host has several host endpoints, its all-interfaces host
+  endpoint is used, if it has one.  Failsafe rules and DNAT by services are not
+  simulated.
+`
+	// Replace all instances of BINARY_NAME with the name of the binary.
+	name, _ := util.NameAndDescription()
+	doc = strings.ReplaceAll(doc, "<BINARY_NAME>", name)
+
+	parsedArgs, err := docopt.ParseArgs(doc, args, "")
+	if err != nil {
+		return fmt.Errorf("Invalid option: 'calicoctl %s'. Use flag '--help' to read about a specific subcommand.", strings.Join(args, " "))
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+	if context := parsedArgs["--context"]; context != nil {
+		os.Setenv("K8S_CURRENT_CONTEXT", context.(string))
+	}
+
+	pkt, err := tracePacketFromArgs(parsedArgs)
+	if err != nil {
+		return err
+	}
+
+	err = common.CheckVersionMismatch(parsedArgs["--config"], parsedArgs["--allow-version-mismatch"])
+	if err != nil {
+		return err
+	}
+
+	var fileUpdates []bapi.Update
+	if parsedArgs["--filename"] != nil {
+		resources, err := common.LoadResources(parsedArgs)
+		if err != nil {
+			return fmt.Errorf("Failed to load resources: %v", err)
+		}
+		if fileUpdates, err = ResourceUpdates(resources); err != nil {
+			return fmt.Errorf("Invalid resource in file: %v", err)
+		}
+	}
+
+	cf := parsedArgs["--config"].(string)
+	cfg, err := clientmgr.LoadClientConfig(cf)
+	if err != nil {
+		return err
+	}
+	client, err := clientmgr.NewClientFromConfig(cfg)
+	if err != nil {
+		return err
+	}
+	type accessor interface {
+		Backend() bapi.Client
+	}
+	bc := client.(accessor).Backend()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	datastoreUpdates, err := DatastoreUpdates(ctx, cfg, bc)
+	if err != nil {
+		return err
+	}
+
+	sim := policysim.New()
+	sim.OnUpdates(datastoreUpdates)
+	sim.OnUpdates(fileUpdates)
+
+	namespace := argutils.ArgStringOrBlank(parsedArgs, "--namespace")
+	srcAddrs, err := traceAddrs(sim, parsedArgs["--src"].(string), namespace)
+	if err != nil {
+		return err
+	}
+	dstAddrs, err := traceAddrs(sim, parsedArgs["--dst"].(string), namespace)
+	if err != nil {
+		return err
+	}
+	pkt.SrcAddr, pkt.DstAddr = pickAddrs(srcAddrs, dstAddrs)
+	if pkt.SrcAddr == nil {
+		return fmt.Errorf("%s and %s have no addresses of the same IP version", parsedArgs["--src"], parsedArgs["--dst"])
+	}
+
+	printTrace(pkt, sim.TracePacket(pkt))
+	return nil
+}
+
+// tracePacketFromArgs returns the packet described by the arguments, without its addresses.
+func tracePacketFromArgs(parsedArgs map[string]interface{}) (policysim.Packet, error) {
+	var pkt policysim.Packet
+	protocol := parsedArgs["--protocol"].(string)
+	if num, ok := policysim.ProtocolNumber(protocol); ok {
+		pkt.Protocol = num
+	} else if num, err := strconv.ParseUint(protocol, 10, 8); err == nil {
+		pkt.Protocol = uint8(num)
+	} else {
+		return pkt, fmt.Errorf("invalid protocol %q", protocol)
+	}
+
+	switch pkt.Protocol {
+	case protoICMP, protoICMPv6:
+		icmpType, err := strconv.ParseUint(parsedArgs["--icmp-type"].(string), 10, 8)
+		if err != nil {
+			return pkt, fmt.Errorf("invalid ICMP type: %v", err)
+		}
+		icmpCode, err := strconv.ParseUint(parsedArgs["--icmp-code"].(string), 10, 8)
+		if err != nil {
+			return pkt, fmt.Errorf("invalid ICMP code: %v", err)
+		}
+		pkt.ICMPType, pkt.ICMPCode = uint8(icmpType), uint8(icmpCode)
+	default:
+		if port := parsedArgs["--port"]; port != nil {
+			dstPort, err := strconv.ParseUint(port.(string), 10, 16)
+			if err != nil {
+				return pkt, fmt.Errorf("invalid port: %v", err)
+			}
+			pkt.DstPort = uint16(dstPort)
+		} else if protocolHasPorts(pkt.Protocol) {
+			return pkt, fmt.Errorf("--port is required for protocol %s", protocol)
+		}
+		srcPort, err := strconv.ParseUint(parsedArgs["--src-port"].(string), 10, 16)
+		if err != nil {
+			return pkt, fmt.Errorf("invalid source port: %v", err)
+		}
+		pkt.SrcPort = uint16(srcPort)
+	}
+	return pkt, nil
+}
+
+func protocolHasPorts(protocol uint8) bool {
+	switch policysim.ProtocolName(protocol) {
+	case "tcp", "udp", "sctp":
+		return true
+	}
+	return false
+}
+
+// traceAddrs returns the addresses of the workload, host endpoint or IP address given to
+// --src or --dst.
+func traceAddrs(sim *policysim.Simulator, spec, namespace string) ([]ip.Addr, error) {
+	if addr := ip.FromString(spec); addr != nil {
+		return []ip.Addr{addr}, nil
+	}
+
+	var matches []*policysim.Endpoint
+	if hepName := strings.TrimPrefix(spec, "hostendpoint/"); hepName != spec {
+		for _, ep := range sim.Endpoints() {
+			if key, ok := ep.Key.(model.HostEndpointKey); ok && key.EndpointID == hepName {
+				matches = append(matches, ep)
+			}
+		}
+	} else {
+		workloadID := spec
+		if !strings.Contains(spec, "/") {
+			if namespace == "" {
+				namespace = "default"
+			}
+			workloadID = namespace + "/" + spec
+		}
+		for _, ep := range sim.Endpoints() {
+			if key, ok := ep.Key.(model.WorkloadEndpointKey); ok && (key.WorkloadID == workloadID || key.WorkloadID == spec) {
+				matches = append(matches, ep)
+			}
+		}
+	}
+
+	switch {
+	case len(matches) == 0:
+		return nil, fmt.Errorf("no endpoint found for %s", spec)
+	case len(matches) > 1:
+		var names []string
+		for _, ep := range matches {
+			names = append(names, endpointName(ep))
+		}
+		return nil, fmt.Errorf("%s matches several endpoints (%s); use an IP address to pick one", spec, strings.Join(names, ", "))
+	case len(matches[0].Addrs) == 0:
+		return nil, fmt.Errorf("endpoint %s has no addresses", endpointName(matches[0]))
+	}
+	return matches[0].Addrs, nil
+}
+
+func printTrace(pkt policysim.Packet, t policysim.Trace) {
+	fmt.Printf("Tracing %s\n", pkt)
+	fmt.Printf("  from %s\n", traceEndpointName(t.Src, pkt.SrcAddr))
+	fmt.Printf("  to   %s\n", traceEndpointName(t.Dst, pkt.DstAddr))
+
+	for _, side := range []struct {
+		title string
+		ep    *policysim.Endpoint
+		addr  ip.Addr
+		steps []policysim.TraceStep
+	}{
+		{"Egress", t.Src, pkt.SrcAddr, t.Egress},
+		{"Ingress", t.Dst, pkt.DstAddr, t.Ingress},
+	} {
+		fmt.Println()
+		if side.ep == nil {
+			fmt.Printf("%s: not policed, %s is not a Calico endpoint.\n", side.title, side.addr)
+			continue
+		}
+		if len(side.steps) == 0 {
+			fmt.Printf("%s: not evaluated, the flow was denied on egress.\n", side.title)
+			continue
+		}
+		fmt.Printf("%s on host %s:\n", side.title, side.ep.Hostname)
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"ENDPOINT", "CHAIN", "VERDICT", "REASON"})
+		for _, step := range side.steps {
+			table.Append([]string{
+				endpointName(step.Endpoint),
+				traceChainName(step.Chain),
+				string(step.Verdict.Action),
+				step.Verdict.Reason,
+			})
+		}
+		table.Render()
+	}
+
+	fmt.Println()
+	if t.Allowed() {
+		fmt.Println("Result: allowed")
+	} else {
+		fmt.Println("Result: denied")
+	}
+	if step := t.EgressDecision(); step != nil {
+		fmt.Printf("  egress:  %s (%s)\n", step.Verdict.Reason, endpointName(step.Endpoint))
+	}
+	if step := t.IngressDecision(); step != nil {
+		fmt.Printf("  ingress: %s (%s)\n", step.Verdict.Reason, endpointName(step.Endpoint))
+	}
+}
+
+func traceEndpointName(ep *policysim.Endpoint, addr ip.Addr) string {
+	if ep == nil {
+		return addr.String()
+	}
+	return fmt.Sprintf("%s %s on host %s", endpointName(ep), addr, ep.Hostname)
+}
+
+func traceChainName(chain policysim.Chain) string {
+	switch chain {
+	case policysim.ChainUntracked:
+		return "doNotTrack"
+	case policysim.ChainPreDNAT:
+		return "preDNAT"
+	case policysim.ChainForward:
+		return "applyOnForward"
+	}
+	return "normal"
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/ip"
+	"github.com/projectcalico/calico/felix/policysim"
+	bapi "github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+var _ = Describe("Trace", func() {
+	It("should parse the packet from the arguments", func() {
+		pkt, err := tracePacketFromArgs(map[string]interface{}{
+			"--protocol": "UDP", "--port": "53", "--src-port": "50000", "--icmp-type": "8", "--icmp-code": "0",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pkt).To(Equal(policysim.Packet{Protocol: 17, SrcPort: 50000, DstPort: 53}))
+
+		pkt, err = tracePacketFromArgs(map[string]interface{}{
+			"--protocol": "icmp", "--port": nil, "--src-port": "50000", "--icmp-type": "3", "--icmp-code": "1",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pkt).To(Equal(policysim.Packet{Protocol: 1, ICMPType: 3, ICMPCode: 1}))
+
+		_, err = tracePacketFromArgs(map[string]interface{}{
+			"--protocol": "tcp", "--port": nil, "--src-port": "50000", "--icmp-type": "8", "--icmp-code": "0",
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should resolve workloads, host endpoints and addresses", func() {
+		sim := policysim.New()
+		sim.OnUpdates([]bapi.Update{
+			{
+				KVPair: model.KVPair{
+					Key: model.WorkloadEndpointKey{
+						Hostname: "node1", OrchestratorID: "k8s", WorkloadID: "ns1/client", EndpointID: "eth0",
+					},
+					Value: &model.WorkloadEndpoint{
+						State:    "active",
+						Name:     "caliclient",
+						IPv4Nets: []net.IPNet{net.MustParseCIDR("10.65.0.1/32")},
+					},
+				},
+				UpdateType: bapi.UpdateTypeKVNew,
+			},
+			{
+				KVPair: model.KVPair{
+					Key: model.HostEndpointKey{Hostname: "node1", EndpointID: "node1-eth0"},
+					Value: &model.HostEndpoint{
+						Name:              "eth0",
+						ExpectedIPv4Addrs: []net.IP{net.MustParseIP("192.168.0.1")},
+					},
+				},
+				UpdateType: bapi.UpdateTypeKVNew,
+			},
+		})
+
+		for _, tc := range []struct {
+			spec, namespace, addr string
+		}{
+			{"ns1/client", "", "10.65.0.1"},
+			{"client", "ns1", "10.65.0.1"},
+			{"hostendpoint/node1-eth0", "", "192.168.0.1"},
+			{"8.8.8.8", "", "8.8.8.8"},
+		} {
+			addrs, err := traceAddrs(sim, tc.spec, tc.namespace)
+			Expect(err).NotTo(HaveOccurred(), tc.spec)
+			Expect(addrs).To(Equal([]ip.Addr{ip.FromString(tc.addr)}), tc.spec)
+		}
+
+		_, err := traceAddrs(sim, "client", "")
+		Expect(err).To(HaveOccurred())
+	})
+})
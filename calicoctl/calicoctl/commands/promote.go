@@ -0,0 +1,160 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	docopt "github.com/docopt/docopt-go"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/argutils"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/common"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/commands/constants"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/resourcemgr"
+	"github.com/projectcalico/calico/calicoctl/calicoctl/util"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+)
+
+func Promote(args []string) error {
+	doc := constants.DatastoreIntro + `Usage:
+  <BINARY_NAME> promote <KIND> <NAME> [--config=<CONFIG>] [--namespace=<NS>]
+                [--keep-staged] [--context=<context>] [--allow-version-mismatch]
+
+Examples:
+  # Promote a staged network policy in namespace "dev".
+  <BINARY_NAME> promote stagednetworkpolicy allow-frontend --namespace=dev
+
+  # Promote a staged global network policy, leaving the staged policy in place.
+  <BINARY_NAME> promote stagedglobalnetworkpolicy deny-all --keep-staged
+
+Options:
+  -h --help                    Show this screen.
+  -c --config=<CONFIG>         Path to the file containing connection
+                               configuration in YAML or JSON format.
+                               [default: ` + constants.DefaultConfigPath + `]
+  -n --namespace=<NS>          Namespace of the resource.
+                               Only applicable to StagedNetworkPolicy.
+                               Uses the default namespace if not specified.
+     --keep-staged             Do not delete the staged policy once it has been
+                               promoted.
+     --context=<context>       The name of the kubeconfig context to use.
+     --allow-version-mismatch  Allow client and cluster versions mismatch.
+
+Description:
+  The promote command turns a staged policy into an enforced policy.  Resource
+  types that can be promoted are:
+
+    * stagedGlobalNetworkPolicy
+    * stagedNetworkPolicy
+
+  A staged policy with a stagedAction of "Set" (the default) creates or replaces
+  the NetworkPolicy or GlobalNetworkPolicy with the same name.  A staged policy
+  with a stagedAction of "Delete" deletes it.
+
+  Once promoted, the staged policy is deleted unless --keep-staged is specified.
+`
+	// Replace all instances of BINARY_NAME with the name of the binary.
+	name, _ := util.NameAndDescription()
+	doc = strings.ReplaceAll(doc, "<BINARY_NAME>", name)
+
+	parsedArgs, err := docopt.ParseArgs(doc, args, "")
+	if err != nil {
+		return fmt.Errorf("Invalid option: 'calicoctl %s'. Use flag '--help' to read about a specific subcommand.", strings.Join(args, " "))
+	}
+	if len(parsedArgs) == 0 {
+		return nil
+	}
+	if context := parsedArgs["--context"]; context != nil {
+		os.Setenv("K8S_CURRENT_CONTEXT", context.(string))
+	}
+
+	log.Debugf("parse args: %+v\n", parsedArgs)
+	kind := parsedArgs["<KIND>"].(string)
+	policyName := parsedArgs["<NAME>"].(string)
+
+	results := common.ExecuteConfigCommand(parsedArgs, common.ActionGetOrList)
+	if results.FileInvalid {
+		return fmt.Errorf("Failed to execute command: %v", results.Err)
+	} else if results.Err != nil {
+		return fmt.Errorf("failed to get %s %s, error %v", kind, policyName, results.Err)
+	} else if len(results.Resources) == 0 {
+		return fmt.Errorf("%s %s not found", kind, policyName)
+	}
+
+	staged := results.Resources[0].(resourcemgr.ResourceObject)
+	var stagedAction api.StagedAction
+	var enforced resourcemgr.ResourceObject
+	switch p := staged.(type) {
+	case *api.StagedNetworkPolicy:
+		var np *api.NetworkPolicy
+		stagedAction, np = api.ConvertStagedPolicyToEnforced(p)
+		np.ObjectMeta = promotedObjectMeta(p.ObjectMeta)
+		enforced = np
+	case *api.StagedGlobalNetworkPolicy:
+		var gnp *api.GlobalNetworkPolicy
+		stagedAction, gnp = api.ConvertStagedGlobalPolicyToEnforced(p)
+		gnp.ObjectMeta = promotedObjectMeta(p.ObjectMeta)
+		enforced = gnp
+	default:
+		return fmt.Errorf("resource type %s cannot be promoted", kind)
+	}
+
+	enforcedKind := enforced.GetObjectKind().GroupVersionKind().Kind
+	client := results.Client
+	if stagedAction == api.StagedActionDelete {
+		_, err = common.ExecuteResourceAction(parsedArgs, client, enforced, common.ActionDelete)
+		if _, ok := err.(cerrors.ErrorResourceDoesNotExist); ok {
+			log.Infof("%s %s does not exist, nothing to delete", enforcedKind, policyName)
+			err = nil
+		}
+	} else {
+		_, err = common.ExecuteResourceAction(parsedArgs, client, enforced, common.ActionApply)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to promote %s %s: %v", kind, policyName, err)
+	}
+
+	if !argutils.ArgBoolOrFalse(parsedArgs, "--keep-staged") {
+		_, err = common.ExecuteResourceAction(parsedArgs, client, staged, common.ActionDelete)
+		if err != nil {
+			return fmt.Errorf("promoted %s %s but failed to delete it: %v", kind, policyName, err)
+		}
+	}
+
+	if stagedAction == api.StagedActionDelete {
+		fmt.Printf("Successfully promoted %s %s, deleted %s\n", kind, policyName, enforcedKind)
+	} else {
+		fmt.Printf("Successfully promoted %s %s to %s\n", kind, policyName, enforcedKind)
+	}
+	return nil
+}
+
+// promotedObjectMeta returns the metadata to use for the enforced policy created from a
+// staged policy.  Only the identity, labels and annotations carry over; everything else
+// is owned by the datastore.
+func promotedObjectMeta(staged metav1.ObjectMeta) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        staged.Name,
+		Namespace:   staged.Namespace,
+		Labels:      staged.Labels,
+		Annotations: staged.Annotations,
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcemgr
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	client "github.com/projectcalico/calico/libcalico-go/lib/clientv3"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+)
+
+func init() {
+	registerResource(
+		api.NewPacketCapture(),
+		newPacketCaptureList(),
+		true,
+		[]string{"packetcapture", "packetcaptures", "pcap", "pcaps"},
+		[]string{"NAME", "SELECTOR"},
+		[]string{"NAME", "SELECTOR", "FILTER"},
+		map[string]string{
+			"NAME":      "{{.ObjectMeta.Name}}",
+			"NAMESPACE": "{{.ObjectMeta.Namespace}}",
+			"SELECTOR":  "{{.Spec.Selector}}",
+			"FILTER":    "{{.Spec.Filter}}",
+		},
+		func(ctx context.Context, client client.Interface, resource ResourceObject) (ResourceObject, error) {
+			r := resource.(*api.PacketCapture)
+			return client.PacketCaptures().Create(ctx, r, options.SetOptions{})
+		},
+		func(ctx context.Context, client client.Interface, resource ResourceObject) (ResourceObject, error) {
+			r := resource.(*api.PacketCapture)
+			return client.PacketCaptures().Update(ctx, r, options.SetOptions{})
+		},
+		func(ctx context.Context, client client.Interface, resource ResourceObject) (ResourceObject, error) {
+			r := resource.(*api.PacketCapture)
+			return client.PacketCaptures().Delete(ctx, r.Namespace, r.Name, options.DeleteOptions{ResourceVersion: r.ResourceVersion})
+		},
+		func(ctx context.Context, client client.Interface, resource ResourceObject) (ResourceObject, error) {
+			r := resource.(*api.PacketCapture)
+			return client.PacketCaptures().Get(ctx, r.Namespace, r.Name, options.GetOptions{ResourceVersion: r.ResourceVersion})
+		},
+		func(ctx context.Context, client client.Interface, resource ResourceObject) (ResourceListObject, error) {
+			r := resource.(*api.PacketCapture)
+			return client.PacketCaptures().List(ctx, options.ListOptions{ResourceVersion: r.ResourceVersion, Namespace: r.Namespace, Name: r.Name})
+		},
+	)
+}
+
+// newPacketCaptureList creates a new (zeroed) PacketCaptureList struct with the TypeMetadata initialised to the current
+// version.
+func newPacketCaptureList() *api.PacketCaptureList {
+	return &api.PacketCaptureList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       api.KindPacketCaptureList,
+			APIVersion: api.GroupVersionCurrent,
+		},
+	}
+}
@@ -36,6 +36,8 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 
+	api "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
 	libapi "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
 	k8sconversion "github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/conversion"
 	k8sresources "github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/resources"
@@ -188,6 +190,8 @@ func CmdAddK8s(ctx context.Context, args *skel.CmdArgs, conf types.NetConf, epID
 	var profiles []string
 	var generateName string
 	var serviceAccount string
+	var qosControls *libapi.QoSControls
+	var egressGateway *api.EgressGatewaySpec
 
 	// Only attempt to fetch the labels and annotations from Kubernetes
 	// if the policy type has been set to "k8s". This allows users to
@@ -209,6 +213,19 @@ func CmdAddK8s(ctx context.Context, args *skel.CmdArgs, conf types.NetConf, epID
 		logger.WithField("ports", ports).Debug("Fetched K8s ports")
 		logger.WithField("profiles", profiles).Debug("Generated profiles")
 
+		// Reject invalid bandwidth and packet rate annotations up front; the datastore
+		// conversion ignores them rather than cutting the pod off from the network.
+		qosControls, err = k8sconversion.PodAnnotationsToQoSControls(annot)
+		if err != nil {
+			return nil, err
+		}
+		logger.WithField("qosControls", qosControls).Debug("Parsed QoS controls")
+
+		egressGateway, err = k8sconversion.AnnotationsToEgressGateway(annot)
+		if err != nil {
+			return nil, err
+		}
+
 		// Check for calico IPAM specific annotations and set them if needed.
 		if conf.IPAM.Type == "calico-ipam" {
 
@@ -364,6 +381,8 @@ func CmdAddK8s(ctx context.Context, args *skel.CmdArgs, conf types.NetConf, epID
 	endpoint.Spec.Ports = ports
 	endpoint.Spec.IPNetworks = []string{}
 	endpoint.Spec.ServiceAccountName = serviceAccount
+	endpoint.Spec.QoSControls = qosControls
+	endpoint.Spec.EgressGateway = egressGateway
 
 	// Set the profileID according to whether Kubernetes policy is required.
 	// If it's not, then just use the network name (which is the normal behavior)
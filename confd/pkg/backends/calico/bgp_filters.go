@@ -0,0 +1,174 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calico
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+// The BIRD templates render a pair of import and export functions for each BGPFilter (and IP
+// version) from these keys, and call them from the peerings that reference the filter.
+const (
+	filterKeyPrefixV4 = "/calico/bgp/v1/global/filters/v4/"
+	filterKeyPrefixV6 = "/calico/bgp/v1/global/filters/v6/"
+)
+
+// bgpFilterStatements holds the BIRD statements that make up the bodies of a BGPFilter's import
+// and export functions for one IP version.
+type bgpFilterStatements struct {
+	Import []string `json:"import"`
+	Export []string `json:"export"`
+}
+
+// updateBGPFilterLockHeld updates the cached BIRD statements for the named BGPFilter; filter
+// is nil if the BGPFilter has been deleted.  The caller should be holding the cacheLock.
+func (c *client) updateBGPFilterLockHeld(name string, filter *apiv3.BGPFilter) {
+	if filter == nil {
+		delete(c.bgpFilters, name)
+		c.setFilterKeyLockHeld(filterKeyPrefixV4+name, nil)
+		c.setFilterKeyLockHeld(filterKeyPrefixV6+name, nil)
+		return
+	}
+	c.bgpFilters[name] = filter
+	c.setFilterKeyLockHeld(filterKeyPrefixV4+name, &bgpFilterStatements{
+		Import: bgpFilterStatementsV4(filter.Spec.ImportV4),
+		Export: bgpFilterStatementsV4(filter.Spec.ExportV4),
+	})
+	c.setFilterKeyLockHeld(filterKeyPrefixV6+name, &bgpFilterStatements{
+		Import: bgpFilterStatementsV6(filter.Spec.ImportV6),
+		Export: bgpFilterStatementsV6(filter.Spec.ExportV6),
+	})
+}
+
+func (c *client) setFilterKeyLockHeld(k string, statements *bgpFilterStatements) {
+	if statements == nil {
+		if _, ok := c.cache[k]; ok {
+			delete(c.cache, k)
+			c.keyUpdated(k)
+		}
+		return
+	}
+	value, err := json.Marshal(statements)
+	if err != nil {
+		log.WithError(err).Errorf("Ignoring update: unable to serialize BGP filter %v", k)
+		return
+	}
+	if c.cache[k] == string(value) {
+		return
+	}
+	c.cache[k] = string(value)
+	c.keyUpdated(k)
+}
+
+// filtersForPeer returns the names of the BGPFilters that the BGPPeer references and that
+// exist; the functions for a missing filter aren't rendered so the peering mustn't call them.
+func (c *client) filtersForPeer(v3res *apiv3.BGPPeer) []string {
+	var filters []string
+	for _, name := range v3res.Spec.Filters {
+		if _, ok := c.bgpFilters[name]; !ok {
+			log.WithFields(log.Fields{"peer": v3res.Name, "filter": name}).Warning(
+				"BGPPeer references a BGPFilter that does not exist")
+			continue
+		}
+		filters = append(filters, name)
+	}
+	return filters
+}
+
+func bgpFilterStatementsV4(rules []apiv3.BGPFilterRuleV4) []string {
+	var statements []string
+	for _, r := range rules {
+		var min, max *int32
+		if r.PrefixLength != nil {
+			min, max = r.PrefixLength.Min, r.PrefixLength.Max
+		}
+		statements = append(statements, bgpFilterStatement(r.CIDR, r.MatchOperator, min, max, 32, r.Action))
+	}
+	return statements
+}
+
+func bgpFilterStatementsV6(rules []apiv3.BGPFilterRuleV6) []string {
+	var statements []string
+	for _, r := range rules {
+		var min, max *int32
+		if r.PrefixLength != nil {
+			min, max = r.PrefixLength.Min, r.PrefixLength.Max
+		}
+		statements = append(statements, bgpFilterStatement(r.CIDR, r.MatchOperator, min, max, 128, r.Action))
+	}
+	return statements
+}
+
+// bgpFilterStatement renders a single BGPFilter rule as a BIRD statement.  maxLen is the
+// maximum prefix length for the rule's IP version.
+func bgpFilterStatement(
+	cidr string,
+	op apiv3.BGPFilterMatchOperator,
+	min, max *int32,
+	maxLen int32,
+	action apiv3.BGPFilterAction,
+) string {
+	actionStatement := strings.ToLower(string(action)) + ";"
+	if cidr == "" {
+		return actionStatement
+	}
+
+	// The CIDR may be a bare IP, which BIRD doesn't accept as a prefix.
+	_, ipn, err := cnet.ParseCIDROrIP(cidr)
+	if err != nil {
+		log.WithError(err).WithField("cidr", cidr).Warning("Invalid BGPFilter CIDR, ignoring rule")
+		return "# Skipped rule with invalid CIDR " + cidr
+	}
+	cidr = ipn.String()
+
+	// BIRD's prefix pattern, "prefix{low,high}", matches routes within the prefix that have
+	// a prefix length in the given range.
+	pattern := cidr
+	if min != nil || max != nil {
+		cidrLen, _ := ipn.Mask.Size()
+		lo, hi := int32(cidrLen), maxLen
+		if min != nil {
+			lo = *min
+		}
+		if max != nil {
+			hi = *max
+		}
+		pattern = fmt.Sprintf("[ %s{%d,%d} ]", cidr, lo, hi)
+	}
+
+	var condition string
+	switch op {
+	case apiv3.MatchOperatorEqual:
+		condition = "net = " + cidr
+	case apiv3.MatchOperatorNotEqual:
+		condition = "net != " + cidr
+	case apiv3.MatchOperatorIn:
+		condition = "net ~ " + pattern
+	case apiv3.MatchOperatorNotIn:
+		condition = "net !~ " + pattern
+	default:
+		log.WithField("matchOperator", op).Warning("Unknown BGPFilter match operator, ignoring rule")
+		return "# Skipped rule with unknown match operator " + string(op)
+	}
+	return fmt.Sprintf("if ( %s ) then { %s }", condition, actionStatement)
+}
@@ -0,0 +1,53 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calico
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+)
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
+var _ = Describe("BGPFilter rendering", func() {
+	DescribeTable("should render rules as BIRD statements",
+		func(cidr string, op apiv3.BGPFilterMatchOperator, min, max *int32, maxLen int32, action apiv3.BGPFilterAction, expected string) {
+			Expect(bgpFilterStatement(cidr, op, min, max, maxLen, action)).To(Equal(expected))
+		},
+		Entry("rule without a CIDR", "", apiv3.BGPFilterMatchOperator(""), nil, nil, int32(32), apiv3.FilterActionAccept,
+			"accept;"),
+		Entry("Equal", "10.0.0.0/8", apiv3.MatchOperatorEqual, nil, nil, int32(32), apiv3.FilterActionReject,
+			"if ( net = 10.0.0.0/8 ) then { reject; }"),
+		Entry("NotEqual", "10.0.0.0/8", apiv3.MatchOperatorNotEqual, nil, nil, int32(32), apiv3.FilterActionAccept,
+			"if ( net != 10.0.0.0/8 ) then { accept; }"),
+		Entry("In", "10.0.0.0/8", apiv3.MatchOperatorIn, nil, nil, int32(32), apiv3.FilterActionAccept,
+			"if ( net ~ 10.0.0.0/8 ) then { accept; }"),
+		Entry("NotIn", "fd00::/64", apiv3.MatchOperatorNotIn, nil, nil, int32(128), apiv3.FilterActionReject,
+			"if ( net !~ fd00::/64 ) then { reject; }"),
+		Entry("In with a prefix length range", "10.0.0.0/8", apiv3.MatchOperatorIn, int32Ptr(16), int32Ptr(24), int32(32), apiv3.FilterActionAccept,
+			"if ( net ~ [ 10.0.0.0/8{16,24} ] ) then { accept; }"),
+		Entry("In with only a minimum prefix length", "10.0.0.0/8", apiv3.MatchOperatorIn, int32Ptr(16), nil, int32(32), apiv3.FilterActionAccept,
+			"if ( net ~ [ 10.0.0.0/8{16,32} ] ) then { accept; }"),
+		Entry("NotIn with only a maximum IPv6 prefix length", "fd00::/64", apiv3.MatchOperatorNotIn, nil, int32Ptr(96), int32(128), apiv3.FilterActionReject,
+			"if ( net !~ [ fd00::/64{64,96} ] ) then { reject; }"),
+		Entry("bare IP", "10.0.0.1", apiv3.MatchOperatorEqual, nil, nil, int32(32), apiv3.FilterActionReject,
+			"if ( net = 10.0.0.1/32 ) then { reject; }"),
+	)
+})
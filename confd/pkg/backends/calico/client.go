@@ -129,6 +129,7 @@ func NewCalicoClient(confdConfig *config.Config) (*client, error) {
 		nodeMeshEnabled:   nodeMeshEnabled,
 		nodeLabelManager:  newNodeLabelManager(),
 		bgpPeers:          make(map[string]*apiv3.BGPPeer),
+		bgpFilters:        make(map[string]*apiv3.BGPFilter),
 		sourceReady:       make(map[string]bool),
 		nodeListenPorts:   make(map[string]uint16),
 		globalBGPConfig:   cfg,
@@ -273,6 +274,7 @@ type client struct {
 	nodeV1Processor  watchersyncer.SyncerUpdateProcessor
 	nodeLabelManager nodeLabelManager
 	bgpPeers         map[string]*apiv3.BGPPeer
+	bgpFilters       map[string]*apiv3.BGPFilter
 	globalListenPort uint16
 	nodeListenPorts  map[string]uint16
 	nodeIPs          map[string]struct{}
@@ -429,6 +431,7 @@ type bgpPeer struct {
 	RestartTime     string               `json:"restart_time"`
 	CalicoNode      bool                 `json:"calico_node"`
 	NumAllowLocalAS int32                `json:"num_allow_local_as"`
+	Filters         []string             `json:"filters,omitempty"`
 }
 
 type bgpPrefix struct {
@@ -924,6 +927,22 @@ func (c *client) onUpdates(updates []api.Update, needUpdatePeersV1 bool) {
 			needUpdatePeersV1 = true
 			needUpdatePeersReasons = append(needUpdatePeersReasons, "BGP peer updated or deleted")
 		}
+
+		if v3key.Kind == apiv3.KindBGPFilter {
+			// Update the BIRD statements for the filter.
+			if u.Value == nil || u.UpdateType == api.UpdateTypeKVDeleted {
+				c.updateBGPFilterLockHeld(v3key.Name, nil)
+			} else if v3res, ok := u.Value.(*apiv3.BGPFilter); ok {
+				c.updateBGPFilterLockHeld(v3key.Name, v3res)
+			} else {
+				log.Warning("Bad value for BGPFilter resource")
+				continue
+			}
+
+			// Peerings only reference the filters that exist, so recompute them.
+			needUpdatePeersV1 = true
+			needUpdatePeersReasons = append(needUpdatePeersReasons, "BGP filter updated or deleted")
+		}
 	}
 
 	// Update our cache from each of the individual updates, and keep track of
@@ -1654,6 +1673,7 @@ func (c *client) setPeerConfigFieldsFromV3Resource(peers []*bgpPeer, v3res *apiv
 	for _, peer := range peers {
 		peer.Password = password
 		peer.SourceAddr = withDefault(string(v3res.Spec.SourceAddress), string(apiv3.SourceAddressUseNodeIP))
+		peer.Filters = c.filtersForPeer(v3res)
 		if v3res.Spec.MaxRestartTime != nil {
 			peer.RestartTime = fmt.Sprintf("%v", int(math.Round(v3res.Spec.MaxRestartTime.Duration.Seconds())))
 		}
@@ -590,6 +590,7 @@ execute_tests_daemon() {
         run_individual_test 'explicit_peering/keepnexthop-global'
 	run_individual_test 'explicit_peering/local-as'
 	run_individual_test 'explicit_peering/local-as-global'
+	run_individual_test 'explicit_peering/filters'
     done
 
     # Turn the node-mesh back on.
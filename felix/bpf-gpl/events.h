@@ -0,0 +1,77 @@
+// Project Calico BPF dataplane programs.
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0 OR GPL-2.0-or-later
+
+#ifndef __CALI_BPF_EVENTS_H__
+#define __CALI_BPF_EVENTS_H__
+
+#include "bpf.h"
+#include "types.h"
+
+/* Events are sent to Felix through a perf event array, which has one ring buffer per CPU.
+ * Felix sizes the map to the number of possible CPUs when it creates it. */
+CALI_MAP_V1(cali_perf_evnt,
+		BPF_MAP_TYPE_PERF_EVENT_ARRAY,
+		__u32, __u32,
+		512, 0, MAP_PIN_GLOBAL)
+
+/* Add new values to the end.
+ * WARNING: must be kept in sync with the definitions in bpf/events/events.go. */
+enum cali_event_type {
+	CALI_EVENT_POLICY_VERDICT = 1,
+};
+
+/* Direction of the policy that produced a verdict. */
+enum cali_event_dirn {
+	CALI_EVENT_DIRN_INGRESS = 0,
+	CALI_EVENT_DIRN_EGRESS = 1,
+};
+
+struct cali_event_hdr {
+	__u32 type;
+	__u32 len;
+};
+
+/* WARNING: must be kept in sync with the definitions in bpf/events/events.go. */
+struct cali_policy_verdict_event {
+	struct cali_event_hdr hdr;
+	__be32 ip_src;
+	__be32 ip_dst;
+	__u16 sport;
+	__u16 dport;
+	__u8 ip_proto;
+	__u8 dirn;
+	__be16 ip_size;
+	__s32 pol_rc;
+	__u32 pad2;
+	__u64 rule_id;
+};
+
+static CALI_BPF_INLINE int event_policy_verdict(struct __sk_buff *skb, struct cali_tc_state *state)
+{
+	struct cali_policy_verdict_event ev = {
+		.hdr = {
+			.type = CALI_EVENT_POLICY_VERDICT,
+			.len = sizeof(struct cali_policy_verdict_event),
+		},
+		.ip_src = state->ip_src,
+		.ip_dst = state->post_nat_ip_dst,
+		.sport = state->sport,
+		.dport = state->post_nat_dport,
+		.ip_proto = state->ip_proto,
+		.dirn = (CALI_F_TO_WEP || CALI_F_FROM_HEP) ?
+			CALI_EVENT_DIRN_INGRESS : CALI_EVENT_DIRN_EGRESS,
+		.ip_size = state->ip_size,
+		.pol_rc = state->pol_rc,
+		.rule_id = state->rule_id,
+	};
+
+	int err = bpf_perf_event_output(skb, &map_symbol(cali_perf_evnt,),
+			BPF_F_CURRENT_CPU, &ev, sizeof(ev));
+	if (err) {
+		CALI_DEBUG("Failed to send policy verdict event: %d\n", err);
+	}
+	return err;
+}
+
+#endif /* __CALI_BPF_EVENTS_H__ */
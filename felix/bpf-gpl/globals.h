@@ -19,6 +19,8 @@ struct cali_tc_globals {
 enum cali_globals_flags {
 	/* CALI_GLOBALS_IPV6_ENABLED is set when IPv6 is enabled by Felix */
 	CALI_GLOBALS_IPV6_ENABLED = 0x00000001,
+	/* CALI_GLOBALS_FLOW_LOGS_ENABLED is set when Felix wants policy verdict events for flow logs */
+	CALI_GLOBALS_FLOW_LOGS_ENABLED = 0x00000002,
 };
 
 struct cali_ctlb_globals {
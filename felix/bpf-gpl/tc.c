@@ -39,6 +39,7 @@
 #include "parsing.h"
 #include "failsafe.h"
 #include "metadata.h"
+#include "events.h"
 #include "bpf_helpers.h"
 
 #if !defined(__BPFTOOL_LOADER__) && !defined (__IPTOOL_LOADER__)
@@ -707,6 +708,9 @@ static CALI_BPF_INLINE struct fwd calico_tc_skb_accepted(struct cali_tc_ctx *ctx
 
 	switch (ct_rc){
 	case CALI_CT_NEW:
+		if (GLOBAL_FLAGS & CALI_GLOBALS_FLOW_LOGS_ENABLED) {
+			event_policy_verdict(skb, state);
+		}
 		switch (state->pol_rc) {
 		case CALI_POL_NO_MATCH:
 			CALI_DEBUG("Implicitly denied by policy: DROP\n");
@@ -1218,7 +1222,8 @@ int calico_tc_skb_drop(struct __sk_buff *skb)
 
 	struct cali_tc_state *state;
 
-	if (CALI_LOG_LEVEL >= CALI_LOG_LEVEL_DEBUG) {
+	if (CALI_LOG_LEVEL >= CALI_LOG_LEVEL_DEBUG ||
+			(GLOBAL_FLAGS & CALI_GLOBALS_FLOW_LOGS_ENABLED)) {
 		state = state_get();
 		if (!state) {
 			CALI_DEBUG("State map lookup failed: no event generated\n");
@@ -1226,6 +1231,14 @@ int calico_tc_skb_drop(struct __sk_buff *skb)
 		}
 	}
 
+	/* The policy program tail calls this program when policy denies the packet, so
+	 * report the verdict to the flow logs here; allowed packets are reported from
+	 * calico_tc_skb_accepted.
+	 */
+	if ((GLOBAL_FLAGS & CALI_GLOBALS_FLOW_LOGS_ENABLED) && state->pol_rc == CALI_POL_DENY) {
+		event_policy_verdict(skb, state);
+	}
+
 	CALI_DEBUG("proto=%d\n", state->ip_proto);
 	CALI_DEBUG("src=%x dst=%x\n", bpf_ntohl(state->ip_src), bpf_ntohl(state->ip_dst));
 	CALI_DEBUG("pre_nat=%x:%d\n", bpf_ntohl(state->pre_nat_ip_dst), state->pre_nat_dport);
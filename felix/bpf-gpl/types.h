@@ -73,6 +73,9 @@ struct cali_tc_state {
 	/* Result of the NAT calculation.  Zeroed if there is no DNAT. */
 	struct calico_nat_dest nat_dest;
 	__u64 prog_start_time;
+	/* ID of the policy rule that allowed or denied the packet, set by the policy program for
+	 * flow logs.  Zero if the packet was denied because no rule matched. */
+	__u64 rule_id;
 };
 
 enum cali_state_flags {
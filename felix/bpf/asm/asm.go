@@ -120,6 +120,9 @@ const (
 	StoreReg32 OpCode = OpClassStoreReg | MemOpModeMem | MemOpSize32
 	StoreReg64 OpCode = OpClassStoreReg | MemOpModeMem | MemOpSize64
 
+	// AtomicAdd64 atomically adds a register to a 64-bit value in memory.
+	AtomicAdd64 OpCode = OpClassStoreReg | MemOpModeXADD | MemOpSize64
+
 	// TODO: check these opcodes, should they be OpClassStoreMem with an immediate source instead?
 	StoreImm8  OpCode = OpClassStoreImm | MemOpModeImm | MemOpSize8
 	StoreImm16 OpCode = OpClassStoreImm | MemOpModeImm | MemOpSize16
@@ -402,6 +405,11 @@ func (b *Block) Store64(dst Reg, ptrReg Reg, offset int16) {
 	b.add(StoreReg64, dst, ptrReg, offset, 0)
 }
 
+// AtomicAdd64 atomically adds src to the 64-bit value at ptrReg+offset.
+func (b *Block) AtomicAdd64(ptrReg Reg, src Reg, offset int16) {
+	b.add(AtomicAdd64, ptrReg, src, offset, 0)
+}
+
 func (b *Block) LoadStack8(dst Reg, offset int16) {
 	b.Load8(dst, R10, offset)
 }
@@ -450,6 +458,18 @@ func (b *Block) AddImm64(dst Reg, imm int32) {
 	b.add(AddImm64, dst, 0, 0, imm)
 }
 
+func (b *Block) Add64(dst, src Reg) {
+	b.add(Add64, dst, src, 0, 0)
+}
+
+func (b *Block) Or64(dst, src Reg) {
+	b.add(Or64, dst, src, 0, 0)
+}
+
+func (b *Block) ShiftLImm64(dst Reg, imm int32) {
+	b.add(ShiftLImm64, dst, 0, 0, imm)
+}
+
 func (b *Block) Jump(label string) {
 	b.addWithOffsetFixup(JumpA, 0, 0, label, 0)
 }
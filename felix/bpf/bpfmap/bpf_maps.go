@@ -25,6 +25,8 @@ import (
 	"github.com/projectcalico/calico/felix/bpf"
 	"github.com/projectcalico/calico/felix/bpf/arp"
 	"github.com/projectcalico/calico/felix/bpf/conntrack"
+	"github.com/projectcalico/calico/felix/bpf/counters"
+	"github.com/projectcalico/calico/felix/bpf/events"
 	"github.com/projectcalico/calico/felix/bpf/failsafes"
 	"github.com/projectcalico/calico/felix/bpf/ipsets"
 	"github.com/projectcalico/calico/felix/bpf/nat"
@@ -63,7 +65,7 @@ func MigrateDataFromOldMap(mc *bpf.MapContext) {
 
 func DestroyBPFMaps(mc *bpf.MapContext) {
 	maps := []bpf.Map{mc.IpsetsMap, mc.StateMap, mc.ArpMap, mc.FailsafesMap, mc.FrontendMap,
-		mc.BackendMap, mc.AffinityMap, mc.RouteMap, mc.CtMap, mc.SrMsgMap, mc.CtNatsMap}
+		mc.BackendMap, mc.AffinityMap, mc.RouteMap, mc.CtMap, mc.SrMsgMap, mc.CtNatsMap, mc.EventsMap, mc.RuleCountersMap}
 	for _, m := range maps {
 		os.Remove(m.(*bpf.PinnedMap).Path())
 		m.(*bpf.PinnedMap).Close()
@@ -106,6 +108,12 @@ func CreateBPFMaps(mc *bpf.MapContext) error {
 	mc.CtNatsMap = nat.AllNATsMsgMap(mc)
 	maps = append(maps, mc.CtNatsMap)
 
+	mc.EventsMap = events.Map(mc)
+	maps = append(maps, mc.EventsMap)
+
+	mc.RuleCountersMap = counters.Map(mc)
+	maps = append(maps, mc.RuleCountersMap)
+
 	for _, bpfMap := range maps {
 		err := bpfMap.EnsureExists()
 		if err != nil {
@@ -0,0 +1,91 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package counters holds the per-rule packet and byte counters that the BPF policy programs
+// maintain.
+package counters
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/projectcalico/calico/felix/bpf"
+)
+
+const (
+	KeySize   = 8
+	ValueSize = 16
+)
+
+// MapParams describes the map of per-rule counters.  The map is keyed on the counter ID of the
+// rule and the policy programs create the entry for a rule when it first matches.
+var MapParams = bpf.MapParameters{
+	Filename:     "/sys/fs/bpf/tc/globals/cali_rule_ctrs",
+	Type:         "hash",
+	KeySize:      KeySize,
+	ValueSize:    ValueSize,
+	MaxEntries:   65536,
+	Name:         "cali_rule_ctrs",
+	UpdatedByBPF: true,
+}
+
+func Map(mc *bpf.MapContext) bpf.Map {
+	return mc.NewPinnedMap(MapParams)
+}
+
+// Value is the value of a map entry.  The policy programs update the fields atomically.
+type Value struct {
+	Packets uint64
+	Bytes   uint64
+}
+
+func ValueFromBytes(b []byte) Value {
+	return Value{
+		Packets: binary.LittleEndian.Uint64(b[0:8]),
+		Bytes:   binary.LittleEndian.Uint64(b[8:16]),
+	}
+}
+
+func (v Value) AsBytes() []byte {
+	b := make([]byte, ValueSize)
+	binary.LittleEndian.PutUint64(b[0:8], v.Packets)
+	binary.LittleEndian.PutUint64(b[8:16], v.Bytes)
+	return b
+}
+
+// Read returns the counters in the map, indexed by counter ID.
+func Read(m bpf.Map) (map[uint64]Value, error) {
+	values := map[uint64]Value{}
+	err := m.Iter(func(k, v []byte) bpf.IteratorAction {
+		values[binary.LittleEndian.Uint64(k)] = ValueFromBytes(v)
+		return bpf.IterNone
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rule counters: %w", err)
+	}
+	return values, nil
+}
+
+// Delete removes the counters with the given IDs from the map.  It ignores the IDs that have no
+// counters, which are those of rules that never matched.
+func Delete(m bpf.Map, ids []uint64) error {
+	k := make([]byte, KeySize)
+	for _, id := range ids {
+		binary.LittleEndian.PutUint64(k, id)
+		if err := m.Delete(k); err != nil && !bpf.IsNotExists(err) {
+			return fmt.Errorf("failed to delete rule counter %x: %w", id, err)
+		}
+	}
+	return nil
+}
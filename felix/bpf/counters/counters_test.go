@@ -0,0 +1,67 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package counters
+
+import (
+	"encoding/binary"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/bpf/mock"
+)
+
+func TestValueRoundTrip(t *testing.T) {
+	RegisterTestingT(t)
+
+	v := Value{Packets: 12, Bytes: 3456}
+	b := v.AsBytes()
+	Expect(b).To(HaveLen(ValueSize))
+	Expect(ValueFromBytes(b)).To(Equal(v))
+}
+
+func TestRead(t *testing.T) {
+	RegisterTestingT(t)
+
+	m := mock.NewMockMap(MapParams)
+	for id, v := range map[uint64]Value{1: {Packets: 1, Bytes: 60}, 0xdeadbeef: {Packets: 5, Bytes: 300}} {
+		k := make([]byte, KeySize)
+		binary.LittleEndian.PutUint64(k, id)
+		Expect(m.Update(k, v.AsBytes())).To(Succeed())
+	}
+
+	values, err := Read(m)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(values).To(Equal(map[uint64]Value{
+		1:          {Packets: 1, Bytes: 60},
+		0xdeadbeef: {Packets: 5, Bytes: 300},
+	}))
+}
+
+func TestDelete(t *testing.T) {
+	RegisterTestingT(t)
+
+	m := mock.NewMockMap(MapParams)
+	for _, id := range []uint64{1, 2} {
+		k := make([]byte, KeySize)
+		binary.LittleEndian.PutUint64(k, id)
+		Expect(m.Update(k, Value{Packets: 1, Bytes: 60}.AsBytes())).To(Succeed())
+	}
+
+	Expect(Delete(m, []uint64{1, 3})).To(Succeed())
+	values, err := Read(m)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(values).To(Equal(map[uint64]Value{2: {Packets: 1, Bytes: 60}}))
+}
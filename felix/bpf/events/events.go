@@ -0,0 +1,154 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package events receives the events that the BPF programs send to Felix through the
+// cali_perf_evnt perf event array.
+package events
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/projectcalico/calico/felix/bpf"
+	"github.com/projectcalico/calico/felix/bpf/state"
+)
+
+// Type is the type of an event, from enum cali_event_type in bpf-gpl/events.h.
+type Type uint32
+
+const (
+	TypePolicyVerdict Type = 1
+)
+
+// Direction is the direction of the policy that produced a verdict, from enum cali_event_dirn.
+type Direction uint8
+
+const (
+	DirectionIngress Direction = 0
+	DirectionEgress  Direction = 1
+)
+
+const (
+	// hdrSize is the size of struct cali_event_hdr.
+	hdrSize = 8
+	// policyVerdictSize is the size of struct cali_policy_verdict_event.
+	policyVerdictSize = 40
+)
+
+var MapParams = bpf.MapParameters{
+	Filename:   "/sys/fs/bpf/tc/globals/cali_perf_evnt",
+	Type:       "perf_event_array",
+	KeySize:    4,
+	ValueSize:  4,
+	MaxEntries: 512,
+	Name:       "cali_perf_evnt",
+}
+
+// Map returns the perf event array, sized to have a slot for each possible CPU.
+func Map(mc *bpf.MapContext) bpf.Map {
+	params := MapParams
+	if n, err := NumPossibleCPUs(); err == nil {
+		params.MaxEntries = n
+	}
+	return mc.NewPinnedMap(params)
+}
+
+// Event is an event received from the BPF programs.
+type Event struct {
+	Type Type
+	// Data is the body of the event, after the header.
+	Data []byte
+}
+
+// ParseEvent parses the raw bytes of an event, as sent by the BPF programs.
+func ParseEvent(raw []byte) (Event, error) {
+	if len(raw) < hdrSize {
+		return Event{}, fmt.Errorf("event too short: %d bytes", len(raw))
+	}
+	typ := Type(binary.LittleEndian.Uint32(raw[0:4]))
+	length := int(binary.LittleEndian.Uint32(raw[4:8]))
+	if length < hdrSize || length > len(raw) {
+		return Event{}, fmt.Errorf("bad event length %d (have %d bytes)", length, len(raw))
+	}
+	return Event{Type: typ, Data: raw[hdrSize:length]}, nil
+}
+
+// PolicyVerdict is the body of a TypePolicyVerdict event, which the BPF programs send when they
+// apply policy to the first packet of a connection.
+type PolicyVerdict struct {
+	SrcAddr net.IP
+	DstAddr net.IP
+	SrcPort uint16
+	DstPort uint16
+	IPProto uint8
+	// Direction is the direction of the policy that was applied.
+	Direction Direction
+	IPSize    uint16
+	PolicyRC  state.PolicyResult
+	// RuleID is the FlowLogID of the rule that decided the verdict, or 0 if no rule matched.
+	RuleID uint64
+}
+
+// ParsePolicyVerdict parses the body of a TypePolicyVerdict event.
+func ParsePolicyVerdict(e Event) (PolicyVerdict, error) {
+	if e.Type != TypePolicyVerdict {
+		return PolicyVerdict{}, fmt.Errorf("not a policy verdict event: type %d", e.Type)
+	}
+	d := e.Data
+	if len(d) < policyVerdictSize-hdrSize {
+		return PolicyVerdict{}, fmt.Errorf("policy verdict event too short: %d bytes", len(d))
+	}
+	return PolicyVerdict{
+		SrcAddr:   net.IPv4(d[0], d[1], d[2], d[3]),
+		DstAddr:   net.IPv4(d[4], d[5], d[6], d[7]),
+		SrcPort:   binary.LittleEndian.Uint16(d[8:10]),
+		DstPort:   binary.LittleEndian.Uint16(d[10:12]),
+		IPProto:   d[12],
+		Direction: Direction(d[13]),
+		IPSize:    binary.BigEndian.Uint16(d[14:16]),
+		PolicyRC:  state.PolicyResult(int32(binary.LittleEndian.Uint32(d[16:20]))),
+		RuleID:    binary.LittleEndian.Uint64(d[24:32]),
+	}, nil
+}
+
+// NumPossibleCPUs returns the number of CPUs that the kernel may bring online, which is the
+// number of per-CPU ring buffers that the BPF programs may write to.
+func NumPossibleCPUs() (int, error) {
+	data, err := os.ReadFile("/sys/devices/system/cpu/possible")
+	if err != nil {
+		return 0, err
+	}
+	return parseCPURanges(strings.TrimSpace(string(data)))
+}
+
+// parseCPURanges parses a kernel CPU list, such as "0-3,5", and returns one more than the
+// highest CPU number.
+func parseCPURanges(s string) (int, error) {
+	max := -1
+	for _, part := range strings.Split(s, ",") {
+		bounds := strings.SplitN(part, "-", 2)
+		last, err := strconv.Atoi(bounds[len(bounds)-1])
+		if err != nil {
+			return 0, fmt.Errorf("bad CPU list %q: %w", s, err)
+		}
+		if last > max {
+			max = last
+		}
+	}
+	return max + 1, nil
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/bpf/state"
+)
+
+func policyVerdictBytes() []byte {
+	b := make([]byte, policyVerdictSize)
+	binary.LittleEndian.PutUint32(b[0:4], uint32(TypePolicyVerdict))
+	binary.LittleEndian.PutUint32(b[4:8], policyVerdictSize)
+	copy(b[8:12], net.ParseIP("10.0.0.1").To4())
+	copy(b[12:16], net.ParseIP("10.0.0.2").To4())
+	binary.LittleEndian.PutUint16(b[16:18], 12345)
+	binary.LittleEndian.PutUint16(b[18:20], 80)
+	b[20] = 6
+	b[21] = uint8(DirectionEgress)
+	binary.BigEndian.PutUint16(b[22:24], 60)
+	binary.LittleEndian.PutUint32(b[24:28], uint32(state.PolicyAllow))
+	binary.LittleEndian.PutUint64(b[32:40], 0xdeadbeef)
+	return b
+}
+
+func TestParsePolicyVerdict(t *testing.T) {
+	RegisterTestingT(t)
+
+	e, err := ParseEvent(policyVerdictBytes())
+	Expect(err).NotTo(HaveOccurred())
+	Expect(e.Type).To(Equal(TypePolicyVerdict))
+
+	v, err := ParsePolicyVerdict(e)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(v.SrcAddr.String()).To(Equal("10.0.0.1"))
+	Expect(v.DstAddr.String()).To(Equal("10.0.0.2"))
+	Expect(v.SrcPort).To(Equal(uint16(12345)))
+	Expect(v.DstPort).To(Equal(uint16(80)))
+	Expect(v.IPProto).To(Equal(uint8(6)))
+	Expect(v.Direction).To(Equal(DirectionEgress))
+	Expect(v.IPSize).To(Equal(uint16(60)))
+	Expect(v.PolicyRC).To(Equal(state.PolicyAllow))
+	Expect(v.RuleID).To(Equal(uint64(0xdeadbeef)))
+}
+
+func TestParseEventErrors(t *testing.T) {
+	RegisterTestingT(t)
+
+	_, err := ParseEvent([]byte{1, 0, 0})
+	Expect(err).To(HaveOccurred())
+
+	b := policyVerdictBytes()
+	binary.LittleEndian.PutUint32(b[4:8], 100)
+	_, err = ParseEvent(b)
+	Expect(err).To(HaveOccurred())
+
+	_, err = ParsePolicyVerdict(Event{Type: 99})
+	Expect(err).To(HaveOccurred())
+}
+
+func TestParseCPURanges(t *testing.T) {
+	RegisterTestingT(t)
+
+	for s, expected := range map[string]int{
+		"0":       1,
+		"0-7":     8,
+		"0-3,5":   6,
+		"0,2-3,8": 9,
+	} {
+		n, err := parseCPURanges(s)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(n).To(Equal(expected), s)
+	}
+	_, err := parseCPURanges("zero")
+	Expect(err).To(HaveOccurred())
+}
+
+// sampleRecord returns a PERF_RECORD_SAMPLE record carrying the given raw data.
+func sampleRecord(raw []byte) []byte {
+	// perf pads the record to a multiple of 8 bytes.
+	size := (perfRecordHdrSize + 4 + len(raw) + 7) &^ 7
+	rec := make([]byte, size)
+	binary.LittleEndian.PutUint32(rec[0:4], perfRecordSample)
+	binary.LittleEndian.PutUint16(rec[6:8], uint16(size))
+	binary.LittleEndian.PutUint32(rec[8:12], uint32(len(raw)))
+	copy(rec[12:], raw)
+	return rec
+}
+
+func TestReadRecordsWrapsAround(t *testing.T) {
+	RegisterTestingT(t)
+
+	data := make([]byte, 64)
+	rec := sampleRecord([]byte("hello, world"))
+	Expect(rec).To(HaveLen(24))
+
+	// Write the record so that it wraps around the end of the ring.
+	const start = 56
+	for i, b := range rec {
+		data[(start+i)%len(data)] = b
+	}
+	lost := make([]byte, 24)
+	binary.LittleEndian.PutUint32(lost[0:4], perfRecordLost)
+	binary.LittleEndian.PutUint16(lost[6:8], 24)
+	binary.LittleEndian.PutUint64(lost[16:24], 3)
+	for i, b := range lost {
+		data[(start+len(rec)+i)%len(data)] = b
+	}
+
+	var samples []string
+	var lostCount uint64
+	tail := readRecords(data, start, start+48, func(typ uint32, body []byte) {
+		switch typ {
+		case perfRecordSample:
+			samples = append(samples, string(body))
+		case perfRecordLost:
+			lostCount += binary.LittleEndian.Uint64(body[8:16])
+		}
+	})
+	Expect(tail).To(Equal(uint64(start + 48)))
+	Expect(samples).To(Equal([]string{"hello, world"}))
+	Expect(lostCount).To(Equal(uint64(3)))
+}
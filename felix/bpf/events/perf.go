@@ -0,0 +1,242 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package events
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"unsafe"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+
+	"github.com/projectcalico/calico/felix/bpf"
+)
+
+const (
+	// Offsets of data_head and data_tail within struct perf_event_mmap_page.
+	perfDataHeadOffset = 1024
+	perfDataTailOffset = 1032
+
+	// Record types from enum perf_event_type.
+	perfRecordLost   = 2
+	perfRecordSample = 9
+
+	// perfRecordHdrSize is the size of struct perf_event_header.
+	perfRecordHdrSize = 8
+)
+
+// Perf reads events from the per-CPU ring buffers of a perf event array.  It is not safe for
+// concurrent use.
+type Perf struct {
+	epollFD     int
+	rings       []*perfRing
+	epollEvents []unix.EpollEvent
+	lost        uint64
+}
+
+// OpenPerf creates a ring buffer of ringPages pages (a power of 2) for each CPU and registers
+// them in the given perf event array.
+func OpenPerf(m bpf.Map, ringPages int) (*Perf, error) {
+	if ringPages <= 0 || ringPages&(ringPages-1) != 0 {
+		return nil, fmt.Errorf("ring size must be a power of 2 pages, not %d", ringPages)
+	}
+	ncpus, err := NumPossibleCPUs()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get number of CPUs: %w", err)
+	}
+
+	epollFD, err := unix.EpollCreate1(unix.EPOLL_CLOEXEC)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create epoll FD: %w", err)
+	}
+	p := &Perf{epollFD: epollFD}
+
+	for cpu := 0; cpu < ncpus; cpu++ {
+		ring, err := openPerfRing(cpu, ringPages)
+		if errors.Is(err, unix.ENODEV) {
+			// Possible but not online.
+			log.WithField("cpu", cpu).Debug("Skipping offline CPU")
+			continue
+		} else if err != nil {
+			p.Close()
+			return nil, err
+		}
+		p.rings = append(p.rings, ring)
+
+		k := make([]byte, 4)
+		v := make([]byte, 4)
+		binary.LittleEndian.PutUint32(k, uint32(cpu))
+		binary.LittleEndian.PutUint32(v, uint32(ring.fd))
+		if err := bpf.UpdateMapEntry(m.MapFD(), k, v); err != nil {
+			p.Close()
+			return nil, fmt.Errorf("failed to register perf ring for CPU %d: %w", cpu, err)
+		}
+
+		ev := unix.EpollEvent{Events: unix.EPOLLIN, Fd: int32(len(p.rings) - 1)}
+		if err := unix.EpollCtl(epollFD, unix.EPOLL_CTL_ADD, ring.fd, &ev); err != nil {
+			p.Close()
+			return nil, fmt.Errorf("failed to add perf ring for CPU %d to epoll: %w", cpu, err)
+		}
+	}
+	if len(p.rings) == 0 {
+		p.Close()
+		return nil, errors.New("no online CPUs")
+	}
+	p.epollEvents = make([]unix.EpollEvent, len(p.rings))
+	return p, nil
+}
+
+// Poll waits up to timeoutMillis for events and returns the raw events that arrived, which may be
+// none.
+func (p *Perf) Poll(timeoutMillis int) ([][]byte, error) {
+	n, err := unix.EpollWait(p.epollFD, p.epollEvents, timeoutMillis)
+	if errors.Is(err, unix.EINTR) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to wait for perf events: %w", err)
+	}
+	var raw [][]byte
+	for i := 0; i < n; i++ {
+		ring := p.rings[p.epollEvents[i].Fd]
+		ring.readAll(func(typ uint32, data []byte) {
+			switch typ {
+			case perfRecordSample:
+				raw = append(raw, data)
+			case perfRecordLost:
+				if len(data) >= 16 {
+					p.lost += binary.LittleEndian.Uint64(data[8:16])
+				}
+			}
+		})
+	}
+	return raw, nil
+}
+
+// Lost returns the number of events that the kernel has dropped because a ring was full.
+func (p *Perf) Lost() uint64 {
+	return p.lost
+}
+
+// Close releases the ring buffers.  The entries in the perf event array become invalid.
+func (p *Perf) Close() {
+	for _, r := range p.rings {
+		r.close()
+	}
+	p.rings = nil
+	_ = unix.Close(p.epollFD)
+}
+
+// perfRing is one CPU's ring buffer.  The first page of mem holds the control structure; the
+// remaining pages hold the data.
+type perfRing struct {
+	fd   int
+	mem  []byte
+	data []byte
+}
+
+func openPerfRing(cpu, ringPages int) (*perfRing, error) {
+	attr := unix.PerfEventAttr{
+		Type:        unix.PERF_TYPE_SOFTWARE,
+		Config:      unix.PERF_COUNT_SW_BPF_OUTPUT,
+		Sample_type: unix.PERF_SAMPLE_RAW,
+		Wakeup:      1,
+	}
+	attr.Size = uint32(unsafe.Sizeof(attr))
+	fd, err := unix.PerfEventOpen(&attr, -1, cpu, -1, unix.PERF_FLAG_FD_CLOEXEC)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open perf event for CPU %d: %w", cpu, err)
+	}
+	pageSize := unix.Getpagesize()
+	mem, err := unix.Mmap(fd, 0, pageSize*(ringPages+1), unix.PROT_READ|unix.PROT_WRITE, unix.MAP_SHARED)
+	if err != nil {
+		_ = unix.Close(fd)
+		return nil, fmt.Errorf("failed to map perf ring for CPU %d: %w", cpu, err)
+	}
+	if err := unix.IoctlSetInt(fd, unix.PERF_EVENT_IOC_ENABLE, 0); err != nil {
+		_ = unix.Munmap(mem)
+		_ = unix.Close(fd)
+		return nil, fmt.Errorf("failed to enable perf event for CPU %d: %w", cpu, err)
+	}
+	return &perfRing{fd: fd, mem: mem, data: mem[pageSize:]}, nil
+}
+
+func (r *perfRing) close() {
+	_ = unix.Munmap(r.mem)
+	_ = unix.Close(r.fd)
+}
+
+func (r *perfRing) head() uint64 {
+	return atomic.LoadUint64((*uint64)(unsafe.Pointer(&r.mem[perfDataHeadOffset])))
+}
+
+func (r *perfRing) setTail(tail uint64) {
+	atomic.StoreUint64((*uint64)(unsafe.Pointer(&r.mem[perfDataTailOffset])), tail)
+}
+
+func (r *perfRing) tail() uint64 {
+	return atomic.LoadUint64((*uint64)(unsafe.Pointer(&r.mem[perfDataTailOffset])))
+}
+
+// readAll passes each record in the ring to the handler and then marks the records as consumed.
+// For samples, the handler gets the raw data that the BPF program sent; for other records, it
+// gets the record body.
+func (r *perfRing) readAll(handler func(typ uint32, data []byte)) {
+	head := r.head()
+	tail := readRecords(r.data, r.tail(), head, handler)
+	r.setTail(tail)
+}
+
+// readRecords reads the records between tail and head from the ring buffer data, handling
+// records that wrap around the end of the buffer, and returns the new tail.
+func readRecords(data []byte, tail, head uint64, handler func(typ uint32, data []byte)) uint64 {
+	size := uint64(len(data))
+	for tail < head {
+		hdr := copyFromRing(data, tail, perfRecordHdrSize)
+		typ := binary.LittleEndian.Uint32(hdr[0:4])
+		recSize := uint64(binary.LittleEndian.Uint16(hdr[6:8]))
+		if recSize < perfRecordHdrSize || recSize > size {
+			log.WithField("size", recSize).Error("Corrupt perf record, discarding ring contents")
+			return head
+		}
+		body := copyFromRing(data, tail+perfRecordHdrSize, int(recSize-perfRecordHdrSize))
+		if typ == perfRecordSample {
+			// The body of a PERF_SAMPLE_RAW sample is a u32 size followed by the data.
+			if len(body) >= 4 {
+				rawSize := int(binary.LittleEndian.Uint32(body[0:4]))
+				if rawSize <= len(body)-4 {
+					handler(typ, body[4:4+rawSize])
+				}
+			}
+		} else {
+			handler(typ, body)
+		}
+		tail += recSize
+	}
+	return tail
+}
+
+func copyFromRing(data []byte, pos uint64, n int) []byte {
+	size := uint64(len(data))
+	out := make([]byte, n)
+	start := pos % size
+	copied := copy(out, data[start:])
+	if copied < n {
+		copy(out[copied:], data)
+	}
+	return out
+}
@@ -242,6 +242,8 @@ func (o *Obj) AttachCGroup(cgroup, progName string) (*Link, error) {
 const (
 	// Set when IPv6 is enabled to configure bpf dataplane accordingly
 	GlobalsIPv6Enabled uint32 = C.CALI_GLOBALS_IPV6_ENABLED
+	// Set when flow logs are enabled, so that the programs emit policy verdict events
+	GlobalsFlowLogsEnabled uint32 = C.CALI_GLOBALS_FLOW_LOGS_ENABLED
 )
 
 func TcSetGlobals(
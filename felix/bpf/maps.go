@@ -98,6 +98,8 @@ type MapContext struct {
 	CtMap            Map
 	SrMsgMap         Map
 	CtNatsMap        Map
+	EventsMap        Map
+	RuleCountersMap  Map
 	MapSizes         map[string]uint32
 }
 
@@ -20,6 +20,7 @@ import (
 	"math/bits"
 	"strings"
 
+	"github.com/projectcalico/calico/felix/bpf/counters"
 	"github.com/projectcalico/calico/felix/bpf/ipsets"
 
 	"github.com/projectcalico/calico/felix/bpf"
@@ -41,22 +42,36 @@ type Builder struct {
 	rulePartID      int
 	ipSetIDProvider ipSetIDProvider
 
-	ipSetMapFD bpf.MapFD
-	stateMapFD bpf.MapFD
-	jumpMapFD  bpf.MapFD
+	ipSetMapFD        bpf.MapFD
+	stateMapFD        bpf.MapFD
+	jumpMapFD         bpf.MapFD
+	ruleCountersMapFD bpf.MapFD
 }
 
 type ipSetIDProvider interface {
 	GetNoAlloc(ipSetID string) uint64
 }
 
-func NewBuilder(ipSetIDProvider ipSetIDProvider, ipsetMapFD, stateMapFD, jumpMapFD bpf.MapFD) *Builder {
+type Option func(b *Builder)
+
+// WithRuleCounters makes the program count the packets and bytes that match each rule with a
+// CounterID in the given counters map.
+func WithRuleCounters(ruleCountersMapFD bpf.MapFD) Option {
+	return func(b *Builder) {
+		b.ruleCountersMapFD = ruleCountersMapFD
+	}
+}
+
+func NewBuilder(ipSetIDProvider ipSetIDProvider, ipsetMapFD, stateMapFD, jumpMapFD bpf.MapFD, opts ...Option) *Builder {
 	b := &Builder{
 		ipSetIDProvider: ipSetIDProvider,
 		ipSetMapFD:      ipsetMapFD,
 		stateMapFD:      stateMapFD,
 		jumpMapFD:       jumpMapFD,
 	}
+	for _, o := range opts {
+		o(b)
+	}
 	return b
 }
 
@@ -82,6 +97,8 @@ var (
 	offStateKey    = nextOffset(4, 4)
 	offSrcIPSetKey = nextOffset(ipsets.IPSetEntrySize, 8)
 	offDstIPSetKey = nextOffset(ipsets.IPSetEntrySize, 8)
+	offCounterKey  = nextOffset(counters.KeySize, 8)
+	offCounterVal  = nextOffset(counters.ValueSize, 8)
 
 	// Offsets within the cal_tc_state struct.
 	// WARNING: must be kept in sync with the definitions in bpf/include/jump.h.
@@ -100,6 +117,8 @@ var (
 	stateOffPostNATDstPort int16 = stateEventHdrSize + 30
 	stateOffIPProto        int16 = stateEventHdrSize + 32
 	stateOffFlags          int16 = stateEventHdrSize + 33
+	stateOffIPSize         int16 = stateEventHdrSize + 34
+	stateOffRuleID         int16 = stateEventHdrSize + 80
 
 	// Compile-time check that IPSetEntrySize hasn't changed; if it changes, the code will need to change.
 	_ = [1]struct{}{{}}[20-ipsets.IPSetEntrySize]
@@ -121,11 +140,18 @@ var (
 
 type Rule struct {
 	*proto.Rule
+	// FlowLogID, if non-zero, is recorded in the state when the rule matches so that the
+	// verdict can be attributed to the rule in flow logs.
+	FlowLogID uint64
+	// CounterID, if non-zero, is the key of the rule's entry in the rule counters map.
+	CounterID uint64
 }
 
 type Policy struct {
 	Name  string
 	Rules []Rule
+	// Staged policies count the packets that match their rules but never decide the verdict.
+	Staged bool
 }
 
 type Tier struct {
@@ -423,7 +449,19 @@ func (p *Builder) writePolicyRules(policy Policy, actionLabels map[string]string
 
 func (p *Builder) writePolicy(policy Policy, actionLabels map[string]string, destLeg matchLeg) {
 	log.Debugf("Start of policy %q %d", policy.Name, p.policyID)
-	p.writePolicyRules(policy, actionLabels, destLeg)
+	if policy.Staged {
+		// The first rule of a staged policy that matches skips the rest of the policy, whatever
+		// its action, so that its counter records what the policy would have done.
+		endOfPolicyLabel := fmt.Sprint("end_of_staged_policy_", p.policyID)
+		stagedLabels := map[string]string{}
+		for action := range actionLabels {
+			stagedLabels[action] = endOfPolicyLabel
+		}
+		p.writePolicyRules(policy, stagedLabels, destLeg)
+		p.b.LabelNextInsn(endOfPolicyLabel)
+	} else {
+		p.writePolicyRules(policy, actionLabels, destLeg)
+	}
 	log.Debugf("End of policy %q %d", policy.Name, p.policyID)
 	p.policyID++
 }
@@ -609,11 +647,66 @@ func (p *Builder) writeEndOfRule(rule Rule, actionLabel string) {
 	// If all the match criteria are met, we fall through to the end of the rule
 	// so all that's left to do is to jump to the relevant action.
 	// TODO log and log-and-xxx actions
+	if rule.CounterID != 0 && p.ruleCountersMapFD != 0 {
+		p.writeRuleCounterUpdate(rule.CounterID)
+	}
+	if rule.FlowLogID != 0 {
+		p.b.LoadImm64(R1, int64(rule.FlowLogID))
+		p.b.Store64(R9, R1, stateOffRuleID)
+	}
 	p.b.Jump(actionLabel)
 
 	p.b.LabelNextInsn(p.endOfRuleLabel())
 }
 
+// writeRuleCounterUpdate emits instructions to count the packet against the given entry in the
+// rule counters map, creating the entry if needed.
+func (p *Builder) writeRuleCounterUpdate(counterID uint64) {
+	foundLabel := p.freshPerRuleLabel()
+	doneLabel := p.freshPerRuleLabel()
+
+	p.b.LoadImm64(R1, int64(counterID))
+	p.b.StoreStack64(R1, offCounterKey)
+	p.writeRuleCounterLookup()
+	p.b.JumpNEImm64(R0, 0, foundLabel)
+
+	// First match; create a zeroed entry and look it up again.  (If another CPU got there
+	// first, the update fails harmlessly.)
+	p.b.MovImm64(R1, 0)
+	p.b.StoreStack64(R1, offCounterVal)
+	p.b.StoreStack64(R1, offCounterVal+8)
+	p.b.LoadMapFD(R1, uint32(p.ruleCountersMapFD))
+	p.b.Mov64(R2, R10)
+	p.b.AddImm64(R2, int32(offCounterKey))
+	p.b.Mov64(R3, R10)
+	p.b.AddImm64(R3, int32(offCounterVal))
+	p.b.MovImm64(R4, 1 /* BPF_NOEXIST */)
+	p.b.Call(HelperMapUpdateElem)
+	p.writeRuleCounterLookup()
+	// If the map is full, don't count the packet.
+	p.b.JumpEqImm64(R0, 0, doneLabel)
+
+	p.b.LabelNextInsn(foundLabel)
+	p.b.MovImm64(R1, 1)
+	p.b.AtomicAdd64(R0, R1, 0)
+	// The IP packet size is big-endian.
+	p.b.Load8(R1, R9, stateOffIPSize)
+	p.b.ShiftLImm64(R1, 8)
+	p.b.Load8(R2, R9, stateOffIPSize+1)
+	p.b.Or64(R1, R2)
+	p.b.AtomicAdd64(R0, R1, 8)
+	p.b.LabelNextInsn(doneLabel)
+}
+
+// writeRuleCounterLookup emits a lookup of the key at offCounterKey in the rule counters map,
+// leaving the pointer to the value (or 0) in R0.
+func (p *Builder) writeRuleCounterLookup() {
+	p.b.LoadMapFD(R1, uint32(p.ruleCountersMapFD))
+	p.b.Mov64(R2, R10)
+	p.b.AddImm64(R2, int32(offCounterKey))
+	p.b.Call(HelperMapLookupElem)
+}
+
 func (p *Builder) writeProtoMatch(negate bool, protocol *proto.Protocol) {
 	p.b.Load8(R1, R9, stateOffIPProto)
 	protoNum := protocolToNumber(protocol)
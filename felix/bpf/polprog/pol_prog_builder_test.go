@@ -19,6 +19,7 @@ import (
 
 	. "github.com/onsi/gomega"
 
+	"github.com/projectcalico/calico/felix/bpf/asm"
 	"github.com/projectcalico/calico/felix/idalloc"
 	"github.com/projectcalico/calico/felix/proto"
 )
@@ -100,3 +101,82 @@ func TestLogActionIgnored(t *testing.T) {
 	Expect(err).NotTo(HaveOccurred())
 	Expect(noOpInsns).To(Equal(insns))
 }
+
+func TestRuleCounters(t *testing.T) {
+	RegisterTestingT(t)
+	alloc := idalloc.New()
+
+	countAtomicAdds := func(insns asm.Insns) int {
+		n := 0
+		for _, in := range insns {
+			if in.OpCode() == asm.AtomicAdd64 {
+				n++
+			}
+		}
+		return n
+	}
+	rules := Rules{
+		Tiers: []Tier{{
+			Name: "default",
+			Policies: []Policy{{
+				Name: "test policy",
+				Rules: []Rule{{
+					Rule:      &proto.Rule{Action: "Allow"},
+					CounterID: 1234,
+				}},
+			}},
+		}}}
+
+	// Without the option, the counter ID is ignored.
+	insns, err := NewBuilder(alloc, 1, 2, 3).Instructions(rules)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(countAtomicAdds(insns)).To(Equal(0))
+
+	// With it, each counted rule updates the packet and byte counters.
+	insns, err = NewBuilder(alloc, 1, 2, 3, WithRuleCounters(4)).Instructions(rules)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(countAtomicAdds(insns)).To(Equal(2))
+}
+
+func TestStagedPolicyDoesNotDecideVerdict(t *testing.T) {
+	RegisterTestingT(t)
+	alloc := idalloc.New()
+
+	stagedTier := func(action string, staged bool) Rules {
+		return Rules{
+			Tiers: []Tier{{
+				Name:      "default",
+				EndAction: TierEndPass,
+				Policies: []Policy{{
+					Name:   "staged:test-policy",
+					Staged: staged,
+					Rules: []Rule{{
+						Rule:      &proto.Rule{Action: action},
+						CounterID: 1234,
+					}},
+				}},
+			}}}
+	}
+
+	// A staged rule jumps to the end of its policy whatever its action, so the programs for
+	// a staged deny and a staged allow are the same.
+	denyInsns, err := NewBuilder(alloc, 1, 2, 3, WithRuleCounters(4)).Instructions(stagedTier("Deny", true))
+	Expect(err).NotTo(HaveOccurred())
+	allowInsns, err := NewBuilder(alloc, 1, 2, 3, WithRuleCounters(4)).Instructions(stagedTier("Allow", true))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(denyInsns).To(Equal(allowInsns))
+
+	// Unlike the enforced policy.
+	enforcedInsns, err := NewBuilder(alloc, 1, 2, 3, WithRuleCounters(4)).Instructions(stagedTier("Deny", false))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(enforcedInsns).NotTo(Equal(denyInsns))
+
+	// The staged rule still updates its counters.
+	atomicAdds := 0
+	for _, in := range denyInsns {
+		if in.OpCode() == asm.AtomicAdd64 {
+			atomicAdds++
+		}
+	}
+	Expect(atomicAdds).To(Equal(2))
+}
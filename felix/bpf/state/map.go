@@ -48,6 +48,7 @@ const (
 //    struct calico_ct_result ct_result;
 //    struct calico_nat_dest nat_dest;
 //    __u64 prog_start_time;
+//    __u64 rule_id;
 // };
 type State struct {
 	SrcAddr             uint32
@@ -70,9 +71,10 @@ type State struct {
 	ConntrackIfIndexCtd uint32
 	NATData             uint64
 	ProgStartTime       uint64
+	RuleID              uint64
 }
 
-const expectedSize = 80
+const expectedSize = 88
 
 func (s *State) AsBytes() []byte {
 	size := unsafe.Sizeof(State{})
@@ -99,7 +101,7 @@ var MapParameters = bpf.MapParameters{
 	ValueSize:  expectedSize,
 	MaxEntries: 1,
 	Name:       "cali_v4_state",
-	Version:    3,
+	Version:    4,
 }
 
 func Map(mc *bpf.MapContext) bpf.Map {
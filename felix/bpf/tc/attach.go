@@ -59,6 +59,7 @@ type AttachPoint struct {
 	PSNATStart           uint16
 	PSNATEnd             uint16
 	IPv6Enabled          bool
+	FlowLogsEnabled      bool
 	MapSizes             map[string]uint32
 }
 
@@ -620,6 +621,9 @@ func (ap *AttachPoint) ConfigureProgram(m *libbpf.Map) error {
 	if ap.IPv6Enabled {
 		flags |= libbpf.GlobalsIPv6Enabled
 	}
+	if ap.FlowLogsEnabled {
+		flags |= libbpf.GlobalsFlowLogsEnabled
+	}
 
 	return libbpf.TcSetGlobals(m, hostIP, intfIP,
 		ap.ExtToServiceConnmark, ap.TunnelMTU, vxlanPort, ap.PSNATStart, ap.PSNATEnd, flags)
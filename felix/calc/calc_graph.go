@@ -73,6 +73,8 @@ type encapCallbacks interface {
 type passthruCallbacks interface {
 	OnHostIPUpdate(hostname string, ip *net.IP)
 	OnHostIPRemove(hostname string)
+	OnHostIPv6Update(hostname string, ip *net.IP)
+	OnHostIPv6Remove(hostname string)
 	OnIPPoolUpdate(model.IPPoolKey, *model.IPPool)
 	OnIPPoolRemove(model.IPPoolKey)
 	OnServiceAccountUpdate(*proto.ServiceAccountUpdate)
@@ -94,6 +96,11 @@ type vxlanCallbacks interface {
 	OnVTEPRemove(node string)
 }
 
+type packetCaptureCallbacks interface {
+	OnPacketCaptureUpdate(update *proto.PacketCaptureUpdate)
+	OnPacketCaptureRemove(id proto.PacketCaptureID)
+}
+
 type PipelineCallbacks interface {
 	ipSetUpdateCallbacks
 	rulesUpdateCallbacks
@@ -103,6 +110,7 @@ type PipelineCallbacks interface {
 	passthruCallbacks
 	routeCallbacks
 	vxlanCallbacks
+	packetCaptureCallbacks
 }
 
 type CalcGraph struct {
@@ -253,7 +261,12 @@ func NewCalculationGraph(callbacks PipelineCallbacks, conf *config.Config) *Calc
 	ruleScanner.OnIPSetActive = func(ipSet *IPSetData) {
 		log.WithField("ipSet", ipSet).Info("IPSet now active")
 		callbacks.OnIPSetAdded(ipSet.UniqueID(), ipSet.DataplaneProtocolType())
-		if ipSet.Service != "" {
+		if len(ipSet.Domains) > 0 {
+			// Domain IP sets have static members; the dataplane resolves them to IPs.
+			for _, domain := range ipSet.Domains {
+				callbacks.OnIPSetMemberAdded(ipSet.UniqueID(), labelindex.IPSetMember{Domain: domain})
+			}
+		} else if ipSet.Service != "" {
 			serviceIndex.UpdateIPSet(ipSet.UniqueID(), ipSet.Service)
 		} else {
 			ipsetMemberIndex.UpdateIPSet(ipSet.UniqueID(), ipSet.Selector, ipSet.NamedPortProtocol, ipSet.NamedPort)
@@ -264,7 +277,7 @@ func NewCalculationGraph(callbacks PipelineCallbacks, conf *config.Config) *Calc
 		log.WithField("ipSet", ipSet).Info("IPSet now inactive")
 		if ipSet.Service != "" {
 			serviceIndex.DeleteIPSet(ipSet.UniqueID())
-		} else {
+		} else if len(ipSet.Domains) == 0 {
 			ipsetMemberIndex.DeleteIPSet(ipSet.UniqueID())
 		}
 		callbacks.OnIPSetRemoved(ipSet.UniqueID())
@@ -334,7 +347,8 @@ func NewCalculationGraph(callbacks PipelineCallbacks, conf *config.Config) *Calc
 	hostIPPassthru := NewDataplanePassthru(callbacks)
 	hostIPPassthru.RegisterWith(allUpdDispatcher)
 
-	if conf.BPFEnabled || conf.Encapsulation.VXLANEnabled || conf.WireguardEnabled {
+	if conf.BPFEnabled || conf.Encapsulation.VXLANEnabled || conf.Encapsulation.VXLANEnabledV6 || conf.WireguardEnabled ||
+		conf.WireguardEnabledV6 {
 		// Calculate simple node-ownership routes.
 		//        ...
 		//     Dispatcher (all updates)
@@ -347,7 +361,10 @@ func NewCalculationGraph(callbacks PipelineCallbacks, conf *config.Config) *Calc
 		//         |
 		//      <dataplane>
 		//
-		l3RR := NewL3RouteResolver(hostname, callbacks, conf.UseNodeResourceUpdates(), conf.RouteSource)
+		// IPv6 routes are only calculated when IPv6 VXLAN or IPv6 wireguard is enabled, since they are the only
+		// consumers of them.
+		l3RR := NewL3RouteResolver(hostname, callbacks, conf.UseNodeResourceUpdates(), conf.RouteSource,
+			conf.Encapsulation.VXLANEnabledV6 || conf.WireguardEnabledV6)
 		l3RR.RegisterWith(allUpdDispatcher, localEndpointDispatcher)
 	}
 
@@ -363,11 +380,49 @@ func NewCalculationGraph(callbacks PipelineCallbacks, conf *config.Config) *Calc
 	//         |
 	//      <dataplane>
 	//
-	if conf.Encapsulation.VXLANEnabled {
+	if conf.Encapsulation.VXLANEnabled || conf.Encapsulation.VXLANEnabledV6 {
 		vxlanResolver := NewVXLANResolver(hostname, callbacks, conf.UseNodeResourceUpdates())
 		vxlanResolver.RegisterWith(allUpdDispatcher)
 	}
 
+	// Calculate egress gateway IP sets.
+	//        ...
+	//     Dispatcher (all updates)
+	//         |
+	//         | workload endpoints, profiles
+	//         |
+	//       egress selector pool
+	//         |         \
+	//         |          | egress selectors
+	//         |          |
+	//         |        IP set member index
+	//         |          |
+	//         | client / gateway IP sets
+	//         |
+	//      <dataplane>
+	//
+	if conf.EgressGatewayEnabled {
+		egressSelectorPool := NewEgressSelectorPool(callbacks, ipsetMemberIndex)
+		egressSelectorPool.RegisterWith(allUpdDispatcher)
+	}
+
+	// Calculate the local endpoints that each packet capture selects.
+	//        ...
+	//     Dispatcher (all updates)
+	//         |    \
+	//         |     \ local endpoints
+	//         |      |
+	//         | packet captures, profiles
+	//         |      |
+	//       packet capture calculator
+	//         |
+	//         | packet captures and their local endpoints
+	//         |
+	//      <dataplane>
+	//
+	packetCaptureCalc := NewPacketCaptureCalculator(callbacks)
+	packetCaptureCalc.RegisterWith(localEndpointDispatcher, allUpdDispatcher)
+
 	// Register for config updates.
 	//
 	//        ...
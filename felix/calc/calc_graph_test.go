@@ -30,6 +30,7 @@ import (
 	"github.com/projectcalico/calico/felix/dataplane/mock"
 	"github.com/projectcalico/calico/felix/dispatcher"
 	"github.com/projectcalico/calico/felix/proto"
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 	"github.com/projectcalico/calico/libcalico-go/lib/net"
@@ -120,6 +121,23 @@ var _ = DescribeTable("Calculation graph pass-through tests",
 		proto.HostMetadataRemove{
 			Hostname: "foo",
 		}),
+	Entry("Node IPv6 address",
+		model.ResourceKey{Kind: libapiv3.KindNode, Name: "foo"},
+		&libapiv3.Node{
+			Spec: libapiv3.NodeSpec{
+				BGP: &libapiv3.NodeBGPSpec{
+					IPv4Address: "10.0.0.1/24",
+					IPv6Address: "fd00::1/64",
+				},
+			},
+		},
+		proto.HostMetadataV6Update{
+			Hostname: "foo",
+			Ipv6Addr: "fd00::1",
+		},
+		proto.HostMetadataV6Remove{
+			Hostname: "foo",
+		}),
 	Entry("Global BGPConfiguration",
 		model.ResourceKey{Kind: v3.KindBGPConfiguration, Name: "default"},
 		&v3.BGPConfiguration{
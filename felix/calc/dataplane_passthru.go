@@ -20,6 +20,7 @@ import (
 	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 
 	"github.com/projectcalico/calico/felix/dispatcher"
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 	"github.com/projectcalico/calico/libcalico-go/lib/net"
@@ -31,13 +32,15 @@ import (
 type DataplanePassthru struct {
 	callbacks passthruCallbacks
 
-	hostIPs map[string]*net.IP
+	hostIPs   map[string]*net.IP
+	hostIPv6s map[string]*net.IP
 }
 
 func NewDataplanePassthru(callbacks passthruCallbacks) *DataplanePassthru {
 	return &DataplanePassthru{
 		callbacks: callbacks,
 		hostIPs:   map[string]*net.IP{},
+		hostIPv6s: map[string]*net.IP{},
 	}
 }
 
@@ -93,9 +96,42 @@ func (h *DataplanePassthru) OnUpdate(update api.Update) (filterOut bool) {
 			log.WithField("update", update).Debug("Passing through global BGPConfiguration")
 			bgpConfig, _ := update.Value.(*v3.BGPConfiguration)
 			h.callbacks.OnGlobalBGPConfigUpdate(bgpConfig)
+		} else if key.Kind == libapiv3.KindNode {
+			h.onNodeUpdate(key.Name, update)
 		} else {
-			log.WithField("key", key).Debug("Ignoring v3 resource other than global BGPConfiguration")
+			log.WithField("key", key).Debug("Ignoring v3 resource other than global BGPConfiguration or Node")
 		}
 	}
 	return
 }
+
+// onNodeUpdate passes through changes to the IPv6 address of a node.  Unlike the IPv4 address, there
+// is no dedicated v1 model key for the IPv6 address so we extract it from the v3 Node resource.
+func (h *DataplanePassthru) onNodeUpdate(hostname string, update api.Update) {
+	var ipv6 *net.IP
+	if node, ok := update.Value.(*libapiv3.Node); ok && node.Spec.BGP != nil && node.Spec.BGP.IPv6Address != "" {
+		parsed, _, err := net.ParseCIDROrIP(node.Spec.BGP.IPv6Address)
+		if err != nil {
+			log.WithError(err).WithField("node", hostname).Warn("Failed to parse node IPv6 address, treating as missing")
+		} else if parsed.Version() == 6 {
+			ipv6 = parsed
+		}
+	}
+
+	oldIP := h.hostIPv6s[hostname]
+	if ipv6 == nil {
+		if oldIP != nil {
+			log.WithField("update", update).Debug("Passing-through HostIPv6 deletion")
+			delete(h.hostIPv6s, hostname)
+			h.callbacks.OnHostIPv6Remove(hostname)
+		}
+		return
+	}
+	if oldIP != nil && ipv6.IP.Equal(oldIP.IP) {
+		log.WithField("update", update).Debug("Ignoring duplicate HostIPv6 update")
+		return
+	}
+	log.WithField("update", update).Debug("Passing-through HostIPv6 update")
+	h.hostIPv6s[hostname] = ipv6
+	h.callbacks.OnHostIPv6Update(hostname, ipv6)
+}
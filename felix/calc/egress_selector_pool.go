@@ -0,0 +1,304 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calc
+
+import (
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/felix/dispatcher"
+	"github.com/projectcalico/calico/felix/ip"
+	"github.com/projectcalico/calico/felix/labelindex"
+	"github.com/projectcalico/calico/felix/multidict"
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/conversion"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/syncersv1/updateprocessors"
+	"github.com/projectcalico/calico/libcalico-go/lib/hash"
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
+)
+
+const (
+	// EgressGatewayIPSetPrefix and EgressClientIPSetPrefix prefix the IDs of the pair of IP sets
+	// that the EgressSelectorPool calculates for each egress selector.  The two IDs share the
+	// same hash, allowing the dataplane to pair them up.
+	EgressGatewayIPSetPrefix = "e:"
+	EgressClientIPSetPrefix  = "ec:"
+)
+
+// EgressClientIPSetID returns the ID of the IP set of workloads that use the egress gateways in
+// the given egress gateway IP set.
+func EgressClientIPSetID(gatewaySetID string) string {
+	return EgressClientIPSetPrefix + strings.TrimPrefix(gatewaySetID, EgressGatewayIPSetPrefix)
+}
+
+type egressSelectorIPSetIndex interface {
+	UpdateIPSet(ipSetID string, sel selector.Selector, namedPortProtocol labelindex.IPSetPortProtocol, namedPort string)
+	DeleteIPSet(id string)
+}
+
+// EgressSelectorPool resolves the egress gateway selector of each workload endpoint in the
+// cluster.  An endpoint's selector comes from the endpoint itself or, failing that, from the
+// first of its profiles that has one (for Kubernetes, the profile of the pod's namespace).
+//
+// For each distinct selector in use, the pool activates a pair of IP sets:
+//
+//   - The gateway IP set contains the IPs of the endpoints that the selector matches; its
+//     members are calculated by the IP set member index.
+//   - The client IP set contains the IPs of the endpoints that use the selector.
+//
+// The egress gateway manager in the dataplane uses the pairs to route the local clients'
+// traffic to the gateways and, on nodes that host gateways, to hand remote clients' traffic
+// to the local gateways.  Since the latter needs to know about remote clients, the pool
+// tracks all endpoints, not just local ones.
+type EgressSelectorPool struct {
+	callbacks  ipSetUpdateCallbacks
+	ipSetIndex egressSelectorIPSetIndex
+	converter  conversion.Converter
+
+	// profileSelectors maps from profile ID to the egress selector of that profile.
+	profileSelectors map[string]string
+	// endpoints holds the endpoints that have an egress selector or profiles.
+	endpoints map[model.WorkloadEndpointKey]*egressEndpoint
+	// profileToEndpoints indexes the endpoints by profile ID.
+	profileToEndpoints multidict.StringToIface
+	// selectors holds the active selectors, indexed by selector string.
+	selectors map[string]*egressSelector
+}
+
+type egressEndpoint struct {
+	ownSelector string
+	profileIDs  []string
+	members     []labelindex.IPSetMember
+	// activeSelector is the selector that the endpoint currently contributes to.
+	activeSelector string
+}
+
+type egressSelector struct {
+	gatewaySetID string
+	clientSetID  string
+	numClients   int
+}
+
+func NewEgressSelectorPool(callbacks ipSetUpdateCallbacks, ipSetIndex egressSelectorIPSetIndex) *EgressSelectorPool {
+	return &EgressSelectorPool{
+		callbacks:          callbacks,
+		ipSetIndex:         ipSetIndex,
+		converter:          conversion.NewConverter(),
+		profileSelectors:   map[string]string{},
+		endpoints:          map[model.WorkloadEndpointKey]*egressEndpoint{},
+		profileToEndpoints: multidict.NewStringToIface(),
+		selectors:          map[string]*egressSelector{},
+	}
+}
+
+func (p *EgressSelectorPool) RegisterWith(allUpdDispatcher *dispatcher.Dispatcher) {
+	allUpdDispatcher.Register(model.WorkloadEndpointKey{}, p.OnUpdate)
+	allUpdDispatcher.Register(model.ResourceKey{}, p.OnUpdate)
+}
+
+func (p *EgressSelectorPool) OnUpdate(update api.Update) (filterOut bool) {
+	switch key := update.Key.(type) {
+	case model.WorkloadEndpointKey:
+		if update.Value == nil {
+			p.onEndpointRemove(key)
+		} else {
+			p.onEndpointUpdate(key, update.Value.(*model.WorkloadEndpoint))
+		}
+	case model.ResourceKey:
+		if key.Kind != apiv3.KindProfile {
+			return
+		}
+		var sel string
+		if update.Value != nil {
+			sel = p.profileEgressSelector(key.Name, update.Value.(*apiv3.Profile))
+		}
+		if sel == p.profileSelectors[key.Name] {
+			return
+		}
+		log.WithFields(log.Fields{
+			"profile":  key.Name,
+			"selector": sel,
+		}).Debug("Profile egress selector changed")
+		if sel == "" {
+			delete(p.profileSelectors, key.Name)
+		} else {
+			p.profileSelectors[key.Name] = sel
+		}
+		p.profileToEndpoints.Iter(key.Name, func(item interface{}) {
+			epKey := item.(model.WorkloadEndpointKey)
+			p.updateActiveSelector(epKey, p.endpoints[epKey])
+		})
+	}
+	return
+}
+
+// profileEgressSelector returns the selector for a profile's egress gateways.  For a namespace's
+// profile, the gateways default to those in the namespace.
+func (p *EgressSelectorPool) profileEgressSelector(name string, profile *apiv3.Profile) string {
+	if profile.Spec.EgressGateway == nil {
+		return ""
+	}
+	namespace, err := p.converter.ProfileNameToNamespace(name)
+	if err != nil {
+		namespace = ""
+	}
+	return updateprocessors.GetEgressGatewaySelector(profile.Spec.EgressGateway, namespace)
+}
+
+func (p *EgressSelectorPool) onEndpointUpdate(key model.WorkloadEndpointKey, wep *model.WorkloadEndpoint) {
+	ep := p.endpoints[key]
+	if ep == nil {
+		if wep.EgressSelector == "" && len(wep.ProfileIDs) == 0 {
+			return
+		}
+		ep = &egressEndpoint{}
+		p.endpoints[key] = ep
+	}
+
+	for _, id := range ep.profileIDs {
+		p.profileToEndpoints.Discard(id, key)
+	}
+	for _, id := range wep.ProfileIDs {
+		p.profileToEndpoints.Put(id, key)
+	}
+	ep.profileIDs = wep.ProfileIDs
+	ep.ownSelector = wep.EgressSelector
+
+	var members []labelindex.IPSetMember
+	for _, nets := range [][]cnet.IPNet{wep.IPv4Nets, wep.IPv6Nets} {
+		for _, n := range nets {
+			members = append(members, labelindex.IPSetMember{CIDR: ip.CIDRFromCalicoNet(n)})
+		}
+	}
+	if ep.activeSelector != "" && !membersEqual(members, ep.members) {
+		// The endpoint's IPs changed; remove it from its client IP set so that it is re-added
+		// below with its new IPs.
+		p.removeClient(ep)
+	}
+	ep.members = members
+
+	p.updateActiveSelector(key, ep)
+}
+
+func (p *EgressSelectorPool) onEndpointRemove(key model.WorkloadEndpointKey) {
+	ep := p.endpoints[key]
+	if ep == nil {
+		return
+	}
+	for _, id := range ep.profileIDs {
+		p.profileToEndpoints.Discard(id, key)
+	}
+	if ep.activeSelector != "" {
+		p.removeClient(ep)
+	}
+	delete(p.endpoints, key)
+}
+
+// updateActiveSelector recalculates the endpoint's selector and moves the endpoint to the client
+// IP set for that selector.
+func (p *EgressSelectorPool) updateActiveSelector(key model.WorkloadEndpointKey, ep *egressEndpoint) {
+	sel := ep.ownSelector
+	if sel == "" {
+		for _, id := range ep.profileIDs {
+			if s := p.profileSelectors[id]; s != "" {
+				sel = s
+				break
+			}
+		}
+	}
+	if sel == ep.activeSelector {
+		return
+	}
+	log.WithFields(log.Fields{
+		"endpoint": key,
+		"old":      ep.activeSelector,
+		"new":      sel,
+	}).Debug("Endpoint's egress selector changed")
+	if ep.activeSelector != "" {
+		p.removeClient(ep)
+	}
+	if sel != "" {
+		p.addClient(ep, sel)
+	}
+}
+
+func (p *EgressSelectorPool) addClient(ep *egressEndpoint, selStr string) {
+	es := p.selectors[selStr]
+	if es == nil {
+		sel, err := selector.Parse(selStr)
+		if err != nil {
+			// Selectors are validated before they reach us so this shouldn't happen.
+			log.WithError(err).WithField("selector", selStr).Warn("Ignoring invalid egress selector")
+			return
+		}
+		gatewaySetID := hash.MakeUniqueID(strings.TrimSuffix(EgressGatewayIPSetPrefix, ":"), sel.UniqueID())
+		es = &egressSelector{
+			gatewaySetID: gatewaySetID,
+			clientSetID:  EgressClientIPSetID(gatewaySetID),
+		}
+		log.WithFields(log.Fields{
+			"selector":     selStr,
+			"gatewaySetID": es.gatewaySetID,
+		}).Info("Egress selector now active")
+		p.selectors[selStr] = es
+		p.callbacks.OnIPSetAdded(es.gatewaySetID, proto.IPSetUpdate_NET)
+		p.ipSetIndex.UpdateIPSet(es.gatewaySetID, sel, labelindex.ProtocolNone, "")
+		p.callbacks.OnIPSetAdded(es.clientSetID, proto.IPSetUpdate_NET)
+	}
+	for _, m := range ep.members {
+		p.callbacks.OnIPSetMemberAdded(es.clientSetID, m)
+	}
+	es.numClients++
+	ep.activeSelector = selStr
+}
+
+func (p *EgressSelectorPool) removeClient(ep *egressEndpoint) {
+	selStr := ep.activeSelector
+	ep.activeSelector = ""
+	es := p.selectors[selStr]
+	if es == nil {
+		return
+	}
+	for _, m := range ep.members {
+		p.callbacks.OnIPSetMemberRemoved(es.clientSetID, m)
+	}
+	es.numClients--
+	if es.numClients > 0 {
+		return
+	}
+	log.WithField("selector", selStr).Info("Egress selector now inactive")
+	p.callbacks.OnIPSetRemoved(es.clientSetID)
+	p.ipSetIndex.DeleteIPSet(es.gatewaySetID)
+	p.callbacks.OnIPSetRemoved(es.gatewaySetID)
+	delete(p.selectors, selStr)
+}
+
+func membersEqual(a, b []labelindex.IPSetMember) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calc_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/felix/calc"
+	"github.com/projectcalico/calico/felix/labelindex"
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/net"
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+)
+
+var _ = Describe("EgressSelectorPool", func() {
+	var (
+		pool     *calc.EgressSelectorPool
+		recorder *egressIPSetRecorder
+	)
+
+	wepKey := model.WorkloadEndpointKey{
+		Hostname:       "host1",
+		OrchestratorID: "k8s",
+		WorkloadID:     "ns1/pod1",
+		EndpointID:     "eth0",
+	}
+	wep := func(egressSelector string) *model.WorkloadEndpoint {
+		return &model.WorkloadEndpoint{
+			IPv4Nets:       []net.IPNet{net.MustParseCIDR("10.65.0.2/32")},
+			ProfileIDs:     []string{"kns.ns1"},
+			EgressSelector: egressSelector,
+		}
+	}
+	profile := func(spec *v3.EgressGatewaySpec) *v3.Profile {
+		p := v3.NewProfile()
+		p.Name = "kns.ns1"
+		p.Spec.EgressGateway = spec
+		return p
+	}
+	update := func(key model.Key, value interface{}) {
+		pool.OnUpdate(api.Update{KVPair: model.KVPair{Key: key, Value: value}})
+	}
+	profileKey := model.ResourceKey{Kind: v3.KindProfile, Name: "kns.ns1"}
+
+	BeforeEach(func() {
+		recorder = newEgressIPSetRecorder()
+		pool = calc.NewEgressSelectorPool(recorder, recorder)
+	})
+
+	It("should ignore endpoints without an egress selector", func() {
+		update(wepKey, wep(""))
+		Expect(recorder.ipSets).To(BeEmpty())
+	})
+
+	It("should activate IP sets for an endpoint's own selector", func() {
+		update(wepKey, wep("egress-code == 'red'"))
+
+		Expect(recorder.ipSets).To(HaveLen(2))
+		gwID := recorder.gatewaySetID()
+		Expect(recorder.selectors[gwID]).To(Equal("egress-code == \"red\""))
+		Expect(recorder.ipSets[calc.EgressClientIPSetID(gwID)].Contains("10.65.0.2/32")).To(BeTrue())
+
+		By("removing the IP sets when the endpoint is deleted")
+		update(wepKey, nil)
+		Expect(recorder.ipSets).To(BeEmpty())
+		Expect(recorder.selectors).To(BeEmpty())
+	})
+
+	It("should fall back to the namespace profile's selector", func() {
+		update(profileKey, profile(&v3.EgressGatewaySpec{Selector: "egress-code == 'red'"}))
+		Expect(recorder.ipSets).To(BeEmpty())
+
+		update(wepKey, wep(""))
+		gwID := recorder.gatewaySetID()
+		Expect(recorder.selectors[gwID]).To(Equal(
+			"(projectcalico.org/namespace == \"ns1\" && egress-code == \"red\")"))
+		Expect(recorder.ipSets[calc.EgressClientIPSetID(gwID)].Contains("10.65.0.2/32")).To(BeTrue())
+
+		By("preferring the endpoint's own selector")
+		update(wepKey, wep("egress-code == 'blue'"))
+		Expect(recorder.ipSets).To(HaveLen(2))
+		Expect(recorder.selectors[recorder.gatewaySetID()]).To(Equal("egress-code == \"blue\""))
+
+		By("going back to the profile's selector")
+		update(wepKey, wep(""))
+		Expect(recorder.ipSets).To(HaveLen(2))
+		Expect(recorder.selectors[recorder.gatewaySetID()]).To(ContainSubstring("ns1"))
+
+		By("removing the IP sets when the profile's selector is removed")
+		update(profileKey, profile(nil))
+		Expect(recorder.ipSets).To(BeEmpty())
+	})
+
+	It("should share IP sets between endpoints with the same selector", func() {
+		wepKey2 := wepKey
+		wepKey2.WorkloadID = "ns1/pod2"
+		wep2 := wep("egress-code == 'red'")
+		wep2.IPv4Nets = []net.IPNet{net.MustParseCIDR("10.65.1.2/32")}
+
+		update(wepKey, wep("egress-code == 'red'"))
+		update(wepKey2, wep2)
+		Expect(recorder.ipSets).To(HaveLen(2))
+		clientSet := recorder.ipSets[calc.EgressClientIPSetID(recorder.gatewaySetID())]
+		Expect(clientSet.Len()).To(Equal(2))
+
+		By("updating the client IP set when an endpoint's IP changes")
+		wep2.IPv4Nets = []net.IPNet{net.MustParseCIDR("10.65.1.3/32")}
+		update(wepKey2, wep2)
+		Expect(clientSet.Contains("10.65.1.2/32")).To(BeFalse())
+		Expect(clientSet.Contains("10.65.1.3/32")).To(BeTrue())
+
+		By("keeping the IP sets until the last endpoint goes")
+		update(wepKey, nil)
+		Expect(recorder.ipSets).To(HaveLen(2))
+		Expect(clientSet.Len()).To(Equal(1))
+		update(wepKey2, nil)
+		Expect(recorder.ipSets).To(BeEmpty())
+	})
+})
+
+// egressIPSetRecorder records the IP set updates and the selectors that the pool sends to the
+// IP set member index.
+type egressIPSetRecorder struct {
+	ipSets    map[string]set.Set
+	selectors map[string]string
+}
+
+func newEgressIPSetRecorder() *egressIPSetRecorder {
+	return &egressIPSetRecorder{
+		ipSets:    map[string]set.Set{},
+		selectors: map[string]string{},
+	}
+}
+
+func (r *egressIPSetRecorder) gatewaySetID() string {
+	for id := range r.ipSets {
+		if strings.HasPrefix(id, calc.EgressGatewayIPSetPrefix) {
+			return id
+		}
+	}
+	return ""
+}
+
+func (r *egressIPSetRecorder) OnIPSetAdded(setID string, ipSetType proto.IPSetUpdate_IPSetType) {
+	Expect(r.ipSets).NotTo(HaveKey(setID))
+	Expect(ipSetType).To(Equal(proto.IPSetUpdate_NET))
+	r.ipSets[setID] = set.New()
+}
+
+func (r *egressIPSetRecorder) OnIPSetMemberAdded(setID string, member labelindex.IPSetMember) {
+	Expect(r.ipSets).To(HaveKey(setID))
+	r.ipSets[setID].Add(member.CIDR.String())
+}
+
+func (r *egressIPSetRecorder) OnIPSetMemberRemoved(setID string, member labelindex.IPSetMember) {
+	Expect(r.ipSets).To(HaveKey(setID))
+	r.ipSets[setID].Discard(member.CIDR.String())
+}
+
+func (r *egressIPSetRecorder) OnIPSetRemoved(setID string) {
+	Expect(r.ipSets).To(HaveKey(setID))
+	delete(r.ipSets, setID)
+}
+
+func (r *egressIPSetRecorder) UpdateIPSet(ipSetID string, sel selector.Selector, _ labelindex.IPSetPortProtocol, _ string) {
+	r.selectors[ipSetID] = sel.String()
+}
+
+func (r *egressIPSetRecorder) DeleteIPSet(id string) {
+	delete(r.selectors, id)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/encap"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
 )
 
 // EncapsulationResolver is a Calculation Graph component that watches IP pool updates and
@@ -84,16 +85,19 @@ func (r *EncapsulationResolver) triggerCalculation() {
 	}
 
 	newEncap := config.Encapsulation{
-		IPIPEnabled:  r.encapCalc.IPIPEnabled(),
-		VXLANEnabled: r.encapCalc.VXLANEnabled(),
+		IPIPEnabled:    r.encapCalc.IPIPEnabled(),
+		VXLANEnabled:   r.encapCalc.VXLANEnabled(),
+		VXLANEnabledV6: r.encapCalc.VXLANEnabledV6(),
 	}
 
-	if r.config.Encapsulation.IPIPEnabled != newEncap.IPIPEnabled || r.config.Encapsulation.VXLANEnabled != newEncap.VXLANEnabled {
+	if r.config.Encapsulation != newEncap {
 		log.WithFields(log.Fields{
-			"oldIPIPEnabled":  r.config.Encapsulation.IPIPEnabled,
-			"newIPIPEnabled":  newEncap.IPIPEnabled,
-			"oldVXLANEnabled": r.config.Encapsulation.VXLANEnabled,
-			"newVXLANEnabled": newEncap.VXLANEnabled,
+			"oldIPIPEnabled":    r.config.Encapsulation.IPIPEnabled,
+			"newIPIPEnabled":    newEncap.IPIPEnabled,
+			"oldVXLANEnabled":   r.config.Encapsulation.VXLANEnabled,
+			"newVXLANEnabled":   newEncap.VXLANEnabled,
+			"oldVXLANEnabledV6": r.config.Encapsulation.VXLANEnabledV6,
+			"newVXLANEnabledV6": newEncap.VXLANEnabledV6,
 		}).Info("EncapsulationResolver: Encapsulation changed.")
 	}
 
@@ -102,13 +106,15 @@ func (r *EncapsulationResolver) triggerCalculation() {
 
 // EncapsulationCalculator is a helper struct to aid in calculating if IPIP and/or VXLAN
 // encapsulation should be enabled based on the existing IP Pools and their
-// configuration. It is used by EncapsulationResolver in this file, where it watches for
+// configuration.  VXLAN is tracked separately for IPv4 and IPv6 pools since each IP
+// version has its own VXLAN device. It is used by EncapsulationResolver in this file, where it watches for
 // encapsulation changes to restart Felix, and by Run() in daemon.go, where it calculates
 // the encapsulation state that will be effectively used by Felix.
 type EncapsulationCalculator struct {
-	config     *config.Config
-	ipipPools  map[string]struct{}
-	vxlanPools map[string]struct{}
+	config       *config.Config
+	ipipPools    map[string]struct{}
+	vxlanPools   map[string]struct{}
+	vxlanV6Pools map[string]struct{}
 }
 
 func NewEncapsulationCalculator(config *config.Config, ippoolKVPList *model.KVPairList) *EncapsulationCalculator {
@@ -117,9 +123,10 @@ func NewEncapsulationCalculator(config *config.Config, ippoolKVPList *model.KVPa
 	}
 
 	encapCalc := &EncapsulationCalculator{
-		config:     config,
-		ipipPools:  map[string]struct{}{},
-		vxlanPools: map[string]struct{}{},
+		config:       config,
+		ipipPools:    map[string]struct{}{},
+		vxlanPools:   map[string]struct{}{},
+		vxlanV6Pools: map[string]struct{}{},
 	}
 
 	if ippoolKVPList != nil {
@@ -163,7 +170,7 @@ func (c *EncapsulationCalculator) handleModelPool(p model.KVPair) error {
 		c.removePool(poolKey)
 	} else {
 		pool, _ := p.Value.(*model.IPPool)
-		c.updatePool(poolKey, pool.IPIPMode != encap.Undefined, pool.VXLANMode != encap.Undefined)
+		c.updatePool(poolKey, pool.CIDR.Version(), pool.IPIPMode != encap.Undefined, pool.VXLANMode != encap.Undefined)
 
 	}
 
@@ -189,28 +196,37 @@ func (c *EncapsulationCalculator) handleAPIPool(p model.KVPair) error {
 	}
 
 	poolKey := pool.Spec.CIDR
-	c.updatePool(poolKey, pool.Spec.IPIPMode != apiv3.IPIPModeNever, pool.Spec.VXLANMode != apiv3.VXLANModeNever)
+	_, cidr, err := cnet.ParseCIDR(poolKey)
+	if err != nil {
+		return fmt.Errorf("failed to parse IP pool CIDR %s: %v", poolKey, err)
+	}
+	c.updatePool(poolKey, cidr.Version(), pool.Spec.IPIPMode != apiv3.IPIPModeNever, pool.Spec.VXLANMode != apiv3.VXLANModeNever)
 
 	return nil
 }
 
-func (c *EncapsulationCalculator) updatePool(cidr string, ipipEnabled, vxlanEnabled bool) {
+func (c *EncapsulationCalculator) updatePool(cidr string, ipVersion int, ipipEnabled, vxlanEnabled bool) {
 	if ipipEnabled {
 		c.ipipPools[cidr] = struct{}{}
 	} else {
 		delete(c.ipipPools, cidr)
 	}
 
+	vxlanPools := c.vxlanPools
+	if ipVersion == 6 {
+		vxlanPools = c.vxlanV6Pools
+	}
 	if vxlanEnabled {
-		c.vxlanPools[cidr] = struct{}{}
+		vxlanPools[cidr] = struct{}{}
 	} else {
-		delete(c.vxlanPools, cidr)
+		delete(vxlanPools, cidr)
 	}
 }
 
 func (c *EncapsulationCalculator) removePool(cidr string) {
 	delete(c.ipipPools, cidr)
 	delete(c.vxlanPools, cidr)
+	delete(c.vxlanV6Pools, cidr)
 }
 
 func (c *EncapsulationCalculator) IPIPEnabled() bool {
@@ -228,3 +244,11 @@ func (c *EncapsulationCalculator) VXLANEnabled() bool {
 
 	return len(c.vxlanPools) > 0
 }
+
+func (c *EncapsulationCalculator) VXLANEnabledV6() bool {
+	if c.config != nil && c.config.VXLANEnabledV6 != nil {
+		return *c.config.VXLANEnabledV6
+	}
+
+	return len(c.vxlanV6Pools) > 0
+}
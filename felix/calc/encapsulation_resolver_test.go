@@ -172,6 +172,48 @@ var _ = Describe("EncapsulationCalculator", func() {
 				true, false),
 		)
 	})
+	Context("IPv6 pools", func() {
+		DescribeTable("pool tests",
+			func(apiPoolsToAdd, modelPoolsToAdd []model.KVPair, modelPoolsToRemove []string, expectedVXLAN, expectedVXLANV6 bool) {
+				for _, p := range apiPoolsToAdd {
+					err := encapsulationCalculator.handlePool(p)
+					Expect(err).To(Not(HaveOccurred()))
+				}
+				for _, p := range modelPoolsToAdd {
+					err := encapsulationCalculator.handlePool(p)
+					Expect(err).To(Not(HaveOccurred()))
+				}
+				for _, p := range modelPoolsToRemove {
+					_, cidr, err := net.ParseCIDR(p)
+					Expect(err).To(Not(HaveOccurred()))
+					err = encapsulationCalculator.handlePool(model.KVPair{Key: model.IPPoolKey{CIDR: *cidr}})
+					Expect(err).To(Not(HaveOccurred()))
+				}
+				Expect(encapsulationCalculator.VXLANEnabled()).To(Equal(expectedVXLAN))
+				Expect(encapsulationCalculator.VXLANEnabledV6()).To(Equal(expectedVXLANV6))
+			},
+			Entry("API IPv6 pool with VXLAN 'Always'",
+				[]model.KVPair{*getAPIPool("fd00::/64", apiv3.IPIPModeNever, apiv3.VXLANModeAlways)}, nil, nil,
+				false, true),
+			Entry("Model IPv6 pool with VXLAN 'CrossSubnet'",
+				nil, []model.KVPair{*getModelPool("fd00::/64", encap.Undefined, encap.CrossSubnet)}, nil,
+				false, true),
+			Entry("Dual-stack model pools with VXLAN",
+				nil, []model.KVPair{*getModelPool("192.168.1.0/24", encap.Undefined, encap.Always), *getModelPool("fd00::/64", encap.Undefined, encap.Always)}, nil,
+				true, true),
+			Entry("Dual-stack model pools with VXLAN, then remove the IPv6 pool",
+				nil, []model.KVPair{*getModelPool("192.168.1.0/24", encap.Undefined, encap.Always), *getModelPool("fd00::/64", encap.Undefined, encap.Always)},
+				[]string{"fd00::/64"},
+				true, false),
+		)
+
+		It("should respect VXLANEnabledV6 in FelixConfig", func() {
+			f := false
+			conf.VXLANEnabledV6 = &f
+			Expect(encapsulationCalculator.handlePool(*getModelPool("fd00::/64", encap.Undefined, encap.Always))).To(Succeed())
+			Expect(encapsulationCalculator.VXLANEnabledV6()).To(BeFalse())
+		})
+	})
 	Context("FelixConfig set", func() {
 		t := true
 		f := false
@@ -436,8 +478,9 @@ type encapResolverCallbackRecorder struct {
 func (e *encapResolverCallbackRecorder) OnEncapUpdate(encap config.Encapsulation) {
 	e.encapUpdates = append(e.encapUpdates,
 		&proto.Encapsulation{
-			IpipEnabled:  encap.IPIPEnabled,
-			VxlanEnabled: encap.VXLANEnabled,
+			IpipEnabled:    encap.IPIPEnabled,
+			VxlanEnabled:   encap.VXLANEnabled,
+			VxlanEnabledV6: encap.VXLANEnabledV6,
 		})
 }
 
@@ -449,6 +492,14 @@ func (e *encapResolverCallbackRecorder) OnHostIPRemove(hostname string) {
 	Fail("HostIPRemove received")
 }
 
+func (e *encapResolverCallbackRecorder) OnHostIPv6Update(hostname string, ip *net.IP) {
+	Fail("HostIPv6Update received")
+}
+
+func (e *encapResolverCallbackRecorder) OnHostIPv6Remove(hostname string) {
+	Fail("HostIPv6Remove received")
+}
+
 func (e *encapResolverCallbackRecorder) OnIPPoolUpdate(model.IPPoolKey, *model.IPPool) {
 	Fail("IPPoolUpdate received")
 }
@@ -61,6 +61,8 @@ type EventSequencer struct {
 	pendingEndpointDeletes       set.Set
 	pendingHostIPUpdates         map[string]*net.IP
 	pendingHostIPDeletes         set.Set
+	pendingHostIPv6Updates       map[string]*net.IP
+	pendingHostIPv6Deletes       set.Set
 	pendingIPPoolUpdates         map[ip.CIDR]*model.IPPool
 	pendingIPPoolDeletes         set.Set
 	pendingNotReady              bool
@@ -77,6 +79,8 @@ type EventSequencer struct {
 	pendingWireguardUpdates      map[string]*model.Wireguard
 	pendingWireguardDeletes      set.Set
 	pendingGlobalBGPConfig       *proto.GlobalBGPConfigUpdate
+	pendingPacketCaptureUpdates  map[proto.PacketCaptureID]*proto.PacketCaptureUpdate
+	pendingPacketCaptureDeletes  set.Set
 
 	// Sets to record what we've sent downstream. Updated whenever we flush.
 	sentIPSets          set.Set
@@ -84,12 +88,15 @@ type EventSequencer struct {
 	sentProfiles        set.Set
 	sentEndpoints       set.Set
 	sentHostIPs         set.Set
+	sentHostIPv6s       set.Set
 	sentIPPools         set.Set
 	sentServiceAccounts set.Set
 	sentNamespaces      set.Set
 	sentRoutes          set.Set
 	sentVTEPs           set.Set
 	sentWireguard       set.Set
+	sentWireguardV6     set.Set
+	sentPacketCaptures  set.Set
 
 	Callback EventHandler
 }
@@ -121,6 +128,8 @@ func NewEventSequencer(conf configInterface) *EventSequencer {
 		pendingEndpointDeletes:       set.New(),
 		pendingHostIPUpdates:         map[string]*net.IP{},
 		pendingHostIPDeletes:         set.New(),
+		pendingHostIPv6Updates:       map[string]*net.IP{},
+		pendingHostIPv6Deletes:       set.New(),
 		pendingIPPoolUpdates:         map[ip.CIDR]*model.IPPool{},
 		pendingIPPoolDeletes:         set.New(),
 		pendingServiceAccountUpdates: map[proto.ServiceAccountID]*proto.ServiceAccountUpdate{},
@@ -133,6 +142,8 @@ func NewEventSequencer(conf configInterface) *EventSequencer {
 		pendingVTEPDeletes:           set.New(),
 		pendingWireguardUpdates:      map[string]*model.Wireguard{},
 		pendingWireguardDeletes:      set.New(),
+		pendingPacketCaptureUpdates:  map[proto.PacketCaptureID]*proto.PacketCaptureUpdate{},
+		pendingPacketCaptureDeletes:  set.New(),
 
 		// Sets to record what we've sent downstream. Updated whenever we flush.
 		sentIPSets:          set.New(),
@@ -140,12 +151,15 @@ func NewEventSequencer(conf configInterface) *EventSequencer {
 		sentProfiles:        set.New(),
 		sentEndpoints:       set.New(),
 		sentHostIPs:         set.New(),
+		sentHostIPv6s:       set.New(),
 		sentIPPools:         set.New(),
 		sentServiceAccounts: set.New(),
 		sentNamespaces:      set.New(),
 		sentRoutes:          set.New(),
 		sentVTEPs:           set.New(),
 		sentWireguard:       set.New(),
+		sentWireguardV6:     set.New(),
+		sentPacketCaptures:  set.New(),
 	}
 	return buf
 }
@@ -272,20 +286,21 @@ func (buf *EventSequencer) flushPolicyUpdates() {
 }
 
 func ParsedRulesToActivePolicyUpdate(key model.PolicyKey, rules *ParsedRules) *proto.ActivePolicyUpdate {
+	tier := tierNameOrDefault(key.Tier)
 	return &proto.ActivePolicyUpdate{
 		Id: &proto.PolicyID{
-			Tier: "default",
+			Tier: tier,
 			Name: key.Name,
 		},
 		Policy: &proto.Policy{
 			Namespace: rules.Namespace,
 			InboundRules: parsedRulesToProtoRules(
 				rules.InboundRules,
-				"pol-in-default/"+key.Name,
+				"pol-in-"+tier+"/"+key.Name,
 			),
 			OutboundRules: parsedRulesToProtoRules(
 				rules.OutboundRules,
-				"pol-out-default/"+key.Name,
+				"pol-out-"+tier+"/"+key.Name,
 			),
 			Untracked: rules.Untracked,
 			PreDnat:   rules.PreDNAT,
@@ -301,10 +316,11 @@ func (buf *EventSequencer) OnPolicyInactive(key model.PolicyKey) {
 }
 func (buf *EventSequencer) flushPolicyDeletes() {
 	buf.pendingPolicyDeletes.Iter(func(item interface{}) error {
+		key := item.(model.PolicyKey)
 		buf.Callback(&proto.ActivePolicyRemove{
 			Id: &proto.PolicyID{
-				Tier: "default",
-				Name: item.(model.PolicyKey).Name,
+				Tier: tierNameOrDefault(key.Tier),
+				Name: key.Name,
 			},
 		})
 		buf.sentPolicies.Discard(item)
@@ -364,15 +380,16 @@ func ModelWorkloadEndpointToProto(ep *model.WorkloadEndpoint, tiers []*proto.Tie
 		mac = ep.Mac.String()
 	}
 	return &proto.WorkloadEndpoint{
-		State:      ep.State,
-		Name:       ep.Name,
-		Mac:        mac,
-		ProfileIds: ep.ProfileIDs,
-		Ipv4Nets:   netsToStrings(ep.IPv4Nets),
-		Ipv6Nets:   netsToStrings(ep.IPv6Nets),
-		Tiers:      tiers,
-		Ipv4Nat:    natsToProtoNatInfo(ep.IPv4NAT),
-		Ipv6Nat:    natsToProtoNatInfo(ep.IPv6NAT),
+		State:       ep.State,
+		Name:        ep.Name,
+		Mac:         mac,
+		ProfileIds:  ep.ProfileIDs,
+		Ipv4Nets:    netsToStrings(ep.IPv4Nets),
+		Ipv6Nets:    netsToStrings(ep.IPv6Nets),
+		Tiers:       tiers,
+		Ipv4Nat:     natsToProtoNatInfo(ep.IPv4NAT),
+		Ipv6Nat:     natsToProtoNatInfo(ep.IPv6NAT),
+		QosControls: qosControlsToProto(ep.QoSControls),
 	}
 }
 
@@ -465,8 +482,9 @@ func (buf *EventSequencer) flushEndpointTierDeletes() {
 
 func (buf *EventSequencer) OnEncapUpdate(encap config.Encapsulation) {
 	log.WithFields(log.Fields{
-		"IPIPEnabled":  encap.IPIPEnabled,
-		"VXLANEnabled": encap.VXLANEnabled,
+		"IPIPEnabled":    encap.IPIPEnabled,
+		"VXLANEnabled":   encap.VXLANEnabled,
+		"VXLANEnabledV6": encap.VXLANEnabledV6,
 	}).Debug("Encapsulation update")
 	buf.pendingEncapUpdate = &encap
 }
@@ -474,8 +492,9 @@ func (buf *EventSequencer) OnEncapUpdate(encap config.Encapsulation) {
 func (buf *EventSequencer) flushEncapUpdate() {
 	if buf.pendingEncapUpdate != nil {
 		buf.Callback(&proto.Encapsulation{
-			IpipEnabled:  buf.pendingEncapUpdate.IPIPEnabled,
-			VxlanEnabled: buf.pendingEncapUpdate.VXLANEnabled,
+			IpipEnabled:    buf.pendingEncapUpdate.IPIPEnabled,
+			VxlanEnabled:   buf.pendingEncapUpdate.VXLANEnabled,
+			VxlanEnabledV6: buf.pendingEncapUpdate.VXLANEnabledV6,
 		})
 		buf.pendingEncapUpdate = nil
 	}
@@ -518,6 +537,44 @@ func (buf *EventSequencer) flushHostIPDeletes() {
 	})
 }
 
+func (buf *EventSequencer) OnHostIPv6Update(hostname string, ip *net.IP) {
+	log.WithFields(log.Fields{
+		"hostname": hostname,
+		"ip":       ip,
+	}).Debug("HostIPv6 update")
+	buf.pendingHostIPv6Deletes.Discard(hostname)
+	buf.pendingHostIPv6Updates[hostname] = ip
+}
+
+func (buf *EventSequencer) flushHostIPv6Updates() {
+	for hostname, hostIP := range buf.pendingHostIPv6Updates {
+		buf.Callback(&proto.HostMetadataV6Update{
+			Hostname: hostname,
+			Ipv6Addr: hostIP.IP.String(),
+		})
+		buf.sentHostIPv6s.Add(hostname)
+		delete(buf.pendingHostIPv6Updates, hostname)
+	}
+}
+
+func (buf *EventSequencer) OnHostIPv6Remove(hostname string) {
+	log.WithField("hostname", hostname).Debug("HostIPv6 removed")
+	delete(buf.pendingHostIPv6Updates, hostname)
+	if buf.sentHostIPv6s.Contains(hostname) {
+		buf.pendingHostIPv6Deletes.Add(hostname)
+	}
+}
+
+func (buf *EventSequencer) flushHostIPv6Deletes() {
+	buf.pendingHostIPv6Deletes.Iter(func(item interface{}) error {
+		buf.Callback(&proto.HostMetadataV6Remove{
+			Hostname: item.(string),
+		})
+		buf.sentHostIPv6s.Discard(item)
+		return set.RemoveItem
+	})
+}
+
 func (buf *EventSequencer) OnIPPoolUpdate(key model.IPPoolKey, pool *model.IPPool) {
 	log.WithFields(log.Fields{
 		"key":  key,
@@ -544,16 +601,47 @@ func (buf *EventSequencer) flushIPPoolUpdates() {
 
 func (buf *EventSequencer) flushHostWireguardUpdates() {
 	for nodename, wg := range buf.pendingWireguardUpdates {
-		var ipstr string
-		if wg.InterfaceIPv4Addr != nil {
-			ipstr = wg.InterfaceIPv4Addr.String()
+		// The IPv4 and IPv6 wireguard devices are managed independently, so send separate messages for each. If
+		// the node no longer has any wireguard data for one of the IP versions then remove it for that version.
+		if wg.PublicKey != "" || wg.InterfaceIPv4Addr != nil {
+			var ipstr string
+			if wg.InterfaceIPv4Addr != nil {
+				ipstr = wg.InterfaceIPv4Addr.String()
+			}
+			buf.Callback(&proto.WireguardEndpointUpdate{
+				Hostname:                 nodename,
+				PublicKey:                wg.PublicKey,
+				InterfaceIpv4Addr:        ipstr,
+				PendingPublicKey:         wg.PendingPublicKey,
+				AppliedPendingPublicKeys: wg.AppliedPendingPublicKeys,
+			})
+			buf.sentWireguard.Add(nodename)
+		} else if buf.sentWireguard.Contains(nodename) {
+			buf.Callback(&proto.WireguardEndpointRemove{
+				Hostname: nodename,
+			})
+			buf.sentWireguard.Discard(nodename)
+		}
+
+		if wg.PublicKeyV6 != "" || wg.InterfaceIPv6Addr != nil {
+			var ipstr string
+			if wg.InterfaceIPv6Addr != nil {
+				ipstr = wg.InterfaceIPv6Addr.String()
+			}
+			buf.Callback(&proto.WireguardEndpointV6Update{
+				Hostname:                   nodename,
+				PublicKeyV6:                wg.PublicKeyV6,
+				InterfaceIpv6Addr:          ipstr,
+				PendingPublicKeyV6:         wg.PendingPublicKeyV6,
+				AppliedPendingPublicKeysV6: wg.AppliedPendingPublicKeysV6,
+			})
+			buf.sentWireguardV6.Add(nodename)
+		} else if buf.sentWireguardV6.Contains(nodename) {
+			buf.Callback(&proto.WireguardEndpointV6Remove{
+				Hostname: nodename,
+			})
+			buf.sentWireguardV6.Discard(nodename)
 		}
-		buf.Callback(&proto.WireguardEndpointUpdate{
-			Hostname:          nodename,
-			PublicKey:         wg.PublicKey,
-			InterfaceIpv4Addr: ipstr,
-		})
-		buf.sentWireguard.Add(nodename)
 		delete(buf.pendingWireguardUpdates, nodename)
 	}
 }
@@ -587,6 +675,12 @@ func (buf *EventSequencer) flushHostWireguardDeletes() {
 			})
 			buf.sentWireguard.Discard(key)
 		}
+		if buf.sentWireguardV6.Contains(key) {
+			buf.Callback(&proto.WireguardEndpointV6Remove{
+				Hostname: key,
+			})
+			buf.sentWireguardV6.Discard(key)
+		}
 		return set.RemoveItem
 	})
 }
@@ -611,6 +705,9 @@ func (buf *EventSequencer) flushAddedIPSets() {
 }
 
 func memberToProto(member labelindex.IPSetMember) string {
+	if member.Domain != "" {
+		return member.Domain
+	}
 	switch member.Protocol {
 	case labelindex.ProtocolNone:
 		return member.CIDR.String()
@@ -663,6 +760,8 @@ func (buf *EventSequencer) Flush() {
 	buf.flushHostWireguardUpdates()
 	buf.flushHostIPDeletes()
 	buf.flushHostIPUpdates()
+	buf.flushHostIPv6Deletes()
+	buf.flushHostIPv6Updates()
 	buf.flushIPPoolDeletes()
 	buf.flushIPPoolUpdates()
 	buf.flushEncapUpdate()
@@ -672,6 +771,9 @@ func (buf *EventSequencer) Flush() {
 		buf.Callback(buf.pendingGlobalBGPConfig)
 		buf.pendingGlobalBGPConfig = nil
 	}
+
+	// Flush packet captures last, after the endpoints that they refer to.
+	buf.flushPacketCaptures()
 }
 
 func (buf *EventSequencer) flushRemovedIPSets() {
@@ -866,6 +968,38 @@ func (buf *EventSequencer) flushVTEPAdds() {
 	log.Debug("Done flushing VTEP adds")
 }
 
+func (buf *EventSequencer) OnPacketCaptureUpdate(update *proto.PacketCaptureUpdate) {
+	id := *update.Id
+	log.WithField("id", id).Debug("Packet capture update")
+	buf.pendingPacketCaptureDeletes.Discard(id)
+	buf.pendingPacketCaptureUpdates[id] = update
+}
+
+func (buf *EventSequencer) OnPacketCaptureRemove(id proto.PacketCaptureID) {
+	log.WithField("id", id).Debug("Packet capture removed")
+	delete(buf.pendingPacketCaptureUpdates, id)
+	if buf.sentPacketCaptures.Contains(id) {
+		buf.pendingPacketCaptureDeletes.Add(id)
+	}
+}
+
+func (buf *EventSequencer) flushPacketCaptures() {
+	// Order doesn't matter, but send removes first to reduce max occupancy
+	buf.pendingPacketCaptureDeletes.Iter(func(item interface{}) error {
+		id := item.(proto.PacketCaptureID)
+		buf.Callback(&proto.PacketCaptureRemove{Id: &id})
+		buf.sentPacketCaptures.Discard(id)
+		return nil
+	})
+	buf.pendingPacketCaptureDeletes.Clear()
+	for id, msg := range buf.pendingPacketCaptureUpdates {
+		buf.Callback(msg)
+		buf.sentPacketCaptures.Add(id)
+	}
+	buf.pendingPacketCaptureUpdates = make(map[proto.PacketCaptureID]*proto.PacketCaptureUpdate)
+	log.Debug("Done flushing packet captures")
+}
+
 func (buf *EventSequencer) OnRouteUpdate(update *proto.RouteUpdate) {
 	routeID := routeID{
 		dst: update.Dst,
@@ -923,10 +1057,14 @@ func addPolicyToTierInfo(pol *PolKV, tierInfo *proto.TierInfo, egressAllowed boo
 func tierInfoToProtoTierInfo(filteredTiers []tierInfo) (normalTiers, untrackedTiers, preDNATTiers, forwardTiers []*proto.TierInfo) {
 	if len(filteredTiers) > 0 {
 		for _, ti := range filteredTiers {
-			untrackedTierInfo := &proto.TierInfo{Name: ti.Name}
-			preDNATTierInfo := &proto.TierInfo{Name: ti.Name}
-			forwardTierInfo := &proto.TierInfo{Name: ti.Name}
-			normalTierInfo := &proto.TierInfo{Name: ti.Name}
+			defaultAction := ti.DefaultAction
+			if defaultAction == "" {
+				defaultAction = string(v3.Deny)
+			}
+			untrackedTierInfo := &proto.TierInfo{Name: ti.Name, DefaultAction: defaultAction}
+			preDNATTierInfo := &proto.TierInfo{Name: ti.Name, DefaultAction: defaultAction}
+			forwardTierInfo := &proto.TierInfo{Name: ti.Name, DefaultAction: defaultAction}
+			normalTierInfo := &proto.TierInfo{Name: ti.Name, DefaultAction: defaultAction}
 			for _, pol := range ti.OrderedPolicies {
 				if pol.Value.DoNotTrack {
 					addPolicyToTierInfo(&pol, untrackedTierInfo, true)
@@ -973,6 +1111,20 @@ func ipsToStrings(ips []net.IP) []string {
 	return output
 }
 
+func qosControlsToProto(q *model.QoSControls) *proto.QoSControls {
+	if q == nil {
+		return nil
+	}
+	return &proto.QoSControls{
+		IngressBandwidth:  q.IngressBandwidth,
+		EgressBandwidth:   q.EgressBandwidth,
+		IngressBurst:      q.IngressBurst,
+		EgressBurst:       q.EgressBurst,
+		IngressPacketRate: q.IngressPacketRate,
+		EgressPacketRate:  q.EgressPacketRate,
+	}
+}
+
 func natsToProtoNatInfo(nats []model.IPNAT) []*proto.NatInfo {
 	protoNats := make([]*proto.NatInfo, len(nats))
 	for ii, nat := range nats {
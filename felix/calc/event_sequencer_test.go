@@ -98,7 +98,7 @@ var _ = Describe("ParsedRulesToActivePolicyUpdate", func() {
 		// We use reflection to scan all the fields in the protobuf rule to make sure that they're
 		// all filled in.  If any are still at their zero value, either hte test is out of date
 		// or we forgot to add conversion logic for that field.
-		protoUpdate := calc.ParsedRulesToActivePolicyUpdate(model.PolicyKey{Name: "a-policy"}, &fullyLoadedParsedRules)
+		protoUpdate := calc.ParsedRulesToActivePolicyUpdate(model.PolicyKey{Tier: "default", Name: "a-policy"}, &fullyLoadedParsedRules)
 		protoPolicy := *protoUpdate.Policy
 		protoPolicyValue := reflect.ValueOf(protoPolicy)
 		numFields := protoPolicyValue.NumField()
@@ -112,7 +112,7 @@ var _ = Describe("ParsedRulesToActivePolicyUpdate", func() {
 	})
 
 	It("should convert the fully-loaded rule", func() {
-		protoUpdate := calc.ParsedRulesToActivePolicyUpdate(model.PolicyKey{Name: "a-policy"}, &fullyLoadedParsedRules)
+		protoUpdate := calc.ParsedRulesToActivePolicyUpdate(model.PolicyKey{Tier: "default", Name: "a-policy"}, &fullyLoadedParsedRules)
 		// Check the rule IDs are filled in but ignore them for comparisons.
 		for _, r := range protoUpdate.Policy.InboundRules {
 			Expect(r.RuleId).ToNot(Equal(""))
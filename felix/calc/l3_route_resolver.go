@@ -1,4 +1,4 @@
-// Copyright (c) 2019-2021 Tigera, Inc. All rights reserved.
+// Copyright (c) 2019-2022 Tigera, Inc. All rights reserved.
 
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -34,7 +34,7 @@ import (
 	"github.com/projectcalico/calico/felix/proto"
 )
 
-// L3RouteResolver is responsible for indexing (currently only IPv4 versions of):
+// L3RouteResolver is responsible for indexing (IPv4 and, if IPv6 is enabled, IPv6 versions of):
 //
 // - IPAM blocks
 // - IP pools
@@ -45,7 +45,7 @@ import (
 // - The relevant destination CIDR.
 // - The IP pool type that contains the CIDR (or none).
 // - Other metadata about the containing IP pool.
-// - Whether this (/32) CIDR is a host or not.
+// - Whether this (/32 or /128) CIDR is a host or not.
 // - For workload CIDRs, the IP and name of the host that contains the workload.
 //
 // The BPF dataplane use the above to form a map of IP space so it can look up whether a particular
@@ -67,16 +67,23 @@ type L3RouteResolver struct {
 	workloadIDToCIDRs      map[model.WorkloadEndpointKey][]cnet.IPNet
 	useNodeResourceUpdates bool
 	routeSource            string
+	ipv6Enabled            bool
 }
 
 type l3rrNodeInfo struct {
 	Addr ip.V4Addr
 	CIDR ip.V4CIDR
 
+	// IPv6 address and subnet of the node, only known when node resource updates are in use.
+	V6Addr ip.V6Addr
+	V6CIDR ip.V6CIDR
+
 	// Tunnel IP addresses
-	IPIPAddr      ip.Addr
-	VXLANAddr     ip.Addr
-	WireguardAddr ip.Addr
+	IPIPAddr        ip.Addr
+	VXLANAddr       ip.Addr
+	VXLANV6Addr     ip.Addr
+	WireguardAddr   ip.Addr
+	WireguardV6Addr ip.Addr
 
 	Addresses []ip.Addr
 }
@@ -84,9 +91,13 @@ type l3rrNodeInfo struct {
 func (i l3rrNodeInfo) Equal(b l3rrNodeInfo) bool {
 	if i.Addr == b.Addr &&
 		i.CIDR == b.CIDR &&
+		i.V6Addr == b.V6Addr &&
+		i.V6CIDR == b.V6CIDR &&
 		i.IPIPAddr == b.IPIPAddr &&
 		i.VXLANAddr == b.VXLANAddr &&
-		i.WireguardAddr == b.WireguardAddr {
+		i.VXLANV6Addr == b.VXLANV6Addr &&
+		i.WireguardAddr == b.WireguardAddr &&
+		i.WireguardV6Addr == b.WireguardV6Addr {
 
 		if len(i.Addresses) != len(b.Addresses) {
 			return false
@@ -114,38 +125,68 @@ func (i l3rrNodeInfo) Equal(b l3rrNodeInfo) bool {
 	return false
 }
 
-func (i l3rrNodeInfo) AddrAsCIDR() ip.V4CIDR {
-	return i.Addr.AsCIDR().(ip.V4CIDR)
+func (i l3rrNodeInfo) hasV4() bool {
+	return i.Addr != ip.V4Addr{}
+}
+
+func (i l3rrNodeInfo) hasV6() bool {
+	return i.V6Addr != ip.V6Addr{}
+}
+
+// addrForVersion returns the node's address of the given IP version, or nil if it is not known.
+func (i l3rrNodeInfo) addrForVersion(version uint8) ip.Addr {
+	if version == 6 {
+		if i.hasV6() {
+			return i.V6Addr
+		}
+		return nil
+	}
+	if i.hasV4() {
+		return i.Addr
+	}
+	return nil
+}
+
+// inSameSubnet returns true if the other node's address of the given IP version is within this node's
+// subnet.  Returns false if either is not known.
+func (i l3rrNodeInfo) inSameSubnet(other l3rrNodeInfo, version uint8) bool {
+	if version == 6 {
+		return i.hasV6() && other.hasV6() && i.V6CIDR.ContainsV6(other.V6Addr)
+	}
+	return i.hasV4() && other.hasV4() && i.CIDR.ContainsV4(other.Addr)
 }
 
-func (i l3rrNodeInfo) AddresesV4AsCIDRs() []ip.V4CIDR {
-	addrs4 := make(map[ip.V4Addr]struct{})
+func (i l3rrNodeInfo) AddressesAsCIDRs() []ip.CIDR {
+	addrs := make(map[ip.Addr]struct{})
 
-	addrs4[i.Addr] = struct{}{}
+	if i.hasV4() {
+		addrs[i.Addr] = struct{}{}
+	}
+	if i.hasV6() {
+		addrs[i.V6Addr] = struct{}{}
+	}
 
 	for _, a := range i.Addresses {
-		if a.Version() == 4 {
-			addrs4[a.(ip.V4Addr)] = struct{}{}
-		}
+		addrs[a] = struct{}{}
 	}
 
-	cidrs := make([]ip.V4CIDR, len(addrs4))
+	cidrs := make([]ip.CIDR, len(addrs))
 	idx := 0
-	for a := range addrs4 {
-		cidrs[idx] = a.AsCIDR().(ip.V4CIDR)
+	for a := range addrs {
+		cidrs[idx] = a.AsCIDR()
 		idx++
 	}
 
 	return cidrs
 }
 
-func NewL3RouteResolver(hostname string, callbacks PipelineCallbacks, useNodeResourceUpdates bool, routeSource string) *L3RouteResolver {
-	logrus.Info("Creating L3 route resolver")
+func NewL3RouteResolver(hostname string, callbacks PipelineCallbacks, useNodeResourceUpdates bool, routeSource string, ipv6Enabled bool) *L3RouteResolver {
+	logrus.WithField("ipv6Enabled", ipv6Enabled).Info("Creating L3 route resolver")
 	return &L3RouteResolver{
 		myNodeName: hostname,
 		callbacks:  callbacks,
 
-		trie: NewRouteTrie(),
+		trie: NewRouteTrie(ipv6Enabled),
 
 		nodeNameToNodeInfo:     map[string]l3rrNodeInfo{},
 		blockToRoutes:          map[string]set.Set{},
@@ -153,6 +194,7 @@ func NewL3RouteResolver(hostname string, callbacks PipelineCallbacks, useNodeRes
 		workloadIDToCIDRs:      map[model.WorkloadEndpointKey][]cnet.IPNet{},
 		useNodeResourceUpdates: useNodeResourceUpdates,
 		routeSource:            routeSource,
+		ipv6Enabled:            ipv6Enabled,
 		nodeRoutes:             newNodeRoutes(),
 	}
 }
@@ -194,6 +236,9 @@ func (c *L3RouteResolver) OnWorkloadUpdate(update api.Update) (_ bool) {
 	if update.Value != nil {
 		newWorkload := update.Value.(*model.WorkloadEndpoint)
 		newCIDRs = newWorkload.IPv4Nets
+		if c.ipv6Enabled {
+			newCIDRs = append(newCIDRs, newWorkload.IPv6Nets...)
+		}
 		logrus.WithField("workload", key).WithField("newCIDRs", newCIDRs).Debug("Workload update")
 	}
 
@@ -205,14 +250,14 @@ func (c *L3RouteResolver) OnWorkloadUpdate(update api.Update) (_ bool) {
 
 	// Incref the new CIDRs.
 	for _, newCIDR := range newCIDRs {
-		cidr := ip.CIDRFromCalicoNet(newCIDR).(ip.V4CIDR)
+		cidr := ip.CIDRFromCalicoNet(newCIDR)
 		c.trie.AddRef(cidr, key.Hostname, RefTypeWEP)
 		c.nodeRoutes.Add(nodenameRoute{key.Hostname, cidr})
 	}
 
 	// Decref the old.
 	for _, oldCIDR := range oldCIDRs {
-		cidr := ip.CIDRFromCalicoNet(oldCIDR).(ip.V4CIDR)
+		cidr := ip.CIDRFromCalicoNet(oldCIDR)
 		c.trie.RemoveRef(cidr, key.Hostname, RefTypeWEP)
 		c.nodeRoutes.Remove(nodenameRoute{key.Hostname, cidr})
 	}
@@ -241,7 +286,7 @@ func (c *L3RouteResolver) OnBlockUpdate(update api.Update) (_ bool) {
 		// We don't allow multiple blocks with the same CIDR, so no need to check
 		// for duplicates here. Look at the routes contributed by this block and determine if we
 		// need to send any updates.
-		newRoutes := c.v4RoutesFromBlock(update.Value.(*model.AllocationBlock))
+		newRoutes := c.routesFromBlock(update.Value.(*model.AllocationBlock))
 		logrus.WithField("numRoutes", len(newRoutes)).Debug("IPAM block update")
 		cachedRoutes, ok := c.blockToRoutes[key]
 		if !ok {
@@ -344,7 +389,7 @@ func (c *L3RouteResolver) OnResourceUpdate(update api.Update) (_ bool) {
 				Addr: ip.FromCalicoIP(*ipv4).(ip.V4Addr),
 				CIDR: ip.CIDRFromCalicoNet(*caliNodeCIDR).(ip.V4CIDR),
 			}
-		} else {
+		} else if node.Spec.BGP == nil || node.Spec.BGP.IPv6Address == "" {
 			ipv4, caliNodeCIDR := cresources.FindNodeAddress(node, apiv3.InternalIP)
 			if ipv4 == nil {
 				ipv4, caliNodeCIDR = cresources.FindNodeAddress(node, apiv3.ExternalIP)
@@ -357,11 +402,27 @@ func (c *L3RouteResolver) OnResourceUpdate(update api.Update) (_ bool) {
 			}
 		}
 
+		if node.Spec.BGP != nil && node.Spec.BGP.IPv6Address != "" {
+			ipv6, caliNodeCIDRV6, err := cnet.ParseCIDROrIP(node.Spec.BGP.IPv6Address)
+			if err != nil {
+				logrus.WithError(err).Panic("Failed to parse already-validated IP address")
+			}
+			if nodeInfo == nil {
+				nodeInfo = &l3rrNodeInfo{}
+			}
+			nodeInfo.V6Addr = ip.FromCalicoIP(*ipv6).(ip.V6Addr)
+			nodeInfo.V6CIDR = ip.CIDRFromCalicoNet(*caliNodeCIDRV6).(ip.V6CIDR)
+		}
+
 		if nodeInfo != nil {
 			if node.Spec.Wireguard != nil && node.Spec.Wireguard.InterfaceIPv4Address != "" {
 				nodeInfo.WireguardAddr = ip.FromString(node.Spec.Wireguard.InterfaceIPv4Address)
 			}
 
+			if node.Spec.Wireguard != nil && node.Spec.Wireguard.InterfaceIPv6Address != "" {
+				nodeInfo.WireguardV6Addr = ip.FromString(node.Spec.Wireguard.InterfaceIPv6Address)
+			}
+
 			if node.Spec.BGP != nil && node.Spec.BGP.IPv4IPIPTunnelAddr != "" {
 				nodeInfo.IPIPAddr = ip.FromString(node.Spec.BGP.IPv4IPIPTunnelAddr)
 			}
@@ -370,6 +431,10 @@ func (c *L3RouteResolver) OnResourceUpdate(update api.Update) (_ bool) {
 				nodeInfo.VXLANAddr = ip.FromString(node.Spec.IPv4VXLANTunnelAddr)
 			}
 
+			if node.Spec.IPv6VXLANTunnelAddr != "" {
+				nodeInfo.VXLANV6Addr = ip.FromString(node.Spec.IPv6VXLANTunnelAddr)
+			}
+
 			for _, a := range node.Spec.Addresses {
 				parsed, _, err := cnet.ParseCIDROrIP(a.Address)
 				if err == nil && parsed != nil {
@@ -421,14 +486,12 @@ func (c *L3RouteResolver) onNodeUpdate(nodeName string, newNodeInfo *l3rrNodeInf
 	}
 
 	if nodeName == c.myNodeName {
-		// Check if our CIDR has changed and if so recalculate the "same subnet" tracking.
-		var myNewCIDR ip.V4CIDR
-		var myNewCIDRKnown bool
+		// Check if our CIDRs have changed and if so recalculate the "same subnet" tracking.
+		var myNewInfo l3rrNodeInfo
 		if newNodeInfo != nil {
-			myNewCIDR = newNodeInfo.CIDR
-			myNewCIDRKnown = true
+			myNewInfo = *newNodeInfo
 		}
-		if oldNodeInfo.CIDR != myNewCIDR {
+		if oldNodeInfo.CIDR != myNewInfo.CIDR || oldNodeInfo.V6CIDR != myNewInfo.V6CIDR {
 			// This node's CIDR has changed; some routes may now have an incorrect value for same-subnet.
 			c.visitAllRoutes(func(r nodenameRoute) {
 				if r.nodeName == c.myNodeName {
@@ -438,9 +501,8 @@ func (c *L3RouteResolver) onNodeUpdate(nodeName string, newNodeInfo *l3rrNodeInf
 				if !known {
 					return // Don't know other node's CIDR so ignore for now.
 				}
-				otherNodesIPv4 := otherNodeInfo.Addr
-				wasSameSubnet := nodeExisted && oldNodeInfo.CIDR.ContainsV4(otherNodesIPv4)
-				nowSameSubnet := myNewCIDRKnown && myNewCIDR.ContainsV4(otherNodesIPv4)
+				wasSameSubnet := oldNodeInfo.inSameSubnet(otherNodeInfo, r.dst.Version())
+				nowSameSubnet := myNewInfo.inSameSubnet(otherNodeInfo, r.dst.Version())
 				if wasSameSubnet != nowSameSubnet {
 					logrus.WithField("route", r).Debug("Update to our subnet invalidated route")
 					c.trie.MarkCIDRDirty(r.dst)
@@ -452,37 +514,49 @@ func (c *L3RouteResolver) onNodeUpdate(nodeName string, newNodeInfo *l3rrNodeInf
 	// Process the tunnel addresses. These are reference counted, so handle adds followed by deletes to minimize churn.
 	if newNodeInfo != nil {
 		if newNodeInfo.IPIPAddr != nil {
-			c.trie.AddRef(newNodeInfo.IPIPAddr.AsCIDR().(ip.V4CIDR), nodeName, RefTypeIPIP)
+			c.trie.AddRef(newNodeInfo.IPIPAddr.AsCIDR(), nodeName, RefTypeIPIP)
 		}
 		if newNodeInfo.VXLANAddr != nil {
-			c.trie.AddRef(newNodeInfo.VXLANAddr.AsCIDR().(ip.V4CIDR), nodeName, RefTypeVXLAN)
+			c.trie.AddRef(newNodeInfo.VXLANAddr.AsCIDR(), nodeName, RefTypeVXLAN)
+		}
+		if newNodeInfo.VXLANV6Addr != nil {
+			c.trie.AddRef(newNodeInfo.VXLANV6Addr.AsCIDR(), nodeName, RefTypeVXLAN)
 		}
 		if newNodeInfo.WireguardAddr != nil {
-			c.trie.AddRef(newNodeInfo.WireguardAddr.AsCIDR().(ip.V4CIDR), nodeName, RefTypeWireguard)
+			c.trie.AddRef(newNodeInfo.WireguardAddr.AsCIDR(), nodeName, RefTypeWireguard)
+		}
+		if newNodeInfo.WireguardV6Addr != nil {
+			c.trie.AddRef(newNodeInfo.WireguardV6Addr.AsCIDR(), nodeName, RefTypeWireguard)
 		}
 	}
 	if nodeExisted {
 		if oldNodeInfo.IPIPAddr != nil {
-			c.trie.RemoveRef(oldNodeInfo.IPIPAddr.AsCIDR().(ip.V4CIDR), nodeName, RefTypeIPIP)
+			c.trie.RemoveRef(oldNodeInfo.IPIPAddr.AsCIDR(), nodeName, RefTypeIPIP)
 		}
 		if oldNodeInfo.VXLANAddr != nil {
-			c.trie.RemoveRef(oldNodeInfo.VXLANAddr.AsCIDR().(ip.V4CIDR), nodeName, RefTypeVXLAN)
+			c.trie.RemoveRef(oldNodeInfo.VXLANAddr.AsCIDR(), nodeName, RefTypeVXLAN)
+		}
+		if oldNodeInfo.VXLANV6Addr != nil {
+			c.trie.RemoveRef(oldNodeInfo.VXLANV6Addr.AsCIDR(), nodeName, RefTypeVXLAN)
 		}
 		if oldNodeInfo.WireguardAddr != nil {
-			c.trie.RemoveRef(oldNodeInfo.WireguardAddr.AsCIDR().(ip.V4CIDR), nodeName, RefTypeWireguard)
+			c.trie.RemoveRef(oldNodeInfo.WireguardAddr.AsCIDR(), nodeName, RefTypeWireguard)
+		}
+		if oldNodeInfo.WireguardV6Addr != nil {
+			c.trie.RemoveRef(oldNodeInfo.WireguardV6Addr.AsCIDR(), nodeName, RefTypeWireguard)
 		}
 	}
 
 	// Process the node CIDR and cache the node info.
 	if nodeExisted {
 		delete(c.nodeNameToNodeInfo, nodeName)
-		for _, a := range oldNodeInfo.AddresesV4AsCIDRs() {
+		for _, a := range oldNodeInfo.AddressesAsCIDRs() {
 			c.trie.RemoveHost(a, nodeName)
 		}
 	}
 	if newNodeInfo != nil {
 		c.nodeNameToNodeInfo[nodeName] = *newNodeInfo
-		for _, a := range newNodeInfo.AddresesV4AsCIDRs() {
+		for _, a := range newNodeInfo.AddressesAsCIDRs() {
 			c.trie.AddHost(a, nodeName)
 		}
 	}
@@ -497,9 +571,9 @@ func (c *L3RouteResolver) markAllNodeRoutesDirty(nodeName string) {
 }
 
 func (c *L3RouteResolver) visitAllRoutes(v func(route nodenameRoute)) {
-	c.trie.t.Visit(func(cidr ip.V4CIDR, data interface{}) bool {
+	c.trie.Visit(func(cidr ip.CIDR, data interface{}) bool {
 		// Construct a nodenameRoute to pass to the visiting function.
-		ri := c.trie.t.Get(cidr).(RouteInfo)
+		ri := data.(RouteInfo)
 		nnr := nodenameRoute{dst: cidr}
 		if len(ri.Refs) > 0 {
 			// From a Ref.
@@ -526,16 +600,16 @@ func (c *L3RouteResolver) OnPoolUpdate(update api.Update) (_ bool) {
 	poolKey := k.String()
 	oldPool, oldPoolExists := c.allPools[poolKey]
 	oldPoolType := proto.IPPoolType_NONE
-	var poolCIDR ip.V4CIDR
+	var poolCIDR ip.CIDR
 	if oldPoolExists {
 		// Need explicit oldPoolExists check so that we don't pass a zero-struct to poolTypeForPool.
 		oldPoolType = c.poolTypeForPool(&oldPool)
-		poolCIDR = ip.CIDRFromCalicoNet(oldPool.CIDR).(ip.V4CIDR)
+		poolCIDR = ip.CIDRFromCalicoNet(oldPool.CIDR)
 	}
 	var newPool *model.IPPool
 	if update.Value != nil {
 		newPool = update.Value.(*model.IPPool)
-		if len(newPool.CIDR.IP.To4()) == 0 {
+		if newPool.CIDR.Version() == 6 && !c.ipv6Enabled {
 			logrus.Debug("Ignoring IPv6 pool")
 			newPool = nil
 		}
@@ -545,12 +619,14 @@ func (c *L3RouteResolver) OnPoolUpdate(update api.Update) (_ bool) {
 	if newPool != nil && newPoolType != proto.IPPoolType_NONE {
 		logCxt.Info("Pool is active")
 		c.allPools[poolKey] = *newPool
-		poolCIDR = ip.CIDRFromCalicoNet(newPool.CIDR).(ip.V4CIDR)
+		poolCIDR = ip.CIDRFromCalicoNet(newPool.CIDR)
 		crossSubnet := newPool.IPIPMode == encap.CrossSubnet || newPool.VXLANMode == encap.CrossSubnet
 		c.trie.UpdatePool(poolCIDR, newPoolType, newPool.Masquerade, crossSubnet)
 	} else {
 		delete(c.allPools, poolKey)
-		c.trie.RemovePool(poolCIDR)
+		if poolCIDR != nil {
+			c.trie.RemovePool(poolCIDR)
+		}
 	}
 
 	return
@@ -569,10 +645,10 @@ func (c *L3RouteResolver) poolTypeForPool(pool *model.IPPool) proto.IPPoolType {
 	return proto.IPPoolType_NO_ENCAP
 }
 
-// v4RoutesFromBlock returns a list of routes which should exist based on the provided
+// routesFromBlock returns a list of routes which should exist based on the provided
 // allocation block.
-func (c *L3RouteResolver) v4RoutesFromBlock(b *model.AllocationBlock) map[string]nodenameRoute {
-	if len(b.CIDR.IP.To4()) == 0 {
+func (c *L3RouteResolver) routesFromBlock(b *model.AllocationBlock) map[string]nodenameRoute {
+	if b.CIDR.Version() == 6 && !c.ipv6Enabled {
 		logrus.Debug("Ignoring IPv6 block")
 		return nil
 	}
@@ -585,7 +661,7 @@ func (c *L3RouteResolver) v4RoutesFromBlock(b *model.AllocationBlock) map[string
 			continue
 		}
 		r := nodenameRoute{
-			dst:      ip.CIDRFromNetIP(alloc.Addr.IP).(ip.V4CIDR),
+			dst:      ip.CIDRFromNetIP(alloc.Addr.IP),
 			nodeName: alloc.Host,
 		}
 		routes[r.Key()] = r
@@ -595,7 +671,7 @@ func (c *L3RouteResolver) v4RoutesFromBlock(b *model.AllocationBlock) map[string
 	if host != "" {
 		logrus.WithField("host", host).Debug("Block has a host, including block-via-host route")
 		r := nodenameRoute{
-			dst:      ip.CIDRFromCalicoNet(b.CIDR).(ip.V4CIDR),
+			dst:      ip.CIDRFromCalicoNet(b.CIDR),
 			nodeName: host,
 		}
 		routes[r.Key()] = r
@@ -607,11 +683,11 @@ func (c *L3RouteResolver) v4RoutesFromBlock(b *model.AllocationBlock) map[string
 // flush() iterates over the CIDRs that are marked dirty in the trie and sends any route updates
 // that it finds.
 func (c *L3RouteResolver) flush() {
-	var buf []ip.V4TrieEntry
+	var buf []ip.CIDRTrieEntry
 	c.trie.dirtyCIDRs.Iter(func(item interface{}) error {
 		logCxt := logrus.WithField("cidr", item)
 		logCxt.Debug("Flushing dirty route")
-		cidr := item.(ip.V4CIDR)
+		cidr := item.(ip.CIDR)
 
 		// We know the CIDR may be dirty, look up the path through the trie to the CIDR.  This will
 		// give us the information about the enclosing CIDRs.  For example, if we have:
@@ -620,7 +696,7 @@ func (c *L3RouteResolver) flush() {
 		// - IP          10.0.0.1/32 node y
 		// Then, we'll see the pool, block and IP in turn on the lookup path allowing us to collect the
 		// relevant information from each.
-		buf = c.trie.t.LookupPath(buf, cidr)
+		buf = c.trie.LookupPath(buf, cidr)
 
 		if len(buf) == 0 {
 			// CIDR is not in the trie.  Nothing to do.  Route removed before it had even been sent?
@@ -726,10 +802,12 @@ func (c *L3RouteResolver) flush() {
 		if rt.DstNodeName != "" {
 			dstNodeInfo, exists := c.nodeNameToNodeInfo[rt.DstNodeName]
 			if exists {
-				rt.DstNodeIp = dstNodeInfo.Addr.String()
+				if addr := dstNodeInfo.addrForVersion(cidr.Version()); addr != nil {
+					rt.DstNodeIp = addr.String()
+				}
 			}
 		}
-		rt.SameSubnet = poolAllowsCrossSubnet && c.nodeInOurSubnet(rt.DstNodeName)
+		rt.SameSubnet = poolAllowsCrossSubnet && c.nodeInOurSubnet(rt.DstNodeName, cidr.Version())
 
 		logrus.WithField("route", rt).Debug("Sending route")
 		c.callbacks.OnRouteUpdate(rt)
@@ -739,9 +817,9 @@ func (c *L3RouteResolver) flush() {
 	})
 }
 
-// nodeInOurSubnet returns true if the IP of the given node is known and it's in our subnet.
-// Return false if either the remote IP or our subnet is not known.
-func (c *L3RouteResolver) nodeInOurSubnet(name string) bool {
+// nodeInOurSubnet returns true if the IP (of the given version) of the given node is known and it's in
+// our subnet.  Return false if either the remote IP or our subnet is not known.
+func (c *L3RouteResolver) nodeInOurSubnet(name string, version uint8) bool {
 	localNodeInfo, exists := c.nodeNameToNodeInfo[c.myNodeName]
 	if !exists {
 		return false
@@ -752,13 +830,13 @@ func (c *L3RouteResolver) nodeInOurSubnet(name string) bool {
 		return false
 	}
 
-	return localNodeInfo.CIDR.ContainsV4(nodeInfo.Addr)
+	return localNodeInfo.inSameSubnet(nodeInfo, version)
 }
 
 // nodenameRoute is the L3RouteResolver's internal representation of a route.
 type nodenameRoute struct {
 	nodeName string
-	dst      ip.V4CIDR
+	dst      ip.CIDR
 }
 
 func (r nodenameRoute) Key() string {
@@ -793,19 +871,49 @@ func (r nodenameRoute) String() string {
 //
 // The RouteTrie maintains a set of dirty CIDRs.  When an IPAM pool is updated, all the CIDRs under it are
 // marked dirty.
+//
+// IPv4 and IPv6 CIDRs are stored in separate tries.  If IPv6 is disabled, updates to IPv6 CIDRs are ignored.
 type RouteTrie struct {
-	t          *ip.V4Trie
+	v4T        *ip.CIDRTrie
+	v6T        *ip.CIDRTrie
 	dirtyCIDRs set.Set
 }
 
-func NewRouteTrie() *RouteTrie {
-	return &RouteTrie{
-		t:          &ip.V4Trie{},
+func NewRouteTrie(ipv6Enabled bool) *RouteTrie {
+	r := &RouteTrie{
+		v4T:        &ip.CIDRTrie{},
 		dirtyCIDRs: set.New(),
 	}
+	if ipv6Enabled {
+		r.v6T = &ip.CIDRTrie{}
+	}
+	return r
+}
+
+// trieForCIDR returns the trie for the CIDR's IP version, or nil if that IP version is disabled.
+func (r *RouteTrie) trieForCIDR(cidr ip.CIDR) *ip.CIDRTrie {
+	if cidr.Version() == 6 {
+		return r.v6T
+	}
+	return r.v4T
+}
+
+func (r *RouteTrie) LookupPath(buffer []ip.CIDRTrieEntry, cidr ip.CIDR) []ip.CIDRTrieEntry {
+	t := r.trieForCIDR(cidr)
+	if t == nil {
+		return buffer[:0]
+	}
+	return t.LookupPath(buffer, cidr)
+}
+
+func (r *RouteTrie) Visit(f func(cidr ip.CIDR, data interface{}) bool) {
+	r.v4T.Visit(f)
+	if r.v6T != nil {
+		r.v6T.Visit(f)
+	}
 }
 
-func (r *RouteTrie) UpdatePool(cidr ip.V4CIDR, poolType proto.IPPoolType, natOutgoing bool, crossSubnet bool) {
+func (r *RouteTrie) UpdatePool(cidr ip.CIDR, poolType proto.IPPoolType, natOutgoing bool, crossSubnet bool) {
 	logrus.WithFields(logrus.Fields{
 		"cidr":        cidr,
 		"poolType":    poolType,
@@ -823,35 +931,42 @@ func (r *RouteTrie) UpdatePool(cidr ip.V4CIDR, poolType proto.IPPoolType, natOut
 	r.markChildrenDirty(cidr)
 }
 
-func (r *RouteTrie) markChildrenDirty(cidr ip.V4CIDR) {
+func (r *RouteTrie) markChildrenDirty(cidr ip.CIDR) {
+	t := r.trieForCIDR(cidr)
+	if t == nil {
+		return
+	}
 	// TODO: avoid full scan to mark children dirty
-	r.t.Visit(func(c ip.V4CIDR, data interface{}) bool {
-		if cidr.ContainsV4(c.Addr().(ip.V4Addr)) {
+	t.Visit(func(c ip.CIDR, data interface{}) bool {
+		if ip.CommonPrefix(cidr, c) == cidr {
 			r.MarkCIDRDirty(c)
 		}
 		return true
 	})
 }
 
-func (r *RouteTrie) MarkCIDRDirty(cidr ip.V4CIDR) {
+func (r *RouteTrie) MarkCIDRDirty(cidr ip.CIDR) {
+	if r.trieForCIDR(cidr) == nil {
+		return
+	}
 	r.dirtyCIDRs.Add(cidr)
 }
 
-func (r *RouteTrie) RemovePool(cidr ip.V4CIDR) {
+func (r *RouteTrie) RemovePool(cidr ip.CIDR) {
 	r.UpdatePool(cidr, proto.IPPoolType_NONE, false, false)
 }
 
-func (r *RouteTrie) UpdateBlockRoute(cidr ip.V4CIDR, nodeName string) {
+func (r *RouteTrie) UpdateBlockRoute(cidr ip.CIDR, nodeName string) {
 	r.updateCIDR(cidr, func(ri *RouteInfo) {
 		ri.Block.NodeName = nodeName
 	})
 }
 
-func (r *RouteTrie) RemoveBlockRoute(cidr ip.V4CIDR) {
+func (r *RouteTrie) RemoveBlockRoute(cidr ip.CIDR) {
 	r.UpdateBlockRoute(cidr, "")
 }
 
-func (r *RouteTrie) AddHost(cidr ip.V4CIDR, nodeName string) {
+func (r *RouteTrie) AddHost(cidr ip.CIDR, nodeName string) {
 	r.updateCIDR(cidr, func(ri *RouteInfo) {
 		ri.Host.NodeNames = append(ri.Host.NodeNames, nodeName)
 		if len(ri.Host.NodeNames) > 1 {
@@ -865,7 +980,7 @@ func (r *RouteTrie) AddHost(cidr ip.V4CIDR, nodeName string) {
 	})
 }
 
-func (r *RouteTrie) RemoveHost(cidr ip.V4CIDR, nodeName string) {
+func (r *RouteTrie) RemoveHost(cidr ip.CIDR, nodeName string) {
 	r.updateCIDR(cidr, func(ri *RouteInfo) {
 		var ns []string
 		for _, n := range ri.Host.NodeNames {
@@ -878,7 +993,7 @@ func (r *RouteTrie) RemoveHost(cidr ip.V4CIDR, nodeName string) {
 	})
 }
 
-func (r *RouteTrie) AddRef(cidr ip.V4CIDR, nodename string, rt RefType) {
+func (r *RouteTrie) AddRef(cidr ip.CIDR, nodename string, rt RefType) {
 	r.updateCIDR(cidr, func(ri *RouteInfo) {
 		// Find the ref in the list for this nodename,
 		// if it exists. If it doesn't, we'll add it below.
@@ -906,7 +1021,7 @@ func (r *RouteTrie) AddRef(cidr ip.V4CIDR, nodename string, rt RefType) {
 	})
 }
 
-func (r *RouteTrie) RemoveRef(cidr ip.V4CIDR, nodename string, rt RefType) {
+func (r *RouteTrie) RemoveRef(cidr ip.CIDR, nodename string, rt RefType) {
 	r.updateCIDR(cidr, func(ri *RouteInfo) {
 		for i := range ri.Refs {
 			if ri.Refs[i].NodeName == nodename && ri.Refs[i].RefType == rt {
@@ -930,13 +1045,19 @@ func (r *RouteTrie) RemoveRef(cidr ip.V4CIDR, nodename string, rt RefType) {
 	})
 }
 
-func (r *RouteTrie) SetRouteSent(cidr ip.V4CIDR, sent bool) {
+func (r *RouteTrie) SetRouteSent(cidr ip.CIDR, sent bool) {
 	r.updateCIDR(cidr, func(ri *RouteInfo) {
 		ri.WasSent = sent
 	})
 }
 
-func (r RouteTrie) updateCIDR(cidr ip.V4CIDR, updateFn func(info *RouteInfo)) bool {
+func (r RouteTrie) updateCIDR(cidr ip.CIDR, updateFn func(info *RouteInfo)) bool {
+	t := r.trieForCIDR(cidr)
+	if t == nil {
+		logrus.WithField("cidr", cidr).Debug("Ignoring update for disabled IP version")
+		return false
+	}
+
 	// Get the RouteInfo for the given CIDR and take a copy so we can compare.
 	ri := r.Get(cidr)
 	riCopy := ri.Copy()
@@ -957,15 +1078,19 @@ func (r RouteTrie) updateCIDR(cidr ip.V4CIDR, updateFn func(info *RouteInfo)) bo
 	if ri.IsZero() {
 		// No longer have *anything* to track about this CIDR, clean it up.
 		logrus.WithField("cidr", cidr).Debug("RouteInfo is zero, cleaning up.")
-		r.t.Delete(cidr)
+		t.Delete(cidr)
 		return true
 	}
-	r.t.Update(cidr, ri)
+	t.Update(cidr, ri)
 	return true
 }
 
-func (r RouteTrie) Get(cidr ip.V4CIDR) RouteInfo {
-	ri := r.t.Get(cidr)
+func (r RouteTrie) Get(cidr ip.CIDR) RouteInfo {
+	t := r.trieForCIDR(cidr)
+	if t == nil {
+		return RouteInfo{}
+	}
+	ri := t.Get(cidr)
 	if ri == nil {
 		return RouteInfo{}
 	}
@@ -1056,18 +1181,18 @@ func (r RouteInfo) Equals(other RouteInfo) bool {
 // It uses a reference counter so that we can properly handle intermediate cases where
 // the same CIDR might appear twice.
 type nodeRoutes struct {
-	cache map[string]map[ip.V4CIDR]int
+	cache map[string]map[ip.CIDR]int
 }
 
 func newNodeRoutes() nodeRoutes {
 	return nodeRoutes{
-		cache: map[string]map[ip.V4CIDR]int{},
+		cache: map[string]map[ip.CIDR]int{},
 	}
 }
 
 func (nr *nodeRoutes) Add(r nodenameRoute) {
 	if _, ok := nr.cache[r.nodeName]; !ok {
-		nr.cache[r.nodeName] = map[ip.V4CIDR]int{r.dst: 0}
+		nr.cache[r.nodeName] = map[ip.CIDR]int{r.dst: 0}
 	}
 	nr.cache[r.nodeName][r.dst]++
 }
@@ -0,0 +1,238 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calc
+
+import (
+	"sort"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/felix/dispatcher"
+	"github.com/projectcalico/calico/felix/labelindex"
+	"github.com/projectcalico/calico/felix/multidict"
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/names"
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+)
+
+// PacketCaptureCalculator matches the PacketCapture resources against the local workload
+// endpoints.  It sends a PacketCaptureUpdate for every PacketCapture, listing the local
+// endpoints that it selects (which may be none, so that the dataplane can keep the files of
+// endpoints that have gone away), and a PacketCaptureRemove when the PacketCapture is deleted.
+//
+// A PacketCapture only selects endpoints in its own namespace.
+type PacketCaptureCalculator struct {
+	callbacks  packetCaptureCallbacks
+	labelIndex *labelindex.InheritIndex
+
+	// captures maps from PacketCapture key to the spec of the capture.
+	captures map[model.ResourceKey]apiv3.PacketCaptureSpec
+	// endpoints holds the local workload endpoints.
+	endpoints map[model.WorkloadEndpointKey]*model.WorkloadEndpoint
+	// matches maps from PacketCapture key to the keys of the endpoints that it selects, and
+	// vice versa.
+	endpointsByCapture multidict.IfaceToIface
+	capturesByEndpoint multidict.IfaceToIface
+	// dirtyCaptures holds the keys of the captures that need to be resent.
+	dirtyCaptures set.Set
+}
+
+func NewPacketCaptureCalculator(callbacks packetCaptureCallbacks) *PacketCaptureCalculator {
+	pcc := &PacketCaptureCalculator{
+		callbacks:          callbacks,
+		captures:           map[model.ResourceKey]apiv3.PacketCaptureSpec{},
+		endpoints:          map[model.WorkloadEndpointKey]*model.WorkloadEndpoint{},
+		endpointsByCapture: multidict.NewIfaceToIface(),
+		capturesByEndpoint: multidict.NewIfaceToIface(),
+		dirtyCaptures:      set.New(),
+	}
+	pcc.labelIndex = labelindex.NewInheritIndex(pcc.onMatchStarted, pcc.onMatchStopped)
+	return pcc
+}
+
+func (pcc *PacketCaptureCalculator) RegisterWith(localEndpointDispatcher, allUpdDispatcher *dispatcher.Dispatcher) {
+	// It needs the local workload endpoints...
+	localEndpointDispatcher.Register(model.WorkloadEndpointKey{}, pcc.OnUpdate)
+	// ...as well as the profiles, for their labels, and the packet captures.
+	allUpdDispatcher.Register(model.ResourceKey{}, pcc.OnUpdate)
+}
+
+func (pcc *PacketCaptureCalculator) OnUpdate(update api.Update) (_ bool) {
+	switch key := update.Key.(type) {
+	case model.WorkloadEndpointKey:
+		if update.Value != nil {
+			wep := update.Value.(*model.WorkloadEndpoint)
+			if old := pcc.endpoints[key]; old != nil && old.Name != wep.Name {
+				// The interface name is part of the update so resend the captures that
+				// select the endpoint.
+				pcc.capturesByEndpoint.Iter(key, func(captureKey interface{}) {
+					pcc.dirtyCaptures.Add(captureKey)
+				})
+			}
+			pcc.endpoints[key] = wep
+		} else {
+			delete(pcc.endpoints, key)
+		}
+		pcc.labelIndex.OnUpdate(update)
+	case model.ResourceKey:
+		switch key.Kind {
+		case apiv3.KindProfile:
+			pcc.labelIndex.OnUpdate(update)
+		case apiv3.KindPacketCapture:
+			if update.Value != nil {
+				pcc.onCaptureUpdate(key, update.Value.(*apiv3.PacketCapture))
+			} else {
+				pcc.onCaptureRemove(key)
+			}
+		default:
+			return
+		}
+	default:
+		return
+	}
+	pcc.flush()
+	return
+}
+
+func (pcc *PacketCaptureCalculator) onCaptureUpdate(key model.ResourceKey, capture *apiv3.PacketCapture) {
+	oldSpec, known := pcc.captures[key]
+	if known && oldSpec == capture.Spec {
+		// Felix updates the status of the capture; ignore those updates.
+		log.WithField("key", key).Debug("No-op packet capture change; ignoring.")
+		return
+	}
+	selStr := capture.Spec.Selector
+	if strings.TrimSpace(selStr) == "" {
+		selStr = "all()"
+	}
+	sel, err := selector.Parse(selStr)
+	if err != nil {
+		// Selectors are validated before they reach us so this shouldn't happen.
+		log.WithError(err).WithField("key", key).Warn("Ignoring packet capture with invalid selector")
+		pcc.onCaptureRemove(key)
+		return
+	}
+	log.WithFields(log.Fields{
+		"key":      key,
+		"selector": selStr,
+		"filter":   capture.Spec.Filter,
+	}).Info("Packet capture updated")
+	pcc.captures[key] = capture.Spec
+	pcc.labelIndex.UpdateSelector(key, sel)
+	pcc.dirtyCaptures.Add(key)
+}
+
+func (pcc *PacketCaptureCalculator) onCaptureRemove(key model.ResourceKey) {
+	if _, known := pcc.captures[key]; !known {
+		return
+	}
+	log.WithField("key", key).Info("Packet capture removed")
+	pcc.labelIndex.DeleteSelector(key)
+	delete(pcc.captures, key)
+	pcc.dirtyCaptures.Discard(key)
+	pcc.callbacks.OnPacketCaptureRemove(proto.PacketCaptureID{Namespace: key.Namespace, Name: key.Name})
+}
+
+func (pcc *PacketCaptureCalculator) onMatchStarted(selID, labelID interface{}) {
+	captureKey := selID.(model.ResourceKey)
+	epKey, ok := labelID.(model.WorkloadEndpointKey)
+	if !ok || workloadNamespace(epKey) != captureKey.Namespace {
+		return
+	}
+	pcc.endpointsByCapture.Put(captureKey, epKey)
+	pcc.capturesByEndpoint.Put(epKey, captureKey)
+	pcc.dirtyCaptures.Add(captureKey)
+}
+
+func (pcc *PacketCaptureCalculator) onMatchStopped(selID, labelID interface{}) {
+	captureKey := selID.(model.ResourceKey)
+	epKey, ok := labelID.(model.WorkloadEndpointKey)
+	if !ok || !pcc.endpointsByCapture.Contains(captureKey, epKey) {
+		return
+	}
+	pcc.endpointsByCapture.Discard(captureKey, epKey)
+	pcc.capturesByEndpoint.Discard(epKey, captureKey)
+	pcc.dirtyCaptures.Add(captureKey)
+}
+
+// flush sends an update for each capture whose filter or endpoints changed.
+func (pcc *PacketCaptureCalculator) flush() {
+	pcc.dirtyCaptures.Iter(func(item interface{}) error {
+		key := item.(model.ResourceKey)
+		update := &proto.PacketCaptureUpdate{
+			Id:     &proto.PacketCaptureID{Namespace: key.Namespace, Name: key.Name},
+			Filter: pcc.captures[key].Filter,
+		}
+		pcc.endpointsByCapture.Iter(key, func(value interface{}) {
+			epKey := value.(model.WorkloadEndpointKey)
+			wep := pcc.endpoints[epKey]
+			if wep == nil {
+				return
+			}
+			update.Endpoints = append(update.Endpoints, &proto.PacketCaptureEndpoint{
+				Id: &proto.WorkloadEndpointID{
+					OrchestratorId: epKey.OrchestratorID,
+					WorkloadId:     epKey.WorkloadID,
+					EndpointId:     epKey.EndpointID,
+				},
+				Name:          workloadEndpointName(epKey),
+				InterfaceName: wep.Name,
+			})
+		})
+		sort.Slice(update.Endpoints, func(i, j int) bool {
+			return update.Endpoints[i].Name < update.Endpoints[j].Name
+		})
+		pcc.callbacks.OnPacketCaptureUpdate(update)
+		return set.RemoveItem
+	})
+}
+
+// workloadNamespace returns the namespace of a workload endpoint.  The workload ID of a
+// namespaced endpoint is "<namespace>/<workload>".
+func workloadNamespace(key model.WorkloadEndpointKey) string {
+	parts := strings.SplitN(key.WorkloadID, "/", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+	return parts[0]
+}
+
+// workloadEndpointName returns the name of the WorkloadEndpoint resource with the given key.
+func workloadEndpointName(key model.WorkloadEndpointKey) string {
+	workload := key.WorkloadID
+	if i := strings.Index(workload, "/"); i >= 0 {
+		workload = workload[i+1:]
+	}
+	ids := names.WorkloadEndpointIdentifiers{
+		Node:         key.Hostname,
+		Orchestrator: key.OrchestratorID,
+		Endpoint:     key.EndpointID,
+		Workload:     workload,
+		Pod:          workload,
+		ContainerID:  workload,
+	}
+	name, err := ids.CalculateWorkloadEndpointName(false)
+	if err != nil {
+		log.WithError(err).WithField("key", key).Warn("Failed to calculate workload endpoint name")
+		return key.WorkloadID + "/" + key.EndpointID
+	}
+	return name
+}
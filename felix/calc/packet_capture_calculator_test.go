@@ -0,0 +1,161 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calc_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/felix/calc"
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+)
+
+var _ = Describe("PacketCaptureCalculator", func() {
+	var (
+		pcc      *calc.PacketCaptureCalculator
+		recorder *packetCaptureRecorder
+	)
+
+	wepKey := func(ns, pod string) model.WorkloadEndpointKey {
+		return model.WorkloadEndpointKey{
+			Hostname:       "host1",
+			OrchestratorID: "k8s",
+			WorkloadID:     ns + "/" + pod,
+			EndpointID:     "eth0",
+		}
+	}
+	wep := func(iface string, labels map[string]string) *model.WorkloadEndpoint {
+		return &model.WorkloadEndpoint{Name: iface, Labels: labels}
+	}
+	capture := func(ns, name, selector, filter string) *v3.PacketCapture {
+		pc := v3.NewPacketCapture()
+		pc.Namespace = ns
+		pc.Name = name
+		pc.Spec = v3.PacketCaptureSpec{Selector: selector, Filter: filter}
+		return pc
+	}
+	captureKey := func(ns, name string) model.ResourceKey {
+		return model.ResourceKey{Kind: v3.KindPacketCapture, Namespace: ns, Name: name}
+	}
+	update := func(key model.Key, value interface{}) {
+		pcc.OnUpdate(api.Update{KVPair: model.KVPair{Key: key, Value: value}})
+	}
+	captureID := proto.PacketCaptureID{Namespace: "ns1", Name: "pc1"}
+	endpoint := func(pod, iface string) *proto.PacketCaptureEndpoint {
+		return &proto.PacketCaptureEndpoint{
+			Id: &proto.WorkloadEndpointID{
+				OrchestratorId: "k8s",
+				WorkloadId:     "ns1/" + pod,
+				EndpointId:     "eth0",
+			},
+			Name:          "host1-k8s-" + pod + "-eth0",
+			InterfaceName: iface,
+		}
+	}
+
+	BeforeEach(func() {
+		recorder = &packetCaptureRecorder{updates: map[proto.PacketCaptureID]*proto.PacketCaptureUpdate{}}
+		pcc = calc.NewPacketCaptureCalculator(recorder)
+	})
+
+	It("should send a capture that selects no endpoints", func() {
+		update(captureKey("ns1", "pc1"), capture("ns1", "pc1", "app == 'web'", "tcp port 80"))
+		Expect(recorder.updates).To(HaveKey(captureID))
+		Expect(recorder.updates[captureID].Filter).To(Equal("tcp port 80"))
+		Expect(recorder.updates[captureID].Endpoints).To(BeEmpty())
+	})
+
+	It("should select matching endpoints in the capture's namespace only", func() {
+		update(wepKey("ns1", "pod2"), wep("cali2", map[string]string{"app": "web"}))
+		update(wepKey("ns1", "pod1"), wep("cali1", map[string]string{"app": "web"}))
+		update(wepKey("ns1", "pod3"), wep("cali3", map[string]string{"app": "db"}))
+		update(wepKey("ns2", "pod4"), wep("cali4", map[string]string{"app": "web"}))
+		update(captureKey("ns1", "pc1"), capture("ns1", "pc1", "app == 'web'", ""))
+
+		Expect(recorder.updates[captureID].Endpoints).To(Equal([]*proto.PacketCaptureEndpoint{
+			endpoint("pod1", "cali1"),
+			endpoint("pod2", "cali2"),
+		}))
+
+		By("dropping an endpoint whose labels stop matching")
+		update(wepKey("ns1", "pod2"), wep("cali2", map[string]string{"app": "db"}))
+		Expect(recorder.updates[captureID].Endpoints).To(Equal([]*proto.PacketCaptureEndpoint{
+			endpoint("pod1", "cali1"),
+		}))
+
+		By("dropping a deleted endpoint")
+		update(wepKey("ns1", "pod1"), nil)
+		Expect(recorder.updates[captureID].Endpoints).To(BeEmpty())
+		Expect(recorder.removes).To(BeEmpty())
+	})
+
+	It("should treat an empty selector as selecting every endpoint in the namespace", func() {
+		update(wepKey("ns1", "pod1"), wep("cali1", nil))
+		update(wepKey("ns2", "pod4"), wep("cali4", nil))
+		update(captureKey("ns1", "pc1"), capture("ns1", "pc1", "", ""))
+
+		Expect(recorder.updates[captureID].Endpoints).To(Equal([]*proto.PacketCaptureEndpoint{
+			endpoint("pod1", "cali1"),
+		}))
+	})
+
+	It("should resend the capture when an endpoint's interface changes", func() {
+		update(wepKey("ns1", "pod1"), wep("cali1", nil))
+		update(captureKey("ns1", "pc1"), capture("ns1", "pc1", "", ""))
+		recorder.updates = map[proto.PacketCaptureID]*proto.PacketCaptureUpdate{}
+
+		update(wepKey("ns1", "pod1"), wep("cali1b", nil))
+		Expect(recorder.updates[captureID].Endpoints).To(Equal([]*proto.PacketCaptureEndpoint{
+			endpoint("pod1", "cali1b"),
+		}))
+	})
+
+	It("should ignore changes to the status of a capture", func() {
+		update(captureKey("ns1", "pc1"), capture("ns1", "pc1", "", "udp"))
+		recorder.updates = map[proto.PacketCaptureID]*proto.PacketCaptureUpdate{}
+
+		pc := capture("ns1", "pc1", "", "udp")
+		pc.Status.Nodes = []v3.PacketCaptureNodeStatus{{Node: "host1"}}
+		update(captureKey("ns1", "pc1"), pc)
+		Expect(recorder.updates).To(BeEmpty())
+	})
+
+	It("should send a remove when the capture is deleted", func() {
+		update(captureKey("ns1", "pc1"), capture("ns1", "pc1", "", ""))
+		update(captureKey("ns1", "pc1"), nil)
+		Expect(recorder.removes).To(ConsistOf(captureID))
+
+		By("ignoring deletes of unknown captures")
+		update(captureKey("ns1", "pc2"), nil)
+		Expect(recorder.removes).To(HaveLen(1))
+	})
+})
+
+type packetCaptureRecorder struct {
+	updates map[proto.PacketCaptureID]*proto.PacketCaptureUpdate
+	removes []proto.PacketCaptureID
+}
+
+func (r *packetCaptureRecorder) OnPacketCaptureUpdate(update *proto.PacketCaptureUpdate) {
+	r.updates[*update.Id] = update
+}
+
+func (r *packetCaptureRecorder) OnPacketCaptureRemove(id proto.PacketCaptureID) {
+	r.removes = append(r.removes, id)
+}
@@ -52,7 +52,7 @@ func init() {
 type PolicyResolver struct {
 	policyIDToEndpointIDs multidict.IfaceToIface
 	endpointIDToPolicyIDs multidict.IfaceToIface
-	sortedTierData        *tierInfo
+	sortedTierData        []*tierInfo
 	endpoints             map[model.Key]interface{}
 	dirtyEndpoints        set.Set
 	sortRequired          bool
@@ -69,7 +69,7 @@ func NewPolicyResolver() *PolicyResolver {
 	return &PolicyResolver{
 		policyIDToEndpointIDs: multidict.NewIfaceToIface(),
 		endpointIDToPolicyIDs: multidict.NewIfaceToIface(),
-		sortedTierData:        NewTierInfo("default"),
+		sortedTierData:        []*tierInfo{},
 		endpoints:             make(map[model.Key]interface{}),
 		dirtyEndpoints:        set.New(),
 		policySorter:          NewPolicySorter(),
@@ -77,6 +77,7 @@ func NewPolicyResolver() *PolicyResolver {
 }
 
 func (pr *PolicyResolver) RegisterWith(allUpdDispatcher, localEndpointDispatcher *dispatcher.Dispatcher) {
+	allUpdDispatcher.Register(model.TierKey{}, pr.OnUpdate)
 	allUpdDispatcher.Register(model.PolicyKey{}, pr.OnUpdate)
 	localEndpointDispatcher.Register(model.WorkloadEndpointKey{}, pr.OnUpdate)
 	localEndpointDispatcher.Register(model.HostEndpointKey{}, pr.OnUpdate)
@@ -94,6 +95,16 @@ func (pr *PolicyResolver) OnUpdate(update api.Update) (filterOut bool) {
 		}
 		pr.dirtyEndpoints.Add(key)
 		gaugeNumActiveEndpoints.Set(float64(len(pr.endpoints)))
+	case model.TierKey:
+		log.Debugf("Tier update: %v", key)
+		policiesDirty = pr.policySorter.OnUpdate(update)
+		if policiesDirty {
+			// The order or default action of the tier may have changed, so all endpoints
+			// that match a policy in the tier need to be recalculated.
+			for _, polKey := range pr.policySorter.TierPolicies(key.Name) {
+				pr.markEndpointsMatchingPolicyDirty(polKey)
+			}
+		}
 	case model.PolicyKey:
 		log.Debugf("Policy update: %v", key)
 		policiesDirty = pr.policySorter.OnUpdate(update)
@@ -165,24 +176,27 @@ func (pr *PolicyResolver) sendEndpointUpdate(endpointID interface{}) error {
 		return nil
 	}
 	applicableTiers := []tierInfo{}
-	tier := pr.sortedTierData
-	tierMatches := false
-	filteredTier := tierInfo{
-		Name:  tier.Name,
-		Order: tier.Order,
-	}
-	for _, polKV := range tier.OrderedPolicies {
-		log.Debugf("Checking if policy %v matches %v", polKV.Key, endpointID)
-		if pr.endpointIDToPolicyIDs.Contains(endpointID, polKV.Key) {
-			log.Debugf("Policy %v matches %v", polKV.Key, endpointID)
-			tierMatches = true
-			filteredTier.OrderedPolicies = append(filteredTier.OrderedPolicies,
-				polKV)
+	for _, tier := range pr.sortedTierData {
+		tierMatches := false
+		filteredTier := tierInfo{
+			Name:          tier.Name,
+			Valid:         tier.Valid,
+			Order:         tier.Order,
+			DefaultAction: tier.DefaultAction,
+		}
+		for _, polKV := range tier.OrderedPolicies {
+			log.Debugf("Checking if policy %v matches %v", polKV.Key, endpointID)
+			if pr.endpointIDToPolicyIDs.Contains(endpointID, polKV.Key) {
+				log.Debugf("Policy %v matches %v", polKV.Key, endpointID)
+				tierMatches = true
+				filteredTier.OrderedPolicies = append(filteredTier.OrderedPolicies,
+					polKV)
+			}
+		}
+		if tierMatches {
+			log.Debugf("Tier %v matches %v", tier.Name, endpointID)
+			applicableTiers = append(applicableTiers, filteredTier)
 		}
-	}
-	if tierMatches {
-		log.Debugf("Tier %v matches %v", tier.Name, endpointID)
-		applicableTiers = append(applicableTiers, filteredTier)
 	}
 	log.Debugf("Endpoint tier update: %v -> %v", endpointID, applicableTiers)
 	pr.Callbacks.OnEndpointTierUpdate(endpointID.(model.Key),
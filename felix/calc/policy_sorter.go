@@ -21,21 +21,20 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
 )
 
 type PolicySorter struct {
-	tier *tierInfo
+	tiers map[string]*tierInfo
 }
 
 func NewPolicySorter() *PolicySorter {
 	return &PolicySorter{
-		tier: &tierInfo{
-			Name:     "default",
-			Policies: make(map[model.PolicyKey]*model.Policy),
-		},
+		tiers: make(map[string]*tierInfo),
 	}
 }
 
@@ -53,8 +52,43 @@ func policyTypesEqual(pol1, pol2 *model.Policy) bool {
 
 func (poc *PolicySorter) OnUpdate(update api.Update) (dirty bool) {
 	switch key := update.Key.(type) {
+	case model.TierKey:
+		tierName := key.Name
+		tierInfo := poc.tiers[tierName]
+		if update.Value != nil {
+			newTier := update.Value.(*model.Tier)
+			if tierInfo == nil {
+				tierInfo = NewTierInfo(tierName)
+				poc.tiers[tierName] = tierInfo
+				dirty = true
+			}
+			if !tierInfo.Valid ||
+				!float64PtrsEqual(tierInfo.Order, newTier.Order) ||
+				tierInfo.DefaultAction != newTier.DefaultAction {
+				dirty = true
+			}
+			tierInfo.Valid = true
+			tierInfo.Order = newTier.Order
+			tierInfo.DefaultAction = newTier.DefaultAction
+		} else if tierInfo != nil {
+			// The tier has been deleted.  If it still contains policies, we keep it around
+			// (but mark it invalid) so that its policies continue to be applied.
+			if len(tierInfo.Policies) == 0 {
+				delete(poc.tiers, tierName)
+			} else {
+				tierInfo.Valid = false
+				tierInfo.Order = nil
+				tierInfo.DefaultAction = ""
+			}
+			dirty = true
+		}
 	case model.PolicyKey:
-		oldPolicy := poc.tier.Policies[key]
+		tierName := tierNameOrDefault(key.Tier)
+		tierInfo := poc.tiers[tierName]
+		var oldPolicy *model.Policy
+		if tierInfo != nil {
+			oldPolicy = tierInfo.Policies[key]
+		}
 		if update.Value != nil {
 			newPolicy := update.Value.(*model.Policy)
 			if oldPolicy == nil ||
@@ -65,10 +99,18 @@ func (poc *PolicySorter) OnUpdate(update api.Update) (dirty bool) {
 				!policyTypesEqual(oldPolicy, newPolicy) {
 				dirty = true
 			}
-			poc.tier.Policies[key] = newPolicy
+			if tierInfo == nil {
+				tierInfo = NewTierInfo(tierName)
+				poc.tiers[tierName] = tierInfo
+			}
+			tierInfo.Policies[key] = newPolicy
 		} else {
 			if oldPolicy != nil {
-				delete(poc.tier.Policies, key)
+				delete(tierInfo.Policies, key)
+				if len(tierInfo.Policies) == 0 && !tierInfo.Valid {
+					// Tier has no policies and no tier resource, so it can be discarded.
+					delete(poc.tiers, tierName)
+				}
 				dirty = true
 			}
 		}
@@ -76,18 +118,51 @@ func (poc *PolicySorter) OnUpdate(update api.Update) (dirty bool) {
 	return
 }
 
-func (poc *PolicySorter) Sorted() *tierInfo {
-	tierInfo := poc.tier
-	tierInfo.OrderedPolicies = make([]PolKV, 0, len(tierInfo.Policies))
-	for k, v := range tierInfo.Policies {
-		tierInfo.OrderedPolicies = append(tierInfo.OrderedPolicies, PolKV{Key: k, Value: v})
+// TierPolicies returns the keys of the policies in the named tier.
+func (poc *PolicySorter) TierPolicies(tierName string) []model.PolicyKey {
+	tierInfo := poc.tiers[tierName]
+	if tierInfo == nil {
+		return nil
+	}
+	keys := make([]model.PolicyKey, 0, len(tierInfo.Policies))
+	for k := range tierInfo.Policies {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func (poc *PolicySorter) Sorted() []*tierInfo {
+	tiers := make([]*tierInfo, 0, len(poc.tiers))
+	for _, tierInfo := range poc.tiers {
+		tierInfo.OrderedPolicies = make([]PolKV, 0, len(tierInfo.Policies))
+		for k, v := range tierInfo.Policies {
+			tierInfo.OrderedPolicies = append(tierInfo.OrderedPolicies, PolKV{Key: k, Value: v})
+		}
+		// Note: using explicit Debugf() here rather than WithFields(); we want the []PolKV slice
+		// to be stringified with %v rather than %#v (as used by WithField()).
+		log.Debugf("Order before sorting: %v", tierInfo.OrderedPolicies)
+		sort.Sort(PolicyByOrder(tierInfo.OrderedPolicies))
+		log.Debugf("Order after sorting: %v", tierInfo.OrderedPolicies)
+		tiers = append(tiers, tierInfo)
+	}
+	sort.Sort(TierByOrder(tiers))
+	return tiers
+}
+
+func float64PtrsEqual(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// tierNameOrDefault returns the supplied tier name, or the name of the default tier if the
+// name is empty.
+func tierNameOrDefault(tier string) string {
+	if tier == "" {
+		return v3.DefaultTierName
 	}
-	// Note: using explicit Debugf() here rather than WithFields(); we want the []PolKV slice
-	// to be stringified with %v rather than %#v (as used by WithField()).
-	log.Debugf("Order before sorting: %v", tierInfo.OrderedPolicies)
-	sort.Sort(PolicyByOrder(tierInfo.OrderedPolicies))
-	log.Debugf("Order after sorting: %v", tierInfo.OrderedPolicies)
-	return tierInfo
+	return tier
 }
 
 // Note: PolKV is really internal to the calc package.  It is named with an initial capital so that
@@ -168,10 +243,52 @@ func (a PolicyByOrder) Less(i, j int) bool {
 	return *a[i].Value.Order < *a[j].Value.Order
 }
 
+// TierByOrder sorts tiers into the order in which they should be applied.  Tiers that have
+// no Tier resource (but do have policies) are invalid and are sorted after all valid tiers.
+// Tiers with no order are applied after tiers with an order, and the default tier is applied
+// after any other tier with no order.  Tiers with identical order are sorted by name.
+type TierByOrder []*tierInfo
+
+func (a TierByOrder) Len() int      { return len(a) }
+func (a TierByOrder) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a TierByOrder) Less(i, j int) bool {
+	if a[i].isValid() != a[j].isValid() {
+		return a[i].isValid()
+	}
+
+	bothNil := a[i].Order == nil && a[j].Order == nil
+	bothSet := a[i].Order != nil && a[j].Order != nil
+	ordersEqual := bothNil || bothSet && (*a[i].Order == *a[j].Order)
+
+	if ordersEqual {
+		if bothNil && a[i].Name != a[j].Name {
+			// The default tier goes after the other tiers with no order.
+			if a[i].Name == v3.DefaultTierName {
+				return false
+			} else if a[j].Name == v3.DefaultTierName {
+				return true
+			}
+		}
+		// Use name as tie-break.
+		return a[i].Name < a[j].Name
+	}
+
+	// nil order maps to "infinity"
+	if a[i].Order == nil {
+		return false
+	} else if a[j].Order == nil {
+		return true
+	}
+
+	// Otherwise, use numeric comparison.
+	return *a[i].Order < *a[j].Order
+}
+
 type tierInfo struct {
 	Name            string
 	Valid           bool
 	Order           *float64
+	DefaultAction   string
 	Policies        map[model.PolicyKey]*model.Policy
 	OrderedPolicies []PolKV
 }
@@ -183,6 +300,12 @@ func NewTierInfo(name string) *tierInfo {
 	}
 }
 
+// isValid returns true if the tier has a corresponding Tier resource.  The default tier
+// always exists, so it is implicitly valid.
+func (t *tierInfo) isValid() bool {
+	return t.Valid || t.Name == v3.DefaultTierName
+}
+
 func (t tierInfo) String() string {
 	policies := make([]string, len(t.OrderedPolicies))
 	for ii, pol := range t.OrderedPolicies {
@@ -17,14 +17,17 @@ package calc_test
 import (
 	. "github.com/projectcalico/calico/felix/calc"
 
+	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 )
 
 var (
 	tenPointFive = 10.5
+	twenty       = 20.0
 )
 
 var _ = DescribeTable("PolKV should stringify correctly",
@@ -32,10 +35,67 @@ var _ = DescribeTable("PolKV should stringify correctly",
 		Expect(kv.String()).To(Equal(expected))
 	},
 	Entry("zero", PolKV{}, "(nil policy)"),
-	Entry("nil policy", PolKV{Key: model.PolicyKey{Name: "name"}}, "name(nil policy)"),
+	Entry("nil policy", PolKV{Key: model.PolicyKey{Tier: "default", Name: "name"}}, "name(nil policy)"),
 	Entry("nil order",
-		PolKV{Key: model.PolicyKey{Name: "name"}, Value: &model.Policy{}}, "name(default)"),
+		PolKV{Key: model.PolicyKey{Tier: "default", Name: "name"}, Value: &model.Policy{}}, "name(default)"),
 	Entry("order set",
-		PolKV{Key: model.PolicyKey{Name: "name"}, Value: &model.Policy{Order: &tenPointFive}},
+		PolKV{Key: model.PolicyKey{Tier: "default", Name: "name"}, Value: &model.Policy{Order: &tenPointFive}},
 		"name(10.5)"),
 )
+
+var _ = Describe("PolicySorter tier ordering", func() {
+	var ps *PolicySorter
+
+	tierUpdate := func(name string, order *float64) api.Update {
+		return api.Update{KVPair: model.KVPair{Key: model.TierKey{Name: name}, Value: &model.Tier{Order: order}}}
+	}
+	policyUpdate := func(tier, name string) api.Update {
+		return api.Update{KVPair: model.KVPair{Key: model.PolicyKey{Tier: tier, Name: name}, Value: &model.Policy{}}}
+	}
+	sortedTierNames := func() []string {
+		var names []string
+		for _, t := range ps.Sorted() {
+			names = append(names, t.Name)
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		ps = NewPolicySorter()
+	})
+
+	It("should sort tiers by order, with the default tier last", func() {
+		ps.OnUpdate(tierUpdate("default", nil))
+		ps.OnUpdate(tierUpdate("tier-b", &twenty))
+		ps.OnUpdate(tierUpdate("tier-a", &tenPointFive))
+		ps.OnUpdate(tierUpdate("tier-c", nil))
+		Expect(sortedTierNames()).To(Equal([]string{"tier-a", "tier-b", "tier-c", "default"}))
+	})
+
+	It("should break order ties by name", func() {
+		ps.OnUpdate(tierUpdate("tier-b", &twenty))
+		ps.OnUpdate(tierUpdate("tier-a", &twenty))
+		Expect(sortedTierNames()).To(Equal([]string{"tier-a", "tier-b"}))
+	})
+
+	It("should sort tiers with policies but no Tier resource last", func() {
+		ps.OnUpdate(policyUpdate("orphan", "orphan.pol"))
+		ps.OnUpdate(policyUpdate("", "pol"))
+		ps.OnUpdate(tierUpdate("tier-a", &tenPointFive))
+		Expect(sortedTierNames()).To(Equal([]string{"tier-a", "default", "orphan"}))
+	})
+
+	It("should put policies with no tier in the default tier", func() {
+		ps.OnUpdate(policyUpdate("", "pol"))
+		Expect(ps.TierPolicies("default")).To(ConsistOf(model.PolicyKey{Name: "pol"}))
+	})
+
+	It("should discard a deleted tier once it has no policies", func() {
+		ps.OnUpdate(tierUpdate("tier-a", &tenPointFive))
+		ps.OnUpdate(policyUpdate("tier-a", "tier-a.pol"))
+		ps.OnUpdate(api.Update{KVPair: model.KVPair{Key: model.TierKey{Name: "tier-a"}}})
+		Expect(sortedTierNames()).To(Equal([]string{"tier-a"}))
+		ps.OnUpdate(api.Update{KVPair: model.KVPair{Key: model.PolicyKey{Tier: "tier-a", Name: "tier-a.pol"}}})
+		Expect(sortedTierNames()).To(BeEmpty())
+	})
+})
@@ -825,7 +825,7 @@ var endpointSlice1 = discovery.EndpointSlice{
 		{Port: &p, Protocol: &tcp},
 	},
 }
-var servicePolicyKey = model.PolicyKey{Name: "svc-policy"}
+var servicePolicyKey = model.PolicyKey{Tier: "default", Name: "svc-policy"}
 var servicePolicy = model.Policy{
 	Namespace: "default",
 	OutboundRules: []model.Rule{
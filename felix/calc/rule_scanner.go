@@ -15,6 +15,8 @@ package calc
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
 
@@ -99,6 +101,9 @@ type IPSetData struct {
 	// Type of the ip set to represent for this service. This allows us to create service
 	// IP sets with and without port information.
 	ServiceIncludePorts bool
+	// The sorted list of domain names that this IP set represents.  The IP set contains the
+	// IP addresses that the dataplane has learned for those names from DNS responses.
+	Domains []string
 	// cachedUID holds the calculated unique ID of this IP set, or "" if it hasn't been calculated
 	// yet.
 	cachedUID string
@@ -106,7 +111,10 @@ type IPSetData struct {
 
 func (d *IPSetData) UniqueID() string {
 	if d.cachedUID == "" {
-		if d.Service != "" {
+		if len(d.Domains) > 0 {
+			// Domain based IP set.
+			d.cachedUID = hash.MakeUniqueID("d", strings.Join(d.Domains, ","))
+		} else if d.Service != "" {
 			// Service based IP set.
 			if d.ServiceIncludePorts {
 				// Service IP set including its ports
@@ -132,6 +140,9 @@ func (d *IPSetData) UniqueID() string {
 // DataplaneProtocolType returns the dataplane driver protocol type of this IP set.
 // One of the proto.IPSetUpdate_IPSetType constants.
 func (d *IPSetData) DataplaneProtocolType() proto.IPSetUpdate_IPSetType {
+	if len(d.Domains) > 0 {
+		return proto.IPSetUpdate_DOMAIN
+	}
 	if d.NamedPortProtocol != labelindex.ProtocolNone {
 		return proto.IPSetUpdate_IP_AND_PORT
 	}
@@ -405,6 +416,14 @@ func ruleToParsedRule(rule *model.Rule) (parsedRule *ParsedRule, allIPSets []*IP
 		srcSelIPSets = append(srcSelIPSets, &IPSetData{Service: svc, ServiceIncludePorts: false})
 	}
 
+	// Domains are rendered as an IP set that the dataplane populates from DNS responses.
+	if len(rule.DstDomains) > 0 {
+		domains := make([]string, len(rule.DstDomains))
+		copy(domains, rule.DstDomains)
+		sort.Strings(domains)
+		dstSelIPSets = append(dstSelIPSets, &IPSetData{Domains: domains})
+	}
+
 	notSrcSelIPSets := selectorsToIPSets(notSrcSels)
 	notDstSelIPSets := selectorsToIPSets(notDstSels)
 
@@ -91,7 +91,7 @@ var _ = DescribeTable("RuleScanner rule conversion should generate correct Parse
 		Expect(ur.activeRules).To(Equal(map[model.Key]*ParsedRules{}))
 
 		By("correctly translating InboundRules in a policy")
-		policyKey := model.PolicyKey{Name: "policy"}
+		policyKey := model.PolicyKey{Tier: "default", Name: "policy"}
 		policy := &model.Policy{
 			Namespace:     "namespace",
 			InboundRules:  []model.Rule{modelRule},
@@ -164,6 +164,13 @@ var _ = DescribeTable("RuleScanner rule conversion should generate correct Parse
 			OriginalSrcServiceNamespace: "default",
 		}),
 
+	// Domains.
+	Entry("dest domains",
+		model.Rule{DstDomains: []string{"b.example.com", "a.example.com"}},
+		ParsedRule{
+			DstIPSetIDs: []string{(&IPSetData{Domains: []string{"a.example.com", "b.example.com"}}).UniqueID()},
+		}),
+
 	// Selectors.
 	Entry("source selector", model.Rule{SrcSelector: sel1}, ParsedRule{SrcIPSetIDs: []string{sel1ID}}),
 	Entry("dest selector", model.Rule{DstSelector: sel1}, ParsedRule{DstIPSetIDs: []string{sel1ID}}),
@@ -239,6 +246,10 @@ var _ = Describe("ParsedRule", func() {
 				// as either IPPortIPSetIDs or IPSetIDs.
 				continue
 			}
+			if name == "DstDomains" {
+				// Domains are rendered on the ParsedRule as a domain IP set in DstIPSetIDs.
+				continue
+			}
 			if strings.HasSuffix(name, "Net") {
 				// Deprecated XXXNet fields.
 				continue
@@ -313,7 +324,7 @@ func (ur *scanUpdateRecorder) OnProfileInactive(key model.ProfileRulesKey) {
 }
 
 func (ur *scanUpdateRecorder) ipSetActive(ipSet *IPSetData) {
-	if ipSet.Service != "" {
+	if ipSet.Service != "" || len(ipSet.Domains) > 0 {
 		// Not a selector-based set.
 		return
 	}
@@ -321,7 +332,7 @@ func (ur *scanUpdateRecorder) ipSetActive(ipSet *IPSetData) {
 }
 
 func (ur *scanUpdateRecorder) ipSetInactive(ipSet *IPSetData) {
-	if ipSet.Service != "" {
+	if ipSet.Service != "" || len(ipSet.Domains) > 0 {
 		// Not a selector-based set.
 		return
 	}
@@ -0,0 +1,182 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package calc
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/proto"
+	apiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/encap"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+// routeAndVTEPRecorder records the route and VTEP updates emitted by the L3RouteResolver and
+// VXLANResolver.  The embedded PipelineCallbacks is left nil; the resolvers under test only use
+// the route and VTEP callbacks.
+type routeAndVTEPRecorder struct {
+	PipelineCallbacks
+
+	routes map[string]*proto.RouteUpdate
+	vteps  map[string]*proto.VXLANTunnelEndpointUpdate
+}
+
+func newRouteAndVTEPRecorder() *routeAndVTEPRecorder {
+	return &routeAndVTEPRecorder{
+		routes: map[string]*proto.RouteUpdate{},
+		vteps:  map[string]*proto.VXLANTunnelEndpointUpdate{},
+	}
+}
+
+func (r *routeAndVTEPRecorder) OnRouteUpdate(update *proto.RouteUpdate) {
+	r.routes[update.Dst] = update
+}
+
+func (r *routeAndVTEPRecorder) OnRouteRemove(dst string) {
+	delete(r.routes, dst)
+}
+
+func (r *routeAndVTEPRecorder) OnVTEPUpdate(update *proto.VXLANTunnelEndpointUpdate) {
+	r.vteps[update.Node] = update
+}
+
+func (r *routeAndVTEPRecorder) OnVTEPRemove(node string) {
+	delete(r.vteps, node)
+}
+
+func dualStackNodeUpdate(name, v4, v6, v6TunnelAddr string) api.Update {
+	node := apiv3.NewNode()
+	node.Name = name
+	node.Spec.BGP = &apiv3.NodeBGPSpec{
+		IPv4Address: v4,
+		IPv6Address: v6,
+	}
+	node.Spec.IPv6VXLANTunnelAddr = v6TunnelAddr
+	return api.Update{
+		KVPair: model.KVPair{
+			Key:   model.ResourceKey{Kind: apiv3.KindNode, Name: name},
+			Value: node,
+		},
+		UpdateType: api.UpdateTypeKVNew,
+	}
+}
+
+func hostConfigUpdate(host, name string, value interface{}) api.Update {
+	return api.Update{
+		KVPair: model.KVPair{
+			Key:   model.HostConfigKey{Hostname: host, Name: name},
+			Value: value,
+		},
+		UpdateType: api.UpdateTypeKVNew,
+	}
+}
+
+var _ = Describe("L3RouteResolver IPv6", func() {
+	var recorder *routeAndVTEPRecorder
+
+	v6PoolCIDR := net.MustParseCIDR("fd10::/48")
+	v6BlockCIDR := net.MustParseCIDR("fd10:0:0:1::/122")
+	v6BlockUpdate := func() api.Update {
+		affinity := "host:remote"
+		return api.Update{
+			KVPair: model.KVPair{
+				Key: model.BlockKey{CIDR: v6BlockCIDR},
+				Value: &model.AllocationBlock{
+					CIDR:     v6BlockCIDR,
+					Affinity: &affinity,
+				},
+			},
+			UpdateType: api.UpdateTypeKVNew,
+		}
+	}
+
+	sendUpdates := func(l3rr *L3RouteResolver) {
+		l3rr.OnResourceUpdate(dualStackNodeUpdate("local", "192.168.0.1/24", "fd00:1::1/64", "fd10:0:0:2::"))
+		l3rr.OnResourceUpdate(dualStackNodeUpdate("remote", "192.168.0.2/24", "fd00:2::2/64", "fd10:0:0:1::"))
+		l3rr.OnPoolUpdate(addPoolUpdate(v6PoolCIDR, encap.Undefined, encap.Always))
+		l3rr.OnBlockUpdate(v6BlockUpdate())
+	}
+
+	BeforeEach(func() {
+		recorder = newRouteAndVTEPRecorder()
+	})
+
+	It("should emit IPv6 routes via the remote node's IPv6 address when enabled", func() {
+		sendUpdates(NewL3RouteResolver("local", recorder, true, "CalicoIPAM", true))
+
+		Expect(recorder.routes).To(HaveKey("fd10:0:0:1::/122"))
+		route := recorder.routes["fd10:0:0:1::/122"]
+		Expect(route.Type).To(Equal(proto.RouteType_REMOTE_WORKLOAD))
+		Expect(route.IpPoolType).To(Equal(proto.IPPoolType_VXLAN))
+		Expect(route.DstNodeName).To(Equal("remote"))
+		Expect(route.DstNodeIp).To(Equal("fd00:2::2"))
+		Expect(route.SameSubnet).To(BeFalse())
+
+		Expect(recorder.routes).To(HaveKey("fd10:0:0:1::/128"))
+		Expect(recorder.routes["fd10:0:0:1::/128"].Type).To(Equal(proto.RouteType_REMOTE_TUNNEL))
+		Expect(recorder.routes).To(HaveKey("fd10:0:0:2::/128"))
+		Expect(recorder.routes["fd10:0:0:2::/128"].Type).To(Equal(proto.RouteType_LOCAL_TUNNEL))
+	})
+
+	It("should ignore IPv6 pools and blocks when disabled", func() {
+		sendUpdates(NewL3RouteResolver("local", recorder, true, "CalicoIPAM", false))
+
+		for dst := range recorder.routes {
+			Expect(dst).NotTo(ContainSubstring(":"), "unexpected IPv6 route")
+		}
+	})
+})
+
+var _ = Describe("VXLANResolver IPv6", func() {
+	var recorder *routeAndVTEPRecorder
+	var resolver *VXLANResolver
+
+	BeforeEach(func() {
+		recorder = newRouteAndVTEPRecorder()
+		resolver = NewVXLANResolver("local", recorder, true)
+		resolver.OnResourceUpdate(dualStackNodeUpdate("remote", "192.168.0.2/24", "fd00:2::2/64", ""))
+	})
+
+	It("should send an IPv6-only VTEP", func() {
+		resolver.OnHostConfigUpdate(hostConfigUpdate("remote", "IPv6VXLANTunnelAddr", "fd10:0:0:1::"))
+
+		Expect(recorder.vteps).To(HaveKey("remote"))
+		vtep := recorder.vteps["remote"]
+		Expect(vtep.Ipv4Addr).To(BeEmpty())
+		Expect(vtep.Ipv6Addr).To(Equal("fd10:0:0:1::"))
+		Expect(vtep.ParentDeviceIpv6).To(Equal("fd00:2::2"))
+		Expect(vtep.MacV6).NotTo(BeEmpty())
+	})
+
+	It("should send a dual-stack VTEP and fall back to IPv6 when the IPv4 tunnel address is removed", func() {
+		resolver.OnHostConfigUpdate(hostConfigUpdate("remote", "IPv4VXLANTunnelAddr", "10.0.1.0"))
+		resolver.OnHostConfigUpdate(hostConfigUpdate("remote", "IPv6VXLANTunnelAddr", "fd10:0:0:1::"))
+
+		vtep := recorder.vteps["remote"]
+		Expect(vtep.Ipv4Addr).To(Equal("10.0.1.0"))
+		Expect(vtep.ParentDeviceIp).To(Equal("192.168.0.2"))
+		Expect(vtep.Ipv6Addr).To(Equal("fd10:0:0:1::"))
+		Expect(vtep.Mac).NotTo(Equal(vtep.MacV6))
+
+		resolver.OnHostConfigUpdate(hostConfigUpdate("remote", "IPv4VXLANTunnelAddr", nil))
+
+		vtep = recorder.vteps["remote"]
+		Expect(vtep.Ipv4Addr).To(BeEmpty())
+		Expect(vtep.Ipv6Addr).To(Equal("fd10:0:0:1::"))
+	})
+})
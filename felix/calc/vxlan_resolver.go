@@ -1,4 +1,4 @@
-// Copyright (c) 2019-2021 Tigera, Inc. All rights reserved.
+// Copyright (c) 2019-2022 Tigera, Inc. All rights reserved.
 
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -42,6 +42,9 @@ import (
 // calculates the VTEPs.  The dataplane is responsible for only programming routes once
 // the VTEP is ready.
 //
+// A node's VTEP may have an IPv4 part, an IPv6 part or both.  The IPv6 part requires the node's
+// IPv6 address, which is only available when node resource updates are in use.
+//
 // For each VTEP, this component will send a *proto.VXLANTunnelEndpointUpdate.
 //
 // If a VTEP is no longer fully specified (e.g., due to a vxlan tunnel address removal),
@@ -55,26 +58,32 @@ type VXLANResolver struct {
 
 	// Store node metadata indexed by node name, and routes by the
 	// block that contributed them. The following comprises the full internal data model.
-	nodeNameToVXLANTunnelAddr map[string]string
-	nodeNameToIPAddr          map[string]string
-	nodeNameToNode            map[string]*apiv3.Node
-	nodeNameToVXLANMac        map[string]string
-	blockToRoutes             map[string]set.Set
-	vxlanPools                map[string]model.IPPool
-	useNodeResourceUpdates    bool
+	nodeNameToVXLANTunnelAddr   map[string]string
+	nodeNameToIPAddr            map[string]string
+	nodeNameToVXLANMac          map[string]string
+	nodeNameToVXLANTunnelAddrV6 map[string]string
+	nodeNameToIPv6Addr          map[string]string
+	nodeNameToVXLANMacV6        map[string]string
+	nodeNameToNode              map[string]*apiv3.Node
+	blockToRoutes               map[string]set.Set
+	vxlanPools                  map[string]model.IPPool
+	useNodeResourceUpdates      bool
 }
 
 func NewVXLANResolver(hostname string, callbacks vxlanCallbacks, useNodeResourceUpdates bool) *VXLANResolver {
 	return &VXLANResolver{
-		hostname:                  hostname,
-		callbacks:                 callbacks,
-		nodeNameToVXLANTunnelAddr: map[string]string{},
-		nodeNameToIPAddr:          map[string]string{},
-		nodeNameToNode:            map[string]*apiv3.Node{},
-		nodeNameToVXLANMac:        map[string]string{},
-		blockToRoutes:             map[string]set.Set{},
-		vxlanPools:                map[string]model.IPPool{},
-		useNodeResourceUpdates:    useNodeResourceUpdates,
+		hostname:                    hostname,
+		callbacks:                   callbacks,
+		nodeNameToVXLANTunnelAddr:   map[string]string{},
+		nodeNameToIPAddr:            map[string]string{},
+		nodeNameToVXLANMac:          map[string]string{},
+		nodeNameToVXLANTunnelAddrV6: map[string]string{},
+		nodeNameToIPv6Addr:          map[string]string{},
+		nodeNameToVXLANMacV6:        map[string]string{},
+		nodeNameToNode:              map[string]*apiv3.Node{},
+		blockToRoutes:               map[string]set.Set{},
+		vxlanPools:                  map[string]model.IPPool{},
+		useNodeResourceUpdates:      useNodeResourceUpdates,
 	}
 }
 
@@ -101,13 +110,26 @@ func (c *VXLANResolver) OnResourceUpdate(update api.Update) (_ bool) {
 		node := update.Value.(*apiv3.Node)
 		bgp := node.Spec.BGP
 		c.nodeNameToNode[nodeName] = node
-		ipv4, _, err := cnet.ParseCIDROrIP(bgp.IPv4Address)
-		if err != nil {
-			logCxt.WithError(err).Error("couldn't parse ipv4 address from node bgp info")
-			return
+
+		var ipv4Str, ipv6Str string
+		if bgp.IPv4Address != "" {
+			ipv4, _, err := cnet.ParseCIDROrIP(bgp.IPv4Address)
+			if err != nil {
+				logCxt.WithError(err).Error("couldn't parse ipv4 address from node bgp info")
+				return
+			}
+			ipv4Str = ipv4.String()
+		}
+		if bgp.IPv6Address != "" {
+			ipv6, _, err := cnet.ParseCIDROrIP(bgp.IPv6Address)
+			if err != nil {
+				logCxt.WithError(err).Error("couldn't parse ipv6 address from node bgp info")
+				return
+			}
+			ipv6Str = ipv6.String()
 		}
 
-		c.onNodeIPUpdate(nodeName, ipv4.String())
+		c.onNodeIPUpdate(nodeName, ipv4Str, ipv6Str)
 	} else {
 		delete(c.nodeNameToNode, nodeName)
 		c.onRemoveNode(nodeName)
@@ -125,22 +147,28 @@ func (c *VXLANResolver) OnHostIPUpdate(update api.Update) (_ bool) {
 	logrus.WithField("node", nodeName).Debug("OnHostIPUpdate triggered")
 
 	if update.Value != nil {
-		c.onNodeIPUpdate(nodeName, update.Value.(*cnet.IP).String())
+		c.onNodeIPUpdate(nodeName, update.Value.(*cnet.IP).String(), "")
 	} else {
 		c.onRemoveNode(nodeName)
 	}
 	return
 }
 
-func (c *VXLANResolver) onNodeIPUpdate(nodeName string, newIP string) {
+func (c *VXLANResolver) onNodeIPUpdate(nodeName string, newIP, newIPv6 string) {
 	logCxt := logrus.WithField("node", nodeName)
 	// Host IP updated or added. If it was added, we should check to see if we're ready
 	// to send a VTEP and associated routes. If we already knew about this one, we need to
 	// see if it has changed. If it has, we should reprogram the VTEP.
 	currIP := c.nodeNameToIPAddr[nodeName]
-	logCxt = logCxt.WithFields(logrus.Fields{"newIP": newIP, "currIP": currIP})
+	currIPv6 := c.nodeNameToIPv6Addr[nodeName]
+	logCxt = logCxt.WithFields(logrus.Fields{
+		"newIP":    newIP,
+		"currIP":   currIP,
+		"newIPv6":  newIPv6,
+		"currIPv6": currIPv6,
+	})
 	if c.vtepSent(nodeName) {
-		if currIP == newIP {
+		if currIP == newIP && currIPv6 == newIPv6 {
 			// If we've already handled this node, there's nothing to do. Deduplicate.
 			logCxt.Debug("Skipping duplicate node IP update")
 			return
@@ -152,7 +180,8 @@ func (c *VXLANResolver) onNodeIPUpdate(nodeName string, newIP string) {
 	}
 
 	// Try sending a VTEP update.
-	c.nodeNameToIPAddr[nodeName] = newIP
+	setOrDelete(c.nodeNameToIPAddr, nodeName, newIP)
+	setOrDelete(c.nodeNameToIPv6Addr, nodeName, newIPv6)
 	c.sendVTEPUpdate(nodeName)
 }
 
@@ -160,6 +189,7 @@ func (c *VXLANResolver) onRemoveNode(nodeName string) {
 	logCxt := logrus.WithField("node", nodeName)
 	logCxt.Info("Withdrawing VTEP, node IP address deleted")
 	delete(c.nodeNameToIPAddr, nodeName)
+	delete(c.nodeNameToIPv6Addr, nodeName)
 	c.sendVTEPRemove(nodeName)
 }
 
@@ -170,67 +200,87 @@ func (c *VXLANResolver) onRemoveNode(nodeName string) {
 func (c *VXLANResolver) OnHostConfigUpdate(update api.Update) (_ bool) {
 	switch update.Key.(model.HostConfigKey).Name {
 	case "IPv4VXLANTunnelAddr":
-		nodeName := update.Key.(model.HostConfigKey).Hostname
-		vtepSent := c.vtepSent(nodeName)
-		logCxt := logrus.WithField("node", nodeName).WithField("value", update.Value)
-		logCxt.Debug("IPv4VXLANTunnelAddr update")
-		if update.Value != nil {
-			// Update for a VXLAN tunnel address.
-			newIP := update.Value.(string)
-			currIP := c.nodeNameToVXLANTunnelAddr[nodeName]
-			logCxt = logCxt.WithFields(logrus.Fields{"newIP": newIP, "currIP": currIP})
-			if vtepSent {
-				if currIP == newIP {
-					// If we've already handled this node, there's nothing to do. Deduplicate.
-					logCxt.Debug("Skipping duplicate tunnel addr update")
-					return
-				}
-				c.sendVTEPRemove(nodeName)
-			}
+		c.onTunnelAddrUpdate(update, c.nodeNameToVXLANTunnelAddr)
+	case "IPv6VXLANTunnelAddr":
+		c.onTunnelAddrUpdate(update, c.nodeNameToVXLANTunnelAddrV6)
+	case "VXLANTunnelMACAddr":
+		c.onTunnelMACUpdate(update, c.nodeNameToVXLANMac, 4)
+	case "VXLANTunnelMACAddrV6":
+		c.onTunnelMACUpdate(update, c.nodeNameToVXLANMacV6, 6)
+	}
+	return
+}
 
-			// Try sending a VTEP update.
-			c.nodeNameToVXLANTunnelAddr[nodeName] = newIP
-			c.sendVTEPUpdate(nodeName)
-		} else {
-			// Withdraw the VTEP.
-			logCxt.Info("Withdrawing VTEP, node tunnel address deleted")
-			delete(c.nodeNameToVXLANTunnelAddr, nodeName)
+func (c *VXLANResolver) onTunnelAddrUpdate(update api.Update, nodeNameToTunnelAddr map[string]string) {
+	key := update.Key.(model.HostConfigKey)
+	nodeName := key.Hostname
+	vtepSent := c.vtepSent(nodeName)
+	logCxt := logrus.WithField("node", nodeName).WithField("value", update.Value)
+	logCxt.Debugf("%s update", key.Name)
+	if update.Value != nil {
+		// Update for a VXLAN tunnel address.
+		newIP := update.Value.(string)
+		currIP := nodeNameToTunnelAddr[nodeName]
+		logCxt = logCxt.WithFields(logrus.Fields{"newIP": newIP, "currIP": currIP})
+		if vtepSent {
+			if currIP == newIP {
+				// If we've already handled this node, there's nothing to do. Deduplicate.
+				logCxt.Debug("Skipping duplicate tunnel addr update")
+				return
+			}
 			c.sendVTEPRemove(nodeName)
 		}
-	case "VXLANTunnelMACAddr":
-		nodeName := update.Key.(model.HostConfigKey).Hostname
-		vtepSent := c.vtepSent(nodeName)
-		logCxt := logrus.WithField("node", nodeName).WithField("value", update.Value)
-		logCxt.Debug("VXLANTunnelMACAddr update")
-		if update.Value != nil {
-			// Update for a VXLAN tunnel MAC address.
-			newMAC := update.Value.(string)
-			currMAC := c.vtepMACForHost(nodeName)
-			logCxt = logCxt.WithFields(logrus.Fields{"newMAC": newMAC, "currMAC": currMAC})
-			c.nodeNameToVXLANMac[nodeName] = newMAC
-			if vtepSent {
-				if currMAC == newMAC {
-					// If we've already handled this node, there's nothing to do. Deduplicate.
-					logCxt.Debug("Skipping duplicate tunnel MAC addr update")
-					return
-				}
-
-				// Try sending a VTEP update.
-				c.sendVTEPUpdate(nodeName)
+
+		// Try sending a VTEP update.
+		nodeNameToTunnelAddr[nodeName] = newIP
+		c.sendVTEPUpdate(nodeName)
+	} else {
+		// Withdraw the VTEP.  If the node still has a tunnel address for the other IP version, a
+		// VTEP with just that part is re-sent.
+		logCxt.Info("Withdrawing VTEP, node tunnel address deleted")
+		delete(nodeNameToTunnelAddr, nodeName)
+		c.sendVTEPRemove(nodeName)
+		c.sendVTEPUpdate(nodeName)
+	}
+}
+
+func (c *VXLANResolver) onTunnelMACUpdate(update api.Update, nodeNameToMAC map[string]string, ipVersion int) {
+	key := update.Key.(model.HostConfigKey)
+	nodeName := key.Hostname
+	vtepSent := c.vtepSent(nodeName)
+	logCxt := logrus.WithField("node", nodeName).WithField("value", update.Value)
+	logCxt.Debugf("%s update", key.Name)
+	if update.Value != nil {
+		// Update for a VXLAN tunnel MAC address.
+		newMAC := update.Value.(string)
+		currMAC := c.vtepMACForHost(nodeName, ipVersion)
+		logCxt = logCxt.WithFields(logrus.Fields{"newMAC": newMAC, "currMAC": currMAC})
+		nodeNameToMAC[nodeName] = newMAC
+		if vtepSent {
+			if currMAC == newMAC {
+				// If we've already handled this node, there's nothing to do. Deduplicate.
+				logCxt.Debug("Skipping duplicate tunnel MAC addr update")
+				return
 			}
 
-		} else {
-			logCxt.Info("Update the VTEP with the system generated MAC address and send it to dataplane")
-			delete(c.nodeNameToVXLANMac, nodeName)
+			// Try sending a VTEP update.
 			c.sendVTEPUpdate(nodeName)
 		}
+
+	} else {
+		logCxt.Info("Update the VTEP with the system generated MAC address and send it to dataplane")
+		delete(nodeNameToMAC, nodeName)
+		c.sendVTEPUpdate(nodeName)
 	}
-	return
 }
 
 // vtepSent returns whether or not we should have sent the VTEP for the given node
 // based on our current internal state.
 func (c *VXLANResolver) vtepSent(node string) bool {
+	return c.v4VTEPComplete(node) || c.v6VTEPComplete(node)
+}
+
+func (c *VXLANResolver) v4VTEPComplete(node string) bool {
 	if _, ok := c.nodeNameToVXLANTunnelAddr[node]; !ok {
 		return false
 	}
@@ -240,25 +290,36 @@ func (c *VXLANResolver) vtepSent(node string) bool {
 	return true
 }
 
-func (c *VXLANResolver) sendVTEPUpdate(node string) bool {
-	logCxt := logrus.WithField("node", node)
-	tunlAddr, ok := c.nodeNameToVXLANTunnelAddr[node]
-	if !ok {
-		logCxt.Info("Missing vxlan tunnel address for node, cannot send VTEP yet")
+func (c *VXLANResolver) v6VTEPComplete(node string) bool {
+	if _, ok := c.nodeNameToVXLANTunnelAddrV6[node]; !ok {
+		return false
+	}
+	if _, ok := c.nodeNameToIPv6Addr[node]; !ok {
 		return false
 	}
-	parentDeviceIP, ok := c.nodeNameToIPAddr[node]
-	if !ok {
-		logCxt.Info("Missing IP for node, cannot send VTEP yet")
+	return true
+}
+
+func (c *VXLANResolver) sendVTEPUpdate(node string) bool {
+	logCxt := logrus.WithField("node", node)
+	if !c.vtepSent(node) {
+		logCxt.Info("Missing vxlan tunnel address or IP for node, cannot send VTEP yet")
 		return false
 	}
 
 	logCxt.Debug("Sending VTEP to dataplane")
 	vtep := &proto.VXLANTunnelEndpointUpdate{
-		Node:           node,
-		ParentDeviceIp: parentDeviceIP,
-		Mac:            c.vtepMACForHost(node),
-		Ipv4Addr:       tunlAddr,
+		Node: node,
+	}
+	if c.v4VTEPComplete(node) {
+		vtep.ParentDeviceIp = c.nodeNameToIPAddr[node]
+		vtep.Mac = c.vtepMACForHost(node, 4)
+		vtep.Ipv4Addr = c.nodeNameToVXLANTunnelAddr[node]
+	}
+	if c.v6VTEPComplete(node) {
+		vtep.ParentDeviceIpv6 = c.nodeNameToIPv6Addr[node]
+		vtep.MacV6 = c.vtepMACForHost(node, 6)
+		vtep.Ipv6Addr = c.nodeNameToVXLANTunnelAddrV6[node]
 	}
 	c.callbacks.OnVTEPUpdate(vtep)
 	return true
@@ -273,8 +334,15 @@ func (c *VXLANResolver) sendVTEPRemove(node string) {
 // If new MAC is present in host config, then vtepMACForHost returns the MAC present in  host config else
 // vtepMACForHost calculates a deterministic MAC address based on the provided host.
 // The returned address matches the address assigned to the VXLAN device on that node.
-func (c *VXLANResolver) vtepMACForHost(nodename string) string {
-	mac := c.nodeNameToVXLANMac[nodename]
+//
+// The IPv4 and IPv6 VXLAN devices use different parts of the hash so that they get distinct MACs.
+func (c *VXLANResolver) vtepMACForHost(nodename string, ipVersion int) string {
+	var mac string
+	if ipVersion == 6 {
+		mac = c.nodeNameToVXLANMacV6[nodename]
+	} else {
+		mac = c.nodeNameToVXLANMac[nodename]
+	}
 
 	if mac != "" {
 		return mac
@@ -286,6 +354,18 @@ func (c *VXLANResolver) vtepMACForHost(nodename string) string {
 		logrus.WithError(err).WithField("node", nodename).Panic("Failed to write hash for node")
 	}
 	sha := hasher.Sum(nil)
-	hw := gonet.HardwareAddr(append([]byte("f"), sha[0:5]...))
+	hashBytes := sha[0:5]
+	if ipVersion == 6 {
+		hashBytes = sha[5:10]
+	}
+	hw := gonet.HardwareAddr(append([]byte("f"), hashBytes...))
 	return hw.String()
 }
+
+func setOrDelete(m map[string]string, key, value string) {
+	if value == "" {
+		delete(m, key)
+		return
+	}
+	m[key] = value
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/felix/bpf"
+	"github.com/projectcalico/calico/felix/bpf/events"
+	"github.com/projectcalico/calico/felix/bpf/state"
+	"github.com/projectcalico/calico/felix/rules"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+)
+
+const (
+	// perfRingPages is the size of each per-CPU perf ring buffer.
+	perfRingPages = 64
+	// perfPollTimeoutMillis is how long each poll of the perf rings waits for events.
+	perfPollTimeoutMillis = 1000
+)
+
+// RuleIDs maps the rule IDs that the dataplane reports in place of a rule's full encoding back to
+// the rules that they identify.  The BPF endpoint manager registers each rule that it programs,
+// and the iptables rule renderer registers each rule whose NFLOG prefix is too long to hold the
+// encoding.
+type RuleIDs struct {
+	lock  sync.Mutex
+	rules map[uint64]rules.FlowLogRule
+	// idsByPolicy indexes the registered IDs by the policy or profile that owns them, so that
+	// they can be forgotten when it is removed.
+	idsByPolicy map[ruleIDsOwner]set.Set
+}
+
+type ruleIDsOwner struct {
+	tier, name string
+}
+
+func NewRuleIDs() *RuleIDs {
+	return &RuleIDs{
+		rules:       map[uint64]rules.FlowLogRule{},
+		idsByPolicy: map[ruleIDsOwner]set.Set{},
+	}
+}
+
+// Register records the rule and returns its ID.
+func (r *RuleIDs) Register(rule rules.FlowLogRule) uint64 {
+	id := rule.ID()
+	owner := ruleIDsOwner{tier: rule.Tier, name: rule.Name}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.rules[id] = rule
+	ids := r.idsByPolicy[owner]
+	if ids == nil {
+		ids = set.New()
+		r.idsByPolicy[owner] = ids
+	}
+	ids.Add(id)
+	return id
+}
+
+// RemovePolicy forgets the rules of the given policy, or of the given profile if tier is
+// rules.FlowLogProfileTier.
+func (r *RuleIDs) RemovePolicy(tier, name string) {
+	owner := ruleIDsOwner{tier: tier, name: name}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	ids := r.idsByPolicy[owner]
+	if ids == nil {
+		return
+	}
+	ids.Iter(func(item interface{}) error {
+		delete(r.rules, item.(uint64))
+		return nil
+	})
+	delete(r.idsByPolicy, owner)
+}
+
+func (r *RuleIDs) Lookup(id uint64) (rules.FlowLogRule, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	rule, ok := r.rules[id]
+	return rule, ok
+}
+
+// StartBPFSource reads the policy verdict events that the BPF programs send through the given
+// perf event array and passes the verdicts to the collector.
+func (c *Collector) StartBPFSource(eventsMap bpf.Map, ruleIDs *RuleIDs) error {
+	perf, err := events.OpenPerf(eventsMap, perfRingPages)
+	if err != nil {
+		return err
+	}
+	go func() {
+		var lastLost uint64
+		for {
+			raw, err := perf.Poll(perfPollTimeoutMillis)
+			if err != nil {
+				log.WithError(err).Error("Failed to read BPF events")
+				continue
+			}
+			if lost := perf.Lost(); lost != lastLost {
+				log.WithField("numLost", lost-lastLost).Warn("Some BPF policy verdict events were lost")
+				lastLost = lost
+			}
+			for _, r := range raw {
+				pi, err := packetInfoFromBPFEvent(r, ruleIDs)
+				if err != nil {
+					log.WithError(err).Debug("Ignoring BPF event")
+					continue
+				}
+				c.sendPacketInfo(pi)
+			}
+		}
+	}()
+	return nil
+}
+
+func packetInfoFromBPFEvent(raw []byte, ruleIDs *RuleIDs) (PacketInfo, error) {
+	e, err := events.ParseEvent(raw)
+	if err != nil {
+		return PacketInfo{}, err
+	}
+	v, err := events.ParsePolicyVerdict(e)
+	if err != nil {
+		return PacketInfo{}, err
+	}
+
+	rule, ok := ruleIDs.Lookup(v.RuleID)
+	if v.RuleID == 0 || !ok {
+		// No rule matched, so the verdict came from the default action.
+		rule = rules.FlowLogRule{
+			Action:    rules.FlowLogActionDeny,
+			Direction: rules.FlowLogDirectionIngress,
+			Index:     -1,
+		}
+		if v.PolicyRC == state.PolicyAllow {
+			rule.Action = rules.FlowLogActionAllow
+		}
+		if v.Direction == events.DirectionEgress {
+			rule.Direction = rules.FlowLogDirectionEgress
+		}
+	}
+	return PacketInfo{
+		Tuple: MakeTuple(v.SrcAddr, v.DstAddr, v.IPProto, v.SrcPort, v.DstPort),
+		Rule:  rule,
+		Bytes: int(v.IPSize),
+	}, nil
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package collector implements Felix's flow logs.  The dataplane reports each policy verdict to
+// the collector, which aggregates the verdicts per tuple and per rule and, at the end of each
+// flush interval, writes the aggregated flow logs to its sinks.
+package collector
+
+import (
+	"sort"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/felix/rules"
+)
+
+const (
+	packetInfoChanSize = 1000
+	// maxFlows is the maximum number of flows that the collector aggregates in each interval;
+	// verdicts for further flows are dropped until the next flush.
+	maxFlows = 100000
+	// sinkQueueLen is the number of batches of flow logs that may be waiting for each sink;
+	// further batches are dropped until the sink catches up.
+	sinkQueueLen = 2
+)
+
+var (
+	counterDroppedPacketInfos = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "felix_flow_logs_dropped_verdicts",
+		Help: "Number of policy verdicts dropped because the flow log collector was too busy or had too many flows.",
+	})
+	counterDroppedFlowLogs = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "felix_flow_logs_dropped_logs",
+		Help: "Number of flow logs dropped because a flow log sink was too slow.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(counterDroppedPacketInfos)
+	prometheus.MustRegister(counterDroppedFlowLogs)
+}
+
+// Sink receives batches of flow logs from the collector.
+type Sink interface {
+	// Write is called from a goroutine per sink, once per flush interval, with the flow logs for
+	// that interval.  If it takes longer than the flush interval then later batches are dropped.
+	// The flow logs are shared between the sinks and must not be modified.
+	Write(logs []*FlowLog) error
+}
+
+// sinkWriter hands batches of flow logs to a sink on its own goroutine, so that a slow sink
+// doesn't hold up the collector.
+type sinkWriter struct {
+	sink  Sink
+	logsC chan []*FlowLog
+}
+
+func newSinkWriter(s Sink) *sinkWriter {
+	return &sinkWriter{sink: s, logsC: make(chan []*FlowLog, sinkQueueLen)}
+}
+
+func (w *sinkWriter) loop() {
+	for logs := range w.logsC {
+		if err := w.sink.Write(logs); err != nil {
+			log.WithError(err).Warn("Failed to write flow logs to sink")
+		}
+	}
+}
+
+type Config struct {
+	FlushInterval time.Duration
+}
+
+type flowKey struct {
+	tuple Tuple
+	rule  rules.FlowLogRule
+}
+
+type Collector struct {
+	config      Config
+	sinks       []*sinkWriter
+	packetInfoC chan PacketInfo
+
+	flows         map[flowKey]*FlowLog
+	maxFlows      int
+	loggedFull    bool
+	intervalStart time.Time
+
+	nowFunc func() time.Time
+}
+
+func New(config Config, sinks ...Sink) *Collector {
+	c := &Collector{
+		config:      config,
+		packetInfoC: make(chan PacketInfo, packetInfoChanSize),
+		flows:       map[flowKey]*FlowLog{},
+		maxFlows:    maxFlows,
+		nowFunc:     time.Now,
+	}
+	for _, s := range sinks {
+		c.RegisterSink(s)
+	}
+	return c
+}
+
+// RegisterSink adds a sink for the flow logs.  It must be called before Start.
+func (c *Collector) RegisterSink(s Sink) {
+	c.sinks = append(c.sinks, newSinkWriter(s))
+}
+
+// PacketInfoC returns the channel that the dataplane sends policy verdicts to.
+func (c *Collector) PacketInfoC() chan<- PacketInfo {
+	return c.packetInfoC
+}
+
+// Start starts the collector's goroutine, which aggregates and flushes the flow logs.
+func (c *Collector) Start() {
+	log.WithField("flushInterval", c.config.FlushInterval).Info("Starting flow log collector")
+	c.intervalStart = c.nowFunc()
+	for _, w := range c.sinks {
+		go w.loop()
+	}
+	go c.loop()
+}
+
+// sendPacketInfo passes a verdict to the collector without blocking; if the collector has fallen
+// behind then the verdict is dropped, so that the source keeps draining its socket.
+func (c *Collector) sendPacketInfo(pi PacketInfo) {
+	select {
+	case c.packetInfoC <- pi:
+	default:
+		counterDroppedPacketInfos.Inc()
+	}
+}
+
+func (c *Collector) loop() {
+	ticker := time.NewTicker(c.config.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case pi := <-c.packetInfoC:
+			c.record(pi)
+		case <-ticker.C:
+			c.flush()
+		}
+	}
+}
+
+func (c *Collector) record(pi PacketInfo) {
+	key := flowKey{tuple: pi.Tuple, rule: pi.Rule}
+	fl := c.flows[key]
+	if fl == nil {
+		if len(c.flows) >= c.maxFlows {
+			if !c.loggedFull {
+				log.WithField("max", c.maxFlows).Warn("Too many flows; dropping new flows until the next flush")
+				c.loggedFull = true
+			}
+			counterDroppedPacketInfos.Inc()
+			return
+		}
+		fl = newFlowLog(pi.Tuple, pi.Rule, c.intervalStart)
+		c.flows[key] = fl
+	}
+	fl.Packets++
+	fl.Bytes += pi.Bytes
+}
+
+// flush writes the flow logs for the interval that has just ended to the sinks and starts a new
+// interval.
+func (c *Collector) flush() {
+	now := c.nowFunc()
+	logs := make([]*FlowLog, 0, len(c.flows))
+	for _, fl := range c.flows {
+		fl.EndTime = now
+		logs = append(logs, fl)
+	}
+	// Sort for a stable output order.
+	sort.Slice(logs, func(i, j int) bool {
+		a, b := logs[i], logs[j]
+		if a.SrcIP != b.SrcIP {
+			return a.SrcIP < b.SrcIP
+		}
+		if a.DstIP != b.DstIP {
+			return a.DstIP < b.DstIP
+		}
+		if a.DstPort != b.DstPort {
+			return a.DstPort < b.DstPort
+		}
+		return a.SrcPort < b.SrcPort
+	})
+	c.flows = map[flowKey]*FlowLog{}
+	c.loggedFull = false
+	c.intervalStart = now
+
+	if len(logs) == 0 {
+		return
+	}
+	log.WithField("numFlows", len(logs)).Debug("Flushing flow logs")
+	for _, w := range c.sinks {
+		select {
+		case w.logsC <- logs:
+		default:
+			log.WithField("numFlows", len(logs)).Warn("Flow log sink is too slow; dropping flow logs")
+			counterDroppedFlowLogs.Add(float64(len(logs)))
+		}
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"encoding/binary"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/bpf/events"
+	"github.com/projectcalico/calico/felix/bpf/state"
+	"github.com/projectcalico/calico/felix/nfnetlink"
+	"github.com/projectcalico/calico/felix/rules"
+)
+
+type mockSink struct {
+	lock   sync.Mutex
+	writes [][]*FlowLog
+	err    error
+	blockC chan struct{}
+}
+
+func (s *mockSink) Write(logs []*FlowLog) error {
+	if s.blockC != nil {
+		<-s.blockC
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.writes = append(s.writes, logs)
+	return s.err
+}
+
+func (s *mockSink) Writes() [][]*FlowLog {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.writes
+}
+
+var (
+	allowWeb = rules.FlowLogRule{
+		Action:    rules.FlowLogActionAllow,
+		Direction: rules.FlowLogDirectionIngress,
+		Index:     0,
+		Tier:      "default",
+		Name:      "default.allow-web",
+	}
+	tierDrop = rules.FlowLogRule{
+		Action:    rules.FlowLogActionDeny,
+		Direction: rules.FlowLogDirectionIngress,
+		Index:     -1,
+		Tier:      "default",
+	}
+	webTuple = MakeTuple(net.ParseIP("10.0.0.1"), net.ParseIP("10.0.0.2"), 6, 34567, 80)
+)
+
+var _ = Describe("Collector", func() {
+	var (
+		c    *Collector
+		sink *mockSink
+		now  time.Time
+	)
+
+	BeforeEach(func() {
+		sink = &mockSink{}
+		c = New(Config{FlushInterval: time.Minute}, sink)
+		now = time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+		c.nowFunc = func() time.Time { return now }
+		c.intervalStart = now
+	})
+
+	AfterEach(func() {
+		for _, w := range c.sinks {
+			close(w.logsC)
+		}
+	})
+
+	startSinks := func() {
+		for _, w := range c.sinks {
+			go w.loop()
+		}
+	}
+
+	It("should aggregate packets with the same tuple and rule", func() {
+		c.record(PacketInfo{Tuple: webTuple, Rule: allowWeb, Bytes: 60})
+		c.record(PacketInfo{Tuple: webTuple, Rule: allowWeb, Bytes: 40})
+		c.record(PacketInfo{Tuple: webTuple, Rule: tierDrop, Bytes: 60})
+		now = now.Add(time.Minute)
+		c.flush()
+		startSinks()
+
+		Eventually(sink.Writes).Should(HaveLen(1))
+		logs := sink.Writes()[0]
+		Expect(logs).To(HaveLen(2))
+
+		var allow, deny *FlowLog
+		for _, fl := range logs {
+			if fl.Action == "allow" {
+				allow = fl
+			} else {
+				deny = fl
+			}
+		}
+		Expect(allow).NotTo(BeNil())
+		Expect(allow.Packets).To(Equal(2))
+		Expect(allow.Bytes).To(Equal(100))
+		Expect(allow.SrcIP).To(Equal("10.0.0.1"))
+		Expect(allow.DstIP).To(Equal("10.0.0.2"))
+		Expect(allow.DstPort).To(Equal(uint16(80)))
+		Expect(allow.Proto).To(Equal("tcp"))
+		Expect(allow.Direction).To(Equal("ingress"))
+		Expect(allow.Policy).To(Equal("default.allow-web"))
+		Expect(*allow.RuleIndex).To(Equal(0))
+		Expect(allow.EndTime.Sub(allow.StartTime)).To(Equal(time.Minute))
+
+		Expect(deny).NotTo(BeNil())
+		Expect(deny.Packets).To(Equal(1))
+		Expect(deny.Policy).To(Equal(""))
+		Expect(deny.RuleIndex).To(BeNil())
+	})
+
+	It("should start a new interval after each flush", func() {
+		c.record(PacketInfo{Tuple: webTuple, Rule: allowWeb, Bytes: 60})
+		now = now.Add(time.Minute)
+		c.flush()
+		c.record(PacketInfo{Tuple: webTuple, Rule: allowWeb, Bytes: 60})
+		now = now.Add(time.Minute)
+		c.flush()
+		startSinks()
+
+		Eventually(sink.Writes).Should(HaveLen(2))
+		writes := sink.Writes()
+		Expect(writes[1]).To(HaveLen(1))
+		Expect(writes[1][0].Packets).To(Equal(1))
+		Expect(writes[1][0].StartTime).To(Equal(writes[0][0].EndTime))
+	})
+
+	It("should not write empty intervals", func() {
+		c.flush()
+		Expect(c.sinks[0].logsC).To(BeEmpty())
+	})
+
+	It("should keep going if a sink fails", func() {
+		sink.err = errors.New("disk full")
+		other := &mockSink{}
+		c.RegisterSink(other)
+		startSinks()
+		c.record(PacketInfo{Tuple: webTuple, Rule: allowWeb, Bytes: 60})
+		c.flush()
+		Eventually(sink.Writes).Should(HaveLen(1))
+		Eventually(other.Writes).Should(HaveLen(1))
+	})
+
+	It("should drop new flows once it has too many", func() {
+		c.maxFlows = 1
+		c.record(PacketInfo{Tuple: webTuple, Rule: allowWeb, Bytes: 60})
+		c.record(PacketInfo{Tuple: webTuple, Rule: tierDrop, Bytes: 60})
+		c.record(PacketInfo{Tuple: webTuple, Rule: allowWeb, Bytes: 40})
+		c.flush()
+		startSinks()
+		Eventually(sink.Writes).Should(HaveLen(1))
+		Expect(sink.Writes()[0]).To(HaveLen(1))
+		Expect(sink.Writes()[0][0].Bytes).To(Equal(100))
+	})
+
+	It("should drop batches for a sink that falls behind without blocking the others", func() {
+		sink.blockC = make(chan struct{})
+		other := &mockSink{}
+		c.RegisterSink(other)
+		startSinks()
+		for i := 0; i < sinkQueueLen+2; i++ {
+			c.record(PacketInfo{Tuple: webTuple, Rule: allowWeb, Bytes: 60})
+			c.flush()
+			// Let the other sink keep up.
+			Eventually(other.Writes).Should(HaveLen(i + 1))
+		}
+		close(sink.blockC)
+		// One batch was being written when the sink blocked and sinkQueueLen were queued.
+		Eventually(sink.Writes).Should(HaveLen(sinkQueueLen + 1))
+		Consistently(sink.Writes).Should(HaveLen(sinkQueueLen + 1))
+	})
+
+	It("should drop verdicts rather than block when the collector is behind", func() {
+		for i := 0; i < packetInfoChanSize+10; i++ {
+			c.sendPacketInfo(PacketInfo{Tuple: webTuple, Rule: allowWeb, Bytes: 60})
+		}
+		Expect(c.packetInfoC).To(HaveLen(packetInfoChanSize))
+	})
+})
+
+var _ = Describe("NFLOG packet parsing", func() {
+	serialize := func(ls ...gopacket.SerializableLayer) []byte {
+		buf := gopacket.NewSerializeBuffer()
+		err := gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true}, ls...)
+		Expect(err).NotTo(HaveOccurred())
+		return buf.Bytes()
+	}
+
+	It("should parse an IPv4 TCP packet", func() {
+		payload := serialize(
+			&layers.IPv4{Version: 4, IHL: 5, TTL: 64, Protocol: layers.IPProtocolTCP,
+				SrcIP: net.ParseIP("10.0.0.1").To4(), DstIP: net.ParseIP("10.0.0.2").To4()},
+			&layers.TCP{SrcPort: 34567, DstPort: 80, SYN: true},
+		)
+		pi, err := packetInfoFromNflog(&nfnetlink.NflogPacket{Prefix: allowWeb.NflogPrefix(), Payload: payload}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pi.Rule).To(Equal(allowWeb))
+		Expect(pi.Tuple).To(Equal(webTuple))
+		Expect(pi.Bytes).To(Equal(len(payload)))
+	})
+
+	It("should parse an IPv6 UDP packet", func() {
+		payload := serialize(
+			&layers.IPv6{Version: 6, HopLimit: 64, NextHeader: layers.IPProtocolUDP,
+				SrcIP: net.ParseIP("fd00::1"), DstIP: net.ParseIP("fd00::2")},
+			&layers.UDP{SrcPort: 5000, DstPort: 53},
+		)
+		pi, err := packetInfoFromNflog(&nfnetlink.NflogPacket{Prefix: tierDrop.NflogPrefix(), Payload: payload}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pi.Rule).To(Equal(tierDrop))
+		Expect(pi.Tuple).To(Equal(MakeTuple(net.ParseIP("fd00::1"), net.ParseIP("fd00::2"), 17, 5000, 53)))
+		Expect(pi.Bytes).To(Equal(len(payload)))
+	})
+
+	It("should reject a packet with an unknown prefix", func() {
+		_, err := packetInfoFromNflog(&nfnetlink.NflogPacket{Prefix: "DRop", Payload: []byte{0x45}}, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject a non-IP packet", func() {
+		_, err := packetInfoFromNflog(&nfnetlink.NflogPacket{Prefix: allowWeb.NflogPrefix(), Payload: []byte{0x10}}, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should look up a rule whose prefix holds its ID until its policy is removed", func() {
+		longRule := rules.FlowLogRule{
+			Action:    rules.FlowLogActionAllow,
+			Direction: rules.FlowLogDirectionIngress,
+			Index:     0,
+			Tier:      "default",
+			Name:      "default." + strings.Repeat("x", 100),
+		}
+		ruleIDs := NewRuleIDs()
+		ruleIDs.Register(longRule)
+		payload := serialize(
+			&layers.IPv4{Version: 4, IHL: 5, TTL: 64, Protocol: layers.IPProtocolTCP,
+				SrcIP: net.ParseIP("10.0.0.1").To4(), DstIP: net.ParseIP("10.0.0.2").To4()},
+			&layers.TCP{SrcPort: 34567, DstPort: 80, SYN: true},
+		)
+		pkt := &nfnetlink.NflogPacket{Prefix: longRule.NflogPrefix(), Payload: payload}
+		pi, err := packetInfoFromNflog(pkt, ruleIDs)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pi.Rule).To(Equal(longRule))
+
+		ruleIDs.RemovePolicy("default", "default.other")
+		_, err = packetInfoFromNflog(pkt, ruleIDs)
+		Expect(err).NotTo(HaveOccurred())
+
+		ruleIDs.RemovePolicy("default", longRule.Name)
+		_, err = packetInfoFromNflog(pkt, ruleIDs)
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("BPF event parsing", func() {
+	verdict := func(ruleID uint64, rc state.PolicyResult, dirn events.Direction) []byte {
+		b := make([]byte, 40)
+		binary.LittleEndian.PutUint32(b[0:4], uint32(events.TypePolicyVerdict))
+		binary.LittleEndian.PutUint32(b[4:8], 40)
+		copy(b[8:12], net.ParseIP("10.0.0.1").To4())
+		copy(b[12:16], net.ParseIP("10.0.0.2").To4())
+		binary.LittleEndian.PutUint16(b[16:18], 34567)
+		binary.LittleEndian.PutUint16(b[18:20], 80)
+		b[20] = 6
+		b[21] = uint8(dirn)
+		binary.BigEndian.PutUint16(b[22:24], 60)
+		binary.LittleEndian.PutUint32(b[24:28], uint32(rc))
+		binary.LittleEndian.PutUint64(b[32:40], ruleID)
+		return b
+	}
+
+	var ruleIDs *RuleIDs
+
+	BeforeEach(func() {
+		ruleIDs = NewRuleIDs()
+	})
+
+	It("should look up the rule by ID", func() {
+		id := ruleIDs.Register(allowWeb)
+		pi, err := packetInfoFromBPFEvent(verdict(id, state.PolicyAllow, events.DirectionIngress), ruleIDs)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pi.Rule).To(Equal(allowWeb))
+		Expect(pi.Tuple).To(Equal(webTuple))
+		Expect(pi.Bytes).To(Equal(60))
+	})
+
+	It("should attribute a verdict with no rule to the default action", func() {
+		pi, err := packetInfoFromBPFEvent(verdict(0, state.PolicyDeny, events.DirectionEgress), ruleIDs)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pi.Rule).To(Equal(rules.FlowLogRule{
+			Action:    rules.FlowLogActionDeny,
+			Direction: rules.FlowLogDirectionEgress,
+			Index:     -1,
+		}))
+	})
+})
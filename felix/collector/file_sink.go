@@ -0,0 +1,57 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"encoding/json"
+	"io"
+	"path/filepath"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// FlowLogFilename is the name of the active flow log file within the flow log directory.
+const FlowLogFilename = "flows.log"
+
+// FileSink writes flow logs to a local file as JSON, one flow log per line, rotating the file
+// when it reaches its maximum size.
+type FileSink struct {
+	out io.WriteCloser
+}
+
+func NewFileSink(directory string, maxFiles, maxFileSizeMB int) *FileSink {
+	return &FileSink{
+		out: &lumberjack.Logger{
+			Filename:   filepath.Join(directory, FlowLogFilename),
+			MaxSize:    maxFileSizeMB,
+			MaxBackups: maxFiles,
+		},
+	}
+}
+
+func (f *FileSink) Write(logs []*FlowLog) error {
+	// The encoder writes each line with a single call, so rotation never splits a line.
+	enc := json.NewEncoder(f.out)
+	for _, fl := range logs {
+		if err := enc.Encode(fl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *FileSink) Close() error {
+	return f.out.Close()
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/projectcalico/calico/felix/rules"
+)
+
+// Tuple identifies a connection.  It is comparable so that it can be used as a map key.
+type Tuple struct {
+	Src   [16]byte
+	Dst   [16]byte
+	Proto uint8
+	L4Src uint16
+	L4Dst uint16
+}
+
+func MakeTuple(src, dst net.IP, proto uint8, l4Src, l4Dst uint16) Tuple {
+	t := Tuple{Proto: proto, L4Src: l4Src, L4Dst: l4Dst}
+	copy(t.Src[:], src.To16())
+	copy(t.Dst[:], dst.To16())
+	return t
+}
+
+func (t Tuple) SrcIP() net.IP {
+	return net.IP(t.Src[:])
+}
+
+func (t Tuple) DstIP() net.IP {
+	return net.IP(t.Dst[:])
+}
+
+// PacketInfo is a packet that policy was applied to, and the verdict.  Policy is only applied
+// to the first packet of each connection so, normally, there is one PacketInfo per connection.
+type PacketInfo struct {
+	Tuple Tuple
+	Rule  rules.FlowLogRule
+	// Bytes is the length of the packet, including its IP header.
+	Bytes int
+}
+
+// FlowLog is the aggregated record of the connections with the same tuple and verdict within a
+// flush interval.
+type FlowLog struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+
+	SrcIP   string `json:"source_ip"`
+	SrcPort uint16 `json:"source_port,omitempty"`
+	DstIP   string `json:"dest_ip"`
+	DstPort uint16 `json:"dest_port,omitempty"`
+	Proto   string `json:"proto"`
+
+	Action    string `json:"action"`
+	Direction string `json:"direction"`
+	// Tier and Policy identify the policy (or profile) whose rule decided the verdict.  Policy is
+	// empty if the packet was dropped because no policy in the tier matched it; both are empty
+	// if the dataplane could not attribute the verdict to a tier.
+	Tier   string `json:"tier,omitempty"`
+	Policy string `json:"policy,omitempty"`
+	// RuleIndex is the index of the rule within its policy; nil if no rule matched.
+	RuleIndex *int `json:"rule_index,omitempty"`
+
+	// Packets and Bytes count the packets that policy was applied to.
+	Packets int `json:"packets"`
+	Bytes   int `json:"bytes"`
+}
+
+func newFlowLog(t Tuple, r rules.FlowLogRule, start time.Time) *FlowLog {
+	fl := &FlowLog{
+		StartTime: start,
+		SrcIP:     t.SrcIP().String(),
+		SrcPort:   t.L4Src,
+		DstIP:     t.DstIP().String(),
+		DstPort:   t.L4Dst,
+		Proto:     protoName(t.Proto),
+		Action:    r.Action.String(),
+		Direction: r.Direction.String(),
+		Tier:      r.Tier,
+		Policy:    r.Name,
+	}
+	if r.Index >= 0 {
+		idx := r.Index
+		fl.RuleIndex = &idx
+	}
+	return fl
+}
+
+func protoName(proto uint8) string {
+	switch proto {
+	case 1:
+		return "icmp"
+	case 6:
+		return "tcp"
+	case 17:
+		return "udp"
+	case 58:
+		return "icmp6"
+	case 132:
+		return "sctp"
+	}
+	return strconv.Itoa(int(proto))
+}
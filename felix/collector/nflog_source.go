@@ -0,0 +1,110 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/felix/nfnetlink"
+	"github.com/projectcalico/calico/felix/rules"
+)
+
+const (
+	// nflogCopyRange is enough of each packet for the IP and L4 headers.
+	nflogCopyRange = 128
+	nflogBufSize   = 2 * 1024 * 1024
+	nflogChanSize  = 1000
+)
+
+// StartNflogSource subscribes to the NFLOG groups that the iptables dataplane logs policy
+// verdicts to and passes the verdicts to the collector.  ruleIDs holds the rules whose NFLOG
+// prefixes hold their IDs.
+func (c *Collector) StartNflogSource(ruleIDs *RuleIDs) error {
+	for _, group := range []int{rules.NFLOGInboundGroup, rules.NFLOGOutboundGroup} {
+		nflogC := make(chan *nfnetlink.NflogPacket, nflogChanSize)
+		if err := nfnetlink.NflogSubscribe(group, nflogCopyRange, nflogBufSize, nflogC, nil); err != nil {
+			return fmt.Errorf("failed to subscribe to flow log NFLOG group %d: %w", group, err)
+		}
+		go func() {
+			for pkt := range nflogC {
+				pi, err := packetInfoFromNflog(pkt, ruleIDs)
+				if err != nil {
+					log.WithError(err).Debug("Ignoring NFLOG packet")
+					continue
+				}
+				c.sendPacketInfo(pi)
+			}
+		}()
+	}
+	return nil
+}
+
+func packetInfoFromNflog(pkt *nfnetlink.NflogPacket, ruleIDs *RuleIDs) (PacketInfo, error) {
+	var rule rules.FlowLogRule
+	if id, ok := rules.ParseFlowLogRuleID(pkt.Prefix); ok {
+		if ruleIDs == nil {
+			return PacketInfo{}, fmt.Errorf("no rule IDs to look up NFLOG prefix %q", pkt.Prefix)
+		}
+		if rule, ok = ruleIDs.Lookup(id); !ok {
+			return PacketInfo{}, fmt.Errorf("unknown rule ID in NFLOG prefix %q", pkt.Prefix)
+		}
+	} else {
+		var err error
+		rule, err = rules.ParseFlowLogRule(pkt.Prefix)
+		if err != nil {
+			return PacketInfo{}, err
+		}
+	}
+	if len(pkt.Payload) == 0 {
+		return PacketInfo{}, errors.New("empty payload")
+	}
+
+	var packet gopacket.Packet
+	switch pkt.Payload[0] >> 4 {
+	case 4:
+		packet = gopacket.NewPacket(pkt.Payload, layers.LayerTypeIPv4, gopacket.Lazy)
+	case 6:
+		packet = gopacket.NewPacket(pkt.Payload, layers.LayerTypeIPv6, gopacket.Lazy)
+	default:
+		return PacketInfo{}, errors.New("not an IP packet")
+	}
+
+	pi := PacketInfo{Rule: rule}
+	switch ip := packet.NetworkLayer().(type) {
+	case *layers.IPv4:
+		pi.Tuple = MakeTuple(ip.SrcIP, ip.DstIP, uint8(ip.Protocol), 0, 0)
+		pi.Bytes = int(ip.Length)
+	case *layers.IPv6:
+		pi.Tuple = MakeTuple(ip.SrcIP, ip.DstIP, uint8(ip.NextHeader), 0, 0)
+		pi.Bytes = int(ip.Length) + 40
+	default:
+		return PacketInfo{}, errors.New("failed to decode IP header")
+	}
+
+	switch l4 := packet.TransportLayer().(type) {
+	case *layers.TCP:
+		pi.Tuple.L4Src, pi.Tuple.L4Dst = uint16(l4.SrcPort), uint16(l4.DstPort)
+	case *layers.UDP:
+		pi.Tuple.L4Src, pi.Tuple.L4Dst = uint16(l4.SrcPort), uint16(l4.DstPort)
+	case *layers.SCTP:
+		pi.Tuple.L4Src, pi.Tuple.L4Dst = uint16(l4.SrcPort), uint16(l4.DstPort)
+	}
+	return pi, nil
+}
@@ -162,12 +162,25 @@ type Config struct {
 
 	// Wireguard configuration
 	WireguardEnabled               bool          `config:"bool;false"`
+	WireguardEnabledV6             bool          `config:"bool;false"`
 	WireguardListeningPort         int           `config:"int;51820"`
+	WireguardListeningPortV6       int           `config:"int;51821"`
 	WireguardRoutingRulePriority   int           `config:"int;99"`
 	WireguardInterfaceName         string        `config:"iface-param;wireguard.cali;non-zero"`
+	WireguardInterfaceNameV6       string        `config:"iface-param;wg-v6.cali;non-zero"`
 	WireguardMTU                   int           `config:"int;0"`
+	WireguardMTUV6                 int           `config:"int;0"`
 	WireguardHostEncryptionEnabled bool          `config:"bool;false"`
 	WireguardPersistentKeepAlive   time.Duration `config:"seconds;0"`
+	WireguardKeyRotationInterval   time.Duration `config:"seconds;0"`
+
+	// Egress gateway configuration
+	EgressGatewayEnabled             bool          `config:"bool;false"`
+	EgressGatewayRoutingRulePriority int           `config:"int;102"`
+	EgressGatewayRoutingTables       int           `config:"int(1,250);32"`
+	EgressGatewayHealthPort          int           `config:"int(0,65535);8080"`
+	EgressGatewayPollInterval        time.Duration `config:"seconds;10"`
+	EgressGatewayPollFailureCount    int           `config:"int;3"`
 
 	BPFEnabled                         bool             `config:"bool;false"`
 	BPFDisableUnprivileged             bool             `config:"bool;true"`
@@ -226,7 +239,7 @@ type Config struct {
 	Ipv6Support    bool `config:"bool;true"`
 	BpfIpv6Support bool `config:"bool;false"`
 
-	IptablesBackend                    string            `config:"oneof(legacy,nft,auto);auto"`
+	IptablesBackend                    string            `config:"oneof(legacy,nft,nftnative,auto);auto"`
 	RouteRefreshInterval               time.Duration     `config:"seconds;90"`
 	InterfaceRefreshInterval           time.Duration     `config:"seconds;90"`
 	DeviceRouteSourceAddress           net.IP            `config:"ipv4;"`
@@ -271,12 +284,16 @@ type Config struct {
 	LogDebugFilenameRegex *regexp.Regexp `config:"regexp(nil-on-empty);"`
 
 	// Optional: VXLAN encap is now determined by the existing IP pools (Encapsulation struct)
-	VXLANEnabled        *bool  `config:"*bool;"`
-	VXLANPort           int    `config:"int;4789"`
-	VXLANVNI            int    `config:"int;4096"`
-	VXLANMTU            int    `config:"int;0"`
-	IPv4VXLANTunnelAddr net.IP `config:"ipv4;"`
-	VXLANTunnelMACAddr  string `config:"string;"`
+	VXLANEnabled         *bool  `config:"*bool;"`
+	VXLANPort            int    `config:"int;4789"`
+	VXLANVNI             int    `config:"int;4096"`
+	VXLANMTU             int    `config:"int;0"`
+	IPv4VXLANTunnelAddr  net.IP `config:"ipv4;"`
+	VXLANTunnelMACAddr   string `config:"string;"`
+	VXLANEnabledV6       *bool  `config:"*bool;"`
+	VXLANMTUV6           int    `config:"int;0"`
+	IPv6VXLANTunnelAddr  net.IP `config:"ipv6;"`
+	VXLANTunnelMACAddrV6 string `config:"string;"`
 
 	// Optional: IPIP encap is now determined by the existing IP pools (Encapsulation struct)
 	IpInIpEnabled    *bool  `config:"*bool;"`
@@ -328,6 +345,21 @@ type Config struct {
 
 	ExternalNodesCIDRList []string `config:"cidr-list;;die-on-fail"`
 
+	DNSSnoopingEnabled bool          `config:"bool;true"`
+	DNSTrustedServers  []string      `config:"server-list;k8s-service:kube-dns"`
+	DNSExtraTTL        time.Duration `config:"seconds;0"`
+
+	FlowLogsEnabled           bool          `config:"bool;false"`
+	FlowLogsFlushInterval     time.Duration `config:"seconds;300"`
+	FlowLogsFileEnabled       bool          `config:"bool;true"`
+	FlowLogsFileDirectory     string        `config:"file;/var/log/calico/flowlogs"`
+	FlowLogsFileMaxFiles      int           `config:"int;5"`
+	FlowLogsFileMaxFileSizeMB int           `config:"int;100"`
+
+	CaptureDir          string `config:"file;/var/log/calico/pcap"`
+	CaptureMaxSizeBytes int    `config:"int;10000000"`
+	CaptureMaxFiles     int    `config:"int;2"`
+
 	DebugMemoryProfilePath          string        `config:"file;;"`
 	DebugCPUProfilePath             string        `config:"file;/tmp/felix-cpu-<timestamp>.pprof;"`
 	DebugDisableLogDropping         bool          `config:"bool;false"`
@@ -356,7 +388,8 @@ type Config struct {
 	// Configures MTU auto-detection.
 	MTUIfacePattern *regexp.Regexp `config:"regexp;^((en|wl|ww|sl|ib)[opsx].*|(eth|wlan|wwan).*)"`
 
-	// Encapsulation information calculated from IP Pools and FelixConfiguration (VXLANEnabled and IpInIpEnabled)
+	// Encapsulation information calculated from IP Pools and FelixConfiguration (VXLANEnabled, VXLANEnabledV6 and
+	// IpInIpEnabled)
 	Encapsulation Encapsulation
 
 	// State tracking.
@@ -641,7 +674,8 @@ func (config *Config) DatastoreConfig() apiconfig.CalicoAPIConfig {
 		cfg.Spec.EtcdCACertFile = config.EtcdCaFile
 	}
 
-	if !(config.Encapsulation.IPIPEnabled || config.Encapsulation.VXLANEnabled || config.BPFEnabled) {
+	if !(config.Encapsulation.IPIPEnabled || config.Encapsulation.VXLANEnabled || config.Encapsulation.VXLANEnabledV6 ||
+		config.BPFEnabled) {
 		// Polling k8s for node updates is expensive (because we get many superfluous
 		// updates) so disable if we don't need it.
 		log.Info("Encap disabled, disabling node poll (if KDD is in use).")
@@ -772,6 +806,8 @@ func loadParams() {
 				Msg: "invalid URL authority"}
 		case "ipv4":
 			param = &Ipv4Param{}
+		case "ipv6":
+			param = &Ipv6Param{}
 		case "endpoint-list":
 			param = &EndpointListParam{}
 		case "port-list":
@@ -800,7 +836,11 @@ func loadParams() {
 			param = &RegexpParam{Regexp: StringRegexp,
 				Msg: "invalid string"}
 		case "cidr-list":
-			param = &CIDRListParam{}
+			param = &CIDRListParam{
+				AllowIPv6: strings.Contains(kindParams, "allow-ipv6"),
+			}
+		case "server-list":
+			param = &ServerListParam{}
 		case "route-table-range":
 			param = &RouteTableRangeParam{}
 		case "route-table-ranges":
@@ -871,6 +911,7 @@ func (config *Config) TyphaDiscoveryOpts() []discovery.Option {
 	return []discovery.Option{
 		discovery.WithAddrOverride(config.TyphaAddr),
 		discovery.WithKubeService(config.TyphaK8sNamespace, config.TyphaK8sServiceName),
+		discovery.WithNodeName(config.FelixHostname),
 	}
 }
 
@@ -926,6 +967,7 @@ type param interface {
 }
 
 type Encapsulation struct {
-	IPIPEnabled  bool
-	VXLANEnabled bool
+	IPIPEnabled    bool
+	VXLANEnabled   bool
+	VXLANEnabledV6 bool
 }
@@ -54,6 +54,8 @@ var _ = Describe("FelixConfig vs ConfigParams parity", func() {
 		"IpInIpTunnelAddr",
 		"IPv4VXLANTunnelAddr",
 		"VXLANTunnelMACAddr",
+		"IPv6VXLANTunnelAddr",
+		"VXLANTunnelMACAddrV6",
 		"loadClientConfigFromEnvironment",
 
 		"loadClientConfigFromEnvironment",
@@ -318,6 +320,11 @@ var _ = DescribeTable("Config parsing",
 	Entry("IpInIpTunnelAddr", "IpInIpTunnelAddr",
 		"10.0.0.1", net.ParseIP("10.0.0.1")),
 
+	Entry("VXLANEnabledV6", "VXLANEnabledV6", "true", &t),
+	Entry("VXLANMTUV6", "VXLANMTUV6", "1430", int(1430)),
+	Entry("IPv6VXLANTunnelAddr", "IPv6VXLANTunnelAddr",
+		"fd00::1", net.ParseIP("fd00::1")),
+
 	Entry("ReportingIntervalSecs", "ReportingIntervalSecs", "31", 31*time.Second),
 	Entry("ReportingTTLSecs", "ReportingTTLSecs", "91", 91*time.Second),
 
@@ -463,6 +470,11 @@ var _ = DescribeTable("Config parsing",
 			{Protocol: "tcp", Port: 6667},
 		},
 	),
+	Entry("DNSTrustedServers default", "DNSTrustedServers", "",
+		[]string{"k8s-service:kube-system/kube-dns"}),
+	Entry("DNSTrustedServers none", "DNSTrustedServers", "none", []string(nil)),
+	Entry("DNSTrustedServers list", "DNSTrustedServers", "10.96.0.10,k8s-service:dns/coredns",
+		[]string{"10.96.0.10/32", "k8s-service:dns/coredns"}),
 	Entry("KubeNodePortRanges empty", "KubeNodePortRanges", "",
 		[]numorstring.Port{
 			{MinPort: 30000, MaxPort: 32767, PortName: ""},
@@ -323,6 +323,19 @@ func (p *Ipv4Param) Parse(raw string) (result interface{}, err error) {
 	return
 }
 
+type Ipv6Param struct {
+	Metadata
+}
+
+func (p *Ipv6Param) Parse(raw string) (result interface{}, err error) {
+	res := net.ParseIP(raw)
+	if res == nil || res.To4() != nil {
+		err = p.parseFailed(raw, "invalid IPv6 address")
+	}
+	result = res
+	return
+}
+
 type PortListParam struct {
 	Metadata
 }
@@ -520,6 +533,7 @@ func (p *OneofListParam) Parse(raw string) (result interface{}, err error) {
 
 type CIDRListParam struct {
 	Metadata
+	AllowIPv6 bool
 }
 
 func (c *CIDRListParam) Parse(raw string) (result interface{}, err error) {
@@ -536,7 +550,7 @@ func (c *CIDRListParam) Parse(raw string) (result interface{}, err error) {
 			err = c.parseFailed(in, "invalid CIDR or IP "+val)
 			return
 		}
-		if ip.Version() != 4 {
+		if ip.Version() != 4 && !c.AllowIPv6 {
 			err = c.parseFailed(in, "invalid CIDR or IP (not v4)")
 			return
 		}
@@ -545,6 +559,45 @@ func (c *CIDRListParam) Parse(raw string) (result interface{}, err error) {
 	return resultSlice, nil
 }
 
+// ServerListParam parses a list of servers, each of which is either an IP or CIDR, or a
+// Kubernetes service as "k8s-service:[<namespace>/]<name>".  The namespace of a service
+// defaults to kube-system.
+type ServerListParam struct {
+	Metadata
+}
+
+const k8sServicePrefix = "k8s-service:"
+
+func (p *ServerListParam) Parse(raw string) (result interface{}, err error) {
+	resultSlice := []string{}
+	for _, in := range strings.Split(raw, ",") {
+		val := strings.Trim(in, " ")
+		if len(val) == 0 {
+			continue
+		}
+		if strings.HasPrefix(val, k8sServicePrefix) {
+			svc := strings.TrimPrefix(val, k8sServicePrefix)
+			if !strings.Contains(svc, "/") {
+				svc = "kube-system/" + svc
+			}
+			parts := strings.Split(svc, "/")
+			if len(parts) != 2 || !HostnameRegexp.MatchString(parts[0]) || !HostnameRegexp.MatchString(parts[1]) {
+				err = p.parseFailed(raw, "invalid Kubernetes service "+val)
+				return
+			}
+			resultSlice = append(resultSlice, k8sServicePrefix+svc)
+			continue
+		}
+		_, ipNet, e := cnet.ParseCIDROrIP(val)
+		if e != nil {
+			err = p.parseFailed(raw, "invalid CIDR, IP or Kubernetes service "+val)
+			return
+		}
+		resultSlice = append(resultSlice, ipNet.String())
+	}
+	return resultSlice, nil
+}
+
 type RegionParam struct {
 	Metadata
 }
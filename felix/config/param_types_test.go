@@ -41,7 +41,7 @@ var _ = DescribeTable("Endpoint list parameter parsing",
 
 var _ = DescribeTable("CIDR list parameter parsing",
 	func(raw string, expected interface{}, expectSuccess bool) {
-		p := config.CIDRListParam{config.Metadata{
+		p := config.CIDRListParam{Metadata: config.Metadata{
 			Name: "CIDRs",
 		}}
 		actual, err := p.Parse(raw)
@@ -60,6 +60,43 @@ var _ = DescribeTable("CIDR list parameter parsing",
 	Entry("Reject IPv6", "aabc::1111/32", []string{}, false),
 )
 
+var _ = DescribeTable("CIDR list parameter parsing with IPv6 allowed",
+	func(raw string, expected interface{}) {
+		p := config.CIDRListParam{
+			Metadata:  config.Metadata{Name: "CIDRs"},
+			AllowIPv6: true,
+		}
+		actual, err := p.Parse(raw)
+		Expect(err).To(BeNil())
+		Expect(actual).To(Equal(expected))
+	},
+	Entry("Single IPv6", "aabc::1111", []string{"aabc::1111/128"}),
+	Entry("Mix of IPv4 and IPv6", "1.1.1.1, aabc::/16", []string{"1.1.1.1/32", "aabc::/16"}),
+)
+
+var _ = DescribeTable("Server list parameter parsing",
+	func(raw string, expected interface{}, expectSuccess bool) {
+		p := config.ServerListParam{Metadata: config.Metadata{
+			Name: "Servers",
+		}}
+		actual, err := p.Parse(raw)
+		if expectSuccess {
+			Expect(err).To(BeNil())
+			Expect(actual).To(Equal(expected))
+		} else {
+			Expect(err).NotTo(BeNil())
+		}
+	},
+	Entry("Empty", "", []string{}, true),
+	Entry("Mix of IPv4, IPv6 and CIDRs", "1.1.1.1, aabc::/16, 10.0.0.1/8",
+		[]string{"1.1.1.1/32", "aabc::/16", "10.0.0.0/8"}, true),
+	Entry("Service", "k8s-service:kube-dns", []string{"k8s-service:kube-system/kube-dns"}, true),
+	Entry("Service with namespace", "k8s-service:dns/coredns, 1.1.1.1",
+		[]string{"k8s-service:dns/coredns", "1.1.1.1/32"}, true),
+	Entry("Reject bad service", "k8s-service:a/b/c", nil, false),
+	Entry("Reject hostname", "dns.example.com", nil, false),
+)
+
 var _ = DescribeTable("KeyValue list parameter parsing",
 	func(raw string, expected map[string]string) {
 		p := config.KeyValueListParam{config.Metadata{
@@ -22,6 +22,7 @@ import (
 	"os"
 	"os/exec"
 	"os/signal"
+	"reflect"
 	"runtime"
 	"runtime/debug"
 	"sync"
@@ -30,8 +31,11 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/clock"
 
 	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 
@@ -53,6 +57,7 @@ import (
 	"github.com/projectcalico/calico/pod2daemon/binder"
 	"github.com/projectcalico/calico/typha/pkg/discovery"
 	"github.com/projectcalico/calico/typha/pkg/syncclient"
+	"github.com/projectcalico/calico/typha/pkg/syncproto"
 
 	"github.com/projectcalico/calico/felix/buildinfo"
 	"github.com/projectcalico/calico/felix/calc"
@@ -171,7 +176,7 @@ func Run(configFile string, gitVersion string, buildDate string, gitRevision str
 	var v3Client client.Interface
 	var datastoreConfig apiconfig.CalicoAPIConfig
 	var configParams *config.Config
-	var typhaAddr string
+	var typhaAddrs []string
 	var numClientsCreated int
 	var k8sClientSet *kubernetes.Clientset
 	var kubernetesVersion string
@@ -279,6 +284,7 @@ configRetry:
 		encapCalculator := calc.NewEncapsulationCalculator(configParams, ippoolKVPList)
 		configParams.Encapsulation.IPIPEnabled = encapCalculator.IPIPEnabled()
 		configParams.Encapsulation.VXLANEnabled = encapCalculator.VXLANEnabled()
+		configParams.Encapsulation.VXLANEnabledV6 = encapCalculator.VXLANEnabledV6()
 
 		// We now have some config flags that affect how we configure the syncer.
 		// After loading the config from the datastore, reconnect, possibly with new
@@ -330,7 +336,7 @@ configRetry:
 		}
 
 		// If we're configured to discover Typha, do that now so we can retry if we fail.
-		typhaAddr, err = discoverTyphaAddr(configParams, k8sClientSet)
+		typhaAddrs, err = discoverTyphaAddrs(configParams, k8sClientSet)
 		if err != nil {
 			log.WithError(err).Error("Typha discovery enabled but discovery failed.")
 			time.Sleep(1 * time.Second)
@@ -469,27 +475,33 @@ configRetry:
 	// which will feed the calculation graph with updates, bringing Felix into sync.
 	var syncer Startable
 	var typhaConnection *syncclient.SyncerClient
+	var newTyphaConnection func(addrs []string, resumeFrom syncproto.ResumePoint) *syncclient.SyncerClient
 	syncerToValidator := calc.NewSyncerCallbacksDecoupler()
-	if typhaAddr != "" {
-		// Use a remote Syncer, via the Typha server.
-		log.WithField("addr", typhaAddr).Info("Connecting to Typha.")
-		typhaConnection = syncclient.New(
-			typhaAddr,
-			buildinfo.GitVersion,
-			configParams.FelixHostname,
-			fmt.Sprintf("Revision: %s; Build date: %s",
-				buildinfo.GitRevision, buildinfo.BuildDate),
-			syncerToValidator,
-			&syncclient.Options{
-				ReadTimeout:  configParams.TyphaReadTimeout,
-				WriteTimeout: configParams.TyphaWriteTimeout,
-				KeyFile:      configParams.TyphaKeyFile,
-				CertFile:     configParams.TyphaCertFile,
-				CAFile:       configParams.TyphaCAFile,
-				ServerCN:     configParams.TyphaCN,
-				ServerURISAN: configParams.TyphaURISAN,
-			},
-		)
+	if len(typhaAddrs) > 0 {
+		// Use a remote Syncer, via the Typha server.  If there are several Typhas, the client fails
+		// over between them if it can't connect.
+		newTyphaConnection = func(addrs []string, resumeFrom syncproto.ResumePoint) *syncclient.SyncerClient {
+			return syncclient.NewWithAddrs(
+				addrs,
+				buildinfo.GitVersion,
+				configParams.FelixHostname,
+				fmt.Sprintf("Revision: %s; Build date: %s",
+					buildinfo.GitRevision, buildinfo.BuildDate),
+				syncerToValidator,
+				&syncclient.Options{
+					ReadTimeout:  configParams.TyphaReadTimeout,
+					WriteTimeout: configParams.TyphaWriteTimeout,
+					KeyFile:      configParams.TyphaKeyFile,
+					CertFile:     configParams.TyphaCertFile,
+					CAFile:       configParams.TyphaCAFile,
+					ServerCN:     configParams.TyphaCN,
+					ServerURISAN: configParams.TyphaURISAN,
+					ResumeFrom:   resumeFrom,
+				},
+			)
+		}
+		log.WithField("addrs", typhaAddrs).Info("Connecting to Typha.")
+		typhaConnection = newTyphaConnection(typhaAddrs, syncproto.ResumePoint{})
 	} else {
 		// Use the syncer locally.
 		syncer = felixsyncer.New(backendClient, datastoreConfig.Spec, syncerToValidator, configParams.IsLeader())
@@ -536,7 +548,21 @@ configRetry:
 		configParams.SetUseNodeResourceUpdates(supportsNodeResourceUpdates)
 
 		go func() {
-			typhaConnection.Finished.Wait()
+			typhaDiscovered := func(addr string) bool {
+				addrs, err := discoverTyphaAddrs(configParams, k8sClientSet)
+				if err != nil {
+					// Keep trying to resume until we know that the Typha has gone.
+					log.WithError(err).Warn("Failed to rediscover Typha.")
+					return true
+				}
+				for _, a := range addrs {
+					if a == addr {
+						return true
+					}
+				}
+				return false
+			}
+			resumeTyphaConnections(typhaConnection, newTyphaConnection, typhaDiscovered)
 			failureReportChan <- "Connection to Typha failed"
 		}()
 	}
@@ -937,6 +963,8 @@ type DataplaneConnector struct {
 	firstStatusReportSent bool
 
 	wireguardStatUpdateFromDataplane chan *proto.WireguardStatusUpdate
+
+	packetCaptureStatUpdateFromDataplane chan *proto.PacketCaptureStatusUpdate
 }
 
 type Startable interface {
@@ -960,7 +988,9 @@ func newConnector(configParams *config.Config,
 		InSync:                           make(chan bool, 1),
 		failureReportChan:                failureReportChan,
 		dataplane:                        dataplane,
-		wireguardStatUpdateFromDataplane: make(chan *proto.WireguardStatusUpdate, 1),
+		wireguardStatUpdateFromDataplane: make(chan *proto.WireguardStatusUpdate, 2),
+
+		packetCaptureStatUpdateFromDataplane: make(chan *proto.PacketCaptureStatusUpdate, 100),
 	}
 	return felixConn
 }
@@ -999,6 +1029,8 @@ func (fc *DataplaneConnector) readMessagesFromDataplane() {
 			}
 		case *proto.WireguardStatusUpdate:
 			fc.wireguardStatUpdateFromDataplane <- msg
+		case *proto.PacketCaptureStatusUpdate:
+			fc.packetCaptureStatUpdateFromDataplane <- msg
 		default:
 			log.WithField("msg", msg).Warning("Unknown message from dataplane")
 		}
@@ -1043,7 +1075,13 @@ func (fc *DataplaneConnector) handleProcessStatusUpdate(ctx context.Context, msg
 	}
 }
 
-func (fc *DataplaneConnector) reconcileWireguardStatUpdate(dpPubKey string) error {
+// reconcileWireguardStatUpdate updates the public key, pending public key and applied pending public keys of the given
+// IP version in our node resource to match those reported by the dataplane.
+func (fc *DataplaneConnector) reconcileWireguardStatUpdate(
+	update *proto.WireguardStatusUpdate, ipVersion proto.IPVersion,
+) error {
+	dpPubKey := update.PublicKey
+
 	// In case of a recoverable failure (ErrorResourceUpdateConflict), retry update 3 times.
 	for iter := 0; iter < 3; iter++ {
 		// Read node resource from datastore and compare it with the publicKey from dataplane.
@@ -1068,11 +1106,25 @@ func (fc *DataplaneConnector) reconcileWireguardStatUpdate(dpPubKey string) erro
 			return err
 		}
 
-		// Check if the public-key needs to be updated.
-		storedPublicKey := node.Status.WireguardPublicKey
-		if storedPublicKey != dpPubKey {
+		// Check if the public-key, pending public-key or applied pending public-keys need to be updated.
+		storedPublicKey, storedPendingPublicKey := node.Status.WireguardPublicKey, node.Status.WireguardPendingPublicKey
+		storedAppliedKeys := node.Status.WireguardAppliedPendingPublicKeys
+		if ipVersion == proto.IPVersion_IPV6 {
+			storedPublicKey, storedPendingPublicKey = node.Status.WireguardPublicKeyV6, node.Status.WireguardPendingPublicKeyV6
+			storedAppliedKeys = node.Status.WireguardAppliedPendingPublicKeysV6
+		}
+		if storedPublicKey != dpPubKey || storedPendingPublicKey != update.PendingPublicKey ||
+			!stringSlicesEqual(storedAppliedKeys, update.AppliedPendingPublicKeys) {
 			updateCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
-			node.Status.WireguardPublicKey = dpPubKey
+			if ipVersion == proto.IPVersion_IPV6 {
+				node.Status.WireguardPublicKeyV6 = dpPubKey
+				node.Status.WireguardPendingPublicKeyV6 = update.PendingPublicKey
+				node.Status.WireguardAppliedPendingPublicKeysV6 = update.AppliedPendingPublicKeys
+			} else {
+				node.Status.WireguardPublicKey = dpPubKey
+				node.Status.WireguardPendingPublicKey = update.PendingPublicKey
+				node.Status.WireguardAppliedPendingPublicKeys = update.AppliedPendingPublicKeys
+			}
 			_, err := fc.datastorev3.Nodes().Update(updateCtx, node, options.SetOptions{})
 			cancel()
 			if err != nil {
@@ -1086,23 +1138,46 @@ func (fc *DataplaneConnector) reconcileWireguardStatUpdate(dpPubKey string) erro
 				log.WithError(err).Info("Failed updating node resource")
 				return err
 			}
-			log.Debugf("Updated Wireguard public-key from %s to %s", storedPublicKey, dpPubKey)
+			log.Debugf("Updated Wireguard %s public-key from %s to %s, pending public-key from %s to %s, "+
+				"applied pending public-keys from %v to %v", ipVersion, storedPublicKey, dpPubKey,
+				storedPendingPublicKey, update.PendingPublicKey, storedAppliedKeys, update.AppliedPendingPublicKeys)
 		}
 		break
 	}
 	return nil
 }
 
+// stringSlicesEqual returns true if the two slices contain the same strings in the same order.  Nil and empty slices
+// are treated as equal.
+func stringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func (fc *DataplaneConnector) handleWireguardStatUpdateFromDataplane() {
-	var current *proto.WireguardStatusUpdate
+	// The IPv4 and IPv6 wireguard devices report their keys independently, so track the latest pending update for
+	// each IP version.
+	pending := map[proto.IPVersion]*proto.WireguardStatusUpdate{}
 	var ticker *jitter.Ticker
 	var retryC <-chan time.Time
 
 	for {
 		// Block until we either get an update or it's time to retry a failed update.
 		select {
-		case current = <-fc.wireguardStatUpdateFromDataplane:
-			log.Debugf("Wireguard status update from dataplane driver: %s", current.PublicKey)
+		case update := <-fc.wireguardStatUpdateFromDataplane:
+			log.Debugf("Wireguard %s status update from dataplane driver: %s", update.IpVersion, update.PublicKey)
+			ipVersion := update.IpVersion
+			if ipVersion != proto.IPVersion_IPV6 {
+				ipVersion = proto.IPVersion_IPV4
+			}
+			pending[ipVersion] = update
 		case <-retryC:
 			log.Debug("retrying failed Wireguard status update")
 		}
@@ -1110,10 +1185,15 @@ func (fc *DataplaneConnector) handleWireguardStatUpdateFromDataplane() {
 			ticker.Stop()
 		}
 
-		// Try and reconcile the current wireguard status data.
-		err := fc.reconcileWireguardStatUpdate(current.PublicKey)
+		// Try and reconcile the pending wireguard status data.
+		var err error
+		for ipVersion, update := range pending {
+			if err = fc.reconcileWireguardStatUpdate(update, ipVersion); err != nil {
+				break
+			}
+			delete(pending, ipVersion)
+		}
 		if err == nil {
-			current = nil
 			retryC = nil
 			ticker = nil
 		} else {
@@ -1124,6 +1204,119 @@ func (fc *DataplaneConnector) handleWireguardStatUpdateFromDataplane() {
 	}
 }
 
+func (fc *DataplaneConnector) reconcilePacketCaptureStatUpdate(update *proto.PacketCaptureStatusUpdate) error {
+	nodeStatus := apiv3.PacketCaptureNodeStatus{
+		Node:        fc.config.FelixHostname,
+		Directory:   update.Directory,
+		LastUpdated: metav1.Now(),
+	}
+	for _, ep := range update.Endpoints {
+		epStatus := apiv3.PacketCaptureEndpointStatus{
+			Endpoint:      ep.Name,
+			InterfaceName: ep.InterfaceName,
+			State:         apiv3.PacketCaptureState(ep.State),
+			Error:         ep.Error,
+		}
+		for _, f := range ep.Files {
+			epStatus.Files = append(epStatus.Files, apiv3.PacketCaptureFile{Name: f.Name, Size: f.SizeBytes})
+		}
+		nodeStatus.Endpoints = append(nodeStatus.Endpoints, epStatus)
+	}
+
+	// In case of a recoverable failure (ErrorResourceUpdateConflict), retry update 3 times.
+	for iter := 0; iter < 3; iter++ {
+		getCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		capture, err := fc.datastorev3.PacketCaptures().Get(getCtx, update.Id.Namespace, update.Id.Name, options.GetOptions{})
+		cancel()
+		if err != nil {
+			if _, ok := err.(cerrors.ErrorResourceDoesNotExist); ok {
+				// The capture has been deleted, so there's no status to update.
+				log.WithField("capture", update.Id).Debug("Packet capture no longer exists")
+				return nil
+			}
+			// return error here so we can retry in some time.
+			log.WithError(err).Info("Failed to read packet capture resource")
+			return err
+		}
+
+		// Replace our node's entry in the status, leaving the other nodes' alone.
+		replaced := false
+		for i := range capture.Status.Nodes {
+			if capture.Status.Nodes[i].Node == nodeStatus.Node {
+				if packetCaptureNodeStatusEqual(capture.Status.Nodes[i], nodeStatus) {
+					log.WithField("capture", update.Id).Debug("Packet capture status is up to date")
+					return nil
+				}
+				capture.Status.Nodes[i] = nodeStatus
+				replaced = true
+			}
+		}
+		if !replaced {
+			capture.Status.Nodes = append(capture.Status.Nodes, nodeStatus)
+		}
+
+		updateCtx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		_, err = fc.datastorev3.PacketCaptures().UpdateStatus(updateCtx, capture, options.SetOptions{})
+		cancel()
+		if err != nil {
+			switch err.(type) {
+			case cerrors.ErrorResourceUpdateConflict:
+				log.Debug("Update conflict, retrying update")
+				continue
+			case cerrors.ErrorResourceDoesNotExist:
+				return nil
+			}
+			// retry in some time.
+			log.WithError(err).Info("Failed updating packet capture resource")
+			return err
+		}
+		log.WithField("capture", update.Id).Debug("Updated packet capture status")
+		return nil
+	}
+	return fmt.Errorf("too many conflicts updating packet capture %s/%s", update.Id.Namespace, update.Id.Name)
+}
+
+// packetCaptureNodeStatusEqual returns true if the two statuses differ at most in when they
+// were last updated.
+func packetCaptureNodeStatusEqual(a, b apiv3.PacketCaptureNodeStatus) bool {
+	a.LastUpdated = b.LastUpdated
+	return reflect.DeepEqual(a, b)
+}
+
+func (fc *DataplaneConnector) handlePacketCaptureStatUpdateFromDataplane() {
+	// pending holds the latest status of each capture that hasn't been written yet.
+	pending := map[proto.PacketCaptureID]*proto.PacketCaptureStatusUpdate{}
+	var ticker *jitter.Ticker
+	var retryC <-chan time.Time
+
+	for {
+		// Block until we either get an update or it's time to retry the failed updates.
+		select {
+		case update := <-fc.packetCaptureStatUpdateFromDataplane:
+			log.WithField("update", update).Debug("Packet capture status update from dataplane driver")
+			pending[*update.Id] = update
+		case <-retryC:
+			log.Debug("retrying failed packet capture status updates")
+		}
+		if ticker != nil {
+			ticker.Stop()
+			ticker = nil
+			retryC = nil
+		}
+
+		for id, update := range pending {
+			if err := fc.reconcilePacketCaptureStatUpdate(update); err == nil {
+				delete(pending, id)
+			}
+		}
+		if len(pending) > 0 {
+			// retry reconciling between 2-4 seconds.
+			ticker = jitter.NewTicker(2*time.Second, 2*time.Second)
+			retryC = ticker.C
+		}
+	}
+}
+
 var handledConfigChanges = set.From("CalicoVersion", "ClusterGUID", "ClusterType")
 
 func (fc *DataplaneConnector) sendMessagesToDataplaneDriver() {
@@ -1198,7 +1391,9 @@ func (fc *DataplaneConnector) sendMessagesToDataplaneDriver() {
 			log.Warn("Datastore became unready, need to restart.")
 			fc.shutDownProcess("datastore became unready")
 		case *proto.Encapsulation:
-			if msg.IpipEnabled != fc.config.Encapsulation.IPIPEnabled || msg.VxlanEnabled != fc.config.Encapsulation.VXLANEnabled {
+			if msg.IpipEnabled != fc.config.Encapsulation.IPIPEnabled ||
+				msg.VxlanEnabled != fc.config.Encapsulation.VXLANEnabled ||
+				msg.VxlanEnabledV6 != fc.config.Encapsulation.VXLANEnabledV6 {
 				log.Warn("IPIP and/or VXLAN encapsulation changed, need to restart.")
 				fc.shutDownProcess(reasonEncapChanged)
 			}
@@ -1227,10 +1422,87 @@ func (fc *DataplaneConnector) Start() {
 
 	// Start a background thread to handle Wireguard update to Node.
 	go fc.handleWireguardStatUpdateFromDataplane()
+
+	// Start a background thread to write the status of packet captures.
+	go fc.handlePacketCaptureStatUpdateFromDataplane()
+}
+
+// resumeTyphaConnections waits for the connection to Typha to fail and then reconnects to the same Typha,
+// asking it to resume from where the old connection left off.  That avoids restarting Felix, and resyncing
+// the dataplane, after a brief outage.  Only that Typha can resume the connection: connecting to another
+// one means a fresh snapshot, which the calculation graph can't apply on top of the old one.  So it returns
+// once a connection fails and Typha can't resume it, which includes when Typha dropped us to rebalance its
+// load or when typhaDiscovered reports that the Typha has gone; Felix then restarts and rediscovers Typha.
+func resumeTyphaConnections(
+	conn *syncclient.SyncerClient,
+	newConn func(addrs []string, resumeFrom syncproto.ResumePoint) *syncclient.SyncerClient,
+	typhaDiscovered func(addr string) bool,
+) {
+	for {
+		conn.Finished.Wait()
+		resumeFrom := conn.ResumePoint()
+		if resumeFrom.IsZero() || conn.ResumeRejected() {
+			// Typha doesn't support resuming, it no longer has our resume point or it already has its
+			// share of connections.
+			return
+		}
+
+		// Only the Typha that we were connected to can resume our connection.
+		addr := conn.Addr()
+		log.WithFields(log.Fields{
+			"addr":       addr,
+			"resumeFrom": resumeFrom,
+		}).Warn("Connection to Typha failed, trying to resume it.")
+		if conn = resumeTyphaConnection(addr, resumeFrom, newConn, typhaDiscovered); conn == nil {
+			return
+		}
+	}
+}
+
+// resumeTyphaConnection tries to resume our connection to the Typha at addr, backing off between attempts,
+// until it succeeds or it gives up.  It gives up straight away if typhaDiscovered reports that the Typha is
+// no longer among the discovered Typhas, rather than waiting out the timeout.  It returns the new
+// connection, or nil if it gave up.
+func resumeTyphaConnection(
+	addr string,
+	resumeFrom syncproto.ResumePoint,
+	newConn func(addrs []string, resumeFrom syncproto.ResumePoint) *syncclient.SyncerClient,
+	typhaDiscovered func(addr string) bool,
+) *syncclient.SyncerClient {
+	const (
+		backoffDuration  = 500 * time.Millisecond
+		backoffExpFactor = 2
+		backoffMax       = 8 * time.Second
+		jitter           = 0.2
+		resumeTimeout    = 30 * time.Second
+	)
+	expBackoffMgr := wait.NewExponentialBackoffManager(
+		backoffDuration, backoffMax, time.Minute, backoffExpFactor, jitter, clock.RealClock{})
+	defer expBackoffMgr.Backoff().Stop()
+
+	startTime := time.Now()
+	for {
+		conn := newConn([]string{addr}, resumeFrom)
+		err := conn.Start(context.Background())
+		if err == nil {
+			return conn
+		}
+		if time.Since(startTime) > resumeTimeout {
+			log.WithError(err).Error("Failed to reconnect to Typha.")
+			return nil
+		}
+		if !typhaDiscovered(addr) {
+			log.WithError(err).WithField("addr", addr).Error(
+				"Failed to reconnect to Typha and it is no longer discovered.")
+			return nil
+		}
+		log.WithError(err).Debug("Retrying Typha connection")
+		<-expBackoffMgr.Backoff().C()
+	}
 }
 
-func discoverTyphaAddr(configParams *config.Config, k8sClientSet kubernetes.Interface) (string, error) {
+func discoverTyphaAddrs(configParams *config.Config, k8sClientSet kubernetes.Interface) ([]string, error) {
 	typhaDiscoveryOpts := configParams.TyphaDiscoveryOpts()
 	typhaDiscoveryOpts = append(typhaDiscoveryOpts, discovery.WithKubeClient(k8sClientSet))
-	return discovery.DiscoverTyphaAddr(typhaDiscoveryOpts...)
+	return discovery.DiscoverTyphaAddrs(typhaDiscoveryOpts...)
 }
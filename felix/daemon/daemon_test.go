@@ -86,49 +86,57 @@ var _ = Describe("Typha address discovery", func() {
 
 	It("should return address if configured", func() {
 		configParams.TyphaAddr = "10.0.0.1:8080"
-		typhaAddr, err := discoverTyphaAddr(configParams, nil)
+		typhaAddrs, err := discoverTyphaAddrs(configParams, nil)
 		Expect(err).NotTo(HaveOccurred())
-		Expect(typhaAddr).To(Equal("10.0.0.1:8080"))
+		Expect(typhaAddrs).To(Equal([]string{"10.0.0.1:8080"}))
 	})
 
 	It("should return nothing if no service name", func() {
 		configParams.TyphaK8sServiceName = ""
-		typhaAddr, err := discoverTyphaAddr(configParams, nil)
+		typhaAddrs, err := discoverTyphaAddrs(configParams, nil)
 		Expect(err).NotTo(HaveOccurred())
-		Expect(typhaAddr).To(Equal(""))
+		Expect(typhaAddrs).To(BeEmpty())
 	})
 
 	It("should return IP from endpoints", func() {
-		typhaAddr, err := discoverTyphaAddr(configParams, k8sClient)
+		typhaAddrs, err := discoverTyphaAddrs(configParams, k8sClient)
 		Expect(err).NotTo(HaveOccurred())
-		Expect(typhaAddr).To(Equal("10.0.0.2:8156"))
+		Expect(typhaAddrs).To(Equal([]string{"10.0.0.2:8156"}))
 	})
 
 	It("should bracket an IPv6 Typha address", func() {
 		endpoints.Subsets[1].Addresses[0].IP = "fd5f:65af::2"
 		refreshClient()
-		typhaAddr, err := discoverTyphaAddr(configParams, k8sClient)
+		typhaAddrs, err := discoverTyphaAddrs(configParams, k8sClient)
 		Expect(err).NotTo(HaveOccurred())
-		Expect(typhaAddr).To(Equal("[fd5f:65af::2]:8156"))
+		Expect(typhaAddrs).To(Equal([]string{"[fd5f:65af::2]:8156"}))
 	})
 
 	It("should error if no Typhas", func() {
 		endpoints.Subsets = nil
 		refreshClient()
-		_, err := discoverTyphaAddr(configParams, k8sClient)
+		_, err := discoverTyphaAddrs(configParams, k8sClient)
 		Expect(err).To(HaveOccurred())
 	})
 
-	It("should choose random Typhas", func() {
+	It("should return all the ready Typhas", func() {
+		endpoints.Subsets[1].Addresses = append(endpoints.Subsets[1].Addresses, v1.EndpointAddress{IP: "10.0.0.6"})
+		refreshClient()
+		typhaAddrs, err := discoverTyphaAddrs(configParams, k8sClient)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(typhaAddrs).To(ConsistOf("10.0.0.2:8156", "10.0.0.6:8156"))
+	})
+
+	It("should put random Typhas first", func() {
 		seenAddresses := set.New()
 		expected := set.From("10.0.0.2:8156", "10.0.0.6:8156")
 		endpoints.Subsets[1].Addresses = append(endpoints.Subsets[1].Addresses, v1.EndpointAddress{IP: "10.0.0.6"})
 		refreshClient()
 
 		for i := 0; i < 32; i++ {
-			addr, err := discoverTyphaAddr(configParams, k8sClient)
+			addrs, err := discoverTyphaAddrs(configParams, k8sClient)
 			Expect(err).NotTo(HaveOccurred())
-			seenAddresses.Add(addr)
+			seenAddresses.Add(addrs[0])
 			if seenAddresses.ContainsAll(expected) {
 				return
 			}
@@ -1,4 +1,4 @@
-// Copyright (c) 2016-2021 Tigera, Inc. All rights reserved.
+// Copyright (c) 2016-2022 Tigera, Inc. All rights reserved.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -15,9 +15,12 @@
 package common
 
 import (
+	"strings"
+
 	log "github.com/sirupsen/logrus"
 
 	"github.com/projectcalico/calico/felix/ipsets"
+	"github.com/projectcalico/calico/felix/multidict"
 	"github.com/projectcalico/calico/felix/proto"
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
 )
@@ -35,18 +38,38 @@ type IPSetsDataplane interface {
 	ApplyDeletions()
 }
 
+// DomainInfoStore provides the IP addresses that domain names currently resolve to, as learned
+// from DNS responses.
+type DomainInfoStore interface {
+	GetDomainIPs(domain string) []string
+}
+
 // Except for domain IP sets, IPSetsManager simply passes through IP set updates from the datastore
 // to the ipsets.IPSets dataplane layer.  For domain IP sets - which hereafter we'll just call
 // "domain sets" - IPSetsManager handles the resolution from domain names to expiring IPs.
 type IPSetsManager struct {
 	dataplanes []IPSetsDataplane
 	maxSize    int
+
+	// domainInfoStore resolves the domain names in domain sets.  If nil, domain sets are
+	// programmed empty.
+	domainInfoStore DomainInfoStore
+	// IDs of the domain sets that we've been told about.
+	domainSetIDs set.Set
+	// Mappings from domain set ID to its domain names, and back again.
+	domainSetIDToDomains multidict.StringToString
+	domainToDomainSetIDs multidict.StringToString
 }
 
-func NewIPSetsManager(ipsets_ IPSetsDataplane, maxIPSetSize int) *IPSetsManager {
+func NewIPSetsManager(ipsets_ IPSetsDataplane, maxIPSetSize int, domainInfoStore DomainInfoStore) *IPSetsManager {
 	return &IPSetsManager{
 		dataplanes: []IPSetsDataplane{ipsets_},
 		maxSize:    maxIPSetSize,
+
+		domainInfoStore:      domainInfoStore,
+		domainSetIDs:         set.New(),
+		domainSetIDToDomains: multidict.NewStringToString(),
+		domainToDomainSetIDs: multidict.NewStringToString(),
 	}
 }
 
@@ -79,12 +102,24 @@ func (m *IPSetsManager) OnUpdate(msg interface{}) {
 	// IP set-related messages, these are extremely common.
 	case *proto.IPSetDeltaUpdate:
 		log.WithField("ipSetId", msg.Id).Debug("IP set delta update")
+		if m.domainSetIDs.Contains(msg.Id) {
+			// Domain set: update the domain names and then reprogram the set.
+			for _, domain := range msg.AddedMembers {
+				m.addDomainSetMember(msg.Id, domain)
+			}
+			for _, domain := range msg.RemovedMembers {
+				m.removeDomainSetMember(msg.Id, domain)
+			}
+			m.programDomainSet(msg.Id)
+			return
+		}
 		for _, dp := range m.dataplanes {
 			dp.AddMembers(msg.Id, msg.AddedMembers)
 			dp.RemoveMembers(msg.Id, msg.RemovedMembers)
 		}
 	case *proto.IPSetUpdate:
 		log.WithField("ipSetId", msg.Id).Debug("IP set update")
+		m.removeDomainSet(msg.Id)
 		var setType ipsets.IPSetType
 		switch msg.Type {
 		case proto.IPSetUpdate_IP:
@@ -93,6 +128,15 @@ func (m *IPSetsManager) OnUpdate(msg interface{}) {
 			setType = ipsets.IPSetTypeHashNet
 		case proto.IPSetUpdate_IP_AND_PORT:
 			setType = ipsets.IPSetTypeHashIPPort
+		case proto.IPSetUpdate_DOMAIN:
+			// The members are domain names; the dataplane IP set holds the IPs that
+			// they resolve to.
+			m.domainSetIDs.Add(msg.Id)
+			for _, domain := range msg.Members {
+				m.addDomainSetMember(msg.Id, domain)
+			}
+			m.programDomainSet(msg.Id)
+			return
 		default:
 			log.WithField("type", msg.Type).Panic("Unknown IP set type")
 		}
@@ -106,12 +150,78 @@ func (m *IPSetsManager) OnUpdate(msg interface{}) {
 		}
 	case *proto.IPSetRemove:
 		log.WithField("ipSetId", msg.Id).Debug("IP set remove")
+		m.removeDomainSet(msg.Id)
 		for _, dp := range m.dataplanes {
 			dp.RemoveIPSet(msg.Id)
 		}
 	}
 }
 
+// OnDomainChange is called by the domain info store when the IPs for the given domain name
+// change.  It reprograms the domain sets that include that name and returns true if there were
+// any.
+func (m *IPSetsManager) OnDomainChange(domain string) (dataplaneSyncNeeded bool) {
+	m.domainToDomainSetIDs.Iter(strings.ToLower(domain), func(setID string) {
+		log.WithFields(log.Fields{"domain": domain, "ipSetId": setID}).Debug("Domain changed, updating domain set")
+		m.programDomainSet(setID)
+		dataplaneSyncNeeded = true
+	})
+	return
+}
+
+func (m *IPSetsManager) addDomainSetMember(setID, domain string) {
+	domain = strings.ToLower(domain)
+	m.domainSetIDToDomains.Put(setID, domain)
+	m.domainToDomainSetIDs.Put(domain, setID)
+}
+
+func (m *IPSetsManager) removeDomainSetMember(setID, domain string) {
+	domain = strings.ToLower(domain)
+	m.domainSetIDToDomains.Discard(setID, domain)
+	m.domainToDomainSetIDs.Discard(domain, setID)
+}
+
+func (m *IPSetsManager) removeDomainSet(setID string) {
+	m.domainSetIDs.Discard(setID)
+	var domains []string
+	m.domainSetIDToDomains.Iter(setID, func(domain string) {
+		domains = append(domains, domain)
+	})
+	for _, domain := range domains {
+		m.removeDomainSetMember(setID, domain)
+	}
+}
+
+// programDomainSet sends the current IPs for the given domain set to each dataplane, filtered
+// to the dataplane's IP family.
+func (m *IPSetsManager) programDomainSet(setID string) {
+	ips := set.New()
+	if m.domainInfoStore != nil {
+		m.domainSetIDToDomains.Iter(setID, func(domain string) {
+			for _, ip := range m.domainInfoStore.GetDomainIPs(domain) {
+				ips.Add(ip)
+			}
+		})
+	}
+	metadata := ipsets.IPSetMetadata{
+		Type:    ipsets.IPSetTypeHashIP,
+		SetID:   setID,
+		MaxSize: m.maxSize,
+	}
+	for _, dp := range m.dataplanes {
+		isV6 := dp.GetIPFamily().Version() == 6
+		var members []string
+		ips.Iter(func(item interface{}) error {
+			ip := item.(string)
+			if strings.Contains(ip, ":") == isV6 {
+				members = append(members, ip)
+			}
+			return nil
+		})
+		dp.AddOrReplaceIPSet(metadata, members)
+	}
+}
+
 func (m *IPSetsManager) CompleteDeferredWork() error {
 	// Nothing to do, we don't defer any work.
 	return nil
@@ -1,4 +1,4 @@
-// Copyright (c) 2017-2021 Tigera, Inc. All rights reserved.
+// Copyright (c) 2017-2022 Tigera, Inc. All rights reserved.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
 // you may not use this file except in compliance with the License.
@@ -18,6 +18,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/projectcalico/calico/felix/ipsets"
 	"github.com/projectcalico/calico/felix/proto"
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
 )
@@ -49,7 +50,7 @@ var _ = Describe("IP Sets manager", func() {
 
 	BeforeEach(func() {
 		ipSets = NewMockIPSets()
-		ipsetsMgr = NewIPSetsManager(ipSets, 1024)
+		ipsetsMgr = NewIPSetsManager(ipSets, 1024, nil)
 	})
 
 	// Generic assumptions used during tests. Having them here reduces code duplication and improves readability.
@@ -144,4 +145,88 @@ var _ = Describe("IP Sets manager", func() {
 	for _, testCase := range ipsetsMgrTestCases {
 		IPsetsMgrTest1(testCase.ipsetID, testCase.ipsetType, testCase.ipsetMembers)
 	}
+
+	Describe("with domain IP sets", func() {
+		var domainStore *mockDomainInfoStore
+
+		BeforeEach(func() {
+			domainStore = &mockDomainInfoStore{ips: map[string][]string{
+				"a.example.com": {"10.0.0.1", "10.0.0.2"},
+				"b.example.com": {"10.0.0.3", "fd00::3"},
+			}}
+			ipsetsMgr = NewIPSetsManager(ipSets, 1024, domainStore)
+			ipsetsMgr.OnUpdate(&proto.IPSetUpdate{
+				Id:      "d1",
+				Members: []string{"a.example.com"},
+				Type:    proto.IPSetUpdate_DOMAIN,
+			})
+		})
+
+		AssertIPSetMembers("d1", []string{"10.0.0.1", "10.0.0.2"})
+
+		It("should program the IP set as hash:ip", func() {
+			Expect(ipSets.Metadata["d1"].Type).To(Equal(ipsets.IPSetTypeHashIP))
+		})
+
+		Describe("after adding a domain with a delta update", func() {
+			BeforeEach(func() {
+				ipsetsMgr.OnUpdate(&proto.IPSetDeltaUpdate{
+					Id:           "d1",
+					AddedMembers: []string{"B.example.com"},
+				})
+			})
+
+			// The IPv6 address is filtered out by the IPv4 dataplane.
+			AssertIPSetMembers("d1", []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"})
+
+			Describe("after the IPs of the domain change", func() {
+				var syncNeeded bool
+
+				BeforeEach(func() {
+					domainStore.ips["b.example.com"] = []string{"10.0.0.4"}
+					syncNeeded = ipsetsMgr.OnDomainChange("b.example.com")
+				})
+
+				It("should request a dataplane sync", func() {
+					Expect(syncNeeded).To(BeTrue())
+				})
+				AssertIPSetMembers("d1", []string{"10.0.0.1", "10.0.0.2", "10.0.0.4"})
+			})
+
+			Describe("after removing the first domain", func() {
+				BeforeEach(func() {
+					ipsetsMgr.OnUpdate(&proto.IPSetDeltaUpdate{
+						Id:             "d1",
+						RemovedMembers: []string{"a.example.com"},
+					})
+				})
+
+				AssertIPSetMembers("d1", []string{"10.0.0.3"})
+
+				It("should ignore changes to the removed domain", func() {
+					Expect(ipsetsMgr.OnDomainChange("a.example.com")).To(BeFalse())
+				})
+			})
+		})
+
+		Describe("after removing the IP set", func() {
+			BeforeEach(func() {
+				ipsetsMgr.OnUpdate(&proto.IPSetRemove{Id: "d1"})
+			})
+
+			AssertIPSetNoMembers("d1")
+
+			It("should ignore changes to the domain", func() {
+				Expect(ipsetsMgr.OnDomainChange("a.example.com")).To(BeFalse())
+			})
+		})
+	})
 })
+
+type mockDomainInfoStore struct {
+	ips map[string][]string
+}
+
+func (s *mockDomainInfoStore) GetDomainIPs(domain string) []string {
+	return s.ips[domain]
+}
@@ -80,6 +80,12 @@ func StartDataplaneDriver(configParams *config.Config,
 				log.Info("Kube-proxy in ipvs mode, enabling felix kube-proxy ipvs support.")
 			}
 		}
+		iptablesBackend := configParams.IptablesBackend
+		if iptablesBackend == intdataplane.IptablesBackendNFTNative && (configParams.BPFEnabled || kubeIPVSSupportEnabled) {
+			log.Warn("Native nftables mode is not supported in BPF mode or with kube-proxy in ipvs mode, " +
+				"falling back to iptables.")
+			iptablesBackend = "auto"
+		}
 		if configChangedRestartCallback == nil || fatalErrorCallback == nil {
 			log.Panic("Starting dataplane with nil callback func.")
 		}
@@ -116,7 +122,7 @@ func StartDataplaneDriver(configParams *config.Config,
 		markScratch0, _ = markBitsManager.NextSingleBitMark()
 		markScratch1, _ = markBitsManager.NextSingleBitMark()
 
-		if configParams.WireguardEnabled {
+		if configParams.WireguardEnabled || configParams.WireguardEnabledV6 {
 			log.Info("Wireguard enabled, allocating a mark bit")
 			markWireguard, _ = markBitsManager.NextSingleBitMark()
 			if markWireguard == 0 {
@@ -162,63 +168,27 @@ func StartDataplaneDriver(configParams *config.Config,
 
 		// Always allocate the wireguard table index (even when not enabled). This ensures we can tidy up entries
 		// if wireguard is disabled after being previously enabled.
-		var wireguardEnabled bool
+		var wireguardEnabled, wireguardEnabledV6 bool
 		var wireguardTableIndex int
 		if idx, err := routeTableIndexAllocator.GrabIndex(); err == nil {
 			log.Debugf("Assigned wireguard table index: %d", idx)
 			wireguardEnabled = configParams.WireguardEnabled
+			wireguardEnabledV6 = configParams.WireguardEnabledV6
 			wireguardTableIndex = idx
 		} else {
 			log.WithError(err).Warning("Unable to assign table index for wireguard")
 		}
 
-		// If wireguard is enabled, update the failsafe ports to include the wireguard port.
+		// If wireguard is enabled, update the failsafe ports to include the wireguard port(s).
 		failsafeInboundHostPorts := configParams.FailsafeInboundHostPorts
 		failsafeOutboundHostPorts := configParams.FailsafeOutboundHostPorts
 		if configParams.WireguardEnabled {
-			var found = false
-			for _, i := range failsafeInboundHostPorts {
-				if i.Port == uint16(configParams.WireguardListeningPort) && i.Protocol == "udp" {
-					log.WithFields(log.Fields{
-						"net":      i.Net,
-						"port":     i.Port,
-						"protocol": i.Protocol,
-					}).Debug("FailsafeInboundHostPorts is already configured for wireguard")
-					found = true
-					break
-				}
-			}
-			if !found {
-				failsafeInboundHostPorts = make([]config.ProtoPort, len(configParams.FailsafeInboundHostPorts)+1)
-				copy(failsafeInboundHostPorts, configParams.FailsafeInboundHostPorts)
-				log.Debug("Adding permissive FailsafeInboundHostPorts for wireguard")
-				failsafeInboundHostPorts[len(configParams.FailsafeInboundHostPorts)] = config.ProtoPort{
-					Port:     uint16(configParams.WireguardListeningPort),
-					Protocol: "udp",
-				}
-			}
-
-			found = false
-			for _, i := range failsafeOutboundHostPorts {
-				if i.Port == uint16(configParams.WireguardListeningPort) && i.Protocol == "udp" {
-					log.WithFields(log.Fields{
-						"net":      i.Net,
-						"port":     i.Port,
-						"protocol": i.Protocol,
-					}).Debug("FailsafeOutboundHostPorts is already configured for wireguard")
-					found = true
-					break
-				}
-			}
-			if !found {
-				failsafeOutboundHostPorts = make([]config.ProtoPort, len(configParams.FailsafeOutboundHostPorts)+1)
-				copy(failsafeOutboundHostPorts, configParams.FailsafeOutboundHostPorts)
-				log.Debug("Adding permissive FailsafeOutboundHostPorts for wireguard")
-				failsafeOutboundHostPorts[len(configParams.FailsafeOutboundHostPorts)] = config.ProtoPort{
-					Port:     uint16(configParams.WireguardListeningPort),
-					Protocol: "udp",
-				}
-			}
+			failsafeInboundHostPorts = addWireguardFailsafePort(failsafeInboundHostPorts, configParams.WireguardListeningPort, "FailsafeInboundHostPorts")
+			failsafeOutboundHostPorts = addWireguardFailsafePort(failsafeOutboundHostPorts, configParams.WireguardListeningPort, "FailsafeOutboundHostPorts")
+		}
+		if configParams.WireguardEnabledV6 {
+			failsafeInboundHostPorts = addWireguardFailsafePort(failsafeInboundHostPorts, configParams.WireguardListeningPortV6, "FailsafeInboundHostPorts")
+			failsafeOutboundHostPorts = addWireguardFailsafePort(failsafeOutboundHostPorts, configParams.WireguardListeningPortV6, "FailsafeOutboundHostPorts")
 		}
 
 		dpConfig := intdataplane.Config{
@@ -257,14 +227,16 @@ func StartDataplaneDriver(configParams *config.Config,
 				IptablesMarkEndpoint:        markEndpointMark,
 				IptablesMarkNonCaliEndpoint: markEndpointNonCaliEndpoint,
 
-				VXLANEnabled: configParams.Encapsulation.VXLANEnabled,
-				VXLANPort:    configParams.VXLANPort,
-				VXLANVNI:     configParams.VXLANVNI,
+				VXLANEnabled:   configParams.Encapsulation.VXLANEnabled,
+				VXLANEnabledV6: configParams.Encapsulation.VXLANEnabledV6,
+				VXLANPort:      configParams.VXLANPort,
+				VXLANVNI:       configParams.VXLANVNI,
 
 				IPIPEnabled:            configParams.Encapsulation.IPIPEnabled,
 				FelixConfigIPIPEnabled: configParams.IpInIpEnabled,
 				IPIPTunnelAddress:      configParams.IpInIpTunnelAddr,
 				VXLANTunnelAddress:     configParams.IPv4VXLANTunnelAddr,
+				VXLANTunnelAddressV6:   configParams.IPv6VXLANTunnelAddr,
 
 				AllowVXLANPacketsFromWorkloads: configParams.AllowVXLANPacketsFromWorkloads,
 				AllowIPIPPacketsFromWorkloads:  configParams.AllowIPIPPacketsFromWorkloads,
@@ -273,6 +245,9 @@ func StartDataplaneDriver(configParams *config.Config,
 				WireguardInterfaceName:      configParams.WireguardInterfaceName,
 				WireguardIptablesMark:       markWireguard,
 				WireguardListeningPort:      configParams.WireguardListeningPort,
+				WireguardEnabledV6:          configParams.WireguardEnabledV6,
+				WireguardInterfaceNameV6:    configParams.WireguardInterfaceNameV6,
+				WireguardListeningPortV6:    configParams.WireguardListeningPortV6,
 				WireguardEncryptHostTraffic: configParams.WireguardHostEncryptionEnabled,
 				RouteSource:                 configParams.RouteSource,
 
@@ -291,23 +266,35 @@ func StartDataplaneDriver(configParams *config.Config,
 				NATOutgoingAddress:                 configParams.NATOutgoingAddress,
 				BPFEnabled:                         configParams.BPFEnabled,
 				ServiceLoopPrevention:              configParams.ServiceLoopPrevention,
+				DNSSnoopingEnabled:                 configParams.DNSSnoopingEnabled && !configParams.BPFEnabled,
+				FlowLogsEnabled:                    configParams.FlowLogsEnabled && !configParams.BPFEnabled,
+				PolicyRuleCountersEnabled:          configParams.PrometheusMetricsEnabled && !configParams.BPFEnabled,
+				EgressGatewayEnabled:               configParams.EgressGatewayEnabled && !configParams.BPFEnabled,
 			},
 			Wireguard: wireguard.Config{
 				Enabled:             wireguardEnabled,
+				EnabledV6:           wireguardEnabledV6,
 				ListeningPort:       configParams.WireguardListeningPort,
+				ListeningPortV6:     configParams.WireguardListeningPortV6,
 				FirewallMark:        int(markWireguard),
 				RoutingRulePriority: configParams.WireguardRoutingRulePriority,
 				RoutingTableIndex:   wireguardTableIndex,
 				InterfaceName:       configParams.WireguardInterfaceName,
+				InterfaceNameV6:     configParams.WireguardInterfaceNameV6,
 				MTU:                 configParams.WireguardMTU,
+				MTUV6:               configParams.WireguardMTUV6,
 				RouteSource:         configParams.RouteSource,
 				EncryptHostTraffic:  configParams.WireguardHostEncryptionEnabled,
 				PersistentKeepAlive: configParams.WireguardPersistentKeepAlive,
+				KeyRotationInterval: configParams.WireguardKeyRotationInterval,
+				PendingKeyFile:      "/var/lib/calico/wireguard-pending-key",
+				PendingKeyFileV6:    "/var/lib/calico/wireguard-pending-key-v6",
 			},
 			IPIPMTU:                        configParams.IpInIpMtu,
 			VXLANMTU:                       configParams.VXLANMTU,
+			VXLANMTUV6:                     configParams.VXLANMTUV6,
 			VXLANPort:                      configParams.VXLANPort,
-			IptablesBackend:                configParams.IptablesBackend,
+			IptablesBackend:                iptablesBackend,
 			IptablesRefreshInterval:        configParams.IptablesRefreshInterval,
 			RouteRefreshInterval:           configParams.RouteRefreshInterval,
 			DeviceRouteSourceAddress:       configParams.DeviceRouteSourceAddress,
@@ -344,6 +331,24 @@ func StartDataplaneDriver(configParams *config.Config,
 			WatchdogTimeout:                    configParams.DataplaneWatchdogTimeout,
 			DebugSimulateDataplaneHangAfter:    configParams.DebugSimulateDataplaneHangAfter,
 			ExternalNodesCidrs:                 configParams.ExternalNodesCIDRList,
+			DNSTrustedServers:                  configParams.DNSTrustedServers,
+			DNSExtraTTL:                        configParams.DNSExtraTTL,
+			FlowLogsEnabled:                    configParams.FlowLogsEnabled,
+			FlowLogsFlushInterval:              configParams.FlowLogsFlushInterval,
+			FlowLogsFileEnabled:                configParams.FlowLogsFileEnabled,
+			FlowLogsFileDirectory:              configParams.FlowLogsFileDirectory,
+			FlowLogsFileMaxFiles:               configParams.FlowLogsFileMaxFiles,
+			FlowLogsFileMaxFileSizeMB:          configParams.FlowLogsFileMaxFileSizeMB,
+			CaptureDir:                         configParams.CaptureDir,
+			CaptureMaxSizeBytes:                configParams.CaptureMaxSizeBytes,
+			CaptureMaxFiles:                    configParams.CaptureMaxFiles,
+			PolicyRuleCountersEnabled:          configParams.PrometheusMetricsEnabled,
+			EgressGatewayEnabled:               configParams.EgressGatewayEnabled,
+			EgressGatewayRoutingRulePriority:   configParams.EgressGatewayRoutingRulePriority,
+			EgressGatewayRoutingTables:         configParams.EgressGatewayRoutingTables,
+			EgressGatewayHealthPort:            configParams.EgressGatewayHealthPort,
+			EgressGatewayPollInterval:          configParams.EgressGatewayPollInterval,
+			EgressGatewayPollFailureCount:      configParams.EgressGatewayPollFailureCount,
 			SidecarAccelerationEnabled:         configParams.SidecarAccelerationEnabled,
 			BPFEnabled:                         configParams.BPFEnabled,
 			BPFDisableUnprivileged:             configParams.BPFDisableUnprivileged,
@@ -420,7 +425,7 @@ func ServePrometheusMetrics(configParams *config.Config) {
 			log.Info("Discarding process metrics")
 			prometheus.Unregister(collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
 		}
-		if !configParams.PrometheusWireGuardMetricsEnabled || !configParams.WireguardEnabled {
+		if !configParams.PrometheusWireGuardMetricsEnabled || !(configParams.WireguardEnabled || configParams.WireguardEnabledV6) {
 			log.Info("Discarding WireGuard metrics")
 			prometheus.Unregister(wireguard.MustNewWireguardMetrics())
 		}
@@ -434,3 +439,26 @@ func ServePrometheusMetrics(configParams *config.Config) {
 		time.Sleep(1 * time.Second)
 	}
 }
+
+// addWireguardFailsafePort returns the given failsafe ports with a permissive UDP entry for the wireguard listening
+// port appended, unless the port is already present.  The input slice is not modified.
+func addWireguardFailsafePort(ports []config.ProtoPort, port int, name string) []config.ProtoPort {
+	for _, i := range ports {
+		if i.Port == uint16(port) && i.Protocol == "udp" {
+			log.WithFields(log.Fields{
+				"net":      i.Net,
+				"port":     i.Port,
+				"protocol": i.Protocol,
+			}).Debugf("%s is already configured for wireguard", name)
+			return ports
+		}
+	}
+	log.Debugf("Adding permissive %s for wireguard", name)
+	updated := make([]config.ProtoPort, len(ports)+1)
+	copy(updated, ports)
+	updated[len(ports)] = config.ProtoPort{
+		Port:     uint16(port),
+		Protocol: "udp",
+	}
+	return updated
+}
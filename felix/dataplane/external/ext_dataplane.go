@@ -124,6 +124,8 @@ func (c *extDataplaneConn) RecvMessage() (msg interface{}, err error) {
 		msg = payload.HostEndpointStatusRemove
 	case *proto.FromDataplane_WireguardStatusUpdate:
 		msg = payload.WireguardStatusUpdate
+	case *proto.FromDataplane_PacketCaptureStatusUpdate:
+		msg = payload.PacketCaptureStatusUpdate
 
 	default:
 		log.WithField("payload", payload).Warn("Ignoring unknown message from dataplane")
@@ -171,6 +173,10 @@ func (fc *extDataplaneConn) SendMessage(msg interface{}) error {
 		envelope.Payload = &proto.ToDataplane_HostMetadataUpdate{HostMetadataUpdate: msg}
 	case *proto.HostMetadataRemove:
 		envelope.Payload = &proto.ToDataplane_HostMetadataRemove{HostMetadataRemove: msg}
+	case *proto.HostMetadataV6Update:
+		envelope.Payload = &proto.ToDataplane_HostMetadataV6Update{HostMetadataV6Update: msg}
+	case *proto.HostMetadataV6Remove:
+		envelope.Payload = &proto.ToDataplane_HostMetadataV6Remove{HostMetadataV6Remove: msg}
 	case *proto.IPAMPoolUpdate:
 		envelope.Payload = &proto.ToDataplane_IpamPoolUpdate{IpamPoolUpdate: msg}
 	case *proto.IPAMPoolRemove:
@@ -195,10 +201,18 @@ func (fc *extDataplaneConn) SendMessage(msg interface{}) error {
 		envelope.Payload = &proto.ToDataplane_WireguardEndpointUpdate{WireguardEndpointUpdate: msg}
 	case *proto.WireguardEndpointRemove:
 		envelope.Payload = &proto.ToDataplane_WireguardEndpointRemove{WireguardEndpointRemove: msg}
+	case *proto.WireguardEndpointV6Update:
+		envelope.Payload = &proto.ToDataplane_WireguardEndpointV6Update{WireguardEndpointV6Update: msg}
+	case *proto.WireguardEndpointV6Remove:
+		envelope.Payload = &proto.ToDataplane_WireguardEndpointV6Remove{WireguardEndpointV6Remove: msg}
 	case *proto.GlobalBGPConfigUpdate:
 		envelope.Payload = &proto.ToDataplane_GlobalBgpConfigUpdate{GlobalBgpConfigUpdate: msg}
 	case *proto.Encapsulation:
 		envelope.Payload = &proto.ToDataplane_Encapsulation{Encapsulation: msg}
+	case *proto.PacketCaptureUpdate:
+		envelope.Payload = &proto.ToDataplane_PacketCaptureUpdate{PacketCaptureUpdate: msg}
+	case *proto.PacketCaptureRemove:
+		envelope.Payload = &proto.ToDataplane_PacketCaptureRemove{PacketCaptureRemove: msg}
 
 	default:
 		log.WithField("msg", msg).Panic("Unknown message type")
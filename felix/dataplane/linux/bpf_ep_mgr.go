@@ -30,6 +30,7 @@ import (
 	"regexp"
 	"runtime"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -40,20 +41,25 @@ import (
 
 	"github.com/projectcalico/calico/felix/logutils"
 
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
 
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	"github.com/projectcalico/api/pkg/lib/numorstring"
 
 	"github.com/projectcalico/calico/felix/bpf"
 	"github.com/projectcalico/calico/felix/bpf/bpfmap"
+	"github.com/projectcalico/calico/felix/bpf/counters"
 	"github.com/projectcalico/calico/felix/bpf/polprog"
 	"github.com/projectcalico/calico/felix/bpf/tc"
 	"github.com/projectcalico/calico/felix/bpf/xdp"
+	"github.com/projectcalico/calico/felix/collector"
 	"github.com/projectcalico/calico/felix/idalloc"
 	"github.com/projectcalico/calico/felix/ifacemonitor"
 	"github.com/projectcalico/calico/felix/iptables"
 	"github.com/projectcalico/calico/felix/proto"
 	"github.com/projectcalico/calico/felix/ratelimited"
+	"github.com/projectcalico/calico/felix/rules"
 )
 
 const jumpMapCleanupInterval = 10 * time.Second
@@ -149,6 +155,13 @@ type bpfEndpointManager struct {
 	psnatPorts              numorstring.Port
 	bpfMapContext           *bpf.MapContext
 
+	// flowLogRuleIDs records the rules that the policy programs report verdicts for; nil if
+	// flow logs are disabled.
+	flowLogRuleIDs *collector.RuleIDs
+	// ruleCounterIDs records the rules that the policy programs keep packet and byte counters
+	// for; nil if rule counters are disabled.
+	ruleCounterIDs *policyRuleCounterIDs
+
 	ruleRenderer        bpfAllowChainRenderer
 	iptablesFilterTable iptablesTable
 
@@ -233,6 +246,13 @@ func newBPFEndpointManager(
 		ipv6Enabled: config.BPFIpv6Enabled,
 	}
 
+	if config.FlowLogsEnabled {
+		m.flowLogRuleIDs = collector.NewRuleIDs()
+	}
+	if config.PolicyRuleCountersEnabled {
+		m.ruleCounterIDs = newPolicyRuleCounterIDs()
+	}
+
 	// Calculate allowed XDP attachment modes.  Note, in BPF mode untracked ingress policy is
 	// _only_ implemented by XDP, so we _should_ fall back to XDPGeneric if necessary in order
 	// to preserve the semantics of untracked ingress policy.  (Therefore we are also saying
@@ -458,6 +478,7 @@ func (m *bpfEndpointManager) onPolicyRemove(msg *proto.ActivePolicyRemove) {
 	m.markEndpointsDirty(m.policiesToWorkloads[polID], "policy")
 	delete(m.policies, polID)
 	delete(m.policiesToWorkloads, polID)
+	m.forgetRuleIDs(polID.Tier, polID.Name)
 }
 
 // onProfileUpdate stores the profile in the cache and marks any endpoints that use it as dirty.
@@ -476,6 +497,22 @@ func (m *bpfEndpointManager) onProfileRemove(msg *proto.ActiveProfileRemove) {
 	m.markEndpointsDirty(m.profilesToWorkloads[profID], "profile")
 	delete(m.profiles, profID)
 	delete(m.profilesToWorkloads, profID)
+	m.forgetRuleIDs(rules.FlowLogProfileTier, profID.Name)
+}
+
+// forgetRuleIDs forgets the flow log and counter IDs of the rules of a removed policy, or
+// profile if tier is rules.FlowLogProfileTier, and frees the rules' counters.
+func (m *bpfEndpointManager) forgetRuleIDs(tier, name string) {
+	if m.flowLogRuleIDs != nil {
+		m.flowLogRuleIDs.RemovePolicy(tier, name)
+	}
+	if m.ruleCounterIDs != nil {
+		removed := m.ruleCounterIDs.removePolicy(tier, name)
+		if err := counters.Delete(m.bpfMapContext.RuleCountersMap, removed); err != nil {
+			log.WithError(err).WithFields(log.Fields{"tier": tier, "name": name}).Warn(
+				"Failed to free the rule counters of removed policy")
+		}
+	}
 }
 
 func (m *bpfEndpointManager) markEndpointsDirty(ids set.Set, kind string) {
@@ -820,20 +857,18 @@ func (m *bpfEndpointManager) attachWorkloadProgram(ifaceName string, endpoint *p
 	}
 
 	var profileIDs []string
-	var tier *proto.TierInfo
+	var tiers []*proto.TierInfo
 	if endpoint != nil {
 		profileIDs = endpoint.ProfileIds
-		if len(endpoint.Tiers) != 0 {
-			tier = endpoint.Tiers[0]
-		}
+		tiers = endpoint.Tiers
 	} else {
 		log.WithField("name", ifaceName).Debug(
 			"Workload interface with no endpoint in datastore, installing default-drop program.")
 	}
 
-	// If tier or profileIDs is nil, this will return an empty set of rules but updatePolicyProgram appends a
+	// If tiers or profileIDs is nil, this will return an empty set of rules but updatePolicyProgram appends a
 	// drop rule, giving us default drop behaviour in that case.
-	rules := m.extractRules(tier, profileIDs, polDirection)
+	rules := m.extractRules(tiers, profileIDs, polDirection)
 
 	// If host-* endpoint is configured, add in its policy.
 	if m.wildcardExists {
@@ -858,21 +893,15 @@ func (m *bpfEndpointManager) addHostPolicy(rules *polprog.Rules, hostEndpoint *p
 
 	// When there is applicable pre-DNAT policy that does not explicitly Allow or Deny traffic,
 	// we continue on to subsequent tiers and normal or AoF policy.
-	if len(hostEndpoint.PreDnatTiers) == 1 {
-		rules.HostPreDnatTiers = m.extractTiers(hostEndpoint.PreDnatTiers[0], polDirection, NoEndTierDrop)
-	}
+	rules.HostPreDnatTiers = m.extractTiers(hostEndpoint.PreDnatTiers, polDirection, NoEndTierDrop)
 
 	// When there is applicable apply-on-forward policy that does not explicitly Allow or Deny
-	// traffic, traffic is dropped.
-	if len(hostEndpoint.ForwardTiers) == 1 {
-		rules.HostForwardTiers = m.extractTiers(hostEndpoint.ForwardTiers[0], polDirection, EndTierDrop)
-	}
+	// traffic, traffic is dropped (unless the tier's default action is Pass).
+	rules.HostForwardTiers = m.extractTiers(hostEndpoint.ForwardTiers, polDirection, EndTierDrop)
 
 	// When there is applicable normal policy that does not explicitly Allow or Deny traffic,
-	// traffic is dropped.
-	if len(hostEndpoint.Tiers) == 1 {
-		rules.HostNormalTiers = m.extractTiers(hostEndpoint.Tiers[0], polDirection, EndTierDrop)
-	}
+	// traffic is dropped (unless the tier's default action is Pass).
+	rules.HostNormalTiers = m.extractTiers(hostEndpoint.Tiers, polDirection, EndTierDrop)
 	rules.HostProfiles = m.extractProfiles(hostEndpoint.ProfileIds, polDirection)
 }
 
@@ -922,7 +951,7 @@ func (m *bpfEndpointManager) attachXDPProgram(ifaceName string, ep *proto.HostEn
 		Modes:    m.xdpModes,
 	}
 
-	if ep != nil && len(ep.UntrackedTiers) == 1 {
+	if ep != nil && len(ep.UntrackedTiers) > 0 {
 		jumpMapFD, err := m.dp.ensureProgramAttached(&ap)
 		if err != nil {
 			return err
@@ -931,7 +960,7 @@ func (m *bpfEndpointManager) attachXDPProgram(ifaceName string, ep *proto.HostEn
 		ap.Log().Debugf("Building program for untracked policy hep=%v jumpMapFD=%v", ep.Name, jumpMapFD)
 		rules := polprog.Rules{
 			ForHostInterface: true,
-			HostNormalTiers:  m.extractTiers(ep.UntrackedTiers[0], PolDirnIngress, false),
+			HostNormalTiers:  m.extractTiers(ep.UntrackedTiers, PolDirnIngress, NoEndTierDrop),
 			ForXDP:           true,
 		}
 		ap.Log().Debugf("Rules: %v", rules)
@@ -1009,6 +1038,7 @@ func (m *bpfEndpointManager) calculateTCAttachPoint(policyDirection PolDirection
 	ap.PSNATEnd = m.psnatPorts.MaxPort
 	ap.IPv6Enabled = m.ipv6Enabled
 	ap.MapSizes = m.bpfMapContext.MapSizes
+	ap.FlowLogsEnabled = m.flowLogRuleIDs != nil
 
 	return ap
 }
@@ -1016,22 +1046,25 @@ func (m *bpfEndpointManager) calculateTCAttachPoint(policyDirection PolDirection
 const EndTierDrop = true
 const NoEndTierDrop = false
 
-func (m *bpfEndpointManager) extractTiers(tier *proto.TierInfo, direction PolDirection, endTierDrop bool) (rTiers []polprog.Tier) {
-	if tier == nil {
-		return
-	}
+func (m *bpfEndpointManager) extractTiers(tiers []*proto.TierInfo, direction PolDirection, endTierDrop bool) (rTiers []polprog.Tier) {
+	for _, tier := range tiers {
+		directionalPols := tier.IngressPolicies
+		if direction == PolDirnEgress {
+			directionalPols = tier.EgressPolicies
+		}
 
-	directionalPols := tier.IngressPolicies
-	if direction == PolDirnEgress {
-		directionalPols = tier.EgressPolicies
-	}
+		if len(directionalPols) == 0 {
+			continue
+		}
 
-	if len(directionalPols) > 0 {
 		polTier := polprog.Tier{
 			Name:     tier.Name,
 			Policies: make([]polprog.Policy, len(directionalPols)),
 		}
 
+		// Staged policies only count packets, so a tier that contains nothing but staged
+		// policies must not drop traffic at its end.
+		enforcedPoliciesInTier := false
 		for i, polName := range directionalPols {
 			pol := m.policies[proto.PolicyID{Tier: tier.Name, Name: polName}]
 			var prules []*proto.Rule
@@ -1040,21 +1073,33 @@ func (m *bpfEndpointManager) extractTiers(tier *proto.TierInfo, direction PolDir
 			} else {
 				prules = pol.OutboundRules
 			}
+			staged := model.PolicyIsStaged(polName)
+			if !staged {
+				enforcedPoliciesInTier = true
+			}
 			policy := polprog.Policy{
-				Name:  polName,
-				Rules: make([]polprog.Rule, len(prules)),
+				Name:   polName,
+				Rules:  make([]polprog.Rule, len(prules)),
+				Staged: staged,
 			}
 
 			for ri, r := range prules {
-				policy.Rules[ri] = polprog.Rule{
-					Rule: r,
+				rule := polprog.Rule{
+					Rule:      r,
+					CounterID: m.counterID(r, staged, direction, ri, tier.Name, polName),
 				}
+				if !staged {
+					// A staged rule never decides the verdict so it has nothing to report
+					// to the flow logs.
+					rule.FlowLogID = m.flowLogID(r, direction, ri, tier.Name, polName)
+				}
+				policy.Rules[ri] = rule
 			}
 
 			polTier.Policies[i] = policy
 		}
 
-		if endTierDrop {
+		if endTierDrop && enforcedPoliciesInTier && tier.DefaultAction != string(v3.Pass) {
 			polTier.EndAction = polprog.TierEndDeny
 		} else {
 			polTier.EndAction = polprog.TierEndPass
@@ -1084,7 +1129,9 @@ func (m *bpfEndpointManager) extractProfiles(profileNames []string, direction Po
 
 			for ri, r := range prules {
 				profile.Rules[ri] = polprog.Rule{
-					Rule: r,
+					Rule:      r,
+					FlowLogID: m.flowLogID(r, direction, ri, rules.FlowLogProfileTier, profName),
+					CounterID: m.counterID(r, false, direction, ri, rules.FlowLogProfileTier, profName),
 				}
 			}
 
@@ -1094,12 +1141,55 @@ func (m *bpfEndpointManager) extractProfiles(profileNames []string, direction Po
 	return
 }
 
-func (m *bpfEndpointManager) extractRules(tier *proto.TierInfo, profileNames []string, direction PolDirection) polprog.Rules {
+// flowLogID returns the ID that the policy program reports, in its policy verdict events, when
+// the given rule decides the verdict; 0 if flow logs are disabled or the rule doesn't decide the
+// verdict.
+func (m *bpfEndpointManager) flowLogID(r *proto.Rule, direction PolDirection, idx int, tier, name string) uint64 {
+	if m.flowLogRuleIDs == nil {
+		return 0
+	}
+	action, ok := rules.FlowLogActionForRule(r.Action)
+	if !ok {
+		return 0
+	}
+	flDirection := rules.FlowLogDirectionIngress
+	if direction == PolDirnEgress {
+		flDirection = rules.FlowLogDirectionEgress
+	}
+	return m.flowLogRuleIDs.Register(rules.FlowLogRule{
+		Action:    action,
+		Direction: flDirection,
+		Index:     idx,
+		Tier:      tier,
+		Name:      name,
+	})
+}
+
+// counterID returns the ID of the packet and byte counters that the policy program updates when
+// the given rule matches; 0 if rule counters are disabled.  The counters of the deny rules of
+// staged policies also count the packets that the policy would have denied.
+func (m *bpfEndpointManager) counterID(r *proto.Rule, staged bool, direction PolDirection, idx int, tier, name string) uint64 {
+	if m.ruleCounterIDs == nil {
+		return 0
+	}
+	ctrDirection := rules.FlowLogDirectionIngress
+	if direction == PolDirnEgress {
+		ctrDirection = rules.FlowLogDirectionEgress
+	}
+	return m.ruleCounterIDs.register(rules.PolicyRuleID{
+		Direction: ctrDirection,
+		Index:     idx,
+		Tier:      tier,
+		Name:      name,
+	}, staged && strings.ToLower(r.Action) == "deny")
+}
+
+func (m *bpfEndpointManager) extractRules(tiers []*proto.TierInfo, profileNames []string, direction PolDirection) polprog.Rules {
 	var r polprog.Rules
 
 	// When there is applicable normal policy that does not explicitly Allow or Deny traffic,
-	// traffic is dropped.
-	r.Tiers = m.extractTiers(tier, direction, EndTierDrop)
+	// traffic is dropped (unless the tier's default action is Pass).
+	r.Tiers = m.extractTiers(tiers, direction, EndTierDrop)
 
 	r.Profiles = m.extractProfiles(profileNames, direction)
 
@@ -1116,16 +1206,16 @@ func (m *bpfEndpointManager) isDataIface(iface string) bool {
 
 func (m *bpfEndpointManager) addWEPToIndexes(wlID proto.WorkloadEndpointID, wl *proto.WorkloadEndpoint) {
 	for _, t := range wl.Tiers {
-		m.addPolicyToEPMappings(t.IngressPolicies, wlID)
-		m.addPolicyToEPMappings(t.EgressPolicies, wlID)
+		m.addPolicyToEPMappings(t.Name, t.IngressPolicies, wlID)
+		m.addPolicyToEPMappings(t.Name, t.EgressPolicies, wlID)
 	}
 	m.addProfileToEPMappings(wl.ProfileIds, wlID)
 }
 
-func (m *bpfEndpointManager) addPolicyToEPMappings(polNames []string, id interface{}) {
+func (m *bpfEndpointManager) addPolicyToEPMappings(tierName string, polNames []string, id interface{}) {
 	for _, pol := range polNames {
 		polID := proto.PolicyID{
-			Tier: "default",
+			Tier: tierName,
 			Name: pol,
 		}
 		if m.policiesToWorkloads[polID] == nil {
@@ -1153,8 +1243,8 @@ func (m *bpfEndpointManager) removeWEPFromIndexes(wlID proto.WorkloadEndpointID,
 	}
 
 	for _, t := range wep.Tiers {
-		m.removePolicyToEPMappings(t.IngressPolicies, wlID)
-		m.removePolicyToEPMappings(t.EgressPolicies, wlID)
+		m.removePolicyToEPMappings(t.Name, t.IngressPolicies, wlID)
+		m.removePolicyToEPMappings(t.Name, t.EgressPolicies, wlID)
 	}
 
 	m.removeProfileToEPMappings(wep.ProfileIds, wlID)
@@ -1165,10 +1255,10 @@ func (m *bpfEndpointManager) removeWEPFromIndexes(wlID proto.WorkloadEndpointID,
 	})
 }
 
-func (m *bpfEndpointManager) removePolicyToEPMappings(polNames []string, id interface{}) {
+func (m *bpfEndpointManager) removePolicyToEPMappings(tierName string, polNames []string, id interface{}) {
 	for _, pol := range polNames {
 		polID := proto.PolicyID{
-			Tier: "default",
+			Tier: tierName,
 			Name: pol,
 		}
 		polSet := m.policiesToWorkloads[polID]
@@ -1276,8 +1366,8 @@ func (m *bpfEndpointManager) OnHEPUpdate(hostIfaceToEpMap map[string]proto.HostE
 func (m *bpfEndpointManager) addHEPToIndexes(ifaceName string, ep *proto.HostEndpoint) {
 	for _, tiers := range [][]*proto.TierInfo{ep.Tiers, ep.UntrackedTiers, ep.PreDnatTiers, ep.ForwardTiers} {
 		for _, t := range tiers {
-			m.addPolicyToEPMappings(t.IngressPolicies, ifaceName)
-			m.addPolicyToEPMappings(t.EgressPolicies, ifaceName)
+			m.addPolicyToEPMappings(t.Name, t.IngressPolicies, ifaceName)
+			m.addPolicyToEPMappings(t.Name, t.EgressPolicies, ifaceName)
 		}
 	}
 	m.addProfileToEPMappings(ep.ProfileIds, ifaceName)
@@ -1286,8 +1376,8 @@ func (m *bpfEndpointManager) addHEPToIndexes(ifaceName string, ep *proto.HostEnd
 func (m *bpfEndpointManager) removeHEPFromIndexes(ifaceName string, ep *proto.HostEndpoint) {
 	for _, tiers := range [][]*proto.TierInfo{ep.Tiers, ep.UntrackedTiers, ep.PreDnatTiers, ep.ForwardTiers} {
 		for _, t := range tiers {
-			m.removePolicyToEPMappings(t.IngressPolicies, ifaceName)
-			m.removePolicyToEPMappings(t.EgressPolicies, ifaceName)
+			m.removePolicyToEPMappings(t.Name, t.IngressPolicies, ifaceName)
+			m.removePolicyToEPMappings(t.Name, t.EgressPolicies, ifaceName)
 		}
 	}
 
@@ -1425,7 +1515,11 @@ func (m *bpfEndpointManager) setJumpMapFD(ap attachPoint, fd bpf.MapFD) {
 }
 
 func (m *bpfEndpointManager) updatePolicyProgram(jumpMapFD bpf.MapFD, rules polprog.Rules) error {
-	pg := polprog.NewBuilder(m.ipSetIDAlloc, m.bpfMapContext.IpsetsMap.MapFD(), m.bpfMapContext.StateMap.MapFD(), jumpMapFD)
+	var opts []polprog.Option
+	if m.ruleCounterIDs != nil {
+		opts = append(opts, polprog.WithRuleCounters(m.bpfMapContext.RuleCountersMap.MapFD()))
+	}
+	pg := polprog.NewBuilder(m.ipSetIDAlloc, m.bpfMapContext.IpsetsMap.MapFD(), m.bpfMapContext.StateMap.MapFD(), jumpMapFD, opts...)
 	insns, err := pg.Instructions(rules)
 	if err != nil {
 		return fmt.Errorf("failed to generate policy bytecode: %w", err)
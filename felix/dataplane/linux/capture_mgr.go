@@ -0,0 +1,643 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/google/gopacket/pcapgo"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/net/bpf"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/felix/ifacemonitor"
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/pcapfilter"
+)
+
+const (
+	// captureSnapLen is the maximum number of bytes captured from each packet.
+	captureSnapLen = 65535
+	// captureStatusInterval is how often the manager checks whether the status of the captures
+	// has changed.
+	captureStatusInterval = 10 * time.Second
+	// captureSizeStatusInterval is how often the manager reports the status of a capture when
+	// only the sizes of its files have changed.  Each report is a write to the datastore, so
+	// growing files are reported much less often than changes of state or files.
+	captureSizeStatusInterval = 2 * time.Minute
+	// pcapFileHeaderLen and pcapPacketHeaderLen are the sizes of the headers of a pcap file and
+	// of each packet in the file.
+	pcapFileHeaderLen   = 24
+	pcapPacketHeaderLen = 16
+)
+
+// errCaptureTimeout is returned by a capture source when no packet arrived within its read
+// timeout.  It allows the capture goroutines to check whether they have been stopped.
+var errCaptureTimeout = errors.New("timed out waiting for a packet")
+
+// captureSource reads the packets that pass its filter from an interface.
+type captureSource interface {
+	// ReadPacket returns the next packet, or errCaptureTimeout if no packet arrives in time.
+	ReadPacket() ([]byte, gopacket.CaptureInfo, error)
+	Close() error
+}
+
+type openCaptureSourceFn func(ifaceName string, filter []bpf.RawInstruction, snapLen int) (captureSource, error)
+
+// captureManager captures the traffic of the local workload endpoints that PacketCapture
+// resources select.
+//
+// The calculation graph sends a PacketCaptureUpdate for each PacketCapture, with its filter and
+// the local endpoints that it selects.  For each of those endpoints, the manager opens a packet
+// socket on the endpoint's interface, with the filter compiled to classic BPF, and writes the
+// packets to pcap files in <CaptureDir>/<namespace>/<name>.  The files are named
+// <interface>-<n>.pcap; once a file reaches CaptureMaxSizeBytes the manager starts the next one,
+// deleting the oldest so that at most CaptureMaxFiles are kept per endpoint.
+//
+// When an endpoint stops being selected, or goes away, its capture stops but its files are kept
+// until the PacketCapture is deleted.  The manager reports the state and files of each capture
+// to the datastore via the status callback when it changes, and periodically while the files
+// grow.
+type captureManager struct {
+	dir      string
+	maxSize  int64
+	maxFiles int
+	// sizeInterval is the minimum interval between reports that only update file sizes.
+	sizeInterval time.Duration
+
+	openSource     openCaptureSourceFn
+	statusCallback func(*proto.PacketCaptureStatusUpdate)
+
+	// pendingUpdates and pendingRemoves hold the updates from the calculation graph that have
+	// not been applied yet.
+	pendingUpdates map[proto.PacketCaptureID]*proto.PacketCaptureUpdate
+	pendingRemoves map[proto.PacketCaptureID]bool
+	// retryFailed is set when an interface comes up, so that the captures that failed to start
+	// are retried.
+	retryFailed bool
+	// cleanedUp is set once the files of PacketCaptures that were deleted while we were down
+	// have been removed.
+	cleanedUp bool
+
+	// lock protects captures, which is read by the status reporting goroutine.
+	lock     sync.Mutex
+	captures map[proto.PacketCaptureID]*packetCapture
+}
+
+// packetCapture is the state of one PacketCapture on this node.
+type packetCapture struct {
+	id     proto.PacketCaptureID
+	dir    string
+	filter string
+	// endpoints holds the captures of the endpoints that the PacketCapture selects, or used to
+	// select, indexed by workload endpoint name.
+	endpoints      map[string]*endpointCapture
+	lastStatus     *proto.PacketCaptureStatusUpdate
+	lastStatusTime time.Time
+}
+
+// endpointCapture captures the traffic of one endpoint for a PacketCapture.  While the
+// capture is running, its goroutine owns the current file and updates the state under the
+// lock.
+type endpointCapture struct {
+	name      string
+	ifaceName string
+	dir       string
+	maxSize   int64
+	maxFiles  int
+
+	lock  sync.Mutex
+	state apiv3.PacketCaptureState
+	err   string
+	files []*proto.PacketCaptureFile
+	// nextSeq is the sequence number of the next file.
+	nextSeq int
+
+	stopC chan struct{}
+	doneC chan struct{}
+}
+
+func newCaptureManager(
+	dpConfig Config,
+	statusCallback func(*proto.PacketCaptureStatusUpdate),
+) *captureManager {
+	return newCaptureManagerWithShims(dpConfig, openAFPacketSource, statusCallback)
+}
+
+func newCaptureManagerWithShims(
+	dpConfig Config,
+	openSource openCaptureSourceFn,
+	statusCallback func(*proto.PacketCaptureStatusUpdate),
+) *captureManager {
+	return &captureManager{
+		dir:            dpConfig.CaptureDir,
+		maxSize:        int64(dpConfig.CaptureMaxSizeBytes),
+		maxFiles:       dpConfig.CaptureMaxFiles,
+		openSource:     openSource,
+		sizeInterval:   captureSizeStatusInterval,
+		statusCallback: statusCallback,
+		pendingUpdates: map[proto.PacketCaptureID]*proto.PacketCaptureUpdate{},
+		pendingRemoves: map[proto.PacketCaptureID]bool{},
+		captures:       map[proto.PacketCaptureID]*packetCapture{},
+	}
+}
+
+func (m *captureManager) OnUpdate(msg interface{}) {
+	switch msg := msg.(type) {
+	case *proto.PacketCaptureUpdate:
+		log.WithField("msg", msg).Debug("Packet capture update")
+		delete(m.pendingRemoves, *msg.Id)
+		m.pendingUpdates[*msg.Id] = msg
+	case *proto.PacketCaptureRemove:
+		log.WithField("msg", msg).Debug("Packet capture removed")
+		delete(m.pendingUpdates, *msg.Id)
+		m.pendingRemoves[*msg.Id] = true
+	case *ifaceUpdate:
+		if msg.State == ifacemonitor.StateUp {
+			m.retryFailed = true
+		}
+	}
+}
+
+func (m *captureManager) CompleteDeferredWork() error {
+	if len(m.pendingUpdates) == 0 && len(m.pendingRemoves) == 0 && !m.retryFailed && m.cleanedUp {
+		return nil
+	}
+
+	m.lock.Lock()
+	for id := range m.pendingRemoves {
+		m.removeCapture(id)
+	}
+	for id, upd := range m.pendingUpdates {
+		m.updateCapture(id, upd)
+	}
+	if m.retryFailed {
+		for _, c := range m.captures {
+			m.retryFailedEndpoints(c)
+		}
+	}
+	m.lock.Unlock()
+
+	if !m.cleanedUp {
+		// CompleteDeferredWork is only called once we're in sync so any capture directory
+		// that we don't know about belongs to a PacketCapture that has been deleted.
+		m.removeUnknownCaptureDirs()
+		m.cleanedUp = true
+	}
+
+	m.pendingUpdates = map[proto.PacketCaptureID]*proto.PacketCaptureUpdate{}
+	m.pendingRemoves = map[proto.PacketCaptureID]bool{}
+	m.retryFailed = false
+
+	// Report changes of state promptly, rather than waiting for the next tick.
+	m.reportStatus()
+	return nil
+}
+
+func (m *captureManager) updateCapture(id proto.PacketCaptureID, upd *proto.PacketCaptureUpdate) {
+	c := m.captures[id]
+	if c == nil {
+		c = &packetCapture{
+			id:        id,
+			dir:       filepath.Join(m.dir, id.Namespace, id.Name),
+			endpoints: map[string]*endpointCapture{},
+		}
+		m.captures[id] = c
+	}
+	if c.filter != upd.Filter {
+		// Restart the running captures with the new filter.
+		for _, ep := range c.endpoints {
+			ep.stop()
+		}
+		c.filter = upd.Filter
+	}
+
+	selected := map[string]bool{}
+	for _, pep := range upd.Endpoints {
+		selected[pep.Name] = true
+		ep := c.endpoints[pep.Name]
+		if ep != nil && ep.ifaceName != pep.InterfaceName {
+			ep.stop()
+			ep = nil
+		}
+		if ep == nil {
+			ep = &endpointCapture{
+				name:      pep.Name,
+				ifaceName: pep.InterfaceName,
+				dir:       c.dir,
+				maxSize:   m.maxSize,
+				maxFiles:  m.maxFiles,
+			}
+			c.endpoints[pep.Name] = ep
+		}
+		if !ep.running() {
+			m.startEndpointCapture(c, ep)
+		}
+	}
+	for name, ep := range c.endpoints {
+		if !selected[name] {
+			ep.stop()
+		}
+	}
+}
+
+func (m *captureManager) retryFailedEndpoints(c *packetCapture) {
+	for _, ep := range c.endpoints {
+		if ep.currentState() == apiv3.PacketCaptureStateError {
+			m.startEndpointCapture(c, ep)
+		}
+	}
+}
+
+func (m *captureManager) startEndpointCapture(c *packetCapture, ep *endpointCapture) {
+	logCxt := log.WithFields(log.Fields{
+		"capture":  c.id,
+		"endpoint": ep.name,
+		"iface":    ep.ifaceName,
+	})
+	filter, err := compileCaptureFilter(c.filter)
+	if err != nil {
+		logCxt.WithError(err).Warn("Failed to compile packet capture filter")
+		ep.setStopped(apiv3.PacketCaptureStateError, err)
+		return
+	}
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		logCxt.WithError(err).Warn("Failed to create packet capture directory")
+		ep.setStopped(apiv3.PacketCaptureStateError, err)
+		return
+	}
+	source, err := m.openSource(ep.ifaceName, filter, captureSnapLen)
+	if err != nil {
+		logCxt.WithError(err).Warn("Failed to start packet capture")
+		ep.setStopped(apiv3.PacketCaptureStateError, err)
+		return
+	}
+	logCxt.Info("Starting packet capture")
+	ep.start(source)
+}
+
+func (m *captureManager) removeCapture(id proto.PacketCaptureID) {
+	c := m.captures[id]
+	if c == nil {
+		return
+	}
+	log.WithField("capture", id).Info("Packet capture deleted, removing its files")
+	for _, ep := range c.endpoints {
+		ep.signalStop()
+	}
+	for _, ep := range c.endpoints {
+		ep.stop()
+	}
+	removeCaptureFiles(c.dir)
+	delete(m.captures, id)
+}
+
+// removeUnknownCaptureDirs removes the files of the PacketCaptures that we don't know about.
+func (m *captureManager) removeUnknownCaptureDirs() {
+	namespaces, err := ioutil.ReadDir(m.dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.WithError(err).Warn("Failed to list packet capture directory")
+		}
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, ns := range namespaces {
+		if !ns.IsDir() {
+			continue
+		}
+		names, err := ioutil.ReadDir(filepath.Join(m.dir, ns.Name()))
+		if err != nil {
+			log.WithError(err).Warn("Failed to list packet capture directory")
+			continue
+		}
+		for _, n := range names {
+			id := proto.PacketCaptureID{Namespace: ns.Name(), Name: n.Name()}
+			if !n.IsDir() || m.captures[id] != nil {
+				continue
+			}
+			log.WithField("capture", id).Info("Removing files of deleted packet capture")
+			removeCaptureFiles(filepath.Join(m.dir, ns.Name(), n.Name()))
+		}
+	}
+}
+
+// removeCaptureFiles removes the pcap files in a capture directory and then the directory, and
+// its parent, if they are empty.  Only pcap files are removed in case the directory has been
+// misconfigured.
+func removeCaptureFiles(dir string) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.pcap"))
+	if err != nil {
+		log.WithError(err).WithField("dir", dir).Warn("Failed to list packet capture files")
+		return
+	}
+	for _, f := range files {
+		if err := os.Remove(f); err != nil {
+			log.WithError(err).WithField("file", f).Warn("Failed to remove packet capture file")
+		}
+	}
+	if err := os.Remove(dir); err == nil {
+		_ = os.Remove(filepath.Dir(dir))
+	}
+}
+
+// loopReportingStatus periodically reports the status of the captures that have changed.
+func (m *captureManager) loopReportingStatus() {
+	for range time.NewTicker(captureStatusInterval).C {
+		m.reportStatus()
+	}
+}
+
+// reportStatus reports the status of each capture that has changed since it was last reported.
+// Changes that only affect the sizes of the files are held back until sizeInterval has
+// passed since the last report.
+func (m *captureManager) reportStatus() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, c := range m.captures {
+		if len(c.endpoints) == 0 && c.lastStatus == nil {
+			// The capture has never selected any of our endpoints.
+			continue
+		}
+		status := &proto.PacketCaptureStatusUpdate{
+			Id:        &proto.PacketCaptureID{Namespace: c.id.Namespace, Name: c.id.Name},
+			Directory: c.dir,
+		}
+		for _, ep := range c.endpoints {
+			status.Endpoints = append(status.Endpoints, ep.status())
+		}
+		sort.Slice(status.Endpoints, func(i, j int) bool {
+			return status.Endpoints[i].Name < status.Endpoints[j].Name
+		})
+		if reflect.DeepEqual(status, c.lastStatus) {
+			continue
+		}
+		if onlySizesChanged(status, c.lastStatus) && time.Since(c.lastStatusTime) < m.sizeInterval {
+			continue
+		}
+		log.WithField("status", status).Debug("Packet capture status changed")
+		c.lastStatus = status
+		c.lastStatusTime = time.Now()
+		m.statusCallback(status)
+	}
+}
+
+// onlySizesChanged returns true if the two statuses differ only in the sizes of the files.
+func onlySizesChanged(status, last *proto.PacketCaptureStatusUpdate) bool {
+	if last == nil || status.Directory != last.Directory || len(status.Endpoints) != len(last.Endpoints) {
+		return false
+	}
+	for i, ep := range status.Endpoints {
+		lastEp := last.Endpoints[i]
+		if ep.Name != lastEp.Name || ep.InterfaceName != lastEp.InterfaceName || ep.State != lastEp.State ||
+			ep.Error != lastEp.Error || len(ep.Files) != len(lastEp.Files) {
+			return false
+		}
+		for j, f := range ep.Files {
+			if f.Name != lastEp.Files[j].Name {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func compileCaptureFilter(filter string) ([]bpf.RawInstruction, error) {
+	expr, err := pcapfilter.Parse(filter)
+	if err != nil {
+		return nil, err
+	}
+	if expr == nil {
+		return nil, nil
+	}
+	return pcapfilter.Compile(expr, captureSnapLen)
+}
+
+func (ep *endpointCapture) running() bool {
+	return ep.stopC != nil
+}
+
+func (ep *endpointCapture) currentState() apiv3.PacketCaptureState {
+	ep.lock.Lock()
+	defer ep.lock.Unlock()
+	return ep.state
+}
+
+func (ep *endpointCapture) setStopped(state apiv3.PacketCaptureState, err error) {
+	ep.lock.Lock()
+	defer ep.lock.Unlock()
+	ep.state = state
+	ep.err = ""
+	if err != nil {
+		ep.err = err.Error()
+	}
+}
+
+func (ep *endpointCapture) status() *proto.PacketCaptureEndpointStatus {
+	ep.lock.Lock()
+	defer ep.lock.Unlock()
+	status := &proto.PacketCaptureEndpointStatus{
+		Name:          ep.name,
+		InterfaceName: ep.ifaceName,
+		State:         string(ep.state),
+		Error:         ep.err,
+	}
+	for _, f := range ep.files {
+		status.Files = append(status.Files, &proto.PacketCaptureFile{Name: f.Name, SizeBytes: f.SizeBytes})
+	}
+	return status
+}
+
+func (ep *endpointCapture) start(source captureSource) {
+	ep.loadExistingFiles()
+	ep.setStopped(apiv3.PacketCaptureStateCapturing, nil)
+	ep.stopC = make(chan struct{})
+	ep.doneC = make(chan struct{})
+	go ep.loopCapturing(source, ep.stopC, ep.doneC)
+}
+
+// signalStop asks the capture goroutine to stop without waiting for it.
+func (ep *endpointCapture) signalStop() {
+	if ep.stopC == nil {
+		return
+	}
+	select {
+	case <-ep.stopC:
+	default:
+		close(ep.stopC)
+	}
+}
+
+// stop stops the capture goroutine, if it is running, and waits for it to finish.
+func (ep *endpointCapture) stop() {
+	if ep.stopC == nil {
+		return
+	}
+	ep.signalStop()
+	<-ep.doneC
+	ep.stopC = nil
+	ep.doneC = nil
+}
+
+// loadExistingFiles picks up the files of an earlier capture of the endpoint, for example, from
+// before Felix restarted, so that they are counted towards the limit on the number of files.
+func (ep *endpointCapture) loadExistingFiles() {
+	ep.lock.Lock()
+	defer ep.lock.Unlock()
+	if len(ep.files) > 0 {
+		return
+	}
+	paths, err := filepath.Glob(filepath.Join(ep.dir, ep.ifaceName+"-*.pcap"))
+	if err != nil {
+		return
+	}
+	seqs := map[int]os.FileInfo{}
+	for _, p := range paths {
+		name := filepath.Base(p)
+		seq, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, ep.ifaceName+"-"), ".pcap"))
+		if err != nil {
+			continue
+		}
+		info, err := os.Stat(p)
+		if err != nil {
+			continue
+		}
+		seqs[seq] = info
+	}
+	var sorted []int
+	for seq := range seqs {
+		sorted = append(sorted, seq)
+	}
+	sort.Ints(sorted)
+	for _, seq := range sorted {
+		ep.files = append(ep.files, &proto.PacketCaptureFile{Name: seqs[seq].Name(), SizeBytes: seqs[seq].Size()})
+		ep.nextSeq = seq + 1
+	}
+}
+
+func (ep *endpointCapture) loopCapturing(source captureSource, stopC <-chan struct{}, doneC chan<- struct{}) {
+	defer close(doneC)
+	defer func() {
+		if err := source.Close(); err != nil {
+			log.WithError(err).WithField("iface", ep.ifaceName).Warn("Failed to close packet capture socket")
+		}
+	}()
+
+	var file *os.File
+	var writer *pcapgo.Writer
+	closeFile := func() {
+		if file == nil {
+			return
+		}
+		if err := file.Close(); err != nil {
+			log.WithError(err).WithField("file", file.Name()).Warn("Failed to close packet capture file")
+		}
+		file = nil
+	}
+	defer closeFile()
+
+	for {
+		select {
+		case <-stopC:
+			log.WithField("iface", ep.ifaceName).Info("Stopping packet capture")
+			ep.setStopped(apiv3.PacketCaptureStateStopped, nil)
+			return
+		default:
+		}
+
+		data, ci, err := source.ReadPacket()
+		if err == errCaptureTimeout {
+			continue
+		} else if err != nil {
+			log.WithError(err).WithField("iface", ep.ifaceName).Warn("Packet capture failed")
+			ep.setStopped(apiv3.PacketCaptureStateError, err)
+			return
+		}
+
+		if file == nil || ep.needsRotation(int64(pcapPacketHeaderLen+len(data))) {
+			closeFile()
+			file, writer, err = ep.openNextFile()
+			if err != nil {
+				log.WithError(err).WithField("iface", ep.ifaceName).Warn("Failed to create packet capture file")
+				ep.setStopped(apiv3.PacketCaptureStateError, err)
+				return
+			}
+		}
+		if err := writer.WritePacket(ci, data); err != nil {
+			log.WithError(err).WithField("file", file.Name()).Warn("Failed to write packet capture file")
+			ep.setStopped(apiv3.PacketCaptureStateError, err)
+			return
+		}
+		ep.addToCurrentFile(int64(pcapPacketHeaderLen + len(data)))
+	}
+}
+
+// needsRotation returns true if writing the given number of bytes would take the current file
+// over the size limit.  A file always gets at least one packet.
+func (ep *endpointCapture) needsRotation(n int64) bool {
+	ep.lock.Lock()
+	defer ep.lock.Unlock()
+	current := ep.files[len(ep.files)-1]
+	return current.SizeBytes > pcapFileHeaderLen && current.SizeBytes+n > ep.maxSize
+}
+
+func (ep *endpointCapture) addToCurrentFile(n int64) {
+	ep.lock.Lock()
+	defer ep.lock.Unlock()
+	ep.files[len(ep.files)-1].SizeBytes += n
+}
+
+// openNextFile starts the next capture file and removes the oldest files if there are too many.
+func (ep *endpointCapture) openNextFile() (*os.File, *pcapgo.Writer, error) {
+	ep.lock.Lock()
+	defer ep.lock.Unlock()
+
+	name := fmt.Sprintf("%s-%d.pcap", ep.ifaceName, ep.nextSeq)
+	file, err := os.OpenFile(filepath.Join(ep.dir, name), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, nil, err
+	}
+	writer := pcapgo.NewWriter(file)
+	if err := writer.WriteFileHeader(captureSnapLen, layers.LinkTypeEthernet); err != nil {
+		_ = file.Close()
+		return nil, nil, err
+	}
+	ep.nextSeq++
+	ep.files = append(ep.files, &proto.PacketCaptureFile{Name: name, SizeBytes: pcapFileHeaderLen})
+
+	for len(ep.files) > ep.maxFiles {
+		oldest := filepath.Join(ep.dir, ep.files[0].Name)
+		if err := os.Remove(oldest); err != nil && !os.IsNotExist(err) {
+			log.WithError(err).WithField("file", oldest).Warn("Failed to remove old packet capture file")
+		}
+		ep.files = ep.files[1:]
+	}
+	return file, writer, nil
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"time"
+	"unsafe"
+
+	"github.com/google/gopacket"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/net/bpf"
+	"golang.org/x/sys/unix"
+)
+
+// captureReadTimeout bounds how long a read from a capture socket blocks, so that the capture
+// goroutine notices promptly when it is stopped.
+const captureReadTimeout = 200 * time.Millisecond
+
+// afPacketSource captures packets from an interface using an AF_PACKET socket, which avoids
+// depending on libpcap.
+type afPacketSource struct {
+	fd      int
+	ifIndex int
+	buf     []byte
+}
+
+func openAFPacketSource(ifaceName string, filter []bpf.RawInstruction, snapLen int) (captureSource, error) {
+	iface, err := net.InterfaceByName(ifaceName)
+	if err != nil {
+		return nil, err
+	}
+	// Open the socket without a protocol, so that it receives nothing until it is bound, and
+	// attach the filter before binding so that no unfiltered packets are queued.
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open packet socket: %w", err)
+	}
+	s := &afPacketSource{fd: fd, ifIndex: iface.Index, buf: make([]byte, snapLen)}
+	if err := s.configure(filter); err != nil {
+		_ = unix.Close(fd)
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *afPacketSource) configure(filter []bpf.RawInstruction) error {
+	if len(filter) > 0 {
+		prog := unix.SockFprog{
+			Len:    uint16(len(filter)),
+			Filter: (*unix.SockFilter)(unsafe.Pointer(&filter[0])),
+		}
+		if err := unix.SetsockoptSockFprog(s.fd, unix.SOL_SOCKET, unix.SO_ATTACH_FILTER, &prog); err != nil {
+			return fmt.Errorf("failed to attach packet filter: %w", err)
+		}
+	}
+	tv := unix.NsecToTimeval(captureReadTimeout.Nanoseconds())
+	if err := unix.SetsockoptTimeval(s.fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &tv); err != nil {
+		return fmt.Errorf("failed to set packet socket timeout: %w", err)
+	}
+	addr := &unix.SockaddrLinklayer{Protocol: htons(unix.ETH_P_ALL), Ifindex: s.ifIndex}
+	if err := unix.Bind(s.fd, addr); err != nil {
+		return fmt.Errorf("failed to bind packet socket: %w", err)
+	}
+	return nil
+}
+
+func (s *afPacketSource) ReadPacket() ([]byte, gopacket.CaptureInfo, error) {
+	// MSG_TRUNC makes Recvfrom return the length of the packet, rather than the length that
+	// was read, so that the capture records the original length of truncated packets.
+	n, _, err := unix.Recvfrom(s.fd, s.buf, unix.MSG_TRUNC)
+	if err == unix.EAGAIN || err == unix.EINTR {
+		return nil, gopacket.CaptureInfo{}, errCaptureTimeout
+	} else if err != nil {
+		return nil, gopacket.CaptureInfo{}, err
+	}
+	captured := n
+	if captured > len(s.buf) {
+		captured = len(s.buf)
+	}
+	data := make([]byte, captured)
+	copy(data, s.buf[:captured])
+	ci := gopacket.CaptureInfo{
+		Timestamp:      time.Now(),
+		CaptureLength:  captured,
+		Length:         n,
+		InterfaceIndex: s.ifIndex,
+	}
+	return data, ci, nil
+}
+
+func (s *afPacketSource) Close() error {
+	return unix.Close(s.fd)
+}
+
+// htons converts v from host to network byte order.
+func htons(v uint16) uint16 {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], v)
+	return nl.NativeEndian().Uint16(b[:])
+}
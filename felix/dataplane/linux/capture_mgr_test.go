@@ -0,0 +1,305 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/gopacket"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/bpf"
+
+	"github.com/projectcalico/calico/felix/ifacemonitor"
+	"github.com/projectcalico/calico/felix/proto"
+)
+
+var _ = Describe("Capture manager", func() {
+	var (
+		mgr      *captureManager
+		dir      string
+		sources  map[string]*mockCaptureSource
+		filters  map[string][]bpf.RawInstruction
+		openErr  error
+		statuses map[proto.PacketCaptureID]*proto.PacketCaptureStatusUpdate
+		lock     sync.Mutex
+	)
+
+	id := proto.PacketCaptureID{Namespace: "ns1", Name: "pc1"}
+	endpoint := func(name, iface string) *proto.PacketCaptureEndpoint {
+		return &proto.PacketCaptureEndpoint{Name: name, InterfaceName: iface}
+	}
+	update := func(filter string, eps ...*proto.PacketCaptureEndpoint) {
+		mgr.OnUpdate(&proto.PacketCaptureUpdate{
+			Id:        &proto.PacketCaptureID{Namespace: id.Namespace, Name: id.Name},
+			Filter:    filter,
+			Endpoints: eps,
+		})
+		Expect(mgr.CompleteDeferredWork()).To(Succeed())
+	}
+	// endpointStatus reports the current status and returns that of the named endpoint.
+	endpointStatus := func(name string) func() *proto.PacketCaptureEndpointStatus {
+		return func() *proto.PacketCaptureEndpointStatus {
+			mgr.reportStatus()
+			lock.Lock()
+			defer lock.Unlock()
+			if statuses[id] == nil {
+				return nil
+			}
+			for _, ep := range statuses[id].Endpoints {
+				if ep.Name == name {
+					return ep
+				}
+			}
+			return nil
+		}
+	}
+	captureDir := func() string {
+		return filepath.Join(dir, "ns1", "pc1")
+	}
+	pcapFiles := func() []string {
+		files, err := filepath.Glob(filepath.Join(captureDir(), "*.pcap"))
+		Expect(err).NotTo(HaveOccurred())
+		var names []string
+		for _, f := range files {
+			names = append(names, filepath.Base(f))
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "capture-mgr-test")
+		Expect(err).NotTo(HaveOccurred())
+		sources = map[string]*mockCaptureSource{}
+		filters = map[string][]bpf.RawInstruction{}
+		openErr = nil
+		statuses = map[proto.PacketCaptureID]*proto.PacketCaptureStatusUpdate{}
+
+		mgr = newCaptureManagerWithShims(
+			Config{
+				CaptureDir:          dir,
+				CaptureMaxSizeBytes: 24 + 2*(16+100),
+				CaptureMaxFiles:     2,
+			},
+			func(iface string, filter []bpf.RawInstruction, snapLen int) (captureSource, error) {
+				lock.Lock()
+				defer lock.Unlock()
+				if openErr != nil {
+					return nil, openErr
+				}
+				s := newMockCaptureSource()
+				sources[iface] = s
+				filters[iface] = filter
+				return s, nil
+			},
+			func(status *proto.PacketCaptureStatusUpdate) {
+				lock.Lock()
+				defer lock.Unlock()
+				statuses[*status.Id] = status
+			},
+		)
+		mgr.sizeInterval = 0
+	})
+
+	AfterEach(func() {
+		mgr.OnUpdate(&proto.PacketCaptureRemove{Id: &proto.PacketCaptureID{Namespace: id.Namespace, Name: id.Name}})
+		Expect(mgr.CompleteDeferredWork()).To(Succeed())
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("should capture the traffic of the selected endpoints", func() {
+		update("tcp port 80", endpoint("wep1", "cali1"))
+		Expect(sources).To(HaveKey("cali1"))
+		Expect(filters["cali1"]).NotTo(BeEmpty())
+
+		sources["cali1"].packets <- make([]byte, 100)
+		Eventually(endpointStatus("wep1")).Should(Equal(&proto.PacketCaptureEndpointStatus{
+			Name:          "wep1",
+			InterfaceName: "cali1",
+			State:         "Capturing",
+			Files:         []*proto.PacketCaptureFile{{Name: "cali1-0.pcap", SizeBytes: 24 + 16 + 100}},
+		}))
+		Expect(statuses[id].Directory).To(Equal(captureDir()))
+		Expect(pcapFiles()).To(ConsistOf("cali1-0.pcap"))
+	})
+
+	It("should hold back status reports that only change file sizes", func() {
+		update("", endpoint("wep1", "cali1"))
+		sources["cali1"].packets <- make([]byte, 100)
+		Eventually(endpointStatus("wep1")).Should(Equal(&proto.PacketCaptureEndpointStatus{
+			Name:          "wep1",
+			InterfaceName: "cali1",
+			State:         "Capturing",
+			Files:         []*proto.PacketCaptureFile{{Name: "cali1-0.pcap", SizeBytes: 24 + 16 + 100}},
+		}))
+
+		mgr.sizeInterval = time.Hour
+		sources["cali1"].packets <- make([]byte, 100)
+		Eventually(func() int64 {
+			info, err := os.Stat(filepath.Join(captureDir(), "cali1-0.pcap"))
+			if err != nil {
+				return 0
+			}
+			return info.Size()
+		}).Should(Equal(int64(24 + 2*(16+100))))
+		Expect(endpointStatus("wep1")().Files).To(Equal([]*proto.PacketCaptureFile{
+			{Name: "cali1-0.pcap", SizeBytes: 24 + 16 + 100},
+		}))
+
+		By("reporting a change of state straight away")
+		update("")
+		Expect(endpointStatus("wep1")()).To(Equal(&proto.PacketCaptureEndpointStatus{
+			Name:          "wep1",
+			InterfaceName: "cali1",
+			State:         "Stopped",
+			Files:         []*proto.PacketCaptureFile{{Name: "cali1-0.pcap", SizeBytes: 24 + 2*(16+100)}},
+		}))
+	})
+
+	It("should rotate the capture files", func() {
+		update("", endpoint("wep1", "cali1"))
+		Expect(filters["cali1"]).To(BeNil())
+		for i := 0; i < 5; i++ {
+			sources["cali1"].packets <- make([]byte, 100)
+		}
+
+		Eventually(endpointStatus("wep1")).Should(Equal(&proto.PacketCaptureEndpointStatus{
+			Name:          "wep1",
+			InterfaceName: "cali1",
+			State:         "Capturing",
+			Files: []*proto.PacketCaptureFile{
+				{Name: "cali1-1.pcap", SizeBytes: 24 + 2*(16+100)},
+				{Name: "cali1-2.pcap", SizeBytes: 24 + 16 + 100},
+			},
+		}))
+		Expect(pcapFiles()).To(ConsistOf("cali1-1.pcap", "cali1-2.pcap"))
+	})
+
+	It("should stop capturing, but keep the files, of endpoints that are no longer selected", func() {
+		update("", endpoint("wep1", "cali1"), endpoint("wep2", "cali2"))
+		sources["cali1"].packets <- make([]byte, 100)
+		Eventually(pcapFiles).Should(HaveLen(1))
+
+		update("", endpoint("wep2", "cali2"))
+		Expect(sources["cali1"].isClosed()).To(BeTrue())
+		Expect(endpointStatus("wep1")()).To(Equal(&proto.PacketCaptureEndpointStatus{
+			Name:          "wep1",
+			InterfaceName: "cali1",
+			State:         "Stopped",
+			Files:         []*proto.PacketCaptureFile{{Name: "cali1-0.pcap", SizeBytes: 24 + 16 + 100}},
+		}))
+		Expect(endpointStatus("wep2")().State).To(Equal("Capturing"))
+		Expect(pcapFiles()).To(ConsistOf("cali1-0.pcap"))
+
+		By("continuing the sequence of files when the endpoint is selected again")
+		update("", endpoint("wep1", "cali1"), endpoint("wep2", "cali2"))
+		sources["cali1"].packets <- make([]byte, 100)
+		Eventually(pcapFiles).Should(ConsistOf("cali1-0.pcap", "cali1-1.pcap"))
+	})
+
+	It("should remove the files when the capture is deleted", func() {
+		update("", endpoint("wep1", "cali1"))
+		sources["cali1"].packets <- make([]byte, 100)
+		Eventually(pcapFiles).Should(HaveLen(1))
+
+		mgr.OnUpdate(&proto.PacketCaptureRemove{Id: &proto.PacketCaptureID{Namespace: id.Namespace, Name: id.Name}})
+		Expect(mgr.CompleteDeferredWork()).To(Succeed())
+		Expect(sources["cali1"].isClosed()).To(BeTrue())
+		_, err := os.Stat(filepath.Join(dir, "ns1"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("should report an invalid filter", func() {
+		update("tcp port", endpoint("wep1", "cali1"))
+		Expect(sources).To(BeEmpty())
+		status := endpointStatus("wep1")()
+		Expect(status.State).To(Equal("Error"))
+		Expect(status.Error).To(ContainSubstring("requires a port"))
+	})
+
+	It("should retry failed captures when an interface comes up", func() {
+		openErr = errors.New("no such interface")
+		update("", endpoint("wep1", "cali1"))
+		Expect(endpointStatus("wep1")().State).To(Equal("Error"))
+
+		openErr = nil
+		mgr.OnUpdate(&ifaceUpdate{Name: "cali1", State: ifacemonitor.StateUp, Index: 10})
+		Expect(mgr.CompleteDeferredWork()).To(Succeed())
+		Expect(sources).To(HaveKey("cali1"))
+		Expect(endpointStatus("wep1")()).To(Equal(&proto.PacketCaptureEndpointStatus{
+			Name:          "wep1",
+			InterfaceName: "cali1",
+			State:         "Capturing",
+		}))
+	})
+
+	It("should remove the pcap files of unknown captures at start of day", func() {
+		oldDir := filepath.Join(dir, "ns2", "old")
+		Expect(os.MkdirAll(oldDir, 0700)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(oldDir, "cali9-0.pcap"), nil, 0600)).To(Succeed())
+		otherDir := filepath.Join(dir, "ns3", "other")
+		Expect(os.MkdirAll(otherDir, 0700)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(otherDir, "notes.txt"), nil, 0600)).To(Succeed())
+
+		update("", endpoint("wep1", "cali1"))
+		_, err := os.Stat(filepath.Join(dir, "ns2"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+		Expect(filepath.Join(otherDir, "notes.txt")).To(BeAnExistingFile())
+		Expect(captureDir()).To(BeADirectory())
+	})
+})
+
+type mockCaptureSource struct {
+	packets chan []byte
+
+	lock   sync.Mutex
+	closed bool
+}
+
+func newMockCaptureSource() *mockCaptureSource {
+	return &mockCaptureSource{packets: make(chan []byte, 10)}
+}
+
+func (s *mockCaptureSource) ReadPacket() ([]byte, gopacket.CaptureInfo, error) {
+	select {
+	case data := <-s.packets:
+		return data, gopacket.CaptureInfo{
+			Timestamp:     time.Now(),
+			CaptureLength: len(data),
+			Length:        len(data),
+		}, nil
+	case <-time.After(10 * time.Millisecond):
+		return nil, gopacket.CaptureInfo{}, errCaptureTimeout
+	}
+}
+
+func (s *mockCaptureSource) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.closed = true
+	return nil
+}
+
+func (s *mockCaptureSource) isClosed() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.closed
+}
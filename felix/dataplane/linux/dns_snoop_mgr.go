@@ -0,0 +1,123 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/felix/rules"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+)
+
+const featureDomainRules = "domain-rules"
+
+// dnsSnoopManager keeps the DNS snooping chain, which copies the DNS responses sent to local
+// workloads to Felix, active only while there are policy rules that match on domain names.
+// Domain names in policy rules reach the dataplane as domain IP sets, so it tracks those.
+//
+// When the dataplane can't snoop on DNS responses, it warns while domain rules are in use
+// instead, since they match no traffic.
+type dnsSnoopManager struct {
+	ipVersion    uint8
+	filterTable  iptablesTable
+	ruleRenderer rules.RuleRenderer
+
+	// unsupportedReason explains why the dataplane can't snoop on DNS responses; empty if it
+	// can.
+	unsupportedReason string
+	// warnings, if non-nil, receives the warning about domain rules that can't be honoured.
+	warnings *featureWarnings
+
+	domainSetIDs set.Set
+	// chainProgrammed and chainActive record the state of the DNS snooping chain that we've
+	// sent to the filter table.
+	chainProgrammed bool
+	chainActive     bool
+	dirty           bool
+
+	logCxt *log.Entry
+}
+
+func newDNSSnoopManager(
+	filterTable iptablesTable,
+	ruleRenderer rules.RuleRenderer,
+	ipVersion uint8,
+	unsupportedReason string,
+	warnings *featureWarnings,
+) *dnsSnoopManager {
+	return &dnsSnoopManager{
+		ipVersion:         ipVersion,
+		filterTable:       filterTable,
+		ruleRenderer:      ruleRenderer,
+		unsupportedReason: unsupportedReason,
+		warnings:          warnings,
+		domainSetIDs:      set.New(),
+		dirty:             true,
+		logCxt:            log.WithField("ipVersion", ipVersion),
+	}
+}
+
+func (m *dnsSnoopManager) OnUpdate(msg interface{}) {
+	switch msg := msg.(type) {
+	case *proto.IPSetUpdate:
+		if msg.Type == proto.IPSetUpdate_DOMAIN {
+			if !m.domainSetIDs.Contains(msg.Id) {
+				m.domainSetIDs.Add(msg.Id)
+				m.dirty = true
+			}
+		} else if m.domainSetIDs.Contains(msg.Id) {
+			m.domainSetIDs.Discard(msg.Id)
+			m.dirty = true
+		}
+	case *proto.IPSetRemove:
+		if m.domainSetIDs.Contains(msg.Id) {
+			m.domainSetIDs.Discard(msg.Id)
+			m.dirty = true
+		}
+	}
+}
+
+func (m *dnsSnoopManager) CompleteDeferredWork() error {
+	if !m.dirty {
+		return nil
+	}
+	m.dirty = false
+	inUse := m.domainSetIDs.Len() > 0
+
+	if m.unsupportedReason != "" {
+		if m.warnings == nil {
+			return nil
+		}
+		if !inUse {
+			m.warnings.Set(featureDomainRules, "")
+			return nil
+		}
+		warning := "policy rules that match on domain names match no traffic: " + m.unsupportedReason
+		m.logCxt.WithField("numDomainSets", m.domainSetIDs.Len()).Warn(
+			"Policy rules match on domain names but " + m.unsupportedReason + "; the rules will not match any traffic.")
+		m.warnings.Set(featureDomainRules, warning)
+		return nil
+	}
+
+	if m.chainProgrammed && inUse == m.chainActive {
+		return nil
+	}
+	m.logCxt.WithField("active", inUse).Info("Domain rules changed, updating DNS snooping chain")
+	m.filterTable.UpdateChain(m.ruleRenderer.DNSSnoopChain(inUse))
+	m.chainProgrammed = true
+	m.chainActive = inUse
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/ipsets"
+	"github.com/projectcalico/calico/felix/iptables"
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/felix/rules"
+)
+
+var _ = Describe("DNS snoop manager", func() {
+	var (
+		filterTable  *mockTable
+		ruleRenderer rules.RuleRenderer
+		warnings     *featureWarnings
+	)
+
+	BeforeEach(func() {
+		filterTable = newMockTable("filter")
+		ruleRenderer = rules.NewRenderer(rules.Config{
+			IPSetConfigV4: ipsets.NewIPVersionConfig(
+				ipsets.IPFamilyV4,
+				"cali",
+				nil,
+				nil,
+			),
+			IptablesMarkPass:     0x1,
+			IptablesMarkAccept:   0x2,
+			IptablesMarkScratch0: 0x4,
+			IptablesMarkScratch1: 0x8,
+			IptablesMarkEndpoint: 0x11110000,
+		})
+		warnings = newFeatureWarnings()
+	})
+
+	addDomainSet := func(m *dnsSnoopManager) {
+		m.OnUpdate(&proto.IPSetUpdate{
+			Id:      "d:abcdef",
+			Members: []string{"example.com"},
+			Type:    proto.IPSetUpdate_DOMAIN,
+		})
+		Expect(m.CompleteDeferredWork()).To(Succeed())
+	}
+	removeDomainSet := func(m *dnsSnoopManager) {
+		m.OnUpdate(&proto.IPSetRemove{Id: "d:abcdef"})
+		Expect(m.CompleteDeferredWork()).To(Succeed())
+	}
+
+	Describe("with DNS snooping supported", func() {
+		var m *dnsSnoopManager
+
+		BeforeEach(func() {
+			m = newDNSSnoopManager(filterTable, ruleRenderer, 4, "", warnings)
+			Expect(m.CompleteDeferredWork()).To(Succeed())
+		})
+
+		It("should program an inactive chain on startup", func() {
+			filterTable.checkChains([][]*iptables.Chain{{{Name: "cali-dns-snoop"}}})
+		})
+
+		It("should only activate the chain while domain sets are in use", func() {
+			m.OnUpdate(&proto.IPSetUpdate{Id: "s:abcdef", Type: proto.IPSetUpdate_IP})
+			Expect(m.CompleteDeferredWork()).To(Succeed())
+			filterTable.checkChains([][]*iptables.Chain{{{Name: "cali-dns-snoop"}}})
+
+			addDomainSet(m)
+			filterTable.checkChains([][]*iptables.Chain{{{
+				Name:  "cali-dns-snoop",
+				Rules: []iptables.Rule{{Action: iptables.NflogAction{Group: 3}}},
+			}}})
+
+			removeDomainSet(m)
+			filterTable.checkChains([][]*iptables.Chain{{{Name: "cali-dns-snoop"}}})
+			Expect(warnings.String()).To(BeEmpty())
+		})
+	})
+
+	Describe("with DNS snooping unsupported", func() {
+		var m *dnsSnoopManager
+
+		BeforeEach(func() {
+			m = newDNSSnoopManager(filterTable, ruleRenderer, 4, "DNS snooping is not supported in BPF mode", warnings)
+			Expect(m.CompleteDeferredWork()).To(Succeed())
+		})
+
+		It("should warn while domain sets are in use", func() {
+			Expect(warnings.String()).To(BeEmpty())
+			addDomainSet(m)
+			Expect(warnings.String()).To(Equal(
+				"policy rules that match on domain names match no traffic: DNS snooping is not supported in BPF mode"))
+			removeDomainSet(m)
+			Expect(warnings.String()).To(BeEmpty())
+			Expect(filterTable.UpdateCalled).To(BeFalse())
+		})
+	})
+})
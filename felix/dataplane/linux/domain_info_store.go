@@ -0,0 +1,471 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/projectcalico/calico/felix/nfnetlink"
+	"github.com/projectcalico/calico/felix/rules"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+)
+
+const (
+	// Maximum number of bytes of each DNS response that we ask NFLOG to copy to us.
+	dnsNflogCopyRange = 8192
+	// Receive buffer size for the NFLOG socket.
+	dnsNflogBufSize = 2 * 1024 * 1024
+	// How often we check for expired DNS mappings.
+	dnsExpiryInterval = time.Second
+	// Maximum number of DNS mappings that we store; further mappings are dropped until some
+	// expire.
+	dnsMaxMappings = 100000
+	// Prefix of a trusted DNS server that is given as a Kubernetes service.
+	dnsK8sServicePrefix = "k8s-service:"
+)
+
+// domainInfoChangeHandler is implemented by the components that need to know when the IPs for a
+// domain name change.
+type domainInfoChangeHandler interface {
+	OnDomainChange(domain string) (dataplaneSyncNeeded bool)
+}
+
+// dnsValue is a value that a domain name maps to: either an IP address or, for a CNAME record,
+// another domain name.
+type dnsValue struct {
+	isName bool
+	expiry time.Time
+}
+
+// domainInfoStore learns the IPs that domain names resolve to by snooping on the DNS responses
+// sent to local workloads, and forgets them again when the TTLs of the DNS records expire.  It
+// is owned by the main dataplane goroutine; the NFLOG reader hands it packets over
+// dnsResponsesC.
+type domainInfoStore struct {
+	dnsResponsesC chan *nfnetlink.NflogPacket
+
+	// mappings maps from domain name to the IPs and names that it maps to.
+	mappings map[string]map[string]*dnsValue
+	// aliases maps from domain name to the set of names that have a CNAME record for it.
+	aliases map[string]set.Set
+	// numMappings is the total number of values in mappings, which is capped at maxMappings.
+	numMappings int
+	maxMappings int
+	loggedFull  bool
+
+	trustedServers []*net.IPNet
+	extraTTL       time.Duration
+
+	// k8sServices are the trusted servers that were given as Kubernetes services.  The
+	// informers that watch them update k8sServerIPs, which maps from "<kind>/<namespace>/<name>"
+	// to the IPs of the service or of its endpoints, from their own goroutines.
+	kubeClient    kubernetes.Interface
+	k8sServices   []types.NamespacedName
+	k8sServerLock sync.Mutex
+	k8sServerIPs  map[string][]net.IP
+
+	handlers []domainInfoChangeHandler
+
+	// Shim for testing.
+	nowFunc func() time.Time
+}
+
+// newDomainInfoStore creates a domainInfoStore that learns from the responses of the given
+// trusted servers.  Each trusted server is either an IP or CIDR, or a Kubernetes service, as
+// "k8s-service:<namespace>/<name>", whose cluster IPs and endpoints are trusted.  If there are
+// no trusted servers then no responses are trusted.
+func newDomainInfoStore(trustedServers []string, extraTTL time.Duration, kubeClient kubernetes.Interface) *domainInfoStore {
+	s := &domainInfoStore{
+		dnsResponsesC: make(chan *nfnetlink.NflogPacket, 100),
+		mappings:      map[string]map[string]*dnsValue{},
+		aliases:       map[string]set.Set{},
+		maxMappings:   dnsMaxMappings,
+		extraTTL:      extraTTL,
+		kubeClient:    kubeClient,
+		k8sServerIPs:  map[string][]net.IP{},
+		nowFunc:       time.Now,
+	}
+	for _, server := range trustedServers {
+		if strings.HasPrefix(server, dnsK8sServicePrefix) {
+			parts := strings.SplitN(strings.TrimPrefix(server, dnsK8sServicePrefix), "/", 2)
+			if len(parts) != 2 {
+				log.WithField("server", server).Warn("Ignoring invalid trusted DNS server")
+				continue
+			}
+			s.k8sServices = append(s.k8sServices, types.NamespacedName{Namespace: parts[0], Name: parts[1]})
+			continue
+		}
+		_, cidr, err := net.ParseCIDR(server)
+		if err != nil {
+			log.WithError(err).WithField("server", server).Warn("Ignoring invalid trusted DNS server")
+			continue
+		}
+		s.trustedServers = append(s.trustedServers, cidr)
+	}
+	return s
+}
+
+// RegisterHandler adds a handler to be notified of domain name changes.  Must be called before
+// the store is started.
+func (s *domainInfoStore) RegisterHandler(handler domainInfoChangeHandler) {
+	s.handlers = append(s.handlers, handler)
+}
+
+// Start starts capturing DNS responses and watching the trusted servers that are Kubernetes
+// services.  The packets are delivered to dnsResponsesC, which the main dataplane loop should
+// pass to processDNSPacket.
+func (s *domainInfoStore) Start() {
+	// The capture and the watches run for the life of the process, so there's no done channel.
+	if len(s.trustedServers) == 0 && len(s.k8sServices) == 0 {
+		log.Warn("No trusted DNS servers configured; rules that match on domain names will not match any traffic")
+	}
+	s.watchK8sServers(wait.NeverStop)
+	err := nfnetlink.NflogSubscribe(rules.NFLOGDomainGroup, dnsNflogCopyRange, dnsNflogBufSize,
+		s.dnsResponsesC, nil)
+	if err != nil {
+		log.WithError(err).Error(
+			"Failed to start capturing DNS responses; rules that match on domain names will not match any traffic")
+	}
+}
+
+// watchK8sServers starts informers on the trusted servers that are Kubernetes services, and on
+// their endpoints, which keep k8sServerIPs up to date.
+func (s *domainInfoStore) watchK8sServers(stopC <-chan struct{}) {
+	for _, svc := range s.k8sServices {
+		if s.kubeClient == nil {
+			log.WithField("service", svc).Warn(
+				"No Kubernetes client available; unable to trust DNS responses from service")
+			continue
+		}
+		selector := fields.OneTermEqualSelector("metadata.name", svc.Name).String()
+		services := s.kubeClient.CoreV1().Services(svc.Namespace)
+		s.runK8sServerInformer("services/"+svc.String(), &v1.Service{}, &cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				opts.FieldSelector = selector
+				return services.List(context.Background(), opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				opts.FieldSelector = selector
+				return services.Watch(context.Background(), opts)
+			},
+		}, stopC)
+		endpoints := s.kubeClient.CoreV1().Endpoints(svc.Namespace)
+		s.runK8sServerInformer("endpoints/"+svc.String(), &v1.Endpoints{}, &cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				opts.FieldSelector = selector
+				return endpoints.List(context.Background(), opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				opts.FieldSelector = selector
+				return endpoints.Watch(context.Background(), opts)
+			},
+		}, stopC)
+	}
+}
+
+func (s *domainInfoStore) runK8sServerInformer(key string, objType runtime.Object, lw cache.ListerWatcher, stopC <-chan struct{}) {
+	_, informer := cache.NewInformer(lw, objType, 0, cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			s.setK8sServerIPs(key, k8sServerIPs(obj))
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			s.setK8sServerIPs(key, k8sServerIPs(obj))
+		},
+		DeleteFunc: func(interface{}) {
+			s.setK8sServerIPs(key, nil)
+		},
+	})
+	go informer.Run(stopC)
+}
+
+// k8sServerIPs returns the cluster IPs of a service, or the ready addresses of its endpoints.
+func k8sServerIPs(obj interface{}) (ips []net.IP) {
+	switch obj := obj.(type) {
+	case *v1.Service:
+		clusterIPs := obj.Spec.ClusterIPs
+		if len(clusterIPs) == 0 {
+			clusterIPs = []string{obj.Spec.ClusterIP}
+		}
+		for _, clusterIP := range clusterIPs {
+			if ip := net.ParseIP(clusterIP); ip != nil {
+				ips = append(ips, ip)
+			}
+		}
+	case *v1.Endpoints:
+		for _, subset := range obj.Subsets {
+			for _, addr := range subset.Addresses {
+				if ip := net.ParseIP(addr.IP); ip != nil {
+					ips = append(ips, ip)
+				}
+			}
+		}
+	}
+	return
+}
+
+func (s *domainInfoStore) setK8sServerIPs(key string, ips []net.IP) {
+	log.WithFields(log.Fields{"server": key, "ips": ips}).Info("Trusted DNS server IPs updated")
+	s.k8sServerLock.Lock()
+	defer s.k8sServerLock.Unlock()
+	if len(ips) == 0 {
+		delete(s.k8sServerIPs, key)
+		return
+	}
+	s.k8sServerIPs[key] = ips
+}
+
+// GetDomainIPs returns the IPs that the given domain name currently maps to, following CNAME
+// records.
+func (s *domainInfoStore) GetDomainIPs(domain string) []string {
+	var ips []string
+	s.collectIPs(normaliseDomain(domain), set.New(), &ips)
+	return ips
+}
+
+func (s *domainInfoStore) collectIPs(domain string, visited set.Set, ips *[]string) {
+	if visited.Contains(domain) {
+		// CNAME loop.
+		return
+	}
+	visited.Add(domain)
+	for value, v := range s.mappings[domain] {
+		if v.isName {
+			s.collectIPs(value, visited, ips)
+		} else {
+			*ips = append(*ips, value)
+		}
+	}
+}
+
+// processDNSPacket updates the store from a captured DNS response and notifies the handlers of
+// any names whose IPs have changed.  It returns true if any handler needs a dataplane sync.
+func (s *domainInfoStore) processDNSPacket(pkt *nfnetlink.NflogPacket) (dataplaneSyncNeeded bool) {
+	if len(pkt.Payload) == 0 {
+		return false
+	}
+	var packet gopacket.Packet
+	switch pkt.Payload[0] >> 4 {
+	case 4:
+		packet = gopacket.NewPacket(pkt.Payload, layers.LayerTypeIPv4, gopacket.Lazy)
+	case 6:
+		packet = gopacket.NewPacket(pkt.Payload, layers.LayerTypeIPv6, gopacket.Lazy)
+	default:
+		log.Debug("Ignoring captured packet that isn't IP")
+		return false
+	}
+
+	if !s.isTrustedServer(packet) {
+		log.Debug("Ignoring DNS response from untrusted server")
+		return false
+	}
+
+	dnsLayer, ok := decodeDNS(packet)
+	if !ok {
+		log.Debug("Ignoring captured packet that isn't DNS")
+		return false
+	}
+	if !dnsLayer.QR || dnsLayer.ResponseCode != layers.DNSResponseCodeNoErr {
+		return false
+	}
+
+	changedNames := set.New()
+	for _, answer := range dnsLayer.Answers {
+		if answer.Class != layers.DNSClassIN {
+			continue
+		}
+		name := normaliseDomain(string(answer.Name))
+		ttl := time.Duration(answer.TTL) * time.Second
+		switch answer.Type {
+		case layers.DNSTypeA, layers.DNSTypeAAAA:
+			if answer.IP == nil {
+				continue
+			}
+			if s.storeMapping(name, answer.IP.String(), false, ttl) {
+				changedNames.Add(name)
+			}
+		case layers.DNSTypeCNAME:
+			if s.storeMapping(name, normaliseDomain(string(answer.CNAME)), true, ttl) {
+				changedNames.Add(name)
+			}
+		}
+	}
+	return s.notifyChanges(changedNames)
+}
+
+// decodeDNS returns the DNS message in a captured packet.  Over TCP, each DNS message is
+// prefixed by its length and may be split across segments; we only decode the messages that
+// start and end in the captured segment, so a long response that is split is missed.
+func decodeDNS(packet gopacket.Packet) (*layers.DNS, bool) {
+	tcp, ok := packet.Layer(layers.LayerTypeTCP).(*layers.TCP)
+	if !ok {
+		dns, ok := packet.Layer(layers.LayerTypeDNS).(*layers.DNS)
+		return dns, ok
+	}
+	payload := tcp.LayerPayload()
+	if len(payload) < 2 {
+		return nil, false
+	}
+	msgLen := int(binary.BigEndian.Uint16(payload))
+	if len(payload)-2 < msgLen {
+		log.Debug("Ignoring DNS response that is split across TCP segments")
+		return nil, false
+	}
+	dns := &layers.DNS{}
+	if err := dns.DecodeFromBytes(payload[2:2+msgLen], gopacket.NilDecodeFeedback); err != nil {
+		log.WithError(err).Debug("Failed to decode DNS response from TCP segment")
+		return nil, false
+	}
+	return dns, true
+}
+
+func (s *domainInfoStore) isTrustedServer(packet gopacket.Packet) bool {
+	netLayer := packet.NetworkLayer()
+	if netLayer == nil {
+		return false
+	}
+	src := net.IP(netLayer.NetworkFlow().Src().Raw())
+	for _, cidr := range s.trustedServers {
+		if cidr.Contains(src) {
+			return true
+		}
+	}
+	s.k8sServerLock.Lock()
+	defer s.k8sServerLock.Unlock()
+	for _, ips := range s.k8sServerIPs {
+		for _, ip := range ips {
+			if ip.Equal(src) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// storeMapping records that name maps to value, until the TTL expires.  It returns true if the
+// mapping is new.  New mappings are dropped while the store is full.
+func (s *domainInfoStore) storeMapping(name, value string, isName bool, ttl time.Duration) bool {
+	expiry := s.nowFunc().Add(ttl + s.extraTTL)
+	if existing := s.mappings[name][value]; existing != nil {
+		if expiry.After(existing.expiry) {
+			existing.expiry = expiry
+		}
+		return false
+	}
+	if s.numMappings >= s.maxMappings {
+		if !s.loggedFull {
+			log.WithField("max", s.maxMappings).Warn(
+				"Too many DNS mappings; ignoring new mappings until some expire")
+			s.loggedFull = true
+		}
+		return false
+	}
+	values := s.mappings[name]
+	if values == nil {
+		values = map[string]*dnsValue{}
+		s.mappings[name] = values
+	}
+	s.numMappings++
+	log.WithFields(log.Fields{"name": name, "value": value, "ttl": ttl}).Debug("Learned DNS mapping")
+	values[value] = &dnsValue{isName: isName, expiry: expiry}
+	if isName {
+		if s.aliases[value] == nil {
+			s.aliases[value] = set.New()
+		}
+		s.aliases[value].Add(name)
+	}
+	return true
+}
+
+// expireMappings removes mappings whose TTL has expired and notifies the handlers of the names
+// that have changed.  It returns true if any handler needs a dataplane sync.
+func (s *domainInfoStore) expireMappings() (dataplaneSyncNeeded bool) {
+	now := s.nowFunc()
+	changedNames := set.New()
+	for name, values := range s.mappings {
+		for value, v := range values {
+			if now.Before(v.expiry) {
+				continue
+			}
+			log.WithFields(log.Fields{"name": name, "value": value}).Debug("DNS mapping expired")
+			delete(values, value)
+			s.numMappings--
+			if v.isName {
+				s.aliases[value].Discard(name)
+				if s.aliases[value].Len() == 0 {
+					delete(s.aliases, value)
+				}
+			}
+			changedNames.Add(name)
+		}
+		if len(values) == 0 {
+			delete(s.mappings, name)
+		}
+	}
+	if s.numMappings < s.maxMappings {
+		s.loggedFull = false
+	}
+	return s.notifyChanges(changedNames)
+}
+
+// notifyChanges notifies the handlers about the given names and all the names that are
+// (directly or indirectly) aliases for them.
+func (s *domainInfoStore) notifyChanges(changedNames set.Set) (dataplaneSyncNeeded bool) {
+	notified := set.New()
+	var notify func(name string)
+	notify = func(name string) {
+		if notified.Contains(name) {
+			return
+		}
+		notified.Add(name)
+		for _, handler := range s.handlers {
+			if handler.OnDomainChange(name) {
+				dataplaneSyncNeeded = true
+			}
+		}
+		if aliases := s.aliases[name]; aliases != nil {
+			aliases.Iter(func(item interface{}) error {
+				notify(item.(string))
+				return nil
+			})
+		}
+	}
+	changedNames.Iter(func(item interface{}) error {
+		notify(item.(string))
+		return nil
+	})
+	return
+}
+
+func normaliseDomain(domain string) string {
+	return strings.ToLower(strings.TrimSuffix(domain, "."))
+}
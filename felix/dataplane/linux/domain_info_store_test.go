@@ -0,0 +1,268 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"net"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/projectcalico/calico/felix/nfnetlink"
+)
+
+type recordingDomainHandler struct {
+	changed []string
+}
+
+func (h *recordingDomainHandler) OnDomainChange(domain string) bool {
+	h.changed = append(h.changed, domain)
+	return true
+}
+
+func dnsResponsePacket(serverIP string, answers ...layers.DNSResourceRecord) *nfnetlink.NflogPacket {
+	ipLayer := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolUDP,
+		SrcIP:    net.ParseIP(serverIP).To4(),
+		DstIP:    net.ParseIP("10.65.0.2").To4(),
+	}
+	udpLayer := &layers.UDP{SrcPort: 53, DstPort: 40000}
+	Expect(udpLayer.SetNetworkLayerForChecksum(ipLayer)).To(Succeed())
+	dnsLayer := &layers.DNS{
+		ID:           1,
+		QR:           true,
+		ResponseCode: layers.DNSResponseCodeNoErr,
+		Answers:      answers,
+		ANCount:      uint16(len(answers)),
+	}
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	Expect(gopacket.SerializeLayers(buf, opts, ipLayer, udpLayer, dnsLayer)).To(Succeed())
+	return &nfnetlink.NflogPacket{Payload: buf.Bytes()}
+}
+
+func dnsResponsePacketTCP(serverIP string, answers ...layers.DNSResourceRecord) *nfnetlink.NflogPacket {
+	ipLayer := &layers.IPv4{
+		Version:  4,
+		TTL:      64,
+		Protocol: layers.IPProtocolTCP,
+		SrcIP:    net.ParseIP(serverIP).To4(),
+		DstIP:    net.ParseIP("10.65.0.2").To4(),
+	}
+	tcpLayer := &layers.TCP{SrcPort: 53, DstPort: 40000, ACK: true, PSH: true, Window: 1024}
+	Expect(tcpLayer.SetNetworkLayerForChecksum(ipLayer)).To(Succeed())
+	dnsLayer := &layers.DNS{
+		ID:           1,
+		QR:           true,
+		ResponseCode: layers.DNSResponseCodeNoErr,
+		Answers:      answers,
+		ANCount:      uint16(len(answers)),
+	}
+	dnsBuf := gopacket.NewSerializeBuffer()
+	Expect(dnsLayer.SerializeTo(dnsBuf, gopacket.SerializeOptions{FixLengths: true})).To(Succeed())
+	msg := dnsBuf.Bytes()
+	payload := append([]byte{byte(len(msg) >> 8), byte(len(msg))}, msg...)
+
+	buf := gopacket.NewSerializeBuffer()
+	opts := gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}
+	Expect(gopacket.SerializeLayers(buf, opts, ipLayer, tcpLayer, gopacket.Payload(payload))).To(Succeed())
+	return &nfnetlink.NflogPacket{Payload: buf.Bytes()}
+}
+
+func aRecord(name, ip string, ttl uint32) layers.DNSResourceRecord {
+	return layers.DNSResourceRecord{
+		Name:  []byte(name),
+		Type:  layers.DNSTypeA,
+		Class: layers.DNSClassIN,
+		TTL:   ttl,
+		IP:    net.ParseIP(ip).To4(),
+	}
+}
+
+func cnameRecord(name, target string, ttl uint32) layers.DNSResourceRecord {
+	return layers.DNSResourceRecord{
+		Name:  []byte(name),
+		Type:  layers.DNSTypeCNAME,
+		Class: layers.DNSClassIN,
+		TTL:   ttl,
+		CNAME: []byte(target),
+	}
+}
+
+var _ = Describe("Domain info store", func() {
+	var (
+		store   *domainInfoStore
+		handler *recordingDomainHandler
+		now     time.Time
+	)
+
+	BeforeEach(func() {
+		store = newDomainInfoStore([]string{"10.96.0.10/32"}, 2*time.Second, nil)
+		handler = &recordingDomainHandler{}
+		store.RegisterHandler(handler)
+		now = time.Now()
+		store.nowFunc = func() time.Time { return now }
+	})
+
+	It("should learn A records from a DNS response", func() {
+		Expect(store.processDNSPacket(dnsResponsePacket("10.96.0.10",
+			aRecord("Example.com", "1.2.3.4", 30),
+			aRecord("example.com", "1.2.3.5", 30),
+		))).To(BeTrue())
+		Expect(store.GetDomainIPs("example.com")).To(ConsistOf("1.2.3.4", "1.2.3.5"))
+		Expect(handler.changed).To(Equal([]string{"example.com"}))
+	})
+
+	It("should not notify when a response only refreshes known mappings", func() {
+		store.processDNSPacket(dnsResponsePacket("10.96.0.10", aRecord("example.com", "1.2.3.4", 30)))
+		handler.changed = nil
+		Expect(store.processDNSPacket(dnsResponsePacket("10.96.0.10", aRecord("example.com", "1.2.3.4", 30)))).To(BeFalse())
+		Expect(handler.changed).To(BeEmpty())
+	})
+
+	It("should follow CNAME records and notify the aliases", func() {
+		store.processDNSPacket(dnsResponsePacket("10.96.0.10",
+			cnameRecord("www.example.com", "cdn.example.net", 30),
+			aRecord("cdn.example.net", "1.2.3.4", 30),
+		))
+		Expect(store.GetDomainIPs("www.example.com")).To(ConsistOf("1.2.3.4"))
+
+		handler.changed = nil
+		store.processDNSPacket(dnsResponsePacket("10.96.0.10", aRecord("cdn.example.net", "1.2.3.5", 30)))
+		Expect(store.GetDomainIPs("www.example.com")).To(ConsistOf("1.2.3.4", "1.2.3.5"))
+		Expect(handler.changed).To(ConsistOf("cdn.example.net", "www.example.com"))
+	})
+
+	It("should not loop on a CNAME cycle", func() {
+		store.processDNSPacket(dnsResponsePacket("10.96.0.10",
+			cnameRecord("a.example.com", "b.example.com", 30),
+			cnameRecord("b.example.com", "a.example.com", 30),
+		))
+		Expect(store.GetDomainIPs("a.example.com")).To(BeEmpty())
+	})
+
+	It("should expire mappings after the TTL plus the extra TTL", func() {
+		store.processDNSPacket(dnsResponsePacket("10.96.0.10", aRecord("example.com", "1.2.3.4", 30)))
+		handler.changed = nil
+
+		now = now.Add(31 * time.Second)
+		Expect(store.expireMappings()).To(BeFalse())
+		Expect(store.GetDomainIPs("example.com")).To(ConsistOf("1.2.3.4"))
+
+		now = now.Add(time.Second)
+		Expect(store.expireMappings()).To(BeTrue())
+		Expect(store.GetDomainIPs("example.com")).To(BeEmpty())
+		Expect(handler.changed).To(Equal([]string{"example.com"}))
+	})
+
+	It("should ignore responses from untrusted servers", func() {
+		store = newDomainInfoStore([]string{"10.96.0.10/32"}, 0, nil)
+		store.processDNSPacket(dnsResponsePacket("10.0.0.66", aRecord("example.com", "6.6.6.6", 30)))
+		Expect(store.GetDomainIPs("example.com")).To(BeEmpty())
+		store.processDNSPacket(dnsResponsePacket("10.96.0.10", aRecord("example.com", "1.2.3.4", 30)))
+		Expect(store.GetDomainIPs("example.com")).To(ConsistOf("1.2.3.4"))
+	})
+
+	It("should trust no servers if none are configured", func() {
+		store = newDomainInfoStore(nil, 0, nil)
+		store.processDNSPacket(dnsResponsePacket("10.96.0.10", aRecord("example.com", "1.2.3.4", 30)))
+		Expect(store.GetDomainIPs("example.com")).To(BeEmpty())
+	})
+
+	It("should trust the cluster IP and endpoints of a Kubernetes service", func() {
+		client := fake.NewSimpleClientset(
+			&v1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "kube-dns"},
+				Spec:       v1.ServiceSpec{ClusterIP: "10.96.0.10"},
+			},
+			&v1.Endpoints{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "kube-dns"},
+				Subsets: []v1.EndpointSubset{{
+					Addresses: []v1.EndpointAddress{{IP: "10.65.0.53"}},
+				}},
+			},
+		)
+		store = newDomainInfoStore([]string{"k8s-service:kube-system/kube-dns"}, 0, client)
+		stopC := make(chan struct{})
+		defer close(stopC)
+		store.watchK8sServers(stopC)
+		trusted := func(server string) bool {
+			return store.isTrustedServer(gopacket.NewPacket(dnsResponsePacket(server).Payload,
+				layers.LayerTypeIPv4, gopacket.Default))
+		}
+		Eventually(func() bool { return trusted("10.96.0.10") }).Should(BeTrue())
+		Eventually(func() bool { return trusted("10.65.0.53") }).Should(BeTrue())
+		Expect(trusted("10.0.0.66")).To(BeFalse())
+
+		// A DNS pod moves to a new IP.
+		_, err := client.CoreV1().Endpoints("kube-system").Update(context.Background(), &v1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "kube-dns"},
+			Subsets: []v1.EndpointSubset{{
+				Addresses: []v1.EndpointAddress{{IP: "10.65.1.53"}},
+			}},
+		}, metav1.UpdateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Eventually(func() bool { return trusted("10.65.1.53") }).Should(BeTrue())
+		Expect(trusted("10.65.0.53")).To(BeFalse())
+		Expect(trusted("10.96.0.10")).To(BeTrue())
+	})
+
+	It("should drop new mappings while the store is full", func() {
+		store.maxMappings = 2
+		store.processDNSPacket(dnsResponsePacket("10.96.0.10",
+			aRecord("example.com", "1.2.3.4", 30),
+			aRecord("example.com", "1.2.3.5", 30),
+			aRecord("example.com", "1.2.3.6", 60),
+		))
+		Expect(store.GetDomainIPs("example.com")).To(ConsistOf("1.2.3.4", "1.2.3.5"))
+
+		now = now.Add(33 * time.Second)
+		store.expireMappings()
+		Expect(store.GetDomainIPs("example.com")).To(BeEmpty())
+		store.processDNSPacket(dnsResponsePacket("10.96.0.10", aRecord("example.com", "1.2.3.6", 60)))
+		Expect(store.GetDomainIPs("example.com")).To(ConsistOf("1.2.3.6"))
+	})
+
+	It("should learn A records from a DNS response over TCP", func() {
+		Expect(store.processDNSPacket(dnsResponsePacketTCP("10.96.0.10",
+			aRecord("example.com", "1.2.3.4", 30),
+		))).To(BeTrue())
+		Expect(store.GetDomainIPs("example.com")).To(ConsistOf("1.2.3.4"))
+	})
+
+	It("should ignore a DNS response over TCP that is split across segments", func() {
+		pkt := dnsResponsePacketTCP("10.96.0.10", aRecord("example.com", "1.2.3.4", 30))
+		// Drop the last byte of the segment, as if the rest followed in the next segment.
+		pkt.Payload = pkt.Payload[:len(pkt.Payload)-1]
+		pkt.Payload[3]--
+		Expect(store.processDNSPacket(pkt)).To(BeFalse())
+		Expect(store.GetDomainIPs("example.com")).To(BeEmpty())
+	})
+
+	It("should ignore packets that aren't DNS responses", func() {
+		Expect(store.processDNSPacket(&nfnetlink.NflogPacket{Payload: []byte{0x45, 0, 0}})).To(BeFalse())
+		Expect(store.processDNSPacket(&nfnetlink.NflogPacket{})).To(BeFalse())
+	})
+})
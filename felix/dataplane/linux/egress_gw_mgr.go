@@ -0,0 +1,828 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+
+	"github.com/projectcalico/calico/felix/calc"
+	"github.com/projectcalico/calico/felix/dataplane/common"
+	"github.com/projectcalico/calico/felix/ip"
+	"github.com/projectcalico/calico/felix/ipsets"
+	"github.com/projectcalico/calico/felix/logutils"
+	"github.com/projectcalico/calico/felix/netlinkshim"
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/felix/routerule"
+	"github.com/projectcalico/calico/felix/routetable"
+	"github.com/projectcalico/calico/felix/rules"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+)
+
+var (
+	errEgressGatewayUnresolved = errors.New("failed to resolve the next hop for some egress gateways")
+
+	defaultCIDRV4 = ip.MustParseCIDROrIP("0.0.0.0/0")
+)
+
+const (
+	// featureEgressGateways is the featureWarnings key of the warning that egress gateways are
+	// enabled but not supported.
+	featureEgressGateways = "egress-gateways"
+	// featureEgressGatewayNAT is the featureWarnings key of the warning about local gateways whose
+	// IPs are in IP pools with NAT outgoing enabled.
+	featureEgressGatewayNAT = "egress-gateway-nat"
+
+	// The tunnel devices that remote egress gateways can be reached through.
+	egressGatewayVXLANDevice = "vxlan.calico"
+	egressGatewayIPIPDevice  = "tunl0"
+)
+
+// egressGatewayHealthUpdate is sent by the egress gateway health probes to the main loop when the
+// health of a gateway changes.
+type egressGatewayHealthUpdate struct {
+	addr    ip.Addr
+	healthy bool
+}
+
+// egressGatewayNetlink is a shim interface for the netlink calls that the egress gateway manager uses
+// to find the next hop towards remote gateways.
+type egressGatewayNetlink interface {
+	RouteGet(destination net.IP) ([]netlink.Route, error)
+	LinkByIndex(index int) (netlink.Link, error)
+}
+
+type realEgressGatewayNetlink struct{}
+
+func (r realEgressGatewayNetlink) RouteGet(destination net.IP) ([]netlink.Route, error) {
+	return netlink.RouteGet(destination)
+}
+
+func (r realEgressGatewayNetlink) LinkByIndex(index int) (netlink.Link, error) {
+	return netlink.LinkByIndex(index)
+}
+
+// egressGatewayManager routes the off-cluster traffic of workloads that use egress gateways to the
+// gateways, which source-NAT the traffic to their own IPs.
+//
+// The calculation graph sends a pair of IP sets for each egress selector in use: the gateways that
+// the selector matches and the workloads (the clients) that use the selector.  For each pair:
+//
+//   - If some of the clients are local, the manager allocates a routing table whose default route
+//     is an ECMP route over the healthy gateways, and adds a routing rule for each local client
+//     that sends its traffic to the table.
+//   - If some of the gateways are local and some of the clients are remote, the manager allocates
+//     a routing table whose default route is an ECMP route over the healthy local gateways, and
+//     adds a routing rule for each remote client.  This hands the traffic that the remote clients'
+//     nodes tunnel to this node to the local gateways.
+//
+// Each table has throw routes for the IP pools and the hosts, so that traffic to destinations in
+// the cluster continues to use the main routing table.  If none of the gateways are healthy, the
+// default route is a blackhole, so that the traffic does not leave the cluster with the wrong
+// source IP.
+//
+// Remote gateways are reached through the next hop of the main routing table's route to the
+// gateway, which must be a Calico tunnel device or another host.
+//
+// The manager also maintains an IP set of all clients, which the NAT-outgoing rules use to avoid
+// masquerading the clients' traffic on its way to the gateways.
+//
+// Unless the health port is set to 0, the manager probes each gateway that it routes to with an
+// HTTP GET of /readiness, and stops routing to a gateway after a number of consecutive failures.
+// This manager is IPv4-only.
+type egressGatewayManager struct {
+	ipsetsDataplane common.IPSetsDataplane
+	maxIPSetSize    int
+	routeRules      routeRules
+	nl              egressGatewayNetlink
+
+	rulePriority int
+	// routeTables holds a route table for each of our table indices.  All the tables are always
+	// present so that tables that are no longer needed get cleaned up.
+	routeTables     map[int]routeTable
+	freeTableIdxs   []int
+	tableIdxByOwner map[egressTableOwner]int
+
+	// gatewaysBySet and clientsBySet hold the members of the egress IP sets, indexed by the ID of
+	// the gateway IP set.
+	gatewaysBySet map[string]set.Set
+	clientsBySet  map[string]set.Set
+	// localWorkloads maps the IPs of local workloads to their interfaces.
+	localWorkloads  map[ip.Addr]string
+	workloadIPsByID map[proto.WorkloadEndpointID][]ip.Addr
+	hostIPs         map[string]ip.Addr
+	poolCIDRs       map[string]ip.CIDR
+	// natPools holds the IDs of the IP pools that have NAT outgoing enabled.  A gateway in such a
+	// pool has its traffic masqueraded to the node's IP, which defeats its purpose.
+	natPools set.Set
+	// natGateways holds the local gateways that we've warned are in such pools.
+	natGateways     set.Set
+	featureWarnings *featureWarnings
+
+	// activeRules maps from client IP to the index of the table that the client's traffic uses.
+	activeRules map[ip.Addr]int
+
+	healthPort         int
+	pollInterval       time.Duration
+	pollFailureCount   int
+	probeFn            func(ctx context.Context, url string) error
+	healthUpdates      chan<- *egressGatewayHealthUpdate
+	unhealthyGateways  set.Set
+	probeCancelsByAddr map[ip.Addr]context.CancelFunc
+
+	dirty bool
+}
+
+// egressTableOwner identifies the use of one of our routing tables.
+type egressTableOwner struct {
+	gatewaySetID string
+	// forRemoteClients is set for tables that route remote clients' traffic to local gateways.
+	forRemoteClients bool
+}
+
+func newEgressGatewayManager(
+	ipsetsDataplane common.IPSetsDataplane,
+	tableIndices set.Set,
+	healthUpdates chan<- *egressGatewayHealthUpdate,
+	featureWarnings *featureWarnings,
+	dpConfig Config,
+	opRecorder logutils.OpRecorder,
+) *egressGatewayManager {
+	rr, err := routerule.New(
+		4,
+		tableIndices,
+		routerule.RulesMatchSrcFWMarkTable,
+		routerule.RulesMatchSrcFWMarkTable,
+		dpConfig.NetlinkTimeout,
+		func() (routerule.HandleIface, error) {
+			return netlinkshim.NewRealNetlink()
+		},
+		opRecorder,
+	)
+	if err != nil {
+		// The table indices come from the allocator so this shouldn't happen.
+		log.WithError(err).Panic("Unexpected error creating rule manager for egress gateways")
+	}
+
+	routeTables := map[int]routeTable{}
+	tableIndices.Iter(func(item interface{}) error {
+		idx := item.(int)
+		routeTables[idx] = routetable.New(
+			[]string{routetable.InterfaceNone},
+			4,
+			false,
+			dpConfig.NetlinkTimeout,
+			nil,
+			dpConfig.DeviceRouteProtocol,
+			true,
+			idx,
+			opRecorder,
+		)
+		return nil
+	})
+
+	return newEgressGatewayManagerWithShims(
+		ipsetsDataplane,
+		rr,
+		routeTables,
+		realEgressGatewayNetlink{},
+		probeEgressGatewayHTTP,
+		healthUpdates,
+		featureWarnings,
+		dpConfig,
+	)
+}
+
+func newEgressGatewayManagerWithShims(
+	ipsetsDataplane common.IPSetsDataplane,
+	rr routeRules,
+	routeTables map[int]routeTable,
+	nl egressGatewayNetlink,
+	probeFn func(ctx context.Context, url string) error,
+	healthUpdates chan<- *egressGatewayHealthUpdate,
+	featureWarnings *featureWarnings,
+	dpConfig Config,
+) *egressGatewayManager {
+	ipsetsDataplane.AddOrReplaceIPSet(ipsets.IPSetMetadata{
+		MaxSize: dpConfig.MaxIPSetSize,
+		SetID:   rules.IPSetIDEgressGatewayClients,
+		Type:    ipsets.IPSetTypeHashNet,
+	}, []string{})
+
+	var freeTableIdxs []int
+	for idx := range routeTables {
+		freeTableIdxs = append(freeTableIdxs, idx)
+	}
+	sort.Ints(freeTableIdxs)
+
+	return &egressGatewayManager{
+		ipsetsDataplane:    ipsetsDataplane,
+		maxIPSetSize:       dpConfig.MaxIPSetSize,
+		routeRules:         rr,
+		nl:                 nl,
+		rulePriority:       dpConfig.EgressGatewayRoutingRulePriority,
+		routeTables:        routeTables,
+		freeTableIdxs:      freeTableIdxs,
+		tableIdxByOwner:    map[egressTableOwner]int{},
+		gatewaysBySet:      map[string]set.Set{},
+		clientsBySet:       map[string]set.Set{},
+		localWorkloads:     map[ip.Addr]string{},
+		workloadIPsByID:    map[proto.WorkloadEndpointID][]ip.Addr{},
+		hostIPs:            map[string]ip.Addr{},
+		poolCIDRs:          map[string]ip.CIDR{},
+		natPools:           set.New(),
+		natGateways:        set.New(),
+		featureWarnings:    featureWarnings,
+		activeRules:        map[ip.Addr]int{},
+		healthPort:         dpConfig.EgressGatewayHealthPort,
+		pollInterval:       dpConfig.EgressGatewayPollInterval,
+		pollFailureCount:   dpConfig.EgressGatewayPollFailureCount,
+		probeFn:            probeFn,
+		healthUpdates:      healthUpdates,
+		unhealthyGateways:  set.New(),
+		probeCancelsByAddr: map[ip.Addr]context.CancelFunc{},
+		dirty:              true,
+	}
+}
+
+func (m *egressGatewayManager) OnUpdate(msg interface{}) {
+	switch msg := msg.(type) {
+	case *proto.IPSetUpdate:
+		members := m.ipSetMembers(msg.Id, true)
+		if members == nil {
+			return
+		}
+		members.Clear()
+		m.addMembers(members, msg.Members)
+	case *proto.IPSetDeltaUpdate:
+		members := m.ipSetMembers(msg.Id, false)
+		if members == nil {
+			return
+		}
+		m.removeMembers(members, msg.RemovedMembers)
+		m.addMembers(members, msg.AddedMembers)
+	case *proto.IPSetRemove:
+		if strings.HasPrefix(msg.Id, calc.EgressGatewayIPSetPrefix) {
+			delete(m.gatewaysBySet, msg.Id)
+		} else if strings.HasPrefix(msg.Id, calc.EgressClientIPSetPrefix) {
+			delete(m.clientsBySet, m.gatewaySetIDForClientSet(msg.Id))
+		} else {
+			return
+		}
+	case *proto.WorkloadEndpointUpdate:
+		m.removeLocalWorkload(*msg.Id)
+		var addrs []ip.Addr
+		for _, cidrStr := range msg.Endpoint.Ipv4Nets {
+			addr := ip.MustParseCIDROrIP(cidrStr).Addr()
+			m.localWorkloads[addr] = msg.Endpoint.Name
+			addrs = append(addrs, addr)
+		}
+		m.workloadIPsByID[*msg.Id] = addrs
+	case *proto.WorkloadEndpointRemove:
+		m.removeLocalWorkload(*msg.Id)
+	case *proto.HostMetadataUpdate:
+		if msg.Ipv4Addr == "" {
+			delete(m.hostIPs, msg.Hostname)
+		} else {
+			m.hostIPs[msg.Hostname] = ip.FromString(msg.Ipv4Addr)
+		}
+	case *proto.HostMetadataRemove:
+		delete(m.hostIPs, msg.Hostname)
+	case *proto.IPAMPoolUpdate:
+		cidr := ip.MustParseCIDROrIP(msg.Pool.Cidr)
+		if cidr.Version() != 4 {
+			return
+		}
+		m.poolCIDRs[msg.Id] = cidr
+		if msg.Pool.Masquerade {
+			m.natPools.Add(msg.Id)
+		} else {
+			m.natPools.Discard(msg.Id)
+		}
+	case *proto.IPAMPoolRemove:
+		delete(m.poolCIDRs, msg.Id)
+		m.natPools.Discard(msg.Id)
+	case *proto.RouteUpdate, *proto.RouteRemove:
+		// The route to a remote gateway may have changed.
+	case *egressGatewayHealthUpdate:
+		if _, ok := m.probeCancelsByAddr[msg.addr]; !ok {
+			// Stale update from a probe that we've stopped.
+			return
+		}
+		log.WithFields(log.Fields{
+			"gateway": msg.addr,
+			"healthy": msg.healthy,
+		}).Info("Egress gateway health changed")
+		if msg.healthy {
+			m.unhealthyGateways.Discard(msg.addr)
+		} else {
+			m.unhealthyGateways.Add(msg.addr)
+		}
+	default:
+		return
+	}
+	m.dirty = true
+}
+
+// ipSetMembers returns the member set for the given egress IP set, creating it if requested.
+// It returns nil for IP sets that aren't egress IP sets.
+func (m *egressGatewayManager) ipSetMembers(id string, create bool) set.Set {
+	var sets map[string]set.Set
+	var gatewaySetID string
+	if strings.HasPrefix(id, calc.EgressGatewayIPSetPrefix) {
+		sets = m.gatewaysBySet
+		gatewaySetID = id
+	} else if strings.HasPrefix(id, calc.EgressClientIPSetPrefix) {
+		sets = m.clientsBySet
+		gatewaySetID = m.gatewaySetIDForClientSet(id)
+	} else {
+		return nil
+	}
+	members := sets[gatewaySetID]
+	if members == nil && create {
+		members = set.New()
+		sets[gatewaySetID] = members
+	}
+	return members
+}
+
+func (m *egressGatewayManager) gatewaySetIDForClientSet(id string) string {
+	return calc.EgressGatewayIPSetPrefix + strings.TrimPrefix(id, calc.EgressClientIPSetPrefix)
+}
+
+func (m *egressGatewayManager) addMembers(members set.Set, cidrStrs []string) {
+	for _, cidrStr := range cidrStrs {
+		cidr := ip.MustParseCIDROrIP(cidrStr)
+		if cidr.Version() != 4 {
+			continue
+		}
+		members.Add(cidr.Addr())
+	}
+}
+
+func (m *egressGatewayManager) removeMembers(members set.Set, cidrStrs []string) {
+	for _, cidrStr := range cidrStrs {
+		members.Discard(ip.MustParseCIDROrIP(cidrStr).Addr())
+	}
+}
+
+func (m *egressGatewayManager) removeLocalWorkload(id proto.WorkloadEndpointID) {
+	for _, addr := range m.workloadIPsByID[id] {
+		delete(m.localWorkloads, addr)
+	}
+	delete(m.workloadIPsByID, id)
+}
+
+func (m *egressGatewayManager) GetRouteTableSyncers() []routeTableSyncer {
+	var idxs []int
+	for idx := range m.routeTables {
+		idxs = append(idxs, idx)
+	}
+	sort.Ints(idxs)
+	var rts []routeTableSyncer
+	for _, idx := range idxs {
+		rts = append(rts, m.routeTables[idx])
+	}
+	return rts
+}
+
+func (m *egressGatewayManager) GetRouteRules() []routeRules {
+	return []routeRules{m.routeRules}
+}
+
+func (m *egressGatewayManager) CompleteDeferredWork() error {
+	if !m.dirty {
+		return nil
+	}
+	m.dirty = false
+
+	var resolveErr error
+	neededOwners := map[egressTableOwner]bool{}
+	tableRoutes := map[egressTableOwner][]routetable.Target{}
+	neededRules := map[ip.Addr]egressTableOwner{}
+	probedGateways := set.New()
+	natGateways := set.New()
+	var allClients []string
+
+	var gatewaySetIDs []string
+	for id := range m.clientsBySet {
+		gatewaySetIDs = append(gatewaySetIDs, id)
+	}
+	sort.Strings(gatewaySetIDs)
+
+	for _, gatewaySetID := range gatewaySetIDs {
+		clients := m.clientsBySet[gatewaySetID]
+		gateways := m.gatewaysBySet[gatewaySetID]
+		if gateways == nil {
+			gateways = set.New()
+		}
+
+		var localClients, remoteClients []ip.Addr
+		clients.Iter(func(item interface{}) error {
+			addr := item.(ip.Addr)
+			allClients = append(allClients, addr.String())
+			if gateways.Contains(addr) {
+				// A gateway can't use itself as an egress gateway.
+				return nil
+			}
+			if _, ok := m.localWorkloads[addr]; ok {
+				localClients = append(localClients, addr)
+			} else {
+				remoteClients = append(remoteClients, addr)
+			}
+			return nil
+		})
+
+		var localGateways []ip.Addr
+		gateways.Iter(func(item interface{}) error {
+			addr := item.(ip.Addr)
+			if _, ok := m.localWorkloads[addr]; ok {
+				localGateways = append(localGateways, addr)
+				if m.inNATOutgoingPool(addr) {
+					natGateways.Add(addr)
+				}
+			}
+			return nil
+		})
+
+		if len(localClients) > 0 {
+			owner := egressTableOwner{gatewaySetID: gatewaySetID}
+			neededOwners[owner] = true
+			var allGateways []ip.Addr
+			gateways.Iter(func(item interface{}) error {
+				addr := item.(ip.Addr)
+				probedGateways.Add(addr)
+				allGateways = append(allGateways, addr)
+				return nil
+			})
+			nextHops, err := m.nextHops(allGateways)
+			if err != nil {
+				resolveErr = err
+			}
+			tableRoutes[owner] = m.tableTargets(nextHops)
+			for _, addr := range localClients {
+				neededRules[addr] = owner
+			}
+		}
+
+		if len(localGateways) > 0 && len(remoteClients) > 0 {
+			owner := egressTableOwner{gatewaySetID: gatewaySetID, forRemoteClients: true}
+			neededOwners[owner] = true
+			for _, addr := range localGateways {
+				probedGateways.Add(addr)
+			}
+			nextHops, err := m.nextHops(localGateways)
+			if err != nil {
+				resolveErr = err
+			}
+			tableRoutes[owner] = m.tableTargets(nextHops)
+			for _, addr := range remoteClients {
+				neededRules[addr] = owner
+			}
+		}
+	}
+
+	m.ipsetsDataplane.AddOrReplaceIPSet(ipsets.IPSetMetadata{
+		MaxSize: m.maxIPSetSize,
+		SetID:   rules.IPSetIDEgressGatewayClients,
+		Type:    ipsets.IPSetTypeHashNet,
+	}, allClients)
+
+	m.updateProbes(probedGateways)
+	m.updateNATOutgoingGateways(natGateways)
+
+	// Release the tables that are no longer needed before we allocate new ones.
+	for owner, idx := range m.tableIdxByOwner {
+		if neededOwners[owner] {
+			continue
+		}
+		log.WithFields(log.Fields{"owner": owner, "table": idx}).Info("Releasing egress gateway routing table")
+		delete(m.tableIdxByOwner, owner)
+		m.freeTableIdxs = append(m.freeTableIdxs, idx)
+	}
+	sort.Ints(m.freeTableIdxs)
+	var owners []egressTableOwner
+	for owner := range neededOwners {
+		owners = append(owners, owner)
+	}
+	sort.Slice(owners, func(i, j int) bool {
+		if owners[i].gatewaySetID != owners[j].gatewaySetID {
+			return owners[i].gatewaySetID < owners[j].gatewaySetID
+		}
+		return !owners[i].forRemoteClients && owners[j].forRemoteClients
+	})
+	for _, owner := range owners {
+		if _, ok := m.tableIdxByOwner[owner]; ok {
+			continue
+		}
+		if len(m.freeTableIdxs) == 0 {
+			log.WithField("owner", owner).Warn(
+				"Ran out of egress gateway routing tables; increase EgressGatewayRoutingTables")
+			continue
+		}
+		m.tableIdxByOwner[owner] = m.freeTableIdxs[0]
+		m.freeTableIdxs = m.freeTableIdxs[1:]
+		log.WithFields(log.Fields{
+			"owner": owner,
+			"table": m.tableIdxByOwner[owner],
+		}).Info("Allocated egress gateway routing table")
+	}
+
+	// Program the routes, clearing out any tables that aren't in use.
+	ownersByIdx := map[int]egressTableOwner{}
+	for owner, idx := range m.tableIdxByOwner {
+		ownersByIdx[idx] = owner
+	}
+	for idx, rt := range m.routeTables {
+		owner, ok := ownersByIdx[idx]
+		if !ok {
+			rt.SetRoutes(routetable.InterfaceNone, nil)
+			continue
+		}
+		rt.SetRoutes(routetable.InterfaceNone, tableRoutes[owner])
+	}
+
+	// Update the routing rules.
+	desiredRules := map[ip.Addr]int{}
+	for addr, owner := range neededRules {
+		if idx, ok := m.tableIdxByOwner[owner]; ok {
+			desiredRules[addr] = idx
+		}
+	}
+	for addr, idx := range m.activeRules {
+		if newIdx, ok := desiredRules[addr]; ok && newIdx == idx {
+			continue
+		}
+		m.routeRules.RemoveRule(m.makeRule(addr, idx))
+		delete(m.activeRules, addr)
+	}
+	for addr, idx := range desiredRules {
+		if _, ok := m.activeRules[addr]; ok {
+			continue
+		}
+		m.routeRules.SetRule(m.makeRule(addr, idx))
+		m.activeRules[addr] = idx
+	}
+
+	if resolveErr != nil {
+		// Try again later; the route to the gateway may not have been programmed yet.
+		m.dirty = true
+		return resolveErr
+	}
+	return nil
+}
+
+func (m *egressGatewayManager) inNATOutgoingPool(addr ip.Addr) bool {
+	for id, cidr := range m.poolCIDRs {
+		ipNet := cidr.ToIPNet()
+		if m.natPools.Contains(id) && ipNet.Contains(addr.AsNetIP()) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateNATOutgoingGateways warns about local gateways whose IPs are in IP pools with NAT outgoing
+// enabled: the traffic that they forward leaves with the node's IP rather than theirs.  Gateways
+// need a dedicated IP pool with natOutgoing set to false.
+func (m *egressGatewayManager) updateNATOutgoingGateways(gateways set.Set) {
+	gateways.Iter(func(item interface{}) error {
+		if !m.natGateways.Contains(item) {
+			log.WithField("gateway", item).Warn("Egress gateway is in an IP pool with natOutgoing " +
+				"enabled; its traffic will leave the cluster with the node's IP.  Egress gateways need " +
+				"an IP pool with natOutgoing set to false.")
+		}
+		return nil
+	})
+	m.natGateways = gateways
+	if m.featureWarnings == nil {
+		return
+	}
+	warning := ""
+	if n := gateways.Len(); n > 0 {
+		warning = fmt.Sprintf("%d egress gateway(s) are in IP pools with natOutgoing enabled", n)
+	}
+	m.featureWarnings.Set(featureEgressGatewayNAT, warning)
+}
+
+func (m *egressGatewayManager) makeRule(addr ip.Addr, tableIdx int) *routerule.Rule {
+	return routerule.NewRule(4, m.rulePriority).
+		MatchSrcAddress(addr.AsCIDR().ToIPNet()).
+		GoToTable(tableIdx)
+}
+
+// tableTargets returns the routes for an egress gateway routing table with the given next hops.
+func (m *egressGatewayManager) tableTargets(nextHops []routetable.NextHop) []routetable.Target {
+	var targets []routetable.Target
+	throwCIDRs := set.New()
+	for _, cidr := range m.poolCIDRs {
+		throwCIDRs.Add(cidr)
+	}
+	for _, addr := range m.hostIPs {
+		throwCIDRs.Add(addr.AsCIDR())
+	}
+	throwCIDRs.Iter(func(item interface{}) error {
+		targets = append(targets, routetable.Target{
+			Type: routetable.TargetTypeThrow,
+			CIDR: item.(ip.CIDR),
+		})
+		return nil
+	})
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i].CIDR.String() < targets[j].CIDR.String()
+	})
+
+	if len(nextHops) == 0 {
+		targets = append(targets, routetable.Target{
+			Type: routetable.TargetTypeBlackhole,
+			CIDR: defaultCIDRV4,
+		})
+	} else {
+		targets = append(targets, routetable.Target{
+			CIDR:      defaultCIDRV4,
+			MultiPath: nextHops,
+		})
+	}
+	return targets
+}
+
+// nextHops returns the next hops towards the healthy gateways among the given gateways.  Gateways
+// that share a next hop (such as the gateways on the same remote node) result in a single, weighted
+// next hop.
+func (m *egressGatewayManager) nextHops(gateways []ip.Addr) ([]routetable.NextHop, error) {
+	var err error
+	weights := map[routetable.NextHop]int{}
+	for _, addr := range gateways {
+		if m.unhealthyGateways.Contains(addr) {
+			continue
+		}
+		var nh routetable.NextHop
+		if iface, ok := m.localWorkloads[addr]; ok {
+			nh = routetable.NextHop{GW: addr, IfaceName: iface}
+		} else if remoteNH, ok := m.remoteNextHop(addr); ok {
+			nh = remoteNH
+		} else {
+			err = errEgressGatewayUnresolved
+			continue
+		}
+		weights[nh]++
+	}
+
+	var nextHops []routetable.NextHop
+	for nh, weight := range weights {
+		if weight > 1 {
+			nh.Weight = weight
+		}
+		nextHops = append(nextHops, nh)
+	}
+	sort.Slice(nextHops, func(i, j int) bool {
+		return nextHops[i].GW.String() < nextHops[j].GW.String()
+	})
+	return nextHops, err
+}
+
+// remoteNextHop looks up the main routing table's next hop towards a remote gateway.  The next hop
+// must be over a Calico tunnel or via another host, since the traffic is routed on its original
+// destination IP.
+func (m *egressGatewayManager) remoteNextHop(addr ip.Addr) (routetable.NextHop, bool) {
+	logCxt := log.WithField("gateway", addr)
+	routes, err := m.nl.RouteGet(addr.AsNetIP())
+	if err != nil || len(routes) == 0 || routes[0].Gw == nil {
+		logCxt.WithError(err).Warn("Failed to find route to egress gateway")
+		return routetable.NextHop{}, false
+	}
+	route := routes[0]
+	link, err := m.nl.LinkByIndex(route.LinkIndex)
+	if err != nil {
+		logCxt.WithError(err).Warn("Failed to find interface for route to egress gateway")
+		return routetable.NextHop{}, false
+	}
+	ifaceName := link.Attrs().Name
+	gw := ip.FromNetIP(route.Gw)
+
+	isHost := false
+	for _, hostIP := range m.hostIPs {
+		if hostIP == gw {
+			isHost = true
+			break
+		}
+	}
+	if !isHost && ifaceName != egressGatewayVXLANDevice && ifaceName != egressGatewayIPIPDevice {
+		logCxt.WithFields(log.Fields{
+			"iface": ifaceName,
+			"via":   gw,
+		}).Warn("Route to egress gateway is not via a tunnel or another host; ignoring gateway")
+		return routetable.NextHop{}, false
+	}
+	return routetable.NextHop{GW: gw, IfaceName: ifaceName}, true
+}
+
+// updateProbes starts health probes for the given gateways and stops those for any other gateways.
+func (m *egressGatewayManager) updateProbes(gateways set.Set) {
+	if m.healthPort == 0 {
+		return
+	}
+	for addr, cancel := range m.probeCancelsByAddr {
+		if gateways.Contains(addr) {
+			continue
+		}
+		log.WithField("gateway", addr).Debug("Stopping egress gateway health probe")
+		cancel()
+		delete(m.probeCancelsByAddr, addr)
+		m.unhealthyGateways.Discard(addr)
+	}
+	gateways.Iter(func(item interface{}) error {
+		addr := item.(ip.Addr)
+		if _, ok := m.probeCancelsByAddr[addr]; ok {
+			return nil
+		}
+		log.WithField("gateway", addr).Debug("Starting egress gateway health probe")
+		ctx, cancel := context.WithCancel(context.Background())
+		m.probeCancelsByAddr[addr] = cancel
+		go m.loopProbingGateway(ctx, addr)
+		return nil
+	})
+}
+
+// loopProbingGateway probes a gateway's readiness endpoint until the context is canceled,
+// reporting changes to the gateway's health to the main loop.  Gateways start out healthy.
+func (m *egressGatewayManager) loopProbingGateway(ctx context.Context, addr ip.Addr) {
+	url := fmt.Sprintf("http://%s/readiness", net.JoinHostPort(addr.String(), strconv.Itoa(m.healthPort)))
+	ticker := time.NewTicker(m.pollInterval)
+	defer ticker.Stop()
+
+	healthy := true
+	failures := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		probeCtx, cancel := context.WithTimeout(ctx, m.pollInterval)
+		err := m.probeFn(probeCtx, url)
+		cancel()
+		if err == nil {
+			failures = 0
+			if healthy {
+				continue
+			}
+			healthy = true
+		} else {
+			failures++
+			log.WithError(err).WithFields(log.Fields{
+				"gateway":  addr,
+				"failures": failures,
+			}).Debug("Egress gateway health probe failed")
+			if !healthy || failures < m.pollFailureCount {
+				continue
+			}
+			healthy = false
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case m.healthUpdates <- &egressGatewayHealthUpdate{addr: addr, healthy: healthy}:
+		}
+	}
+}
+
+func probeEgressGatewayHTTP(ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("readiness probe returned %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink"
+
+	"github.com/projectcalico/calico/felix/dataplane/common"
+	"github.com/projectcalico/calico/felix/ip"
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/felix/routerule"
+	"github.com/projectcalico/calico/felix/routetable"
+	"github.com/projectcalico/calico/felix/rules"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+)
+
+var _ = Describe("Egress gateway manager", func() {
+	var (
+		mgr         *egressGatewayManager
+		ipSets      *common.MockIPSets
+		rr          *mockRouteRules
+		routeTables map[int]*mockRouteTable
+		nl          *mockEgressGatewayNetlink
+		healthC     chan *egressGatewayHealthUpdate
+		warnings    *featureWarnings
+	)
+
+	const (
+		gatewaySetID = "e:abcd"
+		clientSetID  = "ec:abcd"
+	)
+
+	BeforeEach(func() {
+		ipSets = common.NewMockIPSets()
+		rr = &mockRouteRules{rules: map[string]int{}}
+		routeTables = map[int]*mockRouteTable{}
+		rts := map[int]routeTable{}
+		for _, idx := range []int{10, 11} {
+			routeTables[idx] = &mockRouteTable{
+				currentRoutes:   map[string][]routetable.Target{},
+				currentL2Routes: map[string][]routetable.L2Target{},
+			}
+			rts[idx] = routeTables[idx]
+		}
+		nl = &mockEgressGatewayNetlink{routes: map[string]netlink.Route{}}
+		healthC = make(chan *egressGatewayHealthUpdate, 10)
+		warnings = newFeatureWarnings()
+		mgr = newEgressGatewayManagerWithShims(ipSets, rr, rts, nl, nil, healthC, warnings, Config{
+			MaxIPSetSize:                     1024,
+			EgressGatewayRoutingRulePriority: 102,
+		})
+
+		mgr.OnUpdate(&proto.IPAMPoolUpdate{Id: "pool", Pool: &proto.IPAMPool{Cidr: "10.65.0.0/16"}})
+		mgr.OnUpdate(&proto.HostMetadataUpdate{Hostname: "host2", Ipv4Addr: "172.16.0.2"})
+	})
+
+	addLocalWorkload := func(name, iface, addr string) {
+		mgr.OnUpdate(&proto.WorkloadEndpointUpdate{
+			Id:       &proto.WorkloadEndpointID{OrchestratorId: "k8s", WorkloadId: name, EndpointId: "eth0"},
+			Endpoint: &proto.WorkloadEndpoint{Name: iface, Ipv4Nets: []string{addr + "/32"}},
+		})
+	}
+	throwRoutes := []routetable.Target{
+		{Type: routetable.TargetTypeThrow, CIDR: ip.MustParseCIDROrIP("10.65.0.0/16")},
+		{Type: routetable.TargetTypeThrow, CIDR: ip.MustParseCIDROrIP("172.16.0.2/32")},
+	}
+	withDefault := func(target routetable.Target) []routetable.Target {
+		target.CIDR = ip.MustParseCIDROrIP("0.0.0.0/0")
+		return append(append([]routetable.Target{}, throwRoutes...), target)
+	}
+
+	It("should create the client IP set on startup", func() {
+		Expect(ipSets.Members).To(Equal(map[string]set.Set{
+			rules.IPSetIDEgressGatewayClients: set.New(),
+		}))
+	})
+
+	It("should ignore other IP sets", func() {
+		mgr.OnUpdate(&proto.IPSetUpdate{Id: "s:abcd", Members: []string{"10.65.0.2/32"}})
+		Expect(mgr.CompleteDeferredWork()).To(Succeed())
+		Expect(ipSets.Members[rules.IPSetIDEgressGatewayClients].Len()).To(Equal(0))
+		Expect(rr.rules).To(BeEmpty())
+	})
+
+	Describe("with a local client and a local gateway", func() {
+		BeforeEach(func() {
+			addLocalWorkload("client", "cali1", "10.65.0.2")
+			addLocalWorkload("gw", "cali2", "10.65.0.3")
+			mgr.OnUpdate(&proto.IPSetUpdate{Id: clientSetID, Members: []string{"10.65.0.2/32"}})
+			mgr.OnUpdate(&proto.IPSetUpdate{Id: gatewaySetID, Members: []string{"10.65.0.3/32"}})
+			Expect(mgr.CompleteDeferredWork()).To(Succeed())
+		})
+
+		It("should route the client's traffic to the gateway", func() {
+			Expect(rr.rules).To(Equal(map[string]int{"10.65.0.2/32": 10}))
+			routeTables[10].checkRoutes(routetable.InterfaceNone, withDefault(routetable.Target{
+				MultiPath: []routetable.NextHop{{GW: ip.FromString("10.65.0.3"), IfaceName: "cali2"}},
+			}))
+			routeTables[11].checkRoutes(routetable.InterfaceNone, nil)
+			Expect(ipSets.Members[rules.IPSetIDEgressGatewayClients]).To(Equal(set.From("10.65.0.2")))
+		})
+
+		It("should blackhole the client's traffic when the gateway is unhealthy", func() {
+			mgr.probeCancelsByAddr[ip.FromString("10.65.0.3")] = func() {}
+			mgr.OnUpdate(&egressGatewayHealthUpdate{addr: ip.FromString("10.65.0.3"), healthy: false})
+			Expect(mgr.CompleteDeferredWork()).To(Succeed())
+			routeTables[10].checkRoutes(routetable.InterfaceNone, withDefault(routetable.Target{
+				Type: routetable.TargetTypeBlackhole,
+			}))
+
+			By("restoring the route when the gateway recovers")
+			mgr.OnUpdate(&egressGatewayHealthUpdate{addr: ip.FromString("10.65.0.3"), healthy: true})
+			Expect(mgr.CompleteDeferredWork()).To(Succeed())
+			routeTables[10].checkRoutes(routetable.InterfaceNone, withDefault(routetable.Target{
+				MultiPath: []routetable.NextHop{{GW: ip.FromString("10.65.0.3"), IfaceName: "cali2"}},
+			}))
+		})
+
+		It("should warn when the gateway is in a NAT outgoing pool", func() {
+			Expect(warnings.String()).To(BeEmpty())
+
+			mgr.OnUpdate(&proto.IPAMPoolUpdate{Id: "pool", Pool: &proto.IPAMPool{Cidr: "10.65.0.0/16", Masquerade: true}})
+			Expect(mgr.CompleteDeferredWork()).To(Succeed())
+			Expect(warnings.String()).To(ContainSubstring("1 egress gateway(s) are in IP pools with natOutgoing enabled"))
+
+			By("clearing the warning when the pool stops masquerading")
+			mgr.OnUpdate(&proto.IPAMPoolUpdate{Id: "pool", Pool: &proto.IPAMPool{Cidr: "10.65.0.0/16"}})
+			Expect(mgr.CompleteDeferredWork()).To(Succeed())
+			Expect(warnings.String()).To(BeEmpty())
+		})
+
+		It("should add remote gateways via the main table's next hop", func() {
+			nl.routes["10.65.1.3"] = netlink.Route{LinkIndex: 5, Gw: net.ParseIP("10.65.1.0")}
+			nl.routes["10.65.1.4"] = netlink.Route{LinkIndex: 5, Gw: net.ParseIP("10.65.1.0")}
+			mgr.OnUpdate(&proto.IPSetDeltaUpdate{Id: gatewaySetID, AddedMembers: []string{"10.65.1.3/32", "10.65.1.4/32"}})
+			Expect(mgr.CompleteDeferredWork()).To(Succeed())
+			routeTables[10].checkRoutes(routetable.InterfaceNone, withDefault(routetable.Target{
+				MultiPath: []routetable.NextHop{
+					{GW: ip.FromString("10.65.0.3"), IfaceName: "cali2"},
+					{GW: ip.FromString("10.65.1.0"), IfaceName: "vxlan.calico", Weight: 2},
+				},
+			}))
+		})
+
+		It("should route remote clients' traffic to the local gateway", func() {
+			mgr.OnUpdate(&proto.IPSetDeltaUpdate{Id: clientSetID, AddedMembers: []string{"10.65.1.2/32"}})
+			Expect(mgr.CompleteDeferredWork()).To(Succeed())
+			Expect(rr.rules).To(Equal(map[string]int{"10.65.0.2/32": 10, "10.65.1.2/32": 11}))
+			routeTables[11].checkRoutes(routetable.InterfaceNone, withDefault(routetable.Target{
+				MultiPath: []routetable.NextHop{{GW: ip.FromString("10.65.0.3"), IfaceName: "cali2"}},
+			}))
+			Expect(ipSets.Members[rules.IPSetIDEgressGatewayClients]).To(Equal(set.From("10.65.0.2", "10.65.1.2")))
+		})
+
+		It("should clean up when the IP sets are removed", func() {
+			mgr.OnUpdate(&proto.IPSetRemove{Id: clientSetID})
+			mgr.OnUpdate(&proto.IPSetRemove{Id: gatewaySetID})
+			Expect(mgr.CompleteDeferredWork()).To(Succeed())
+			Expect(rr.rules).To(BeEmpty())
+			routeTables[10].checkRoutes(routetable.InterfaceNone, nil)
+			Expect(ipSets.Members[rules.IPSetIDEgressGatewayClients].Len()).To(Equal(0))
+		})
+	})
+
+	It("should retry remote gateways that it can't resolve", func() {
+		addLocalWorkload("client", "cali1", "10.65.0.2")
+		mgr.OnUpdate(&proto.IPSetUpdate{Id: clientSetID, Members: []string{"10.65.0.2/32"}})
+		mgr.OnUpdate(&proto.IPSetUpdate{Id: gatewaySetID, Members: []string{"10.65.1.3/32"}})
+		Expect(mgr.CompleteDeferredWork()).To(Equal(errEgressGatewayUnresolved))
+		routeTables[10].checkRoutes(routetable.InterfaceNone, withDefault(routetable.Target{
+			Type: routetable.TargetTypeBlackhole,
+		}))
+
+		nl.routes["10.65.1.3"] = netlink.Route{LinkIndex: 2, Gw: net.ParseIP("172.16.0.2")}
+		Expect(mgr.CompleteDeferredWork()).To(Succeed())
+		routeTables[10].checkRoutes(routetable.InterfaceNone, withDefault(routetable.Target{
+			MultiPath: []routetable.NextHop{{GW: ip.FromString("172.16.0.2"), IfaceName: "eth0"}},
+		}))
+	})
+
+	It("should report a gateway as unhealthy after enough failed probes", func() {
+		var lock sync.Mutex
+		failing := true
+		mgr.probeFn = func(ctx context.Context, url string) error {
+			Expect(url).To(Equal("http://10.65.0.3:8080/readiness"))
+			lock.Lock()
+			defer lock.Unlock()
+			if failing {
+				return errors.New("probe failed")
+			}
+			return nil
+		}
+		mgr.healthPort = 8080
+		mgr.pollInterval = 10 * time.Millisecond
+		mgr.pollFailureCount = 3
+
+		mgr.updateProbes(set.From(ip.FromString("10.65.0.3")))
+		Eventually(healthC).Should(Receive(Equal(&egressGatewayHealthUpdate{
+			addr: ip.FromString("10.65.0.3"), healthy: false,
+		})))
+		lock.Lock()
+		failing = false
+		lock.Unlock()
+		Eventually(healthC).Should(Receive(Equal(&egressGatewayHealthUpdate{
+			addr: ip.FromString("10.65.0.3"), healthy: true,
+		})))
+
+		mgr.updateProbes(set.New())
+		Expect(mgr.probeCancelsByAddr).To(BeEmpty())
+	})
+})
+
+type mockRouteRules struct {
+	rules map[string]int
+}
+
+func (r *mockRouteRules) SetRule(rule *routerule.Rule) {
+	r.rules[rule.NetLinkRule().Src.String()] = rule.NetLinkRule().Table
+}
+
+func (r *mockRouteRules) RemoveRule(rule *routerule.Rule) {
+	Expect(r.rules[rule.NetLinkRule().Src.String()]).To(Equal(rule.NetLinkRule().Table))
+	delete(r.rules, rule.NetLinkRule().Src.String())
+}
+
+func (r *mockRouteRules) QueueResync() {}
+
+func (r *mockRouteRules) Apply() error {
+	return nil
+}
+
+type mockEgressGatewayNetlink struct {
+	routes map[string]netlink.Route
+}
+
+func (n *mockEgressGatewayNetlink) RouteGet(destination net.IP) ([]netlink.Route, error) {
+	route, ok := n.routes[destination.String()]
+	if !ok {
+		return nil, errors.New("no route")
+	}
+	return []netlink.Route{route}, nil
+}
+
+func (n *mockEgressGatewayNetlink) LinkByIndex(index int) (netlink.Link, error) {
+	names := map[int]string{2: "eth0", 5: "vxlan.calico"}
+	return &netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Index: index, Name: names[index]}}, nil
+}
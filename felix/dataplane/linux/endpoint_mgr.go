@@ -138,6 +138,7 @@ type endpointManager struct {
 	writeProcSys procSysWriter
 	osStat       func(path string) (os.FileInfo, error)
 	epMarkMapper rules.EndpointMarkMapper
+	qosDataplane qosDataplane
 
 	// Pending updates, cleared in CompleteDeferredWork as the data is copied to the activeXYZ
 	// fields.
@@ -188,12 +189,19 @@ type endpointManager struct {
 
 	needToCheckDispatchChains     bool
 	needToCheckEndpointMarkChains bool
+	needToCleanUpIFBs             bool
 
 	// Callbacks
 	OnEndpointStatusUpdate EndpointStatusUpdateCallback
 	callbacks              endpointManagerCallbacks
 	bpfEnabled             bool
 	bpfEndpointManager     hepListener
+
+	// wlsWithUnsupportedQoS holds the IDs of the workload endpoints whose bandwidth and packet
+	// rate limits are ignored because they aren't supported in BPF mode.
+	wlsWithUnsupportedQoS set.Set
+	// featureWarnings, if non-nil, receives the warning about those endpoints.
+	featureWarnings *featureWarnings
 }
 
 type EndpointStatusUpdateCallback func(ipVersion uint8, id interface{}, status string)
@@ -214,6 +222,7 @@ func newEndpointManager(
 	bpfEnabled bool,
 	bpfEndpointManager hepListener,
 	callbacks *common.Callbacks,
+	featureWarnings *featureWarnings,
 ) *endpointManager {
 	return newEndpointManagerWithShims(
 		rawTable,
@@ -228,9 +237,11 @@ func newEndpointManager(
 		onWorkloadEndpointStatusUpdate,
 		writeProcSys,
 		os.Stat,
+		realQoSNetlink{},
 		bpfEnabled,
 		bpfEndpointManager,
 		callbacks,
+		featureWarnings,
 	)
 }
 
@@ -247,9 +258,11 @@ func newEndpointManagerWithShims(
 	onWorkloadEndpointStatusUpdate EndpointStatusUpdateCallback,
 	procSysWriter procSysWriter,
 	osStat func(name string) (os.FileInfo, error),
+	qosDataplane qosDataplane,
 	bpfEnabled bool,
 	bpfEndpointManager hepListener,
 	callbacks *common.Callbacks,
+	featureWarnings *featureWarnings,
 ) *endpointManager {
 	wlIfacesPattern := "^(" + strings.Join(wlInterfacePrefixes, "|") + ").*"
 	wlIfacesRegexp := regexp.MustCompile(wlIfacesPattern)
@@ -269,6 +282,7 @@ func newEndpointManagerWithShims(
 		writeProcSys: procSysWriter,
 		osStat:       osStat,
 		epMarkMapper: epMarkMapper,
+		qosDataplane: qosDataplane,
 
 		// Pending updates, we store these up as OnUpdate is called, then process them
 		// in CompleteDeferredWork and transfer the important data to the activeXYX fields.
@@ -305,9 +319,13 @@ func newEndpointManagerWithShims(
 		activeEPMarkDispatchChains:     map[string]*iptables.Chain{},
 		needToCheckDispatchChains:      true, // Need to do start-of-day update.
 		needToCheckEndpointMarkChains:  true, // Need to do start-of-day update.
+		needToCleanUpIFBs:              true, // Need to do start-of-day cleanup.
 
 		OnEndpointStatusUpdate: onWorkloadEndpointStatusUpdate,
 		callbacks:              newEndpointManagerCallbacks(callbacks, ipVersion),
+
+		wlsWithUnsupportedQoS: set.New(),
+		featureWarnings:       featureWarnings,
 	}
 }
 
@@ -383,6 +401,11 @@ func (m *endpointManager) CompleteDeferredWork() error {
 
 	m.resolveWorkloadEndpoints()
 
+	if m.qosEnabled() && m.needToCleanUpIFBs {
+		m.cleanUpOrphanedIFBs()
+		m.needToCleanUpIFBs = false
+	}
+
 	if m.hostEndpointsDirty {
 		log.Debug("Host endpoints updated, resolving them.")
 		m.updateHostEndpoints()
@@ -538,6 +561,9 @@ func (m *endpointManager) resolveWorkloadEndpoints() {
 			m.routeTable.SetRoutes(oldWorkload.Name, nil)
 			m.wlIfaceNamesToReconfigure.Discard(oldWorkload.Name)
 			delete(m.activeWlIfaceNameToID, oldWorkload.Name)
+			if m.qosEnabled() && oldWorkload.QosControls != nil {
+				m.removeQoS(oldWorkload.Name)
+			}
 		}
 		delete(m.activeWlEndpoints, id)
 	}
@@ -550,6 +576,7 @@ func (m *endpointManager) resolveWorkloadEndpoints() {
 		for id, workload := range m.pendingWlEpUpdates {
 			logCxt := log.WithField("id", id)
 			oldWorkload := m.activeWlEndpoints[id]
+			m.checkQoSSupported(logCxt, id, workload)
 			if workload != nil {
 				// Check if there is already an active workload endpoint with the same
 				// interface name.
@@ -583,11 +610,9 @@ func (m *endpointManager) resolveWorkloadEndpoints() {
 					m.routeTable.SetRoutes(oldWorkload.Name, nil)
 					m.wlIfaceNamesToReconfigure.Discard(oldWorkload.Name)
 					delete(m.activeWlIfaceNameToID, oldWorkload.Name)
-				}
-				var ingressPolicyNames, egressPolicyNames []string
-				if len(workload.Tiers) > 0 {
-					ingressPolicyNames = workload.Tiers[0].IngressPolicies
-					egressPolicyNames = workload.Tiers[0].EgressPolicies
+					if m.qosEnabled() && oldWorkload.QosControls != nil {
+						m.removeQoS(oldWorkload.Name)
+					}
 				}
 				adminUp := workload.State == "active"
 				if !m.bpfEnabled {
@@ -595,9 +620,9 @@ func (m *endpointManager) resolveWorkloadEndpoints() {
 						workload.Name,
 						m.epMarkMapper,
 						adminUp,
-						ingressPolicyNames,
-						egressPolicyNames,
+						workload.Tiers,
 						workload.ProfileIds,
+						workload.QosControls,
 					)
 					m.filterTable.UpdateChains(chains)
 					m.activeWlIDToChains[id] = chains
@@ -922,24 +947,11 @@ func (m *endpointManager) updateHostEndpoints() {
 			hostEp := m.rawHostEndpoints[id]
 
 			// Update chains in the filter and mangle tables, for normal traffic.
-			var ingressPolicyNames, egressPolicyNames []string
-			var ingressForwardPolicyNames, egressForwardPolicyNames []string
-			if len(hostEp.Tiers) > 0 {
-				ingressPolicyNames = hostEp.Tiers[0].IngressPolicies
-				egressPolicyNames = hostEp.Tiers[0].EgressPolicies
-			}
-			if len(hostEp.ForwardTiers) > 0 {
-				ingressForwardPolicyNames = hostEp.ForwardTiers[0].IngressPolicies
-				egressForwardPolicyNames = hostEp.ForwardTiers[0].EgressPolicies
-			}
-
 			filtChains := m.ruleRenderer.HostEndpointToFilterChains(
 				ifaceName,
 				m.epMarkMapper,
-				ingressPolicyNames,
-				egressPolicyNames,
-				ingressForwardPolicyNames,
-				egressForwardPolicyNames,
+				hostEp.Tiers,
+				hostEp.ForwardTiers,
 				hostEp.ProfileIds,
 			)
 
@@ -951,7 +963,7 @@ func (m *endpointManager) updateHostEndpoints() {
 
 			mangleChains := m.ruleRenderer.HostEndpointToMangleEgressChains(
 				ifaceName,
-				egressPolicyNames,
+				hostEp.Tiers,
 				hostEp.ProfileIds,
 			)
 			if !reflect.DeepEqual(mangleChains, m.activeHostIfaceToMangleEgressChains[ifaceName]) {
@@ -968,13 +980,9 @@ func (m *endpointManager) updateHostEndpoints() {
 			hostEp := m.rawHostEndpoints[id]
 
 			// Update the mangle table for preDNAT policy.
-			var ingressPolicyNames []string
-			if len(hostEp.PreDnatTiers) > 0 {
-				ingressPolicyNames = hostEp.PreDnatTiers[0].IngressPolicies
-			}
 			mangleChains := m.ruleRenderer.HostEndpointToMangleIngressChains(
 				ifaceName,
-				ingressPolicyNames,
+				hostEp.PreDnatTiers,
 			)
 			if !reflect.DeepEqual(mangleChains, m.activeHostIfaceToMangleIngressChains[ifaceName]) {
 				m.mangleTable.UpdateChains(mangleChains)
@@ -1016,22 +1024,16 @@ func (m *endpointManager) updateHostEndpoints() {
 			hostEp := m.rawHostEndpoints[id]
 
 			// Update the raw chain, for untracked traffic.
-			var ingressPolicyNames, egressPolicyNames []string
-			if len(hostEp.UntrackedTiers) > 0 {
-				ingressPolicyNames = hostEp.UntrackedTiers[0].IngressPolicies
-				egressPolicyNames = hostEp.UntrackedTiers[0].EgressPolicies
-			}
 			var rawChains []*iptables.Chain
 			if m.bpfEnabled {
 				rawChains = append(rawChains, m.ruleRenderer.HostEndpointToRawEgressChain(
 					ifaceName,
-					egressPolicyNames,
+					hostEp.UntrackedTiers,
 				))
 			} else {
 				rawChains = m.ruleRenderer.HostEndpointToRawChains(
 					ifaceName,
-					ingressPolicyNames,
-					egressPolicyNames,
+					hostEp.UntrackedTiers,
 				)
 			}
 			if !reflect.DeepEqual(rawChains, m.activeHostIfaceToRawChains[ifaceName]) {
@@ -1232,7 +1234,56 @@ func (m *endpointManager) configureInterface(name string) error {
 		}
 	}
 
-	return configureInterface(name, int(m.ipVersion), m.writeProcSys)
+	err = configureInterface(name, int(m.ipVersion), m.writeProcSys)
+	if err != nil {
+		return err
+	}
+
+	if m.qosEnabled() {
+		return m.configureQoS(name)
+	}
+	return nil
+}
+
+// featureQoS is the featureWarnings key of the warning about unsupported workload bandwidth and
+// packet rate limits.
+const featureQoS = "qos"
+
+// qosEnabled returns true if this endpoint manager programs the tc qdiscs that implement workload
+// bandwidth limits.  The qdiscs apply to both IP versions so only the IPv4 manager programs them.
+// In BPF mode, the workload interfaces' tc hooks belong to the BPF programs.
+func (m *endpointManager) qosEnabled() bool {
+	return m.ipVersion == 4 && !m.bpfEnabled
+}
+
+// checkQoSSupported warns about a workload endpoint whose bandwidth and packet rate limits are
+// ignored because they aren't supported in BPF mode, and keeps the health report's warning about
+// such endpoints up to date.
+func (m *endpointManager) checkQoSSupported(logCxt *log.Entry, id proto.WorkloadEndpointID, workload *proto.WorkloadEndpoint) {
+	if m.ipVersion != 4 || !m.bpfEnabled {
+		return
+	}
+	if workload != nil && workload.QosControls != nil {
+		if m.wlsWithUnsupportedQoS.Contains(id) {
+			return
+		}
+		logCxt.Warn("Workload endpoint has bandwidth or packet rate limits, which are not " +
+			"supported in BPF mode; ignoring them.")
+		m.wlsWithUnsupportedQoS.Add(id)
+	} else if m.wlsWithUnsupportedQoS.Contains(id) {
+		m.wlsWithUnsupportedQoS.Discard(id)
+	} else {
+		return
+	}
+	if m.featureWarnings == nil {
+		return
+	}
+	warning := ""
+	if n := m.wlsWithUnsupportedQoS.Len(); n > 0 {
+		warning = fmt.Sprintf("bandwidth and packet rate limits of %d workload endpoint(s) are ignored: "+
+			"not supported in BPF mode", n)
+	}
+	m.featureWarnings.Set(featureQoS, warning)
 }
 
 func writeProcSys(path, value string) error {
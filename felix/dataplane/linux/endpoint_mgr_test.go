@@ -17,6 +17,7 @@ package intdataplane
 import (
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"strings"
 	"sync"
@@ -26,6 +27,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	log "github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
 
 	"github.com/projectcalico/calico/felix/dataplane/common"
 	"github.com/projectcalico/calico/felix/ip"
@@ -284,7 +286,7 @@ func chainsForIfaces(ifaceMetadata []string,
 			outRules = append(outRules, iptables.Rule{
 				Match:   iptables.Match(),
 				Action:  iptables.ClearMarkAction{Mark: 16},
-				Comment: []string{"Start of policies"},
+				Comment: []string{"Start of tier default"},
 			})
 			outRules = append(outRules, iptables.Rule{
 				Match:  iptables.Match().MarkClear(16),
@@ -308,7 +310,7 @@ func chainsForIfaces(ifaceMetadata []string,
 				outRules = append(outRules, iptables.Rule{
 					Match:   iptables.Match().MarkClear(16),
 					Action:  iptables.DropAction{},
-					Comment: []string{"Drop if no policies passed packet"},
+					Comment: []string{"End of tier default. Drop if no policies passed packet"},
 				})
 			}
 
@@ -364,7 +366,7 @@ func chainsForIfaces(ifaceMetadata []string,
 			inRules = append(inRules, iptables.Rule{
 				Match:   iptables.Match(),
 				Action:  iptables.ClearMarkAction{Mark: 16},
-				Comment: []string{"Start of policies"},
+				Comment: []string{"Start of tier default"},
 			})
 			// For untracked policy, we expect a tier with a policy in it.
 			inRules = append(inRules, iptables.Rule{
@@ -389,7 +391,7 @@ func chainsForIfaces(ifaceMetadata []string,
 				inRules = append(inRules, iptables.Rule{
 					Match:   iptables.Match().MarkClear(16),
 					Action:  iptables.DropAction{},
-					Comment: []string{"Drop if no policies passed packet"},
+					Comment: []string{"End of tier default. Drop if no policies passed packet"},
 				})
 			}
 
@@ -650,6 +652,7 @@ func endpointManagerTests(ipVersion uint8) func() {
 			mockProcSys     *testProcSys
 			statusReportRec *statusReportRecorder
 			hepListener     *testHEPListener
+			qosDataplane    *mockQoSDataplane
 		)
 
 		BeforeEach(func() {
@@ -690,6 +693,7 @@ func endpointManagerTests(ipVersion uint8) func() {
 			mockProcSys = &testProcSys{state: map[string]string{}, pathsThatExist: map[string]bool{}}
 			statusReportRec = &statusReportRecorder{currentState: map[interface{}]string{}}
 			hepListener = &testHEPListener{}
+			qosDataplane = newMockQoSDataplane()
 			epMgr = newEndpointManagerWithShims(
 				rawTable,
 				mangleTable,
@@ -703,9 +707,11 @@ func endpointManagerTests(ipVersion uint8) func() {
 				statusReportRec.endpointStatusUpdateCallback,
 				mockProcSys.write,
 				mockProcSys.stat,
+				qosDataplane,
 				false,
 				hepListener,
 				common.NewCallbacks(),
+				nil,
 			)
 		})
 
@@ -1833,6 +1839,123 @@ func endpointManagerTests(ipVersion uint8) func() {
 						})
 					})
 
+					Context("with QoS controls added to the endpoint", func() {
+						qosControls := &proto.QoSControls{
+							IngressBandwidth:  10000000,
+							EgressBandwidth:   20000000,
+							EgressBurst:       8000000,
+							IngressPacketRate: 100,
+						}
+						updateQoS := func(qos *proto.QoSControls) {
+							epMgr.OnUpdate(&proto.WorkloadEndpointUpdate{
+								Id: &wlEPID1,
+								Endpoint: &proto.WorkloadEndpoint{
+									State:       "active",
+									Mac:         "01:02:03:04:05:06",
+									Name:        "cali12345-ab",
+									ProfileIds:  []string{},
+									Tiers:       []*proto.TierInfo{},
+									Ipv4Nets:    []string{"10.0.240.2/24"},
+									Ipv6Nets:    []string{"2001:db8:2::2/128"},
+									QosControls: qos,
+								},
+							})
+							err := epMgr.ResolveUpdateBatch()
+							Expect(err).ToNot(HaveOccurred())
+							err = epMgr.CompleteDeferredWork()
+							Expect(err).ToNot(HaveOccurred())
+						}
+						ifbName := qosIFBName("cali12345-ab")
+
+						JustBeforeEach(func() {
+							updateQoS(qosControls)
+						})
+
+						It("should report endpoint up", func() {
+							Expect(statusReportRec.currentState).To(Equal(map[interface{}]string{
+								wlEPID1: "up",
+							}))
+						})
+
+						It("should render the packet rate limit", func() {
+							chains := filterTable.currentChains
+							Expect(chains).To(HaveKey("cali-tw-cali12345-ab"))
+							Expect(chains["cali-tw-cali12345-ab"].Rules[1].Match).To(Equal(iptables.Match().LimitPacketRate(100, 100)))
+						})
+
+						It("should program the bandwidth limits in the IPv4 manager only", func() {
+							if ipVersion == 6 {
+								Expect(qosDataplane.links).NotTo(HaveKey(ifbName))
+								Expect(qosDataplane.qdiscsOf("cali12345-ab")).To(BeEmpty())
+								return
+							}
+							Expect(qosDataplane.tbfRateOf("cali12345-ab")).To(Equal(uint64(1250000)))
+							Expect(qosDataplane.links).To(HaveKey(ifbName))
+							ifb := qosDataplane.links[ifbName]
+							Expect(ifb.Type()).To(Equal("ifb"))
+							Expect(ifb.Attrs().Flags & net.FlagUp).NotTo(BeZero())
+							Expect(ifb.Attrs().MTU).To(Equal(1440))
+							Expect(qosDataplane.tbfRateOf(ifbName)).To(Equal(uint64(2500000)))
+							Expect(qosDataplane.redirectOf("cali12345-ab")).To(Equal(ifb.Attrs().Index))
+							Expect(makeTBF(0, 20000000, 8000000).Buffer).To(BeNumerically(">",
+								makeTBF(0, 20000000, 0).Buffer))
+						})
+
+						It("should not reprogram unchanged limits", func() {
+							numUpdates := qosDataplane.numUpdates
+							epMgr.OnUpdate(&ifaceUpdate{Name: "cali12345-ab", State: "up"})
+							err := epMgr.ResolveUpdateBatch()
+							Expect(err).ToNot(HaveOccurred())
+							err = epMgr.CompleteDeferredWork()
+							Expect(err).ToNot(HaveOccurred())
+							Expect(qosDataplane.numUpdates).To(Equal(numUpdates))
+						})
+
+						Context("with the QoS controls changed", func() {
+							JustBeforeEach(func() {
+								updateQoS(&proto.QoSControls{IngressBandwidth: 30000000})
+							})
+
+							It("should update the ingress limit and remove the egress limit", func() {
+								if ipVersion == 6 {
+									Expect(qosDataplane.qdiscsOf("cali12345-ab")).To(BeEmpty())
+									return
+								}
+								Expect(qosDataplane.tbfRateOf("cali12345-ab")).To(Equal(uint64(3750000)))
+								Expect(qosDataplane.links).NotTo(HaveKey(ifbName))
+								Expect(qosDataplane.redirectOf("cali12345-ab")).To(BeZero())
+							})
+						})
+
+						Context("with the QoS controls removed", func() {
+							JustBeforeEach(func() {
+								updateQoS(nil)
+							})
+
+							It("should remove the qdiscs and IFB", func() {
+								Expect(qosDataplane.qdiscsOf("cali12345-ab")).To(BeEmpty())
+								Expect(qosDataplane.links).NotTo(HaveKey(ifbName))
+							})
+							It("should have expected chains", expectWlChainsFor("cali12345-ab"))
+						})
+
+						Context("with the endpoint removed", func() {
+							JustBeforeEach(func() {
+								epMgr.OnUpdate(&proto.WorkloadEndpointRemove{
+									Id: &wlEPID1,
+								})
+								err := epMgr.ResolveUpdateBatch()
+								Expect(err).ToNot(HaveOccurred())
+								err = epMgr.CompleteDeferredWork()
+								Expect(err).ToNot(HaveOccurred())
+							})
+
+							It("should remove the IFB", func() {
+								Expect(qosDataplane.links).NotTo(HaveKey(ifbName))
+							})
+						})
+					})
+
 					Context("changing the endpoint to another up interface", func() {
 						JustBeforeEach(func() {
 							epMgr.OnUpdate(&ifaceUpdate{
@@ -1945,6 +2068,19 @@ func endpointManagerTests(ipVersion uint8) func() {
 			})
 		})
 
+		It("should remove orphaned QoS IFB devices in the IPv4 manager", func() {
+			Expect(qosDataplane.LinkAdd(&netlink.Ifb{LinkAttrs: netlink.LinkAttrs{Name: "bwcq12345678901"}})).To(Succeed())
+			Expect(qosDataplane.LinkAdd(&netlink.Ifb{LinkAttrs: netlink.LinkAttrs{Name: "ifb0"}})).To(Succeed())
+			err := epMgr.CompleteDeferredWork()
+			Expect(err).ToNot(HaveOccurred())
+			if ipVersion == 4 {
+				Expect(qosDataplane.links).NotTo(HaveKey("bwcq12345678901"))
+			} else {
+				Expect(qosDataplane.links).To(HaveKey("bwcq12345678901"))
+			}
+			Expect(qosDataplane.links).To(HaveKey("ifb0"))
+		})
+
 		It("should check the correct path", func() {
 			mockProcSys.pathsThatExist[fmt.Sprintf("/proc/sys/net/ipv%d/conf/cali1234", ipVersion)] = true
 			Expect(epMgr.interfaceExistsInProcSys("cali1234")).To(BeTrue())
@@ -1957,6 +2093,85 @@ var _ = Describe("EndpointManager IPv4", endpointManagerTests(4))
 
 var _ = Describe("EndpointManager IPv6", endpointManagerTests(6))
 
+var _ = Describe("EndpointManager in BPF mode", func() {
+	var (
+		epMgr        *endpointManager
+		qosDataplane *mockQoSDataplane
+		warnings     *featureWarnings
+	)
+	wlEPID := proto.WorkloadEndpointID{
+		OrchestratorId: "k8s",
+		WorkloadId:     "pod-11",
+		EndpointId:     "endpoint-id-11",
+	}
+	updateWorkload := func(qos *proto.QoSControls) {
+		epMgr.OnUpdate(&proto.WorkloadEndpointUpdate{
+			Id: &wlEPID,
+			Endpoint: &proto.WorkloadEndpoint{
+				State:       "active",
+				Name:        "cali12345-ab",
+				Ipv4Nets:    []string{"10.0.240.2/24"},
+				QosControls: qos,
+			},
+		})
+		Expect(epMgr.ResolveUpdateBatch()).To(Succeed())
+		Expect(epMgr.CompleteDeferredWork()).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		rrConfig := rules.Config{
+			IPSetConfigV4:        ipsets.NewIPVersionConfig(ipsets.IPFamilyV4, "cali", nil, nil),
+			IptablesMarkAccept:   0x8,
+			IptablesMarkPass:     0x10,
+			IptablesMarkScratch0: 0x20,
+			IptablesMarkScratch1: 0x40,
+			IptablesMarkEndpoint: 0xff00,
+			BPFEnabled:           true,
+		}
+		qosDataplane = newMockQoSDataplane()
+		warnings = newFeatureWarnings()
+		epMgr = newEndpointManagerWithShims(
+			newMockTable("raw"),
+			newMockTable("mangle"),
+			newMockTable("filter"),
+			rules.NewRenderer(rrConfig),
+			&mockRouteTable{currentRoutes: map[string][]routetable.Target{}},
+			4,
+			rules.NewEndpointMarkMapper(rrConfig.IptablesMarkEndpoint, rrConfig.IptablesMarkNonCaliEndpoint),
+			false,
+			[]string{"cali"},
+			(&statusReportRecorder{currentState: map[interface{}]string{}}).endpointStatusUpdateCallback,
+			(&testProcSys{state: map[string]string{}, pathsThatExist: map[string]bool{}}).write,
+			(&testProcSys{state: map[string]string{}, pathsThatExist: map[string]bool{}}).stat,
+			qosDataplane,
+			true,
+			&testHEPListener{},
+			common.NewCallbacks(),
+			warnings,
+		)
+	})
+
+	It("should report, rather than program, bandwidth limits", func() {
+		updateWorkload(&proto.QoSControls{IngressBandwidth: 10000000})
+		Expect(qosDataplane.qdiscsOf("cali12345-ab")).To(BeEmpty())
+		Expect(warnings.String()).To(Equal(
+			"bandwidth and packet rate limits of 1 workload endpoint(s) are ignored: not supported in BPF mode"))
+
+		updateWorkload(nil)
+		Expect(warnings.String()).To(BeEmpty())
+	})
+
+	It("should clear the warning when the workload is removed", func() {
+		updateWorkload(&proto.QoSControls{IngressPacketRate: 100})
+		Expect(warnings.String()).NotTo(BeEmpty())
+
+		epMgr.OnUpdate(&proto.WorkloadEndpointRemove{Id: &wlEPID})
+		Expect(epMgr.ResolveUpdateBatch()).To(Succeed())
+		Expect(epMgr.CompleteDeferredWork()).To(Succeed())
+		Expect(warnings.String()).To(BeEmpty())
+	})
+})
+
 type testProcSys struct {
 	lock           sync.Mutex
 	state          map[string]string
@@ -2023,3 +2238,140 @@ func (t *testHEPListener) OnHEPUpdate(hostIfaceToEpMap map[string]proto.HostEndp
 			",AoF=" + stringify(hep.ForwardTiers)
 	}
 }
+
+// mockQoSDataplane is a fake qosDataplane that tracks links and their qdiscs and filters.  Workload
+// interfaces ("cali*") spring into existence when they are looked up.
+type mockQoSDataplane struct {
+	links      map[string]netlink.Link
+	qdiscs     map[int][]netlink.Qdisc
+	filters    map[int][]netlink.Filter
+	nextIndex  int
+	numUpdates int
+}
+
+func newMockQoSDataplane() *mockQoSDataplane {
+	return &mockQoSDataplane{
+		links:     map[string]netlink.Link{},
+		qdiscs:    map[int][]netlink.Qdisc{},
+		filters:   map[int][]netlink.Filter{},
+		nextIndex: 10,
+	}
+}
+
+func (d *mockQoSDataplane) LinkList() ([]netlink.Link, error) {
+	var links []netlink.Link
+	for _, l := range d.links {
+		links = append(links, l)
+	}
+	return links, nil
+}
+
+func (d *mockQoSDataplane) LinkByName(name string) (netlink.Link, error) {
+	if l, ok := d.links[name]; ok {
+		return l, nil
+	}
+	if strings.HasPrefix(name, "cali") {
+		l := &netlink.Veth{LinkAttrs: netlink.LinkAttrs{Name: name, MTU: 1440, Flags: net.FlagUp}}
+		Expect(d.LinkAdd(l)).To(Succeed())
+		return l, nil
+	}
+	return nil, errors.New("Link not found")
+}
+
+func (d *mockQoSDataplane) LinkAdd(link netlink.Link) error {
+	if _, ok := d.links[link.Attrs().Name]; ok {
+		return errors.New("file exists")
+	}
+	d.nextIndex++
+	link.Attrs().Index = d.nextIndex
+	d.links[link.Attrs().Name] = link
+	d.numUpdates++
+	return nil
+}
+
+func (d *mockQoSDataplane) LinkDel(link netlink.Link) error {
+	delete(d.links, link.Attrs().Name)
+	delete(d.qdiscs, link.Attrs().Index)
+	delete(d.filters, link.Attrs().Index)
+	d.numUpdates++
+	return nil
+}
+
+func (d *mockQoSDataplane) LinkSetUp(link netlink.Link) error {
+	link.Attrs().Flags |= net.FlagUp
+	d.numUpdates++
+	return nil
+}
+
+func (d *mockQoSDataplane) QdiscList(link netlink.Link) ([]netlink.Qdisc, error) {
+	return d.qdiscs[link.Attrs().Index], nil
+}
+
+func (d *mockQoSDataplane) QdiscReplace(qdisc netlink.Qdisc) error {
+	idx := qdisc.Attrs().LinkIndex
+	var qdiscs []netlink.Qdisc
+	for _, q := range d.qdiscs[idx] {
+		if q.Attrs().Parent != qdisc.Attrs().Parent {
+			qdiscs = append(qdiscs, q)
+		}
+	}
+	d.qdiscs[idx] = append(qdiscs, qdisc)
+	d.numUpdates++
+	return nil
+}
+
+func (d *mockQoSDataplane) QdiscDel(qdisc netlink.Qdisc) error {
+	idx := qdisc.Attrs().LinkIndex
+	var qdiscs []netlink.Qdisc
+	for _, q := range d.qdiscs[idx] {
+		if q.Attrs().Parent != qdisc.Attrs().Parent {
+			qdiscs = append(qdiscs, q)
+		}
+	}
+	d.qdiscs[idx] = qdiscs
+	if qdisc.Attrs().Parent == netlink.HANDLE_INGRESS {
+		delete(d.filters, idx)
+	}
+	d.numUpdates++
+	return nil
+}
+
+func (d *mockQoSDataplane) FilterList(link netlink.Link, parent uint32) ([]netlink.Filter, error) {
+	return d.filters[link.Attrs().Index], nil
+}
+
+func (d *mockQoSDataplane) FilterAdd(filter netlink.Filter) error {
+	idx := filter.Attrs().LinkIndex
+	d.filters[idx] = append(d.filters[idx], filter)
+	d.numUpdates++
+	return nil
+}
+
+func (d *mockQoSDataplane) qdiscsOf(name string) []netlink.Qdisc {
+	l, ok := d.links[name]
+	if !ok {
+		return nil
+	}
+	return d.qdiscs[l.Attrs().Index]
+}
+
+func (d *mockQoSDataplane) tbfRateOf(name string) uint64 {
+	for _, q := range d.qdiscsOf(name) {
+		if tbf, ok := q.(*netlink.Tbf); ok {
+			return tbf.Rate
+		}
+	}
+	return 0
+}
+
+// redirectOf returns the index of the link that the given link's ingress traffic is redirected to.
+func (d *mockQoSDataplane) redirectOf(name string) int {
+	for _, f := range d.filters[d.links[name].Attrs().Index] {
+		for _, a := range f.(*netlink.U32).Actions {
+			if mirred, ok := a.(*netlink.MirredAction); ok {
+				return mirred.Ifindex
+			}
+		}
+	}
+	return 0
+}
@@ -0,0 +1,346 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net"
+	"strings"
+	"syscall"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink"
+
+	"github.com/projectcalico/calico/felix/netlinkshim"
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+)
+
+const (
+	// qosIFBPrefix is the prefix of the IFB devices that we use to shape traffic from workloads.
+	// It must not overlap with the workload interface prefixes.
+	qosIFBPrefix = "bwcq"
+
+	// qosTBFLatencyUsec is the longest time that a packet may wait in a TBF queue before it
+	// is dropped.
+	qosTBFLatencyUsec = 25000
+
+	// qosMinDefaultBurstBytes is the minimum default burst.  TBF drops packets that are bigger
+	// than the burst so it needs to be big enough for a GSO packet.
+	qosMinDefaultBurstBytes = 64 * 1024
+
+	qosIFBTxQueueLen = 1000
+)
+
+var (
+	// Handles of the qdiscs that we add.  The TBF handle is distinctive so that we can tell our
+	// TBF qdiscs apart from any that were added by someone else.
+	qosTBFHandle     = netlink.MakeHandle(0xca1, 0)
+	qosIngressHandle = netlink.MakeHandle(0xffff, 0)
+)
+
+// qosIFBName returns the name of the IFB device used to shape the traffic from the workload with
+// the given interface.
+func qosIFBName(ifaceName string) string {
+	h := sha1.Sum([]byte(ifaceName))
+	return qosIFBPrefix + hex.EncodeToString(h[:])[:11]
+}
+
+// configureQoS programs the tc qdiscs that implement the bandwidth limits of the workload with
+// the given interface, or removes them if the workload has no limits.  Traffic to the workload is
+// shaped by a TBF qdisc at the root of the workload interface.  Traffic from the workload arrives
+// on the interface's ingress qdisc, which has no queue, so we redirect it to an IFB device and
+// shape it with a TBF qdisc there.  (Packet rate limits are enforced by the workload's iptables
+// chains.)
+func (m *endpointManager) configureQoS(ifaceName string) error {
+	var qos *proto.QoSControls
+	if id, ok := m.activeWlIfaceNameToID[ifaceName]; ok {
+		qos = m.activeWlEndpoints[id].QosControls
+	}
+	link, err := m.qosDataplane.LinkByName(ifaceName)
+	if err != nil {
+		return err
+	}
+	logCxt := log.WithFields(log.Fields{"ifaceName": ifaceName, "qos": qos})
+	logCxt.Debug("Configuring QoS controls on interface")
+	if err := m.ensureTBF(link, qos.GetIngressBandwidth(), qos.GetIngressBurst()); err != nil {
+		return fmt.Errorf("failed to configure ingress bandwidth: %w", err)
+	}
+	if err := m.ensureEgressShaping(link, qos.GetEgressBandwidth(), qos.GetEgressBurst()); err != nil {
+		return fmt.Errorf("failed to configure egress bandwidth: %w", err)
+	}
+	return nil
+}
+
+// removeQoS removes the bandwidth limits of a workload that has been removed.  The workload
+// interface and its qdiscs are usually gone by now but the IFB device needs to be cleaned up.
+func (m *endpointManager) removeQoS(ifaceName string) {
+	link, err := m.qosDataplane.LinkByName(ifaceName)
+	if err == nil {
+		if err = m.ensureTBF(link, 0, 0); err == nil {
+			err = m.ensureEgressShaping(link, 0, 0)
+		}
+	} else if netlinkshim.IsNotExist(err) {
+		err = m.removeIFB(qosIFBName(ifaceName))
+	}
+	if err != nil {
+		log.WithError(err).WithField("ifaceName", ifaceName).Warn("Failed to remove QoS controls")
+	}
+}
+
+// cleanUpOrphanedIFBs removes any IFB devices that were left behind by workloads that were
+// removed while Felix wasn't running.
+func (m *endpointManager) cleanUpOrphanedIFBs() {
+	expected := set.New()
+	for ifaceName := range m.activeWlIfaceNameToID {
+		expected.Add(qosIFBName(ifaceName))
+	}
+	links, err := m.qosDataplane.LinkList()
+	if err != nil {
+		log.WithError(err).Warn("Failed to list interfaces, unable to clean up orphaned IFB devices")
+		return
+	}
+	for _, link := range links {
+		name := link.Attrs().Name
+		if link.Type() != "ifb" || !strings.HasPrefix(name, qosIFBPrefix) || expected.Contains(name) {
+			continue
+		}
+		log.WithField("name", name).Info("Removing orphaned QoS IFB device")
+		if err := m.qosDataplane.LinkDel(link); err != nil {
+			log.WithError(err).WithField("name", name).Warn("Failed to remove orphaned IFB device")
+		}
+	}
+}
+
+// ensureTBF makes sure that the root qdisc of the given link is a TBF with the given rate and
+// burst, in bits, or, if the rate is zero, that there's no TBF qdisc of ours.
+func (m *endpointManager) ensureTBF(link netlink.Link, rateBits, burstBits int64) error {
+	qdiscs, err := m.qosDataplane.QdiscList(link)
+	if err != nil {
+		return err
+	}
+	var existing *netlink.Tbf
+	for _, q := range qdiscs {
+		if tbf, ok := q.(*netlink.Tbf); ok && tbf.Parent == netlink.HANDLE_ROOT && tbf.Handle == qosTBFHandle {
+			existing = tbf
+		}
+	}
+	if rateBits == 0 {
+		if existing == nil {
+			return nil
+		}
+		log.WithField("link", link.Attrs().Name).Info("Removing TBF qdisc")
+		return m.qosDataplane.QdiscDel(existing)
+	}
+	desired := makeTBF(link.Attrs().Index, rateBits, burstBits)
+	if existing != nil &&
+		existing.Rate == desired.Rate &&
+		existing.Buffer == desired.Buffer &&
+		existing.Limit == desired.Limit {
+		return nil
+	}
+	log.WithFields(log.Fields{
+		"link":      link.Attrs().Name,
+		"rateBits":  rateBits,
+		"burstBits": burstBits,
+	}).Info("Programming TBF qdisc")
+	return m.qosDataplane.QdiscReplace(desired)
+}
+
+// ensureEgressShaping makes sure that traffic arriving on the given workload link is redirected to
+// an IFB device that shapes it to the given rate and burst, in bits.  If the rate is zero, it
+// removes the redirect and the IFB device.
+func (m *endpointManager) ensureEgressShaping(link netlink.Link, rateBits, burstBits int64) error {
+	ifbName := qosIFBName(link.Attrs().Name)
+	if rateBits == 0 {
+		ifb, err := m.qosDataplane.LinkByName(ifbName)
+		if netlinkshim.IsNotExist(err) {
+			// No IFB so we never added the redirect.
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := m.removeIngressQdisc(link); err != nil {
+			return err
+		}
+		log.WithField("name", ifbName).Info("Removing QoS IFB device")
+		return m.qosDataplane.LinkDel(ifb)
+	}
+
+	ifb, err := m.ensureIFB(ifbName, link.Attrs().MTU)
+	if err != nil {
+		return err
+	}
+	if err := m.ensureTBF(ifb, rateBits, burstBits); err != nil {
+		return err
+	}
+	return m.ensureRedirect(link, ifb)
+}
+
+func (m *endpointManager) ensureIFB(name string, mtu int) (netlink.Link, error) {
+	ifb, err := m.qosDataplane.LinkByName(name)
+	if netlinkshim.IsNotExist(err) {
+		log.WithField("name", name).Info("Creating QoS IFB device")
+		err = m.qosDataplane.LinkAdd(&netlink.Ifb{
+			LinkAttrs: netlink.LinkAttrs{
+				Name:   name,
+				MTU:    mtu,
+				TxQLen: qosIFBTxQueueLen,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		ifb, err = m.qosDataplane.LinkByName(name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if ifb.Attrs().Flags&net.FlagUp == 0 {
+		if err := m.qosDataplane.LinkSetUp(ifb); err != nil {
+			return nil, err
+		}
+	}
+	return ifb, nil
+}
+
+// ensureRedirect makes sure that the given link has an ingress qdisc with a filter that redirects
+// all traffic to the given IFB device.
+func (m *endpointManager) ensureRedirect(link, ifb netlink.Link) error {
+	qdiscs, err := m.qosDataplane.QdiscList(link)
+	if err != nil {
+		return err
+	}
+	for _, q := range qdiscs {
+		if _, ok := q.(*netlink.Ingress); !ok {
+			continue
+		}
+		filters, err := m.qosDataplane.FilterList(link, qosIngressHandle)
+		if err != nil {
+			return err
+		}
+		for _, f := range filters {
+			if filterRedirectsTo(f, ifb.Attrs().Index) {
+				return nil
+			}
+		}
+		// Wrong or missing filter; start again with a fresh qdisc.
+		if err := m.qosDataplane.QdiscDel(q); err != nil {
+			return err
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"link": link.Attrs().Name,
+		"ifb":  ifb.Attrs().Name,
+	}).Info("Redirecting workload traffic to IFB device")
+	err = m.qosDataplane.QdiscReplace(&netlink.Ingress{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: link.Attrs().Index,
+			Handle:    qosIngressHandle,
+			Parent:    netlink.HANDLE_INGRESS,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return m.qosDataplane.FilterAdd(&netlink.U32{
+		FilterAttrs: netlink.FilterAttrs{
+			LinkIndex: link.Attrs().Index,
+			Parent:    qosIngressHandle,
+			Priority:  1,
+			Protocol:  syscall.ETH_P_ALL,
+		},
+		ClassId:    netlink.MakeHandle(1, 1),
+		RedirIndex: ifb.Attrs().Index,
+		Actions:    []netlink.Action{netlink.NewMirredAction(ifb.Attrs().Index)},
+	})
+}
+
+func (m *endpointManager) removeIngressQdisc(link netlink.Link) error {
+	qdiscs, err := m.qosDataplane.QdiscList(link)
+	if err != nil {
+		return err
+	}
+	for _, q := range qdiscs {
+		if _, ok := q.(*netlink.Ingress); ok {
+			log.WithField("link", link.Attrs().Name).Info("Removing ingress qdisc")
+			return m.qosDataplane.QdiscDel(q)
+		}
+	}
+	return nil
+}
+
+func (m *endpointManager) removeIFB(name string) error {
+	ifb, err := m.qosDataplane.LinkByName(name)
+	if netlinkshim.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	log.WithField("name", name).Info("Removing QoS IFB device")
+	return m.qosDataplane.LinkDel(ifb)
+}
+
+func filterRedirectsTo(f netlink.Filter, ifIndex int) bool {
+	u32, ok := f.(*netlink.U32)
+	if !ok {
+		return false
+	}
+	for _, a := range u32.Actions {
+		if mirred, ok := a.(*netlink.MirredAction); ok &&
+			mirred.MirredAction == netlink.TCA_EGRESS_REDIR &&
+			mirred.Ifindex == ifIndex {
+			return true
+		}
+	}
+	return false
+}
+
+// makeTBF returns a TBF qdisc for the given rate and burst, in bits.  If the burst is zero, it
+// defaults to 100ms of traffic at the given rate.
+func makeTBF(linkIndex int, rateBits, burstBits int64) *netlink.Tbf {
+	rate := uint64(rateBits) / 8
+	burst := uint64(burstBits) / 8
+	if burst == 0 {
+		burst = rate / 10
+		if burst < qosMinDefaultBurstBytes {
+			burst = qosMinDefaultBurstBytes
+		}
+	}
+	// TBF takes the burst as the time, in ticks, needed to send it at the configured rate.
+	bufferUsec := float64(burst) * netlink.TIME_UNITS_PER_SEC / float64(rate)
+	limit := float64(rate)*qosTBFLatencyUsec/netlink.TIME_UNITS_PER_SEC + float64(burst)
+	return &netlink.Tbf{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: linkIndex,
+			Handle:    qosTBFHandle,
+			Parent:    netlink.HANDLE_ROOT,
+		},
+		Rate:   rate,
+		Buffer: clampUint32(bufferUsec * netlink.TickInUsec()),
+		Limit:  clampUint32(limit),
+	}
+}
+
+func clampUint32(f float64) uint32 {
+	if f > math.MaxUint32 {
+		return math.MaxUint32
+	}
+	return uint32(f)
+}
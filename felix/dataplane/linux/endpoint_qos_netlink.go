@@ -0,0 +1,76 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	"github.com/vishvananda/netlink"
+)
+
+// qosDataplane is a shim interface for mocking the netlink calls that the endpoint manager uses to
+// program the tc qdiscs that implement workload QoS controls.
+type qosDataplane interface {
+	LinkList() ([]netlink.Link, error)
+	LinkByName(name string) (netlink.Link, error)
+	LinkAdd(link netlink.Link) error
+	LinkDel(link netlink.Link) error
+	LinkSetUp(link netlink.Link) error
+	QdiscList(link netlink.Link) ([]netlink.Qdisc, error)
+	QdiscReplace(qdisc netlink.Qdisc) error
+	QdiscDel(qdisc netlink.Qdisc) error
+	FilterList(link netlink.Link, parent uint32) ([]netlink.Filter, error)
+	FilterAdd(filter netlink.Filter) error
+}
+
+type realQoSNetlink struct{}
+
+func (r realQoSNetlink) LinkList() ([]netlink.Link, error) {
+	return netlink.LinkList()
+}
+
+func (r realQoSNetlink) LinkByName(name string) (netlink.Link, error) {
+	return netlink.LinkByName(name)
+}
+
+func (r realQoSNetlink) LinkAdd(link netlink.Link) error {
+	return netlink.LinkAdd(link)
+}
+
+func (r realQoSNetlink) LinkDel(link netlink.Link) error {
+	return netlink.LinkDel(link)
+}
+
+func (r realQoSNetlink) LinkSetUp(link netlink.Link) error {
+	return netlink.LinkSetUp(link)
+}
+
+func (r realQoSNetlink) QdiscList(link netlink.Link) ([]netlink.Qdisc, error) {
+	return netlink.QdiscList(link)
+}
+
+func (r realQoSNetlink) QdiscReplace(qdisc netlink.Qdisc) error {
+	return netlink.QdiscReplace(qdisc)
+}
+
+func (r realQoSNetlink) QdiscDel(qdisc netlink.Qdisc) error {
+	return netlink.QdiscDel(qdisc)
+}
+
+func (r realQoSNetlink) FilterList(link netlink.Link, parent uint32) ([]netlink.Filter, error) {
+	return netlink.FilterList(link, parent)
+}
+
+func (r realQoSNetlink) FilterAdd(filter netlink.Filter) error {
+	return netlink.FilterAdd(filter)
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// featureWarnings collects warnings about features that are in use but that the dataplane can't
+// implement, so that they're reported in the detail of the dataplane's health report as well as
+// being logged.
+type featureWarnings struct {
+	lock     sync.Mutex
+	warnings map[string]string
+}
+
+func newFeatureWarnings() *featureWarnings {
+	return &featureWarnings{warnings: map[string]string{}}
+}
+
+// Set records the warning for the given feature, or clears it if the warning is empty.
+func (w *featureWarnings) Set(feature, warning string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	if warning == "" {
+		delete(w.warnings, feature)
+		return
+	}
+	w.warnings[feature] = warning
+}
+
+// String returns the current warnings, ordered by feature.
+func (w *featureWarnings) String() string {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	features := make([]string, 0, len(w.warnings))
+	for f := range w.warnings {
+		features = append(features, f)
+	}
+	sort.Strings(features)
+	warnings := make([]string, len(features))
+	for i, f := range features {
+		warnings[i] = w.warnings[f]
+	}
+	return strings.Join(warnings, "; ")
+}
@@ -45,6 +45,7 @@ import (
 	bpfproxy "github.com/projectcalico/calico/felix/bpf/proxy"
 
 	"github.com/projectcalico/calico/felix/bpf/tc"
+	"github.com/projectcalico/calico/felix/collector"
 	"github.com/projectcalico/calico/felix/config"
 	"github.com/projectcalico/calico/felix/dataplane/common"
 	"github.com/projectcalico/calico/felix/idalloc"
@@ -54,6 +55,7 @@ import (
 	"github.com/projectcalico/calico/felix/jitter"
 	"github.com/projectcalico/calico/felix/labelindex"
 	"github.com/projectcalico/calico/felix/logutils"
+	"github.com/projectcalico/calico/felix/nftables"
 	"github.com/projectcalico/calico/felix/proto"
 	"github.com/projectcalico/calico/felix/routerule"
 	"github.com/projectcalico/calico/felix/routetable"
@@ -74,6 +76,10 @@ const (
 
 	// Interface name used by kube-proxy to bind service ips.
 	KubeIPVSInterface = "kube-ipvs0"
+
+	// IptablesBackendNFTNative is the IptablesBackend value that selects the native nftables
+	// dataplane.
+	IptablesBackendNFTNative = "nftnative"
 )
 
 var (
@@ -128,6 +134,7 @@ type Config struct {
 	RuleRendererOverride rules.RuleRenderer
 	IPIPMTU              int
 	VXLANMTU             int
+	VXLANMTUV6           int
 	VXLANPort            int
 
 	MaxIPSetSize int
@@ -168,6 +175,29 @@ type Config struct {
 
 	ExternalNodesCidrs []string
 
+	DNSTrustedServers []string
+	DNSExtraTTL       time.Duration
+
+	FlowLogsEnabled           bool
+	FlowLogsFlushInterval     time.Duration
+	FlowLogsFileEnabled       bool
+	FlowLogsFileDirectory     string
+	FlowLogsFileMaxFiles      int
+	FlowLogsFileMaxFileSizeMB int
+
+	CaptureDir          string
+	CaptureMaxSizeBytes int
+	CaptureMaxFiles     int
+
+	PolicyRuleCountersEnabled bool
+
+	EgressGatewayEnabled             bool
+	EgressGatewayRoutingRulePriority int
+	EgressGatewayRoutingTables       int
+	EgressGatewayHealthPort          int
+	EgressGatewayPollInterval        time.Duration
+	EgressGatewayPollFailureCount    int
+
 	BPFEnabled                         bool
 	BPFDisableUnprivileged             bool
 	BPFKubeProxyIptablesCleanupEnabled bool
@@ -248,27 +278,58 @@ type InternalDataplane struct {
 	toDataplane   chan interface{}
 	fromDataplane chan interface{}
 
-	allIptablesTables    []*iptables.Table
-	iptablesMangleTables []*iptables.Table
-	iptablesNATTables    []*iptables.Table
-	iptablesRawTables    []*iptables.Table
-	iptablesFilterTables []*iptables.Table
+	allIptablesTables    []fullIptablesTable
+	iptablesMangleTables []fullIptablesTable
+	iptablesNATTables    []fullIptablesTable
+	iptablesRawTables    []fullIptablesTable
+	iptablesFilterTables []fullIptablesTable
 	ipSets               []common.IPSetsDataplane
+	// nftablesDataplanes, in native nftables mode, write the updates to the nftables tables and
+	// IP sets above, one transaction per IP version.
+	nftablesDataplanes []*nftables.Dataplane
 
 	ipipManager *ipipManager
 
-	wireguardManager *wireguardManager
+	wireguardManager   *wireguardManager
+	wireguardManagerV6 *wireguardManager
+
+	// captureManager captures the traffic of the endpoints selected by PacketCaptures.
+	captureManager *captureManager
+
+	// domainInfoStore tracks the IPs of the domain names in policy, learned from DNS responses.
+	domainInfoStore *domainInfoStore
+
+	// featureWarnings collects warnings about features that are in use but that this dataplane
+	// can't implement, for the health report.
+	featureWarnings *featureWarnings
+
+	// flowLogCollector aggregates policy verdicts into flow logs; nil if flow logs are disabled.
+	flowLogCollector *collector.Collector
+	// In BPF mode, the perf event array that the policy programs report verdicts through.  The
+	// rule IDs map the IDs in BPF verdicts, or in NFLOG prefixes too short to hold the rule, back
+	// to the rules.
+	flowLogEventsMap bpf.Map
+	flowLogRuleIDs   *collector.RuleIDs
+
+	// cachedRuleCounters, if non-nil, is the policy rule counters collector whose counters the
+	// dataplane loop refreshes periodically.
+	cachedRuleCounters *policyRuleCountersCollector
 
 	ifaceMonitor     *ifacemonitor.InterfaceMonitor
 	ifaceUpdates     chan *ifaceUpdate
 	ifaceAddrUpdates chan *ifaceAddrsUpdate
 
+	// egressGatewayHealthUpdates carries the results of the egress gateway health probes; nil if
+	// egress gateways are disabled.
+	egressGatewayHealthUpdates chan *egressGatewayHealthUpdate
+
 	endpointStatusCombiner *endpointStatusCombiner
 
-	allManagers             []Manager
-	managersWithRouteTables []ManagerWithRouteTables
-	managersWithRouteRules  []ManagerWithRouteRules
-	ruleRenderer            rules.RuleRenderer
+	allManagers              []Manager
+	managersWithRouteTables  []ManagerWithRouteTables
+	managersWithRouteRules   []ManagerWithRouteRules
+	managersWithRescheduling []ManagerWithRescheduling
+	ruleRenderer             rules.RuleRenderer
 
 	// dataplaneNeedsSync is set if the dataplane is dirty in some way, i.e. we need to
 	// call apply().
@@ -285,6 +346,10 @@ type InternalDataplane struct {
 	// doneFirstApply is set after we finish the first update to the dataplane. It indicates
 	// that the dataplane should now be in sync.
 	doneFirstApply bool
+	// cleanUpAfterFirstApply, if non-nil, removes the state programmed by the iptables or
+	// native nftables dataplane, whichever is not in use.  It is run after the first apply so
+	// that there's no gap in policy enforcement when switching between them.
+	cleanUpAfterFirstApply func()
 
 	reschedTimer *time.Timer
 	reschedC     <-chan time.Time
@@ -304,18 +369,36 @@ type InternalDataplane struct {
 	loopSummarizer *logutils.Summarizer
 }
 
+// featureNFTablesRender is the feature warning for chains that the native nftables dataplane
+// failed to render.
+const featureNFTablesRender = "nftables-render"
+
 const (
 	healthName     = "int_dataplane"
 	healthInterval = 10 * time.Second
 
-	ipipMTUOverhead      = 20
-	vxlanMTUOverhead     = 50
-	wireguardMTUOverhead = 60
-	aksMTUOverhead       = 100
+	ipipMTUOverhead        = 20
+	vxlanMTUOverhead       = 50
+	vxlanV6MTUOverhead     = 70
+	wireguardMTUOverhead   = 60
+	wireguardV6MTUOverhead = 80
+	aksMTUOverhead         = 100
 )
 
 func NewIntDataplaneDriver(config Config) *InternalDataplane {
 	log.WithField("config", config).Info("Creating internal dataplane driver.")
+	var flowLogRuleIDs *collector.RuleIDs
+	if config.FlowLogsEnabled && !config.BPFEnabled {
+		// The renderer registers the rules whose NFLOG prefixes are too short to hold them.
+		flowLogRuleIDs = collector.NewRuleIDs()
+		config.RulesConfig.FlowLogRuleIDs = flowLogRuleIDs
+	}
+	var ruleCounterIDs *policyRuleCounterIDs
+	if config.PolicyRuleCountersEnabled && !config.BPFEnabled {
+		// The renderer registers the rules whose counter comments hold their IDs.
+		ruleCounterIDs = newPolicyRuleCounterIDs()
+		config.RulesConfig.PolicyRuleIDs = ruleCounterIDs
+	}
 	ruleRenderer := config.RuleRendererOverride
 	if ruleRenderer == nil {
 		ruleRenderer = rules.NewRenderer(config.RulesConfig)
@@ -346,12 +429,19 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 		config:           config,
 		applyThrottle:    throttle.New(10),
 		loopSummarizer:   logutils.NewSummarizer("dataplane reconciliation loops"),
+		featureWarnings:  newFeatureWarnings(),
 	}
 	dp.applyThrottle.Refill() // Allow the first apply() immediately.
 	dp.ifaceMonitor.StateCallback = dp.onIfaceStateChange
 	dp.ifaceMonitor.AddrCallback = dp.onIfaceAddrsChange
 
-	backendMode := iptables.DetectBackend(config.LookPathOverride, iptables.NewRealCmd, config.IptablesBackend)
+	// In native nftables mode, the iptables-based tables and IP sets are replaced by their nftables
+	// equivalents.  BPF mode always uses iptables.
+	nftablesMode := config.IptablesBackend == IptablesBackendNFTNative && !config.BPFEnabled
+	var backendMode string
+	if !nftablesMode {
+		backendMode = iptables.DetectBackend(config.LookPathOverride, iptables.NewRealCmd, config.IptablesBackend)
+	}
 
 	// Most iptables tables need the same options.
 	iptablesOptions := iptables.TableOptions{
@@ -406,37 +496,55 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 		)
 	}
 
-	mangleTableV4 := iptables.NewTable(
-		"mangle",
-		4,
-		rules.RuleHashPrefix,
-		iptablesLock,
-		featureDetector,
-		iptablesOptions)
-	natTableV4 := iptables.NewTable(
-		"nat",
-		4,
-		rules.RuleHashPrefix,
-		iptablesLock,
-		featureDetector,
-		iptablesNATOptions,
-	)
-	rawTableV4 := iptables.NewTable(
-		"raw",
-		4,
-		rules.RuleHashPrefix,
-		iptablesLock,
-		featureDetector,
-		iptablesOptions)
-	filterTableV4 := iptables.NewTable(
-		"filter",
-		4,
-		rules.RuleHashPrefix,
-		iptablesLock,
-		featureDetector,
-		iptablesOptions)
+	var mangleTableV4, natTableV4, rawTableV4, filterTableV4 fullIptablesTable
+	var ipSetsV4 common.IPSetsDataplane
 	ipSetsConfigV4 := config.RulesConfig.IPSetConfigV4
-	ipSetsV4 := ipsets.NewIPSets(ipSetsConfigV4, dp.loopSummarizer)
+	if nftablesMode {
+		log.Info("Native nftables mode enabled.")
+		nftablesV4 := newNFTablesDataplane(
+			4, featureDetector, config, ipSetsConfigV4, dp.reportHealth, dp.loopSummarizer)
+		dp.nftablesDataplanes = append(dp.nftablesDataplanes, nftablesV4)
+		mangleTableV4, natTableV4, rawTableV4, filterTableV4 =
+			nftablesV4.Mangle, nftablesV4.NAT, nftablesV4.Raw, nftablesV4.Filter
+		ipSetsV4 = nftablesV4.IPSets
+		dp.cleanUpAfterFirstApply = func() {
+			cleanUpIptables(config, iptablesLock, featureDetector, iptablesOptions, iptablesNATOptions)
+		}
+	} else {
+		mangleTableV4 = iptables.NewTable(
+			"mangle",
+			4,
+			rules.RuleHashPrefix,
+			iptablesLock,
+			featureDetector,
+			iptablesOptions)
+		natTableV4 = iptables.NewTable(
+			"nat",
+			4,
+			rules.RuleHashPrefix,
+			iptablesLock,
+			featureDetector,
+			iptablesNATOptions,
+		)
+		rawTableV4 = iptables.NewTable(
+			"raw",
+			4,
+			rules.RuleHashPrefix,
+			iptablesLock,
+			featureDetector,
+			iptablesOptions)
+		filterTableV4 = iptables.NewTable(
+			"filter",
+			4,
+			rules.RuleHashPrefix,
+			iptablesLock,
+			featureDetector,
+			iptablesOptions)
+		ipSetsV4 = ipsets.NewIPSets(ipSetsConfigV4, dp.loopSummarizer)
+		dp.cleanUpAfterFirstApply = func() {
+			nftables.RemoveTables(4, 6)
+		}
+	}
 	dp.iptablesNATTables = append(dp.iptablesNATTables, natTableV4)
 	dp.iptablesRawTables = append(dp.iptablesRawTables, rawTableV4)
 	dp.iptablesMangleTables = append(dp.iptablesMangleTables, mangleTableV4)
@@ -454,12 +562,13 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 			"vxlan.calico",
 			config,
 			dp.loopSummarizer,
+			4,
 		)
 		go vxlanManager.KeepVXLANDeviceInSync(config.VXLANMTU, iptablesFeatures.ChecksumOffloadBroken, 10*time.Second)
 		dp.RegisterManager(vxlanManager)
 	} else {
 		// Start a cleanup goroutine not to block felix if it needs to retry
-		go cleanUpVXLANDevice()
+		go cleanUpVXLANDevice("vxlan.calico")
 	}
 
 	dp.endpointStatusCombiner = newEndpointStatusCombiner(dp.fromDataplane, config.IPv6Enabled)
@@ -527,7 +636,25 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 		// bpffs so there's nothing to clean up
 	}
 
-	ipsetsManager := common.NewIPSetsManager(ipSetsV4, config.MaxIPSetSize)
+	if config.FlowLogsEnabled {
+		dp.flowLogRuleIDs = flowLogRuleIDs
+		dp.flowLogCollector = collector.New(collector.Config{FlushInterval: config.FlowLogsFlushInterval})
+		if config.FlowLogsFileEnabled {
+			dp.flowLogCollector.RegisterSink(collector.NewFileSink(
+				config.FlowLogsFileDirectory,
+				config.FlowLogsFileMaxFiles,
+				config.FlowLogsFileMaxFileSizeMB,
+			))
+		}
+	}
+
+	var kubeClient kubernetes.Interface
+	if config.KubeClientSet != nil {
+		kubeClient = config.KubeClientSet
+	}
+	dp.domainInfoStore = newDomainInfoStore(config.DNSTrustedServers, config.DNSExtraTTL, kubeClient)
+	ipsetsManager := common.NewIPSetsManager(ipSetsV4, config.MaxIPSetSize, dp.domainInfoStore)
+	dp.domainInfoStore.RegisterHandler(ipsetsManager)
 	dp.RegisterManager(ipsetsManager)
 
 	if !config.BPFEnabled {
@@ -539,7 +666,7 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 			rules.IPSetIDThisHostIPs,
 			ipSetsV4,
 			config.MaxIPSetSize))
-		dp.RegisterManager(newPolicyManager(rawTableV4, mangleTableV4, filterTableV4, ruleRenderer, 4))
+		dp.RegisterManager(newPolicyManager(rawTableV4, mangleTableV4, filterTableV4, ruleRenderer, 4, flowLogRuleIDs, ruleCounterIDs))
 
 		// Clean up any leftover BPF state.
 		err := nat.RemoveConnectTimeLoadBalancer("")
@@ -550,7 +677,8 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 	} else {
 		// In BPF mode we still use iptables for raw egress policy.
 		dp.RegisterManager(newRawEgressPolicyManager(rawTableV4, ruleRenderer, 4, func(neededIPSets set.Set) {
-			ipSetsV4.SetFilter(neededIPSets)
+			// BPF mode always uses the ipset-based implementation.
+			ipSetsV4.(*ipsets.IPSets).SetFilter(neededIPSets)
 		}))
 	}
 
@@ -563,6 +691,7 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 
 	var (
 		bpfEndpointManager *bpfEndpointManager
+		ruleCounters       *policyRuleCountersCollector
 	)
 
 	if config.BPFEnabled {
@@ -610,6 +739,12 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 			dp.loopSummarizer,
 		)
 		dp.RegisterManager(bpfEndpointManager)
+		dp.flowLogEventsMap = bpfMapContext.EventsMap
+		dp.flowLogRuleIDs = bpfEndpointManager.flowLogRuleIDs
+		if config.PolicyRuleCountersEnabled {
+			ruleCounters = newBPFPolicyRuleCountersCollector(
+				bpfMapContext.RuleCountersMap, bpfEndpointManager.ruleCounterIDs)
+		}
 
 		conntrackScanner := conntrack.NewScanner(bpfMapContext.CtMap,
 			conntrack.NewLivenessScanner(config.BPFConntrackTimeouts, config.BPFNodePortDSREnabled))
@@ -679,11 +814,20 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 		dp.endpointStatusCombiner.OnEndpointStatusUpdate,
 		config.BPFEnabled,
 		bpfEndpointManager,
-		callbacks)
+		callbacks,
+		dp.featureWarnings)
 	dp.RegisterManager(epManager)
 	dp.endpointsSourceV4 = epManager
 	dp.RegisterManager(newFloatingIPManager(natTableV4, ruleRenderer, 4))
 	dp.RegisterManager(newMasqManager(ipSetsV4, natTableV4, ruleRenderer, config.MaxIPSetSize, 4))
+	dnsSnoopingUnsupportedReason := ""
+	if config.BPFEnabled {
+		dnsSnoopingUnsupportedReason = "DNS snooping is not supported in BPF mode"
+	} else if !config.RulesConfig.DNSSnoopingEnabled {
+		dnsSnoopingUnsupportedReason = "DNS snooping is disabled"
+	}
+	dp.RegisterManager(newDNSSnoopManager(filterTableV4, ruleRenderer, 4,
+		dnsSnoopingUnsupportedReason, dp.featureWarnings))
 	if config.RulesConfig.IPIPEnabled {
 		// Add a manager to keep the all-hosts IP set up to date.
 		dp.ipipManager = newIPIPManager(ipSetsV4, config.MaxIPSetSize, config.ExternalNodesCidrs)
@@ -698,57 +842,83 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 
 	// Add a manager for wireguard configuration. This is added irrespective of whether wireguard is actually enabled
 	// because it may need to tidy up some of the routing rules when disabled.
-	cryptoRouteTableWireguard := wireguard.New(config.Hostname, &config.Wireguard, config.NetlinkTimeout,
-		config.DeviceRouteProtocol, func(publicKey wgtypes.Key) error {
-			if publicKey == zeroKey {
-				dp.fromDataplane <- &proto.WireguardStatusUpdate{PublicKey: ""}
-			} else {
-				dp.fromDataplane <- &proto.WireguardStatusUpdate{PublicKey: publicKey.String()}
-			}
+	cryptoRouteTableWireguard := wireguard.New(config.Hostname, &config.Wireguard, 4, config.NetlinkTimeout,
+		config.DeviceRouteProtocol, func(publicKey, pendingPublicKey wgtypes.Key, appliedPendingKeys []wgtypes.Key) error {
+			dp.fromDataplane <- wireguardStatusUpdate(proto.IPVersion_IPV4, publicKey, pendingPublicKey, appliedPendingKeys)
 			return nil
 		},
 		dp.loopSummarizer)
-	dp.wireguardManager = newWireguardManager(cryptoRouteTableWireguard, config)
-	dp.RegisterManager(dp.wireguardManager) // IPv4-only
+	dp.wireguardManager = newWireguardManager(cryptoRouteTableWireguard, config, 4)
+	dp.RegisterManager(dp.wireguardManager)
 
 	dp.RegisterManager(newServiceLoopManager(filterTableV4, ruleRenderer, 4))
 
-	if config.IPv6Enabled {
-		mangleTableV6 := iptables.NewTable(
-			"mangle",
-			6,
-			rules.RuleHashPrefix,
-			iptablesLock,
-			featureDetector,
-			iptablesOptions,
-		)
-		natTableV6 := iptables.NewTable(
-			"nat",
-			6,
-			rules.RuleHashPrefix,
-			iptablesLock,
-			featureDetector,
-			iptablesNATOptions,
-		)
-		rawTableV6 := iptables.NewTable(
-			"raw",
-			6,
-			rules.RuleHashPrefix,
-			iptablesLock,
-			featureDetector,
-			iptablesOptions,
-		)
-		filterTableV6 := iptables.NewTable(
-			"filter",
-			6,
-			rules.RuleHashPrefix,
-			iptablesLock,
-			featureDetector,
-			iptablesOptions,
-		)
+	dp.captureManager = newCaptureManager(config, func(status *proto.PacketCaptureStatusUpdate) {
+		dp.fromDataplane <- status
+	})
+	dp.RegisterManager(dp.captureManager)
+
+	if config.EgressGatewayEnabled && !config.BPFEnabled {
+		tableIndices, err := config.RouteTableManager.GrabBlock(config.EgressGatewayRoutingTables)
+		if err != nil {
+			log.WithError(err).Error("Failed to allocate routing tables for egress gateways; egress gateways disabled")
+		} else {
+			dp.egressGatewayHealthUpdates = make(chan *egressGatewayHealthUpdate, 100)
+			dp.RegisterManager(newEgressGatewayManager(ipSetsV4, tableIndices, dp.egressGatewayHealthUpdates,
+				dp.featureWarnings, config, dp.loopSummarizer)) // IPv4-only
+		}
+	} else if config.EgressGatewayEnabled {
+		log.Warn("Egress gateways are enabled but are not supported in BPF mode; workloads' traffic " +
+			"will not be routed via egress gateways.")
+		dp.featureWarnings.Set(featureEgressGateways, "egress gateways are not supported in BPF mode")
+	}
 
+	if config.IPv6Enabled {
+		var mangleTableV6, natTableV6, rawTableV6, filterTableV6 fullIptablesTable
+		var ipSetsV6 common.IPSetsDataplane
 		ipSetsConfigV6 := config.RulesConfig.IPSetConfigV6
-		ipSetsV6 := ipsets.NewIPSets(ipSetsConfigV6, dp.loopSummarizer)
+		if nftablesMode {
+			nftablesV6 := newNFTablesDataplane(
+				6, featureDetector, config, ipSetsConfigV6, dp.reportHealth, dp.loopSummarizer)
+			dp.nftablesDataplanes = append(dp.nftablesDataplanes, nftablesV6)
+			mangleTableV6, natTableV6, rawTableV6, filterTableV6 =
+				nftablesV6.Mangle, nftablesV6.NAT, nftablesV6.Raw, nftablesV6.Filter
+			ipSetsV6 = nftablesV6.IPSets
+		} else {
+			mangleTableV6 = iptables.NewTable(
+				"mangle",
+				6,
+				rules.RuleHashPrefix,
+				iptablesLock,
+				featureDetector,
+				iptablesOptions,
+			)
+			natTableV6 = iptables.NewTable(
+				"nat",
+				6,
+				rules.RuleHashPrefix,
+				iptablesLock,
+				featureDetector,
+				iptablesNATOptions,
+			)
+			rawTableV6 = iptables.NewTable(
+				"raw",
+				6,
+				rules.RuleHashPrefix,
+				iptablesLock,
+				featureDetector,
+				iptablesOptions,
+			)
+			filterTableV6 = iptables.NewTable(
+				"filter",
+				6,
+				rules.RuleHashPrefix,
+				iptablesLock,
+				featureDetector,
+				iptablesOptions,
+			)
+			ipSetsV6 = ipsets.NewIPSets(ipSetsConfigV6, dp.loopSummarizer)
+		}
 		dp.ipSets = append(dp.ipSets, ipSetsV6)
 		dp.iptablesNATTables = append(dp.iptablesNATTables, natTableV6)
 		dp.iptablesRawTables = append(dp.iptablesRawTables, rawTableV6)
@@ -761,13 +931,18 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 			dp.loopSummarizer, routetable.WithLivenessCB(dp.reportHealth))
 
 		if !config.BPFEnabled {
-			dp.RegisterManager(common.NewIPSetsManager(ipSetsV6, config.MaxIPSetSize))
+			ipsetsManagerV6 := common.NewIPSetsManager(ipSetsV6, config.MaxIPSetSize, dp.domainInfoStore)
+			dp.domainInfoStore.RegisterHandler(ipsetsManagerV6)
+			dp.RegisterManager(ipsetsManagerV6)
 			dp.RegisterManager(newHostIPManager(
 				config.RulesConfig.WorkloadIfacePrefixes,
 				rules.IPSetIDThisHostIPs,
 				ipSetsV6,
 				config.MaxIPSetSize))
-			dp.RegisterManager(newPolicyManager(rawTableV6, mangleTableV6, filterTableV6, ruleRenderer, 6))
+			dp.RegisterManager(newPolicyManager(rawTableV6, mangleTableV6, filterTableV6, ruleRenderer, 6, flowLogRuleIDs, ruleCounterIDs))
+			// The IPv4 manager reports any warning about domain rules.
+			dp.RegisterManager(newDNSSnoopManager(filterTableV6, ruleRenderer, 6,
+				dnsSnoopingUnsupportedReason, nil))
 		}
 		dp.RegisterManager(newEndpointManager(
 			rawTableV6,
@@ -782,10 +957,41 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 			dp.endpointStatusCombiner.OnEndpointStatusUpdate,
 			config.BPFEnabled,
 			nil,
-			callbacks))
+			callbacks,
+			nil))
 		dp.RegisterManager(newFloatingIPManager(natTableV6, ruleRenderer, 6))
 		dp.RegisterManager(newMasqManager(ipSetsV6, natTableV6, ruleRenderer, config.MaxIPSetSize, 6))
 		dp.RegisterManager(newServiceLoopManager(filterTableV6, ruleRenderer, 6))
+
+		if config.RulesConfig.VXLANEnabledV6 {
+			routeTableVXLANV6 := routetable.New([]string{"^vxlan-v6.calico$"}, 6, true, config.NetlinkTimeout,
+				config.DeviceRouteSourceAddress, config.DeviceRouteProtocol, true, 0,
+				dp.loopSummarizer, routetable.WithLivenessCB(dp.reportHealth))
+
+			vxlanManagerV6 := newVXLANManager(
+				ipSetsV6,
+				routeTableVXLANV6,
+				"vxlan-v6.calico",
+				config,
+				dp.loopSummarizer,
+				6,
+			)
+			go vxlanManagerV6.KeepVXLANDeviceInSync(config.VXLANMTUV6, iptablesFeatures.ChecksumOffloadBroken, 10*time.Second)
+			dp.RegisterManager(vxlanManagerV6)
+		}
+
+		// As for IPv4, the IPv6 wireguard manager is added even if IPv6 wireguard is disabled so that it can tidy up.
+		cryptoRouteTableWireguardV6 := wireguard.New(config.Hostname, &config.Wireguard, 6, config.NetlinkTimeout,
+			config.DeviceRouteProtocol, func(publicKey, pendingPublicKey wgtypes.Key, appliedPendingKeys []wgtypes.Key) error {
+				dp.fromDataplane <- wireguardStatusUpdate(proto.IPVersion_IPV6, publicKey, pendingPublicKey, appliedPendingKeys)
+				return nil
+			},
+			dp.loopSummarizer)
+		dp.wireguardManagerV6 = newWireguardManager(cryptoRouteTableWireguardV6, config, 6)
+		dp.RegisterManager(dp.wireguardManagerV6)
+	}
+	if !config.IPv6Enabled || !config.RulesConfig.VXLANEnabledV6 {
+		go cleanUpVXLANDevice("vxlan-v6.calico")
 	}
 
 	dp.allIptablesTables = append(dp.allIptablesTables, dp.iptablesMangleTables...)
@@ -793,6 +999,16 @@ func NewIntDataplaneDriver(config Config) *InternalDataplane {
 	dp.allIptablesTables = append(dp.allIptablesTables, dp.iptablesFilterTables...)
 	dp.allIptablesTables = append(dp.allIptablesTables, dp.iptablesRawTables...)
 
+	if config.PolicyRuleCountersEnabled {
+		if ruleCounters == nil {
+			ruleCounters = newIptablesPolicyRuleCountersCollector(dp.iptablesFilterTables, ruleCounterIDs)
+			dp.cachedRuleCounters = ruleCounters
+		}
+		if err := prometheus.Register(ruleCounters); err != nil {
+			log.WithError(err).Warn("Failed to register policy rule counters metrics.")
+		}
+	}
+
 	// Register that we will report liveness and readiness.
 	if config.HealthAggregator != nil {
 		log.Info("Registering to report health.")
@@ -881,7 +1097,9 @@ func determinePodMTU(config Config) int {
 	for _, s := range []mtuState{
 		{config.IPIPMTU, config.RulesConfig.IPIPEnabled},
 		{config.VXLANMTU, config.RulesConfig.VXLANEnabled},
+		{config.VXLANMTUV6, config.RulesConfig.VXLANEnabledV6},
 		{config.Wireguard.MTU, config.Wireguard.Enabled},
+		{config.Wireguard.MTUV6, config.Wireguard.EnabledV6},
 	} {
 		if s.enabled && s.mtu != 0 && (s.mtu < mtu || mtu == 0) {
 			mtu = s.mtu
@@ -912,6 +1130,10 @@ func ConfigureDefaultMTUs(hostMTU int, c *Config) {
 		log.Debug("Defaulting VXLAN MTU based on host")
 		c.VXLANMTU = hostMTU - vxlanMTUOverhead
 	}
+	if c.VXLANMTUV6 == 0 {
+		log.Debug("Defaulting IPv6 VXLAN MTU based on host")
+		c.VXLANMTUV6 = hostMTU - vxlanV6MTUOverhead
+	}
 	if c.Wireguard.MTU == 0 {
 		if c.KubernetesProvider == config.ProviderAKS && c.Wireguard.EncryptHostTraffic {
 			// The default MTU on Azure is 1500, but the underlying network stack will fragment packets at 1400 bytes,
@@ -927,6 +1149,15 @@ func ConfigureDefaultMTUs(hostMTU int, c *Config) {
 			c.Wireguard.MTU = hostMTU - wireguardMTUOverhead
 		}
 	}
+	if c.Wireguard.MTUV6 == 0 {
+		if c.KubernetesProvider == config.ProviderAKS && c.Wireguard.EncryptHostTraffic {
+			log.Debug("Defaulting IPv6 Wireguard MTU based on host and AKS with WorkloadIPs")
+			c.Wireguard.MTUV6 = hostMTU - aksMTUOverhead - wireguardV6MTUOverhead
+		} else {
+			log.Debug("Defaulting IPv6 Wireguard MTU based on host")
+			c.Wireguard.MTUV6 = hostMTU - wireguardV6MTUOverhead
+		}
+	}
 }
 
 func cleanUpIPIPAddrs() {
@@ -980,9 +1211,27 @@ cleanupRetry:
 	}
 }
 
-func cleanUpVXLANDevice() {
+// wireguardStatusUpdate builds the status update for our wireguard keys.  The pending public key is only included while
+// a key rotation is in progress.
+func wireguardStatusUpdate(
+	ipVersion proto.IPVersion, publicKey, pendingPublicKey wgtypes.Key, appliedPendingKeys []wgtypes.Key,
+) *proto.WireguardStatusUpdate {
+	update := &proto.WireguardStatusUpdate{IpVersion: ipVersion}
+	if publicKey != zeroKey {
+		update.PublicKey = publicKey.String()
+	}
+	if pendingPublicKey != zeroKey {
+		update.PendingPublicKey = pendingPublicKey.String()
+	}
+	for _, key := range appliedPendingKeys {
+		update.AppliedPendingPublicKeys = append(update.AppliedPendingPublicKeys, key.String())
+	}
+	return update
+}
+
+func cleanUpVXLANDevice(deviceName string) {
 	// If VXLAN is not enabled, check to see if there is a VXLAN device and delete it if there is.
-	log.Debug("Checking if we need to clean up the VXLAN device")
+	log.WithField("device", deviceName).Debug("Checking if we need to clean up the VXLAN device")
 
 	var errFound bool
 	for i := 0; i <= maxCleanupRetries; i++ {
@@ -990,7 +1239,7 @@ func cleanUpVXLANDevice() {
 		if i > 0 {
 			log.Debugf("Retrying %v/%v times", i, maxCleanupRetries)
 		}
-		link, err := netlink.LinkByName("vxlan.calico")
+		link, err := netlink.LinkByName(deviceName)
 		if err != nil {
 			if _, ok := err.(netlink.LinkNotFoundError); ok {
 				log.Debug("VXLAN disabled and no VXLAN device found")
@@ -1038,6 +1287,14 @@ type ManagerWithRouteRules interface {
 	GetRouteRules() []routeRules
 }
 
+// ManagerWithRescheduling is implemented by managers that have time-based work to do, even if no updates are
+// received.  RescheduleAfter is called after each apply and returns the delay before the dataplane should be applied
+// again, or 0 if no rescheduling is required.
+type ManagerWithRescheduling interface {
+	Manager
+	RescheduleAfter() time.Duration
+}
+
 // routeTableSyncer is the interface used to manage data-sync of route table managers. This includes notification of
 // interface state changes, hooks to queue a full resync and apply routing updates.
 type routeTableSyncer interface {
@@ -1085,6 +1342,12 @@ func (d *InternalDataplane) RegisterManager(mgr Manager) {
 		log.WithField("manager", mgr).Debug("registering ManagerWithRouteRules")
 		d.managersWithRouteRules = append(d.managersWithRouteRules, rulesMgr)
 	}
+
+	reschedMgr, ok := mgr.(ManagerWithRescheduling)
+	if ok {
+		log.WithField("manager", reflect.TypeOf(mgr).Name()).Debug("registering ManagerWithRescheduling")
+		d.managersWithRescheduling = append(d.managersWithRescheduling, reschedMgr)
+	}
 	d.allManagers = append(d.allManagers, mgr)
 }
 
@@ -1093,12 +1356,32 @@ func (d *InternalDataplane) Start() {
 	d.doStaticDataplaneConfig()
 
 	// Then, start the worker threads.
+	if d.config.RulesConfig.DNSSnoopingEnabled {
+		d.domainInfoStore.Start()
+	}
+	if d.flowLogCollector != nil {
+		d.startFlowLogCollector()
+	}
 	go d.loopUpdatingDataplane()
 	go d.loopReportingStatus()
+	go d.captureManager.loopReportingStatus()
 	go d.ifaceMonitor.MonitorInterfaces()
 	go d.monitorHostMTU()
 }
 
+func (d *InternalDataplane) startFlowLogCollector() {
+	var err error
+	if d.config.BPFEnabled {
+		err = d.flowLogCollector.StartBPFSource(d.flowLogEventsMap, d.flowLogRuleIDs)
+	} else {
+		err = d.flowLogCollector.StartNflogSource(d.flowLogRuleIDs)
+	}
+	if err != nil {
+		log.WithError(err).Error("Failed to start capturing policy verdicts; flow logs will be empty")
+	}
+	d.flowLogCollector.Start()
+}
+
 // onIfaceStateChange is our interface monitor callback.  It gets called from the monitor's thread.
 func (d *InternalDataplane) onIfaceStateChange(ifaceName string, state ifacemonitor.State, ifIndex int) {
 	log.WithFields(log.Fields{
@@ -1272,7 +1555,7 @@ func (d *InternalDataplane) setUpIptablesBPF() {
 			})
 		}
 
-		if t.IPVersion == 6 {
+		if t.GetIPVersion() == 6 {
 			for _, prefix := range rulesConfig.WorkloadIfacePrefixes {
 				// In BPF mode, we don't support IPv6 yet.  Drop it.
 				fwdRules = append(fwdRules, iptables.Rule{
@@ -1329,7 +1612,7 @@ func (d *InternalDataplane) setUpIptablesBPF() {
 	}
 
 	for _, t := range d.iptablesNATTables {
-		t.UpdateChains(d.ruleRenderer.StaticNATPostroutingChains(t.IPVersion))
+		t.UpdateChains(d.ruleRenderer.StaticNATPostroutingChains(t.GetIPVersion()))
 		t.InsertOrAppendRules("POSTROUTING", []iptables.Rule{{
 			Action: iptables.JumpAction{Target: rules.ChainNATPostrouting},
 		}})
@@ -1350,7 +1633,7 @@ func (d *InternalDataplane) setUpIptablesBPF() {
 		})
 
 		// Do the full RPF check and dis-allow accept_local for anything else.
-		rpfRules = append(rpfRules, rules.RPFilter(t.IPVersion, tcdefs.MarkSeen, tcdefs.MarkSeenMask,
+		rpfRules = append(rpfRules, rules.RPFilter(t.GetIPVersion(), tcdefs.MarkSeen, tcdefs.MarkSeenMask,
 			rulesConfig.OpenStackSpecialCasesEnabled, false)...)
 
 		rpfChain := []*iptables.Chain{{
@@ -1360,8 +1643,10 @@ func (d *InternalDataplane) setUpIptablesBPF() {
 		t.UpdateChains(rpfChain)
 
 		var rawRules []iptables.Rule
-		if t.IPVersion == 4 && rulesConfig.WireguardEnabled && len(rulesConfig.WireguardInterfaceName) > 0 &&
-			d.config.Wireguard.EncryptHostTraffic {
+		ipVersion := t.GetIPVersion()
+		wireguardEnabled := (ipVersion == 4 && rulesConfig.WireguardEnabled && len(rulesConfig.WireguardInterfaceName) > 0) ||
+			(ipVersion == 6 && rulesConfig.WireguardEnabledV6 && len(rulesConfig.WireguardInterfaceNameV6) > 0)
+		if wireguardEnabled && d.config.Wireguard.EncryptHostTraffic {
 			// Set a mark on packets coming from any interface except for lo, wireguard, or pod veths to ensure the RPF
 			// check allows it.
 			log.Debug("Adding Wireguard iptables rule chain")
@@ -1369,7 +1654,7 @@ func (d *InternalDataplane) setUpIptablesBPF() {
 				Match:  nil,
 				Action: iptables.JumpAction{Target: rules.ChainSetWireguardIncomingMark},
 			})
-			t.UpdateChain(d.ruleRenderer.WireguardIncomingMarkChain())
+			t.UpdateChain(d.ruleRenderer.WireguardIncomingMarkChain(ipVersion))
 		}
 
 		rawRules = append(rawRules, iptables.Rule{
@@ -1386,9 +1671,9 @@ func (d *InternalDataplane) setUpIptablesBPF() {
 			Action: iptables.JumpAction{Target: rules.ChainRawPrerouting},
 		}})
 
-		if t.IPVersion == 4 {
+		if t.GetIPVersion() == 4 {
 			// Iptables for untracked policy.
-			t.UpdateChains(d.ruleRenderer.StaticBPFModeRawChains(t.IPVersion, uint32(tcdefs.MarkSeenBypass)))
+			t.UpdateChains(d.ruleRenderer.StaticBPFModeRawChains(t.GetIPVersion(), uint32(tcdefs.MarkSeenBypass)))
 			t.InsertOrAppendRules("PREROUTING", []iptables.Rule{{
 				Action: iptables.JumpAction{Target: rules.ChainRawPrerouting},
 			}})
@@ -1415,7 +1700,7 @@ func (d *InternalDataplane) setUpIptablesBPF() {
 
 func (d *InternalDataplane) setUpIptablesNormal() {
 	for _, t := range d.iptablesRawTables {
-		rawChains := d.ruleRenderer.StaticRawTableChains(t.IPVersion)
+		rawChains := d.ruleRenderer.StaticRawTableChains(t.GetIPVersion())
 		t.UpdateChains(rawChains)
 		t.InsertOrAppendRules("PREROUTING", []iptables.Rule{{
 			Action: iptables.JumpAction{Target: rules.ChainRawPrerouting},
@@ -1425,7 +1710,7 @@ func (d *InternalDataplane) setUpIptablesNormal() {
 		}})
 	}
 	for _, t := range d.iptablesFilterTables {
-		filterChains := d.ruleRenderer.StaticFilterTableChains(t.IPVersion)
+		filterChains := d.ruleRenderer.StaticFilterTableChains(t.GetIPVersion())
 		t.UpdateChains(filterChains)
 		t.InsertOrAppendRules("FORWARD", []iptables.Rule{{
 			Action: iptables.JumpAction{Target: rules.ChainFilterForward},
@@ -1441,7 +1726,7 @@ func (d *InternalDataplane) setUpIptablesNormal() {
 		t.AppendRules("FORWARD", d.ruleRenderer.StaticFilterForwardAppendRules())
 	}
 	for _, t := range d.iptablesNATTables {
-		t.UpdateChains(d.ruleRenderer.StaticNATTableChains(t.IPVersion))
+		t.UpdateChains(d.ruleRenderer.StaticNATTableChains(t.GetIPVersion()))
 		t.InsertOrAppendRules("PREROUTING", []iptables.Rule{{
 			Action: iptables.JumpAction{Target: rules.ChainNATPrerouting},
 		}})
@@ -1453,7 +1738,7 @@ func (d *InternalDataplane) setUpIptablesNormal() {
 		}})
 	}
 	for _, t := range d.iptablesMangleTables {
-		t.UpdateChains(d.ruleRenderer.StaticMangleTableChains(t.IPVersion))
+		t.UpdateChains(d.ruleRenderer.StaticMangleTableChains(t.GetIPVersion()))
 		t.InsertOrAppendRules("PREROUTING", []iptables.Rule{{
 			Action: iptables.JumpAction{Target: rules.ChainManglePrerouting},
 		}})
@@ -1569,6 +1854,20 @@ func (d *InternalDataplane) loopUpdatingDataplane() {
 		xdpRefreshC = refreshTicker.C
 	}
 
+	var ruleCountersRefreshC <-chan time.Time
+	if d.cachedRuleCounters != nil {
+		refreshTicker := jitter.NewTicker(
+			policyRuleCountersRefreshInterval,
+			policyRuleCountersRefreshInterval/10,
+		)
+		ruleCountersRefreshC = refreshTicker.C
+	}
+
+	var dnsExpiryC <-chan time.Time
+	if d.config.RulesConfig.DNSSnoopingEnabled {
+		dnsExpiryC = time.NewTicker(dnsExpiryInterval).C
+	}
+
 	// Fill the apply throttle leaky bucket.
 	throttleC := jitter.NewTicker(100*time.Millisecond, 10*time.Millisecond).C
 	beingThrottled := false
@@ -1669,6 +1968,36 @@ func (d *InternalDataplane) loopUpdatingDataplane() {
 			}
 			summaryAddrBatchSize.Observe(float64(batchSize))
 			d.dataplaneNeedsSync = true
+		case healthUpdate := <-d.egressGatewayHealthUpdates:
+			for _, mgr := range d.allManagers {
+				mgr.OnUpdate(healthUpdate)
+			}
+			d.dataplaneNeedsSync = true
+		case pkt := <-d.domainInfoStore.dnsResponsesC:
+			// Process the DNS response, then opportunistically process any others that
+			// are pending.
+			if d.domainInfoStore.processDNSPacket(pkt) {
+				d.dataplaneNeedsSync = true
+			}
+		msgLoop4:
+			for i := 0; i < msgPeekLimit; i++ {
+				select {
+				case pkt := <-d.domainInfoStore.dnsResponsesC:
+					if d.domainInfoStore.processDNSPacket(pkt) {
+						d.dataplaneNeedsSync = true
+					}
+				default:
+					// Channel blocked so we must be caught up.
+					break msgLoop4
+				}
+			}
+		case <-dnsExpiryC:
+			if d.domainInfoStore.expireMappings() {
+				d.dataplaneNeedsSync = true
+			}
+		case <-ruleCountersRefreshC:
+			log.Debug("Refreshing policy rule counters")
+			d.cachedRuleCounters.refresh()
 		case <-ipSetsRefreshC:
 			log.Debug("Refreshing IP sets state")
 			d.forceIPSetsRefresh = true
@@ -1727,6 +2056,9 @@ func (d *InternalDataplane) loopUpdatingDataplane() {
 					).Info("Completed first update to dataplane.")
 					d.loopSummarizer.RecordOperation("first-update")
 					d.doneFirstApply = true
+					if d.cleanUpAfterFirstApply != nil {
+						go d.cleanUpAfterFirstApply()
+					}
 					if d.config.PostInSyncCallback != nil {
 						d.config.PostInSyncCallback()
 					}
@@ -1775,7 +2107,7 @@ func (d *InternalDataplane) configureKernel() {
 			log.WithError(err).Error("Failed to set unprivileged_bpf_disabled sysctl")
 		}
 	}
-	if d.config.Wireguard.Enabled {
+	if d.config.Wireguard.Enabled || d.config.Wireguard.EnabledV6 {
 		// wireguard module is available in linux kernel >= 5.6
 		mpwg := newModProbe(moduleWireguard, newRealCmd)
 		out, err = mpwg.Exec()
@@ -1929,7 +2261,7 @@ func (d *InternalDataplane) apply() {
 	var iptablesWG sync.WaitGroup
 	for _, t := range d.allIptablesTables {
 		iptablesWG.Add(1)
-		go func(t *iptables.Table) {
+		go func(t fullIptablesTable) {
 			tableReschedAfter := t.Apply()
 
 			reschedDelayMutex.Lock()
@@ -1943,6 +2275,31 @@ func (d *InternalDataplane) apply() {
 	}
 	iptablesWG.Wait()
 
+	// In native nftables mode, the tables and IP sets above only record the desired state; write
+	// it to the dataplane.
+	for _, nft := range d.nftablesDataplanes {
+		iptablesWG.Add(1)
+		go func(nft *nftables.Dataplane) {
+			nftReschedAfter := nft.Apply()
+			feature := fmt.Sprintf("%s-v%d", featureNFTablesRender, nft.IPVersion)
+			if failed := nft.RenderFailures(); len(failed) > 0 {
+				d.featureWarnings.Set(feature, fmt.Sprintf(
+					"failed to render nftables chain(s) %s, they drop all traffic", strings.Join(failed, ", ")))
+			} else {
+				d.featureWarnings.Set(feature, "")
+			}
+
+			reschedDelayMutex.Lock()
+			defer reschedDelayMutex.Unlock()
+			if nftReschedAfter != 0 && (reschedDelay == 0 || nftReschedAfter < reschedDelay) {
+				reschedDelay = nftReschedAfter
+			}
+			d.reportHealth()
+			iptablesWG.Done()
+		}(nft)
+	}
+	iptablesWG.Wait()
+
 	// Now clean up any left-over IP sets.
 	for _, ipSets := range d.ipSets {
 		ipSetsWG.Add(1)
@@ -1960,6 +2317,13 @@ func (d *InternalDataplane) apply() {
 	// Wait for the rule updates to finish.
 	rulesWG.Wait()
 
+	// Include any rescheduling requested by the managers.
+	for _, mgr := range d.managersWithRescheduling {
+		if mgrReschedAfter := mgr.RescheduleAfter(); mgrReschedAfter != 0 && (reschedDelay == 0 || mgrReschedAfter < reschedDelay) {
+			reschedDelay = mgrReschedAfter
+		}
+	}
+
 	// And publish and status updates.
 	d.endpointStatusCombiner.Apply()
 
@@ -2031,11 +2395,22 @@ type iptablesTable interface {
 	RemoveChainByName(name string)
 }
 
+// fullIptablesTable is the interface that both iptables.Table and its native nftables
+// equivalent, nftables.Table, implement.
+type fullIptablesTable interface {
+	iptablesTable
+	InsertOrAppendRules(chainName string, rules []iptables.Rule)
+	AppendRules(chainName string, rules []iptables.Rule)
+	ReadCommentCounters(commentPrefixes ...string) (map[string]map[string]iptables.RuleCounters, error)
+	GetIPVersion() uint8
+	Apply() (rescheduleAfter time.Duration)
+}
+
 func (d *InternalDataplane) reportHealth() {
 	if d.config.HealthAggregator != nil {
 		d.config.HealthAggregator.Report(
 			healthName,
-			&health.HealthReport{Live: true, Ready: d.doneFirstApply},
+			&health.HealthReport{Live: true, Ready: d.doneFirstApply, Detail: d.featureWarnings.String()},
 		)
 	}
 }
@@ -125,6 +125,7 @@ var _ = Describe("Constructor test", func() {
 		It("should set the correct MTU", func() {
 			intdataplane.ConfigureDefaultMTUs(1500, &dpConfig)
 			Expect(dpConfig.Wireguard.MTU).To(Equal(1340))
+			Expect(dpConfig.Wireguard.MTUV6).To(Equal(1320))
 		})
 	})
 
@@ -138,6 +139,7 @@ var _ = Describe("Constructor test", func() {
 		It("should set the correct MTU", func() {
 			intdataplane.ConfigureDefaultMTUs(1500, &dpConfig)
 			Expect(dpConfig.Wireguard.MTU).To(Equal(1440))
+			Expect(dpConfig.Wireguard.MTUV6).To(Equal(1420))
 		})
 	})
 })
@@ -0,0 +1,103 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	"os/exec"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/felix/ipsets"
+	"github.com/projectcalico/calico/felix/iptables"
+	"github.com/projectcalico/calico/felix/logutils"
+	"github.com/projectcalico/calico/felix/nftables"
+	"github.com/projectcalico/calico/felix/rules"
+)
+
+// newNFTablesDataplane creates the native nftables dataplane of the given IP version, which holds
+// the stand-ins for the mangle, nat, raw and filter iptables tables and for the IP sets.
+func newNFTablesDataplane(
+	ipVersion uint8,
+	featureDetector *iptables.FeatureDetector,
+	config Config,
+	ipSetsConfig *ipsets.IPVersionConfig,
+	onStillAlive func(),
+	opRecorder logutils.OpRecorder,
+) *nftables.Dataplane {
+	return nftables.NewDataplane(ipVersion, rules.RuleHashPrefix, featureDetector, ipSetsConfig, nftables.DataplaneOptions{
+		InsertMode:      config.IptablesInsertMode,
+		RefreshInterval: config.IptablesRefreshInterval,
+		OnStillAlive:    onStillAlive,
+		OpRecorder:      opRecorder,
+	})
+}
+
+// cleanUpIptables removes the chains, rules and IP sets that Felix programmed before it was
+// switched to native nftables mode.  It does so by applying empty iptables tables and IP sets,
+// which remove anything left over from a previous run.  Hosts that don't have the iptables or
+// ipset binaries can't have any such state so the corresponding cleanup is skipped.
+func cleanUpIptables(
+	config Config,
+	iptablesLock sync.Locker,
+	featureDetector *iptables.FeatureDetector,
+	iptablesOptions iptables.TableOptions,
+	iptablesNATOptions iptables.TableOptions,
+) {
+	lookPath := exec.LookPath
+	if config.LookPathOverride != nil {
+		lookPath = config.LookPathOverride
+	}
+	ipVersions := []uint8{4}
+	ipSetsConfigs := []*ipsets.IPVersionConfig{config.RulesConfig.IPSetConfigV4}
+	if config.IPv6Enabled {
+		ipVersions = append(ipVersions, 6)
+		ipSetsConfigs = append(ipSetsConfigs, config.RulesConfig.IPSetConfigV6)
+	}
+
+	haveIptables := true
+	for _, binary := range []string{"iptables-save", "iptables-restore", "ip6tables-save", "ip6tables-restore"} {
+		if _, err := lookPath(binary); err != nil {
+			haveIptables = false
+		}
+	}
+	if haveIptables {
+		log.Info("Cleaning up iptables rules left over from before native nftables mode was enabled.")
+		backendMode := iptables.DetectBackend(config.LookPathOverride, iptables.NewRealCmd, "auto")
+		iptablesOptions.BackendMode = backendMode
+		iptablesNATOptions.BackendMode = backendMode
+		for _, ipVersion := range ipVersions {
+			for _, table := range []string{"mangle", "nat", "raw", "filter"} {
+				options := iptablesOptions
+				if table == "nat" {
+					options = iptablesNATOptions
+				}
+				iptables.NewTable(table, ipVersion, rules.RuleHashPrefix, iptablesLock, featureDetector, options).Apply()
+			}
+		}
+	} else {
+		log.Debug("iptables binaries not found, no iptables rules to clean up.")
+	}
+
+	if _, err := lookPath("ipset"); err == nil {
+		for _, ipSetsConfig := range ipSetsConfigs {
+			ipSets := ipsets.NewIPSets(ipSetsConfig, iptablesOptions.OpRecorder)
+			ipSets.ApplyUpdates()
+			ipSets.ApplyDeletions()
+		}
+	} else {
+		log.Debug("ipset binary not found, no IP sets to clean up.")
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
 
+	"github.com/projectcalico/calico/felix/collector"
 	"github.com/projectcalico/calico/felix/iptables"
 	"github.com/projectcalico/calico/felix/proto"
 	"github.com/projectcalico/calico/felix/rules"
@@ -37,6 +38,12 @@ type policyManager struct {
 	rawEgressOnly  bool
 	neededIPSets   map[proto.PolicyID]set.Set
 	ipSetsCallback func(neededIPSets set.Set)
+	// flowLogRuleIDs, if non-nil, holds the flow log rules that the renderer registered; the
+	// rules of removed policies and profiles are pruned from it.
+	flowLogRuleIDs *collector.RuleIDs
+	// ruleCounterIDs, if non-nil, holds the rules that the renderer gave counter comments; the
+	// rules of removed policies and profiles are pruned from it.
+	ruleCounterIDs *policyRuleCounterIDs
 }
 
 type policyRenderer interface {
@@ -44,13 +51,21 @@ type policyRenderer interface {
 	ProfileToIptablesChains(profileID *proto.ProfileID, policy *proto.Profile, ipVersion uint8) (inbound, outbound *iptables.Chain)
 }
 
-func newPolicyManager(rawTable, mangleTable, filterTable iptablesTable, ruleRenderer policyRenderer, ipVersion uint8) *policyManager {
+func newPolicyManager(
+	rawTable, mangleTable, filterTable iptablesTable,
+	ruleRenderer policyRenderer,
+	ipVersion uint8,
+	flowLogRuleIDs *collector.RuleIDs,
+	ruleCounterIDs *policyRuleCounterIDs,
+) *policyManager {
 	return &policyManager{
-		rawTable:     rawTable,
-		mangleTable:  mangleTable,
-		filterTable:  filterTable,
-		ruleRenderer: ruleRenderer,
-		ipVersion:    ipVersion,
+		rawTable:       rawTable,
+		mangleTable:    mangleTable,
+		filterTable:    filterTable,
+		ruleRenderer:   ruleRenderer,
+		ipVersion:      ipVersion,
+		flowLogRuleIDs: flowLogRuleIDs,
+		ruleCounterIDs: ruleCounterIDs,
 	}
 }
 
@@ -124,6 +139,12 @@ func (m *policyManager) OnUpdate(msg interface{}) {
 		m.mangleTable.RemoveChainByName(outName)
 		m.rawTable.RemoveChainByName(inName)
 		m.rawTable.RemoveChainByName(outName)
+		if m.flowLogRuleIDs != nil {
+			m.flowLogRuleIDs.RemovePolicy(msg.Id.Tier, msg.Id.Name)
+		}
+		if m.ruleCounterIDs != nil {
+			m.ruleCounterIDs.removePolicy(msg.Id.Tier, msg.Id.Name)
+		}
 	case *proto.ActiveProfileUpdate:
 		if m.rawEgressOnly {
 			log.WithField("id", msg.Id).Debug("Ignore non-untracked profile")
@@ -140,6 +161,12 @@ func (m *policyManager) OnUpdate(msg interface{}) {
 		m.filterTable.RemoveChainByName(inName)
 		m.filterTable.RemoveChainByName(outName)
 		m.mangleTable.RemoveChainByName(outName)
+		if m.flowLogRuleIDs != nil {
+			m.flowLogRuleIDs.RemovePolicy(rules.FlowLogProfileTier, msg.Id.Name)
+		}
+		if m.ruleCounterIDs != nil {
+			m.ruleCounterIDs.removePolicy(rules.FlowLogProfileTier, msg.Id.Name)
+		}
 	}
 }
 
@@ -0,0 +1,311 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/felix/bpf"
+	"github.com/projectcalico/calico/felix/bpf/counters"
+	"github.com/projectcalico/calico/felix/iptables"
+	"github.com/projectcalico/calico/felix/rules"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+)
+
+var (
+	policyRuleLabels = []string{"type", "tier", "policy", "direction", "rule_index"}
+
+	descPolicyRulePackets = prometheus.NewDesc(
+		"felix_policy_rule_packets",
+		"Number of packets that have matched the policy rule.  Packets of established "+
+			"connections are accepted before policy is evaluated, so are not counted.",
+		policyRuleLabels, nil,
+	)
+	descPolicyRuleBytes = prometheus.NewDesc(
+		"felix_policy_rule_bytes",
+		"Number of bytes that have matched the policy rule.  Packets of established "+
+			"connections are accepted before policy is evaluated, so are not counted.",
+		policyRuleLabels, nil,
+	)
+
+	stagedPolicyRuleLabels = []string{"tier", "policy", "direction", "rule_index"}
+
+	descStagedPolicyWouldDenyPackets = prometheus.NewDesc(
+		"felix_staged_policy_would_deny_packets",
+		"Number of packets that the deny rule of the staged policy would have denied.  Packets of "+
+			"established connections are accepted before policy is evaluated, so are not counted.",
+		stagedPolicyRuleLabels, nil,
+	)
+	descStagedPolicyWouldDenyBytes = prometheus.NewDesc(
+		"felix_staged_policy_would_deny_bytes",
+		"Number of bytes that the deny rule of the staged policy would have denied.  Packets of "+
+			"established connections are accepted before policy is evaluated, so are not counted.",
+		stagedPolicyRuleLabels, nil,
+	)
+)
+
+// policyRuleCounts are the packet and byte counts of a single policy rule, summed over the
+// IP versions and programs that it's rendered into.
+type policyRuleCounts struct {
+	packets uint64
+	bytes   uint64
+}
+
+// policyRuleCounters are the counters read from the dataplane: those of every counted rule and,
+// separately, those of the deny rules of staged policies.
+type policyRuleCounters struct {
+	rules     map[rules.PolicyRuleID]policyRuleCounts
+	wouldDeny map[rules.PolicyRuleID]policyRuleCounts
+}
+
+func newPolicyRuleCounters() policyRuleCounters {
+	return policyRuleCounters{
+		rules:     map[rules.PolicyRuleID]policyRuleCounts{},
+		wouldDeny: map[rules.PolicyRuleID]policyRuleCounts{},
+	}
+}
+
+// policyRuleCountersRefreshInterval is how often the dataplane refreshes the cached counters of
+// a collector whose counters are expensive to read.
+const policyRuleCountersRefreshInterval = 15 * time.Second
+
+// policyRuleCountersCollector is a prometheus.Collector that reports the per-rule packet and
+// byte counters.  The counters are read from the dataplane on each scrape, unless the collector
+// is cached, in which case each scrape reports the counters read by the last refresh.
+type policyRuleCountersCollector struct {
+	read func() (policyRuleCounters, error)
+
+	// cached is set if reading the counters is too expensive to do on every scrape.  The
+	// dataplane loop then calls refresh every policyRuleCountersRefreshInterval.
+	cached bool
+	lock   sync.Mutex
+	counts policyRuleCounters
+}
+
+var _ prometheus.Collector = (*policyRuleCountersCollector)(nil)
+
+// newIptablesPolicyRuleCountersCollector returns a cached collector that reads the counters of the
+// rules that the rule renderer marked as counting rules in the given filter tables, and
+// registered in ids.  Reading the counters means running iptables-save, which is expensive for
+// large tables, so they are read once per table and refresh.
+func newIptablesPolicyRuleCountersCollector(tables []fullIptablesTable, ids *policyRuleCounterIDs) *policyRuleCountersCollector {
+	return &policyRuleCountersCollector{
+		cached: true,
+		read: func() (policyRuleCounters, error) {
+			counts := newPolicyRuleCounters()
+			for _, t := range tables {
+				byPrefix, err := t.ReadCommentCounters(rules.RuleCounterCommentPrefix, rules.StagedDenyCounterCommentPrefix)
+				if err != nil {
+					return policyRuleCounters{}, err
+				}
+				addIptablesRuleCounters(byPrefix[rules.RuleCounterCommentPrefix], ids, counts.rules)
+				addIptablesRuleCounters(byPrefix[rules.StagedDenyCounterCommentPrefix], ids, counts.wouldDeny)
+			}
+			return counts, nil
+		},
+	}
+}
+
+// addIptablesRuleCounters adds the counters of rules, indexed by the rule ID in their comments,
+// to counts.
+func addIptablesRuleCounters(byComment map[string]iptables.RuleCounters, ids *policyRuleCounterIDs, counts map[rules.PolicyRuleID]policyRuleCounts) {
+	for comment, c := range byComment {
+		key, err := rules.ParseRuleCounterID(comment)
+		if err != nil {
+			log.WithError(err).WithField("comment", comment).Debug("Ignoring unparseable rule counter comment")
+			continue
+		}
+		id, _, ok := ids.lookup(key)
+		if !ok {
+			// Counter for a rule that we've not rendered since we started.
+			continue
+		}
+		sum := counts[id]
+		sum.packets += c.Packets
+		sum.bytes += c.Bytes
+		counts[id] = sum
+	}
+}
+
+// newBPFPolicyRuleCountersCollector returns a collector that reads the counters that the BPF
+// policy programs maintain in the rule counters map.
+func newBPFPolicyRuleCountersCollector(m bpf.Map, ids *policyRuleCounterIDs) *policyRuleCountersCollector {
+	return &policyRuleCountersCollector{
+		read: func() (policyRuleCounters, error) {
+			values, err := counters.Read(m)
+			if err != nil {
+				return policyRuleCounters{}, err
+			}
+			counts := newPolicyRuleCounters()
+			for key, v := range values {
+				id, stagedDeny, ok := ids.lookup(key)
+				if !ok {
+					// Counter for a rule that we've not programmed since we started.
+					continue
+				}
+				c := policyRuleCounts{packets: v.Packets, bytes: v.Bytes}
+				counts.rules[id] = c
+				if stagedDeny {
+					counts.wouldDeny[id] = c
+				}
+			}
+			return counts, nil
+		},
+	}
+}
+
+func (c *policyRuleCountersCollector) Describe(d chan<- *prometheus.Desc) {
+	d <- descPolicyRulePackets
+	d <- descPolicyRuleBytes
+	d <- descStagedPolicyWouldDenyPackets
+	d <- descStagedPolicyWouldDenyBytes
+}
+
+// refresh reads the counters of a cached collector.  If the read fails, the collector keeps
+// reporting the counters that it last read.
+func (c *policyRuleCountersCollector) refresh() {
+	counts, err := c.read()
+	if err != nil {
+		log.WithError(err).Warn("Failed to read policy rule counters")
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.counts = counts
+}
+
+func (c *policyRuleCountersCollector) Collect(m chan<- prometheus.Metric) {
+	var counts policyRuleCounters
+	if c.cached {
+		c.lock.Lock()
+		counts = c.counts
+		c.lock.Unlock()
+	} else {
+		var err error
+		counts, err = c.read()
+		if err != nil {
+			log.WithError(err).Warn("Failed to read policy rule counters")
+			return
+		}
+	}
+	for id, count := range counts.rules {
+		labels := policyRuleLabelValues(id)
+		m <- prometheus.MustNewConstMetric(descPolicyRulePackets, prometheus.CounterValue, float64(count.packets), labels...)
+		m <- prometheus.MustNewConstMetric(descPolicyRuleBytes, prometheus.CounterValue, float64(count.bytes), labels...)
+	}
+	for id, count := range counts.wouldDeny {
+		// The staged policy metrics have no "type" label; only policies can be staged.
+		labels := policyRuleLabelValues(id)[1:]
+		m <- prometheus.MustNewConstMetric(descStagedPolicyWouldDenyPackets, prometheus.CounterValue, float64(count.packets), labels...)
+		m <- prometheus.MustNewConstMetric(descStagedPolicyWouldDenyBytes, prometheus.CounterValue, float64(count.bytes), labels...)
+	}
+}
+
+func policyRuleLabelValues(id rules.PolicyRuleID) []string {
+	ruleType, tier := "policy", id.Tier
+	if id.Tier == rules.FlowLogProfileTier {
+		ruleType, tier = "profile", ""
+	}
+	direction := "ingress"
+	if id.Direction == rules.FlowLogDirectionEgress {
+		direction = "egress"
+	}
+	return []string{ruleType, tier, id.Name, direction, strconv.Itoa(id.Index)}
+}
+
+// policyRuleCounterIDs maps the IDs that the BPF policy programs count rules under back to
+// the rules.
+type policyRuleCounterIDs struct {
+	lock  sync.Mutex
+	rules map[uint64]rules.PolicyRuleID
+	// stagedDeny records the IDs of the deny rules of staged policies.
+	stagedDeny map[uint64]bool
+	// keysByPolicy indexes the IDs by the policy or profile that owns the rules.
+	keysByPolicy map[rules.PolicyRuleID]set.Set
+}
+
+func newPolicyRuleCounterIDs() *policyRuleCounterIDs {
+	return &policyRuleCounterIDs{
+		rules:        map[uint64]rules.PolicyRuleID{},
+		stagedDeny:   map[uint64]bool{},
+		keysByPolicy: map[rules.PolicyRuleID]set.Set{},
+	}
+}
+
+// ownerKey returns the key of keysByPolicy for the policy or profile that owns the rule.
+func ownerKey(tier, name string) rules.PolicyRuleID {
+	return rules.PolicyRuleID{Tier: tier, Name: name}
+}
+
+// register records the rule and returns its counter ID.  stagedDeny marks the deny rule of a
+// staged policy, whose counters also count the packets that it would have denied.
+func (r *policyRuleCounterIDs) register(id rules.PolicyRuleID, stagedDeny bool) uint64 {
+	key := id.ID()
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.rules[key] = id
+	keys := r.keysByPolicy[ownerKey(id.Tier, id.Name)]
+	if keys == nil {
+		keys = set.New()
+		r.keysByPolicy[ownerKey(id.Tier, id.Name)] = keys
+	}
+	keys.Add(key)
+	if stagedDeny {
+		r.stagedDeny[key] = true
+	} else {
+		delete(r.stagedDeny, key)
+	}
+	return key
+}
+
+// Register records a rule that the rule renderer gave a counter comment, and returns its ID.
+// The iptables dataplane tells the deny rules of staged policies apart by their comment prefix,
+// so it doesn't record them as such.
+func (r *policyRuleCounterIDs) Register(id rules.PolicyRuleID) uint64 {
+	return r.register(id, false)
+}
+
+func (r *policyRuleCounterIDs) lookup(key uint64) (id rules.PolicyRuleID, stagedDeny, ok bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	id, ok = r.rules[key]
+	return id, r.stagedDeny[key], ok
+}
+
+// removePolicy forgets the rules of the given policy, or of the given profile if tier is
+// rules.FlowLogProfileTier, and returns their IDs.
+func (r *policyRuleCounterIDs) removePolicy(tier, name string) []uint64 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	keys := r.keysByPolicy[ownerKey(tier, name)]
+	if keys == nil {
+		return nil
+	}
+	var removed []uint64
+	keys.Iter(func(item interface{}) error {
+		key := item.(uint64)
+		delete(r.rules, key)
+		delete(r.stagedDeny, key)
+		removed = append(removed, key)
+		return nil
+	})
+	delete(r.keysByPolicy, ownerKey(tier, name))
+	return removed
+}
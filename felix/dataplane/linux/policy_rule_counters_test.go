@@ -0,0 +1,183 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package intdataplane
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/projectcalico/calico/felix/bpf/counters"
+	"github.com/projectcalico/calico/felix/bpf/mock"
+	"github.com/projectcalico/calico/felix/iptables"
+	"github.com/projectcalico/calico/felix/rules"
+)
+
+var _ = Describe("Policy rule counters collector", func() {
+	It("should report the BPF counters of the registered rules and staged deny rules", func() {
+		m := mock.NewMockMap(counters.MapParams)
+		ids := newPolicyRuleCounterIDs()
+		setCounter := func(id uint64, v counters.Value) {
+			k := make([]byte, counters.KeySize)
+			binary.LittleEndian.PutUint64(k, id)
+			Expect(m.Update(k, v.AsBytes())).To(Succeed())
+		}
+
+		polID := ids.register(rules.PolicyRuleID{
+			Direction: rules.FlowLogDirectionIngress,
+			Index:     1,
+			Tier:      "default",
+			Name:      "default.pol",
+		}, false)
+		profID := ids.register(rules.PolicyRuleID{
+			Direction: rules.FlowLogDirectionEgress,
+			Index:     0,
+			Tier:      rules.FlowLogProfileTier,
+			Name:      "kns.ns1",
+		}, false)
+		stagedID := ids.register(rules.PolicyRuleID{
+			Direction: rules.FlowLogDirectionEgress,
+			Index:     0,
+			Tier:      "default",
+			Name:      "staged:default.pol",
+		}, true)
+		setCounter(polID, counters.Value{Packets: 3, Bytes: 180})
+		setCounter(profID, counters.Value{Packets: 1, Bytes: 60})
+		setCounter(stagedID, counters.Value{Packets: 2, Bytes: 120})
+		// Counter left behind by a rule that we haven't programmed; should be ignored.
+		setCounter(12345, counters.Value{Packets: 7, Bytes: 700})
+
+		expected := `
+# HELP felix_policy_rule_packets Number of packets that have matched the policy rule.  Packets of established connections are accepted before policy is evaluated, so are not counted.
+# TYPE felix_policy_rule_packets counter
+felix_policy_rule_packets{direction="egress",policy="kns.ns1",rule_index="0",tier="",type="profile"} 1
+felix_policy_rule_packets{direction="egress",policy="staged:default.pol",rule_index="0",tier="default",type="policy"} 2
+felix_policy_rule_packets{direction="ingress",policy="default.pol",rule_index="1",tier="default",type="policy"} 3
+# HELP felix_policy_rule_bytes Number of bytes that have matched the policy rule.  Packets of established connections are accepted before policy is evaluated, so are not counted.
+# TYPE felix_policy_rule_bytes counter
+felix_policy_rule_bytes{direction="egress",policy="kns.ns1",rule_index="0",tier="",type="profile"} 60
+felix_policy_rule_bytes{direction="egress",policy="staged:default.pol",rule_index="0",tier="default",type="policy"} 120
+felix_policy_rule_bytes{direction="ingress",policy="default.pol",rule_index="1",tier="default",type="policy"} 180
+# HELP felix_staged_policy_would_deny_packets Number of packets that the deny rule of the staged policy would have denied.  Packets of established connections are accepted before policy is evaluated, so are not counted.
+# TYPE felix_staged_policy_would_deny_packets counter
+felix_staged_policy_would_deny_packets{direction="egress",policy="staged:default.pol",rule_index="0",tier="default"} 2
+# HELP felix_staged_policy_would_deny_bytes Number of bytes that the deny rule of the staged policy would have denied.  Packets of established connections are accepted before policy is evaluated, so are not counted.
+# TYPE felix_staged_policy_would_deny_bytes counter
+felix_staged_policy_would_deny_bytes{direction="egress",policy="staged:default.pol",rule_index="0",tier="default"} 120
+`
+		Expect(testutil.CollectAndCompare(
+			newBPFPolicyRuleCountersCollector(m, ids), strings.NewReader(expected),
+		)).To(Succeed())
+	})
+
+	It("should forget the IDs of a removed policy's rules", func() {
+		ids := newPolicyRuleCounterIDs()
+		polRule := rules.PolicyRuleID{Direction: rules.FlowLogDirectionIngress, Index: 0, Tier: "default", Name: "default.pol"}
+		otherRule := rules.PolicyRuleID{Direction: rules.FlowLogDirectionIngress, Index: 0, Tier: "default", Name: "default.other"}
+		polKey := ids.register(polRule, true)
+		otherKey := ids.register(otherRule, false)
+
+		Expect(ids.removePolicy("default", "default.pol")).To(ConsistOf(polKey))
+		_, stagedDeny, ok := ids.lookup(polKey)
+		Expect(ok).To(BeFalse())
+		Expect(stagedDeny).To(BeFalse())
+		id, _, ok := ids.lookup(otherKey)
+		Expect(ok).To(BeTrue())
+		Expect(id).To(Equal(otherRule))
+		Expect(ids.removePolicy("default", "default.pol")).To(BeEmpty())
+	})
+
+	It("should report the iptables counters read by the last refresh", func() {
+		ids := newPolicyRuleCounterIDs()
+		polKey := fmt.Sprintf("%016x", ids.Register(rules.PolicyRuleID{
+			Direction: rules.FlowLogDirectionIngress, Index: 0, Tier: "default", Name: "default.pol"}))
+		stagedKey := fmt.Sprintf("%016x", ids.Register(rules.PolicyRuleID{
+			Direction: rules.FlowLogDirectionEgress, Index: 0, Tier: "default", Name: "staged:default.pol"}))
+		table := &counterReadingTable{
+			mockTable: newMockTable("filter"),
+			counters: map[string]map[string]iptables.RuleCounters{
+				rules.RuleCounterCommentPrefix: {
+					polKey:    {Packets: 3, Bytes: 180},
+					stagedKey: {Packets: 2, Bytes: 120},
+					// A rule that was rendered before a restart and has not been rendered since.
+					"00000000deadbeef": {Packets: 1, Bytes: 60},
+				},
+				rules.StagedDenyCounterCommentPrefix: {
+					stagedKey: {Packets: 2, Bytes: 120},
+				},
+			},
+		}
+		c := newIptablesPolicyRuleCountersCollector([]fullIptablesTable{table}, ids)
+
+		// Nothing to report until the first refresh.
+		Expect(testutil.CollectAndCount(c)).To(Equal(0))
+		Expect(table.numReads).To(Equal(0))
+
+		c.refresh()
+		Expect(table.numReads).To(Equal(1))
+		expected := `
+# HELP felix_policy_rule_packets Number of packets that have matched the policy rule.  Packets of established connections are accepted before policy is evaluated, so are not counted.
+# TYPE felix_policy_rule_packets counter
+felix_policy_rule_packets{direction="egress",policy="staged:default.pol",rule_index="0",tier="default",type="policy"} 2
+felix_policy_rule_packets{direction="ingress",policy="default.pol",rule_index="0",tier="default",type="policy"} 3
+# HELP felix_staged_policy_would_deny_packets Number of packets that the deny rule of the staged policy would have denied.  Packets of established connections are accepted before policy is evaluated, so are not counted.
+# TYPE felix_staged_policy_would_deny_packets counter
+felix_staged_policy_would_deny_packets{direction="egress",policy="staged:default.pol",rule_index="0",tier="default"} 2
+`
+		for i := 0; i < 2; i++ {
+			Expect(testutil.CollectAndCompare(c, strings.NewReader(expected),
+				"felix_policy_rule_packets", "felix_staged_policy_would_deny_packets")).To(Succeed())
+		}
+		// Scrapes don't run iptables-save.
+		Expect(table.numReads).To(Equal(1))
+
+		// A failed refresh keeps the previous counters.
+		table.err = errors.New("iptables-save failed")
+		c.refresh()
+		Expect(testutil.CollectAndCompare(c, strings.NewReader(expected),
+			"felix_policy_rule_packets", "felix_staged_policy_would_deny_packets")).To(Succeed())
+	})
+})
+
+// counterReadingTable is a mockTable that returns canned rule counters.
+type counterReadingTable struct {
+	*mockTable
+	counters map[string]map[string]iptables.RuleCounters
+	err      error
+	numReads int
+}
+
+func (t *counterReadingTable) InsertOrAppendRules(chainName string, rules []iptables.Rule) {}
+func (t *counterReadingTable) AppendRules(chainName string, rules []iptables.Rule)         {}
+func (t *counterReadingTable) GetIPVersion() uint8                                         { return 4 }
+func (t *counterReadingTable) Apply() time.Duration                                        { return 0 }
+
+func (t *counterReadingTable) ReadCommentCounters(commentPrefixes ...string) (map[string]map[string]iptables.RuleCounters, error) {
+	t.numReads++
+	if t.err != nil {
+		return nil, t.err
+	}
+	byPrefix := map[string]map[string]iptables.RuleCounters{}
+	for _, prefix := range commentPrefixes {
+		byPrefix[prefix] = t.counters[prefix]
+	}
+	return byPrefix, nil
+}
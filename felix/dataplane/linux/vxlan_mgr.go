@@ -72,6 +72,7 @@ type vxlanManager struct {
 	vxlanDevice string
 	vxlanID     int
 	vxlanPort   int
+	ipVersion   uint8
 
 	// Indicates if configuration has changed since the last apply.
 	routesDirty       bool
@@ -97,6 +98,7 @@ func newVXLANManager(
 	deviceName string,
 	dpConfig Config,
 	opRecorder logutils.OpRecorder,
+	ipVersion uint8,
 ) *vxlanManager {
 	nlHandle, _ := netlink.NewHandle()
 
@@ -107,7 +109,7 @@ func newVXLANManager(
 
 	brt := routetable.New(
 		[]string{routetable.InterfaceNone},
-		4,
+		ipVersion,
 		false,
 		dpConfig.NetlinkTimeout,
 		dpConfig.DeviceRouteSourceAddress,
@@ -123,6 +125,7 @@ func newVXLANManager(
 		deviceName,
 		dpConfig,
 		nlHandle,
+		ipVersion,
 		func(interfaceRegexes []string, ipVersion uint8, vxlan bool, netlinkTimeout time.Duration,
 			deviceRouteSourceAddress net.IP, deviceRouteProtocol netlink.RouteProtocol, removeExternalRoutes bool) routeTable {
 			return routetable.New(interfaceRegexes, ipVersion, vxlan, netlinkTimeout,
@@ -139,6 +142,7 @@ func newVXLANManagerWithShims(
 	deviceName string,
 	dpConfig Config,
 	nlHandle netlinkHandle,
+	ipVersion uint8,
 	noEncapRTConstruct func(interfacePrefixes []string, ipVersion uint8, vxlan bool, netlinkTimeout time.Duration,
 		deviceRouteSourceAddress net.IP, deviceRouteProtocol netlink.RouteProtocol, removeExternalRoutes bool) routeTable,
 ) *vxlanManager {
@@ -162,6 +166,7 @@ func newVXLANManagerWithShims(
 		vxlanDevice:         deviceName,
 		vxlanID:             dpConfig.RulesConfig.VXLANVNI,
 		vxlanPort:           dpConfig.RulesConfig.VXLANPort,
+		ipVersion:           ipVersion,
 		externalNodeCIDRs:   dpConfig.ExternalNodesCidrs,
 		routesDirty:         true,
 		vtepsDirty:          true,
@@ -178,6 +183,11 @@ func (m *vxlanManager) OnUpdate(protoBufMsg interface{}) {
 		// In case the route changes type to one we no longer care about...
 		m.deleteRoute(msg.Dst)
 
+		// Each manager only handles routes for its own IP version.
+		if !m.routeIsForOurIPVersion(msg.Dst) {
+			return
+		}
+
 		if msg.Type == proto.RouteType_REMOTE_WORKLOAD && msg.IpPoolType == proto.IPPoolType_VXLAN {
 			logrus.WithField("msg", msg).Debug("VXLAN data plane received route update")
 			m.routesByDest[msg.Dst] = msg
@@ -199,7 +209,14 @@ func (m *vxlanManager) OnUpdate(protoBufMsg interface{}) {
 		m.deleteRoute(msg.Dst)
 	case *proto.VXLANTunnelEndpointUpdate:
 		logrus.WithField("msg", msg).Debug("VXLAN data plane received VTEP update")
-		if msg.Node == m.hostname {
+		if !m.vtepHasOurIPVersion(msg) {
+			// The VTEP may have lost its address for our IP version, treat it as a removal.
+			if msg.Node == m.hostname {
+				m.setLocalVTEP(nil)
+			} else {
+				delete(m.vtepsByNode, msg.Node)
+			}
+		} else if msg.Node == m.hostname {
 			m.setLocalVTEP(msg)
 		} else {
 			m.vtepsByNode[msg.Node] = msg
@@ -218,6 +235,43 @@ func (m *vxlanManager) OnUpdate(protoBufMsg interface{}) {
 	}
 }
 
+func (m *vxlanManager) routeIsForOurIPVersion(dst string) bool {
+	cidr, err := ip.CIDRFromString(dst)
+	if err != nil {
+		// Let the route through so that the error is logged when we try to program it.
+		return true
+	}
+	return cidr.Version() == m.ipVersion
+}
+
+func (m *vxlanManager) vtepHasOurIPVersion(vtep *proto.VXLANTunnelEndpointUpdate) bool {
+	return m.vtepAddr(vtep) != "" && m.vtepParentDeviceIP(vtep) != ""
+}
+
+// vtepAddr returns the VTEP's tunnel address for this manager's IP version.
+func (m *vxlanManager) vtepAddr(vtep *proto.VXLANTunnelEndpointUpdate) string {
+	if m.ipVersion == 6 {
+		return vtep.Ipv6Addr
+	}
+	return vtep.Ipv4Addr
+}
+
+// vtepParentDeviceIP returns the VTEP's parent device address for this manager's IP version.
+func (m *vxlanManager) vtepParentDeviceIP(vtep *proto.VXLANTunnelEndpointUpdate) string {
+	if m.ipVersion == 6 {
+		return vtep.ParentDeviceIpv6
+	}
+	return vtep.ParentDeviceIp
+}
+
+// vtepMAC returns the VTEP's MAC address for this manager's IP version.
+func (m *vxlanManager) vtepMAC(vtep *proto.VXLANTunnelEndpointUpdate) string {
+	if m.ipVersion == 6 {
+		return vtep.MacV6
+	}
+	return vtep.Mac
+}
+
 func routeIsLocalVXLANBlock(msg *proto.RouteUpdate) bool {
 	// RouteType_LOCAL_WORKLOAD means "local IPAM block _or_ /32 of workload"
 	if msg.Type != proto.RouteType_LOCAL_WORKLOAD {
@@ -231,12 +285,12 @@ func routeIsLocalVXLANBlock(msg *proto.RouteUpdate) bool {
 	if msg.LocalWorkload {
 		return false
 	}
-	// Ignore /32 routes in any case for two reasons:
+	// Ignore /32 (or /128) routes in any case for two reasons:
 	// * If we have a /32 block then our blackhole route would stop the CNI plugin from programming its /32 for a
 	//   newly added workload.
 	// * If this isn't a /32 block then it must be a borrowed /32 from another block.  In that case, we know we're
 	//   racing with CNI, adding a new workload.  We've received the borrowed IP but not the workload endpoint yet.
-	if strings.HasSuffix(msg.Dst, "/32") {
+	if strings.HasSuffix(msg.Dst, "/32") || strings.HasSuffix(msg.Dst, "/128") {
 		return false
 	}
 	return true
@@ -335,7 +389,7 @@ func (m *vxlanManager) CompleteDeferredWork() error {
 		// known VTEPs.
 		var l2routes []routetable.L2Target
 		for _, u := range m.vtepsByNode {
-			mac, err := net.ParseMAC(u.Mac)
+			mac, err := net.ParseMAC(m.vtepMAC(u))
 			if err != nil {
 				// Don't block programming of other VTEPs if somehow we receive one with a bad mac.
 				logrus.WithError(err).Warn("Failed to parse VTEP mac address")
@@ -343,10 +397,10 @@ func (m *vxlanManager) CompleteDeferredWork() error {
 			}
 			l2routes = append(l2routes, routetable.L2Target{
 				VTEPMAC: mac,
-				GW:      ip.FromString(u.Ipv4Addr),
-				IP:      ip.FromString(u.ParentDeviceIp),
+				GW:      ip.FromString(m.vtepAddr(u)),
+				IP:      ip.FromString(m.vtepParentDeviceIP(u)),
 			})
-			allowedVXLANSources = append(allowedVXLANSources, u.ParentDeviceIp)
+			allowedVXLANSources = append(allowedVXLANSources, m.vtepParentDeviceIP(u))
 		}
 		logrus.WithField("l2routes", l2routes).Debug("VXLAN manager sending L2 updates")
 		m.routeTable.SetL2Routes(m.vxlanDevice, l2routes)
@@ -393,7 +447,7 @@ func (m *vxlanManager) CompleteDeferredWork() error {
 				vxlanRoute := routetable.Target{
 					Type: routetable.TargetTypeVXLAN,
 					CIDR: cidr,
-					GW:   ip.FromString(vtep.Ipv4Addr),
+					GW:   ip.FromString(m.vtepAddr(vtep)),
 				}
 
 				vxlanRoutes = append(vxlanRoutes, vxlanRoute)
@@ -452,7 +506,7 @@ func (m *vxlanManager) KeepVXLANDeviceInSync(mtu int, xsumBroken bool, wait time
 			continue
 		} else {
 			if m.getNoEncapRouteTable() == nil {
-				noEncapRouteTable := m.noEncapRTConstruct([]string{"^" + parent.Attrs().Name + "$"}, 4, false, m.dpConfig.NetlinkTimeout, m.dpConfig.DeviceRouteSourceAddress,
+				noEncapRouteTable := m.noEncapRTConstruct([]string{"^" + parent.Attrs().Name + "$"}, m.ipVersion, false, m.dpConfig.NetlinkTimeout, m.dpConfig.DeviceRouteSourceAddress,
 					m.noEncapProtocol, false)
 				m.setNoEncapRouteTable(noEncapRouteTable)
 			}
@@ -481,19 +535,20 @@ func (m *vxlanManager) getParentInterface(localVTEP *proto.VXLANTunnelEndpointUp
 	if err != nil {
 		return nil, err
 	}
+	parentDeviceIP := m.vtepParentDeviceIP(localVTEP)
 	for _, link := range links {
-		addrs, err := m.nlHandle.AddrList(link, netlink.FAMILY_V4)
+		addrs, err := m.nlHandle.AddrList(link, m.netlinkFamily())
 		if err != nil {
 			return nil, err
 		}
 		for _, addr := range addrs {
-			if addr.IPNet.IP.String() == localVTEP.ParentDeviceIp {
+			if addr.IPNet.IP.String() == parentDeviceIP {
 				logrus.Debugf("Found parent interface: %s", link)
 				return link, nil
 			}
 		}
 	}
-	return nil, fmt.Errorf("Unable to find parent interface with address %s", localVTEP.ParentDeviceIp)
+	return nil, fmt.Errorf("Unable to find parent interface with address %s", parentDeviceIP)
 }
 
 // configureVXLANDevice ensures the VXLAN tunnel device is up and configured correctly.
@@ -504,7 +559,7 @@ func (m *vxlanManager) configureVXLANDevice(mtu int, localVTEP *proto.VXLANTunne
 	if err != nil {
 		return err
 	}
-	mac, err := net.ParseMAC(localVTEP.Mac)
+	mac, err := net.ParseMAC(m.vtepMAC(localVTEP))
 	if err != nil {
 		return err
 	}
@@ -516,7 +571,7 @@ func (m *vxlanManager) configureVXLANDevice(mtu int, localVTEP *proto.VXLANTunne
 		VxlanId:      m.vxlanID,
 		Port:         m.vxlanPort,
 		VtepDevIndex: parent.Attrs().Index,
-		SrcAddr:      ip.FromString(localVTEP.ParentDeviceIp).AsNetIP(),
+		SrcAddr:      ip.FromString(m.vtepParentDeviceIP(localVTEP)).AsNetIP(),
 	}
 
 	// Try to get the device.
@@ -571,7 +626,7 @@ func (m *vxlanManager) configureVXLANDevice(mtu int, localVTEP *proto.VXLANTunne
 	}
 
 	// Make sure the IP address is configured.
-	if err := m.ensureV4AddressOnLink(localVTEP.Ipv4Addr, link); err != nil {
+	if err := m.ensureAddressOnLink(m.vtepAddr(localVTEP), link); err != nil {
 		return fmt.Errorf("failed to ensure address of interface: %s", err)
 	}
 
@@ -590,15 +645,19 @@ func (m *vxlanManager) configureVXLANDevice(mtu int, localVTEP *proto.VXLANTunne
 	return nil
 }
 
-// ensureV4AddressOnLink ensures that the provided IPv4 address is configured on the provided Link. If there are other addresses,
-// this function will remove them, ensuring that the desired IPv4 address is the _only_ address on the Link.
-func (m *vxlanManager) ensureV4AddressOnLink(ipStr string, link netlink.Link) error {
-	_, net, err := net.ParseCIDR(ipStr + "/32")
+// ensureAddressOnLink ensures that the provided IP address is configured on the provided Link. If there are other addresses
+// of the same IP version, this function will remove them, ensuring that the desired address is the _only_ address on the Link.
+func (m *vxlanManager) ensureAddressOnLink(ipStr string, link netlink.Link) error {
+	suffix := "/32"
+	if m.ipVersion == 6 {
+		suffix = "/128"
+	}
+	_, net, err := net.ParseCIDR(ipStr + suffix)
 	if err != nil {
 		return err
 	}
 	addr := netlink.Addr{IPNet: net}
-	existingAddrs, err := m.nlHandle.AddrList(link, netlink.FAMILY_V4)
+	existingAddrs, err := m.nlHandle.AddrList(link, m.netlinkFamily())
 	if err != nil {
 		return err
 	}
@@ -610,6 +669,10 @@ func (m *vxlanManager) ensureV4AddressOnLink(ipStr string, link netlink.Link) er
 			addrPresent = true
 			continue
 		}
+		if m.ipVersion == 6 && existing.IP.IsLinkLocalUnicast() {
+			// Leave the kernel-assigned IPv6 link-local address alone.
+			continue
+		}
 		logrus.WithFields(logrus.Fields{"address": existing, "link": link.Attrs().Name}).Warn("Removing unwanted IP from VXLAN device")
 		if err := m.nlHandle.AddrDel(link, &existing); err != nil {
 			return fmt.Errorf("failed to remove IP address %s", existing)
@@ -626,6 +689,13 @@ func (m *vxlanManager) ensureV4AddressOnLink(ipStr string, link netlink.Link) er
 	return nil
 }
 
+func (m *vxlanManager) netlinkFamily() int {
+	if m.ipVersion == 6 {
+		return netlink.FAMILY_V6
+	}
+	return netlink.FAMILY_V4
+}
+
 // vlanLinksIncompat takes two vxlan devices and compares them to make sure they match. If they do not match,
 // this function will return a mesasge indicating which configuration is mismatched.
 func vxlanLinksIncompat(l1, l2 netlink.Link) string {
@@ -23,6 +23,7 @@ import (
 	"github.com/projectcalico/calico/felix/proto"
 	"github.com/projectcalico/calico/felix/routetable"
 	"github.com/projectcalico/calico/felix/rules"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -53,6 +54,13 @@ func (m *mockVXLANDataplane) LinkSetUp(link netlink.Link) error {
 }
 
 func (m *mockVXLANDataplane) AddrList(link netlink.Link, family int) ([]netlink.Addr, error) {
+	if family == netlink.FAMILY_V6 {
+		return []netlink.Addr{{
+			IPNet: &net.IPNet{
+				IP: net.ParseIP("fc00:10:96::2"),
+			},
+		}}, nil
+	}
 	l := []netlink.Addr{{
 		IPNet: &net.IPNet{
 			IP: net.IPv4(172, 0, 0, 2),
@@ -115,6 +123,7 @@ var _ = Describe("VXLANManager", func() {
 			&mockVXLANDataplane{
 				links: []netlink.Link{&mockLink{attrs: netlink.LinkAttrs{Name: "eth0"}}},
 			},
+			4,
 			func(interfacePrefixes []string, ipVersion uint8, vxlan bool, netlinkTimeout time.Duration,
 				deviceRouteSourceAddress net.IP, deviceRouteProtocol netlink.RouteProtocol, removeExternalRoutes bool) routeTable {
 				return prt
@@ -246,3 +255,130 @@ var _ = Describe("VXLANManager", func() {
 		Expect(prt.currentRoutes["eth0"]).To(HaveLen(1))
 	})
 })
+
+var _ = Describe("VXLANManager IPv6", func() {
+	var manager *vxlanManager
+	var rt, brt, prt *mockRouteTable
+	var ipSets *common.MockIPSets
+
+	BeforeEach(func() {
+		rt = &mockRouteTable{
+			currentRoutes:   map[string][]routetable.Target{},
+			currentL2Routes: map[string][]routetable.L2Target{},
+		}
+		brt = &mockRouteTable{
+			currentRoutes:   map[string][]routetable.Target{},
+			currentL2Routes: map[string][]routetable.L2Target{},
+		}
+		prt = &mockRouteTable{
+			currentRoutes:   map[string][]routetable.Target{},
+			currentL2Routes: map[string][]routetable.L2Target{},
+		}
+		ipSets = common.NewMockIPSets()
+
+		manager = newVXLANManagerWithShims(
+			ipSets,
+			rt, brt,
+			"vxlan-v6.calico",
+			Config{
+				MaxIPSetSize: 5,
+				Hostname:     "node1",
+				RulesConfig: rules.Config{
+					VXLANVNI:  1,
+					VXLANPort: 20,
+				},
+			},
+			&mockVXLANDataplane{
+				links: []netlink.Link{&mockLink{attrs: netlink.LinkAttrs{Name: "eth0"}}},
+			},
+			6,
+			func(interfacePrefixes []string, ipVersion uint8, vxlan bool, netlinkTimeout time.Duration,
+				deviceRouteSourceAddress net.IP, deviceRouteProtocol netlink.RouteProtocol, removeExternalRoutes bool) routeTable {
+				return prt
+			},
+		)
+	})
+
+	It("programs only IPv6 routes and VTEPs", func() {
+		manager.OnUpdate(&proto.VXLANTunnelEndpointUpdate{
+			Node:             "node1",
+			Mac:              "00:0a:74:9d:68:16",
+			Ipv4Addr:         "10.0.0.0",
+			ParentDeviceIp:   "172.0.0.2",
+			MacV6:            "00:0a:74:9d:68:26",
+			Ipv6Addr:         "fd00:10:244::",
+			ParentDeviceIpv6: "fc00:10:96::2",
+		})
+
+		manager.OnUpdate(&proto.VXLANTunnelEndpointUpdate{
+			Node:             "node2",
+			MacV6:            "00:0a:95:9d:68:26",
+			Ipv6Addr:         "fd00:10:244:0:1::",
+			ParentDeviceIpv6: "fc00:10:96::3",
+		})
+
+		// An IPv4-only VTEP is ignored by the IPv6 manager.
+		manager.OnUpdate(&proto.VXLANTunnelEndpointUpdate{
+			Node:           "node3",
+			Mac:            "00:0a:95:9d:68:36",
+			Ipv4Addr:       "10.0.81.0",
+			ParentDeviceIp: "172.0.12.2",
+		})
+
+		localVTEP := manager.getLocalVTEP()
+		Expect(localVTEP).NotTo(BeNil())
+		manager.noEncapRouteTable = prt
+
+		err := manager.configureVXLANDevice(50, localVTEP, false)
+		Expect(err).NotTo(HaveOccurred())
+
+		manager.OnUpdate(&proto.RouteUpdate{
+			Type:        proto.RouteType_REMOTE_WORKLOAD,
+			IpPoolType:  proto.IPPoolType_VXLAN,
+			Dst:         "fd00:10:244:0:1::/112",
+			DstNodeName: "node2",
+			DstNodeIp:   "fc00:10:96::3",
+		})
+
+		manager.OnUpdate(&proto.RouteUpdate{
+			Type:        proto.RouteType_REMOTE_WORKLOAD,
+			IpPoolType:  proto.IPPoolType_VXLAN,
+			Dst:         "172.0.0.2/26",
+			DstNodeName: "node2",
+			DstNodeIp:   "172.8.8.8",
+		})
+
+		manager.OnUpdate(&proto.RouteUpdate{
+			Type:        proto.RouteType_LOCAL_WORKLOAD,
+			IpPoolType:  proto.IPPoolType_VXLAN,
+			Dst:         "fd00:10:244::/112",
+			DstNodeName: "node1",
+			DstNodeIp:   "fc00:10:96::2",
+		})
+
+		// Borrowed /128 should not be programmed as blackhole.
+		manager.OnUpdate(&proto.RouteUpdate{
+			Type:        proto.RouteType_LOCAL_WORKLOAD,
+			IpPoolType:  proto.IPPoolType_VXLAN,
+			Dst:         "fd00:10:244:0:1::1/128",
+			DstNodeName: "node1",
+			DstNodeIp:   "fc00:10:96::2",
+		})
+
+		err = manager.CompleteDeferredWork()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(rt.currentRoutes["vxlan-v6.calico"]).To(ConsistOf(routetable.Target{
+			Type: routetable.TargetTypeVXLAN,
+			CIDR: ip.MustParseCIDROrIP("fd00:10:244:0:1::/112"),
+			GW:   ip.FromString("fd00:10:244:0:1::"),
+		}))
+		Expect(rt.currentL2Routes["vxlan-v6.calico"]).To(HaveLen(1))
+		Expect(rt.currentL2Routes["vxlan-v6.calico"][0].IP).To(Equal(ip.FromString("fc00:10:96::3")))
+		Expect(brt.currentRoutes[routetable.InterfaceNone]).To(ConsistOf(routetable.Target{
+			Type: routetable.TargetTypeBlackhole,
+			CIDR: ip.MustParseCIDROrIP("fd00:10:244::/112"),
+		}))
+		Expect(ipSets.Members[rules.IPSetIDAllVXLANSourceNets]).To(Equal(set.From("fc00:10:96::3")))
+	})
+})
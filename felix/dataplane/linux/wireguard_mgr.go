@@ -15,6 +15,8 @@
 package intdataplane
 
 import (
+	"time"
+
 	log "github.com/sirupsen/logrus"
 	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
 
@@ -34,10 +36,13 @@ import (
 // to the Apply method, with periodic resyncs occurring after calls to QueueResync. Calls from the main OnUpdate method
 // call through to the various update methods on the wireguard module which simply record state without actually
 // programming.
+//
+// A separate manager (and wireguard device) is used for each IP version.
 type wireguardManager struct {
 	// Our dependencies.
 	wireguardRouteTable *wireguard.Wireguard
 	dpConfig            Config
+	ipVersion           uint8
 }
 
 type WireguardStatusUpdateCallback func(ipVersion uint8, id interface{}, status string)
@@ -45,10 +50,12 @@ type WireguardStatusUpdateCallback func(ipVersion uint8, id interface{}, status
 func newWireguardManager(
 	wireguardRouteTable *wireguard.Wireguard,
 	dpConfig Config,
+	ipVersion uint8,
 ) *wireguardManager {
 	return &wireguardManager{
 		wireguardRouteTable: wireguardRouteTable,
 		dpConfig:            dpConfig,
+		ipVersion:           ipVersion,
 	}
 }
 
@@ -56,11 +63,29 @@ func (m *wireguardManager) OnUpdate(protoBufMsg interface{}) {
 	log.WithField("msg", protoBufMsg).Debug("Received message")
 	switch msg := protoBufMsg.(type) {
 	case *proto.HostMetadataUpdate:
+		if m.ipVersion != 4 {
+			return
+		}
 		log.WithField("msg", msg).Debug("HostMetadataUpdate update")
 		m.wireguardRouteTable.EndpointUpdate(msg.Hostname, ip.FromString(msg.Ipv4Addr))
 	case *proto.HostMetadataRemove:
+		if m.ipVersion != 4 {
+			return
+		}
 		log.WithField("msg", msg).Debug("HostMetadataRemove update")
 		m.wireguardRouteTable.EndpointRemove(msg.Hostname)
+	case *proto.HostMetadataV6Update:
+		if m.ipVersion != 6 {
+			return
+		}
+		log.WithField("msg", msg).Debug("HostMetadataV6Update update")
+		m.wireguardRouteTable.EndpointUpdate(msg.Hostname, ip.FromString(msg.Ipv6Addr))
+	case *proto.HostMetadataV6Remove:
+		if m.ipVersion != 6 {
+			return
+		}
+		log.WithField("msg", msg).Debug("HostMetadataV6Remove update")
+		m.wireguardRouteTable.EndpointRemove(msg.Hostname)
 	case *proto.RouteUpdate:
 		log.WithField("msg", msg).Debug("RouteUpdate update")
 		cidr, err := ip.ParseCIDROrIP(msg.Dst)
@@ -68,12 +93,16 @@ func (m *wireguardManager) OnUpdate(protoBufMsg interface{}) {
 			log.Errorf("error parsing RouteUpdate CIDR: %s", msg.Dst)
 			return
 		}
+		if cidr.Version() != m.ipVersion {
+			log.Debugf("Ignoring IPv%d RouteUpdate", cidr.Version())
+			return
+		}
 		switch msg.Type {
 		case proto.RouteType_REMOTE_HOST:
 			log.Debug("RouteUpdate is a remote host update")
 			// This can only be done in WorkloadIPs mode, because this breaks networking during upgrade in CalicoIPAM
-			// mode.
-			if m.dpConfig.Wireguard.EncryptHostTraffic {
+			// mode.  Host encryption is only supported for IPv4.
+			if m.dpConfig.Wireguard.EncryptHostTraffic && m.ipVersion == 4 {
 				m.wireguardRouteTable.RouteUpdate(msg.DstNodeName, cidr)
 			}
 		case proto.RouteType_LOCAL_WORKLOAD, proto.RouteType_REMOTE_WORKLOAD:
@@ -97,30 +126,78 @@ func (m *wireguardManager) OnUpdate(protoBufMsg interface{}) {
 			log.Errorf("error parsing RouteUpdate CIDR: %s", msg.Dst)
 			return
 		}
-		log.Debugf("Route removal for IPv4 CIDR: %s", cidr)
+		if cidr.Version() != m.ipVersion {
+			log.Debugf("Ignoring IPv%d RouteRemove", cidr.Version())
+			return
+		}
+		log.Debugf("Route removal for IPv%d CIDR: %s", m.ipVersion, cidr)
 		m.wireguardRouteTable.RouteRemove(cidr)
 	case *proto.WireguardEndpointUpdate:
-		log.WithField("msg", msg).Debug("WireguardEndpointUpdate update")
-		key, err := wgtypes.ParseKey(msg.PublicKey)
-		if err != nil {
-			log.WithError(err).Errorf("error parsing wireguard public key %s for node %s", msg.PublicKey, msg.Hostname)
-		}
-		var ifaceAddr ip.Addr
-		if msg.InterfaceIpv4Addr != "" {
-			addr := ip.FromString(msg.InterfaceIpv4Addr)
-			if addr == nil {
-				// Unable to parse the wireguard interface address. We can still enable wireguard without this, so treat as
-				// an update with no interface address.
-				log.WithError(err).Errorf("error parsing wireguard interface address %s for node %s", msg.InterfaceIpv4Addr, msg.Hostname)
-			} else if addr.Version() == 4 {
-				ifaceAddr = addr
-			}
+		if m.ipVersion != 4 {
+			return
 		}
-		m.wireguardRouteTable.EndpointWireguardUpdate(msg.Hostname, key, ifaceAddr)
+		log.WithField("msg", msg).Debug("WireguardEndpointUpdate update")
+		m.endpointWireguardUpdate(msg.Hostname, msg.PublicKey, msg.InterfaceIpv4Addr)
+		m.endpointWireguardKeyRotationUpdate(msg.Hostname, msg.PendingPublicKey, msg.AppliedPendingPublicKeys)
 	case *proto.WireguardEndpointRemove:
+		if m.ipVersion != 4 {
+			return
+		}
 		log.WithField("msg", msg).Debug("WireguardEndpointRemove update")
 		m.wireguardRouteTable.EndpointWireguardRemove(msg.Hostname)
+	case *proto.WireguardEndpointV6Update:
+		if m.ipVersion != 6 {
+			return
+		}
+		log.WithField("msg", msg).Debug("WireguardEndpointV6Update update")
+		m.endpointWireguardUpdate(msg.Hostname, msg.PublicKeyV6, msg.InterfaceIpv6Addr)
+		m.endpointWireguardKeyRotationUpdate(msg.Hostname, msg.PendingPublicKeyV6, msg.AppliedPendingPublicKeysV6)
+	case *proto.WireguardEndpointV6Remove:
+		if m.ipVersion != 6 {
+			return
+		}
+		log.WithField("msg", msg).Debug("WireguardEndpointV6Remove update")
+		m.wireguardRouteTable.EndpointWireguardRemove(msg.Hostname)
+	}
+}
+
+func (m *wireguardManager) endpointWireguardUpdate(hostname, publicKey, interfaceAddr string) {
+	key, err := wgtypes.ParseKey(publicKey)
+	if err != nil {
+		log.WithError(err).Errorf("error parsing wireguard public key %s for node %s", publicKey, hostname)
+	}
+	var ifaceAddr ip.Addr
+	if interfaceAddr != "" {
+		addr := ip.FromString(interfaceAddr)
+		if addr == nil {
+			// Unable to parse the wireguard interface address. We can still enable wireguard without this, so treat as
+			// an update with no interface address.
+			log.Errorf("error parsing wireguard interface address %s for node %s", interfaceAddr, hostname)
+		} else if addr.Version() == m.ipVersion {
+			ifaceAddr = addr
+		}
+	}
+	m.wireguardRouteTable.EndpointWireguardUpdate(hostname, key, ifaceAddr)
+}
+
+func (m *wireguardManager) endpointWireguardKeyRotationUpdate(hostname, pendingPublicKey string, appliedPendingKeys []string) {
+	var key wgtypes.Key
+	if pendingPublicKey != "" {
+		var err error
+		if key, err = wgtypes.ParseKey(pendingPublicKey); err != nil {
+			log.WithError(err).Errorf("error parsing pending wireguard public key %s for node %s", pendingPublicKey, hostname)
+		}
 	}
+	var appliedKeys []wgtypes.Key
+	for _, appliedPendingKey := range appliedPendingKeys {
+		appliedKey, err := wgtypes.ParseKey(appliedPendingKey)
+		if err != nil {
+			log.WithError(err).Errorf("error parsing applied pending wireguard public key %s for node %s", appliedPendingKey, hostname)
+			continue
+		}
+		appliedKeys = append(appliedKeys, appliedKey)
+	}
+	m.wireguardRouteTable.EndpointWireguardKeyRotationUpdate(hostname, key, appliedKeys)
 }
 
 func (m *wireguardManager) CompleteDeferredWork() error {
@@ -131,3 +208,8 @@ func (m *wireguardManager) CompleteDeferredWork() error {
 func (m *wireguardManager) GetRouteTableSyncers() []routeTableSyncer {
 	return []routeTableSyncer{m.wireguardRouteTable}
 }
+
+// RescheduleAfter returns the time until the next scheduled key rotation event, or 0 if none is scheduled.
+func (m *wireguardManager) RescheduleAfter() time.Duration {
+	return m.wireguardRouteTable.RescheduleAfter()
+}
@@ -30,6 +30,7 @@ import (
 
 	"github.com/projectcalico/calico/felix/dataplane/windows/policysets"
 	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
 )
 
@@ -245,13 +246,12 @@ func (m *endpointManager) refreshPendingWlEpUpdates(updatedPolicies []string) {
 		var activePolicyNames []string
 		profilesApply := true
 
-		if len(workload.Tiers) > 0 {
-			activePolicyNames = append(activePolicyNames, prependAll(policysets.PolicyNamePrefix, workload.Tiers[0].IngressPolicies)...)
-			activePolicyNames = append(activePolicyNames, prependAll(policysets.PolicyNamePrefix, workload.Tiers[0].EgressPolicies)...)
+		ingressPolicies, egressPolicies := flattenTiers(workload.Tiers)
+		activePolicyNames = append(activePolicyNames, prependAll(policysets.PolicyNamePrefix, ingressPolicies)...)
+		activePolicyNames = append(activePolicyNames, prependAll(policysets.PolicyNamePrefix, egressPolicies)...)
 
-			if len(workload.Tiers[0].IngressPolicies) > 0 && len(workload.Tiers[0].EgressPolicies) > 0 {
-				profilesApply = false
-			}
+		if len(ingressPolicies) > 0 && len(egressPolicies) > 0 {
+			profilesApply = false
 		}
 
 		if profilesApply && len(workload.ProfileIds) > 0 {
@@ -356,17 +356,18 @@ func (m *endpointManager) CompleteDeferredWork() error {
 
 			logCxt.Info("Processing endpoint add/update")
 
-			if len(workload.Tiers) > 0 && len(workload.Tiers[0].IngressPolicies) > 0 {
+			ingressPolicies, egressPolicies := flattenTiers(workload.Tiers)
+			if len(ingressPolicies) > 0 {
 				logCxt.Debug("Workload Tier Policies will be applied Inbound")
-				inboundPolicyIds = append(inboundPolicyIds, prependAll(policysets.PolicyNamePrefix, workload.Tiers[0].IngressPolicies)...)
+				inboundPolicyIds = append(inboundPolicyIds, prependAll(policysets.PolicyNamePrefix, ingressPolicies)...)
 			} else if len(workload.ProfileIds) > 0 {
 				logCxt.Debug("Profiles will be applied Inbound")
 				inboundPolicyIds = append(inboundPolicyIds, prependAll(policysets.ProfileNamePrefix, workload.ProfileIds)...)
 			}
 
-			if len(workload.Tiers) > 0 && len(workload.Tiers[0].EgressPolicies) > 0 {
+			if len(egressPolicies) > 0 {
 				logCxt.Debug("Workload Tier Policies will be applied Outbound")
-				outboundPolicyIds = append(outboundPolicyIds, prependAll(policysets.PolicyNamePrefix, workload.Tiers[0].EgressPolicies)...)
+				outboundPolicyIds = append(outboundPolicyIds, prependAll(policysets.PolicyNamePrefix, egressPolicies)...)
 			} else if len(workload.ProfileIds) > 0 {
 				logCxt.Debug("Profiles will be applied Outbound")
 				outboundPolicyIds = append(outboundPolicyIds, prependAll(policysets.ProfileNamePrefix, workload.ProfileIds)...)
@@ -519,6 +520,28 @@ func (m *endpointManager) getHnsEndpointId(ip string) (string, error) {
 }
 
 // prependAll prepends a string to all of the provided input strings
+// flattenTiers returns the ingress and egress policy names of all the given tiers, in tier order.
+// HNS has no equivalent of a tier's "pass" action so the tiers are applied as a single ordered
+// list of policies.
+func flattenTiers(tiers []*proto.TierInfo) (ingress, egress []string) {
+	for _, t := range tiers {
+		// Staged policies are not enforced and the Windows dataplane has no way to
+		// render them without enforcing them, so skip them.  The policy manager warns
+		// about them.
+		for _, p := range t.IngressPolicies {
+			if !model.PolicyIsStaged(p) {
+				ingress = append(ingress, p)
+			}
+		}
+		for _, p := range t.EgressPolicies {
+			if !model.PolicyIsStaged(p) {
+				egress = append(egress, p)
+			}
+		}
+	}
+	return
+}
+
 func prependAll(prefix string, in []string) (out []string) {
 	for _, s := range in {
 		out = append(out, prefix+s)
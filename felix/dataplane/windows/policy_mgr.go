@@ -19,6 +19,7 @@ import (
 
 	"github.com/projectcalico/calico/felix/dataplane/windows/policysets"
 	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 )
 
 // policyManager simply passes through Policy and Profile updates from the datastore to the
@@ -39,9 +40,19 @@ func (m *policyManager) OnUpdate(msg interface{}) {
 	switch msg := msg.(type) {
 	case *proto.ActivePolicyUpdate:
 		log.WithField("policyID", msg.Id).Info("Processing ActivePolicyUpdate")
+		if model.PolicyIsStaged(msg.Id.Name) {
+			// The Windows dataplane has no way to count the packets that a policy matches
+			// without enforcing it, so it can't evaluate staged policies.
+			log.WithField("policyID", msg.Id).Warn(
+				"Staged policies are not supported by the Windows dataplane; ignoring the policy.")
+			return
+		}
 		m.policysetsDataplane.AddOrReplacePolicySet(policysets.PolicyNamePrefix+msg.Id.Name, msg.Policy)
 	case *proto.ActivePolicyRemove:
 		log.WithField("policyID", msg.Id).Info("Processing ActivePolicyRemove")
+		if model.PolicyIsStaged(msg.Id.Name) {
+			return
+		}
 		m.policysetsDataplane.RemovePolicySet(policysets.PolicyNamePrefix + msg.Id.Name)
 	case *proto.ActiveProfileUpdate:
 		log.WithField("profileId", msg.Id).Info("Processing ActiveProfileUpdate")
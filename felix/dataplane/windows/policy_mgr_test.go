@@ -152,3 +152,33 @@ func (m mockReader) ReadData() ([]byte, error) {
 	}
 	return []byte(string(m)), nil
 }
+
+func TestPolicyManagerIgnoresStagedPolicies(t *testing.T) {
+	RegisterTestingT(t)
+
+	h := mockHNS{}
+
+	ipsc := mockIPSetCache{
+		IPSets: map[string][]string{},
+	}
+
+	ps := policysets.NewPolicySets(&h, []policysets.IPSetCache{&ipsc}, mockReader(""))
+	policyMgr := newPolicyManager(ps)
+
+	policyMgr.OnUpdate(&proto.ActivePolicyUpdate{
+		Id: &proto.PolicyID{Name: "staged:pol1", Tier: "tier1"},
+		Policy: &proto.Policy{
+			InboundRules: []*proto.Rule{
+				{Action: "deny"},
+			},
+		},
+	})
+
+	// The staged policy's deny rule must not be programmed.
+	Expect(ps.GetPolicySetRules([]string{"policy-staged:pol1"}, true)).To(Equal([]*hns.ACLPolicy{
+		// Default deny rule.
+		{Type: hns.ACL, Protocol: 256, Action: hns.Block, Direction: hns.In, RuleType: hns.Switch, Priority: 1001},
+		// Default host/pod rule.
+		{Type: hns.ACL, Protocol: 256, Action: hns.Allow, Direction: hns.In, RuleType: hns.Host, Priority: 100},
+	}), "staged policy should not be programmed")
+}
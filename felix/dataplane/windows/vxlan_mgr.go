@@ -72,7 +72,9 @@ func newVXLANManager(hcn hcnInterface, hostname string, networkName *regexp.Rege
 func (m *vxlanManager) OnUpdate(protoBufMsg interface{}) {
 	switch msg := protoBufMsg.(type) {
 	case *proto.RouteUpdate:
-		if msg.Type == proto.RouteType_REMOTE_WORKLOAD && msg.IpPoolType == proto.IPPoolType_VXLAN {
+		// IPv6 VXLAN is not supported on Windows, only IPv4 routes are programmed.
+		if msg.Type == proto.RouteType_REMOTE_WORKLOAD && msg.IpPoolType == proto.IPPoolType_VXLAN &&
+			!strings.Contains(msg.Dst, ":") {
 			logrus.WithField("msg", msg).Debug("VXLAN data plane received route update")
 			m.routesByDest[msg.Dst] = msg
 			m.dirty = true
@@ -94,7 +96,12 @@ func (m *vxlanManager) OnUpdate(protoBufMsg interface{}) {
 	case *proto.VXLANTunnelEndpointUpdate:
 		logrus.WithField("msg", msg).Debug("VXLAN data plane received VTEP update")
 		if msg.Node != m.hostname { // Skip creating a route to ourselves.
-			m.vtepsByNode[msg.Node] = msg
+			if msg.Ipv4Addr == "" {
+				// IPv6-only VTEP, which we can't use.
+				delete(m.vtepsByNode, msg.Node)
+			} else {
+				m.vtepsByNode[msg.Node] = msg
+			}
 			m.dirty = true
 		}
 	case *proto.VXLANTunnelEndpointRemove:
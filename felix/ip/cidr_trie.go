@@ -0,0 +1,299 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ip
+
+import (
+	"log"
+)
+
+// CIDRTrie is a version-agnostic equivalent of V4Trie that stores CIDRs of a single IP version.  It
+// is slower than V4Trie because it works through the CIDR interface, so V4Trie should be preferred
+// on hot paths that only deal with IPv4.
+type CIDRTrie struct {
+	root *cidrTrieNode
+}
+
+type cidrTrieNode struct {
+	cidr     CIDR
+	children [2]*cidrTrieNode
+	data     interface{}
+}
+
+func (t *CIDRTrie) Delete(cidr CIDR) {
+	if t.root == nil {
+		// Trie is empty.
+		return
+	}
+	if CommonPrefix(t.root.cidr, cidr) != t.root.cidr {
+		// Trie does not contain prefix.
+		return
+	}
+	t.root = cidrTrieDeleteInternal(t.root, cidr)
+}
+
+func cidrTrieDeleteInternal(n *cidrTrieNode, cidr CIDR) *cidrTrieNode {
+	if !cidrContains(n.cidr, cidr.Addr()) {
+		// Not in trie.
+		return n
+	}
+
+	if cidr == n.cidr {
+		// Found the node.  If either child is nil then this was just an intermediate node
+		// and it no longer has any data in it so we replace it by its remaining child.
+		if n.children[0] == nil {
+			// 0th child is nil, return the other child (or nil if both children were nil)
+			return n.children[1]
+		} else if n.children[1] == nil {
+			// oth child non-nil but 1st child is nil, return oth child.
+			return n.children[0]
+		} else {
+			// Intermediate node but it has two children so it is still required.
+			n.data = nil
+			return n
+		}
+	}
+
+	// If we get here, then this node is a parent of the CIDR we're looking for.
+	// Figure out which child to recurse on.
+	childIdx := nthBit(cidr.Addr(), uint(n.cidr.Prefix()+1))
+	oldChild := n.children[childIdx]
+	if oldChild == nil {
+		return n
+	}
+	newChild := cidrTrieDeleteInternal(oldChild, cidr)
+	n.children[childIdx] = newChild
+	if newChild == nil {
+		// One of our children has been deleted completely, check if this node is an intermediate node
+		// that needs to be cleaned up.
+		if n.data == nil {
+			return n.children[1-childIdx]
+		}
+	}
+	return n
+}
+
+type CIDRTrieEntry struct {
+	CIDR CIDR
+	Data interface{}
+}
+
+func (t *CIDRTrie) Get(cidr CIDR) interface{} {
+	return t.root.get(cidr)
+}
+
+// LookupPath looks up the given CIDR in the trie.  It returns a slice containing a CIDRTrieEntry for each
+// CIDR in the trie that encloses the given CIDR.  If buffer is non-nil, then it is used to store the entries;
+// if it is too short append() is used to extend it and the updated slice is returned.
+//
+// If the CIDR is not in the trie then an empty slice is returned.
+func (t *CIDRTrie) LookupPath(buffer []CIDRTrieEntry, cidr CIDR) []CIDRTrieEntry {
+	return t.root.lookupPath(buffer[:0], cidr)
+}
+
+func (n *cidrTrieNode) lookupPath(buffer []CIDRTrieEntry, cidr CIDR) []CIDRTrieEntry {
+	if n == nil {
+		return buffer[:0]
+	}
+
+	if !cidrContains(n.cidr, cidr.Addr()) {
+		// Not in trie.
+		return nil
+	}
+
+	if n.data != nil {
+		buffer = append(buffer, CIDRTrieEntry{CIDR: n.cidr, Data: n.data})
+	}
+
+	if cidr == n.cidr {
+		if n.data == nil {
+			// CIDR is an intermediate node with no data so CIDR isn't actually in the trie.
+			return nil
+		}
+		return buffer
+	}
+
+	// If we get here, then this node is a parent of the CIDR we're looking for.
+	// Figure out which child to recurse on.
+	childIdx := nthBit(cidr.Addr(), uint(n.cidr.Prefix()+1))
+	child := n.children[childIdx]
+	return child.lookupPath(buffer, cidr)
+}
+
+func (n *cidrTrieNode) get(cidr CIDR) interface{} {
+	if n == nil {
+		return nil
+	}
+
+	if !cidrContains(n.cidr, cidr.Addr()) {
+		// Not in trie.
+		return nil
+	}
+
+	if cidr == n.cidr {
+		if n.data == nil {
+			// CIDR is an intermediate node with no data so CIDR isn't actually in the trie.
+			return nil
+		}
+		return n.data
+	}
+
+	// If we get here, then this node is a parent of the CIDR we're looking for.
+	// Figure out which child to recurse on.
+	childIdx := nthBit(cidr.Addr(), uint(n.cidr.Prefix()+1))
+	child := n.children[childIdx]
+	return child.get(cidr)
+}
+
+func (n *cidrTrieNode) appendTo(s []CIDRTrieEntry) []CIDRTrieEntry {
+	if n == nil {
+		return s
+	}
+	if n.data != nil {
+		s = append(s, CIDRTrieEntry{
+			CIDR: n.cidr,
+			Data: n.data,
+		})
+	}
+	s = n.children[0].appendTo(s)
+	s = n.children[1].appendTo(s)
+	return s
+}
+
+func (n *cidrTrieNode) visit(f func(cidr CIDR, data interface{}) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	if n.data != nil {
+		keepGoing := f(n.cidr, n.data)
+		if !keepGoing {
+			return false
+		}
+	}
+	keepGoing := n.children[0].visit(f)
+	if !keepGoing {
+		return false
+	}
+	return n.children[1].visit(f)
+}
+
+func (t *CIDRTrie) ToSlice() []CIDRTrieEntry {
+	return t.root.appendTo(nil)
+}
+
+func (t *CIDRTrie) Visit(f func(cidr CIDR, data interface{}) bool) {
+	t.root.visit(f)
+}
+
+func (t *CIDRTrie) Update(cidr CIDR, value interface{}) {
+	if value == nil {
+		log.Panic("Can't store nil in a CIDRTrie")
+	}
+	parentsPtr := &t.root
+	thisNode := t.root
+
+	for {
+		if thisNode == nil {
+			// We've run off the end of the tree, create new child to hold this data.
+			newNode := &cidrTrieNode{
+				cidr: cidr,
+				data: value,
+			}
+			*parentsPtr = newNode
+			return
+		}
+
+		if thisNode.cidr == cidr {
+			// Found a node with exactly this CIDR, just update the data.
+			thisNode.data = value
+			return
+		}
+
+		// If we get here, there are three cases:
+		// - CIDR of this node contains the new CIDR, in which case we need look for matching child
+		// - The new CIDR contains this node, in which case we need to insert a new node as the parent of this one.
+		// - The two CIDRs are disjoint, in which case we need to insert a new intermediate node as the parent of
+		//   thisNode and the new CIDR.
+		commonPrefix := CommonPrefix(cidr, thisNode.cidr)
+
+		if commonPrefix.Prefix() == thisNode.cidr.Prefix() {
+			// Common is this node's CIDR so this node is parent of the new CIDR. Figure out which child to recurse on.
+			childIdx := nthBit(cidr.Addr(), uint(commonPrefix.Prefix()+1))
+			parentsPtr = &thisNode.children[childIdx]
+			thisNode = thisNode.children[childIdx]
+			continue
+		}
+
+		if commonPrefix.Prefix() == cidr.Prefix() {
+			// Common is new CIDR so this node is a child of the new CIDR. Insert new node.
+			newNode := &cidrTrieNode{
+				cidr: cidr,
+				data: value,
+			}
+			childIdx := nthBit(thisNode.cidr.Addr(), uint(commonPrefix.Prefix()+1))
+			newNode.children[childIdx] = thisNode
+			*parentsPtr = newNode
+			return
+		}
+
+		// Neither CIDR contains the other.  Create an internal node with this node and new CIDR as children.
+		newInternalNode := &cidrTrieNode{
+			cidr: commonPrefix,
+		}
+		childIdx := nthBit(thisNode.cidr.Addr(), uint(commonPrefix.Prefix()+1))
+		newInternalNode.children[childIdx] = thisNode
+		newInternalNode.children[1-childIdx] = &cidrTrieNode{
+			cidr: cidr,
+			data: value,
+		}
+		*parentsPtr = newInternalNode
+		return
+	}
+}
+
+// CommonPrefix returns the longest CIDR that contains both a and b.  a and b must be of the same
+// IP version.
+func CommonPrefix(a, b CIDR) CIDR {
+	switch a := a.(type) {
+	case V4CIDR:
+		return V4CommonPrefix(a, b.(V4CIDR))
+	case V6CIDR:
+		return V6CommonPrefix(a, b.(V6CIDR))
+	}
+	log.Panicf("Unknown CIDR type %T", a)
+	return nil
+}
+
+func cidrContains(c CIDR, addr Addr) bool {
+	switch c := c.(type) {
+	case V4CIDR:
+		return c.ContainsV4(addr.(V4Addr))
+	case V6CIDR:
+		return c.ContainsV6(addr.(V6Addr))
+	}
+	log.Panicf("Unknown CIDR type %T", c)
+	return false
+}
+
+func nthBit(addr Addr, n uint) int {
+	switch addr := addr.(type) {
+	case V4Addr:
+		return addr.NthBit(n)
+	case V6Addr:
+		return addr.NthBit(n)
+	}
+	log.Panicf("Unknown address type %T", addr)
+	return 0
+}
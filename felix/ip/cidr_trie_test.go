@@ -0,0 +1,177 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ip_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/ip"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+)
+
+var _ = DescribeTable("V6CommonPrefix",
+	func(a, b, expected string) {
+		aCIDR := ip.MustParseCIDROrIP(a).(ip.V6CIDR)
+		bCIDR := ip.MustParseCIDROrIP(b).(ip.V6CIDR)
+		expCIDR := ip.MustParseCIDROrIP(expected).(ip.V6CIDR)
+
+		Expect(ip.V6CommonPrefix(aCIDR, bCIDR)).To(Equal(expCIDR))
+		Expect(ip.V6CommonPrefix(bCIDR, aCIDR)).To(Equal(expCIDR))
+		Expect(ip.CommonPrefix(aCIDR, bCIDR)).To(Equal(expCIDR))
+	},
+	// Zero cases.
+	cpEntry("::/0", "::/0", "::/0"),
+	cpEntry("::/0", "fd00::/8", "::/0"),
+
+	// One contained in the other.
+	cpEntry("fd00::/8", "fd00:0:0:3::/64", "fd00::/8"),
+	cpEntry("fd00:0:0:3::/64", "fd00:0:0:3::1/128", "fd00:0:0:3::/64"),
+
+	// Disjoint, differing in the high 64 bits.
+	cpEntry("fd00::/16", "fd01::/16", "fd00::/15"),
+	// Disjoint, differing in the low 64 bits.
+	cpEntry("fd00::1/128", "fd00::2/128", "fd00::/126"),
+	cpEntry("fd00::8000:0:0:0/65", "fd00::/65", "fd00::/64"),
+)
+
+var _ = Describe("CIDRTrie tests", func() {
+	var trie *ip.CIDRTrie
+
+	BeforeEach(func() {
+		trie = &ip.CIDRTrie{}
+	})
+
+	update := func(cidr string) {
+		trie.Update(ip.MustParseCIDROrIP(cidr), "data:"+cidr)
+	}
+
+	remove := func(cidr string) {
+		trie.Delete(ip.MustParseCIDROrIP(cidr))
+	}
+
+	contents := func() []string {
+		var s []string
+		for _, t := range trie.ToSlice() {
+			cidrStr := t.CIDR.String()
+			Expect(t.Data).To(Equal("data:"+cidrStr), "Trie returned entry with unexpected data")
+			s = append(s, cidrStr)
+		}
+		return s
+	}
+
+	lookup := func(cidr string) []string {
+		var s []string
+		for _, t := range trie.LookupPath(nil, ip.MustParseCIDROrIP(cidr)) {
+			cidrStr := t.CIDR.String()
+			Expect(t.Data).To(Equal("data:"+cidrStr), "Trie returned entry with unexpected data")
+			s = append(s, cidrStr)
+		}
+		return s
+	}
+
+	It("should allow inserting a single IPv4 CIDR", func() {
+		update("10.0.0.0/8")
+		Expect(contents()).To(ConsistOf("10.0.0.0/8"))
+		Expect(trie.Get(ip.MustParseCIDROrIP("10.0.0.0/8"))).To(Equal("data:10.0.0.0/8"))
+	})
+
+	It("should allow inserting a single IPv6 CIDR", func() {
+		update("fd00::/8")
+		Expect(contents()).To(ConsistOf("fd00::/8"))
+		Expect(trie.Get(ip.MustParseCIDROrIP("fd00::/8"))).To(Equal("data:fd00::/8"))
+	})
+
+	It("should look up the enclosing IPv6 CIDRs", func() {
+		update("fd00::/8")
+		update("fd00:0:0:3::/64")
+		update("fd00:0:0:3::1/128")
+		update("fd00:0:0:4::/64")
+		Expect(lookup("fd00:0:0:3::1/128")).To(Equal([]string{"fd00::/8", "fd00:0:0:3::/64", "fd00:0:0:3::1/128"}))
+		Expect(lookup("fd00:0:0:4::/64")).To(Equal([]string{"fd00::/8", "fd00:0:0:4::/64"}))
+	})
+
+	It("should ignore IPv6 deletes outside the trie", func() {
+		update("fd00::/8")
+		remove("fe00::/8")
+		remove("fd00::1/128")
+		Expect(contents()).To(ConsistOf("fd00::/8"))
+	})
+
+	It("should fail to lookup intermediate IPv6 node", func() {
+		update("::/1")
+		update("8000::/1")
+		Expect(lookup("::/0")).To(BeEmpty())
+	})
+
+	It("should stop visiting when asked to", func() {
+		update("fd00::1/128")
+		update("fd00::2/128")
+		var visited []string
+		trie.Visit(func(cidr ip.CIDR, data interface{}) bool {
+			visited = append(visited, cidr.String())
+			return false
+		})
+		Expect(visited).To(HaveLen(1))
+	})
+
+	pEntry := func(cidrs ...string) TableEntry {
+		return Entry(fmt.Sprint(cidrs), cidrs)
+	}
+	DescribeTable("permutation tests",
+		func(cidrs []string) {
+			// As for the V4Trie tests, the first instance of a CIDR in a permutation means "insert" and
+			// the second means "remove".
+			cidrs = append(cidrs, cidrs...)
+			permute(cidrs, func(cidrs []string) {
+				expected := set.New()
+				for _, c := range cidrs {
+					if expected.Contains(c) {
+						expected.Discard(c)
+						remove(c)
+					} else {
+						expected.Add(c)
+						update(c)
+					}
+					var expSlice []string
+					expected.Iter(func(item interface{}) error {
+						cidr := item.(string)
+						expSlice = append(expSlice, cidr)
+
+						path := lookup(cidr)
+						for _, c := range path {
+							Expect(expected.Contains(c)).To(BeTrue(), fmt.Sprintf(
+								"Trie returned a path (%v) including a CIDR that wasn't supposed to be in the trie (%v)", path, c))
+						}
+
+						return nil
+					})
+					Expect(contents()).To(ConsistOf(expSlice),
+						fmt.Sprintf("Trie had incorrect contents with this sequence of CIDRs: %s", cidrs))
+				}
+			})
+		},
+		pEntry("0.0.0.0/0", "10.0.0.0/8", "11.0.0.0/8"),
+		pEntry("1.0.0.0/8", "1.0.0.0/24", "1.0.0.27/32"),
+		pEntry("::/0"),
+		pEntry("::/0", "fd00::/8", "fe00::/8"),
+		pEntry("::/0", "8000::/1", "::/1"),
+		pEntry("fd00::/64", "fd00::/120", "fd00::1b/128"),
+		pEntry("fd00::4/128", "fd00::5/128", "fd00::6/128"),
+	)
+})
@@ -101,6 +101,18 @@ func (a V6Addr) AsCIDR() CIDR {
 	}
 }
 
+func (a V6Addr) AsUint64Pair() (uint64, uint64) {
+	return binary.BigEndian.Uint64(a[:8]), binary.BigEndian.Uint64(a[8:])
+}
+
+func (a V6Addr) NthBit(n uint) int {
+	hi, lo := a.AsUint64Pair()
+	if n <= 64 {
+		return int(hi >> (64 - n) & 1)
+	}
+	return int(lo >> (128 - n) & 1)
+}
+
 func (a V6Addr) String() string {
 	return a.AsNetIP().String()
 }
@@ -173,6 +185,10 @@ func (c V6CIDR) ToIPNet() net.IPNet {
 	}
 }
 
+func (c V6CIDR) ContainsV6(addr V6Addr) bool {
+	return v6CommonPrefixLen(c.addr, addr) >= c.prefix
+}
+
 func (c V6CIDR) String() string {
 	return fmt.Sprintf("%s/%v", c.addr.String(), c.prefix)
 }
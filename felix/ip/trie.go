@@ -380,3 +380,41 @@ func V4CommonPrefix(a, b V4CIDR) V4CIDR {
 
 	return result
 }
+
+func V6CommonPrefix(a, b V6CIDR) V6CIDR {
+	var result V6CIDR
+	var maxLen uint8
+	if b.prefix < a.prefix {
+		maxLen = b.prefix
+	} else {
+		maxLen = a.prefix
+	}
+
+	commonPrefixLen := v6CommonPrefixLen(a.addr, b.addr)
+	if commonPrefixLen > maxLen {
+		result.prefix = maxLen
+	} else {
+		result.prefix = commonPrefixLen
+	}
+
+	hi, lo := a.addr.AsUint64Pair()
+	if result.prefix <= 64 {
+		hi &= ^uint64(0) << (64 - result.prefix)
+		lo = 0
+	} else {
+		lo &= ^uint64(0) << (128 - result.prefix)
+	}
+	binary.BigEndian.PutUint64(result.addr[:8], hi)
+	binary.BigEndian.PutUint64(result.addr[8:], lo)
+
+	return result
+}
+
+func v6CommonPrefixLen(a, b V6Addr) uint8 {
+	aHi, aLo := a.AsUint64Pair()
+	bHi, bLo := b.AsUint64Pair()
+	if xored := aHi ^ bHi; xored != 0 {
+		return uint8(bits.LeadingZeros64(xored))
+	}
+	return uint8(64 + bits.LeadingZeros64(aLo^bLo))
+}
@@ -111,6 +111,22 @@ func (g LogAction) String() string {
 	return "Log"
 }
 
+type NflogAction struct {
+	Group  uint16
+	Prefix string
+}
+
+func (n NflogAction) ToFragment(features *Features) string {
+	if n.Prefix == "" {
+		return fmt.Sprintf("--jump NFLOG --nflog-group %d", n.Group)
+	}
+	return fmt.Sprintf(`--jump NFLOG --nflog-group %d --nflog-prefix "%s"`, n.Group, n.Prefix)
+}
+
+func (n NflogAction) String() string {
+	return fmt.Sprintf("Nflog:g=%d,p=%s", n.Group, n.Prefix)
+}
+
 type AcceptAction struct {
 	TypeAccept struct{}
 }
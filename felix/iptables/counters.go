@@ -0,0 +1,90 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iptables
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+// RuleCounters holds the packet and byte counters of one or more iptables rules.
+type RuleCounters struct {
+	Packets uint64
+	Bytes   uint64
+}
+
+// countersRegexp matches the counters at the start of an "iptables-save -c" line, such as
+// "[12:3456] -A chain-name ...".
+var countersRegexp = regexp.MustCompile(`^\[(\d+):(\d+)\] -A `)
+
+// ReadCommentCounters runs iptables-save once to read the counters of the rules in this table
+// that have a comment starting with one of the commentPrefixes.  The result is indexed by
+// prefix and then by the remainder of the comment; the counters of rules with the same comment
+// are summed.
+//
+// Unlike the other methods of Table, it is safe to call concurrently with Apply().
+func (t *Table) ReadCommentCounters(commentPrefixes ...string) (map[string]map[string]RuleCounters, error) {
+	cmd := t.newCmd(t.iptablesSaveCmd, "-c", "-t", t.Name)
+	countNumSaveCalls.Inc()
+	out, err := cmd.Output()
+	if err != nil {
+		countNumSaveErrors.Inc()
+		return nil, fmt.Errorf("%s command failed: %w", t.iptablesSaveCmd, err)
+	}
+	byPrefix := map[string]map[string]RuleCounters{}
+	for _, prefix := range commentPrefixes {
+		counters, err := ParseCommentCounters(bytes.NewReader(out), prefix)
+		if err != nil {
+			return nil, err
+		}
+		byPrefix[prefix] = counters
+	}
+	return byPrefix, nil
+}
+
+// ParseCommentCounters parses the output of "iptables-save -c"; see ReadCommentCounters.
+func ParseCommentCounters(r io.Reader, commentPrefix string) (map[string]RuleCounters, error) {
+	commentRegexp := regexp.MustCompile(`--comment "?` + regexp.QuoteMeta(commentPrefix) + `([^" ]+)"?`)
+	counters := map[string]RuleCounters{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		comment := commentRegexp.FindSubmatch(line)
+		if comment == nil {
+			continue
+		}
+		captures := countersRegexp.FindSubmatch(line)
+		if captures == nil {
+			continue
+		}
+		packets, err := strconv.ParseUint(string(captures[1]), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		byteCount, err := strconv.ParseUint(string(captures[2]), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		c := counters[string(comment[1])]
+		c.Packets += packets
+		c.Bytes += byteCount
+		counters[string(comment[1])] = c
+	}
+	return counters, scanner.Err()
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package iptables_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/projectcalico/calico/felix/iptables"
+)
+
+var _ = Describe("ParseCommentCounters", func() {
+	It("should sum the counters of the rules with each comment", func() {
+		save := strings.Join([]string{
+			"# Generated by iptables-save v1.8.4 on Mon Jan 10 12:00:00 2022",
+			"*filter",
+			":cali-pi-default.pol - [0:0]",
+			`[10:1000] -A cali-pi-default.pol -m comment --comment "cali:aaaa" -m comment --comment "cali-ctr:I,0,default,default.pol" -j MARK --set-xmark 0x100/0x100`,
+			`[3:180] -A cali-pi-default.pol -m comment --comment "cali:bbbb" -m comment --comment cali-ctr:I,1,default,default.pol -j MARK --set-xmark 0x80/0x80`,
+			`[2:120] -A cali-pi6-default.pol -m comment --comment "cali:cccc" -m comment --comment "cali-ctr:I,1,default,default.pol" -j MARK --set-xmark 0x80/0x80`,
+			`[7:700] -A cali-pi-default.pol -m comment --comment "cali:dddd" -j RETURN`,
+			"COMMIT",
+		}, "\n")
+		counters, err := ParseCommentCounters(strings.NewReader(save), "cali-ctr:")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(counters).To(Equal(map[string]RuleCounters{
+			"I,0,default,default.pol": {Packets: 10, Bytes: 1000},
+			"I,1,default,default.pol": {Packets: 5, Bytes: 300},
+		}))
+	})
+})
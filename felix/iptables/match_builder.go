@@ -304,6 +304,12 @@ func (m MatchCriteria) NotICMPV6TypeAndCode(t, c uint8) MatchCriteria {
 	return append(m, fmt.Sprintf("-m icmp6 ! --icmpv6-type %d/%d", t, c))
 }
 
+// LimitPacketRate matches packets up to the given rate, in packets per second, with the given burst.
+// Note: the limit module supports rates up to 10000 packets per second.
+func (m MatchCriteria) LimitPacketRate(rate, burst int64) MatchCriteria {
+	return append(m, fmt.Sprintf("-m limit --limit %d/second --limit-burst %d", rate, burst))
+}
+
 // VXLANVNI matches on the VNI contained within the VXLAN header.  It assumes that this is indeed a VXLAN
 // packet; i.e. it should be used with a protocol==UDP and port==VXLAN port match.
 //
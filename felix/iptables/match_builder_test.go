@@ -60,6 +60,7 @@ var _ = DescribeTable("MatchBuilder",
 	// Conntrack.
 	Entry("ConntrackState", Match().ConntrackState("INVALID"), "-m conntrack --ctstate INVALID"),
 	// Interfaces.
+	Entry("LimitPacketRate", Match().LimitPacketRate(100, 200), "-m limit --limit 100/second --limit-burst 200"),
 	Entry("InInterface", Match().InInterface("tap1234abcd"), "--in-interface tap1234abcd"),
 	Entry("OutInterface", Match().OutInterface("tap1234abcd"), "--out-interface tap1234abcd"),
 	// Address types.
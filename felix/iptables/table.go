@@ -454,6 +454,10 @@ func NewTable(
 	return table
 }
 
+func (t *Table) GetIPVersion() uint8 {
+	return t.IPVersion
+}
+
 // Insert or Append rules based on insert mode configuration.
 func (t *Table) InsertOrAppendRules(chainName string, rules []Rule) {
 	t.logCxt.WithField("chainName", chainName).Debug("Updating rule insertions")
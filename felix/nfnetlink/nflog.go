@@ -0,0 +1,197 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package nfnetlink receives packets that iptables NFLOG rules send to userspace, using the
+// nfnetlink_log netlink protocol.
+package nfnetlink
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"syscall"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+// Constants from linux/netfilter/nfnetlink_log.h.
+const (
+	nfulnlMsgPacket = 0
+	nfulnlMsgConfig = 1
+
+	nfulaCfgCmd  = 1
+	nfulaCfgMode = 2
+
+	nfulaPayload = 9
+	nfulaPrefix  = 10
+
+	nfulnlCfgCmdBind = 1
+
+	nfulnlCopyPacket = 2
+
+	nlaTypeMask = ^uint16(unix.NLA_F_NESTED | unix.NLA_F_NET_BYTEORDER)
+)
+
+// NflogPacket is a packet received from an NFLOG group.
+type NflogPacket struct {
+	// Prefix is the --nflog-prefix of the rule that logged the packet.
+	Prefix string
+	// Payload is the packet, starting with its IP header.
+	Payload []byte
+}
+
+// NflogSubscribe binds to the given NFLOG group and starts a goroutine that sends the packets
+// logged to that group to packetsC, until done is closed.  copyRange is the maximum number of
+// bytes of each packet to copy to userspace; bufSize is the size of the socket's receive
+// buffer.
+func NflogSubscribe(groupNum int, copyRange int, bufSize int, packetsC chan<- *NflogPacket, done <-chan struct{}) error {
+	sock, err := nl.Subscribe(unix.NETLINK_NETFILTER)
+	if err != nil {
+		return fmt.Errorf("failed to open netfilter netlink socket: %w", err)
+	}
+	if err := unix.SetsockoptInt(sock.GetFd(), unix.SOL_SOCKET, unix.SO_RCVBUF, bufSize); err != nil {
+		log.WithError(err).Warn("Failed to set NFLOG socket receive buffer size")
+	}
+
+	// Bind to the group and ask for the packet contents to be copied to us.
+	bindAttr := nl.NewRtAttr(nfulaCfgCmd, []byte{nfulnlCfgCmdBind})
+	mode := make([]byte, 6)
+	binary.BigEndian.PutUint32(mode, uint32(copyRange))
+	mode[4] = nfulnlCopyPacket
+	modeAttr := nl.NewRtAttr(nfulaCfgMode, mode)
+	for _, attr := range []*nl.RtAttr{bindAttr, modeAttr} {
+		if err := sendConfig(sock, groupNum, attr); err != nil {
+			sock.Close()
+			return fmt.Errorf("failed to configure NFLOG group %d: %w", groupNum, err)
+		}
+	}
+
+	// Use a receive timeout so that the reader can notice when it should stop.
+	if err := sock.SetReceiveTimeout(&unix.Timeval{Sec: 1}); err != nil {
+		sock.Close()
+		return fmt.Errorf("failed to set NFLOG socket timeout: %w", err)
+	}
+
+	go func() {
+		defer sock.Close()
+		logCxt := log.WithField("group", groupNum)
+		for {
+			select {
+			case <-done:
+				logCxt.Info("Stopping NFLOG reader")
+				return
+			default:
+			}
+			msgs, _, err := sock.Receive()
+			if err != nil {
+				if errors.Is(err, unix.EAGAIN) || errors.Is(err, unix.EINTR) {
+					continue
+				}
+				if errors.Is(err, unix.ENOBUFS) {
+					logCxt.Warn("NFLOG socket buffer overflowed, some packets were dropped")
+					continue
+				}
+				logCxt.WithError(err).Error("Failed to receive from NFLOG socket")
+				continue
+			}
+			for _, msg := range msgs {
+				pkt, err := ParseNflogMessage(msg)
+				if err != nil {
+					logCxt.WithError(err).Debug("Ignoring unparseable NFLOG message")
+					continue
+				}
+				if pkt == nil {
+					continue
+				}
+				select {
+				case packetsC <- pkt:
+				case <-done:
+					return
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func sendConfig(sock *nl.NetlinkSocket, groupNum int, attr *nl.RtAttr) error {
+	req := nl.NewNetlinkRequest((unix.NFNL_SUBSYS_ULOG<<8)|nfulnlMsgConfig, unix.NLM_F_ACK)
+	req.AddRawData(nfgenmsg(groupNum))
+	req.AddData(attr)
+	if err := sock.Send(req); err != nil {
+		return err
+	}
+	for {
+		msgs, _, err := sock.Receive()
+		if err != nil {
+			return err
+		}
+		for _, msg := range msgs {
+			if msg.Header.Type != unix.NLMSG_ERROR || msg.Header.Seq != req.Seq {
+				continue
+			}
+			if len(msg.Data) < 4 {
+				return errors.New("short netlink ack")
+			}
+			if errno := -int32(nl.NativeEndian().Uint32(msg.Data[0:4])); errno != 0 {
+				return syscall.Errno(errno)
+			}
+			return nil
+		}
+	}
+}
+
+// nfgenmsg returns the nfnetlink header for the given NFLOG group.  The resource ID (the group)
+// is big-endian.
+func nfgenmsg(groupNum int) []byte {
+	return []byte{unix.AF_UNSPEC, nl.NFNETLINK_V0, byte(groupNum >> 8), byte(groupNum)}
+}
+
+// ParseNflogMessage parses a netlink message received on an NFLOG socket.  It returns nil, with
+// no error, for messages that are not logged packets.
+func ParseNflogMessage(msg syscall.NetlinkMessage) (*NflogPacket, error) {
+	if msg.Header.Type != (unix.NFNL_SUBSYS_ULOG<<8)|nfulnlMsgPacket {
+		return nil, nil
+	}
+	if len(msg.Data) < nl.SizeofNfgenmsg {
+		return nil, errors.New("NFLOG message too short")
+	}
+	attrs, err := nl.ParseRouteAttr(msg.Data[nl.SizeofNfgenmsg:])
+	if err != nil {
+		return nil, err
+	}
+	pkt := &NflogPacket{}
+	for _, attr := range attrs {
+		switch attr.Attr.Type & nlaTypeMask {
+		case nfulaPrefix:
+			// The prefix is NUL-terminated.
+			prefix := attr.Value
+			for i, b := range prefix {
+				if b == 0 {
+					prefix = prefix[:i]
+					break
+				}
+			}
+			pkt.Prefix = string(prefix)
+		case nfulaPayload:
+			pkt.Payload = attr.Value
+		}
+	}
+	if pkt.Payload == nil {
+		return nil, errors.New("NFLOG message has no payload")
+	}
+	return pkt, nil
+}
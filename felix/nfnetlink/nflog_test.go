@@ -0,0 +1,70 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nfnetlink_test
+
+import (
+	"syscall"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+
+	. "github.com/projectcalico/calico/felix/nfnetlink"
+)
+
+func nflogMessage(msgType uint16, attrs ...*nl.RtAttr) syscall.NetlinkMessage {
+	data := []byte{unix.AF_INET, nl.NFNETLINK_V0, 0, 3}
+	for _, attr := range attrs {
+		data = append(data, attr.Serialize()...)
+	}
+	return syscall.NetlinkMessage{
+		Header: syscall.NlMsghdr{Type: msgType},
+		Data:   data,
+	}
+}
+
+var _ = Describe("NFLOG message parsing", func() {
+	packetMsgType := uint16(unix.NFNL_SUBSYS_ULOG << 8)
+	payload := []byte{0x45, 0x00, 0x00, 0x1c, 1, 2, 3}
+
+	It("should extract the prefix and payload", func() {
+		pkt, err := ParseNflogMessage(nflogMessage(packetMsgType,
+			nl.NewRtAttr(10, []byte("DNS\x00")),
+			nl.NewRtAttr(9, payload),
+		))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pkt).To(Equal(&NflogPacket{Prefix: "DNS", Payload: payload}))
+	})
+
+	It("should ignore other message types", func() {
+		pkt, err := ParseNflogMessage(nflogMessage(packetMsgType|1, nl.NewRtAttr(9, payload)))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pkt).To(BeNil())
+	})
+
+	It("should reject a packet message with no payload", func() {
+		_, err := ParseNflogMessage(nflogMessage(packetMsgType, nl.NewRtAttr(10, []byte("DNS\x00"))))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject a truncated message", func() {
+		_, err := ParseNflogMessage(syscall.NetlinkMessage{
+			Header: syscall.NlMsghdr{Type: packetMsgType},
+			Data:   []byte{unix.AF_INET},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
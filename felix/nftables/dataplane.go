@@ -0,0 +1,272 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nftables
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/felix/ipsets"
+	"github.com/projectcalico/calico/felix/logutils"
+)
+
+type DataplaneOptions struct {
+	// InsertMode is "insert" or "append"; our base chains are hooked in just before or just
+	// after the iptables chains with the same hook so that they see packets first or last, as
+	// they would if we inserted or appended our rules to the built-in iptables chains.
+	InsertMode      string
+	RefreshInterval time.Duration
+
+	// NewConnOverride for tests, if non-nil, factory to use instead of a real netlink connection.
+	NewConnOverride connFactory
+	// SleepOverride for tests, if non-nil, replacement for time.Sleep()
+	SleepOverride func(d time.Duration)
+	// NowOverride for tests, if non-nil, replacement for time.Now()
+	NowOverride func() time.Time
+	// Thunk to call periodically when doing a long-running operation.
+	OnStillAlive func()
+	// OpRecorder to tell when we do resyncs etc.
+	OpRecorder logutils.OpRecorder
+}
+
+// Dataplane owns our nftables table in the family of one IP version.  It holds the stand-ins for
+// the four iptables tables and for the IP sets, which record the desired state, and writes all of
+// their updates to the kernel in a single transaction, so either all of an update is applied or
+// none of it is.
+type Dataplane struct {
+	IPVersion uint8
+
+	Mangle *Table
+	NAT    *Table
+	Raw    *Table
+	Filter *Table
+	IPSets *IPSets
+
+	tables []*Table
+
+	inSyncWithDataPlane bool
+	lastReadTime        time.Time
+	refreshInterval     time.Duration
+
+	logCxt *log.Entry
+
+	newConn      connFactory
+	timeSleep    func(d time.Duration)
+	timeNow      func() time.Time
+	onStillAlive func()
+	opReporter   logutils.OpRecorder
+}
+
+func NewDataplane(
+	ipVersion uint8,
+	hashPrefix string,
+	features featureSource,
+	ipSetsConfig *ipsets.IPVersionConfig,
+	options DataplaneOptions,
+) *Dataplane {
+	newConn := newRealConn
+	if options.NewConnOverride != nil {
+		newConn = options.NewConnOverride
+	}
+	sleep := time.Sleep
+	if options.SleepOverride != nil {
+		sleep = options.SleepOverride
+	}
+	now := time.Now
+	if options.NowOverride != nil {
+		now = options.NowOverride
+	}
+	onStillAlive := func() {}
+	if options.OnStillAlive != nil {
+		onStillAlive = options.OnStillAlive
+	}
+
+	d := &Dataplane{
+		IPVersion:       ipVersion,
+		IPSets:          newIPSets(ipSetsConfig),
+		refreshInterval: options.RefreshInterval,
+		logCxt: log.WithFields(log.Fields{
+			"ipVersion": ipVersion,
+			"backend":   "nftables",
+		}),
+		newConn:      newConn,
+		timeSleep:    sleep,
+		timeNow:      now,
+		onStillAlive: onStillAlive,
+		opReporter:   options.OpRecorder,
+	}
+	newTable := func(name string) *Table {
+		t := newTable(name, ipVersion, hashPrefix, features, options.InsertMode, newConn)
+		d.tables = append(d.tables, t)
+		return t
+	}
+	d.Mangle = newTable("mangle")
+	d.NAT = newTable("nat")
+	d.Raw = newTable("raw")
+	d.Filter = newTable("filter")
+	return d
+}
+
+// InvalidateDataplaneCache forces a re-read of the dataplane on the next Apply().  Nothing else
+// should modify our table so, unlike the iptables implementation, we don't invalidate the cache
+// before every write; only on failures and when the refresh timer pops.
+func (d *Dataplane) InvalidateDataplaneCache(reason string) {
+	logCxt := d.logCxt.WithField("reason", reason)
+	if !d.inSyncWithDataPlane {
+		logCxt.Debug("Would invalidate dataplane cache but it was already invalid.")
+		return
+	}
+	logCxt.Debug("Invalidating dataplane cache")
+	d.inSyncWithDataPlane = false
+}
+
+// Apply writes any pending updates to the tables and IP sets to the dataplane, in a single
+// transaction.  It retries on failure and panics if the dataplane can't be programmed.
+func (d *Dataplane) Apply() (rescheduleAfter time.Duration) {
+	if d.refreshInterval > 0 && d.timeNow().Sub(d.lastReadTime) > d.refreshInterval {
+		// Too long since we've forced a refresh.
+		d.InvalidateDataplaneCache("refresh timer")
+	}
+	if d.IPSets.resyncRequired {
+		d.InvalidateDataplaneCache("IP set resync")
+	}
+
+	retries := 10
+	backoffTime := 1 * time.Millisecond
+	for {
+		var err error
+		if !d.inSyncWithDataPlane {
+			// We have reason to believe that our picture of the dataplane is out of sync.
+			// Refresh it.  This may mark chains and sets as dirty.
+			err = d.loadDataplaneState()
+		}
+		d.onStillAlive()
+		if err == nil {
+			err = d.applyUpdates()
+		}
+		if err != nil {
+			if retries > 0 {
+				retries--
+				d.logCxt.WithError(err).Warn("Failed to program nftables, will retry")
+				d.InvalidateDataplaneCache("update failed")
+				d.timeSleep(backoffTime)
+				backoffTime *= 2
+				continue
+			}
+			d.logCxt.WithError(err).Panic("Failed to program nftables, giving up after retries")
+		}
+		break
+	}
+
+	if d.refreshInterval > 0 {
+		rescheduleAfter = d.refreshInterval - d.timeNow().Sub(d.lastReadTime)
+		if rescheduleAfter <= 0 {
+			rescheduleAfter = 1 * time.Millisecond
+		}
+	}
+	return
+}
+
+// RenderFailures returns the chains, as "<table>/<chain>", whose rules we failed to render.  They
+// drop all traffic until their rules can be rendered.
+func (d *Dataplane) RenderFailures() []string {
+	var chains []string
+	for _, t := range d.tables {
+		for _, chainName := range t.RenderFailures() {
+			chains = append(chains, t.Name+"/"+chainName)
+		}
+	}
+	return chains
+}
+
+func (d *Dataplane) loadDataplaneState() error {
+	d.logCxt.Debug("Loading current nftables state and checking it is correct.")
+	d.opReporter.RecordOperation(fmt.Sprintf("resync-nft-v%d", d.IPVersion))
+	d.lastReadTime = d.timeNow()
+
+	c, err := d.newConn()
+	if err != nil {
+		return err
+	}
+	state, err := listTable(c, d.IPVersion, "", true)
+	if err != nil {
+		return err
+	}
+	for _, t := range d.tables {
+		t.loadDataplaneState(state)
+	}
+	d.IPSets.loadDataplaneState(state)
+	d.inSyncWithDataPlane = true
+	return nil
+}
+
+func (d *Dataplane) applyUpdates() error {
+	setsUpdate := d.IPSets.prepareUpdate()
+	if setsUpdate.recreating {
+		// Rewrite every chain so that no rules refer to the sets by the time we delete them.
+		for _, t := range d.tables {
+			t.markAllDirty()
+		}
+	}
+	tableUpdates := make([]*tableUpdate, len(d.tables))
+	empty := len(setsUpdate.deletions) == 0 && len(setsUpdate.dirtySetIDs) == 0
+	for i, t := range d.tables {
+		tableUpdates[i] = t.prepareUpdate()
+		empty = empty && len(tableUpdates[i].toWrite) == 0 && len(tableUpdates[i].toDelete) == 0
+	}
+	if empty {
+		d.logCxt.Debug("No updates to nftables.")
+		for i, t := range d.tables {
+			t.commitUpdate(tableUpdates[i])
+		}
+		d.IPSets.commitUpdate(setsUpdate)
+		return nil
+	}
+
+	tx, err := newTransaction(d.newConn)
+	if err != nil {
+		return err
+	}
+	// The order matters: the kernel checks each change against the state left by the previous
+	// ones.
+	tx.AddTable(ourTable(d.IPVersion))
+	for i, t := range d.tables {
+		t.queueChainFlushes(tx, tableUpdates[i])
+	}
+	d.IPSets.queueDeletions(tx, setsUpdate)
+	if err := d.IPSets.queueUpdates(tx, setsUpdate); err != nil {
+		return err
+	}
+	for i, t := range d.tables {
+		if err := t.queueRules(tx, tableUpdates[i]); err != nil {
+			return err
+		}
+	}
+	for i, t := range d.tables {
+		t.queueChainDeletions(tx, tableUpdates[i])
+	}
+
+	d.opReporter.RecordOperation(fmt.Sprintf("update-nft-v%d", d.IPVersion))
+	if err := tx.commit(); err != nil {
+		return err
+	}
+	for i, t := range d.tables {
+		t.commitUpdate(tableUpdates[i])
+	}
+	d.IPSets.commitUpdate(setsUpdate)
+	return nil
+}
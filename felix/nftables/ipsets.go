@@ -0,0 +1,590 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nftables
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+
+	"github.com/projectcalico/calico/felix/ip"
+	"github.com/projectcalico/calico/felix/ipsets"
+	"github.com/projectcalico/calico/felix/labelindex"
+)
+
+// maxElementsPerMessage limits the number of elements that we add or remove in a single netlink
+// message to keep the messages well within the size of the kernel's socket buffers.
+const maxElementsPerMessage = 1000
+
+// IPSets is a drop-in replacement for ipsets.IPSets that programs each IP set as an nftables set
+// in our nftables table.  Sets are named after the IP sets that they replace (see SetName()).
+//
+// nftables doesn't allow the elements of an interval set (which we use for hash:net IP sets) to
+// overlap.  We therefore only write the CIDRs of such a set that aren't contained in another of
+// its CIDRs, which matches the same packets.
+//
+// Like Table, IPSets only records the desired state; the Dataplane that owns it writes the
+// changes to the sets in the same transaction as the changes to the chains that use them.
+type IPSets struct {
+	IPVersionConfig *ipsets.IPVersionConfig
+
+	ipVersion uint8
+
+	setIDToIPSet map[string]*nftSet
+	// existingSetNames contains the names of the sets that are in the dataplane.
+	existingSetNames set.Set
+	// dirtyIPSetIDs contains IDs of IP sets that need updating.
+	dirtyIPSetIDs  set.Set
+	resyncRequired bool
+	// pendingDeletions contains the names of sets that need to be deleted.
+	pendingDeletions set.Set
+
+	logCxt *log.Entry
+}
+
+// nftSet holds the state for a particular IP set.
+type nftSet struct {
+	ipsets.IPSetMetadata
+
+	Name string
+
+	// members contains the canonicalised members that we want in the set.
+	members set.Set
+	// programmed contains the members that we believe are in the dataplane (for hash:net sets,
+	// after removing the CIDRs that are contained in other CIDRs), or nil if the set needs to
+	// be rewritten.
+	programmed set.Set
+	// wrongType is true if the set in the dataplane has the wrong type, so it has to be deleted
+	// and recreated.
+	wrongType bool
+}
+
+func newIPSets(ipVersionConfig *ipsets.IPVersionConfig) *IPSets {
+	return &IPSets{
+		IPVersionConfig:  ipVersionConfig,
+		ipVersion:        uint8(ipVersionConfig.Family.Version()),
+		setIDToIPSet:     map[string]*nftSet{},
+		existingSetNames: set.New(),
+		dirtyIPSetIDs:    set.New(),
+		resyncRequired:   true,
+		pendingDeletions: set.New(),
+		logCxt: log.WithFields(log.Fields{
+			"family":  ipVersionConfig.Family,
+			"backend": "nftables",
+		}),
+	}
+}
+
+func (s *IPSets) setName(setID string) string {
+	return SetName(s.IPVersionConfig.NameForMainIPSet(setID))
+}
+
+// AddOrReplaceIPSet queues up the creation (or replacement) of an IP set.
+func (s *IPSets) AddOrReplaceIPSet(setMetadata ipsets.IPSetMetadata, members []string) {
+	s.logCxt.WithFields(log.Fields{
+		"setID":   setMetadata.SetID,
+		"setType": setMetadata.Type,
+	}).Info("Queueing IP set for creation")
+	name := s.setName(setMetadata.SetID)
+	nftSet := &nftSet{
+		IPSetMetadata: setMetadata,
+		Name:          name,
+		members:       s.filterAndCanonicaliseMembers(setMetadata.Type, members),
+	}
+	if old := s.setIDToIPSet[setMetadata.SetID]; old != nil {
+		if old.Type == setMetadata.Type {
+			// Keep our record of the dataplane so that we can write a delta.
+			nftSet.programmed = old.programmed
+			nftSet.wrongType = old.wrongType
+		} else {
+			nftSet.wrongType = s.existingSetNames.Contains(name)
+		}
+	}
+	s.setIDToIPSet[setMetadata.SetID] = nftSet
+	s.dirtyIPSetIDs.Add(setMetadata.SetID)
+	s.pendingDeletions.Discard(name)
+}
+
+// RemoveIPSet queues up the removal of an IP set.  The set is removed in the same transaction as
+// the rules that refer to it.
+func (s *IPSets) RemoveIPSet(setID string) {
+	s.logCxt.WithField("setID", setID).Info("Queueing IP set for removal")
+	delete(s.setIDToIPSet, setID)
+	s.dirtyIPSetIDs.Discard(setID)
+	s.pendingDeletions.Add(s.setName(setID))
+}
+
+// AddMembers adds the given members to the IP set.  Filters out members that are of the incorrect
+// IP version.
+func (s *IPSets) AddMembers(setID string, newMembers []string) {
+	nftSet := s.setIDToIPSet[setID]
+	canonMembers := s.filterAndCanonicaliseMembers(nftSet.Type, newMembers)
+	canonMembers.Iter(func(m interface{}) error {
+		nftSet.members.Add(m)
+		return nil
+	})
+	s.dirtyIPSetIDs.Add(setID)
+}
+
+// RemoveMembers queues up removal of the given members from an IP set.  Members of the wrong IP
+// version are ignored.
+func (s *IPSets) RemoveMembers(setID string, removedMembers []string) {
+	nftSet := s.setIDToIPSet[setID]
+	canonMembers := s.filterAndCanonicaliseMembers(nftSet.Type, removedMembers)
+	canonMembers.Iter(func(m interface{}) error {
+		nftSet.members.Discard(m)
+		return nil
+	})
+	s.dirtyIPSetIDs.Add(setID)
+}
+
+// QueueResync forces a resync of the whole dataplane on the next update.
+func (s *IPSets) QueueResync() {
+	s.logCxt.Debug("Asked to resync with the dataplane on next update.")
+	s.resyncRequired = true
+}
+
+func (s *IPSets) GetIPFamily() ipsets.IPFamily {
+	return s.IPVersionConfig.Family
+}
+
+func (s *IPSets) GetTypeOf(setID string) (ipsets.IPSetType, error) {
+	nftSet, ok := s.setIDToIPSet[setID]
+	if !ok {
+		return "", fmt.Errorf("ipset %s not found", setID)
+	}
+	return nftSet.Type, nil
+}
+
+func (s *IPSets) GetMembers(setID string) (set.Set, error) {
+	nftSet, ok := s.setIDToIPSet[setID]
+	if !ok {
+		return nil, fmt.Errorf("ipset %s not found", setID)
+	}
+	members := set.New()
+	nftSet.members.Iter(func(item interface{}) error {
+		members.Add(item.(fmt.Stringer).String())
+		return nil
+	})
+	return members, nil
+}
+
+func (s *IPSets) filterAndCanonicaliseMembers(ipSetType ipsets.IPSetType, members []string) set.Set {
+	filtered := set.New()
+	wantIPV6 := s.IPVersionConfig.Family == ipsets.IPFamilyV6
+	for _, member := range members {
+		if ipSetType.IsMemberIPV6(member) != wantIPV6 {
+			continue
+		}
+		filtered.Add(ipSetType.CanonicaliseMember(member))
+	}
+	return filtered
+}
+
+// ApplyUpdates does nothing: the Dataplane that owns the IP sets writes their updates.  It is here
+// so that IPSets can be used in place of an ipsets.IPSets.
+func (s *IPSets) ApplyUpdates() {
+}
+
+// ApplyDeletions does nothing; see ApplyUpdates().
+func (s *IPSets) ApplyDeletions() {
+}
+
+// loadDataplaneState compares the sets in the given state of the dataplane with our record of
+// what we programmed.  Sets that differ are marked for a rewrite and sets that we don't want are
+// marked for deletion.
+func (s *IPSets) loadDataplaneState(state *tableState) {
+	s.existingSetNames = set.New()
+	nameToSetID := map[string]string{}
+	for setID, nftSet := range s.setIDToIPSet {
+		nameToSetID[nftSet.Name] = setID
+	}
+	for name, actual := range state.sets {
+		s.existingSetNames.Add(name)
+		setID, ok := nameToSetID[name]
+		if !ok {
+			s.logCxt.WithField("setName", name).Info("Found unexpected nftables set, queueing it for deletion")
+			s.pendingDeletions.Add(name)
+			continue
+		}
+		nftSet := s.setIDToIPSet[setID]
+		nftSet.programmed = nil
+		nftSet.wrongType = !s.hasType(actual.set, nftSet.Type)
+		if nftSet.wrongType {
+			s.logCxt.WithField("setName", name).Info("Found nftables set of the wrong type, queueing it for recreation")
+		} else if members, err := s.membersFromElements(nftSet.Type, actual.elements); err != nil {
+			s.logCxt.WithError(err).WithField("setName", name).Warn("Failed to parse nftables set, queueing it for rewrite")
+		} else {
+			nftSet.programmed = members
+		}
+		s.dirtyIPSetIDs.Add(setID)
+	}
+	for setID, nftSet := range s.setIDToIPSet {
+		if !s.existingSetNames.Contains(nftSet.Name) {
+			nftSet.programmed = nil
+			nftSet.wrongType = false
+			s.dirtyIPSetIDs.Add(setID)
+		}
+	}
+	s.resyncRequired = false
+}
+
+// ipSetsUpdate is the pending update to the IP sets.
+type ipSetsUpdate struct {
+	// deletions contains the names of the sets to delete, including those that we recreate.
+	deletions []string
+	// dirtySetIDs contains the IDs of the sets to create or update, sorted.
+	dirtySetIDs []string
+	// wanted contains the members that each dirty set will have.
+	wanted map[string]set.Set
+	// recreating is true if any set is being deleted and recreated because it has the wrong
+	// type.  That can only be done once all the rules that refer to the set have been removed.
+	recreating bool
+}
+
+func (s *IPSets) prepareUpdate() *ipSetsUpdate {
+	u := &ipSetsUpdate{wanted: map[string]set.Set{}}
+	s.pendingDeletions.Iter(func(item interface{}) error {
+		if name := item.(string); s.existingSetNames.Contains(name) {
+			u.deletions = append(u.deletions, name)
+		}
+		return nil
+	})
+	s.dirtyIPSetIDs.Iter(func(item interface{}) error {
+		setID := item.(string)
+		nftSet := s.setIDToIPSet[setID]
+		if nftSet.wrongType {
+			u.deletions = append(u.deletions, nftSet.Name)
+			u.recreating = true
+		}
+		u.dirtySetIDs = append(u.dirtySetIDs, setID)
+		u.wanted[setID] = s.desiredMembers(nftSet)
+		return nil
+	})
+	sort.Strings(u.deletions)
+	sort.Strings(u.dirtySetIDs)
+	return u
+}
+
+func (s *IPSets) nftablesSet(name string, t ipsets.IPSetType) *nftables.Set {
+	keyType, interval := s.setType(t)
+	return &nftables.Set{
+		Table:         ourTable(s.ipVersion),
+		Name:          name,
+		KeyType:       keyType,
+		Interval:      interval,
+		Concatenation: t == ipsets.IPSetTypeHashIPPort,
+	}
+}
+
+// queueDeletions queues the deletion of the sets that we no longer want and of the sets that we
+// have to recreate.  The Dataplane queues this after flushing the chains that refer to them.
+func (s *IPSets) queueDeletions(tx *transaction, u *ipSetsUpdate) {
+	for _, name := range u.deletions {
+		s.logCxt.WithField("setName", name).Info("Deleting nftables set.")
+		tx.DelSet(&nftables.Set{Table: ourTable(s.ipVersion), Name: name})
+	}
+}
+
+// queueUpdates queues the creation of new sets and the changes to the members of existing ones.
+func (s *IPSets) queueUpdates(tx *transaction, u *ipSetsUpdate) error {
+	for _, setID := range u.dirtySetIDs {
+		nftSet := s.setIDToIPSet[setID]
+		desired := u.wanted[setID]
+		nftablesSet := s.nftablesSet(nftSet.Name, nftSet.Type)
+
+		if !s.existingSetNames.Contains(nftSet.Name) || nftSet.wrongType {
+			if err := tx.addSet(nftablesSet, s.elements(nftSet.Type, desired)); err != nil {
+				return err
+			}
+			continue
+		}
+		if nftSet.programmed == nil {
+			// We don't know what's in the set: empty and refill it.
+			tx.FlushSet(nftablesSet)
+			if err := tx.addElements(nftablesSet, s.elements(nftSet.Type, desired)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Delta update.  Deletions first, in case an added CIDR overlaps one that we're
+		// removing.
+		toDelete := set.New()
+		nftSet.programmed.Iter(func(item interface{}) error {
+			if !desired.Contains(item) {
+				toDelete.Add(item)
+			}
+			return nil
+		})
+		toAdd := set.New()
+		desired.Iter(func(item interface{}) error {
+			if !nftSet.programmed.Contains(item) {
+				toAdd.Add(item)
+			}
+			return nil
+		})
+		if err := tx.deleteElements(nftablesSet, s.elements(nftSet.Type, toDelete)); err != nil {
+			return err
+		}
+		if err := tx.addElements(nftablesSet, s.elements(nftSet.Type, toAdd)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// commitUpdate updates our record of the dataplane once the transaction that contained the given
+// update has been committed.
+func (s *IPSets) commitUpdate(u *ipSetsUpdate) {
+	for _, name := range u.deletions {
+		s.existingSetNames.Discard(name)
+	}
+	// Any other pending deletions were of sets that weren't in the dataplane.
+	s.pendingDeletions.Clear()
+	for _, setID := range u.dirtySetIDs {
+		nftSet := s.setIDToIPSet[setID]
+		nftSet.programmed = u.wanted[setID]
+		nftSet.wrongType = false
+		s.existingSetNames.Add(nftSet.Name)
+	}
+	s.dirtyIPSetIDs.Clear()
+}
+
+// setType returns the key type of the nftables set that we use for the given IP set type and
+// whether it is an interval set.
+func (s *IPSets) setType(t ipsets.IPSetType) (keyType nftables.SetDatatype, interval bool) {
+	addrType := nftables.TypeIPAddr
+	if s.ipVersion == 6 {
+		addrType = nftables.TypeIP6Addr
+	}
+	switch t {
+	case ipsets.IPSetTypeHashIPPort:
+		return nftables.MustConcatSetType(addrType, nftables.TypeInetProto, nftables.TypeInetService), false
+	case ipsets.IPSetTypeHashNet:
+		return addrType, true
+	}
+	return addrType, false
+}
+
+// hasType returns true if the given set from the dataplane is of the type that we use for the
+// given IP set type.  The kernel only reports the "magic" number of a concatenated type.
+func (s *IPSets) hasType(actual *nftables.Set, t ipsets.IPSetType) bool {
+	keyType, interval := s.setType(t)
+	return actual.KeyType.GetNFTMagic() == keyType.GetNFTMagic() && actual.Interval == interval
+}
+
+// desiredMembers returns the members that we write to the dataplane for the given set.
+func (s *IPSets) desiredMembers(nftSet *nftSet) set.Set {
+	if nftSet.Type != ipsets.IPSetTypeHashNet {
+		return nftSet.members.Copy()
+	}
+	var cidrs []ip.CIDR
+	nftSet.members.Iter(func(item interface{}) error {
+		cidrs = append(cidrs, item.(ip.CIDR))
+		return nil
+	})
+	desired := set.New()
+	for _, c := range collapseCIDRs(cidrs) {
+		desired.Add(c)
+	}
+	return desired
+}
+
+// elements converts the given members to nftables set elements, in a stable order.
+func (s *IPSets) elements(t ipsets.IPSetType, members set.Set) []nftables.SetElement {
+	var elements []nftables.SetElement
+	members.Iter(func(item interface{}) error {
+		switch m := item.(type) {
+		case ip.CIDR:
+			// An interval: the start address and the end element, which holds the first address
+			// after the CIDR.  A CIDR that runs to the last address has no end element.
+			ipNet := m.ToIPNet()
+			start := addrBytes(ipNet.IP)
+			elements = append(elements, nftables.SetElement{Key: start})
+			if end := nextAfterCIDR(start, m.Prefix()); end != nil {
+				elements = append(elements, nftables.SetElement{Key: end, IntervalEnd: true})
+			}
+		case ip.Addr:
+			elements = append(elements, nftables.SetElement{Key: addrBytes(m.AsNetIP())})
+		case ipsets.V4IPPort:
+			elements = append(elements, nftables.SetElement{Key: ipPortKey(m.IP.AsNetIP(), m.Protocol, m.Port)})
+		case ipsets.V6IPPort:
+			elements = append(elements, nftables.SetElement{Key: ipPortKey(m.IP.AsNetIP(), m.Protocol, m.Port)})
+		default:
+			s.logCxt.WithField("member", item).Panic("Unexpected IP set member type")
+		}
+		return nil
+	})
+	sort.Slice(elements, func(i, j int) bool {
+		return compareElements(elements[i], elements[j]) < 0
+	})
+	return elements
+}
+
+// compareElements orders elements by key, with interval ends before starts on equal keys, so
+// that each interval start is followed by its end.
+func compareElements(a, b nftables.SetElement) int {
+	if c := bytes.Compare(a.Key, b.Key); c != 0 {
+		return c
+	}
+	if a.IntervalEnd == b.IntervalEnd {
+		return 0
+	}
+	if a.IntervalEnd {
+		return -1
+	}
+	return 1
+}
+
+// membersFromElements converts the elements of a set in the dataplane back to members.  It
+// returns an error if the elements aren't ones that we would have written.
+func (s *IPSets) membersFromElements(t ipsets.IPSetType, elements []nftables.SetElement) (set.Set, error) {
+	members := set.New()
+	addrLen := 4
+	if s.ipVersion == 6 {
+		addrLen = 16
+	}
+	switch t {
+	case ipsets.IPSetTypeHashNet:
+		elements = append([]nftables.SetElement(nil), elements...)
+		sort.Slice(elements, func(i, j int) bool {
+			return compareElements(elements[i], elements[j]) < 0
+		})
+		for i := 0; i < len(elements); i++ {
+			start := elements[i]
+			if start.IntervalEnd || len(start.Key) != addrLen {
+				return nil, fmt.Errorf("unexpected element %v", start)
+			}
+			var end []byte
+			if i+1 < len(elements) && elements[i+1].IntervalEnd {
+				end = elements[i+1].Key
+				i++
+			}
+			cidr, err := cidrFromInterval(start.Key, end)
+			if err != nil {
+				return nil, err
+			}
+			members.Add(cidr)
+		}
+	case ipsets.IPSetTypeHashIPPort:
+		for _, e := range elements {
+			// The address, the protocol and the port, each padded to a multiple of 4 bytes.
+			if len(e.Key) != addrLen+8 {
+				return nil, fmt.Errorf("unexpected element %v", e)
+			}
+			addr := ip.FromNetIP(net.IP(e.Key[:addrLen]))
+			proto := labelindex.IPSetPortProtocol(e.Key[addrLen])
+			port := binaryutil.BigEndian.Uint16(e.Key[addrLen+4 : addrLen+6])
+			switch a := addr.(type) {
+			case ip.V4Addr:
+				members.Add(ipsets.V4IPPort{IP: a, Protocol: proto, Port: port})
+			case ip.V6Addr:
+				members.Add(ipsets.V6IPPort{IP: a, Protocol: proto, Port: port})
+			}
+		}
+	default:
+		for _, e := range elements {
+			if len(e.Key) != addrLen {
+				return nil, fmt.Errorf("unexpected element %v", e)
+			}
+			members.Add(ip.FromNetIP(net.IP(e.Key)))
+		}
+	}
+	return members, nil
+}
+
+// addrBytes returns the 4 bytes of an IPv4 address or the 16 bytes of an IPv6 address.
+func addrBytes(addr net.IP) []byte {
+	if v4 := addr.To4(); v4 != nil {
+		return v4
+	}
+	return addr.To16()
+}
+
+// ipPortKey returns the key of an element of an IP,port set.
+func ipPortKey(addr net.IP, proto labelindex.IPSetPortProtocol, port uint16) []byte {
+	key := append([]byte(nil), addrBytes(addr)...)
+	key = append(key, byte(proto), 0, 0, 0)
+	key = append(key, binaryutil.BigEndian.PutUint16(port)...)
+	return append(key, 0, 0)
+}
+
+// nextAfterCIDR returns the first address after the CIDR with the given start address and
+// prefix length, or nil if the CIDR runs to the last address.
+func nextAfterCIDR(start []byte, prefix uint8) []byte {
+	bits := uint(len(start) * 8)
+	next := new(big.Int).SetBytes(start)
+	next.Add(next, new(big.Int).Lsh(big.NewInt(1), bits-uint(prefix)))
+	if next.BitLen() > int(bits) {
+		return nil
+	}
+	return next.FillBytes(make([]byte, len(start)))
+}
+
+// cidrFromInterval returns the CIDR that covers exactly the addresses from start up to, but not
+// including, end.  A nil end means that the interval runs to the last address.
+func cidrFromInterval(start, end []byte) (ip.CIDR, error) {
+	bits := len(start) * 8
+	first := new(big.Int).SetBytes(start)
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	if end != nil {
+		limit.SetBytes(end)
+	}
+	size := new(big.Int).Sub(limit, first)
+	// The size must be a power of two and the start must be aligned to it.
+	hostBits := size.BitLen() - 1
+	isCIDR := size.Sign() > 0 && size.TrailingZeroBits() == uint(hostBits)
+	if isCIDR && first.Sign() != 0 {
+		isCIDR = first.TrailingZeroBits() >= uint(hostBits)
+	}
+	if !isCIDR {
+		return nil, fmt.Errorf("interval %v-%v is not a CIDR", net.IP(start), net.IP(end))
+	}
+	return ip.CIDRFromAddrAndPrefix(ip.FromNetIP(net.IP(start)), bits-hostBits), nil
+}
+
+// collapseCIDRs returns the CIDRs that aren't contained in any of the other CIDRs.
+func collapseCIDRs(cidrs []ip.CIDR) []ip.CIDR {
+	// Sort by start address, then by prefix length so that any CIDR comes after all the CIDRs
+	// that contain it.  Since CIDRs are either nested or disjoint, the CIDRs that we keep are
+	// disjoint and so a CIDR can only be contained in the last one that we kept.
+	sort.Slice(cidrs, func(i, j int) bool {
+		a, b := cidrs[i].ToIPNet(), cidrs[j].ToIPNet()
+		if c := bytes.Compare(a.IP, b.IP); c != 0 {
+			return c < 0
+		}
+		return cidrs[i].Prefix() < cidrs[j].Prefix()
+	})
+	var kept []ip.CIDR
+	var last net.IPNet
+	for _, c := range cidrs {
+		ipNet := c.ToIPNet()
+		if len(kept) > 0 && last.Contains(ipNet.IP) {
+			continue
+		}
+		kept = append(kept, c)
+		last = ipNet
+	}
+	return kept
+}
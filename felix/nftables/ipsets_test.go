@@ -0,0 +1,268 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nftables
+
+import (
+	"time"
+
+	"github.com/google/nftables"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/ip"
+	"github.com/projectcalico/calico/felix/ipsets"
+	"github.com/projectcalico/calico/felix/iptables"
+	"github.com/projectcalico/calico/felix/logutils"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+)
+
+var _ = Describe("IPSets", func() {
+	var (
+		dataplane *mockNFT
+		nft       *Dataplane
+		s         *IPSets
+	)
+
+	BeforeEach(func() {
+		dataplane = newMockNFT()
+		nft = NewDataplane(4, "cali:", staticFeatures{},
+			ipsets.NewIPVersionConfig(ipsets.IPFamilyV4, "cali", nil, nil),
+			DataplaneOptions{
+				InsertMode:      "insert",
+				RefreshInterval: 30 * time.Second,
+				NewConnOverride: dataplane.newConn,
+				SleepOverride:   dataplane.sleep,
+				OpRecorder:      logutils.NewSummarizer("test loop"),
+			})
+		s = nft.IPSets
+	})
+
+	It("should create a new set with its members", func() {
+		s.AddOrReplaceIPSet(ipsets.IPSetMetadata{SetID: "s:abcd", Type: ipsets.IPSetTypeHashIP},
+			[]string{"10.0.0.2", "10.0.0.1", "fd00::1"})
+		nft.Apply()
+		Expect(dataplane.LastTransaction()).To(Equal([]string{
+			"add table calico",
+			"add set cali40s_abcd",
+			"add elements cali40s_abcd { 0a000001, 0a000002 }",
+		}))
+	})
+
+	It("should collapse overlapping CIDRs in a net set", func() {
+		s.AddOrReplaceIPSet(ipsets.IPSetMetadata{SetID: "s:abcd", Type: ipsets.IPSetTypeHashNet},
+			[]string{"10.0.0.0/16", "10.0.1.0/24", "10.0.0.5/32", "10.1.0.0/16", "192.168.0.1"})
+		nft.Apply()
+		Expect(dataplane.LastTransaction()).To(Equal([]string{
+			"add table calico",
+			"add set cali40s_abcd",
+			"add elements cali40s_abcd { 0a000000, 0a010000(end), 0a010000, 0a020000(end), c0a80001, c0a80002(end) }",
+		}))
+	})
+
+	It("should render IP,port sets", func() {
+		s.AddOrReplaceIPSet(ipsets.IPSetMetadata{SetID: "n:abcd", Type: ipsets.IPSetTypeHashIPPort},
+			[]string{"10.0.0.1,tcp:80", "10.0.0.2,udp:53"})
+		nft.Apply()
+		Expect(dataplane.LastTransaction()).To(ContainElement(
+			"add elements cali40n_abcd { 0a0000010600000000500000, 0a0000021100000000350000 }"))
+		set := dataplane.Tables[nftables.TableFamilyIPv4].Sets["cali40n_abcd"].Set
+		Expect(set.Concatenation).To(BeTrue())
+		keyType := nftables.MustConcatSetType(nftables.TypeIPAddr, nftables.TypeInetProto, nftables.TypeInetService)
+		Expect(set.KeyType.GetNFTMagic()).To(Equal(keyType.GetNFTMagic()))
+	})
+
+	Describe("with a programmed set", func() {
+		BeforeEach(func() {
+			s.AddOrReplaceIPSet(ipsets.IPSetMetadata{SetID: "s:abcd", Type: ipsets.IPSetTypeHashIP},
+				[]string{"10.0.0.1", "10.0.0.2"})
+			nft.Apply()
+		})
+
+		It("should apply deltas", func() {
+			s.AddMembers("s:abcd", []string{"10.0.0.3"})
+			s.RemoveMembers("s:abcd", []string{"10.0.0.1"})
+			nft.Apply()
+			Expect(dataplane.LastTransaction()).To(Equal([]string{
+				"add table calico",
+				"delete elements cali40s_abcd { 0a000001 }",
+				"add elements cali40s_abcd { 0a000003 }",
+			}))
+			members, err := s.GetMembers("s:abcd")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(members).To(Equal(set.From("10.0.0.2", "10.0.0.3")))
+		})
+
+		It("should resync and fix up the set and remove unknown sets", func() {
+			sets := dataplane.Tables[nftables.TableFamilyIPv4].Sets
+			sets["cali40s_abcd"].Elements = []nftables.SetElement{
+				{Key: []byte{10, 0, 0, 1}}, {Key: []byte{10, 0, 0, 9}},
+			}
+			sets["cali40s_old"] = &mockSet{Set: &nftables.Set{
+				Table: ourTable(4), Name: "cali40s_old", KeyType: nftables.TypeIPAddr,
+			}}
+			s.QueueResync()
+			nft.Apply()
+			Expect(dataplane.LastTransaction()).To(Equal([]string{
+				"add table calico",
+				"delete set cali40s_old",
+				"delete elements cali40s_abcd { 0a000009 }",
+				"add elements cali40s_abcd { 0a000002 }",
+			}))
+			Expect(dataplane.Tables[nftables.TableFamilyIPv4].Sets).NotTo(HaveKey("cali40s_old"))
+		})
+
+		It("should refill a set whose contents it can't parse", func() {
+			dataplane.Tables[nftables.TableFamilyIPv4].Sets["cali40s_abcd"].Elements = []nftables.SetElement{
+				{Key: []byte{10, 0, 0}},
+			}
+			s.QueueResync()
+			nft.Apply()
+			Expect(dataplane.LastTransaction()).To(Equal([]string{
+				"add table calico",
+				"flush set cali40s_abcd",
+				"add elements cali40s_abcd { 0a000001, 0a000002 }",
+			}))
+		})
+
+		It("should recreate a set of the wrong type along with the rules that use it", func() {
+			nft.Filter.InsertOrAppendRules("FORWARD", []iptables.Rule{{
+				Match:  iptables.Match().SourceIPSet("cali40s:abcd"),
+				Action: iptables.DropAction{},
+			}})
+			nft.Apply()
+			dataplane.Tables[nftables.TableFamilyIPv4].Sets["cali40s_abcd"].Set.KeyType =
+				nftables.MustConcatSetType(nftables.TypeIPAddr, nftables.TypeInetProto, nftables.TypeInetService)
+			s.QueueResync()
+			nft.Apply()
+			tx := dataplane.LastTransaction()
+			Expect(tx).To(HaveLen(8))
+			Expect(tx[:7]).To(Equal([]string{
+				"add table calico",
+				"flush chain filter-FORWARD",
+				"delete chain filter-FORWARD",
+				"add chain filter-FORWARD { type filter hook 2 priority -1 }",
+				"delete set cali40s_abcd",
+				"add set cali40s_abcd",
+				"add elements cali40s_abcd { 0a000001, 0a000002 }",
+			}))
+			Expect(tx[7]).To(HavePrefix("add rule filter-FORWARD"))
+		})
+
+		It("should delete a removed set in the same transaction as the rules that use it", func() {
+			nft.Filter.InsertOrAppendRules("FORWARD", []iptables.Rule{{
+				Match:  iptables.Match().SourceIPSet("cali40s:abcd"),
+				Action: iptables.DropAction{},
+			}})
+			nft.Apply()
+			Expect(dataplane.Transactions).To(HaveLen(2))
+
+			nft.Filter.InsertOrAppendRules("FORWARD", nil)
+			s.RemoveIPSet("s:abcd")
+			nft.Apply()
+			Expect(dataplane.Transactions).To(HaveLen(3))
+			Expect(dataplane.LastTransaction()).To(Equal([]string{
+				"add table calico",
+				"flush chain filter-FORWARD",
+				"delete set cali40s_abcd",
+				"delete chain filter-FORWARD",
+			}))
+		})
+
+		It("should resync and retry after a failure", func() {
+			dataplane.FailNextFlush = true
+			dataplane.Tables = map[nftables.TableFamily]*mockTable{}
+			s.AddMembers("s:abcd", []string{"10.0.0.3"})
+			nft.Apply()
+			Expect(dataplane.CumulativeSleep).NotTo(BeZero())
+			// The resync found no table so the set gets recreated.
+			Expect(dataplane.LastTransaction()).To(Equal([]string{
+				"add table calico",
+				"add set cali40s_abcd",
+				"add elements cali40s_abcd { 0a000001, 0a000002, 0a000003 }",
+			}))
+		})
+	})
+
+	It("should write large sets in several messages", func() {
+		var members []string
+		for i := 0; i < maxElementsPerMessage+10; i++ {
+			members = append(members, ip.FromNetIP([]byte{10, 0, byte(i >> 8), byte(i)}).String())
+		}
+		s.AddOrReplaceIPSet(ipsets.IPSetMetadata{SetID: "s:abcd", Type: ipsets.IPSetTypeHashIP}, members)
+		nft.Apply()
+		Expect(dataplane.LastTransaction()).To(HaveLen(4))
+		Expect(dataplane.Tables[nftables.TableFamilyIPv4].Sets["cali40s_abcd"].Elements).To(
+			HaveLen(maxElementsPerMessage + 10))
+	})
+
+	DescribeTable("element conversion round trips",
+		func(ipVersion int, t ipsets.IPSetType, members []string, expected []nftables.SetElement) {
+			family := ipsets.IPFamilyV4
+			if ipVersion == 6 {
+				family = ipsets.IPFamilyV6
+			}
+			s := newIPSets(ipsets.NewIPVersionConfig(family, "cali", nil, nil))
+			s.AddOrReplaceIPSet(ipsets.IPSetMetadata{SetID: "s:abcd", Type: t}, members)
+			nftSet := s.setIDToIPSet["s:abcd"]
+			desired := s.desiredMembers(nftSet)
+			elements := s.elements(t, desired)
+			Expect(elements).To(Equal(expected))
+			parsed, err := s.membersFromElements(t, elements)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed).To(Equal(desired))
+		},
+		Entry("hash:ip", 4, ipsets.IPSetTypeHashIP, []string{"10.0.0.1"}, []nftables.SetElement{
+			{Key: []byte{10, 0, 0, 1}},
+		}),
+		Entry("adjacent CIDRs", 4, ipsets.IPSetTypeHashNet, []string{"10.0.0.0/24", "10.0.1.0/24"}, []nftables.SetElement{
+			{Key: []byte{10, 0, 0, 0}},
+			{Key: []byte{10, 0, 1, 0}, IntervalEnd: true},
+			{Key: []byte{10, 0, 1, 0}},
+			{Key: []byte{10, 0, 2, 0}, IntervalEnd: true},
+		}),
+		Entry("all addresses", 4, ipsets.IPSetTypeHashNet, []string{"0.0.0.0/0"}, []nftables.SetElement{
+			{Key: []byte{0, 0, 0, 0}},
+		}),
+		Entry("last address", 4, ipsets.IPSetTypeHashNet, []string{"255.255.255.255/32"}, []nftables.SetElement{
+			{Key: []byte{255, 255, 255, 255}},
+		}),
+		Entry("IPv6 CIDR", 6, ipsets.IPSetTypeHashNet, []string{"fd00::/64"}, []nftables.SetElement{
+			{Key: []byte{0xfd, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}},
+			{Key: []byte{0xfd, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0}, IntervalEnd: true},
+		}),
+		Entry("IP,port", 4, ipsets.IPSetTypeHashIPPort, []string{"10.0.0.1,udp:53"}, []nftables.SetElement{
+			{Key: []byte{10, 0, 0, 1, 17, 0, 0, 0, 0, 53, 0, 0}},
+		}),
+	)
+
+	It("should reject intervals that aren't CIDRs", func() {
+		_, err := s.membersFromElements(ipsets.IPSetTypeHashNet, []nftables.SetElement{
+			{Key: []byte{10, 0, 0, 1}},
+			{Key: []byte{10, 0, 0, 4}, IntervalEnd: true},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should remove tables", func() {
+		dataplane.Tables[nftables.TableFamilyIPv6] = &mockTable{
+			Chains: map[string]*mockChain{},
+			Sets:   map[string]*mockSet{},
+		}
+		removeTables(dataplane.newConn, 4, 6)
+		Expect(dataplane.LastTransaction()).To(Equal([]string{"delete table calico"}))
+		Expect(dataplane.Tables).NotTo(HaveKey(nftables.TableFamilyIPv6))
+	})
+})
@@ -0,0 +1,333 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nftables
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/expr"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+// TableName is the name of the nftables table, in both the ip and ip6 families, that holds all of
+// Felix's chains and sets.
+const TableName = "calico"
+
+// udataTypeComment is the type of the user data TLV that holds a rule's comment; it is the type
+// that nft uses, so that "nft list" shows our comments.
+const udataTypeComment = 0
+
+var (
+	countNumNFTCalls = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "felix_nft_calls",
+		Help: "Number of nftables transactions executed.",
+	})
+	countNumNFTErrors = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "felix_nft_errors",
+		Help: "Number of nftables transactions that failed.",
+	})
+	countNumNFTListCalls = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "felix_nft_list_calls",
+		Help: "Number of times that the nftables state was read.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(countNumNFTCalls)
+	prometheus.MustRegister(countNumNFTErrors)
+	prometheus.MustRegister(countNumNFTListCalls)
+}
+
+// conn is the subset of the methods of nftables.Conn that we use.  Changes are buffered until
+// Flush(), which sends them to the kernel as a single netlink batch; the kernel applies the
+// batch as one transaction, so either all of it is applied or none of it is.  The reads are not
+// buffered.
+type conn interface {
+	AddTable(t *nftables.Table) *nftables.Table
+	DelTable(t *nftables.Table)
+	AddChain(c *nftables.Chain) *nftables.Chain
+	DelChain(c *nftables.Chain)
+	FlushChain(c *nftables.Chain)
+	AddRule(r *nftables.Rule) *nftables.Rule
+	AddSet(s *nftables.Set, vals []nftables.SetElement) error
+	DelSet(s *nftables.Set)
+	FlushSet(s *nftables.Set)
+	SetAddElements(s *nftables.Set, vals []nftables.SetElement) error
+	SetDeleteElements(s *nftables.Set, vals []nftables.SetElement) error
+	Flush() error
+
+	ListTablesOfFamily(family nftables.TableFamily) ([]*nftables.Table, error)
+	ListChainsOfTableFamily(family nftables.TableFamily) ([]*nftables.Chain, error)
+	GetRules(t *nftables.Table, c *nftables.Chain) ([]*nftables.Rule, error)
+	GetSets(t *nftables.Table) ([]*nftables.Set, error)
+	GetSetElements(s *nftables.Set) ([]nftables.SetElement, error)
+}
+
+// connFactory returns a new conn.  A conn remembers the first error that it hit while
+// buffering changes so each transaction uses a new one.
+type connFactory func() (conn, error)
+
+func newRealConn() (conn, error) {
+	return nftables.New()
+}
+
+// Family returns the nftables address family that holds rules for the given IP version.
+func Family(ipVersion uint8) nftables.TableFamily {
+	if ipVersion == 6 {
+		return nftables.TableFamilyIPv6
+	}
+	return nftables.TableFamilyIPv4
+}
+
+// familyName returns the name that nft uses for the family of the given IP version.
+func familyName(ipVersion uint8) string {
+	if ipVersion == 6 {
+		return "ip6"
+	}
+	return "ip"
+}
+
+// ourTable returns our table in the family of the given IP version.
+func ourTable(ipVersion uint8) *nftables.Table {
+	return &nftables.Table{Name: TableName, Family: Family(ipVersion)}
+}
+
+// transaction wraps the conn that buffers the changes of a single transaction.  The kernel
+// finds the sets that a transaction creates by their IDs, which must be unique within the
+// transaction, so we allocate them here rather than leaving it to the nftables package, which
+// uses a global counter without locking.
+type transaction struct {
+	conn
+	nextSetID uint32
+}
+
+func newTransaction(newConn connFactory) (*transaction, error) {
+	c, err := newConn()
+	if err != nil {
+		return nil, err
+	}
+	return &transaction{conn: c}, nil
+}
+
+// addSet adds the given set with the given elements, in batches of at most
+// maxElementsPerMessage elements.
+func (tx *transaction) addSet(s *nftables.Set, elements []nftables.SetElement) error {
+	tx.nextSetID++
+	s.ID = tx.nextSetID
+	first := elements
+	if len(first) > maxElementsPerMessage && !s.Anonymous {
+		first = first[:maxElementsPerMessage]
+	}
+	if err := tx.AddSet(s, first); err != nil {
+		return err
+	}
+	return tx.addElements(s, elements[len(first):])
+}
+
+func (tx *transaction) addElements(s *nftables.Set, elements []nftables.SetElement) error {
+	for len(elements) > 0 {
+		n := len(elements)
+		if n > maxElementsPerMessage {
+			n = maxElementsPerMessage
+		}
+		if err := tx.SetAddElements(s, elements[:n]); err != nil {
+			return err
+		}
+		elements = elements[n:]
+	}
+	return nil
+}
+
+func (tx *transaction) deleteElements(s *nftables.Set, elements []nftables.SetElement) error {
+	for len(elements) > 0 {
+		n := len(elements)
+		if n > maxElementsPerMessage {
+			n = maxElementsPerMessage
+		}
+		if err := tx.SetDeleteElements(s, elements[:n]); err != nil {
+			return err
+		}
+		elements = elements[n:]
+	}
+	return nil
+}
+
+// commit sends the transaction to the kernel.
+func (tx *transaction) commit() error {
+	countNumNFTCalls.Inc()
+	if err := tx.Flush(); err != nil {
+		countNumNFTErrors.Inc()
+		return fmt.Errorf("nftables transaction failed: %w", err)
+	}
+	return nil
+}
+
+// tableState is our parsed copy of the contents of the nftables table, as read from the kernel.
+type tableState struct {
+	// chains maps from nftables chain name to the rules in that chain, in order.
+	chains map[string][]ruleState
+	// sets maps from nftables set name to the set.
+	sets map[string]*setState
+}
+
+type ruleState struct {
+	comment string
+	packets uint64
+	bytes   uint64
+}
+
+type setState struct {
+	set *nftables.Set
+	// elements are the elements of the set, as reported by the kernel.
+	elements []nftables.SetElement
+}
+
+// listTable reads the contents of our table in the family of the given IP version.  Only the
+// rules of chains whose names start with chainPrefix are read.  The sets, and their elements,
+// are only read if listSets is true.  If the table doesn't exist, an empty tableState is
+// returned.
+func listTable(c conn, ipVersion uint8, chainPrefix string, listSets bool) (*tableState, error) {
+	countNumNFTListCalls.Inc()
+	state := &tableState{chains: map[string][]ruleState{}, sets: map[string]*setState{}}
+	family := Family(ipVersion)
+	table := ourTable(ipVersion)
+
+	tables, err := c.ListTablesOfFamily(family)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nftables tables: %w", err)
+	}
+	found := false
+	for _, t := range tables {
+		if t.Name == TableName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return state, nil
+	}
+
+	chains, err := c.ListChainsOfTableFamily(family)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nftables chains: %w", err)
+	}
+	for _, chain := range chains {
+		if chain.Table == nil || chain.Table.Name != TableName || !strings.HasPrefix(chain.Name, chainPrefix) {
+			continue
+		}
+		rules, err := c.GetRules(table, chain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list rules of nftables chain %s: %w", chain.Name, err)
+		}
+		ruleStates := make([]ruleState, 0, len(rules))
+		for _, r := range rules {
+			rs := ruleState{comment: decodeComment(r.UserData)}
+			for _, e := range r.Exprs {
+				if counter, ok := e.(*expr.Counter); ok {
+					rs.packets = counter.Packets
+					rs.bytes = counter.Bytes
+				}
+			}
+			ruleStates = append(ruleStates, rs)
+		}
+		state.chains[chain.Name] = ruleStates
+	}
+
+	if !listSets {
+		return state, nil
+	}
+	sets, err := c.GetSets(table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list nftables sets: %w", err)
+	}
+	for _, s := range sets {
+		if s.Anonymous {
+			// Belongs to a rule.
+			continue
+		}
+		s.Table = table
+		elements, err := c.GetSetElements(s)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list elements of nftables set %s: %w", s.Name, err)
+		}
+		state.sets[s.Name] = &setState{set: s, elements: elements}
+	}
+	return state, nil
+}
+
+// encodeComment encodes the given comment as rule user data, in the same way as nft.
+func encodeComment(comment string) []byte {
+	udata := []byte{udataTypeComment, byte(len(comment) + 1)}
+	udata = append(udata, comment...)
+	return append(udata, 0)
+}
+
+// decodeComment extracts the comment from the given rule user data, which may also contain
+// other TLVs written by nft.
+func decodeComment(udata []byte) string {
+	for len(udata) >= 2 {
+		typ, length := udata[0], int(udata[1])
+		if len(udata) < 2+length {
+			break
+		}
+		value := udata[2 : 2+length]
+		if typ == udataTypeComment {
+			return strings.TrimRight(string(value), "\x00")
+		}
+		udata = udata[2+length:]
+	}
+	return ""
+}
+
+// RemoveTables deletes Felix's nftables tables, if present.  It is used to clean up when the
+// native nftables dataplane is disabled.  Errors are logged and ignored.
+func RemoveTables(ipVersions ...uint8) {
+	removeTables(newRealConn, ipVersions...)
+}
+
+func removeTables(newConn connFactory, ipVersions ...uint8) {
+	tx, err := newTransaction(newConn)
+	if err != nil {
+		log.WithError(err).Info("Failed to connect to nftables, ignoring.")
+		return
+	}
+	var removed []string
+	for _, v := range ipVersions {
+		logCxt := log.WithFields(log.Fields{"family": familyName(v), "table": TableName})
+		tables, err := tx.ListTablesOfFamily(Family(v))
+		if err != nil {
+			logCxt.WithError(err).Info("Failed to list nftables tables, ignoring.")
+			continue
+		}
+		for _, t := range tables {
+			if t.Name == TableName {
+				tx.DelTable(ourTable(v))
+				removed = append(removed, familyName(v))
+			}
+		}
+	}
+	if len(removed) == 0 {
+		log.Debug("No nftables tables to clean up.")
+		return
+	}
+	if err := tx.commit(); err != nil {
+		log.WithError(err).Info("Failed to remove nftables tables, ignoring.")
+		return
+	}
+	log.WithField("families", removed).Info("Removed nftables tables.")
+}
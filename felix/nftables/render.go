@@ -0,0 +1,926 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nftables
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	"golang.org/x/sys/unix"
+
+	"github.com/projectcalico/calico/felix/iptables"
+)
+
+const (
+	// maxCommentLen is the longest comment that nft accepts on a rule.
+	maxCommentLen = 128
+	// commentSeparator separates the comments of a rule, which nftables only allows one of.  The
+	// ';' character never appears in an escaped comment.
+	commentSeparator = "; "
+
+	// reg is the register that we load single values into.
+	reg = unix.NFT_REG_1
+	// reg2 is the register that we load the second value that a statement needs into.
+	reg2 = unix.NFT_REG_2
+	// concatReg is the first of the 4-byte registers that we load the parts of a concatenation
+	// into.  Each part is padded to a multiple of 4 bytes.
+	concatReg = unix.NFT_REG32_00
+
+	// The conntrack status bits that mark NATted connections.
+	ctStatusSrcNAT = 0x10
+	ctStatusDstNAT = 0x20
+)
+
+var (
+	commentUnsafe = regexp.MustCompile(`[^\w @%+=:,./-]`)
+
+	// vxlanVNIRegexp matches the u32 expression that MatchCriteria.VXLANVNI() renders, capturing
+	// the VNI.
+	vxlanVNIRegexp = regexp.MustCompile(`^0>>22&0x3C@12>>8=0x([0-9a-fA-F]+)$`)
+
+	// protocolNumbers maps the protocol names that Felix uses to their numbers, so that we don't
+	// depend on the contents of /etc/protocols.
+	protocolNumbers = map[string]uint8{
+		"icmp":      1,
+		"ipip":      4,
+		"ipencap":   4,
+		"tcp":       6,
+		"udp":       17,
+		"esp":       50,
+		"icmpv6":    58,
+		"ipv6-icmp": 58,
+		"sctp":      132,
+		"udplite":   136,
+	}
+
+	ctStateBits = map[string]uint32{
+		"invalid":     expr.CtStateBitINVALID,
+		"established": expr.CtStateBitESTABLISHED,
+		"related":     expr.CtStateBitRELATED,
+		"new":         expr.CtStateBitNEW,
+		"untracked":   expr.CtStateBitUNTRACKED,
+	}
+
+	ctStatusBits = map[string]uint32{
+		"snat": ctStatusSrcNAT,
+		"dnat": ctStatusDstNAT,
+	}
+
+	addrTypes = map[string]uint32{
+		"unspec":      unix.RTN_UNSPEC,
+		"unicast":     unix.RTN_UNICAST,
+		"local":       unix.RTN_LOCAL,
+		"broadcast":   unix.RTN_BROADCAST,
+		"anycast":     unix.RTN_ANYCAST,
+		"multicast":   unix.RTN_MULTICAST,
+		"blackhole":   unix.RTN_BLACKHOLE,
+		"unreachable": unix.RTN_UNREACHABLE,
+		"prohibit":    unix.RTN_PROHIBIT,
+	}
+
+	limitUnits = map[string]expr.LimitTime{
+		"s": expr.LimitTimeSecond,
+		"m": expr.LimitTimeMinute,
+		"h": expr.LimitTimeHour,
+		"d": expr.LimitTimeDay,
+	}
+)
+
+// ChainName returns the name of the nftables chain that holds the given chain of the given
+// iptables table.  All of Felix's chains live in a single nftables table so the name of the
+// iptables table is used as a prefix to keep them apart.
+func ChainName(table, chain string) string {
+	return table + "-" + chain
+}
+
+// SetName converts the name of an IP set, as used in the iptables rules that the rules package
+// renders, to the name of the equivalent nftables set.  nftables doesn't allow ':' in names.
+func SetName(ipSetName string) string {
+	return strings.Replace(ipSetName, ":", "_", -1)
+}
+
+// renderedRule is an iptables rule translated into nftables expressions.
+type renderedRule struct {
+	exprs []expr.Any
+	// anonSets are the anonymous sets that the expressions look up.  They have to be added in
+	// the same transaction as, and before, the rule.
+	anonSets []*anonSet
+	userData []byte
+}
+
+// anonSet is an anonymous set that belongs to a rule, such as the set of ports in a multi-port
+// match.
+type anonSet struct {
+	set      *nftables.Set
+	elements []nftables.SetElement
+	// lookup is the expression that looks up the set.  The kernel finds anonymous sets by their
+	// ID, which is only allocated when the set is added to a transaction.
+	lookup *expr.Lookup
+}
+
+// ruleRenderer converts iptables.Rules into the equivalent nftables expressions.
+type ruleRenderer struct {
+	table     string
+	ipVersion uint8
+}
+
+// RenderRule translates the given iptables rule.  The hash is written as the first part of the
+// rule's comment so that the rule can be recognised when we read it back.
+func (r ruleRenderer) RenderRule(rule iptables.Rule, hashComment string, features *iptables.Features) (*renderedRule, error) {
+	b := &exprBuilder{ipVersion: r.ipVersion}
+	if err := r.renderMatch(b, rule.Match); err != nil {
+		return nil, err
+	}
+	b.add(&expr.Counter{})
+	if rule.Action != nil {
+		if err := r.renderAction(b, rule.Action, features); err != nil {
+			return nil, err
+		}
+	}
+	return &renderedRule{
+		exprs:    b.exprs,
+		anonSets: b.anonSets,
+		userData: encodeComment(renderComment(hashComment, rule.Comment)),
+	}, nil
+}
+
+// dropAllRule returns the rule that we write in place of the rules of a chain that we failed to
+// render.
+func dropAllRule() *renderedRule {
+	return &renderedRule{
+		exprs:    []expr.Any{&expr.Counter{}, &expr.Verdict{Kind: expr.VerdictDrop}},
+		userData: encodeComment("render-failed"),
+	}
+}
+
+// renderComment combines the hash comment and the rule's comments into the single comment that
+// nftables allows.  Long comments are truncated, so nothing but the hash, which always fits,
+// should be read back from the comment; in particular, the rule counter comments are found
+// from the hash (see Table.ReadCommentCounters()).
+func renderComment(hashComment string, comments []string) string {
+	parts := []string{hashComment}
+	for _, c := range comments {
+		parts = append(parts, commentUnsafe.ReplaceAllString(c, "_"))
+	}
+	s := strings.Join(parts, commentSeparator)
+	if len(s) > maxCommentLen {
+		s = s[:maxCommentLen]
+	}
+	return s
+}
+
+// exprBuilder accumulates the expressions of a rule.
+type exprBuilder struct {
+	ipVersion uint8
+	exprs     []expr.Any
+	anonSets  []*anonSet
+}
+
+func (b *exprBuilder) add(exprs ...expr.Any) {
+	b.exprs = append(b.exprs, exprs...)
+}
+
+// addCmp loads a value using the given expression and compares it with data.
+func (b *exprBuilder) addCmp(load expr.Any, negate bool, data []byte) {
+	b.add(load, &expr.Cmp{Op: cmpOp(negate), Register: reg, Data: data})
+}
+
+// addLookup adds an anonymous set with the given elements and looks up the value in register
+// reg in it.
+func (b *exprBuilder) addLookup(keyType nftables.SetDatatype, interval, negate bool, elements []nftables.SetElement) {
+	lookup := &expr.Lookup{SourceRegister: reg, Invert: negate}
+	b.anonSets = append(b.anonSets, &anonSet{
+		set: &nftables.Set{
+			Name:      "__set%d",
+			Anonymous: true,
+			Constant:  true,
+			Interval:  interval,
+			KeyType:   keyType,
+		},
+		elements: elements,
+		lookup:   lookup,
+	})
+	b.add(lookup)
+}
+
+func cmpOp(negate bool) expr.CmpOp {
+	if negate {
+		return expr.CmpOpNeq
+	}
+	return expr.CmpOpEq
+}
+
+func (b *exprBuilder) loadAddr(field string, register uint32) *expr.Payload {
+	offset, length := uint32(12), uint32(4)
+	if b.ipVersion == 6 {
+		offset, length = 8, 16
+	}
+	if field == "daddr" {
+		offset += length
+	}
+	return &expr.Payload{
+		DestRegister: register,
+		Base:         expr.PayloadBaseNetworkHeader,
+		Offset:       offset,
+		Len:          length,
+	}
+}
+
+func loadPort(field string, register uint32) *expr.Payload {
+	offset := uint32(0)
+	if field == "dport" {
+		offset = 2
+	}
+	return &expr.Payload{
+		DestRegister: register,
+		Base:         expr.PayloadBaseTransportHeader,
+		Offset:       offset,
+		Len:          2,
+	}
+}
+
+// renderMatch translates the iptables match fragments that iptables.MatchCriteria renders into
+// nftables expressions.  It walks the tokens in the same way that iptables parses them so that
+// the expressions are emitted in the same order as the matches.
+func (r ruleRenderer) renderMatch(b *exprBuilder, m iptables.MatchCriteria) error {
+	tokens, err := tokenize(m.Render())
+	if err != nil {
+		return err
+	}
+	var (
+		negate bool
+		module string
+	)
+	arg := func(i int) (string, error) {
+		if i+1 >= len(tokens) {
+			return "", fmt.Errorf("missing argument to %s in %q", tokens[i], m.Render())
+		}
+		return tokens[i+1], nil
+	}
+	// rpfilter's flags are spread over several tokens; collect them and emit the expression when
+	// the module's options end.
+	rpf := struct{ seen, invert, validMark, acceptLocal bool }{}
+	flushRPF := func() error {
+		if !rpf.seen {
+			return nil
+		}
+		if rpf.acceptLocal {
+			return fmt.Errorf("rpfilter --accept-local is not supported by nftables")
+		}
+		// fib saddr [. mark] . iif oif exists|missing
+		result := byte(1)
+		if rpf.invert {
+			result = 0
+		}
+		b.add(
+			&expr.Fib{Register: reg, FlagSADDR: true, FlagMARK: rpf.validMark, FlagIIF: true, FlagPRESENT: true, ResultOIF: true},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: reg, Data: []byte{result}},
+		)
+		rpf.seen, rpf.invert, rpf.validMark, rpf.acceptLocal = false, false, false, false
+		return nil
+	}
+
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+		switch tok {
+		case "!":
+			negate = true
+			continue
+		case "-m", "--match":
+			if err := flushRPF(); err != nil {
+				return err
+			}
+			v, err := arg(i)
+			if err != nil {
+				return err
+			}
+			module = v
+			if module == "rpfilter" {
+				rpf.seen = true
+			}
+			i++
+			continue
+		case "-p", "--protocol":
+			v, err := arg(i)
+			if err != nil {
+				return err
+			}
+			proto, err := protocolNumber(v)
+			if err != nil {
+				return err
+			}
+			b.addCmp(&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: reg}, negate, []byte{proto})
+			i++
+		case "-s", "--source", "-d", "--destination":
+			v, err := arg(i)
+			if err != nil {
+				return err
+			}
+			field := "saddr"
+			if tok == "-d" || tok == "--destination" {
+				field = "daddr"
+			}
+			ipNet, err := r.parseNet(v)
+			if err != nil {
+				return err
+			}
+			b.add(b.loadAddr(field, reg))
+			if ones, bits := ipNet.Mask.Size(); ones < bits {
+				b.add(&expr.Bitwise{
+					SourceRegister: reg,
+					DestRegister:   reg,
+					Len:            uint32(len(ipNet.IP)),
+					Mask:           ipNet.Mask,
+					Xor:            make([]byte, len(ipNet.IP)),
+				})
+			}
+			b.add(&expr.Cmp{Op: cmpOp(negate), Register: reg, Data: ipNet.IP})
+			i++
+		case "-i", "--in-interface", "-o", "--out-interface":
+			v, err := arg(i)
+			if err != nil {
+				return err
+			}
+			key := expr.MetaKeyIIFNAME
+			if tok == "-o" || tok == "--out-interface" {
+				key = expr.MetaKeyOIFNAME
+			}
+			name, err := ifaceName(v)
+			if err != nil {
+				return err
+			}
+			if len(name) == 0 {
+				// "+" matches every interface.
+				if negate {
+					return fmt.Errorf("negated match on all interfaces in %q", m.Render())
+				}
+				i++
+				break
+			}
+			b.addCmp(&expr.Meta{Key: key, Register: reg}, negate, name)
+			i++
+		case "--mark":
+			v, err := arg(i)
+			if err != nil {
+				return err
+			}
+			parts := strings.SplitN(v, "/", 2)
+			value, err := parseUint32(parts[0])
+			if err != nil {
+				return err
+			}
+			b.add(&expr.Meta{Key: expr.MetaKeyMARK, Register: reg})
+			if len(parts) == 2 {
+				mask, err := parseUint32(parts[1])
+				if err != nil {
+					return err
+				}
+				b.add(bitwise(mask, 0))
+			}
+			b.add(&expr.Cmp{Op: cmpOp(negate), Register: reg, Data: binaryutil.NativeEndian.PutUint32(value)})
+			i++
+		case "--validmark":
+			rpf.validMark = true
+		case "--invert":
+			rpf.invert = true
+		case "--accept-local":
+			rpf.acceptLocal = true
+		case "--ipvs":
+			return fmt.Errorf("the ipvs match is not supported by nftables")
+		case "--src-type", "--dst-type":
+			v, err := arg(i)
+			if err != nil {
+				return err
+			}
+			i++
+			addrType, ok := addrTypes[strings.ToLower(v)]
+			if !ok {
+				return fmt.Errorf("unknown address type %q", v)
+			}
+			fib := &expr.Fib{Register: reg, ResultADDRTYPE: true}
+			if tok == "--src-type" {
+				fib.FlagSADDR = true
+			} else {
+				fib.FlagDADDR = true
+			}
+			if i+1 < len(tokens) && tokens[i+1] == "--limit-iface-out" {
+				fib.FlagOIF = true
+				i++
+			}
+			b.addCmp(fib, negate, binaryutil.NativeEndian.PutUint32(addrType))
+		case "--ctstate":
+			v, err := arg(i)
+			if err != nil {
+				return err
+			}
+			if err := b.addCtState(strings.Split(strings.ToLower(v), ","), negate); err != nil {
+				return err
+			}
+			i++
+		case "--match-set":
+			if i+2 >= len(tokens) {
+				return fmt.Errorf("missing arguments to --match-set in %q", m.Render())
+			}
+			name, dirs := tokens[i+1], tokens[i+2]
+			lookup := &expr.Lookup{SourceRegister: reg, SetName: SetName(name), Invert: negate}
+			switch dirs {
+			case "src", "dst":
+				field := "saddr"
+				if dirs == "dst" {
+					field = "daddr"
+				}
+				b.add(b.loadAddr(field, reg))
+			case "src,src", "dst,dst":
+				// addr . meta l4proto . th sport|dport, each part padded to a multiple of 4
+				// bytes.
+				field, portField := "saddr", "sport"
+				if dirs == "dst,dst" {
+					field, portField = "daddr", "dport"
+				}
+				addr := b.loadAddr(field, concatReg)
+				protoReg := concatReg + addr.Len/4
+				b.add(
+					addr,
+					&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: protoReg},
+					loadPort(portField, protoReg+1),
+				)
+				lookup.SourceRegister = concatReg
+			default:
+				return fmt.Errorf("unsupported IP set match direction %q", dirs)
+			}
+			b.add(lookup)
+			i += 2
+		case "--source-ports", "--sports", "--sport", "--destination-ports", "--dports", "--dport":
+			v, err := arg(i)
+			if err != nil {
+				return err
+			}
+			field := "sport"
+			if strings.HasPrefix(tok, "--d") {
+				field = "dport"
+			}
+			ranges, err := parsePortRanges(v)
+			if err != nil {
+				return err
+			}
+			b.add(loadPort(field, reg))
+			switch {
+			case len(ranges) == 1 && ranges[0].first == ranges[0].last:
+				b.add(&expr.Cmp{Op: cmpOp(negate), Register: reg, Data: binaryutil.BigEndian.PutUint16(ranges[0].first)})
+			case len(ranges) == 1:
+				b.add(&expr.Range{
+					Op:       cmpOp(negate),
+					Register: reg,
+					FromData: binaryutil.BigEndian.PutUint16(ranges[0].first),
+					ToData:   binaryutil.BigEndian.PutUint16(ranges[0].last),
+				})
+			default:
+				b.addLookup(nftables.TypeInetService, true, negate, portRangeElements(ranges))
+			}
+			i++
+		case "--icmp-type", "--icmpv6-type":
+			v, err := arg(i)
+			if err != nil {
+				return err
+			}
+			// The type and code are the first two bytes of the ICMP header, so a type and code
+			// can be matched with a single comparison.
+			var data []byte
+			for _, part := range strings.SplitN(v, "/", 2) {
+				n, err := strconv.ParseUint(part, 10, 8)
+				if err != nil {
+					return fmt.Errorf("invalid ICMP type/code %q: %w", v, err)
+				}
+				data = append(data, byte(n))
+			}
+			b.addCmp(&expr.Payload{
+				DestRegister: reg,
+				Base:         expr.PayloadBaseTransportHeader,
+				Offset:       0,
+				Len:          uint32(len(data)),
+			}, negate, data)
+			i++
+		case "--limit":
+			v, err := arg(i)
+			if err != nil {
+				return err
+			}
+			i++
+			limit, err := parseLimit(v)
+			if err != nil {
+				return err
+			}
+			limit.Over = negate
+			if i+2 < len(tokens) && tokens[i+1] == "--limit-burst" {
+				burst, err := strconv.ParseUint(tokens[i+2], 10, 32)
+				if err != nil {
+					return fmt.Errorf("invalid limit burst %q: %w", tokens[i+2], err)
+				}
+				limit.Burst = uint32(burst)
+				i += 2
+			}
+			b.add(limit)
+		case "--u32":
+			v, err := arg(i)
+			if err != nil {
+				return err
+			}
+			captures := vxlanVNIRegexp.FindStringSubmatch(v)
+			if captures == nil {
+				return fmt.Errorf("unsupported u32 expression %q", v)
+			}
+			vni, err := strconv.ParseUint(captures[1], 16, 24)
+			if err != nil {
+				return fmt.Errorf("invalid VXLAN VNI in %q: %w", v, err)
+			}
+			// The VNI is the 3 bytes at offset 12 from the start of the UDP header.
+			b.addCmp(&expr.Payload{
+				DestRegister: reg,
+				Base:         expr.PayloadBaseTransportHeader,
+				Offset:       12,
+				Len:          3,
+			}, negate, binaryutil.BigEndian.PutUint32(uint32(vni))[1:])
+			i++
+		default:
+			return fmt.Errorf("unsupported match %q (module %q) in %q", tok, module, m.Render())
+		}
+		negate = false
+	}
+	if err := flushRPF(); err != nil {
+		return err
+	}
+	if negate {
+		return fmt.Errorf("dangling negation in %q", m.Render())
+	}
+	return nil
+}
+
+// addCtState matches the conntrack states in the given list, any of which may match.  iptables
+// treats the SNAT and DNAT states, which are part of the conntrack status in nftables, as
+// states; we only support them on their own.
+func (b *exprBuilder) addCtState(states []string, negate bool) error {
+	var stateBits, statusBits uint32
+	for _, s := range states {
+		if bit, ok := ctStateBits[s]; ok {
+			stateBits |= bit
+		} else if bit, ok := ctStatusBits[s]; ok {
+			statusBits |= bit
+		} else {
+			return fmt.Errorf("unknown conntrack state %q", s)
+		}
+	}
+	if stateBits != 0 && statusBits != 0 {
+		return fmt.Errorf("can't mix conntrack states and NAT states in %v", states)
+	}
+	key, bits := expr.CtKeySTATE, stateBits
+	if statusBits != 0 {
+		key, bits = expr.CtKeySTATUS, statusBits
+	}
+	// ct state & bits != 0, or == 0 if negated.
+	b.add(
+		&expr.Ct{Register: reg, Key: key},
+		bitwise(bits, 0),
+		&expr.Cmp{Op: cmpOp(!negate), Register: reg, Data: binaryutil.NativeEndian.PutUint32(0)},
+	)
+	return nil
+}
+
+// renderAction translates an iptables action into nftables statements.
+func (r ruleRenderer) renderAction(b *exprBuilder, action iptables.Action, features *iptables.Features) error {
+	switch a := action.(type) {
+	case iptables.JumpAction:
+		b.add(&expr.Verdict{Kind: expr.VerdictJump, Chain: ChainName(r.table, a.Target)})
+	case iptables.GotoAction:
+		b.add(&expr.Verdict{Kind: expr.VerdictGoto, Chain: ChainName(r.table, a.Target)})
+	case iptables.ReturnAction:
+		b.add(&expr.Verdict{Kind: expr.VerdictReturn})
+	case iptables.AcceptAction:
+		b.add(&expr.Verdict{Kind: expr.VerdictAccept})
+	case iptables.DropAction:
+		b.add(&expr.Verdict{Kind: expr.VerdictDrop})
+	case iptables.RejectAction:
+		// Like iptables, reject with ICMP port unreachable.
+		code := uint8(3)
+		if r.ipVersion == 6 {
+			code = 4
+		}
+		b.add(&expr.Reject{Type: unix.NFT_REJECT_ICMP_UNREACH, Code: code})
+	case iptables.LogAction:
+		b.add(&expr.Log{
+			Key:   1<<unix.NFTA_LOG_PREFIX | 1<<unix.NFTA_LOG_LEVEL,
+			Level: expr.LogLevelNotice,
+			Data:  []byte(a.Prefix + ": "),
+		})
+	case iptables.NflogAction:
+		log := &expr.Log{Key: 1 << unix.NFTA_LOG_GROUP, Group: a.Group}
+		if a.Prefix != "" {
+			log.Key |= 1 << unix.NFTA_LOG_PREFIX
+			log.Data = []byte(a.Prefix)
+		}
+		b.add(log)
+	case iptables.DNATAction:
+		addr, err := r.parseAddr(a.DestAddr)
+		if err != nil {
+			return err
+		}
+		nat := &expr.NAT{Type: expr.NATTypeDestNAT, Family: uint32(Family(r.ipVersion)), RegAddrMin: reg}
+		b.add(&expr.Immediate{Register: reg, Data: addr})
+		if a.DestPort != 0 {
+			b.add(&expr.Immediate{Register: reg2, Data: binaryutil.BigEndian.PutUint16(a.DestPort)})
+			nat.RegProtoMin = reg2
+		}
+		b.add(nat)
+	case iptables.SNATAction:
+		nat := &expr.NAT{
+			Type:        expr.NATTypeSourceNAT,
+			Family:      uint32(Family(r.ipVersion)),
+			RegAddrMin:  reg,
+			FullyRandom: features != nil && features.SNATFullyRandom,
+		}
+		parts := strings.SplitN(a.ToAddr, "-", 2)
+		for i, p := range parts {
+			addr, err := r.parseAddr(p)
+			if err != nil {
+				return err
+			}
+			b.add(&expr.Immediate{Register: reg + uint32(i), Data: addr})
+		}
+		if len(parts) == 2 {
+			nat.RegAddrMax = reg2
+		}
+		b.add(nat)
+	case iptables.MasqAction:
+		masq := &expr.Masq{FullyRandom: features != nil && features.MASQFullyRandom}
+		if a.ToPorts != "" {
+			ranges, err := parsePortRanges(a.ToPorts)
+			if err != nil || len(ranges) != 1 {
+				return fmt.Errorf("invalid masquerade ports %q", a.ToPorts)
+			}
+			// The nftables package can't combine a port range with flags, so a port range
+			// can't be fully random.
+			b.add(
+				&expr.Immediate{Register: reg, Data: binaryutil.BigEndian.PutUint16(ranges[0].first)},
+				&expr.Immediate{Register: reg2, Data: binaryutil.BigEndian.PutUint16(ranges[0].last)},
+			)
+			masq = &expr.Masq{ToPorts: true, RegProtoMin: reg, RegProtoMax: reg2}
+		}
+		b.add(masq)
+	case iptables.ClearMarkAction:
+		b.addSetMark(^a.Mark, 0)
+	case iptables.SetMarkAction:
+		b.addSetMark(^a.Mark, a.Mark)
+	case iptables.SetMaskedMarkAction:
+		b.addSetMark(^a.Mask, a.Mark)
+	case iptables.NoTrackAction:
+		b.add(&expr.Notrack{})
+	case iptables.SetConnMarkAction:
+		mask := a.Mask
+		if mask == 0 {
+			mask = 0xffffffff
+		}
+		// ct mark set ct mark & ^mask ^ mark
+		b.add(
+			&expr.Ct{Register: reg, Key: expr.CtKeyMARK},
+			bitwise(^mask, a.Mark),
+			&expr.Ct{Register: reg, SourceRegister: true, Key: expr.CtKeyMARK},
+		)
+	case iptables.SaveConnMarkAction:
+		if a.SaveMask != 0 && a.SaveMask != 0xffffffff {
+			return fmt.Errorf("masked CONNMARK --save-mark is not supported by nftables")
+		}
+		b.add(
+			&expr.Meta{Key: expr.MetaKeyMARK, Register: reg},
+			&expr.Ct{Register: reg, SourceRegister: true, Key: expr.CtKeyMARK},
+		)
+	case iptables.RestoreConnMarkAction:
+		if a.RestoreMask != 0 && a.RestoreMask != 0xffffffff {
+			return fmt.Errorf("masked CONNMARK --restore-mark is not supported by nftables")
+		}
+		b.add(
+			&expr.Ct{Register: reg, Key: expr.CtKeyMARK},
+			&expr.Meta{Key: expr.MetaKeyMARK, SourceRegister: true, Register: reg},
+		)
+	default:
+		return fmt.Errorf("unsupported action %T", action)
+	}
+	return nil
+}
+
+// addSetMark sets the packet mark to mark & mask ^ xor.
+func (b *exprBuilder) addSetMark(mask, xor uint32) {
+	b.add(
+		&expr.Meta{Key: expr.MetaKeyMARK, Register: reg},
+		bitwise(mask, xor),
+		&expr.Meta{Key: expr.MetaKeyMARK, SourceRegister: true, Register: reg},
+	)
+}
+
+// bitwise returns the expression that sets the 32-bit value in register reg to value & mask ^
+// xor.  Marks and conntrack states are in host byte order.
+func bitwise(mask, xor uint32) *expr.Bitwise {
+	return &expr.Bitwise{
+		SourceRegister: reg,
+		DestRegister:   reg,
+		Len:            4,
+		Mask:           binaryutil.NativeEndian.PutUint32(mask),
+		Xor:            binaryutil.NativeEndian.PutUint32(xor),
+	}
+}
+
+// parseNet parses an address or CIDR of our IP version.
+func (r ruleRenderer) parseNet(s string) (*net.IPNet, error) {
+	if !strings.Contains(s, "/") {
+		addr, err := r.parseAddr(s)
+		if err != nil {
+			return nil, err
+		}
+		bits := len(addr) * 8
+		return &net.IPNet{IP: addr, Mask: net.CIDRMask(bits, bits)}, nil
+	}
+	_, ipNet, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CIDR %q: %w", s, err)
+	}
+	if (ipNet.IP.To4() != nil) != (r.ipVersion == 4) {
+		return nil, fmt.Errorf("CIDR %q is not IPv%d", s, r.ipVersion)
+	}
+	return ipNet, nil
+}
+
+// parseAddr parses an address of our IP version into its 4 or 16 bytes.
+func (r ruleRenderer) parseAddr(s string) ([]byte, error) {
+	addr := net.ParseIP(s)
+	if addr == nil {
+		return nil, fmt.Errorf("invalid IP address %q", s)
+	}
+	if v4 := addr.To4(); v4 != nil {
+		if r.ipVersion != 4 {
+			return nil, fmt.Errorf("address %q is not IPv%d", s, r.ipVersion)
+		}
+		return v4, nil
+	}
+	if r.ipVersion != 6 {
+		return nil, fmt.Errorf("address %q is not IPv%d", s, r.ipVersion)
+	}
+	return addr.To16(), nil
+}
+
+// ifaceName returns the bytes to compare an interface name with.  An iptables wildcard
+// ("eth+") becomes a comparison of only the prefix.
+func ifaceName(s string) ([]byte, error) {
+	if strings.HasSuffix(s, "+") {
+		return []byte(strings.TrimSuffix(s, "+")), nil
+	}
+	if len(s) >= unix.IFNAMSIZ {
+		return nil, fmt.Errorf("interface name %q is too long", s)
+	}
+	name := make([]byte, unix.IFNAMSIZ)
+	copy(name, s)
+	return name, nil
+}
+
+// protocolNumber converts an iptables protocol name or number to its number.
+func protocolNumber(p string) (uint8, error) {
+	if num, ok := protocolNumbers[strings.ToLower(p)]; ok {
+		return num, nil
+	}
+	num, err := strconv.ParseUint(p, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("unknown protocol %q", p)
+	}
+	return uint8(num), nil
+}
+
+func parseUint32(s string) (uint32, error) {
+	v, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q: %w", s, err)
+	}
+	return uint32(v), nil
+}
+
+// parseLimit parses an iptables rate, such as "100/second".
+func parseLimit(s string) (*expr.Limit, error) {
+	parts := strings.SplitN(s, "/", 2)
+	rate, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil || len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("invalid limit %q", s)
+	}
+	unit, ok := limitUnits[parts[1][:1]]
+	if !ok {
+		return nil, fmt.Errorf("invalid limit unit in %q", s)
+	}
+	// 5 is the default burst of both iptables and nft.
+	return &expr.Limit{Type: expr.LimitTypePkts, Rate: rate, Unit: unit, Burst: 5}, nil
+}
+
+type portRange struct {
+	first, last uint16
+}
+
+// parsePortRanges parses a comma-separated list of ports and port ranges ("80,8080:8090").  The
+// result is sorted and overlapping ranges are merged, since the elements of an interval set
+// mustn't overlap.
+func parsePortRanges(s string) ([]portRange, error) {
+	var ranges []portRange
+	for _, part := range strings.Split(s, ",") {
+		bounds := strings.SplitN(strings.Replace(part, "-", ":", 1), ":", 2)
+		var r portRange
+		for i, bound := range bounds {
+			port, err := strconv.ParseUint(bound, 10, 16)
+			if err != nil {
+				return nil, fmt.Errorf("invalid port %q in %q", part, s)
+			}
+			if i == 0 {
+				r.first = uint16(port)
+			}
+			r.last = uint16(port)
+		}
+		if r.last < r.first {
+			return nil, fmt.Errorf("invalid port range %q in %q", part, s)
+		}
+		ranges = append(ranges, r)
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].first < ranges[j].first
+	})
+	merged := ranges[:1]
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if uint32(r.first) <= uint32(last.last)+1 {
+			if r.last > last.last {
+				last.last = r.last
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged, nil
+}
+
+// portRangeElements returns the elements of an interval set that contains the given ranges.
+// Each range is a start element and an end element that holds the first port after the range;
+// a range that runs to the last port has no end element.
+func portRangeElements(ranges []portRange) []nftables.SetElement {
+	var elements []nftables.SetElement
+	for _, r := range ranges {
+		elements = append(elements, nftables.SetElement{Key: binaryutil.BigEndian.PutUint16(r.first)})
+		if r.last < 0xffff {
+			elements = append(elements, nftables.SetElement{
+				Key:         binaryutil.BigEndian.PutUint16(r.last + 1),
+				IntervalEnd: true,
+			})
+		}
+	}
+	return elements
+}
+
+// tokenize splits a rendered iptables match on whitespace, honouring double quotes.
+func tokenize(s string) ([]string, error) {
+	var tokens []string
+	var current strings.Builder
+	inQuotes := false
+	inToken := false
+	for _, c := range s {
+		switch {
+		case c == '"':
+			inQuotes = !inQuotes
+			inToken = true
+		case (c == ' ' || c == '\t') && !inQuotes:
+			if inToken {
+				tokens = append(tokens, current.String())
+				current.Reset()
+				inToken = false
+			}
+		default:
+			current.WriteRune(c)
+			inToken = true
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("unterminated quote in %q", s)
+	}
+	if inToken {
+		tokens = append(tokens, current.String())
+	}
+	return tokens, nil
+}
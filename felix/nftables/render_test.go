@@ -0,0 +1,255 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nftables
+
+import (
+	"strings"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/binaryutil"
+	"github.com/google/nftables/expr"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"golang.org/x/sys/unix"
+
+	"github.com/projectcalico/calico/felix/iptables"
+	"github.com/projectcalico/calico/felix/proto"
+)
+
+var _ = Describe("Rule rendering", func() {
+	v4 := ruleRenderer{table: "filter", ipVersion: 4}
+	v6 := ruleRenderer{table: "filter", ipVersion: 6}
+
+	renderMatch := func(r ruleRenderer, match iptables.MatchCriteria) (*exprBuilder, error) {
+		b := &exprBuilder{ipVersion: r.ipVersion}
+		err := r.renderMatch(b, match)
+		return b, err
+	}
+	renderAction := func(r ruleRenderer, action iptables.Action, features *iptables.Features) ([]expr.Any, error) {
+		b := &exprBuilder{ipVersion: r.ipVersion}
+		err := r.renderAction(b, action, features)
+		return b.exprs, err
+	}
+	ifname := func(s string) []byte {
+		name := make([]byte, unix.IFNAMSIZ)
+		copy(name, s)
+		return name
+	}
+	u32 := binaryutil.NativeEndian.PutUint32
+	u16 := binaryutil.BigEndian.PutUint16
+	mark := func(mask, xor uint32) []expr.Any {
+		return []expr.Any{
+			&expr.Meta{Key: expr.MetaKeyMARK, Register: reg},
+			bitwise(mask, xor),
+			&expr.Meta{Key: expr.MetaKeyMARK, SourceRegister: true, Register: reg},
+		}
+	}
+
+	DescribeTable("matches",
+		func(r ruleRenderer, match iptables.MatchCriteria, expected []expr.Any) {
+			b, err := renderMatch(r, match)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(b.exprs).To(Equal(expected))
+			Expect(b.anonSets).To(BeEmpty())
+		},
+		Entry("empty", v4, iptables.Match(), nil),
+		Entry("mark clear", v4, iptables.Match().MarkClear(0x10), []expr.Any{
+			&expr.Meta{Key: expr.MetaKeyMARK, Register: reg},
+			bitwise(0x10, 0),
+			&expr.Cmp{Op: expr.CmpOpEq, Register: reg, Data: u32(0)},
+		}),
+		Entry("mark with mask", v4, iptables.Match().MarkMatchesWithMask(0x100, 0xf00), []expr.Any{
+			&expr.Meta{Key: expr.MetaKeyMARK, Register: reg},
+			bitwise(0xf00, 0),
+			&expr.Cmp{Op: expr.CmpOpEq, Register: reg, Data: u32(0x100)},
+		}),
+		Entry("interfaces", v4, iptables.Match().InInterface("cali+").OutInterface("eth0"), []expr.Any{
+			&expr.Meta{Key: expr.MetaKeyIIFNAME, Register: reg},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: reg, Data: []byte("cali")},
+			&expr.Meta{Key: expr.MetaKeyOIFNAME, Register: reg},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: reg, Data: ifname("eth0")},
+		}),
+		Entry("any interface", v4, iptables.Match().InInterface("+").Protocol("tcp"), []expr.Any{
+			&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: reg},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: reg, Data: []byte{6}},
+		}),
+		Entry("RPF failed", v4, iptables.Match().RPFCheckFailed(false), []expr.Any{
+			&expr.Fib{Register: reg, FlagSADDR: true, FlagMARK: true, FlagIIF: true, FlagPRESENT: true, ResultOIF: true},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: reg, Data: []byte{0}},
+		}),
+		Entry("non-local source, limited", v4, iptables.Match().NotSrcAddrType(iptables.AddrTypeLocal, true), []expr.Any{
+			&expr.Fib{Register: reg, FlagSADDR: true, FlagOIF: true, ResultADDRTYPE: true},
+			&expr.Cmp{Op: expr.CmpOpNeq, Register: reg, Data: u32(unix.RTN_LOCAL)},
+		}),
+		Entry("conntrack state", v4, iptables.Match().ConntrackState("RELATED,ESTABLISHED"), []expr.Any{
+			&expr.Ct{Register: reg, Key: expr.CtKeySTATE},
+			bitwise(ctStateBits["related"]|ctStateBits["established"], 0),
+			&expr.Cmp{Op: expr.CmpOpNeq, Register: reg, Data: u32(0)},
+		}),
+		Entry("not DNAT", v4, iptables.Match().NotConntrackState("DNAT"), []expr.Any{
+			&expr.Ct{Register: reg, Key: expr.CtKeySTATUS},
+			bitwise(ctStatusDstNAT, 0),
+			&expr.Cmp{Op: expr.CmpOpEq, Register: reg, Data: u32(0)},
+		}),
+		Entry("nets", v4, iptables.Match().SourceNet("10.0.0.1").NotDestNet("10.1.0.0/16"), []expr.Any{
+			&expr.Payload{DestRegister: reg, Base: expr.PayloadBaseNetworkHeader, Offset: 12, Len: 4},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: reg, Data: []byte{10, 0, 0, 1}},
+			&expr.Payload{DestRegister: reg, Base: expr.PayloadBaseNetworkHeader, Offset: 16, Len: 4},
+			&expr.Bitwise{SourceRegister: reg, DestRegister: reg, Len: 4, Mask: []byte{255, 255, 0, 0}, Xor: []byte{0, 0, 0, 0}},
+			&expr.Cmp{Op: expr.CmpOpNeq, Register: reg, Data: []byte{10, 1, 0, 0}},
+		}),
+		Entry("IP sets", v6, iptables.Match().NotDestIPSet("cali60d:efgh"), []expr.Any{
+			&expr.Payload{DestRegister: reg, Base: expr.PayloadBaseNetworkHeader, Offset: 24, Len: 16},
+			&expr.Lookup{SourceRegister: reg, SetName: "cali60d_efgh", Invert: true},
+		}),
+		Entry("IP port set", v4, iptables.Match().DestIPPortSet("cali40n:abcd"), []expr.Any{
+			&expr.Payload{DestRegister: concatReg, Base: expr.PayloadBaseNetworkHeader, Offset: 16, Len: 4},
+			&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: concatReg + 1},
+			&expr.Payload{DestRegister: concatReg + 2, Base: expr.PayloadBaseTransportHeader, Offset: 2, Len: 2},
+			&expr.Lookup{SourceRegister: concatReg, SetName: "cali40n_abcd"},
+		}),
+		Entry("port", v4, iptables.Match().SourcePorts(53), []expr.Any{
+			&expr.Payload{DestRegister: reg, Base: expr.PayloadBaseTransportHeader, Offset: 0, Len: 2},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: reg, Data: u16(53)},
+		}),
+		Entry("port range", v4, iptables.Match().NotDestPortRanges([]*proto.PortRange{{First: 80, Last: 90}}), []expr.Any{
+			&expr.Payload{DestRegister: reg, Base: expr.PayloadBaseTransportHeader, Offset: 2, Len: 2},
+			&expr.Range{Op: expr.CmpOpNeq, Register: reg, FromData: u16(80), ToData: u16(90)},
+		}),
+		Entry("ICMP type and code", v4, iptables.Match().ICMPTypeAndCode(3, 1), []expr.Any{
+			&expr.Payload{DestRegister: reg, Base: expr.PayloadBaseTransportHeader, Offset: 0, Len: 2},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: reg, Data: []byte{3, 1}},
+		}),
+		Entry("limit", v4, iptables.Match().LimitPacketRate(100, 20), []expr.Any{
+			&expr.Limit{Type: expr.LimitTypePkts, Rate: 100, Unit: expr.LimitTimeSecond, Burst: 20},
+		}),
+		Entry("VXLAN VNI", v4, iptables.Match().VXLANVNI(4096), []expr.Any{
+			&expr.Payload{DestRegister: reg, Base: expr.PayloadBaseTransportHeader, Offset: 12, Len: 3},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: reg, Data: []byte{0, 0x10, 0}},
+		}),
+	)
+
+	It("should match several ports with an anonymous interval set", func() {
+		b, err := renderMatch(v4, iptables.Match().NotDestPorts(8080, 80, 81, 65535))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(b.anonSets).To(HaveLen(1))
+		anon := b.anonSets[0]
+		Expect(anon.set.Anonymous).To(BeTrue())
+		Expect(anon.set.Interval).To(BeTrue())
+		Expect(anon.set.KeyType).To(Equal(nftables.TypeInetService))
+		Expect(anon.elements).To(Equal([]nftables.SetElement{
+			{Key: u16(80)}, {Key: u16(82), IntervalEnd: true},
+			{Key: u16(8080)}, {Key: u16(8081), IntervalEnd: true},
+			{Key: u16(65535)},
+		}))
+		Expect(anon.lookup.Invert).To(BeTrue())
+		Expect(b.exprs[len(b.exprs)-1]).To(BeIdenticalTo(anon.lookup))
+	})
+
+	DescribeTable("unsupported matches",
+		func(match iptables.MatchCriteria) {
+			_, err := renderMatch(v4, match)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("RPF accept-local", iptables.Match().RPFCheckFailed(true)),
+		Entry("IPVS", iptables.Match().IPVSConnection()),
+		Entry("v6 address", iptables.Match().SourceNet("fd00::1")),
+		Entry("mixed conntrack states", iptables.Match().ConntrackState("ESTABLISHED,DNAT")),
+		Entry("unknown", iptables.MatchCriteria{"-m foo --bar"}),
+	)
+
+	DescribeTable("actions",
+		func(r ruleRenderer, action iptables.Action, expected []expr.Any) {
+			Expect(renderAction(r, action, &iptables.Features{})).To(Equal(expected))
+		},
+		Entry("jump", v4, iptables.JumpAction{Target: "cali-fw-eth0"}, []expr.Any{
+			&expr.Verdict{Kind: expr.VerdictJump, Chain: "filter-cali-fw-eth0"},
+		}),
+		Entry("goto", v4, iptables.GotoAction{Target: "cali-tw-eth0"}, []expr.Any{
+			&expr.Verdict{Kind: expr.VerdictGoto, Chain: "filter-cali-tw-eth0"},
+		}),
+		Entry("drop", v4, iptables.DropAction{}, []expr.Any{&expr.Verdict{Kind: expr.VerdictDrop}}),
+		Entry("nflog", v4, iptables.NflogAction{Group: 1, Prefix: "API0|default.foo"}, []expr.Any{
+			&expr.Log{Key: 1<<unix.NFTA_LOG_GROUP | 1<<unix.NFTA_LOG_PREFIX, Group: 1, Data: []byte("API0|default.foo")},
+		}),
+		Entry("DNAT v6", v6, iptables.DNATAction{DestAddr: "fd00::1", DestPort: 8080}, []expr.Any{
+			&expr.Immediate{Register: reg, Data: []byte{0xfd, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1}},
+			&expr.Immediate{Register: reg2, Data: u16(8080)},
+			&expr.NAT{Type: expr.NATTypeDestNAT, Family: unix.NFPROTO_IPV6, RegAddrMin: reg, RegProtoMin: reg2},
+		}),
+		Entry("SNAT range", v4, iptables.SNATAction{ToAddr: "10.0.0.2-10.0.0.3"}, []expr.Any{
+			&expr.Immediate{Register: reg, Data: []byte{10, 0, 0, 2}},
+			&expr.Immediate{Register: reg2, Data: []byte{10, 0, 0, 3}},
+			&expr.NAT{Type: expr.NATTypeSourceNAT, Family: unix.NFPROTO_IPV4, RegAddrMin: reg, RegAddrMax: reg2},
+		}),
+		Entry("masquerade to ports", v4, iptables.MasqAction{ToPorts: "1000-2000"}, []expr.Any{
+			&expr.Immediate{Register: reg, Data: u16(1000)},
+			&expr.Immediate{Register: reg2, Data: u16(2000)},
+			&expr.Masq{ToPorts: true, RegProtoMin: reg, RegProtoMax: reg2},
+		}),
+		Entry("clear mark", v4, iptables.ClearMarkAction{Mark: 0xff}, mark(0xffffff00, 0)),
+		Entry("set mark", v4, iptables.SetMarkAction{Mark: 0x100}, mark(0xfffffeff, 0x100)),
+		Entry("set masked mark", v4, iptables.SetMaskedMarkAction{Mark: 0x10, Mask: 0xf0}, mark(0xffffff0f, 0x10)),
+		Entry("no track", v4, iptables.NoTrackAction{}, []expr.Any{&expr.Notrack{}}),
+		Entry("restore conn mark", v4, iptables.RestoreConnMarkAction{}, []expr.Any{
+			&expr.Ct{Register: reg, Key: expr.CtKeyMARK},
+			&expr.Meta{Key: expr.MetaKeyMARK, SourceRegister: true, Register: reg},
+		}),
+	)
+
+	It("should render fully-random NAT if supported", func() {
+		features := &iptables.Features{SNATFullyRandom: true, MASQFullyRandom: true}
+		exprs, err := renderAction(v4, iptables.MasqAction{}, features)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exprs).To(Equal([]expr.Any{&expr.Masq{FullyRandom: true}}))
+		exprs, err = renderAction(v4, iptables.SNATAction{ToAddr: "10.0.0.2"}, features)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exprs[len(exprs)-1].(*expr.NAT).FullyRandom).To(BeTrue())
+	})
+
+	It("should reject masked conn mark save", func() {
+		_, err := renderAction(v4, iptables.SaveConnMarkAction{SaveMask: 0xff}, &iptables.Features{})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should render a full rule with a counter and its comments", func() {
+		rule := iptables.Rule{
+			Match:   iptables.Match().Protocol("tcp"),
+			Action:  iptables.AcceptAction{},
+			Comment: []string{"Allow TCP", `with "quotes"`},
+		}
+		rendered, err := v4.RenderRule(rule, "cali:abcd", &iptables.Features{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rendered.exprs).To(Equal([]expr.Any{
+			&expr.Meta{Key: expr.MetaKeyL4PROTO, Register: reg},
+			&expr.Cmp{Op: expr.CmpOpEq, Register: reg, Data: []byte{6}},
+			&expr.Counter{},
+			&expr.Verdict{Kind: expr.VerdictAccept},
+		}))
+		Expect(decodeComment(rendered.userData)).To(Equal("cali:abcd; Allow TCP; with _quotes_"))
+	})
+
+	It("should truncate long comments after the hash", func() {
+		comment := renderComment("cali:abcd", []string{strings.Repeat("x", 200), "cali-ctr:abcd"})
+		Expect(comment).To(HavePrefix("cali:abcd; xxx"))
+		Expect(comment).To(HaveLen(maxCommentLen))
+		Expect(decodeComment(encodeComment(comment))).To(Equal(comment))
+	})
+
+	It("should convert IP set names", func() {
+		Expect(SetName("cali40s:abcd")).To(Equal("cali40s_abcd"))
+	})
+})
@@ -0,0 +1,619 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package nftables implements Felix's native nftables dataplane.  It renders the same chains as the
+// iptables dataplane (as produced by the rules package) into a single nftables table per IP
+// family, with nftables sets in place of IP sets.  It programs the kernel over netlink; all the
+// updates to a family, to both the chains and the sets, are sent as a single nftables
+// transaction so that the kernel never sees a partially-updated ruleset.
+package nftables
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/nftables"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+
+	"github.com/projectcalico/calico/felix/iptables"
+)
+
+// baseChain describes the nftables base chain that we create to stand in for one of the
+// kernel's built-in iptables chains.
+type baseChain struct {
+	chainType nftables.ChainType
+	hook      nftables.ChainHook
+	priority  nftables.ChainPriority
+}
+
+// tableToBaseChains maps from iptables table and built-in chain name to the equivalent nftables
+// base chain.  The priorities are those of the iptables tables.
+var tableToBaseChains = map[string]map[string]baseChain{
+	"raw": {
+		"PREROUTING": {nftables.ChainTypeFilter, nftables.ChainHookPrerouting, nftables.ChainPriorityRaw},
+		"OUTPUT":     {nftables.ChainTypeFilter, nftables.ChainHookOutput, nftables.ChainPriorityRaw},
+	},
+	"mangle": {
+		"PREROUTING":  {nftables.ChainTypeFilter, nftables.ChainHookPrerouting, nftables.ChainPriorityMangle},
+		"INPUT":       {nftables.ChainTypeFilter, nftables.ChainHookInput, nftables.ChainPriorityMangle},
+		"FORWARD":     {nftables.ChainTypeFilter, nftables.ChainHookForward, nftables.ChainPriorityMangle},
+		"OUTPUT":      {nftables.ChainTypeRoute, nftables.ChainHookOutput, nftables.ChainPriorityMangle},
+		"POSTROUTING": {nftables.ChainTypeFilter, nftables.ChainHookPostrouting, nftables.ChainPriorityMangle},
+	},
+	"nat": {
+		"PREROUTING":  {nftables.ChainTypeNAT, nftables.ChainHookPrerouting, nftables.ChainPriorityNATDest},
+		"INPUT":       {nftables.ChainTypeNAT, nftables.ChainHookInput, nftables.ChainPriorityNATSource},
+		"OUTPUT":      {nftables.ChainTypeNAT, nftables.ChainHookOutput, nftables.ChainPriorityNATDest},
+		"POSTROUTING": {nftables.ChainTypeNAT, nftables.ChainHookPostrouting, nftables.ChainPriorityNATSource},
+	},
+	"filter": {
+		"INPUT":   {nftables.ChainTypeFilter, nftables.ChainHookInput, nftables.ChainPriorityFilter},
+		"FORWARD": {nftables.ChainTypeFilter, nftables.ChainHookForward, nftables.ChainPriorityFilter},
+		"OUTPUT":  {nftables.ChainTypeFilter, nftables.ChainHookOutput, nftables.ChainPriorityFilter},
+	},
+}
+
+// featureSource is the subset of iptables.FeatureDetector that we use.
+type featureSource interface {
+	GetFeatures() *iptables.Features
+}
+
+// Table is a drop-in replacement for iptables.Table that programs one of the iptables tables
+// (raw, mangle, nat or filter) into the chains of our nftables table.  Chain names are prefixed
+// with the name of the iptables table.  Unlike the iptables implementation, the built-in chains
+// are ours alone, so inserted and appended rules are both written to our base chain for that
+// hook, inserted rules first.
+//
+// A Table only records the desired state; the Dataplane that owns it writes the changes to all
+// of its tables, and its IP sets, in a single transaction.
+type Table struct {
+	Name      string
+	IPVersion uint8
+
+	renderer          ruleRenderer
+	features          featureSource
+	hashCommentPrefix string
+	baseChains        map[string]baseChain
+	priorityOffset    nftables.ChainPriority
+
+	chainToInsertedRules map[string][]iptables.Rule
+	chainToAppendedRules map[string][]iptables.Rule
+	chainNameToChain     map[string]*iptables.Chain
+	// chainRefCounts counts the number of chains that refer to a given chain.  We only program
+	// chains that are referenced.
+	chainRefCounts map[string]int
+	dirtyChains    set.Set
+	// renderFailures contains the names of the chains that have rules that we failed to render.
+	// We program each of them as a single rule that drops all traffic.
+	renderFailures set.Set
+
+	// chainToDataplaneHashes contains the rule hashes that we think are in the dataplane,
+	// indexed by the (unprefixed) chain name.  Rules that we don't recognise have an empty hash.
+	chainToDataplaneHashes map[string][]string
+
+	// hashToComments maps from the hash of each rule that we know to be in the dataplane to the
+	// rule's full comments.  The comment that we write to the dataplane may be truncated so
+	// ReadCommentCounters() uses this to find the comments of the rules that it reads.  It is
+	// read concurrently with Apply() so it is protected by hashToCommentsLock.
+	hashToComments     map[string][]string
+	hashToCommentsLock sync.Mutex
+
+	logCxt *log.Entry
+
+	newConn connFactory
+}
+
+func newTable(
+	name string,
+	ipVersion uint8,
+	hashPrefix string,
+	features featureSource,
+	insertMode string,
+	newConn connFactory,
+) *Table {
+	baseChains, ok := tableToBaseChains[name]
+	if !ok {
+		log.WithField("table", name).Panic("Unknown iptables table")
+	}
+
+	var priorityOffset nftables.ChainPriority
+	switch insertMode {
+	case "", "insert":
+		priorityOffset = -1
+	case "append":
+		priorityOffset = 1
+	default:
+		log.WithField("insertMode", insertMode).Panic("Unknown insert mode")
+	}
+
+	refcounts := map[string]int{}
+	dirtyChains := set.New()
+	for chainName := range baseChains {
+		// Base chains are referred to by definition.  Mark them dirty so that we clean up any
+		// base chains that we created on a previous run.
+		refcounts[chainName] += 1
+		dirtyChains.Add(chainName)
+	}
+
+	return &Table{
+		Name:      name,
+		IPVersion: ipVersion,
+
+		renderer:          ruleRenderer{table: name, ipVersion: ipVersion},
+		features:          features,
+		hashCommentPrefix: hashPrefix,
+		baseChains:        baseChains,
+		priorityOffset:    priorityOffset,
+
+		chainToInsertedRules:   map[string][]iptables.Rule{},
+		chainToAppendedRules:   map[string][]iptables.Rule{},
+		chainNameToChain:       map[string]*iptables.Chain{},
+		chainRefCounts:         refcounts,
+		dirtyChains:            dirtyChains,
+		renderFailures:         set.New(),
+		chainToDataplaneHashes: map[string][]string{},
+		hashToComments:         map[string][]string{},
+
+		logCxt: log.WithFields(log.Fields{
+			"ipVersion": ipVersion,
+			"table":     name,
+			"backend":   "nftables",
+		}),
+
+		newConn: newConn,
+	}
+}
+
+func (t *Table) GetIPVersion() uint8 {
+	return t.IPVersion
+}
+
+// InsertOrAppendRules sets the rules that come first in our base chain for the given built-in
+// chain.
+func (t *Table) InsertOrAppendRules(chainName string, rules []iptables.Rule) {
+	t.logCxt.WithField("chainName", chainName).Debug("Updating rule insertions")
+	t.checkBaseChain(chainName)
+	oldRules := t.chainToInsertedRules[chainName]
+	t.chainToInsertedRules[chainName] = rules
+	t.dirtyChains.Add(chainName)
+	t.increfReferredChains(rules)
+	t.decrefReferredChains(oldRules)
+}
+
+// AppendRules sets the rules that come last in our base chain for the given built-in chain.
+func (t *Table) AppendRules(chainName string, rules []iptables.Rule) {
+	t.logCxt.WithField("chainName", chainName).Debug("Updating rule appends")
+	t.checkBaseChain(chainName)
+	oldRules := t.chainToAppendedRules[chainName]
+	t.chainToAppendedRules[chainName] = rules
+	t.dirtyChains.Add(chainName)
+	t.increfReferredChains(rules)
+	t.decrefReferredChains(oldRules)
+}
+
+func (t *Table) checkBaseChain(chainName string) {
+	if _, ok := t.baseChains[chainName]; !ok {
+		t.logCxt.WithField("chainName", chainName).Panic("Bug: not a built-in chain of this table")
+	}
+}
+
+func (t *Table) UpdateChains(chains []*iptables.Chain) {
+	for _, chain := range chains {
+		t.UpdateChain(chain)
+	}
+}
+
+func (t *Table) UpdateChain(chain *iptables.Chain) {
+	t.logCxt.WithField("chainName", chain.Name).Info("Queueing update of chain.")
+	// Incref any newly-referenced chains, then decref the old ones.  By incrementing first we
+	// avoid marking a still-referenced chain as dirty.
+	t.increfReferredChains(chain.Rules)
+	if oldChain := t.chainNameToChain[chain.Name]; oldChain != nil {
+		t.decrefReferredChains(oldChain.Rules)
+	}
+	t.chainNameToChain[chain.Name] = chain
+	if t.chainRefCounts[chain.Name] > 0 {
+		t.dirtyChains.Add(chain.Name)
+	}
+}
+
+func (t *Table) RemoveChains(chains []*iptables.Chain) {
+	for _, chain := range chains {
+		t.RemoveChainByName(chain.Name)
+	}
+}
+
+func (t *Table) RemoveChainByName(name string) {
+	t.logCxt.WithField("chainName", name).Info("Queuing deletion of chain.")
+	if oldChain, known := t.chainNameToChain[name]; known {
+		delete(t.chainNameToChain, name)
+		if t.chainRefCounts[name] > 0 {
+			t.dirtyChains.Add(name)
+		}
+		t.decrefReferredChains(oldChain.Rules)
+	}
+}
+
+func (t *Table) increfReferredChains(rules []iptables.Rule) {
+	for _, r := range rules {
+		if ref, ok := r.Action.(iptables.Referrer); ok {
+			t.chainRefCounts[ref.ReferencedChain()] += 1
+			if t.chainRefCounts[ref.ReferencedChain()] == 1 {
+				t.dirtyChains.Add(ref.ReferencedChain())
+			}
+		}
+	}
+}
+
+func (t *Table) decrefReferredChains(rules []iptables.Rule) {
+	for _, r := range rules {
+		if ref, ok := r.Action.(iptables.Referrer); ok {
+			t.chainRefCounts[ref.ReferencedChain()] -= 1
+			if t.chainRefCounts[ref.ReferencedChain()] == 0 {
+				delete(t.chainRefCounts, ref.ReferencedChain())
+				t.dirtyChains.Add(ref.ReferencedChain())
+			}
+		}
+	}
+}
+
+// desiredStateOfChain returns the rules that should be in the given chain and whether the chain
+// should exist at all.  Base chains only exist while they have rules, so that we don't add hooks
+// that do nothing.
+func (t *Table) desiredStateOfChain(chainName string) (rules []iptables.Rule, present bool) {
+	if _, ok := t.baseChains[chainName]; ok {
+		rules = append(rules, t.chainToInsertedRules[chainName]...)
+		rules = append(rules, t.chainToAppendedRules[chainName]...)
+		return rules, len(rules) > 0
+	}
+	chain := t.chainNameToChain[chainName]
+	if chain == nil || t.chainRefCounts[chainName] <= 0 {
+		return nil, false
+	}
+	return chain.Rules, true
+}
+
+func (t *Table) expectedHashes(chainName string, rules []iptables.Rule, features *iptables.Features) []string {
+	chain := iptables.Chain{Name: chainName, Rules: rules}
+	return chain.RuleHashes(features)
+}
+
+// loadDataplaneState compares the given state of the dataplane with our desired state and marks
+// the chains that differ as dirty.
+func (t *Table) loadDataplaneState(state *tableState) {
+	prefix := ChainName(t.Name, "")
+	dataplaneHashes := map[string][]string{}
+	for nftChainName, rules := range state.chains {
+		if !strings.HasPrefix(nftChainName, prefix) {
+			// Belongs to a different iptables table.
+			continue
+		}
+		hashes := make([]string, len(rules))
+		for i, r := range rules {
+			hashes[i] = t.hashFromComment(r.comment)
+		}
+		dataplaneHashes[strings.TrimPrefix(nftChainName, prefix)] = hashes
+	}
+
+	// Mark any chains that differ from their desired state as dirty and record the comments of
+	// the rules of the chains that are correct.
+	features := t.features.GetFeatures()
+	hashToComments := map[string][]string{}
+	t.allChainNames(dataplaneHashes).Iter(func(item interface{}) error {
+		chainName := item.(string)
+		rules, present := t.desiredStateOfChain(chainName)
+		actual, inDataplane := dataplaneHashes[chainName]
+		if present != inDataplane {
+			t.dirtyChains.Add(chainName)
+			return nil
+		}
+		if !present {
+			return nil
+		}
+		expected := t.expectedHashes(chainName, rules, features)
+		if !reflect.DeepEqual(expected, actual) {
+			t.logCxt.WithField("chainName", chainName).Warn("Detected out-of-sync nftables chain, marking for resync")
+			t.dirtyChains.Add(chainName)
+			return nil
+		}
+		for i, hash := range expected {
+			hashToComments[hash] = rules[i].Comment
+		}
+		return nil
+	})
+
+	t.chainToDataplaneHashes = dataplaneHashes
+	t.hashToCommentsLock.Lock()
+	t.hashToComments = hashToComments
+	t.hashToCommentsLock.Unlock()
+}
+
+// markAllDirty marks every chain that we know of as dirty, so that the next update rewrites or
+// deletes all of them.  Since rewriting a chain starts by flushing it, this releases all of the
+// table's references to sets.
+func (t *Table) markAllDirty() {
+	t.allChainNames(t.chainToDataplaneHashes).Iter(func(item interface{}) error {
+		t.dirtyChains.Add(item)
+		return nil
+	})
+}
+
+// allChainNames returns the names of all the chains that we want and of the chains in the given
+// dataplane state.
+func (t *Table) allChainNames(dataplaneHashes map[string][]string) set.Set {
+	names := set.New()
+	for chainName := range dataplaneHashes {
+		names.Add(chainName)
+	}
+	for chainName := range t.chainNameToChain {
+		names.Add(chainName)
+	}
+	for chainName := range t.baseChains {
+		names.Add(chainName)
+	}
+	return names
+}
+
+// hashFromComment extracts our rule hash from the comment of a rule that we wrote.
+func (t *Table) hashFromComment(comment string) string {
+	first := strings.SplitN(comment, commentSeparator, 2)[0]
+	if !strings.HasPrefix(first, t.hashCommentPrefix) {
+		return ""
+	}
+	return strings.TrimPrefix(first, t.hashCommentPrefix)
+}
+
+// Apply does nothing: the Dataplane that owns the table writes its updates.  It is here so that
+// Table can be used in place of an iptables.Table.
+func (t *Table) Apply() (rescheduleAfter time.Duration) {
+	return 0
+}
+
+// tableUpdate is the pending update to the chains of a Table.
+type tableUpdate struct {
+	// toWrite contains the names of the chains to create or rewrite and toDelete the names of
+	// the chains to delete, sorted.
+	toWrite  []string
+	toDelete []string
+
+	rules    map[string][]*renderedRule
+	hashes   map[string][]string
+	comments map[string][][]string
+}
+
+// prepareUpdate renders the chains that need to be written.  The result is queued up by the
+// queueXXX methods, which the Dataplane calls in turn for each of its tables so that each phase
+// of the update is complete for all of them before the next starts.
+func (t *Table) prepareUpdate() *tableUpdate {
+	u := &tableUpdate{
+		rules:    map[string][]*renderedRule{},
+		hashes:   map[string][]string{},
+		comments: map[string][][]string{},
+	}
+	if t.dirtyChains.Len() == 0 {
+		return u
+	}
+	features := t.features.GetFeatures()
+
+	// Work out which chains we need to (re)write and which we need to delete.
+	t.dirtyChains.Iter(func(item interface{}) error {
+		chainName := item.(string)
+		if _, present := t.desiredStateOfChain(chainName); present {
+			u.toWrite = append(u.toWrite, chainName)
+		} else if _, inDataplane := t.chainToDataplaneHashes[chainName]; inDataplane {
+			u.toDelete = append(u.toDelete, chainName)
+		}
+		return nil
+	})
+	sort.Strings(u.toWrite)
+	sort.Strings(u.toDelete)
+	for _, chainName := range u.toDelete {
+		t.renderFailures.Discard(chainName)
+	}
+
+	for _, chainName := range u.toWrite {
+		rules, _ := t.desiredStateOfChain(chainName)
+		hashes := t.expectedHashes(chainName, rules, features)
+		rendered, err := t.renderRules(rules, hashes, features)
+		comments := make([][]string, len(rules))
+		for i, rule := range rules {
+			comments[i] = rule.Comment
+		}
+		if err != nil {
+			// Fail closed: a chain that is missing some of its rules could let through traffic
+			// that it should drop.  The blank hash doesn't match the chain's expected hashes so
+			// we'll try again on the next resync.
+			t.logCxt.WithError(err).WithField("chainName", chainName).Error(
+				"Failed to render nftables chain, it will drop all traffic")
+			t.renderFailures.Add(chainName)
+			rendered = []*renderedRule{dropAllRule()}
+			hashes = []string{""}
+			comments = [][]string{nil}
+		} else {
+			t.renderFailures.Discard(chainName)
+		}
+		u.rules[chainName] = rendered
+		u.hashes[chainName] = hashes
+		u.comments[chainName] = comments
+	}
+	return u
+}
+
+// renderRules renders the given rules, or returns the first error.
+func (t *Table) renderRules(rules []iptables.Rule, hashes []string, features *iptables.Features) ([]*renderedRule, error) {
+	rendered := make([]*renderedRule, len(rules))
+	for i, rule := range rules {
+		r, err := t.renderer.RenderRule(rule, t.hashCommentPrefix+hashes[i], features)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d (%v): %w", i, rule, err)
+		}
+		rendered[i] = r
+	}
+	return rendered, nil
+}
+
+// RenderFailures returns the names of the chains that are dropping all traffic because we failed
+// to render their rules, sorted.
+func (t *Table) RenderFailures() []string {
+	var chains []string
+	t.renderFailures.Iter(func(item interface{}) error {
+		chains = append(chains, item.(string))
+		return nil
+	})
+	sort.Strings(chains)
+	return chains
+}
+
+func (t *Table) chain(chainName string) *nftables.Chain {
+	return &nftables.Chain{Name: ChainName(t.Name, chainName), Table: ourTable(t.IPVersion)}
+}
+
+// queueChainFlushes queues the creation of the chains that we're about to write and the
+// flushing of the chains that we're going to rewrite or delete.  This comes first so that the
+// rules that we write can jump to the chains in any order and so that, by the time we delete
+// chains and sets, no rules refer to them.
+func (t *Table) queueChainFlushes(tx *transaction, u *tableUpdate) {
+	for _, chainName := range u.toWrite {
+		chain := t.chain(chainName)
+		_, inDataplane := t.chainToDataplaneHashes[chainName]
+		if base, ok := t.baseChains[chainName]; ok {
+			// Recreate base chains so that a change of priority takes effect.
+			if inDataplane {
+				tx.FlushChain(chain)
+				tx.DelChain(chain)
+			}
+			policy := nftables.ChainPolicyAccept
+			chain.Type = base.chainType
+			chain.Hooknum = base.hook
+			chain.Priority = base.priority + t.priorityOffset
+			chain.Policy = &policy
+			tx.AddChain(chain)
+			continue
+		}
+		tx.AddChain(chain)
+		if inDataplane {
+			tx.FlushChain(chain)
+		}
+	}
+	for _, chainName := range u.toDelete {
+		tx.FlushChain(t.chain(chainName))
+	}
+}
+
+// queueRules queues the writing of the rules of the chains that we're writing, along with the
+// anonymous sets that they use.
+func (t *Table) queueRules(tx *transaction, u *tableUpdate) error {
+	table := ourTable(t.IPVersion)
+	for _, chainName := range u.toWrite {
+		chain := t.chain(chainName)
+		for _, r := range u.rules[chainName] {
+			for _, anon := range r.anonSets {
+				anon.set.Table = table
+				if err := tx.addSet(anon.set, anon.elements); err != nil {
+					return err
+				}
+				anon.lookup.SetID = anon.set.ID
+				anon.lookup.SetName = anon.set.Name
+			}
+			tx.AddRule(&nftables.Rule{
+				Table:    table,
+				Chain:    chain,
+				Exprs:    r.exprs,
+				UserData: r.userData,
+			})
+		}
+	}
+	return nil
+}
+
+// queueChainDeletions queues the deletion of the chains that we no longer want.
+func (t *Table) queueChainDeletions(tx *transaction, u *tableUpdate) {
+	for _, chainName := range u.toDelete {
+		tx.DelChain(t.chain(chainName))
+	}
+}
+
+// commitUpdate updates our record of the dataplane once the transaction that contained the given
+// update has been committed.
+func (t *Table) commitUpdate(u *tableUpdate) {
+	t.hashToCommentsLock.Lock()
+	for _, chainName := range append(u.toWrite, u.toDelete...) {
+		for _, hash := range t.chainToDataplaneHashes[chainName] {
+			delete(t.hashToComments, hash)
+		}
+	}
+	for _, chainName := range u.toWrite {
+		for i, hash := range u.hashes[chainName] {
+			t.hashToComments[hash] = u.comments[chainName][i]
+		}
+	}
+	t.hashToCommentsLock.Unlock()
+
+	for _, chainName := range u.toWrite {
+		t.chainToDataplaneHashes[chainName] = u.hashes[chainName]
+	}
+	for _, chainName := range u.toDelete {
+		delete(t.chainToDataplaneHashes, chainName)
+	}
+	t.dirtyChains.Clear()
+}
+
+// ReadCommentCounters reads the counters of the rules in this table that have a comment starting
+// with one of the commentPrefixes.  The result is indexed by prefix and then by the remainder of
+// the comment; the counters of rules with the same comment are summed.
+//
+// The comments that we write to the dataplane may be truncated so the comments of each rule
+// are looked up from the rule's hash, which always fits, rather than read from the dataplane.
+//
+// Unlike the other methods of Table, it is safe to call concurrently with Apply().
+func (t *Table) ReadCommentCounters(commentPrefixes ...string) (map[string]map[string]iptables.RuleCounters, error) {
+	c, err := t.newConn()
+	if err != nil {
+		return nil, err
+	}
+	state, err := listTable(c, t.IPVersion, ChainName(t.Name, ""), false)
+	if err != nil {
+		return nil, err
+	}
+
+	byPrefix := map[string]map[string]iptables.RuleCounters{}
+	for _, prefix := range commentPrefixes {
+		byPrefix[prefix] = map[string]iptables.RuleCounters{}
+	}
+	t.hashToCommentsLock.Lock()
+	defer t.hashToCommentsLock.Unlock()
+	for _, rules := range state.chains {
+		for _, r := range rules {
+			for _, comment := range t.hashToComments[t.hashFromComment(r.comment)] {
+				for _, prefix := range commentPrefixes {
+					if !strings.HasPrefix(comment, prefix) {
+						continue
+					}
+					key := strings.TrimPrefix(comment, prefix)
+					counters := byPrefix[prefix][key]
+					counters.Packets += r.packets
+					counters.Bytes += r.bytes
+					byPrefix[prefix][key] = counters
+				}
+			}
+		}
+	}
+	return byPrefix, nil
+}
@@ -0,0 +1,298 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nftables
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/nftables"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/ipsets"
+	"github.com/projectcalico/calico/felix/iptables"
+	"github.com/projectcalico/calico/felix/logutils"
+)
+
+type staticFeatures struct{}
+
+func (staticFeatures) GetFeatures() *iptables.Features {
+	return &iptables.Features{}
+}
+
+var _ = Describe("Table", func() {
+	var (
+		dataplane *mockNFT
+		nft       *Dataplane
+		table     *Table
+		now       time.Time
+	)
+
+	newDataplane := func(insertMode string) *Dataplane {
+		return NewDataplane(4, "cali:", staticFeatures{},
+			ipsets.NewIPVersionConfig(ipsets.IPFamilyV4, "cali", nil, nil),
+			DataplaneOptions{
+				InsertMode:      insertMode,
+				RefreshInterval: 30 * time.Second,
+				NewConnOverride: dataplane.newConn,
+				SleepOverride:   dataplane.sleep,
+				NowOverride:     func() time.Time { return now },
+				OpRecorder:      logutils.NewSummarizer("test loop"),
+			})
+	}
+
+	BeforeEach(func() {
+		dataplane = newMockNFT()
+		now = time.Now()
+		nft = newDataplane("insert")
+		table = nft.Filter
+	})
+
+	It("should do nothing on first Apply() with no rules", func() {
+		nft.Apply()
+		Expect(dataplane.Transactions).To(BeEmpty())
+		Expect(dataplane.ListCalls).To(Equal(1))
+	})
+
+	It("should remove stale base chains from a previous run", func() {
+		dataplane.Tables[nftables.TableFamilyIPv4] = &mockTable{
+			Chains: map[string]*mockChain{
+				"filter-FORWARD": {
+					Chain: &nftables.Chain{Name: "filter-FORWARD", Type: nftables.ChainTypeFilter},
+					Rules: []*nftables.Rule{{UserData: encodeComment("cali:oldhash")}},
+				},
+			},
+			Sets: map[string]*mockSet{},
+		}
+		nft.Apply()
+		Expect(dataplane.LastTransaction()).To(Equal([]string{
+			"add table calico",
+			"flush chain filter-FORWARD",
+			"delete chain filter-FORWARD",
+		}))
+		Expect(dataplane.ChainNames(nftables.TableFamilyIPv4)).To(BeEmpty())
+	})
+
+	It("should be a no-op to apply the table itself", func() {
+		table.InsertOrAppendRules("FORWARD", []iptables.Rule{{Action: iptables.AcceptAction{}}})
+		Expect(table.Apply()).To(BeZero())
+		Expect(dataplane.Transactions).To(BeEmpty())
+	})
+
+	Describe("with a referenced chain", func() {
+		var chain *iptables.Chain
+
+		BeforeEach(func() {
+			chain = &iptables.Chain{
+				Name: "cali-foo",
+				Rules: []iptables.Rule{
+					{Match: iptables.Match().Protocol("tcp"), Action: iptables.AcceptAction{}},
+				},
+			}
+			table.UpdateChain(chain)
+			table.InsertOrAppendRules("FORWARD", []iptables.Rule{
+				{Action: iptables.JumpAction{Target: "cali-foo"}},
+			})
+			nft.Apply()
+		})
+
+		It("should write the base chain and the chain in one transaction", func() {
+			hashes := chain.RuleHashes(&iptables.Features{})
+			fwdHashes := (&iptables.Chain{
+				Name:  "FORWARD",
+				Rules: []iptables.Rule{{Action: iptables.JumpAction{Target: "cali-foo"}}},
+			}).RuleHashes(&iptables.Features{})
+			Expect(dataplane.Transactions).To(HaveLen(1))
+			Expect(dataplane.LastTransaction()).To(Equal([]string{
+				"add table calico",
+				"add chain filter-FORWARD { type filter hook 2 priority -1 }",
+				"add chain filter-cali-foo",
+				`add rule filter-FORWARD "cali:` + fwdHashes[0] + `"`,
+				`add rule filter-cali-foo "cali:` + hashes[0] + `"`,
+			}))
+		})
+
+		It("should not rewrite anything on the next Apply()", func() {
+			nft.Apply()
+			Expect(dataplane.Transactions).To(HaveLen(1))
+		})
+
+		It("should rewrite only the updated chain", func() {
+			table.UpdateChain(&iptables.Chain{
+				Name:  "cali-foo",
+				Rules: []iptables.Rule{{Action: iptables.DropAction{}}},
+			})
+			nft.Apply()
+			Expect(dataplane.Transactions).To(HaveLen(2))
+			tx := dataplane.LastTransaction()
+			Expect(tx).To(HaveLen(4))
+			Expect(tx[1:3]).To(Equal([]string{
+				"add chain filter-cali-foo",
+				"flush chain filter-cali-foo",
+			}))
+			Expect(tx[3]).To(HavePrefix("add rule filter-cali-foo"))
+		})
+
+		It("should delete the chain and base chain once unreferenced", func() {
+			table.InsertOrAppendRules("FORWARD", nil)
+			table.RemoveChainByName("cali-foo")
+			nft.Apply()
+			Expect(dataplane.LastTransaction()).To(Equal([]string{
+				"add table calico",
+				"flush chain filter-FORWARD",
+				"flush chain filter-cali-foo",
+				"delete chain filter-FORWARD",
+				"delete chain filter-cali-foo",
+			}))
+			Expect(dataplane.ChainNames(nftables.TableFamilyIPv4)).To(BeEmpty())
+		})
+
+		It("should write all the tables in one transaction", func() {
+			nft.NAT.AppendRules("POSTROUTING", []iptables.Rule{{Action: iptables.MasqAction{}}})
+			nft.Raw.InsertOrAppendRules("PREROUTING", []iptables.Rule{{Action: iptables.NoTrackAction{}}})
+			nft.Apply()
+			Expect(dataplane.Transactions).To(HaveLen(2))
+			Expect(dataplane.ChainNames(nftables.TableFamilyIPv4)).To(Equal([]string{
+				"filter-FORWARD", "filter-cali-foo", "nat-POSTROUTING", "raw-PREROUTING",
+			}))
+		})
+
+		It("should retry after a failure", func() {
+			dataplane.FailNextFlush = true
+			dataplane.Tables = map[nftables.TableFamily]*mockTable{}
+			table.UpdateChain(&iptables.Chain{
+				Name:  "cali-foo",
+				Rules: []iptables.Rule{{Action: iptables.DropAction{}}},
+			})
+			nft.Apply()
+			Expect(dataplane.CumulativeSleep).To(Equal(1 * time.Millisecond))
+			// After the failure we re-read the (now empty) table so everything gets rewritten.
+			Expect(dataplane.LastTransaction()).To(ContainElement(
+				"add chain filter-FORWARD { type filter hook 2 priority -1 }"))
+			Expect(dataplane.ChainNames(nftables.TableFamilyIPv4)).To(Equal([]string{
+				"filter-FORWARD", "filter-cali-foo",
+			}))
+		})
+
+		It("should panic after repeated failures", func() {
+			dataplane.FailAllFlushes = true
+			table.UpdateChain(&iptables.Chain{Name: "cali-foo"})
+			Expect(func() { nft.Apply() }).To(Panic())
+		})
+
+		It("should drop all traffic in a chain that it fails to render", func() {
+			table.UpdateChain(&iptables.Chain{
+				Name: "cali-foo",
+				Rules: []iptables.Rule{
+					{Action: iptables.AcceptAction{}},
+					{Match: iptables.MatchCriteria{"--unknown-match"}, Action: iptables.DropAction{}},
+				},
+			})
+			nft.Apply()
+			Expect(dataplane.Comments(nftables.TableFamilyIPv4, "filter-cali-foo")).To(Equal([]string{"render-failed"}))
+			Expect(nft.RenderFailures()).To(Equal([]string{"filter/cali-foo"}))
+
+			// Once the chain renders, its rules are written and the failure cleared.
+			table.UpdateChain(&iptables.Chain{
+				Name:  "cali-foo",
+				Rules: []iptables.Rule{{Action: iptables.AcceptAction{}}},
+			})
+			nft.Apply()
+			Expect(dataplane.Comments(nftables.TableFamilyIPv4, "filter-cali-foo")).To(HaveLen(1))
+			Expect(dataplane.Comments(nftables.TableFamilyIPv4, "filter-cali-foo")[0]).NotTo(Equal("render-failed"))
+			Expect(nft.RenderFailures()).To(BeEmpty())
+		})
+
+		It("should resync after the refresh interval", func() {
+			fwd := dataplane.Tables[nftables.TableFamilyIPv4].Chains["filter-FORWARD"]
+			fwd.Rules = []*nftables.Rule{{UserData: encodeComment("cali:wrong")}}
+			now = now.Add(31 * time.Second)
+			Expect(nft.Apply()).To(Equal(30 * time.Second))
+			Expect(dataplane.ListCalls).To(Equal(2))
+			tx := dataplane.LastTransaction()
+			Expect(tx).To(HaveLen(5))
+			Expect(tx[1:4]).To(Equal([]string{
+				"flush chain filter-FORWARD",
+				"delete chain filter-FORWARD",
+				"add chain filter-FORWARD { type filter hook 2 priority -1 }",
+			}))
+			Expect(tx[4]).To(HavePrefix("add rule filter-FORWARD"))
+		})
+
+		It("should read comment counters", func() {
+			table.UpdateChain(&iptables.Chain{
+				Name: "cali-foo",
+				Rules: []iptables.Rule{
+					{Action: iptables.AcceptAction{}, Comment: []string{"Policy foo ingress"}},
+					{Action: iptables.DropAction{}, Comment: []string{"Policy foo ingress"}},
+					{Action: iptables.DropAction{}, Comment: []string{"Other"}},
+				},
+			})
+			nft.Apply()
+			dataplane.SetCounters(nftables.TableFamilyIPv4, "filter-cali-foo", 0, 10, 1000)
+			dataplane.SetCounters(nftables.TableFamilyIPv4, "filter-cali-foo", 1, 5, 500)
+			dataplane.SetCounters(nftables.TableFamilyIPv4, "filter-cali-foo", 2, 1, 1)
+			counters, err := table.ReadCommentCounters("Policy ")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counters).To(Equal(map[string]map[string]iptables.RuleCounters{
+				"Policy ": {
+					"foo ingress": {Packets: 15, Bytes: 1500},
+				},
+			}))
+		})
+
+		It("should read the counters of rules whose comments were truncated", func() {
+			// Long comments push the counter comment past the end of the comment that we write
+			// to the dataplane.
+			longComment := strings.Repeat("x", maxCommentLen)
+			table.UpdateChain(&iptables.Chain{
+				Name: "cali-foo",
+				Rules: []iptables.Rule{
+					{Action: iptables.AcceptAction{}, Comment: []string{longComment, "cali-ctr:abcd"}},
+				},
+			})
+			nft.Apply()
+			Expect(dataplane.Comments(nftables.TableFamilyIPv4, "filter-cali-foo")[0]).NotTo(ContainSubstring("cali-ctr:"))
+			dataplane.SetCounters(nftables.TableFamilyIPv4, "filter-cali-foo", 0, 3, 300)
+			counters, err := table.ReadCommentCounters("cali-ctr:")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counters).To(Equal(map[string]map[string]iptables.RuleCounters{
+				"cali-ctr:": {
+					"abcd": {Packets: 3, Bytes: 300},
+				},
+			}))
+
+			// The comments are still known after a resync.
+			nft.InvalidateDataplaneCache("test")
+			nft.Apply()
+			counters, err = table.ReadCommentCounters("cali-ctr:")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counters["cali-ctr:"]).To(HaveKey("abcd"))
+		})
+	})
+
+	It("should use a later priority in append mode", func() {
+		nft = newDataplane("append")
+		nft.NAT.AppendRules("POSTROUTING", []iptables.Rule{{Action: iptables.MasqAction{}}})
+		nft.Apply()
+		Expect(dataplane.LastTransaction()[1]).To(Equal(
+			"add chain nat-POSTROUTING { type nat hook 4 priority 101 }"))
+	})
+
+	It("should panic if asked to insert into a non-built-in chain", func() {
+		Expect(func() { table.InsertOrAppendRules("cali-foo", nil) }).To(Panic())
+	})
+})
@@ -0,0 +1,478 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nftables
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/nftables"
+	"github.com/google/nftables/expr"
+)
+
+// This file contains shared test infrastructure for testing the nftables package.
+
+// mockNFT simulates the kernel's nftables state.  Like the kernel, it applies the changes that a
+// conn buffers atomically on Flush(), failing the whole transaction if any change is invalid.
+// The changes of each successful transaction are recorded as strings in Transactions.
+type mockNFT struct {
+	Tables       map[nftables.TableFamily]*mockTable
+	Transactions [][]string
+	ListCalls    int
+
+	FailNextFlush   bool
+	FailAllFlushes  bool
+	CumulativeSleep time.Duration
+}
+
+type mockTable struct {
+	Chains map[string]*mockChain
+	Sets   map[string]*mockSet
+}
+
+type mockChain struct {
+	Chain *nftables.Chain
+	Rules []*nftables.Rule
+}
+
+type mockSet struct {
+	Set      *nftables.Set
+	Elements []nftables.SetElement
+}
+
+func newMockNFT() *mockNFT {
+	return &mockNFT{Tables: map[nftables.TableFamily]*mockTable{}}
+}
+
+func (m *mockNFT) newConn() (conn, error) {
+	return &mockConn{nft: m}, nil
+}
+
+func (m *mockNFT) sleep(d time.Duration) {
+	m.CumulativeSleep += d
+}
+
+// LastTransaction returns the changes made by the most recent successful transaction.
+func (m *mockNFT) LastTransaction() []string {
+	if len(m.Transactions) == 0 {
+		return nil
+	}
+	return m.Transactions[len(m.Transactions)-1]
+}
+
+// ChainNames returns the sorted names of the chains in the table of the given family.
+func (m *mockNFT) ChainNames(family nftables.TableFamily) []string {
+	var names []string
+	if t := m.Tables[family]; t != nil {
+		for name := range t.Chains {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Comments returns the comments of the rules in the given chain.
+func (m *mockNFT) Comments(family nftables.TableFamily, chain string) []string {
+	var comments []string
+	for _, r := range m.Tables[family].Chains[chain].Rules {
+		comments = append(comments, decodeComment(r.UserData))
+	}
+	return comments
+}
+
+// SetCounters sets the counter of the given rule, as if it had matched packets.
+func (m *mockNFT) SetCounters(family nftables.TableFamily, chain string, rule int, packets, bytes uint64) {
+	for _, e := range m.Tables[family].Chains[chain].Rules[rule].Exprs {
+		if c, ok := e.(*expr.Counter); ok {
+			c.Packets = packets
+			c.Bytes = bytes
+		}
+	}
+}
+
+func (m *mockNFT) copyTables() map[nftables.TableFamily]*mockTable {
+	tables := map[nftables.TableFamily]*mockTable{}
+	for family, t := range m.Tables {
+		c := &mockTable{Chains: map[string]*mockChain{}, Sets: map[string]*mockSet{}}
+		for name, chain := range t.Chains {
+			c.Chains[name] = &mockChain{Chain: chain.Chain, Rules: append([]*nftables.Rule(nil), chain.Rules...)}
+		}
+		for name, s := range t.Sets {
+			c.Sets[name] = &mockSet{Set: s.Set, Elements: append([]nftables.SetElement(nil), s.Elements...)}
+		}
+		tables[family] = c
+	}
+	return tables
+}
+
+// mockConn buffers changes until Flush(), like nftables.Conn.
+type mockConn struct {
+	nft  *mockNFT
+	ops  []mockOp
+	sets map[uint32]*nftables.Set
+}
+
+type mockOp struct {
+	desc  string
+	apply func(tables map[nftables.TableFamily]*mockTable) error
+}
+
+var (
+	errNotFound = errors.New("no such file or directory")
+	errBusy     = errors.New("device or resource busy")
+)
+
+func (c *mockConn) queue(desc string, apply func(tables map[nftables.TableFamily]*mockTable) error) {
+	c.ops = append(c.ops, mockOp{desc: desc, apply: apply})
+}
+
+func lookupTable(tables map[nftables.TableFamily]*mockTable, t *nftables.Table) (*mockTable, error) {
+	if t == nil || t.Name != TableName {
+		return nil, fmt.Errorf("unexpected table %v", t)
+	}
+	mt := tables[t.Family]
+	if mt == nil {
+		return nil, errNotFound
+	}
+	return mt, nil
+}
+
+func lookupChain(tables map[nftables.TableFamily]*mockTable, ch *nftables.Chain) (*mockTable, *mockChain, error) {
+	mt, err := lookupTable(tables, ch.Table)
+	if err != nil {
+		return nil, nil, err
+	}
+	mc := mt.Chains[ch.Name]
+	if mc == nil {
+		return nil, nil, fmt.Errorf("chain %s: %w", ch.Name, errNotFound)
+	}
+	return mt, mc, nil
+}
+
+func lookupSet(tables map[nftables.TableFamily]*mockTable, s *nftables.Set) (*mockSet, error) {
+	mt, err := lookupTable(tables, s.Table)
+	if err != nil {
+		return nil, err
+	}
+	ms := mt.Sets[s.Name]
+	if ms == nil {
+		return nil, fmt.Errorf("set %s: %w", s.Name, errNotFound)
+	}
+	return ms, nil
+}
+
+func (c *mockConn) AddTable(t *nftables.Table) *nftables.Table {
+	c.queue("add table "+t.Name, func(tables map[nftables.TableFamily]*mockTable) error {
+		if tables[t.Family] == nil {
+			tables[t.Family] = &mockTable{Chains: map[string]*mockChain{}, Sets: map[string]*mockSet{}}
+		}
+		return nil
+	})
+	return t
+}
+
+func (c *mockConn) DelTable(t *nftables.Table) {
+	c.queue("delete table "+t.Name, func(tables map[nftables.TableFamily]*mockTable) error {
+		if _, err := lookupTable(tables, t); err != nil {
+			return err
+		}
+		delete(tables, t.Family)
+		return nil
+	})
+}
+
+func (c *mockConn) AddChain(ch *nftables.Chain) *nftables.Chain {
+	desc := "add chain " + ch.Name
+	if ch.Type != "" {
+		desc += fmt.Sprintf(" { type %s hook %d priority %d }", ch.Type, ch.Hooknum, ch.Priority)
+	}
+	c.queue(desc, func(tables map[nftables.TableFamily]*mockTable) error {
+		mt, err := lookupTable(tables, ch.Table)
+		if err != nil {
+			return err
+		}
+		if existing := mt.Chains[ch.Name]; existing != nil {
+			if existing.Chain.Type != ch.Type || existing.Chain.Priority != ch.Priority {
+				return fmt.Errorf("chain %s exists with different hook", ch.Name)
+			}
+			return nil
+		}
+		mt.Chains[ch.Name] = &mockChain{Chain: ch}
+		return nil
+	})
+	return ch
+}
+
+func (c *mockConn) DelChain(ch *nftables.Chain) {
+	c.queue("delete chain "+ch.Name, func(tables map[nftables.TableFamily]*mockTable) error {
+		mt, mc, err := lookupChain(tables, ch)
+		if err != nil {
+			return err
+		}
+		if len(mc.Rules) > 0 {
+			return fmt.Errorf("chain %s has rules: %w", ch.Name, errBusy)
+		}
+		for _, other := range mt.Chains {
+			for _, r := range other.Rules {
+				for _, e := range r.Exprs {
+					if v, ok := e.(*expr.Verdict); ok && v.Chain == ch.Name {
+						return fmt.Errorf("chain %s is referenced: %w", ch.Name, errBusy)
+					}
+				}
+			}
+		}
+		delete(mt.Chains, ch.Name)
+		return nil
+	})
+}
+
+func (c *mockConn) FlushChain(ch *nftables.Chain) {
+	c.queue("flush chain "+ch.Name, func(tables map[nftables.TableFamily]*mockTable) error {
+		_, mc, err := lookupChain(tables, ch)
+		if err != nil {
+			return err
+		}
+		mc.Rules = nil
+		return nil
+	})
+}
+
+func (c *mockConn) AddRule(r *nftables.Rule) *nftables.Rule {
+	c.queue(fmt.Sprintf("add rule %s %q", r.Chain.Name, decodeComment(r.UserData)), func(tables map[nftables.TableFamily]*mockTable) error {
+		mt, mc, err := lookupChain(tables, r.Chain)
+		if err != nil {
+			return err
+		}
+		for _, e := range r.Exprs {
+			switch e := e.(type) {
+			case *expr.Verdict:
+				if e.Chain != "" && mt.Chains[e.Chain] == nil {
+					return fmt.Errorf("jump to chain %s: %w", e.Chain, errNotFound)
+				}
+			case *expr.Lookup:
+				if anon := c.sets[e.SetID]; anon != nil && anon.Anonymous {
+					continue
+				}
+				if mt.Sets[e.SetName] == nil {
+					return fmt.Errorf("lookup of set %s: %w", e.SetName, errNotFound)
+				}
+			}
+		}
+		mc.Rules = append(mc.Rules, r)
+		return nil
+	})
+	return r
+}
+
+func (c *mockConn) AddSet(s *nftables.Set, vals []nftables.SetElement) error {
+	if s.ID == 0 {
+		return errors.New("set ID not allocated")
+	}
+	if c.sets == nil {
+		c.sets = map[uint32]*nftables.Set{}
+	}
+	if c.sets[s.ID] != nil {
+		return fmt.Errorf("duplicate set ID %d", s.ID)
+	}
+	c.sets[s.ID] = s
+	if s.Anonymous {
+		// Anonymous sets belong to the rule that uses them.
+		c.queue(fmt.Sprintf("add anonymous set %d", len(vals)), func(map[nftables.TableFamily]*mockTable) error {
+			return nil
+		})
+		return nil
+	}
+	c.queue("add set "+s.Name, func(tables map[nftables.TableFamily]*mockTable) error {
+		mt, err := lookupTable(tables, s.Table)
+		if err != nil {
+			return err
+		}
+		if existing := mt.Sets[s.Name]; existing != nil {
+			if existing.Set.KeyType.GetNFTMagic() != s.KeyType.GetNFTMagic() || existing.Set.Interval != s.Interval {
+				return fmt.Errorf("set %s exists with a different type", s.Name)
+			}
+			return nil
+		}
+		mt.Sets[s.Name] = &mockSet{Set: s}
+		return nil
+	})
+	if len(vals) > 0 {
+		return c.SetAddElements(s, vals)
+	}
+	return nil
+}
+
+func (c *mockConn) DelSet(s *nftables.Set) {
+	c.queue("delete set "+s.Name, func(tables map[nftables.TableFamily]*mockTable) error {
+		if _, err := lookupSet(tables, s); err != nil {
+			return err
+		}
+		for _, chain := range tables[s.Table.Family].Chains {
+			for _, r := range chain.Rules {
+				for _, e := range r.Exprs {
+					if l, ok := e.(*expr.Lookup); ok && l.SetName == s.Name {
+						return fmt.Errorf("set %s is referenced: %w", s.Name, errBusy)
+					}
+				}
+			}
+		}
+		delete(tables[s.Table.Family].Sets, s.Name)
+		return nil
+	})
+}
+
+func (c *mockConn) FlushSet(s *nftables.Set) {
+	c.queue("flush set "+s.Name, func(tables map[nftables.TableFamily]*mockTable) error {
+		ms, err := lookupSet(tables, s)
+		if err != nil {
+			return err
+		}
+		ms.Elements = nil
+		return nil
+	})
+}
+
+func elementsEqual(a, b nftables.SetElement) bool {
+	return bytes.Equal(a.Key, b.Key) && a.IntervalEnd == b.IntervalEnd
+}
+
+func (c *mockConn) SetAddElements(s *nftables.Set, vals []nftables.SetElement) error {
+	c.queue(fmt.Sprintf("add elements %s %s", s.Name, describeElements(vals)), func(tables map[nftables.TableFamily]*mockTable) error {
+		ms, err := lookupSet(tables, s)
+		if err != nil {
+			return err
+		}
+	nextElement:
+		for _, v := range vals {
+			for _, e := range ms.Elements {
+				if elementsEqual(e, v) {
+					continue nextElement
+				}
+			}
+			ms.Elements = append(ms.Elements, v)
+		}
+		return nil
+	})
+	return nil
+}
+
+func (c *mockConn) SetDeleteElements(s *nftables.Set, vals []nftables.SetElement) error {
+	c.queue(fmt.Sprintf("delete elements %s %s", s.Name, describeElements(vals)), func(tables map[nftables.TableFamily]*mockTable) error {
+		ms, err := lookupSet(tables, s)
+		if err != nil {
+			return err
+		}
+	nextElement:
+		for _, v := range vals {
+			for i, e := range ms.Elements {
+				if elementsEqual(e, v) {
+					ms.Elements = append(ms.Elements[:i], ms.Elements[i+1:]...)
+					continue nextElement
+				}
+			}
+			return fmt.Errorf("element %v of set %s: %w", v.Key, s.Name, errNotFound)
+		}
+		return nil
+	})
+	return nil
+}
+
+// describeElements renders elements as their keys in hex, with interval ends marked.
+func describeElements(vals []nftables.SetElement) string {
+	var parts []string
+	for _, v := range vals {
+		part := fmt.Sprintf("%x", v.Key)
+		if v.IntervalEnd {
+			part += "(end)"
+		}
+		parts = append(parts, part)
+	}
+	return "{ " + strings.Join(parts, ", ") + " }"
+}
+
+func (c *mockConn) Flush() error {
+	m := c.nft
+	ops := c.ops
+	c.ops = nil
+	c.sets = nil
+	if m.FailNextFlush || m.FailAllFlushes {
+		m.FailNextFlush = false
+		return errors.New("netlink receive: invalid argument")
+	}
+	tables := m.copyTables()
+	var descs []string
+	for _, op := range ops {
+		if err := op.apply(tables); err != nil {
+			return fmt.Errorf("%s: %w", op.desc, err)
+		}
+		descs = append(descs, op.desc)
+	}
+	m.Tables = tables
+	m.Transactions = append(m.Transactions, descs)
+	return nil
+}
+
+func (c *mockConn) ListTablesOfFamily(family nftables.TableFamily) ([]*nftables.Table, error) {
+	c.nft.ListCalls++
+	if c.nft.Tables[family] == nil {
+		return nil, nil
+	}
+	return []*nftables.Table{{Name: TableName, Family: family}}, nil
+}
+
+func (c *mockConn) ListChainsOfTableFamily(family nftables.TableFamily) ([]*nftables.Chain, error) {
+	var chains []*nftables.Chain
+	for _, name := range c.nft.ChainNames(family) {
+		chain := *c.nft.Tables[family].Chains[name].Chain
+		chain.Table = &nftables.Table{Name: TableName, Family: family}
+		chains = append(chains, &chain)
+	}
+	return chains, nil
+}
+
+func (c *mockConn) GetRules(t *nftables.Table, ch *nftables.Chain) ([]*nftables.Rule, error) {
+	_, mc, err := lookupChain(c.nft.Tables, ch)
+	if err != nil {
+		return nil, err
+	}
+	return mc.Rules, nil
+}
+
+func (c *mockConn) GetSets(t *nftables.Table) ([]*nftables.Set, error) {
+	mt, err := lookupTable(c.nft.Tables, t)
+	if err != nil {
+		return nil, err
+	}
+	var sets []*nftables.Set
+	for _, ms := range mt.Sets {
+		s := *ms.Set
+		s.Table = nil
+		sets = append(sets, &s)
+	}
+	return sets, nil
+}
+
+func (c *mockConn) GetSetElements(s *nftables.Set) ([]nftables.SetElement, error) {
+	ms, err := lookupSet(c.nft.Tables, s)
+	if err != nil {
+		return nil, err
+	}
+	return append([]nftables.SetElement(nil), ms.Elements...), nil
+}
@@ -0,0 +1,288 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policysim
+
+import (
+	"fmt"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/felix/ip"
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+)
+
+// Packet is the packet to evaluate.  ICMPType and ICMPCode are only used if Protocol is ICMP or
+// ICMPv6; SrcPort and DstPort are only used for protocols that have ports.
+type Packet struct {
+	SrcAddr  ip.Addr
+	DstAddr  ip.Addr
+	Protocol uint8
+	SrcPort  uint16
+	DstPort  uint16
+	ICMPType uint8
+	ICMPCode uint8
+}
+
+func (p Packet) String() string {
+	name := ProtocolName(p.Protocol)
+	if name == "" {
+		name = fmt.Sprint(p.Protocol)
+	}
+	if protocolHasPorts(p.Protocol) {
+		return fmt.Sprintf("%s %s:%d -> %s:%d", name, p.SrcAddr, p.SrcPort, p.DstAddr, p.DstPort)
+	}
+	return fmt.Sprintf("%s %s -> %s", name, p.SrcAddr, p.DstAddr)
+}
+
+type Direction string
+
+const (
+	Ingress Direction = "ingress"
+	Egress  Direction = "egress"
+)
+
+type Action string
+
+const (
+	ActionAllow Action = "allow"
+	ActionDeny  Action = "deny"
+	// ActionNone means that the chain didn't decide the packet, so it continues to the next
+	// chain.
+	ActionNone Action = "none"
+)
+
+// Verdict is the result of evaluating the policy of one endpoint, in one direction, for a
+// packet.
+type Verdict struct {
+	Action Action
+
+	// Tier and Policy identify the policy that decided the verdict.  They are empty if no
+	// policy decided it.
+	Tier   string
+	Policy string
+	// Profile is the profile that decided the verdict, if it was decided by a profile.
+	Profile string
+	// RuleIndex is the index of the deciding rule in the policy or profile, or -1 if the
+	// verdict came from a default action.
+	RuleIndex int
+	// Reason describes how the verdict was reached.
+	Reason string
+}
+
+func (v Verdict) String() string {
+	return fmt.Sprintf("%s (%s)", v.Action, v.Reason)
+}
+
+// Chain identifies one of the policy chains that Felix renders for an endpoint.
+type Chain string
+
+const (
+	// ChainNormal holds an endpoint's normal (tracked) policy and its profiles.
+	ChainNormal Chain = "normal"
+	// ChainUntracked holds a host endpoint's doNotTrack policy.
+	ChainUntracked Chain = "untracked"
+	// ChainPreDNAT holds a host endpoint's preDNAT policy.  It only applies to ingress.
+	ChainPreDNAT Chain = "preDNAT"
+	// ChainForward holds a host endpoint's applyOnForward policy, which applies to traffic
+	// that the host forwards through the endpoint.
+	ChainForward Chain = "forward"
+)
+
+// Evaluate returns the verdict that the given endpoint's normal (tracked) policy has for the
+// given packet, mirroring the chains that Felix renders for the endpoint.
+func (s *Simulator) Evaluate(ep *Endpoint, dir Direction, pkt Packet) Verdict {
+	return s.EvaluateChain(ep, ChainNormal, dir, pkt)
+}
+
+// EvaluateChain returns the verdict that one of the endpoint's policy chains has for the given
+// packet.  Only the normal chain ends with a default deny; the untracked and preDNAT chains
+// return ActionNone if no policy decides the packet, leaving it to the chains that follow, and
+// the forward chain allows the packet if no applyOnForward policy applies to the endpoint.
+func (s *Simulator) EvaluateChain(ep *Endpoint, chain Chain, dir Direction, pkt Packet) Verdict {
+	var tiers []*proto.TierInfo
+	switch chain {
+	case ChainNormal:
+		tiers = ep.Tiers
+	case ChainUntracked:
+		tiers = ep.UntrackedTiers
+	case ChainPreDNAT:
+		if dir == Ingress {
+			tiers = ep.PreDNATTiers
+		}
+	case ChainForward:
+		tiers = ep.ForwardTiers
+	}
+
+	policiesRendered := false
+	for _, tier := range tiers {
+		policyNames := tier.IngressPolicies
+		if dir == Egress {
+			policyNames = tier.EgressPolicies
+		}
+		if len(policyNames) == 0 {
+			continue
+		}
+
+		enforcedPoliciesInTier := false
+		passed := false
+	policyLoop:
+		for _, name := range policyNames {
+			if model.PolicyIsStaged(name) {
+				// Staged policies never change the verdict.
+				continue
+			}
+			enforcedPoliciesInTier = true
+			policiesRendered = true
+			policy := s.policies[proto.PolicyID{Tier: tier.Name, Name: name}]
+			if policy == nil {
+				continue
+			}
+			rules := policy.InboundRules
+			if dir == Egress {
+				rules = policy.OutboundRules
+			}
+			for i, rule := range rules {
+				if !s.ruleMatches(rule, pkt) {
+					continue
+				}
+				switch rule.Action {
+				case "", "allow":
+					return Verdict{
+						Action: ActionAllow, Tier: tier.Name, Policy: name, RuleIndex: i,
+						Reason: fmt.Sprintf("allowed by rule %d of policy %s in tier %s", i, name, tier.Name),
+					}
+				case "deny":
+					return Verdict{
+						Action: ActionDeny, Tier: tier.Name, Policy: name, RuleIndex: i,
+						Reason: fmt.Sprintf("denied by rule %d of policy %s in tier %s", i, name, tier.Name),
+					}
+				case "next-tier", "pass":
+					passed = true
+					break policyLoop
+				}
+			}
+		}
+
+		endOfTierDrop := chain == ChainNormal || chain == ChainForward
+		if endOfTierDrop && !passed && enforcedPoliciesInTier && tier.DefaultAction != string(v3.Pass) {
+			return Verdict{
+				Action: ActionDeny, Tier: tier.Name, RuleIndex: -1,
+				Reason: fmt.Sprintf("denied by the end of tier %s: no policy in the tier allowed it", tier.Name),
+			}
+		}
+	}
+
+	switch chain {
+	case ChainUntracked, ChainPreDNAT:
+		return Verdict{
+			Action: ActionNone, RuleIndex: -1,
+			Reason: fmt.Sprintf("no %s policy decided it", chain),
+		}
+	case ChainForward:
+		if !policiesRendered {
+			return Verdict{
+				Action: ActionAllow, RuleIndex: -1,
+				Reason: "allowed because no applyOnForward policy applies",
+			}
+		}
+		return Verdict{
+			Action: ActionNone, RuleIndex: -1,
+			Reason: "every applyOnForward policy passed it",
+		}
+	}
+
+	for _, profileID := range ep.ProfileIDs {
+		profile := s.profiles[proto.ProfileID{Name: profileID}]
+		if profile == nil {
+			continue
+		}
+		rules := profile.InboundRules
+		if dir == Egress {
+			rules = profile.OutboundRules
+		}
+	ruleLoop:
+		for i, rule := range rules {
+			if !s.ruleMatches(rule, pkt) {
+				continue
+			}
+			switch rule.Action {
+			case "", "allow":
+				return Verdict{
+					Action: ActionAllow, Profile: profileID, RuleIndex: i,
+					Reason: fmt.Sprintf("allowed by rule %d of profile %s", i, profileID),
+				}
+			case "deny":
+				return Verdict{
+					Action: ActionDeny, Profile: profileID, RuleIndex: i,
+					Reason: fmt.Sprintf("denied by rule %d of profile %s", i, profileID),
+				}
+			case "next-tier", "pass":
+				break ruleLoop
+			}
+		}
+	}
+	return Verdict{
+		Action: ActionDeny, RuleIndex: -1,
+		Reason: "denied because no policy or profile allowed it",
+	}
+}
+
+// FlowResult is the result of evaluating a packet against the policy of its source and
+// destination endpoints.
+type FlowResult struct {
+	// Src and Dst are the endpoints that own the packet's addresses, or nil if the address
+	// doesn't belong to an endpoint.
+	Src *Endpoint
+	Dst *Endpoint
+	// Egress is the verdict of the source endpoint's egress policy and Ingress is the verdict
+	// of the destination endpoint's ingress policy.  Each is nil if there is no endpoint.
+	Egress  *Verdict
+	Ingress *Verdict
+}
+
+// Allowed returns true if the packet is allowed by both endpoints.
+func (r FlowResult) Allowed() bool {
+	return (r.Egress == nil || r.Egress.Action == ActionAllow) &&
+		(r.Ingress == nil || r.Ingress.Action == ActionAllow)
+}
+
+// Decision returns the verdict that decided the flow: the first verdict that denied it or,
+// if it was allowed, the destination's verdict.  It returns nil if neither address belongs
+// to an endpoint.
+func (r FlowResult) Decision() *Verdict {
+	if r.Egress != nil && r.Egress.Action != ActionAllow {
+		return r.Egress
+	}
+	if r.Ingress != nil {
+		return r.Ingress
+	}
+	return r.Egress
+}
+
+// EvaluateFlow evaluates the packet against the egress policy of the endpoint that owns its
+// source address and the ingress policy of the endpoint that owns its destination address.
+func (s *Simulator) EvaluateFlow(pkt Packet) FlowResult {
+	var result FlowResult
+	if result.Src = s.EndpointByAddr(pkt.SrcAddr); result.Src != nil {
+		v := s.Evaluate(result.Src, Egress, pkt)
+		result.Egress = &v
+	}
+	if result.Dst = s.EndpointByAddr(pkt.DstAddr); result.Dst != nil {
+		v := s.Evaluate(result.Dst, Ingress, pkt)
+		result.Ingress = &v
+	}
+	return result
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policysim
+
+import (
+	"net"
+	"strings"
+
+	"github.com/projectcalico/calico/felix/ip"
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/felix/rules"
+)
+
+const (
+	protoICMP    = 1
+	protoTCP     = 6
+	protoUDP     = 17
+	protoICMPv6  = 58
+	protoSCTP    = 132
+	protoUDPLite = 136
+)
+
+var protocolNumbersByName = map[string]uint8{
+	"icmp":    protoICMP,
+	"tcp":     protoTCP,
+	"udp":     protoUDP,
+	"icmpv6":  protoICMPv6,
+	"sctp":    protoSCTP,
+	"udplite": protoUDPLite,
+}
+
+// ProtocolNumber returns the number of the named protocol, accepting the same names as policy
+// rules.
+func ProtocolNumber(name string) (uint8, bool) {
+	num, ok := protocolNumbersByName[strings.ToLower(name)]
+	return num, ok
+}
+
+// ProtocolName returns the name that policy rules and named port IP sets use for the protocol,
+// or "" if the protocol doesn't have one.
+func ProtocolName(num uint8) string {
+	for name, n := range protocolNumbersByName {
+		if n == num {
+			return name
+		}
+	}
+	return ""
+}
+
+func protocolHasPorts(num uint8) bool {
+	return num == protoTCP || num == protoUDP || num == protoSCTP
+}
+
+// ruleMatches returns true if the rule matches the packet.  It follows the semantics of the
+// iptables rules that Felix renders: positive CIDR and port matches are or-ed together, all of
+// the IP set matches must match, and none of the negated matches may match.  HTTP matches
+// aren't enforced by Felix so they are ignored here too.
+func (s *Simulator) ruleMatches(rule *proto.Rule, pkt Packet) bool {
+	rule = rules.FilterRuleToIPVersion(pkt.SrcAddr.Version(), rule)
+	if rule == nil {
+		return false
+	}
+
+	if rule.Protocol != nil && !protocolMatches(rule.Protocol, pkt.Protocol) {
+		return false
+	}
+	if rule.NotProtocol != nil && protocolMatches(rule.NotProtocol, pkt.Protocol) {
+		return false
+	}
+
+	if len(rule.SrcNet) > 0 && !anyNetContains(rule.SrcNet, pkt.SrcAddr) {
+		return false
+	}
+	if anyNetContains(rule.NotSrcNet, pkt.SrcAddr) {
+		return false
+	}
+	if len(rule.DstNet) > 0 && !anyNetContains(rule.DstNet, pkt.DstAddr) {
+		return false
+	}
+	if anyNetContains(rule.NotDstNet, pkt.DstAddr) {
+		return false
+	}
+
+	for _, id := range rule.SrcIpSetIds {
+		if !s.ipSetContainsAddr(id, pkt.SrcAddr) {
+			return false
+		}
+	}
+	for _, id := range rule.NotSrcIpSetIds {
+		if s.ipSetContainsAddr(id, pkt.SrcAddr) {
+			return false
+		}
+	}
+	for _, id := range rule.DstIpSetIds {
+		if !s.ipSetContainsAddr(id, pkt.DstAddr) {
+			return false
+		}
+	}
+	for _, id := range rule.NotDstIpSetIds {
+		if s.ipSetContainsAddr(id, pkt.DstAddr) {
+			return false
+		}
+	}
+	for _, id := range rule.DstIpPortSetIds {
+		if !s.ipSetContainsAddrAndPort(id, pkt.DstAddr, pkt.Protocol, pkt.DstPort) {
+			return false
+		}
+	}
+
+	if !s.portsMatch(rule.SrcPorts, rule.SrcNamedPortIpSetIds, pkt.SrcAddr, pkt.Protocol, pkt.SrcPort) {
+		return false
+	}
+	if s.portsMatchAny(rule.NotSrcPorts, rule.NotSrcNamedPortIpSetIds, pkt.SrcAddr, pkt.Protocol, pkt.SrcPort) {
+		return false
+	}
+	if !s.portsMatch(rule.DstPorts, rule.DstNamedPortIpSetIds, pkt.DstAddr, pkt.Protocol, pkt.DstPort) {
+		return false
+	}
+	if s.portsMatchAny(rule.NotDstPorts, rule.NotDstNamedPortIpSetIds, pkt.DstAddr, pkt.Protocol, pkt.DstPort) {
+		return false
+	}
+
+	if rule.Icmp != nil && !icmpMatches(rule.Icmp, pkt) {
+		return false
+	}
+	if rule.NotIcmp != nil && icmpMatches(rule.NotIcmp, pkt) {
+		return false
+	}
+	return true
+}
+
+func protocolMatches(p *proto.Protocol, num uint8) bool {
+	switch p := p.NumberOrName.(type) {
+	case *proto.Protocol_Number:
+		return uint8(p.Number) == num
+	case *proto.Protocol_Name:
+		n, ok := ProtocolNumber(p.Name)
+		return ok && n == num
+	}
+	return false
+}
+
+func anyNetContains(cidrs []string, addr ip.Addr) bool {
+	for _, c := range cidrs {
+		_, ipNet, err := net.ParseCIDR(c)
+		if err != nil {
+			if netIP := net.ParseIP(c); netIP != nil && netIP.Equal(addr.AsNetIP()) {
+				return true
+			}
+			continue
+		}
+		if ipNet.Contains(addr.AsNetIP()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Simulator) ipSetContainsAddr(id string, addr ip.Addr) bool {
+	ipSet := s.ipSets[id]
+	return ipSet != nil && ipSet.containsAddr(addr)
+}
+
+func (s *Simulator) ipSetContainsAddrAndPort(id string, addr ip.Addr, protocol uint8, port uint16) bool {
+	ipSet := s.ipSets[id]
+	return ipSet != nil && ipSet.containsAddrAndPort(addr, ProtocolName(protocol), port)
+}
+
+// portsMatch returns true if there are no port matches, or if the port is in one of the ranges
+// or named port IP sets.
+func (s *Simulator) portsMatch(ranges []*proto.PortRange, namedPortIPSetIDs []string, addr ip.Addr, protocol uint8, port uint16) bool {
+	if len(ranges) == 0 && len(namedPortIPSetIDs) == 0 {
+		return true
+	}
+	return s.portsMatchAny(ranges, namedPortIPSetIDs, addr, protocol, port)
+}
+
+// portsMatchAny returns true if the port is in one of the ranges or named port IP sets.
+func (s *Simulator) portsMatchAny(ranges []*proto.PortRange, namedPortIPSetIDs []string, addr ip.Addr, protocol uint8, port uint16) bool {
+	if !protocolHasPorts(protocol) {
+		return false
+	}
+	for _, r := range ranges {
+		if int32(port) >= r.First && int32(port) <= r.Last {
+			return true
+		}
+	}
+	for _, id := range namedPortIPSetIDs {
+		if s.ipSetContainsAddrAndPort(id, addr, protocol, port) {
+			return true
+		}
+	}
+	return false
+}
+
+func icmpMatches(icmp interface{}, pkt Packet) bool {
+	if pkt.Protocol != protoICMP && pkt.Protocol != protoICMPv6 {
+		return false
+	}
+	switch icmp := icmp.(type) {
+	case *proto.Rule_IcmpType:
+		return int32(pkt.ICMPType) == icmp.IcmpType
+	case *proto.Rule_IcmpTypeCode:
+		return int32(pkt.ICMPType) == icmp.IcmpTypeCode.Type && int32(pkt.ICMPCode) == icmp.IcmpTypeCode.Code
+	case *proto.Rule_NotIcmpType:
+		return int32(pkt.ICMPType) == icmp.NotIcmpType
+	case *proto.Rule_NotIcmpTypeCode:
+		return int32(pkt.ICMPType) == icmp.NotIcmpTypeCode.Type && int32(pkt.ICMPCode) == icmp.NotIcmpTypeCode.Code
+	}
+	return false
+}
@@ -0,0 +1,358 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policysim
+
+import (
+	"fmt"
+	"net"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/felix/calc"
+	"github.com/projectcalico/calico/felix/config"
+	"github.com/projectcalico/calico/felix/ip"
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/set"
+)
+
+// simulatedHostname is the hostname that the simulator's calculation graph runs as.  Every
+// endpoint is moved onto this host so that the graph calculates the policy for all of them.
+const simulatedHostname = "policysim"
+
+// Endpoint is a workload or host endpoint, as Felix's dataplane sees it.
+type Endpoint struct {
+	// Key is the datastore key of the endpoint, either a model.WorkloadEndpointKey or a
+	// model.HostEndpointKey, with its original hostname.
+	Key model.Key
+	// Hostname is the host that the endpoint belongs to.
+	Hostname string
+	// InterfaceName is the name of a host endpoint's interface, or "*" if it applies to all of
+	// the host's interfaces.  It is empty for a workload endpoint.
+	InterfaceName string
+
+	// Tiers holds the policies that apply to the endpoint, in order.  For a host endpoint,
+	// these are the policies that apply to traffic to and from the host itself.
+	Tiers []*proto.TierInfo
+	// UntrackedTiers, PreDNATTiers and ForwardTiers hold the doNotTrack, preDNAT and
+	// applyOnForward policies that apply to a host endpoint.
+	UntrackedTiers []*proto.TierInfo
+	PreDNATTiers   []*proto.TierInfo
+	ForwardTiers   []*proto.TierInfo
+
+	ProfileIDs []string
+	Addrs      []ip.Addr
+	// Ports are the named ports that the endpoint declares.
+	Ports []model.EndpointPort
+}
+
+// Simulator evaluates Calico policy offline.  It feeds datastore updates through Felix's
+// calculation graph, treating every endpoint as local, and keeps the resulting dataplane state
+// (IP sets, active policies and profiles, and endpoints) so that packets can be matched
+// against exactly the rules that Felix would program.
+type Simulator struct {
+	validationFilter *calc.ValidationFilter
+	eventSequencer   *calc.EventSequencer
+
+	ipSets    map[string]*ipSet
+	policies  map[proto.PolicyID]*proto.Policy
+	profiles  map[proto.ProfileID]*proto.Profile
+	endpoints map[interface{}]*Endpoint
+
+	// keysByID maps from the dataplane ID of each endpoint to its datastore key, and
+	// portsByID to its named ports, which the dataplane messages don't include.
+	keysByID  map[interface{}]model.Key
+	portsByID map[interface{}][]model.EndpointPort
+	// endpointsByAddr indexes the endpoints by their addresses.  It is rebuilt when the
+	// endpoints change.
+	endpointsByAddr map[ip.Addr]*Endpoint
+}
+
+func New() *Simulator {
+	conf := config.New()
+	conf.FelixHostname = simulatedHostname
+
+	s := &Simulator{
+		ipSets:    map[string]*ipSet{},
+		policies:  map[proto.PolicyID]*proto.Policy{},
+		profiles:  map[proto.ProfileID]*proto.Profile{},
+		endpoints: map[interface{}]*Endpoint{},
+		keysByID:  map[interface{}]model.Key{},
+		portsByID: map[interface{}][]model.EndpointPort{},
+	}
+	s.eventSequencer = calc.NewEventSequencer(conf)
+	s.eventSequencer.Callback = s.onEvent
+	calcGraph := calc.NewCalculationGraph(s.eventSequencer, conf)
+	s.validationFilter = calc.NewValidationFilter(calcGraph.AllUpdDispatcher)
+	s.validationFilter.OnStatusUpdated(api.InSync)
+	return s
+}
+
+// OnUpdates applies the given datastore updates, which should be in the v1 data model that
+// Felix's syncer produces.
+func (s *Simulator) OnUpdates(updates []api.Update) {
+	localUpdates := make([]api.Update, 0, len(updates))
+	for _, upd := range updates {
+		switch key := upd.Key.(type) {
+		case model.WorkloadEndpointKey:
+			id := proto.WorkloadEndpointID{
+				OrchestratorId: key.OrchestratorID,
+				WorkloadId:     key.WorkloadID,
+				EndpointId:     key.EndpointID,
+			}
+			s.keysByID[id] = key
+			if wep, ok := upd.Value.(*model.WorkloadEndpoint); ok {
+				s.portsByID[id] = wep.Ports
+			}
+			key.Hostname = simulatedHostname
+			upd.Key = key
+		case model.HostEndpointKey:
+			id := proto.HostEndpointID{EndpointId: key.EndpointID}
+			s.keysByID[id] = key
+			if hep, ok := upd.Value.(*model.HostEndpoint); ok {
+				s.portsByID[id] = hep.Ports
+			}
+			key.Hostname = simulatedHostname
+			upd.Key = key
+		}
+		localUpdates = append(localUpdates, upd)
+	}
+	s.validationFilter.OnUpdates(localUpdates)
+	s.eventSequencer.Flush()
+}
+
+func (s *Simulator) onEvent(event interface{}) {
+	switch event := event.(type) {
+	case *proto.IPSetUpdate:
+		s.ipSets[event.Id] = newIPSet(event.Type, event.Members)
+	case *proto.IPSetDeltaUpdate:
+		s.ipSets[event.Id].applyDelta(event.AddedMembers, event.RemovedMembers)
+	case *proto.IPSetRemove:
+		delete(s.ipSets, event.Id)
+	case *proto.ActivePolicyUpdate:
+		s.policies[*event.Id] = event.Policy
+	case *proto.ActivePolicyRemove:
+		delete(s.policies, *event.Id)
+	case *proto.ActiveProfileUpdate:
+		s.profiles[*event.Id] = event.Profile
+	case *proto.ActiveProfileRemove:
+		delete(s.profiles, *event.Id)
+	case *proto.WorkloadEndpointUpdate:
+		key := s.keysByID[*event.Id].(model.WorkloadEndpointKey)
+		s.endpoints[*event.Id] = &Endpoint{
+			Key:        key,
+			Hostname:   key.Hostname,
+			Tiers:      event.Endpoint.Tiers,
+			ProfileIDs: event.Endpoint.ProfileIds,
+			Addrs:      parseAddrs(event.Endpoint.Ipv4Nets, event.Endpoint.Ipv6Nets),
+			Ports:      s.portsByID[*event.Id],
+		}
+		s.endpointsByAddr = nil
+	case *proto.WorkloadEndpointRemove:
+		delete(s.endpoints, *event.Id)
+		delete(s.keysByID, *event.Id)
+		delete(s.portsByID, *event.Id)
+		s.endpointsByAddr = nil
+	case *proto.HostEndpointUpdate:
+		key := s.keysByID[*event.Id].(model.HostEndpointKey)
+		s.endpoints[*event.Id] = &Endpoint{
+			Key:            key,
+			Hostname:       key.Hostname,
+			InterfaceName:  event.Endpoint.Name,
+			Tiers:          event.Endpoint.Tiers,
+			UntrackedTiers: event.Endpoint.UntrackedTiers,
+			PreDNATTiers:   event.Endpoint.PreDnatTiers,
+			ForwardTiers:   event.Endpoint.ForwardTiers,
+			ProfileIDs:     event.Endpoint.ProfileIds,
+			Addrs:          parseAddrs(event.Endpoint.ExpectedIpv4Addrs, event.Endpoint.ExpectedIpv6Addrs),
+			Ports:          s.portsByID[*event.Id],
+		}
+		s.endpointsByAddr = nil
+	case *proto.HostEndpointRemove:
+		delete(s.endpoints, *event.Id)
+		delete(s.keysByID, *event.Id)
+		delete(s.portsByID, *event.Id)
+		s.endpointsByAddr = nil
+	default:
+		log.WithField("event", event).Debug("Ignoring event that doesn't affect policy")
+	}
+}
+
+// parseAddrs parses the addresses of an endpoint, which may be given as IPs or as CIDRs.
+func parseAddrs(addrLists ...[]string) (out []ip.Addr) {
+	for _, addrs := range addrLists {
+		for _, a := range addrs {
+			cidr, err := ip.ParseCIDROrIP(a)
+			if err != nil {
+				log.WithError(err).WithField("addr", a).Warn("Ignoring unparsable endpoint address")
+				continue
+			}
+			out = append(out, cidr.Addr())
+		}
+	}
+	return
+}
+
+// Endpoints returns all the endpoints, sorted by key.
+func (s *Simulator) Endpoints() []*Endpoint {
+	eps := make([]*Endpoint, 0, len(s.endpoints))
+	for _, ep := range s.endpoints {
+		eps = append(eps, ep)
+	}
+	sort.Slice(eps, func(i, j int) bool {
+		return eps[i].Key.String() < eps[j].Key.String()
+	})
+	return eps
+}
+
+// EndpointByAddr returns the endpoint with the given address, or nil if there isn't one.
+func (s *Simulator) EndpointByAddr(addr ip.Addr) *Endpoint {
+	if s.endpointsByAddr == nil {
+		s.endpointsByAddr = map[ip.Addr]*Endpoint{}
+		for _, ep := range s.Endpoints() {
+			for _, a := range ep.Addrs {
+				if _, ok := s.endpointsByAddr[a]; !ok {
+					s.endpointsByAddr[a] = ep
+				}
+			}
+		}
+	}
+	return s.endpointsByAddr[addr]
+}
+
+// Policy returns the active policy with the given ID, as Felix's dataplane sees it, or nil if
+// the policy doesn't apply to any endpoint.
+func (s *Simulator) Policy(id proto.PolicyID) *proto.Policy {
+	return s.policies[id]
+}
+
+// EndpointsSelectedBy returns the endpoints that the given policy applies to, sorted by key.
+func (s *Simulator) EndpointsSelectedBy(id proto.PolicyID) []*Endpoint {
+	var eps []*Endpoint
+	for _, ep := range s.Endpoints() {
+		if ep.hasPolicy(id) {
+			eps = append(eps, ep)
+		}
+	}
+	return eps
+}
+
+// IsHostEndpoint returns true if the endpoint is a host endpoint.
+func (ep *Endpoint) IsHostEndpoint() bool {
+	_, ok := ep.Key.(model.HostEndpointKey)
+	return ok
+}
+
+// HostEndpointFor returns the host endpoint that polices the given host's traffic to and from
+// other hosts: its all-interfaces host endpoint if it has one, otherwise the first of its
+// host endpoints by name.  It returns nil if the host has no host endpoints.
+func (s *Simulator) HostEndpointFor(hostname string) *Endpoint {
+	var hep *Endpoint
+	for _, ep := range s.Endpoints() {
+		if !ep.IsHostEndpoint() || ep.Hostname != hostname {
+			continue
+		}
+		if ep.InterfaceName == "*" {
+			return ep
+		}
+		if hep == nil {
+			hep = ep
+		}
+	}
+	return hep
+}
+
+func (ep *Endpoint) hasPolicy(id proto.PolicyID) bool {
+	for _, tiers := range [][]*proto.TierInfo{ep.Tiers, ep.UntrackedTiers, ep.PreDNATTiers, ep.ForwardTiers} {
+		for _, tier := range tiers {
+			if tier.Name != id.Tier {
+				continue
+			}
+			for _, names := range [][]string{tier.IngressPolicies, tier.EgressPolicies} {
+				for _, name := range names {
+					if name == id.Name {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// ipSet holds the members of one of the dataplane's IP sets.
+type ipSet struct {
+	setType proto.IPSetUpdate_IPSetType
+	members set.Set
+
+	// nets caches the parsed members of a NET IP set; it is nil if it needs to be recalculated.
+	nets []*net.IPNet
+}
+
+func newIPSet(setType proto.IPSetUpdate_IPSetType, members []string) *ipSet {
+	return &ipSet{
+		setType: setType,
+		members: set.FromArray(members),
+	}
+}
+
+func (s *ipSet) applyDelta(added, removed []string) {
+	for _, m := range removed {
+		s.members.Discard(m)
+	}
+	for _, m := range added {
+		s.members.Add(m)
+	}
+	s.nets = nil
+}
+
+// containsAddr returns true if the IP set contains the given address, either explicitly or as
+// part of one of its CIDRs.
+func (s *ipSet) containsAddr(addr ip.Addr) bool {
+	switch s.setType {
+	case proto.IPSetUpdate_IP, proto.IPSetUpdate_NET:
+	default:
+		return false
+	}
+	if s.members.Contains(addr.AsCIDR().String()) || s.members.Contains(addr.String()) {
+		return true
+	}
+	if s.nets == nil {
+		s.nets = make([]*net.IPNet, 0, s.members.Len())
+		s.members.Iter(func(item interface{}) error {
+			if cidr, err := ip.ParseCIDROrIP(item.(string)); err == nil {
+				ipNet := cidr.ToIPNet()
+				s.nets = append(s.nets, &ipNet)
+			}
+			return nil
+		})
+	}
+	for _, n := range s.nets {
+		if n.Contains(addr.AsNetIP()) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsAddrAndPort returns true if the IP set contains the given address, protocol and port.
+func (s *ipSet) containsAddrAndPort(addr ip.Addr, protocolName string, port uint16) bool {
+	if s.setType != proto.IPSetUpdate_IP_AND_PORT || protocolName == "" {
+		return false
+	}
+	return s.members.Contains(fmt.Sprintf("%s,%s:%d", addr, protocolName, port))
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policysim_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/api/pkg/lib/numorstring"
+
+	"github.com/projectcalico/calico/felix/ip"
+	"github.com/projectcalico/calico/felix/policysim"
+	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+var _ = Describe("Simulator", func() {
+	var sim *policysim.Simulator
+
+	tcp := numorstring.ProtocolFromStringV1("tcp")
+	wepKey := func(host, name string) model.WorkloadEndpointKey {
+		return model.WorkloadEndpointKey{
+			Hostname:       host,
+			OrchestratorID: "k8s",
+			WorkloadID:     "ns1/" + name,
+			EndpointID:     "eth0",
+		}
+	}
+	wep := func(addr, app string) *model.WorkloadEndpoint {
+		return &model.WorkloadEndpoint{
+			State:      "active",
+			Name:       "cali" + app,
+			IPv4Nets:   []net.IPNet{net.MustParseCIDR(addr + "/32")},
+			Labels:     map[string]string{"app": app},
+			ProfileIDs: []string{"kns.ns1"},
+			Ports:      []model.EndpointPort{{Name: "http", Protocol: tcp, Port: 8080}},
+		}
+	}
+	update := func(key model.Key, value interface{}) {
+		sim.OnUpdates([]api.Update{{KVPair: model.KVPair{Key: key, Value: value}, UpdateType: api.UpdateTypeKVNew}})
+	}
+	tcpPacket := func(src, dst string, port uint16) policysim.Packet {
+		return policysim.Packet{
+			SrcAddr:  ip.FromString(src),
+			DstAddr:  ip.FromString(dst),
+			Protocol: 6,
+			SrcPort:  40000,
+			DstPort:  port,
+		}
+	}
+
+	allowFromClientPolicy := &model.Policy{
+		Namespace: "ns1",
+		Selector:  "app == 'server'",
+		Types:     []string{"ingress"},
+		InboundRules: []model.Rule{{
+			Action:      "allow",
+			Protocol:    &tcp,
+			SrcSelector: "app == 'client'",
+			DstPorts:    []numorstring.Port{numorstring.NamedPort("http")},
+		}},
+	}
+	policyKey := model.PolicyKey{Tier: "default", Name: "ns1/default.allow-client"}
+
+	BeforeEach(func() {
+		sim = policysim.New()
+		profileKey := model.ProfileKey{Name: "kns.ns1"}
+		update(model.ProfileRulesKey{ProfileKey: profileKey}, &model.ProfileRules{
+			InboundRules:  []model.Rule{{Action: "allow"}},
+			OutboundRules: []model.Rule{{Action: "allow"}},
+		})
+		update(model.ProfileLabelsKey{ProfileKey: profileKey}, map[string]string{
+			"pcns.projectcalico.org/name": "ns1",
+		})
+		update(wepKey("host1", "client"), wep("10.65.0.1", "client"))
+		update(wepKey("host2", "server"), wep("10.65.0.2", "server"))
+		update(wepKey("host2", "other"), wep("10.65.0.3", "other"))
+	})
+
+	It("should find endpoints by address", func() {
+		Expect(sim.Endpoints()).To(HaveLen(3))
+		ep := sim.EndpointByAddr(ip.FromString("10.65.0.2"))
+		Expect(ep).NotTo(BeNil())
+		Expect(ep.Key).To(Equal(wepKey("host2", "server")))
+		Expect(ep.Ports).To(Equal([]model.EndpointPort{{Name: "http", Protocol: tcp, Port: 8080}}))
+		Expect(sim.EndpointByAddr(ip.FromString("10.65.0.4"))).To(BeNil())
+	})
+
+	It("should allow traffic through the profiles when there is no policy", func() {
+		result := sim.EvaluateFlow(tcpPacket("10.65.0.1", "10.65.0.2", 8080))
+		Expect(result.Allowed()).To(BeTrue())
+		Expect(result.Egress.Profile).To(Equal("kns.ns1"))
+		Expect(result.Ingress.Profile).To(Equal("kns.ns1"))
+	})
+
+	Describe("with a policy that allows the client to the server's named port", func() {
+		BeforeEach(func() {
+			update(policyKey, allowFromClientPolicy)
+		})
+
+		It("should select the server", func() {
+			eps := sim.EndpointsSelectedBy(proto.PolicyID{Tier: "default", Name: policyKey.Name})
+			Expect(eps).To(HaveLen(1))
+			Expect(eps[0].Key).To(Equal(wepKey("host2", "server")))
+		})
+
+		It("should allow the client to the named port", func() {
+			result := sim.EvaluateFlow(tcpPacket("10.65.0.1", "10.65.0.2", 8080))
+			Expect(result.Allowed()).To(BeTrue())
+			Expect(*result.Ingress).To(Equal(policysim.Verdict{
+				Action:    policysim.ActionAllow,
+				Tier:      "default",
+				Policy:    policyKey.Name,
+				RuleIndex: 0,
+				Reason:    "allowed by rule 0 of policy ns1/default.allow-client in tier default",
+			}))
+		})
+
+		It("should deny other ports and sources at the end of the tier", func() {
+			for _, pkt := range []policysim.Packet{
+				tcpPacket("10.65.0.1", "10.65.0.2", 9090),
+				tcpPacket("10.65.0.3", "10.65.0.2", 8080),
+				tcpPacket("192.168.0.1", "10.65.0.2", 8080),
+			} {
+				result := sim.EvaluateFlow(pkt)
+				Expect(result.Allowed()).To(BeFalse(), pkt.String())
+				Expect(result.Decision().Tier).To(Equal("default"))
+				Expect(result.Decision().RuleIndex).To(Equal(-1))
+			}
+		})
+
+		It("should leave the server's egress traffic to its profile", func() {
+			result := sim.EvaluateFlow(tcpPacket("10.65.0.2", "10.65.0.3", 8080))
+			Expect(result.Allowed()).To(BeTrue())
+			Expect(result.Egress.Profile).To(Equal("kns.ns1"))
+		})
+
+		It("should stop selecting an endpoint when its labels change", func() {
+			server := wep("10.65.0.2", "server")
+			server.Labels["app"] = "backend"
+			update(wepKey("host2", "server"), server)
+			Expect(sim.EndpointsSelectedBy(proto.PolicyID{Tier: "default", Name: policyKey.Name})).To(BeEmpty())
+			Expect(sim.EvaluateFlow(tcpPacket("10.65.0.3", "10.65.0.2", 8080)).Allowed()).To(BeTrue())
+		})
+	})
+
+	It("should ignore staged policies", func() {
+		update(model.PolicyKey{Tier: "default", Name: "ns1/staged:default.allow-client"}, allowFromClientPolicy)
+		result := sim.EvaluateFlow(tcpPacket("10.65.0.3", "10.65.0.2", 8080))
+		Expect(result.Allowed()).To(BeTrue())
+		Expect(result.Ingress.Profile).To(Equal("kns.ns1"))
+	})
+
+	It("should fall through a tier with a pass default action", func() {
+		order := 10.0
+		update(model.TierKey{Name: "security"}, &model.Tier{Order: &order, DefaultAction: "Pass"})
+		update(model.PolicyKey{Tier: "security", Name: "security.deny-other"}, &model.Policy{
+			Selector: "app == 'server'",
+			Types:    []string{"ingress"},
+			InboundRules: []model.Rule{{
+				Action:      "deny",
+				SrcSelector: "app == 'other'",
+			}},
+		})
+		update(policyKey, allowFromClientPolicy)
+
+		result := sim.EvaluateFlow(tcpPacket("10.65.0.3", "10.65.0.2", 8080))
+		Expect(result.Allowed()).To(BeFalse())
+		Expect(result.Decision().Policy).To(Equal("security.deny-other"))
+
+		result = sim.EvaluateFlow(tcpPacket("10.65.0.1", "10.65.0.2", 8080))
+		Expect(result.Allowed()).To(BeTrue())
+		Expect(result.Decision().Policy).To(Equal(policyKey.Name))
+	})
+})
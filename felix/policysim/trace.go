@@ -0,0 +1,160 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policysim
+
+// TraceStep is the evaluation of one of an endpoint's policy chains.
+type TraceStep struct {
+	Endpoint  *Endpoint
+	Chain     Chain
+	Direction Direction
+	Verdict   Verdict
+}
+
+// Trace records the policy chains that a packet traverses on its way from its source to its
+// destination.
+type Trace struct {
+	// Src and Dst are the endpoints that own the packet's addresses, or nil if the address
+	// doesn't belong to an endpoint.
+	Src *Endpoint
+	Dst *Endpoint
+
+	// Egress holds the chains evaluated on the source host and Ingress holds those evaluated
+	// on the destination host, in order.  Evaluation stops at the first chain that denies
+	// the packet.
+	Egress  []TraceStep
+	Ingress []TraceStep
+}
+
+// Allowed returns true if the packet reaches its destination.
+func (t Trace) Allowed() bool {
+	for _, steps := range [][]TraceStep{t.Egress, t.Ingress} {
+		for _, step := range steps {
+			if step.Verdict.Action == ActionDeny {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// EgressDecision returns the step that decided the packet on the source host, or nil if no
+// chain there decided it.
+func (t Trace) EgressDecision() *TraceStep {
+	return decidingStep(t.Egress)
+}
+
+// IngressDecision returns the step that decided the packet on the destination host, or nil
+// if no chain there decided it.
+func (t Trace) IngressDecision() *TraceStep {
+	return decidingStep(t.Ingress)
+}
+
+// decidingStep returns the step that denied the packet, if any, otherwise the last step that
+// allowed it.
+func decidingStep(steps []TraceStep) *TraceStep {
+	var deciding *TraceStep
+	for i := range steps {
+		switch steps[i].Verdict.Action {
+		case ActionDeny:
+			return &steps[i]
+		case ActionAllow:
+			deciding = &steps[i]
+		}
+	}
+	return deciding
+}
+
+// TracePacket follows the packet through the policy chains that Felix would apply to it:
+//
+//   - On the source host, a workload's egress policy followed by the applyOnForward egress
+//     policy of the host endpoint that the packet leaves through, or, for traffic from a
+//     host, the host endpoint's untracked and then normal egress policy.
+//   - On the destination host, the untracked, preDNAT and then applyOnForward (for a
+//     workload) or normal (for the host) ingress policy of the host endpoint that the packet
+//     arrives through, followed by a workload's ingress policy.
+//
+// Host endpoint policy doesn't apply to traffic between endpoints on the same host.  An
+// untracked or preDNAT policy that allows the packet skips the host endpoint's later chains,
+// but not the destination workload's policy.  Failsafe rules are not simulated.
+func (s *Simulator) TracePacket(pkt Packet) Trace {
+	t := Trace{
+		Src: s.EndpointByAddr(pkt.SrcAddr),
+		Dst: s.EndpointByAddr(pkt.DstAddr),
+	}
+	sameHost := t.Src != nil && t.Dst != nil && t.Src.Hostname == t.Dst.Hostname
+
+	// evaluate appends the verdict of the chain to the steps and returns it.
+	evaluate := func(steps *[]TraceStep, ep *Endpoint, chain Chain, dir Direction) Action {
+		v := s.EvaluateChain(ep, chain, dir, pkt)
+		*steps = append(*steps, TraceStep{Endpoint: ep, Chain: chain, Direction: dir, Verdict: v})
+		return v.Action
+	}
+
+	if src := t.Src; src != nil {
+		if src.IsHostEndpoint() {
+			if !sameHost {
+				action := evaluate(&t.Egress, src, ChainUntracked, Egress)
+				if action == ActionDeny {
+					return t
+				}
+				if action != ActionAllow && evaluate(&t.Egress, src, ChainNormal, Egress) == ActionDeny {
+					return t
+				}
+			}
+		} else {
+			if evaluate(&t.Egress, src, ChainNormal, Egress) == ActionDeny {
+				return t
+			}
+			if hep := s.HostEndpointFor(src.Hostname); hep != nil && !sameHost {
+				if evaluate(&t.Egress, hep, ChainForward, Egress) == ActionDeny {
+					return t
+				}
+			}
+		}
+	}
+
+	if dst := t.Dst; dst != nil {
+		hep := dst
+		if !dst.IsHostEndpoint() {
+			hep = s.HostEndpointFor(dst.Hostname)
+		}
+		accepted := false
+		if hep != nil && !sameHost {
+			for _, chain := range []Chain{ChainUntracked, ChainPreDNAT} {
+				action := evaluate(&t.Ingress, hep, chain, Ingress)
+				if action == ActionDeny {
+					return t
+				}
+				if action == ActionAllow {
+					accepted = true
+					break
+				}
+			}
+			if !accepted {
+				chain := ChainForward
+				if dst.IsHostEndpoint() {
+					chain = ChainNormal
+				}
+				if evaluate(&t.Ingress, hep, chain, Ingress) == ActionDeny {
+					return t
+				}
+			}
+		}
+		if !dst.IsHostEndpoint() {
+			evaluate(&t.Ingress, dst, ChainNormal, Ingress)
+		}
+	}
+	return t
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policysim_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/api/pkg/lib/numorstring"
+
+	"github.com/projectcalico/calico/felix/ip"
+	"github.com/projectcalico/calico/felix/policysim"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+var _ = Describe("TracePacket", func() {
+	var sim *policysim.Simulator
+
+	update := func(key model.Key, value interface{}) {
+		sim.OnUpdates([]api.Update{{KVPair: model.KVPair{Key: key, Value: value}, UpdateType: api.UpdateTypeKVNew}})
+	}
+	addWorkload := func(host, name, addr string) {
+		update(model.WorkloadEndpointKey{
+			Hostname:       host,
+			OrchestratorID: "k8s",
+			WorkloadID:     "ns1/" + name,
+			EndpointID:     "eth0",
+		}, &model.WorkloadEndpoint{
+			State:      "active",
+			Name:       "cali" + name,
+			IPv4Nets:   []net.IPNet{net.MustParseCIDR(addr + "/32")},
+			Labels:     map[string]string{"app": name},
+			ProfileIDs: []string{"kns.ns1"},
+		})
+	}
+	addHostEndpoint := func(host, addr string) {
+		update(model.HostEndpointKey{Hostname: host, EndpointID: host + "-all"}, &model.HostEndpoint{
+			Name:              "*",
+			ExpectedIPv4Addrs: []net.IP{net.MustParseIP(addr)},
+			Labels:            map[string]string{"host": host},
+		})
+	}
+	tcpPacket := func(src, dst string, port uint16) policysim.Packet {
+		return policysim.Packet{
+			SrcAddr:  ip.FromString(src),
+			DstAddr:  ip.FromString(dst),
+			Protocol: 6,
+			SrcPort:  40000,
+			DstPort:  port,
+		}
+	}
+	chains := func(steps []policysim.TraceStep) []policysim.Chain {
+		var out []policysim.Chain
+		for _, s := range steps {
+			out = append(out, s.Chain)
+		}
+		return out
+	}
+
+	BeforeEach(func() {
+		sim = policysim.New()
+		profileKey := model.ProfileKey{Name: "kns.ns1"}
+		update(model.ProfileRulesKey{ProfileKey: profileKey}, &model.ProfileRules{
+			InboundRules:  []model.Rule{{Action: "allow"}},
+			OutboundRules: []model.Rule{{Action: "allow"}},
+		})
+		addWorkload("host1", "client", "10.65.0.1")
+		addWorkload("host1", "cache", "10.65.0.3")
+		addWorkload("host2", "server", "10.65.1.1")
+	})
+
+	It("should only evaluate the workloads' policy when there are no host endpoints", func() {
+		t := sim.TracePacket(tcpPacket("10.65.0.1", "10.65.1.1", 8080))
+		Expect(t.Allowed()).To(BeTrue())
+		Expect(chains(t.Egress)).To(Equal([]policysim.Chain{policysim.ChainNormal}))
+		Expect(chains(t.Ingress)).To(Equal([]policysim.Chain{policysim.ChainNormal}))
+		Expect(t.IngressDecision().Endpoint).To(Equal(t.Dst))
+		Expect(t.IngressDecision().Verdict.Profile).To(Equal("kns.ns1"))
+	})
+
+	Describe("with all-interfaces host endpoints", func() {
+		BeforeEach(func() {
+			addHostEndpoint("host1", "192.168.0.1")
+			addHostEndpoint("host2", "192.168.0.2")
+		})
+
+		It("should find the host endpoints", func() {
+			hep := sim.HostEndpointFor("host2")
+			Expect(hep).NotTo(BeNil())
+			Expect(hep.InterfaceName).To(Equal("*"))
+			Expect(hep.IsHostEndpoint()).To(BeTrue())
+			Expect(sim.HostEndpointFor("host3")).To(BeNil())
+		})
+
+		It("should allow forwarded traffic when no applyOnForward policy applies", func() {
+			t := sim.TracePacket(tcpPacket("10.65.0.1", "10.65.1.1", 8080))
+			Expect(t.Allowed()).To(BeTrue())
+			Expect(chains(t.Egress)).To(Equal([]policysim.Chain{policysim.ChainNormal, policysim.ChainForward}))
+			Expect(chains(t.Ingress)).To(Equal([]policysim.Chain{
+				policysim.ChainUntracked, policysim.ChainPreDNAT, policysim.ChainForward, policysim.ChainNormal,
+			}))
+		})
+
+		It("should not apply host endpoint policy between workloads on the same host", func() {
+			t := sim.TracePacket(tcpPacket("10.65.0.1", "10.65.0.3", 8080))
+			Expect(t.Allowed()).To(BeTrue())
+			Expect(chains(t.Egress)).To(Equal([]policysim.Chain{policysim.ChainNormal}))
+			Expect(chains(t.Ingress)).To(Equal([]policysim.Chain{policysim.ChainNormal}))
+		})
+
+		It("should deny traffic to a host that has no policy or profile", func() {
+			t := sim.TracePacket(tcpPacket("10.65.0.1", "192.168.0.2", 22))
+			Expect(t.Allowed()).To(BeFalse())
+			Expect(t.IngressDecision().Chain).To(Equal(policysim.ChainNormal))
+			Expect(t.IngressDecision().Endpoint.IsHostEndpoint()).To(BeTrue())
+		})
+
+		It("should deny traffic with a preDNAT policy", func() {
+			update(model.PolicyKey{Tier: "default", Name: "default.block-client"}, &model.Policy{
+				Selector:       "has(host)",
+				Types:          []string{"ingress"},
+				PreDNAT:        true,
+				ApplyOnForward: true,
+				InboundRules: []model.Rule{{
+					Action:  "deny",
+					SrcNets: []*net.IPNet{mustParseNet("10.65.0.1/32")},
+				}},
+			})
+			t := sim.TracePacket(tcpPacket("10.65.0.1", "10.65.1.1", 8080))
+			Expect(t.Allowed()).To(BeFalse())
+			Expect(t.IngressDecision().Chain).To(Equal(policysim.ChainPreDNAT))
+			Expect(t.IngressDecision().Verdict.Policy).To(Equal("default.block-client"))
+			Expect(chains(t.Ingress)).To(Equal([]policysim.Chain{policysim.ChainUntracked, policysim.ChainPreDNAT}))
+		})
+
+		It("should let an untracked allow skip the host endpoint's later chains", func() {
+			update(model.PolicyKey{Tier: "default", Name: "default.untracked"}, &model.Policy{
+				Selector:       "has(host)",
+				Types:          []string{"ingress"},
+				DoNotTrack:     true,
+				ApplyOnForward: true,
+				InboundRules:   []model.Rule{{Action: "allow"}},
+			})
+			t := sim.TracePacket(tcpPacket("10.65.0.1", "10.65.1.1", 8080))
+			Expect(t.Allowed()).To(BeTrue())
+			Expect(chains(t.Ingress)).To(Equal([]policysim.Chain{policysim.ChainUntracked, policysim.ChainNormal}))
+		})
+
+		It("should enforce applyOnForward policy on forwarded traffic", func() {
+			tcp := numorstring.ProtocolFromStringV1("tcp")
+			update(model.PolicyKey{Tier: "default", Name: "default.forward-web"}, &model.Policy{
+				Selector:       "has(host)",
+				Types:          []string{"egress"},
+				ApplyOnForward: true,
+				OutboundRules: []model.Rule{{
+					Action:   "allow",
+					Protocol: &tcp,
+					DstPorts: []numorstring.Port{numorstring.SinglePort(80)},
+				}},
+			})
+			Expect(sim.TracePacket(tcpPacket("10.65.0.1", "10.65.1.1", 80)).Allowed()).To(BeTrue())
+
+			t := sim.TracePacket(tcpPacket("10.65.0.1", "10.65.1.1", 8080))
+			Expect(t.Allowed()).To(BeFalse())
+			Expect(t.EgressDecision().Chain).To(Equal(policysim.ChainForward))
+			Expect(t.EgressDecision().Verdict.Tier).To(Equal("default"))
+			Expect(t.EgressDecision().Verdict.RuleIndex).To(Equal(-1))
+			Expect(t.Ingress).To(BeEmpty())
+		})
+	})
+})
+
+func mustParseNet(cidr string) *net.IPNet {
+	n := net.MustParseCIDR(cidr)
+	return &n
+}
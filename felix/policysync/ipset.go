@@ -26,9 +26,19 @@ import (
 
 type ipSetInfo struct {
 	ipsets.IPSetMetadata
+	// domains is true for domain IP sets, whose members are domain names rather than
+	// IPs.  Those are passed through to the client as they are.
+	domains bool
 	members set.Set
 }
 
+// domainMember is the member type of a domain IP set.
+type domainMember string
+
+func (d domainMember) String() string {
+	return string(d)
+}
+
 func newIPSet(update *proto.IPSetUpdate) *ipSetInfo {
 	s := &ipSetInfo{}
 
@@ -39,6 +49,8 @@ func newIPSet(update *proto.IPSetUpdate) *ipSetInfo {
 		s.Type = ipsets.IPSetTypeHashIPPort
 	case proto.IPSetUpdate_NET:
 		s.Type = ipsets.IPSetTypeHashNet
+	case proto.IPSetUpdate_DOMAIN:
+		s.domains = true
 	default:
 		log.WithField("IPSetType", update.GetType()).Panic("unknown IPSetType")
 	}
@@ -54,19 +66,26 @@ func newIPSet(update *proto.IPSetUpdate) *ipSetInfo {
 func (s *ipSetInfo) replaceMembers(update *proto.IPSetUpdate) {
 	s.members = set.New()
 	for _, ms := range update.GetMembers() {
-		s.members.Add(s.Type.CanonicaliseMember(ms))
+		s.members.Add(s.canonicaliseMember(ms))
 	}
 }
 
 func (s *ipSetInfo) deltaUpdate(update *proto.IPSetDeltaUpdate) {
 	for _, ms := range update.GetAddedMembers() {
-		s.members.Add(s.Type.CanonicaliseMember(ms))
+		s.members.Add(s.canonicaliseMember(ms))
 	}
 	for _, ms := range update.GetRemovedMembers() {
-		s.members.Discard(s.Type.CanonicaliseMember(ms))
+		s.members.Discard(s.canonicaliseMember(ms))
 	}
 }
 
+func (s *ipSetInfo) canonicaliseMember(member string) interface{} {
+	if s.domains {
+		return domainMember(member)
+	}
+	return s.Type.CanonicaliseMember(member)
+}
+
 func (s *ipSetInfo) getIPSetUpdate() *proto.IPSetUpdate {
 	u := &proto.IPSetUpdate{Id: s.SetID, Type: s.getProtoType()}
 	s.members.Iter(func(item interface{}) error {
@@ -78,6 +97,9 @@ func (s *ipSetInfo) getIPSetUpdate() *proto.IPSetUpdate {
 }
 
 func (s *ipSetInfo) getProtoType() proto.IPSetUpdate_IPSetType {
+	if s.domains {
+		return proto.IPSetUpdate_DOMAIN
+	}
 	switch s.Type {
 	case ipsets.IPSetTypeHashIP:
 		return proto.IPSetUpdate_IP
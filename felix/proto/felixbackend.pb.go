@@ -68,6 +68,18 @@
 		WireguardEndpointUpdate
 		WireguardEndpointRemove
 		GlobalBGPConfigUpdate
+		QoSControls
+		PacketCaptureID
+		PacketCaptureUpdate
+		PacketCaptureEndpoint
+		PacketCaptureRemove
+		PacketCaptureStatusUpdate
+		PacketCaptureEndpointStatus
+		PacketCaptureFile
+		WireguardEndpointV6Update
+		WireguardEndpointV6Remove
+		HostMetadataV6Update
+		HostMetadataV6Remove
 */
 package proto
 
@@ -193,17 +205,20 @@ const (
 	IPSetUpdate_IP          IPSetUpdate_IPSetType = 0
 	IPSetUpdate_IP_AND_PORT IPSetUpdate_IPSetType = 1
 	IPSetUpdate_NET         IPSetUpdate_IPSetType = 2
+	IPSetUpdate_DOMAIN      IPSetUpdate_IPSetType = 3
 )
 
 var IPSetUpdate_IPSetType_name = map[int32]string{
 	0: "IP",
 	1: "IP_AND_PORT",
 	2: "NET",
+	3: "DOMAIN",
 }
 var IPSetUpdate_IPSetType_value = map[string]int32{
 	"IP":          0,
 	"IP_AND_PORT": 1,
 	"NET":         2,
+	"DOMAIN":      3,
 }
 
 func (x IPSetUpdate_IPSetType) String() string {
@@ -255,6 +270,12 @@ type ToDataplane struct {
 	//	*ToDataplane_WireguardEndpointRemove
 	//	*ToDataplane_GlobalBgpConfigUpdate
 	//	*ToDataplane_Encapsulation
+	//	*ToDataplane_PacketCaptureUpdate
+	//	*ToDataplane_PacketCaptureRemove
+	//	*ToDataplane_WireguardEndpointV6Update
+	//	*ToDataplane_WireguardEndpointV6Remove
+	//	*ToDataplane_HostMetadataV6Update
+	//	*ToDataplane_HostMetadataV6Remove
 	Payload isToDataplane_Payload `protobuf_oneof:"payload"`
 }
 
@@ -356,36 +377,60 @@ type ToDataplane_GlobalBgpConfigUpdate struct {
 type ToDataplane_Encapsulation struct {
 	Encapsulation *Encapsulation `protobuf:"bytes,30,opt,name=encapsulation,oneof"`
 }
-
-func (*ToDataplane_InSync) isToDataplane_Payload()                  {}
-func (*ToDataplane_IpsetUpdate) isToDataplane_Payload()             {}
-func (*ToDataplane_IpsetDeltaUpdate) isToDataplane_Payload()        {}
-func (*ToDataplane_IpsetRemove) isToDataplane_Payload()             {}
-func (*ToDataplane_ActiveProfileUpdate) isToDataplane_Payload()     {}
-func (*ToDataplane_ActiveProfileRemove) isToDataplane_Payload()     {}
-func (*ToDataplane_ActivePolicyUpdate) isToDataplane_Payload()      {}
-func (*ToDataplane_ActivePolicyRemove) isToDataplane_Payload()      {}
-func (*ToDataplane_HostEndpointUpdate) isToDataplane_Payload()      {}
-func (*ToDataplane_HostEndpointRemove) isToDataplane_Payload()      {}
-func (*ToDataplane_WorkloadEndpointUpdate) isToDataplane_Payload()  {}
-func (*ToDataplane_WorkloadEndpointRemove) isToDataplane_Payload()  {}
-func (*ToDataplane_ConfigUpdate) isToDataplane_Payload()            {}
-func (*ToDataplane_HostMetadataUpdate) isToDataplane_Payload()      {}
-func (*ToDataplane_HostMetadataRemove) isToDataplane_Payload()      {}
-func (*ToDataplane_IpamPoolUpdate) isToDataplane_Payload()          {}
-func (*ToDataplane_IpamPoolRemove) isToDataplane_Payload()          {}
-func (*ToDataplane_ServiceAccountUpdate) isToDataplane_Payload()    {}
-func (*ToDataplane_ServiceAccountRemove) isToDataplane_Payload()    {}
-func (*ToDataplane_NamespaceUpdate) isToDataplane_Payload()         {}
-func (*ToDataplane_NamespaceRemove) isToDataplane_Payload()         {}
-func (*ToDataplane_RouteUpdate) isToDataplane_Payload()             {}
-func (*ToDataplane_RouteRemove) isToDataplane_Payload()             {}
-func (*ToDataplane_VtepUpdate) isToDataplane_Payload()              {}
-func (*ToDataplane_VtepRemove) isToDataplane_Payload()              {}
-func (*ToDataplane_WireguardEndpointUpdate) isToDataplane_Payload() {}
-func (*ToDataplane_WireguardEndpointRemove) isToDataplane_Payload() {}
-func (*ToDataplane_GlobalBgpConfigUpdate) isToDataplane_Payload()   {}
-func (*ToDataplane_Encapsulation) isToDataplane_Payload()           {}
+type ToDataplane_PacketCaptureUpdate struct {
+	PacketCaptureUpdate *PacketCaptureUpdate `protobuf:"bytes,31,opt,name=packet_capture_update,json=packetCaptureUpdate,oneof"`
+}
+type ToDataplane_PacketCaptureRemove struct {
+	PacketCaptureRemove *PacketCaptureRemove `protobuf:"bytes,32,opt,name=packet_capture_remove,json=packetCaptureRemove,oneof"`
+}
+type ToDataplane_WireguardEndpointV6Update struct {
+	WireguardEndpointV6Update *WireguardEndpointV6Update `protobuf:"bytes,33,opt,name=wireguard_endpoint_v6_update,json=wireguardEndpointV6Update,oneof"`
+}
+type ToDataplane_WireguardEndpointV6Remove struct {
+	WireguardEndpointV6Remove *WireguardEndpointV6Remove `protobuf:"bytes,34,opt,name=wireguard_endpoint_v6_remove,json=wireguardEndpointV6Remove,oneof"`
+}
+type ToDataplane_HostMetadataV6Update struct {
+	HostMetadataV6Update *HostMetadataV6Update `protobuf:"bytes,35,opt,name=host_metadata_v6_update,json=hostMetadataV6Update,oneof"`
+}
+type ToDataplane_HostMetadataV6Remove struct {
+	HostMetadataV6Remove *HostMetadataV6Remove `protobuf:"bytes,36,opt,name=host_metadata_v6_remove,json=hostMetadataV6Remove,oneof"`
+}
+
+func (*ToDataplane_InSync) isToDataplane_Payload()                    {}
+func (*ToDataplane_IpsetUpdate) isToDataplane_Payload()               {}
+func (*ToDataplane_IpsetDeltaUpdate) isToDataplane_Payload()          {}
+func (*ToDataplane_IpsetRemove) isToDataplane_Payload()               {}
+func (*ToDataplane_ActiveProfileUpdate) isToDataplane_Payload()       {}
+func (*ToDataplane_ActiveProfileRemove) isToDataplane_Payload()       {}
+func (*ToDataplane_ActivePolicyUpdate) isToDataplane_Payload()        {}
+func (*ToDataplane_ActivePolicyRemove) isToDataplane_Payload()        {}
+func (*ToDataplane_HostEndpointUpdate) isToDataplane_Payload()        {}
+func (*ToDataplane_HostEndpointRemove) isToDataplane_Payload()        {}
+func (*ToDataplane_WorkloadEndpointUpdate) isToDataplane_Payload()    {}
+func (*ToDataplane_WorkloadEndpointRemove) isToDataplane_Payload()    {}
+func (*ToDataplane_ConfigUpdate) isToDataplane_Payload()              {}
+func (*ToDataplane_HostMetadataUpdate) isToDataplane_Payload()        {}
+func (*ToDataplane_HostMetadataRemove) isToDataplane_Payload()        {}
+func (*ToDataplane_IpamPoolUpdate) isToDataplane_Payload()            {}
+func (*ToDataplane_IpamPoolRemove) isToDataplane_Payload()            {}
+func (*ToDataplane_ServiceAccountUpdate) isToDataplane_Payload()      {}
+func (*ToDataplane_ServiceAccountRemove) isToDataplane_Payload()      {}
+func (*ToDataplane_NamespaceUpdate) isToDataplane_Payload()           {}
+func (*ToDataplane_NamespaceRemove) isToDataplane_Payload()           {}
+func (*ToDataplane_RouteUpdate) isToDataplane_Payload()               {}
+func (*ToDataplane_RouteRemove) isToDataplane_Payload()               {}
+func (*ToDataplane_VtepUpdate) isToDataplane_Payload()                {}
+func (*ToDataplane_VtepRemove) isToDataplane_Payload()                {}
+func (*ToDataplane_WireguardEndpointUpdate) isToDataplane_Payload()   {}
+func (*ToDataplane_WireguardEndpointRemove) isToDataplane_Payload()   {}
+func (*ToDataplane_GlobalBgpConfigUpdate) isToDataplane_Payload()     {}
+func (*ToDataplane_Encapsulation) isToDataplane_Payload()             {}
+func (*ToDataplane_PacketCaptureUpdate) isToDataplane_Payload()       {}
+func (*ToDataplane_PacketCaptureRemove) isToDataplane_Payload()       {}
+func (*ToDataplane_WireguardEndpointV6Update) isToDataplane_Payload() {}
+func (*ToDataplane_WireguardEndpointV6Remove) isToDataplane_Payload() {}
+func (*ToDataplane_HostMetadataV6Update) isToDataplane_Payload()      {}
+func (*ToDataplane_HostMetadataV6Remove) isToDataplane_Payload()      {}
 
 func (m *ToDataplane) GetPayload() isToDataplane_Payload {
 	if m != nil {
@@ -604,6 +649,48 @@ func (m *ToDataplane) GetEncapsulation() *Encapsulation {
 	return nil
 }
 
+func (m *ToDataplane) GetPacketCaptureUpdate() *PacketCaptureUpdate {
+	if x, ok := m.GetPayload().(*ToDataplane_PacketCaptureUpdate); ok {
+		return x.PacketCaptureUpdate
+	}
+	return nil
+}
+
+func (m *ToDataplane) GetPacketCaptureRemove() *PacketCaptureRemove {
+	if x, ok := m.GetPayload().(*ToDataplane_PacketCaptureRemove); ok {
+		return x.PacketCaptureRemove
+	}
+	return nil
+}
+
+func (m *ToDataplane) GetWireguardEndpointV6Update() *WireguardEndpointV6Update {
+	if x, ok := m.GetPayload().(*ToDataplane_WireguardEndpointV6Update); ok {
+		return x.WireguardEndpointV6Update
+	}
+	return nil
+}
+
+func (m *ToDataplane) GetWireguardEndpointV6Remove() *WireguardEndpointV6Remove {
+	if x, ok := m.GetPayload().(*ToDataplane_WireguardEndpointV6Remove); ok {
+		return x.WireguardEndpointV6Remove
+	}
+	return nil
+}
+
+func (m *ToDataplane) GetHostMetadataV6Update() *HostMetadataV6Update {
+	if x, ok := m.GetPayload().(*ToDataplane_HostMetadataV6Update); ok {
+		return x.HostMetadataV6Update
+	}
+	return nil
+}
+
+func (m *ToDataplane) GetHostMetadataV6Remove() *HostMetadataV6Remove {
+	if x, ok := m.GetPayload().(*ToDataplane_HostMetadataV6Remove); ok {
+		return x.HostMetadataV6Remove
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ToDataplane) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.Buffer) error, func(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error), func(msg proto1.Message) (n int), []interface{}) {
 	return _ToDataplane_OneofMarshaler, _ToDataplane_OneofUnmarshaler, _ToDataplane_OneofSizer, []interface{}{
@@ -636,6 +723,12 @@ func (*ToDataplane) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.Buffer)
 		(*ToDataplane_WireguardEndpointRemove)(nil),
 		(*ToDataplane_GlobalBgpConfigUpdate)(nil),
 		(*ToDataplane_Encapsulation)(nil),
+		(*ToDataplane_PacketCaptureUpdate)(nil),
+		(*ToDataplane_PacketCaptureRemove)(nil),
+		(*ToDataplane_WireguardEndpointV6Update)(nil),
+		(*ToDataplane_WireguardEndpointV6Remove)(nil),
+		(*ToDataplane_HostMetadataV6Update)(nil),
+		(*ToDataplane_HostMetadataV6Remove)(nil),
 	}
 }
 
@@ -788,6 +881,36 @@ func _ToDataplane_OneofMarshaler(msg proto1.Message, b *proto1.Buffer) error {
 		if err := b.EncodeMessage(x.Encapsulation); err != nil {
 			return err
 		}
+	case *ToDataplane_PacketCaptureUpdate:
+		_ = b.EncodeVarint(31<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.PacketCaptureUpdate); err != nil {
+			return err
+		}
+	case *ToDataplane_PacketCaptureRemove:
+		_ = b.EncodeVarint(32<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.PacketCaptureRemove); err != nil {
+			return err
+		}
+	case *ToDataplane_WireguardEndpointV6Update:
+		_ = b.EncodeVarint(33<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.WireguardEndpointV6Update); err != nil {
+			return err
+		}
+	case *ToDataplane_WireguardEndpointV6Remove:
+		_ = b.EncodeVarint(34<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.WireguardEndpointV6Remove); err != nil {
+			return err
+		}
+	case *ToDataplane_HostMetadataV6Update:
+		_ = b.EncodeVarint(35<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.HostMetadataV6Update); err != nil {
+			return err
+		}
+	case *ToDataplane_HostMetadataV6Remove:
+		_ = b.EncodeVarint(36<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.HostMetadataV6Remove); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ToDataplane.Payload has unexpected type %T", x)
@@ -1030,6 +1153,54 @@ func _ToDataplane_OneofUnmarshaler(msg proto1.Message, tag, wire int, b *proto1.
 		err := b.DecodeMessage(msg)
 		m.Payload = &ToDataplane_Encapsulation{msg}
 		return true, err
+	case 31: // payload.packet_capture_update
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(PacketCaptureUpdate)
+		err := b.DecodeMessage(msg)
+		m.Payload = &ToDataplane_PacketCaptureUpdate{msg}
+		return true, err
+	case 32: // payload.packet_capture_remove
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(PacketCaptureRemove)
+		err := b.DecodeMessage(msg)
+		m.Payload = &ToDataplane_PacketCaptureRemove{msg}
+		return true, err
+	case 33: // payload.wireguard_endpoint_v6_update
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(WireguardEndpointV6Update)
+		err := b.DecodeMessage(msg)
+		m.Payload = &ToDataplane_WireguardEndpointV6Update{msg}
+		return true, err
+	case 34: // payload.wireguard_endpoint_v6_remove
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(WireguardEndpointV6Remove)
+		err := b.DecodeMessage(msg)
+		m.Payload = &ToDataplane_WireguardEndpointV6Remove{msg}
+		return true, err
+	case 35: // payload.host_metadata_v6_update
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(HostMetadataV6Update)
+		err := b.DecodeMessage(msg)
+		m.Payload = &ToDataplane_HostMetadataV6Update{msg}
+		return true, err
+	case 36: // payload.host_metadata_v6_remove
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(HostMetadataV6Remove)
+		err := b.DecodeMessage(msg)
+		m.Payload = &ToDataplane_HostMetadataV6Remove{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1184,6 +1355,36 @@ func _ToDataplane_OneofSizer(msg proto1.Message) (n int) {
 		n += proto1.SizeVarint(30<<3 | proto1.WireBytes)
 		n += proto1.SizeVarint(uint64(s))
 		n += s
+	case *ToDataplane_PacketCaptureUpdate:
+		s := proto1.Size(x.PacketCaptureUpdate)
+		n += proto1.SizeVarint(31<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *ToDataplane_PacketCaptureRemove:
+		s := proto1.Size(x.PacketCaptureRemove)
+		n += proto1.SizeVarint(32<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *ToDataplane_WireguardEndpointV6Update:
+		s := proto1.Size(x.WireguardEndpointV6Update)
+		n += proto1.SizeVarint(33<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *ToDataplane_WireguardEndpointV6Remove:
+		s := proto1.Size(x.WireguardEndpointV6Remove)
+		n += proto1.SizeVarint(34<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *ToDataplane_HostMetadataV6Update:
+		s := proto1.Size(x.HostMetadataV6Update)
+		n += proto1.SizeVarint(35<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
+	case *ToDataplane_HostMetadataV6Remove:
+		s := proto1.Size(x.HostMetadataV6Remove)
+		n += proto1.SizeVarint(36<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1200,6 +1401,7 @@ type FromDataplane struct {
 	//	*FromDataplane_WorkloadEndpointStatusUpdate
 	//	*FromDataplane_WorkloadEndpointStatusRemove
 	//	*FromDataplane_WireguardStatusUpdate
+	//	*FromDataplane_PacketCaptureStatusUpdate
 	Payload isFromDataplane_Payload `protobuf_oneof:"payload"`
 }
 
@@ -1232,6 +1434,9 @@ type FromDataplane_WorkloadEndpointStatusRemove struct {
 type FromDataplane_WireguardStatusUpdate struct {
 	WireguardStatusUpdate *WireguardStatusUpdate `protobuf:"bytes,9,opt,name=wireguard_status_update,json=wireguardStatusUpdate,oneof"`
 }
+type FromDataplane_PacketCaptureStatusUpdate struct {
+	PacketCaptureStatusUpdate *PacketCaptureStatusUpdate `protobuf:"bytes,10,opt,name=packet_capture_status_update,json=packetCaptureStatusUpdate,oneof"`
+}
 
 func (*FromDataplane_ProcessStatusUpdate) isFromDataplane_Payload()          {}
 func (*FromDataplane_HostEndpointStatusUpdate) isFromDataplane_Payload()     {}
@@ -1239,6 +1444,7 @@ func (*FromDataplane_HostEndpointStatusRemove) isFromDataplane_Payload()     {}
 func (*FromDataplane_WorkloadEndpointStatusUpdate) isFromDataplane_Payload() {}
 func (*FromDataplane_WorkloadEndpointStatusRemove) isFromDataplane_Payload() {}
 func (*FromDataplane_WireguardStatusUpdate) isFromDataplane_Payload()        {}
+func (*FromDataplane_PacketCaptureStatusUpdate) isFromDataplane_Payload()    {}
 
 func (m *FromDataplane) GetPayload() isFromDataplane_Payload {
 	if m != nil {
@@ -1296,6 +1502,13 @@ func (m *FromDataplane) GetWireguardStatusUpdate() *WireguardStatusUpdate {
 	return nil
 }
 
+func (m *FromDataplane) GetPacketCaptureStatusUpdate() *PacketCaptureStatusUpdate {
+	if x, ok := m.GetPayload().(*FromDataplane_PacketCaptureStatusUpdate); ok {
+		return x.PacketCaptureStatusUpdate
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*FromDataplane) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.Buffer) error, func(msg proto1.Message, tag, wire int, b *proto1.Buffer) (bool, error), func(msg proto1.Message) (n int), []interface{}) {
 	return _FromDataplane_OneofMarshaler, _FromDataplane_OneofUnmarshaler, _FromDataplane_OneofSizer, []interface{}{
@@ -1305,6 +1518,7 @@ func (*FromDataplane) XXX_OneofFuncs() (func(msg proto1.Message, b *proto1.Buffe
 		(*FromDataplane_WorkloadEndpointStatusUpdate)(nil),
 		(*FromDataplane_WorkloadEndpointStatusRemove)(nil),
 		(*FromDataplane_WireguardStatusUpdate)(nil),
+		(*FromDataplane_PacketCaptureStatusUpdate)(nil),
 	}
 }
 
@@ -1342,6 +1556,11 @@ func _FromDataplane_OneofMarshaler(msg proto1.Message, b *proto1.Buffer) error {
 		if err := b.EncodeMessage(x.WireguardStatusUpdate); err != nil {
 			return err
 		}
+	case *FromDataplane_PacketCaptureStatusUpdate:
+		_ = b.EncodeVarint(10<<3 | proto1.WireBytes)
+		if err := b.EncodeMessage(x.PacketCaptureStatusUpdate); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("FromDataplane.Payload has unexpected type %T", x)
@@ -1400,6 +1619,14 @@ func _FromDataplane_OneofUnmarshaler(msg proto1.Message, tag, wire int, b *proto
 		err := b.DecodeMessage(msg)
 		m.Payload = &FromDataplane_WireguardStatusUpdate{msg}
 		return true, err
+	case 10: // payload.packet_capture_status_update
+		if wire != proto1.WireBytes {
+			return true, proto1.ErrInternalBadWireType
+		}
+		msg := new(PacketCaptureStatusUpdate)
+		err := b.DecodeMessage(msg)
+		m.Payload = &FromDataplane_PacketCaptureStatusUpdate{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1439,6 +1666,11 @@ func _FromDataplane_OneofSizer(msg proto1.Message) (n int) {
 		n += proto1.SizeVarint(9<<3 | proto1.WireBytes)
 		n += proto1.SizeVarint(uint64(s))
 		n += s
+	case *FromDataplane_PacketCaptureStatusUpdate:
+		s := proto1.Size(x.PacketCaptureStatusUpdate)
+		n += proto1.SizeVarint(10<<3 | proto1.WireBytes)
+		n += proto1.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -2648,15 +2880,16 @@ func (m *WorkloadEndpointUpdate) GetEndpoint() *WorkloadEndpoint {
 }
 
 type WorkloadEndpoint struct {
-	State      string      `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	Name       string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Mac        string      `protobuf:"bytes,3,opt,name=mac,proto3" json:"mac,omitempty"`
-	ProfileIds []string    `protobuf:"bytes,4,rep,name=profile_ids,json=profileIds" json:"profile_ids,omitempty"`
-	Ipv4Nets   []string    `protobuf:"bytes,5,rep,name=ipv4_nets,json=ipv4Nets" json:"ipv4_nets,omitempty"`
-	Ipv6Nets   []string    `protobuf:"bytes,6,rep,name=ipv6_nets,json=ipv6Nets" json:"ipv6_nets,omitempty"`
-	Tiers      []*TierInfo `protobuf:"bytes,7,rep,name=tiers" json:"tiers,omitempty"`
-	Ipv4Nat    []*NatInfo  `protobuf:"bytes,8,rep,name=ipv4_nat,json=ipv4Nat" json:"ipv4_nat,omitempty"`
-	Ipv6Nat    []*NatInfo  `protobuf:"bytes,9,rep,name=ipv6_nat,json=ipv6Nat" json:"ipv6_nat,omitempty"`
+	State       string       `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Name        string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Mac         string       `protobuf:"bytes,3,opt,name=mac,proto3" json:"mac,omitempty"`
+	ProfileIds  []string     `protobuf:"bytes,4,rep,name=profile_ids,json=profileIds" json:"profile_ids,omitempty"`
+	Ipv4Nets    []string     `protobuf:"bytes,5,rep,name=ipv4_nets,json=ipv4Nets" json:"ipv4_nets,omitempty"`
+	Ipv6Nets    []string     `protobuf:"bytes,6,rep,name=ipv6_nets,json=ipv6Nets" json:"ipv6_nets,omitempty"`
+	Tiers       []*TierInfo  `protobuf:"bytes,7,rep,name=tiers" json:"tiers,omitempty"`
+	Ipv4Nat     []*NatInfo   `protobuf:"bytes,8,rep,name=ipv4_nat,json=ipv4Nat" json:"ipv4_nat,omitempty"`
+	Ipv6Nat     []*NatInfo   `protobuf:"bytes,9,rep,name=ipv6_nat,json=ipv6Nat" json:"ipv6_nat,omitempty"`
+	QosControls *QoSControls `protobuf:"bytes,10,opt,name=qos_controls,json=qosControls" json:"qos_controls,omitempty"`
 }
 
 func (m *WorkloadEndpoint) Reset()                    { *m = WorkloadEndpoint{} }
@@ -2727,6 +2960,13 @@ func (m *WorkloadEndpoint) GetIpv6Nat() []*NatInfo {
 	return nil
 }
 
+func (m *WorkloadEndpoint) GetQosControls() *QoSControls {
+	if m != nil {
+		return m.QosControls
+	}
+	return nil
+}
+
 type WorkloadEndpointRemove struct {
 	Id *WorkloadEndpointID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 }
@@ -2877,6 +3117,7 @@ type TierInfo struct {
 	Name            string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	IngressPolicies []string `protobuf:"bytes,2,rep,name=ingress_policies,json=ingressPolicies" json:"ingress_policies,omitempty"`
 	EgressPolicies  []string `protobuf:"bytes,3,rep,name=egress_policies,json=egressPolicies" json:"egress_policies,omitempty"`
+	DefaultAction   string   `protobuf:"bytes,4,opt,name=default_action,json=defaultAction,proto3" json:"default_action,omitempty"`
 }
 
 func (m *TierInfo) Reset()                    { *m = TierInfo{} }
@@ -2905,6 +3146,13 @@ func (m *TierInfo) GetEgressPolicies() []string {
 	return nil
 }
 
+func (m *TierInfo) GetDefaultAction() string {
+	if m != nil {
+		return m.DefaultAction
+	}
+	return ""
+}
+
 type NatInfo struct {
 	ExtIp string `protobuf:"bytes,1,opt,name=ext_ip,json=extIp,proto3" json:"ext_ip,omitempty"`
 	IntIp string `protobuf:"bytes,2,opt,name=int_ip,json=intIp,proto3" json:"int_ip,omitempty"`
@@ -3061,7 +3309,10 @@ func (m *WorkloadEndpointStatusRemove) GetId() *WorkloadEndpointID {
 
 type WireguardStatusUpdate struct {
 	// Wireguard public-key set on the interface.
-	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	PublicKey                string    `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	IpVersion                IPVersion `protobuf:"varint,2,opt,name=ip_version,json=ipVersion,proto3,enum=felix.IPVersion" json:"ip_version,omitempty"`
+	PendingPublicKey         string    `protobuf:"bytes,3,opt,name=pending_public_key,json=pendingPublicKey,proto3" json:"pending_public_key,omitempty"`
+	AppliedPendingPublicKeys []string  `protobuf:"bytes,4,rep,name=applied_pending_public_keys,json=appliedPendingPublicKeys" json:"applied_pending_public_keys,omitempty"`
 }
 
 func (m *WireguardStatusUpdate) Reset()         { *m = WireguardStatusUpdate{} }
@@ -3078,6 +3329,27 @@ func (m *WireguardStatusUpdate) GetPublicKey() string {
 	return ""
 }
 
+func (m *WireguardStatusUpdate) GetIpVersion() IPVersion {
+	if m != nil {
+		return m.IpVersion
+	}
+	return IPVersion_ANY
+}
+
+func (m *WireguardStatusUpdate) GetPendingPublicKey() string {
+	if m != nil {
+		return m.PendingPublicKey
+	}
+	return ""
+}
+
+func (m *WireguardStatusUpdate) GetAppliedPendingPublicKeys() []string {
+	if m != nil {
+		return m.AppliedPendingPublicKeys
+	}
+	return nil
+}
+
 type HostMetadataUpdate struct {
 	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
 	Ipv4Addr string `protobuf:"bytes,2,opt,name=ipv4_addr,json=ipv4Addr,proto3" json:"ipv4_addr,omitempty"`
@@ -3191,8 +3463,9 @@ func (m *IPAMPool) GetMasquerade() bool {
 }
 
 type Encapsulation struct {
-	IpipEnabled  bool `protobuf:"varint,1,opt,name=ipip_enabled,json=ipipEnabled,proto3" json:"ipip_enabled,omitempty"`
-	VxlanEnabled bool `protobuf:"varint,2,opt,name=vxlan_enabled,json=vxlanEnabled,proto3" json:"vxlan_enabled,omitempty"`
+	IpipEnabled    bool `protobuf:"varint,1,opt,name=ipip_enabled,json=ipipEnabled,proto3" json:"ipip_enabled,omitempty"`
+	VxlanEnabled   bool `protobuf:"varint,2,opt,name=vxlan_enabled,json=vxlanEnabled,proto3" json:"vxlan_enabled,omitempty"`
+	VxlanEnabledV6 bool `protobuf:"varint,3,opt,name=vxlan_enabled_v6,json=vxlanEnabledV6,proto3" json:"vxlan_enabled_v6,omitempty"`
 }
 
 func (m *Encapsulation) Reset()                    { *m = Encapsulation{} }
@@ -3214,6 +3487,13 @@ func (m *Encapsulation) GetVxlanEnabled() bool {
 	return false
 }
 
+func (m *Encapsulation) GetVxlanEnabledV6() bool {
+	if m != nil {
+		return m.VxlanEnabledV6
+	}
+	return false
+}
+
 type ServiceAccountUpdate struct {
 	Id     *ServiceAccountID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
 	Labels map[string]string `protobuf:"bytes,2,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
@@ -3469,10 +3749,13 @@ func (m *RouteRemove) GetDst() string {
 }
 
 type VXLANTunnelEndpointUpdate struct {
-	Node           string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Mac            string `protobuf:"bytes,2,opt,name=mac,proto3" json:"mac,omitempty"`
-	Ipv4Addr       string `protobuf:"bytes,3,opt,name=ipv4_addr,json=ipv4Addr,proto3" json:"ipv4_addr,omitempty"`
-	ParentDeviceIp string `protobuf:"bytes,4,opt,name=parent_device_ip,json=parentDeviceIp,proto3" json:"parent_device_ip,omitempty"`
+	Node             string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Mac              string `protobuf:"bytes,2,opt,name=mac,proto3" json:"mac,omitempty"`
+	Ipv4Addr         string `protobuf:"bytes,3,opt,name=ipv4_addr,json=ipv4Addr,proto3" json:"ipv4_addr,omitempty"`
+	ParentDeviceIp   string `protobuf:"bytes,4,opt,name=parent_device_ip,json=parentDeviceIp,proto3" json:"parent_device_ip,omitempty"`
+	Ipv6Addr         string `protobuf:"bytes,5,opt,name=ipv6_addr,json=ipv6Addr,proto3" json:"ipv6_addr,omitempty"`
+	ParentDeviceIpv6 string `protobuf:"bytes,6,opt,name=parent_device_ipv6,json=parentDeviceIpv6,proto3" json:"parent_device_ipv6,omitempty"`
+	MacV6            string `protobuf:"bytes,7,opt,name=mac_v6,json=macV6,proto3" json:"mac_v6,omitempty"`
 }
 
 func (m *VXLANTunnelEndpointUpdate) Reset()         { *m = VXLANTunnelEndpointUpdate{} }
@@ -3510,6 +3793,27 @@ func (m *VXLANTunnelEndpointUpdate) GetParentDeviceIp() string {
 	return ""
 }
 
+func (m *VXLANTunnelEndpointUpdate) GetIpv6Addr() string {
+	if m != nil {
+		return m.Ipv6Addr
+	}
+	return ""
+}
+
+func (m *VXLANTunnelEndpointUpdate) GetParentDeviceIpv6() string {
+	if m != nil {
+		return m.ParentDeviceIpv6
+	}
+	return ""
+}
+
+func (m *VXLANTunnelEndpointUpdate) GetMacV6() string {
+	if m != nil {
+		return m.MacV6
+	}
+	return ""
+}
+
 type VXLANTunnelEndpointRemove struct {
 	Node string `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
 }
@@ -3534,7 +3838,9 @@ type WireguardEndpointUpdate struct {
 	// The public key for this endpoint.
 	PublicKey string `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
 	// The IP address of the wireguard interface.
-	InterfaceIpv4Addr string `protobuf:"bytes,3,opt,name=interface_ipv4_addr,json=interfaceIpv4Addr,proto3" json:"interface_ipv4_addr,omitempty"`
+	InterfaceIpv4Addr        string   `protobuf:"bytes,3,opt,name=interface_ipv4_addr,json=interfaceIpv4Addr,proto3" json:"interface_ipv4_addr,omitempty"`
+	PendingPublicKey         string   `protobuf:"bytes,4,opt,name=pending_public_key,json=pendingPublicKey,proto3" json:"pending_public_key,omitempty"`
+	AppliedPendingPublicKeys []string `protobuf:"bytes,5,rep,name=applied_pending_public_keys,json=appliedPendingPublicKeys" json:"applied_pending_public_keys,omitempty"`
 }
 
 func (m *WireguardEndpointUpdate) Reset()         { *m = WireguardEndpointUpdate{} }
@@ -3565,6 +3871,20 @@ func (m *WireguardEndpointUpdate) GetInterfaceIpv4Addr() string {
 	return ""
 }
 
+func (m *WireguardEndpointUpdate) GetPendingPublicKey() string {
+	if m != nil {
+		return m.PendingPublicKey
+	}
+	return ""
+}
+
+func (m *WireguardEndpointUpdate) GetAppliedPendingPublicKeys() []string {
+	if m != nil {
+		return m.AppliedPendingPublicKeys
+	}
+	return nil
+}
+
 type WireguardEndpointRemove struct {
 	// The name of the wireguard host.
 	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
@@ -3618,696 +3938,618 @@ func (m *GlobalBGPConfigUpdate) GetServiceLoadbalancerCidrs() []string {
 	return nil
 }
 
-func init() {
-	proto1.RegisterType((*SyncRequest)(nil), "felix.SyncRequest")
-	proto1.RegisterType((*ToDataplane)(nil), "felix.ToDataplane")
-	proto1.RegisterType((*FromDataplane)(nil), "felix.FromDataplane")
-	proto1.RegisterType((*ConfigUpdate)(nil), "felix.ConfigUpdate")
-	proto1.RegisterType((*InSync)(nil), "felix.InSync")
-	proto1.RegisterType((*IPSetUpdate)(nil), "felix.IPSetUpdate")
-	proto1.RegisterType((*IPSetDeltaUpdate)(nil), "felix.IPSetDeltaUpdate")
-	proto1.RegisterType((*IPSetRemove)(nil), "felix.IPSetRemove")
-	proto1.RegisterType((*ActiveProfileUpdate)(nil), "felix.ActiveProfileUpdate")
-	proto1.RegisterType((*ActiveProfileRemove)(nil), "felix.ActiveProfileRemove")
-	proto1.RegisterType((*ProfileID)(nil), "felix.ProfileID")
-	proto1.RegisterType((*Profile)(nil), "felix.Profile")
-	proto1.RegisterType((*ActivePolicyUpdate)(nil), "felix.ActivePolicyUpdate")
-	proto1.RegisterType((*ActivePolicyRemove)(nil), "felix.ActivePolicyRemove")
-	proto1.RegisterType((*PolicyID)(nil), "felix.PolicyID")
-	proto1.RegisterType((*Policy)(nil), "felix.Policy")
-	proto1.RegisterType((*Rule)(nil), "felix.Rule")
-	proto1.RegisterType((*ServiceAccountMatch)(nil), "felix.ServiceAccountMatch")
-	proto1.RegisterType((*HTTPMatch)(nil), "felix.HTTPMatch")
-	proto1.RegisterType((*HTTPMatch_PathMatch)(nil), "felix.HTTPMatch.PathMatch")
-	proto1.RegisterType((*RuleMetadata)(nil), "felix.RuleMetadata")
-	proto1.RegisterType((*IcmpTypeAndCode)(nil), "felix.IcmpTypeAndCode")
-	proto1.RegisterType((*Protocol)(nil), "felix.Protocol")
-	proto1.RegisterType((*PortRange)(nil), "felix.PortRange")
-	proto1.RegisterType((*WorkloadEndpointID)(nil), "felix.WorkloadEndpointID")
-	proto1.RegisterType((*WorkloadEndpointUpdate)(nil), "felix.WorkloadEndpointUpdate")
-	proto1.RegisterType((*WorkloadEndpoint)(nil), "felix.WorkloadEndpoint")
-	proto1.RegisterType((*WorkloadEndpointRemove)(nil), "felix.WorkloadEndpointRemove")
-	proto1.RegisterType((*HostEndpointID)(nil), "felix.HostEndpointID")
-	proto1.RegisterType((*HostEndpointUpdate)(nil), "felix.HostEndpointUpdate")
-	proto1.RegisterType((*HostEndpoint)(nil), "felix.HostEndpoint")
-	proto1.RegisterType((*HostEndpointRemove)(nil), "felix.HostEndpointRemove")
-	proto1.RegisterType((*TierInfo)(nil), "felix.TierInfo")
-	proto1.RegisterType((*NatInfo)(nil), "felix.NatInfo")
-	proto1.RegisterType((*ProcessStatusUpdate)(nil), "felix.ProcessStatusUpdate")
-	proto1.RegisterType((*HostEndpointStatusUpdate)(nil), "felix.HostEndpointStatusUpdate")
-	proto1.RegisterType((*EndpointStatus)(nil), "felix.EndpointStatus")
-	proto1.RegisterType((*HostEndpointStatusRemove)(nil), "felix.HostEndpointStatusRemove")
-	proto1.RegisterType((*WorkloadEndpointStatusUpdate)(nil), "felix.WorkloadEndpointStatusUpdate")
-	proto1.RegisterType((*WorkloadEndpointStatusRemove)(nil), "felix.WorkloadEndpointStatusRemove")
-	proto1.RegisterType((*WireguardStatusUpdate)(nil), "felix.WireguardStatusUpdate")
-	proto1.RegisterType((*HostMetadataUpdate)(nil), "felix.HostMetadataUpdate")
-	proto1.RegisterType((*HostMetadataRemove)(nil), "felix.HostMetadataRemove")
-	proto1.RegisterType((*IPAMPoolUpdate)(nil), "felix.IPAMPoolUpdate")
-	proto1.RegisterType((*IPAMPoolRemove)(nil), "felix.IPAMPoolRemove")
-	proto1.RegisterType((*IPAMPool)(nil), "felix.IPAMPool")
-	proto1.RegisterType((*Encapsulation)(nil), "felix.Encapsulation")
-	proto1.RegisterType((*ServiceAccountUpdate)(nil), "felix.ServiceAccountUpdate")
-	proto1.RegisterType((*ServiceAccountRemove)(nil), "felix.ServiceAccountRemove")
-	proto1.RegisterType((*ServiceAccountID)(nil), "felix.ServiceAccountID")
-	proto1.RegisterType((*NamespaceUpdate)(nil), "felix.NamespaceUpdate")
-	proto1.RegisterType((*NamespaceRemove)(nil), "felix.NamespaceRemove")
-	proto1.RegisterType((*NamespaceID)(nil), "felix.NamespaceID")
-	proto1.RegisterType((*TunnelType)(nil), "felix.TunnelType")
-	proto1.RegisterType((*RouteUpdate)(nil), "felix.RouteUpdate")
-	proto1.RegisterType((*RouteRemove)(nil), "felix.RouteRemove")
-	proto1.RegisterType((*VXLANTunnelEndpointUpdate)(nil), "felix.VXLANTunnelEndpointUpdate")
-	proto1.RegisterType((*VXLANTunnelEndpointRemove)(nil), "felix.VXLANTunnelEndpointRemove")
-	proto1.RegisterType((*WireguardEndpointUpdate)(nil), "felix.WireguardEndpointUpdate")
-	proto1.RegisterType((*WireguardEndpointRemove)(nil), "felix.WireguardEndpointRemove")
-	proto1.RegisterType((*GlobalBGPConfigUpdate)(nil), "felix.GlobalBGPConfigUpdate")
-	proto1.RegisterEnum("felix.IPVersion", IPVersion_name, IPVersion_value)
-	proto1.RegisterEnum("felix.RouteType", RouteType_name, RouteType_value)
-	proto1.RegisterEnum("felix.IPPoolType", IPPoolType_name, IPPoolType_value)
-	proto1.RegisterEnum("felix.IPSetUpdate_IPSetType", IPSetUpdate_IPSetType_name, IPSetUpdate_IPSetType_value)
+type QoSControls struct {
+	IngressBandwidth  int64 `protobuf:"varint,1,opt,name=ingress_bandwidth,json=ingressBandwidth,proto3" json:"ingress_bandwidth,omitempty"`
+	EgressBandwidth   int64 `protobuf:"varint,2,opt,name=egress_bandwidth,json=egressBandwidth,proto3" json:"egress_bandwidth,omitempty"`
+	IngressBurst      int64 `protobuf:"varint,3,opt,name=ingress_burst,json=ingressBurst,proto3" json:"ingress_burst,omitempty"`
+	EgressBurst       int64 `protobuf:"varint,4,opt,name=egress_burst,json=egressBurst,proto3" json:"egress_burst,omitempty"`
+	IngressPacketRate int64 `protobuf:"varint,5,opt,name=ingress_packet_rate,json=ingressPacketRate,proto3" json:"ingress_packet_rate,omitempty"`
+	EgressPacketRate  int64 `protobuf:"varint,6,opt,name=egress_packet_rate,json=egressPacketRate,proto3" json:"egress_packet_rate,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// Client API for PolicySync service
+func (m *QoSControls) Reset()                    { *m = QoSControls{} }
+func (m *QoSControls) String() string            { return proto1.CompactTextString(m) }
+func (*QoSControls) ProtoMessage()               {}
+func (*QoSControls) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{60} }
 
-type PolicySyncClient interface {
-	// On this API, only the following payloads will be sent:
-	//  - InSync
-	//  - IPSetUpdate
-	//  - IPSetDeltaUpdate
-	//  - IPSetRemove
-	//  - ActiveProfileUpdate
-	//  - ActiveProfileRemove
-	//  - ActivePolicyUpdate
-	//  - ActivePolicyRemove
-	//  - WorkloadEndpointUpdate
-	//  - WorkloadEndpointRemove
-	//  - ServiceAccountUpdate
-	//  - ServiceAccountRemove
-	//  - NamespaceUpdate
-	//  - NamespaceRemove
-	//  - RouteUpdate
-	//  - RouteRemove
-	//  - VXLANTunnelEndpointUpdate
-	//  - VXLANTunnelEndpointRemove
-	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (PolicySync_SyncClient, error)
+func (m *QoSControls) GetIngressBandwidth() int64 {
+	if m != nil {
+		return m.IngressBandwidth
+	}
+	return 0
 }
 
-type policySyncClient struct {
-	cc *grpc.ClientConn
+func (m *QoSControls) GetEgressBandwidth() int64 {
+	if m != nil {
+		return m.EgressBandwidth
+	}
+	return 0
 }
 
-func NewPolicySyncClient(cc *grpc.ClientConn) PolicySyncClient {
-	return &policySyncClient{cc}
+func (m *QoSControls) GetIngressBurst() int64 {
+	if m != nil {
+		return m.IngressBurst
+	}
+	return 0
 }
 
-func (c *policySyncClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (PolicySync_SyncClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_PolicySync_serviceDesc.Streams[0], c.cc, "/felix.PolicySync/Sync", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &policySyncSyncClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+func (m *QoSControls) GetEgressBurst() int64 {
+	if m != nil {
+		return m.EgressBurst
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return 0
+}
+
+func (m *QoSControls) GetIngressPacketRate() int64 {
+	if m != nil {
+		return m.IngressPacketRate
 	}
-	return x, nil
+	return 0
 }
 
-type PolicySync_SyncClient interface {
-	Recv() (*ToDataplane, error)
-	grpc.ClientStream
+func (m *QoSControls) GetEgressPacketRate() int64 {
+	if m != nil {
+		return m.EgressPacketRate
+	}
+	return 0
 }
 
-type policySyncSyncClient struct {
-	grpc.ClientStream
+type PacketCaptureID struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *policySyncSyncClient) Recv() (*ToDataplane, error) {
-	m := new(ToDataplane)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *PacketCaptureID) Reset()                    { *m = PacketCaptureID{} }
+func (m *PacketCaptureID) String() string            { return proto1.CompactTextString(m) }
+func (*PacketCaptureID) ProtoMessage()               {}
+func (*PacketCaptureID) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{61} }
+
+func (m *PacketCaptureID) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return m, nil
+	return ""
 }
 
-// Server API for PolicySync service
+func (m *PacketCaptureID) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
 
-type PolicySyncServer interface {
-	// On this API, only the following payloads will be sent:
-	//  - InSync
-	//  - IPSetUpdate
-	//  - IPSetDeltaUpdate
-	//  - IPSetRemove
-	//  - ActiveProfileUpdate
-	//  - ActiveProfileRemove
-	//  - ActivePolicyUpdate
-	//  - ActivePolicyRemove
-	//  - WorkloadEndpointUpdate
-	//  - WorkloadEndpointRemove
-	//  - ServiceAccountUpdate
-	//  - ServiceAccountRemove
-	//  - NamespaceUpdate
-	//  - NamespaceRemove
-	//  - RouteUpdate
-	//  - RouteRemove
-	//  - VXLANTunnelEndpointUpdate
-	//  - VXLANTunnelEndpointRemove
-	Sync(*SyncRequest, PolicySync_SyncServer) error
+type PacketCaptureUpdate struct {
+	Id        *PacketCaptureID         `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Filter    string                   `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Endpoints []*PacketCaptureEndpoint `protobuf:"bytes,3,rep,name=endpoints" json:"endpoints,omitempty"`
 }
 
-func RegisterPolicySyncServer(s *grpc.Server, srv PolicySyncServer) {
-	s.RegisterService(&_PolicySync_serviceDesc, srv)
+func (m *PacketCaptureUpdate) Reset()         { *m = PacketCaptureUpdate{} }
+func (m *PacketCaptureUpdate) String() string { return proto1.CompactTextString(m) }
+func (*PacketCaptureUpdate) ProtoMessage()    {}
+func (*PacketCaptureUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{62}
 }
 
-func _PolicySync_Sync_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SyncRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *PacketCaptureUpdate) GetId() *PacketCaptureID {
+	if m != nil {
+		return m.Id
 	}
-	return srv.(PolicySyncServer).Sync(m, &policySyncSyncServer{stream})
+	return nil
 }
 
-type PolicySync_SyncServer interface {
-	Send(*ToDataplane) error
-	grpc.ServerStream
+func (m *PacketCaptureUpdate) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
 }
 
-type policySyncSyncServer struct {
-	grpc.ServerStream
+func (m *PacketCaptureUpdate) GetEndpoints() []*PacketCaptureEndpoint {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
 }
 
-func (x *policySyncSyncServer) Send(m *ToDataplane) error {
-	return x.ServerStream.SendMsg(m)
+type PacketCaptureEndpoint struct {
+	Id            *WorkloadEndpointID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Name          string              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	InterfaceName string              `protobuf:"bytes,3,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
 }
 
-var _PolicySync_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "felix.PolicySync",
-	HandlerType: (*PolicySyncServer)(nil),
-	Methods:     []grpc.MethodDesc{},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "Sync",
-			Handler:       _PolicySync_Sync_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "felixbackend.proto",
+func (m *PacketCaptureEndpoint) Reset()         { *m = PacketCaptureEndpoint{} }
+func (m *PacketCaptureEndpoint) String() string { return proto1.CompactTextString(m) }
+func (*PacketCaptureEndpoint) ProtoMessage()    {}
+func (*PacketCaptureEndpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{63}
 }
 
-func (m *SyncRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *PacketCaptureEndpoint) GetId() *WorkloadEndpointID {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SyncRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+func (m *PacketCaptureEndpoint) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *ToDataplane) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *PacketCaptureEndpoint) GetInterfaceName() string {
+	if m != nil {
+		return m.InterfaceName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ToDataplane) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Payload != nil {
-		nn1, err := m.Payload.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn1
-	}
-	if m.SequenceNumber != 0 {
-		dAtA[i] = 0x78
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.SequenceNumber))
+type PacketCaptureRemove struct {
+	Id *PacketCaptureID `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+}
+
+func (m *PacketCaptureRemove) Reset()         { *m = PacketCaptureRemove{} }
+func (m *PacketCaptureRemove) String() string { return proto1.CompactTextString(m) }
+func (*PacketCaptureRemove) ProtoMessage()    {}
+func (*PacketCaptureRemove) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{64}
+}
+
+func (m *PacketCaptureRemove) GetId() *PacketCaptureID {
+	if m != nil {
+		return m.Id
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ToDataplane_InSync) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.InSync != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.InSync.Size()))
-		n2, err := m.InSync.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n2
+type PacketCaptureStatusUpdate struct {
+	Id        *PacketCaptureID               `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	Directory string                         `protobuf:"bytes,2,opt,name=directory,proto3" json:"directory,omitempty"`
+	Endpoints []*PacketCaptureEndpointStatus `protobuf:"bytes,3,rep,name=endpoints" json:"endpoints,omitempty"`
+}
+
+func (m *PacketCaptureStatusUpdate) Reset()         { *m = PacketCaptureStatusUpdate{} }
+func (m *PacketCaptureStatusUpdate) String() string { return proto1.CompactTextString(m) }
+func (*PacketCaptureStatusUpdate) ProtoMessage()    {}
+func (*PacketCaptureStatusUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{65}
+}
+
+func (m *PacketCaptureStatusUpdate) GetId() *PacketCaptureID {
+	if m != nil {
+		return m.Id
 	}
-	return i, nil
+	return nil
 }
-func (m *ToDataplane_IpsetUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.IpsetUpdate != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpsetUpdate.Size()))
-		n3, err := m.IpsetUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+
+func (m *PacketCaptureStatusUpdate) GetDirectory() string {
+	if m != nil {
+		return m.Directory
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_IpsetDeltaUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.IpsetDeltaUpdate != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpsetDeltaUpdate.Size()))
-		n4, err := m.IpsetDeltaUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n4
+
+func (m *PacketCaptureStatusUpdate) GetEndpoints() []*PacketCaptureEndpointStatus {
+	if m != nil {
+		return m.Endpoints
 	}
-	return i, nil
+	return nil
 }
-func (m *ToDataplane_IpsetRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.IpsetRemove != nil {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpsetRemove.Size()))
-		n5, err := m.IpsetRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n5
+
+type PacketCaptureEndpointStatus struct {
+	Name          string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	InterfaceName string               `protobuf:"bytes,2,opt,name=interface_name,json=interfaceName,proto3" json:"interface_name,omitempty"`
+	State         string               `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Error         string               `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	Files         []*PacketCaptureFile `protobuf:"bytes,5,rep,name=files" json:"files,omitempty"`
+}
+
+func (m *PacketCaptureEndpointStatus) Reset()         { *m = PacketCaptureEndpointStatus{} }
+func (m *PacketCaptureEndpointStatus) String() string { return proto1.CompactTextString(m) }
+func (*PacketCaptureEndpointStatus) ProtoMessage()    {}
+func (*PacketCaptureEndpointStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{66}
+}
+
+func (m *PacketCaptureEndpointStatus) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_ActiveProfileUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ActiveProfileUpdate != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ActiveProfileUpdate.Size()))
-		n6, err := m.ActiveProfileUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n6
+
+func (m *PacketCaptureEndpointStatus) GetInterfaceName() string {
+	if m != nil {
+		return m.InterfaceName
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_ActiveProfileRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ActiveProfileRemove != nil {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ActiveProfileRemove.Size()))
-		n7, err := m.ActiveProfileRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n7
+
+func (m *PacketCaptureEndpointStatus) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_ActivePolicyUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ActivePolicyUpdate != nil {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ActivePolicyUpdate.Size()))
-		n8, err := m.ActivePolicyUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
+
+func (m *PacketCaptureEndpointStatus) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_ActivePolicyRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ActivePolicyRemove != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ActivePolicyRemove.Size()))
-		n9, err := m.ActivePolicyRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n9
+
+func (m *PacketCaptureEndpointStatus) GetFiles() []*PacketCaptureFile {
+	if m != nil {
+		return m.Files
 	}
-	return i, nil
+	return nil
 }
-func (m *ToDataplane_HostEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.HostEndpointUpdate != nil {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostEndpointUpdate.Size()))
-		n10, err := m.HostEndpointUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n10
+
+type PacketCaptureFile struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SizeBytes int64  `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+}
+
+func (m *PacketCaptureFile) Reset()                    { *m = PacketCaptureFile{} }
+func (m *PacketCaptureFile) String() string            { return proto1.CompactTextString(m) }
+func (*PacketCaptureFile) ProtoMessage()               {}
+func (*PacketCaptureFile) Descriptor() ([]byte, []int) { return fileDescriptorFelixbackend, []int{67} }
+
+func (m *PacketCaptureFile) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_HostEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.HostEndpointRemove != nil {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostEndpointRemove.Size()))
-		n11, err := m.HostEndpointRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n11
+
+func (m *PacketCaptureFile) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
 	}
-	return i, nil
+	return 0
 }
-func (m *ToDataplane_WorkloadEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.WorkloadEndpointUpdate != nil {
-		dAtA[i] = 0x5a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WorkloadEndpointUpdate.Size()))
-		n12, err := m.WorkloadEndpointUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n12
+
+type WireguardEndpointV6Update struct {
+	Hostname                   string   `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	PublicKeyV6                string   `protobuf:"bytes,2,opt,name=public_key_v6,json=publicKeyV6,proto3" json:"public_key_v6,omitempty"`
+	InterfaceIpv6Addr          string   `protobuf:"bytes,3,opt,name=interface_ipv6_addr,json=interfaceIpv6Addr,proto3" json:"interface_ipv6_addr,omitempty"`
+	PendingPublicKeyV6         string   `protobuf:"bytes,4,opt,name=pending_public_key_v6,json=pendingPublicKeyV6,proto3" json:"pending_public_key_v6,omitempty"`
+	AppliedPendingPublicKeysV6 []string `protobuf:"bytes,5,rep,name=applied_pending_public_keys_v6,json=appliedPendingPublicKeysV6" json:"applied_pending_public_keys_v6,omitempty"`
+}
+
+func (m *WireguardEndpointV6Update) Reset()         { *m = WireguardEndpointV6Update{} }
+func (m *WireguardEndpointV6Update) String() string { return proto1.CompactTextString(m) }
+func (*WireguardEndpointV6Update) ProtoMessage()    {}
+func (*WireguardEndpointV6Update) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{68}
+}
+
+func (m *WireguardEndpointV6Update) GetHostname() string {
+	if m != nil {
+		return m.Hostname
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_WorkloadEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.WorkloadEndpointRemove != nil {
-		dAtA[i] = 0x62
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WorkloadEndpointRemove.Size()))
-		n13, err := m.WorkloadEndpointRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n13
+
+func (m *WireguardEndpointV6Update) GetPublicKeyV6() string {
+	if m != nil {
+		return m.PublicKeyV6
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_ConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ConfigUpdate != nil {
-		dAtA[i] = 0x6a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ConfigUpdate.Size()))
-		n14, err := m.ConfigUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n14
+
+func (m *WireguardEndpointV6Update) GetInterfaceIpv6Addr() string {
+	if m != nil {
+		return m.InterfaceIpv6Addr
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_HostMetadataUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.HostMetadataUpdate != nil {
-		dAtA[i] = 0x72
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostMetadataUpdate.Size()))
-		n15, err := m.HostMetadataUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n15
+
+func (m *WireguardEndpointV6Update) GetPendingPublicKeyV6() string {
+	if m != nil {
+		return m.PendingPublicKeyV6
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_IpamPoolUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.IpamPoolUpdate != nil {
-		dAtA[i] = 0x82
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpamPoolUpdate.Size()))
-		n16, err := m.IpamPoolUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n16
+
+func (m *WireguardEndpointV6Update) GetAppliedPendingPublicKeysV6() []string {
+	if m != nil {
+		return m.AppliedPendingPublicKeysV6
 	}
-	return i, nil
+	return nil
 }
-func (m *ToDataplane_IpamPoolRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.IpamPoolRemove != nil {
-		dAtA[i] = 0x8a
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpamPoolRemove.Size()))
-		n17, err := m.IpamPoolRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n17
+
+type WireguardEndpointV6Remove struct {
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+}
+
+func (m *WireguardEndpointV6Remove) Reset()         { *m = WireguardEndpointV6Remove{} }
+func (m *WireguardEndpointV6Remove) String() string { return proto1.CompactTextString(m) }
+func (*WireguardEndpointV6Remove) ProtoMessage()    {}
+func (*WireguardEndpointV6Remove) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{69}
+}
+
+func (m *WireguardEndpointV6Remove) GetHostname() string {
+	if m != nil {
+		return m.Hostname
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_HostMetadataRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.HostMetadataRemove != nil {
-		dAtA[i] = 0x92
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostMetadataRemove.Size()))
-		n18, err := m.HostMetadataRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n18
+
+type HostMetadataV6Update struct {
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Ipv6Addr string `protobuf:"bytes,2,opt,name=ipv6_addr,json=ipv6Addr,proto3" json:"ipv6_addr,omitempty"`
+}
+
+func (m *HostMetadataV6Update) Reset()         { *m = HostMetadataV6Update{} }
+func (m *HostMetadataV6Update) String() string { return proto1.CompactTextString(m) }
+func (*HostMetadataV6Update) ProtoMessage()    {}
+func (*HostMetadataV6Update) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{70}
+}
+
+func (m *HostMetadataV6Update) GetHostname() string {
+	if m != nil {
+		return m.Hostname
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_ServiceAccountUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ServiceAccountUpdate != nil {
-		dAtA[i] = 0x9a
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ServiceAccountUpdate.Size()))
-		n19, err := m.ServiceAccountUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n19
+
+func (m *HostMetadataV6Update) GetIpv6Addr() string {
+	if m != nil {
+		return m.Ipv6Addr
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_ServiceAccountRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.ServiceAccountRemove != nil {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ServiceAccountRemove.Size()))
-		n20, err := m.ServiceAccountRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n20
+
+type HostMetadataV6Remove struct {
+	Hostname string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Ipv6Addr string `protobuf:"bytes,2,opt,name=ipv6_addr,json=ipv6Addr,proto3" json:"ipv6_addr,omitempty"`
+}
+
+func (m *HostMetadataV6Remove) Reset()         { *m = HostMetadataV6Remove{} }
+func (m *HostMetadataV6Remove) String() string { return proto1.CompactTextString(m) }
+func (*HostMetadataV6Remove) ProtoMessage()    {}
+func (*HostMetadataV6Remove) Descriptor() ([]byte, []int) {
+	return fileDescriptorFelixbackend, []int{71}
+}
+
+func (m *HostMetadataV6Remove) GetHostname() string {
+	if m != nil {
+		return m.Hostname
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_NamespaceUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.NamespaceUpdate != nil {
-		dAtA[i] = 0xaa
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.NamespaceUpdate.Size()))
-		n21, err := m.NamespaceUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n21
+
+func (m *HostMetadataV6Remove) GetIpv6Addr() string {
+	if m != nil {
+		return m.Ipv6Addr
 	}
-	return i, nil
+	return ""
 }
-func (m *ToDataplane_NamespaceRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.NamespaceRemove != nil {
-		dAtA[i] = 0xb2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.NamespaceRemove.Size()))
-		n22, err := m.NamespaceRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n22
-	}
-	return i, nil
+
+func init() {
+	proto1.RegisterType((*SyncRequest)(nil), "felix.SyncRequest")
+	proto1.RegisterType((*ToDataplane)(nil), "felix.ToDataplane")
+	proto1.RegisterType((*FromDataplane)(nil), "felix.FromDataplane")
+	proto1.RegisterType((*ConfigUpdate)(nil), "felix.ConfigUpdate")
+	proto1.RegisterType((*InSync)(nil), "felix.InSync")
+	proto1.RegisterType((*IPSetUpdate)(nil), "felix.IPSetUpdate")
+	proto1.RegisterType((*IPSetDeltaUpdate)(nil), "felix.IPSetDeltaUpdate")
+	proto1.RegisterType((*IPSetRemove)(nil), "felix.IPSetRemove")
+	proto1.RegisterType((*ActiveProfileUpdate)(nil), "felix.ActiveProfileUpdate")
+	proto1.RegisterType((*ActiveProfileRemove)(nil), "felix.ActiveProfileRemove")
+	proto1.RegisterType((*ProfileID)(nil), "felix.ProfileID")
+	proto1.RegisterType((*Profile)(nil), "felix.Profile")
+	proto1.RegisterType((*ActivePolicyUpdate)(nil), "felix.ActivePolicyUpdate")
+	proto1.RegisterType((*ActivePolicyRemove)(nil), "felix.ActivePolicyRemove")
+	proto1.RegisterType((*PolicyID)(nil), "felix.PolicyID")
+	proto1.RegisterType((*Policy)(nil), "felix.Policy")
+	proto1.RegisterType((*Rule)(nil), "felix.Rule")
+	proto1.RegisterType((*ServiceAccountMatch)(nil), "felix.ServiceAccountMatch")
+	proto1.RegisterType((*HTTPMatch)(nil), "felix.HTTPMatch")
+	proto1.RegisterType((*HTTPMatch_PathMatch)(nil), "felix.HTTPMatch.PathMatch")
+	proto1.RegisterType((*RuleMetadata)(nil), "felix.RuleMetadata")
+	proto1.RegisterType((*IcmpTypeAndCode)(nil), "felix.IcmpTypeAndCode")
+	proto1.RegisterType((*Protocol)(nil), "felix.Protocol")
+	proto1.RegisterType((*PortRange)(nil), "felix.PortRange")
+	proto1.RegisterType((*WorkloadEndpointID)(nil), "felix.WorkloadEndpointID")
+	proto1.RegisterType((*WorkloadEndpointUpdate)(nil), "felix.WorkloadEndpointUpdate")
+	proto1.RegisterType((*WorkloadEndpoint)(nil), "felix.WorkloadEndpoint")
+	proto1.RegisterType((*WorkloadEndpointRemove)(nil), "felix.WorkloadEndpointRemove")
+	proto1.RegisterType((*HostEndpointID)(nil), "felix.HostEndpointID")
+	proto1.RegisterType((*HostEndpointUpdate)(nil), "felix.HostEndpointUpdate")
+	proto1.RegisterType((*HostEndpoint)(nil), "felix.HostEndpoint")
+	proto1.RegisterType((*HostEndpointRemove)(nil), "felix.HostEndpointRemove")
+	proto1.RegisterType((*TierInfo)(nil), "felix.TierInfo")
+	proto1.RegisterType((*NatInfo)(nil), "felix.NatInfo")
+	proto1.RegisterType((*ProcessStatusUpdate)(nil), "felix.ProcessStatusUpdate")
+	proto1.RegisterType((*HostEndpointStatusUpdate)(nil), "felix.HostEndpointStatusUpdate")
+	proto1.RegisterType((*EndpointStatus)(nil), "felix.EndpointStatus")
+	proto1.RegisterType((*HostEndpointStatusRemove)(nil), "felix.HostEndpointStatusRemove")
+	proto1.RegisterType((*WorkloadEndpointStatusUpdate)(nil), "felix.WorkloadEndpointStatusUpdate")
+	proto1.RegisterType((*WorkloadEndpointStatusRemove)(nil), "felix.WorkloadEndpointStatusRemove")
+	proto1.RegisterType((*WireguardStatusUpdate)(nil), "felix.WireguardStatusUpdate")
+	proto1.RegisterType((*HostMetadataUpdate)(nil), "felix.HostMetadataUpdate")
+	proto1.RegisterType((*HostMetadataRemove)(nil), "felix.HostMetadataRemove")
+	proto1.RegisterType((*IPAMPoolUpdate)(nil), "felix.IPAMPoolUpdate")
+	proto1.RegisterType((*IPAMPoolRemove)(nil), "felix.IPAMPoolRemove")
+	proto1.RegisterType((*IPAMPool)(nil), "felix.IPAMPool")
+	proto1.RegisterType((*Encapsulation)(nil), "felix.Encapsulation")
+	proto1.RegisterType((*ServiceAccountUpdate)(nil), "felix.ServiceAccountUpdate")
+	proto1.RegisterType((*ServiceAccountRemove)(nil), "felix.ServiceAccountRemove")
+	proto1.RegisterType((*ServiceAccountID)(nil), "felix.ServiceAccountID")
+	proto1.RegisterType((*NamespaceUpdate)(nil), "felix.NamespaceUpdate")
+	proto1.RegisterType((*NamespaceRemove)(nil), "felix.NamespaceRemove")
+	proto1.RegisterType((*NamespaceID)(nil), "felix.NamespaceID")
+	proto1.RegisterType((*TunnelType)(nil), "felix.TunnelType")
+	proto1.RegisterType((*RouteUpdate)(nil), "felix.RouteUpdate")
+	proto1.RegisterType((*RouteRemove)(nil), "felix.RouteRemove")
+	proto1.RegisterType((*VXLANTunnelEndpointUpdate)(nil), "felix.VXLANTunnelEndpointUpdate")
+	proto1.RegisterType((*VXLANTunnelEndpointRemove)(nil), "felix.VXLANTunnelEndpointRemove")
+	proto1.RegisterType((*WireguardEndpointUpdate)(nil), "felix.WireguardEndpointUpdate")
+	proto1.RegisterType((*WireguardEndpointRemove)(nil), "felix.WireguardEndpointRemove")
+	proto1.RegisterType((*GlobalBGPConfigUpdate)(nil), "felix.GlobalBGPConfigUpdate")
+	proto1.RegisterType((*QoSControls)(nil), "felix.QoSControls")
+	proto1.RegisterType((*PacketCaptureID)(nil), "felix.PacketCaptureID")
+	proto1.RegisterType((*PacketCaptureUpdate)(nil), "felix.PacketCaptureUpdate")
+	proto1.RegisterType((*PacketCaptureEndpoint)(nil), "felix.PacketCaptureEndpoint")
+	proto1.RegisterType((*PacketCaptureRemove)(nil), "felix.PacketCaptureRemove")
+	proto1.RegisterType((*PacketCaptureStatusUpdate)(nil), "felix.PacketCaptureStatusUpdate")
+	proto1.RegisterType((*PacketCaptureEndpointStatus)(nil), "felix.PacketCaptureEndpointStatus")
+	proto1.RegisterType((*PacketCaptureFile)(nil), "felix.PacketCaptureFile")
+	proto1.RegisterType((*WireguardEndpointV6Update)(nil), "felix.WireguardEndpointV6Update")
+	proto1.RegisterType((*WireguardEndpointV6Remove)(nil), "felix.WireguardEndpointV6Remove")
+	proto1.RegisterType((*HostMetadataV6Update)(nil), "felix.HostMetadataV6Update")
+	proto1.RegisterType((*HostMetadataV6Remove)(nil), "felix.HostMetadataV6Remove")
+	proto1.RegisterEnum("felix.IPVersion", IPVersion_name, IPVersion_value)
+	proto1.RegisterEnum("felix.RouteType", RouteType_name, RouteType_value)
+	proto1.RegisterEnum("felix.IPPoolType", IPPoolType_name, IPPoolType_value)
+	proto1.RegisterEnum("felix.IPSetUpdate_IPSetType", IPSetUpdate_IPSetType_name, IPSetUpdate_IPSetType_value)
 }
-func (m *ToDataplane_RouteUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.RouteUpdate != nil {
-		dAtA[i] = 0xba
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.RouteUpdate.Size()))
-		n23, err := m.RouteUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n23
-	}
-	return i, nil
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for PolicySync service
+
+type PolicySyncClient interface {
+	// On this API, only the following payloads will be sent:
+	//  - InSync
+	//  - IPSetUpdate
+	//  - IPSetDeltaUpdate
+	//  - IPSetRemove
+	//  - ActiveProfileUpdate
+	//  - ActiveProfileRemove
+	//  - ActivePolicyUpdate
+	//  - ActivePolicyRemove
+	//  - WorkloadEndpointUpdate
+	//  - WorkloadEndpointRemove
+	//  - ServiceAccountUpdate
+	//  - ServiceAccountRemove
+	//  - NamespaceUpdate
+	//  - NamespaceRemove
+	//  - RouteUpdate
+	//  - RouteRemove
+	//  - VXLANTunnelEndpointUpdate
+	//  - VXLANTunnelEndpointRemove
+	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (PolicySync_SyncClient, error)
 }
-func (m *ToDataplane_RouteRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.RouteRemove != nil {
-		dAtA[i] = 0xc2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.RouteRemove.Size()))
-		n24, err := m.RouteRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n24
-	}
-	return i, nil
+
+type policySyncClient struct {
+	cc *grpc.ClientConn
 }
-func (m *ToDataplane_VtepUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.VtepUpdate != nil {
-		dAtA[i] = 0xca
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.VtepUpdate.Size()))
-		n25, err := m.VtepUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n25
-	}
-	return i, nil
+
+func NewPolicySyncClient(cc *grpc.ClientConn) PolicySyncClient {
+	return &policySyncClient{cc}
 }
-func (m *ToDataplane_VtepRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.VtepRemove != nil {
-		dAtA[i] = 0xd2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.VtepRemove.Size()))
-		n26, err := m.VtepRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n26
+
+func (c *policySyncClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (PolicySync_SyncClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_PolicySync_serviceDesc.Streams[0], c.cc, "/felix.PolicySync/Sync", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
-}
-func (m *ToDataplane_WireguardEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.WireguardEndpointUpdate != nil {
-		dAtA[i] = 0xda
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WireguardEndpointUpdate.Size()))
-		n27, err := m.WireguardEndpointUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n27
+	x := &policySyncSyncClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return i, nil
-}
-func (m *ToDataplane_WireguardEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.WireguardEndpointRemove != nil {
-		dAtA[i] = 0xe2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WireguardEndpointRemove.Size()))
-		n28, err := m.WireguardEndpointRemove.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n28
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return x, nil
 }
-func (m *ToDataplane_GlobalBgpConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.GlobalBgpConfigUpdate != nil {
-		dAtA[i] = 0xea
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.GlobalBgpConfigUpdate.Size()))
-		n29, err := m.GlobalBgpConfigUpdate.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n29
-	}
-	return i, nil
+
+type PolicySync_SyncClient interface {
+	Recv() (*ToDataplane, error)
+	grpc.ClientStream
 }
-func (m *ToDataplane_Encapsulation) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Encapsulation != nil {
-		dAtA[i] = 0xf2
-		i++
-		dAtA[i] = 0x1
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Encapsulation.Size()))
-		n30, err := m.Encapsulation.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n30
+
+type policySyncSyncClient struct {
+	grpc.ClientStream
+}
+
+func (x *policySyncSyncClient) Recv() (*ToDataplane, error) {
+	m := new(ToDataplane)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return i, nil
+	return m, nil
 }
-func (m *FromDataplane) Marshal() (dAtA []byte, err error) {
+
+// Server API for PolicySync service
+
+type PolicySyncServer interface {
+	// On this API, only the following payloads will be sent:
+	//  - InSync
+	//  - IPSetUpdate
+	//  - IPSetDeltaUpdate
+	//  - IPSetRemove
+	//  - ActiveProfileUpdate
+	//  - ActiveProfileRemove
+	//  - ActivePolicyUpdate
+	//  - ActivePolicyRemove
+	//  - WorkloadEndpointUpdate
+	//  - WorkloadEndpointRemove
+	//  - ServiceAccountUpdate
+	//  - ServiceAccountRemove
+	//  - NamespaceUpdate
+	//  - NamespaceRemove
+	//  - RouteUpdate
+	//  - RouteRemove
+	//  - VXLANTunnelEndpointUpdate
+	//  - VXLANTunnelEndpointRemove
+	Sync(*SyncRequest, PolicySync_SyncServer) error
+}
+
+func RegisterPolicySyncServer(s *grpc.Server, srv PolicySyncServer) {
+	s.RegisterService(&_PolicySync_serviceDesc, srv)
+}
+
+func _PolicySync_Sync_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SyncRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PolicySyncServer).Sync(m, &policySyncSyncServer{stream})
+}
+
+type PolicySync_SyncServer interface {
+	Send(*ToDataplane) error
+	grpc.ServerStream
+}
+
+type policySyncSyncServer struct {
+	grpc.ServerStream
+}
+
+func (x *policySyncSyncServer) Send(m *ToDataplane) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _PolicySync_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "felix.PolicySync",
+	HandlerType: (*PolicySyncServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Sync",
+			Handler:       _PolicySync_Sync_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "felixbackend.proto",
+}
+
+func (m *SyncRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4317,484 +4559,577 @@ func (m *FromDataplane) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FromDataplane) MarshalTo(dAtA []byte) (int, error) {
+func (m *SyncRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ToDataplane) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ToDataplane) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	if m.Payload != nil {
-		nn31, err := m.Payload.MarshalTo(dAtA[i:])
+		nn1, err := m.Payload.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn31
+		i += nn1
 	}
 	if m.SequenceNumber != 0 {
-		dAtA[i] = 0x40
+		dAtA[i] = 0x78
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.SequenceNumber))
 	}
 	return i, nil
 }
 
-func (m *FromDataplane_ProcessStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_InSync) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.ProcessStatusUpdate != nil {
+	if m.InSync != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.InSync.Size()))
+		n2, err := m.InSync.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+func (m *ToDataplane_IpsetUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.IpsetUpdate != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpsetUpdate.Size()))
+		n3, err := m.IpsetUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+func (m *ToDataplane_IpsetDeltaUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.IpsetDeltaUpdate != nil {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ProcessStatusUpdate.Size()))
-		n32, err := m.ProcessStatusUpdate.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpsetDeltaUpdate.Size()))
+		n4, err := m.IpsetDeltaUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n4
 	}
 	return i, nil
 }
-func (m *FromDataplane_HostEndpointStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_IpsetRemove) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.HostEndpointStatusUpdate != nil {
+	if m.IpsetRemove != nil {
 		dAtA[i] = 0x22
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostEndpointStatusUpdate.Size()))
-		n33, err := m.HostEndpointStatusUpdate.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpsetRemove.Size()))
+		n5, err := m.IpsetRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n5
 	}
 	return i, nil
 }
-func (m *FromDataplane_HostEndpointStatusRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_ActiveProfileUpdate) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.HostEndpointStatusRemove != nil {
+	if m.ActiveProfileUpdate != nil {
 		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostEndpointStatusRemove.Size()))
-		n34, err := m.HostEndpointStatusRemove.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ActiveProfileUpdate.Size()))
+		n6, err := m.ActiveProfileUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n6
 	}
 	return i, nil
 }
-func (m *FromDataplane_WorkloadEndpointStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_ActiveProfileRemove) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.WorkloadEndpointStatusUpdate != nil {
+	if m.ActiveProfileRemove != nil {
 		dAtA[i] = 0x32
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WorkloadEndpointStatusUpdate.Size()))
-		n35, err := m.WorkloadEndpointStatusUpdate.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ActiveProfileRemove.Size()))
+		n7, err := m.ActiveProfileRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n7
 	}
 	return i, nil
 }
-func (m *FromDataplane_WorkloadEndpointStatusRemove) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_ActivePolicyUpdate) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.WorkloadEndpointStatusRemove != nil {
+	if m.ActivePolicyUpdate != nil {
 		dAtA[i] = 0x3a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WorkloadEndpointStatusRemove.Size()))
-		n36, err := m.WorkloadEndpointStatusRemove.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ActivePolicyUpdate.Size()))
+		n8, err := m.ActivePolicyUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n8
 	}
 	return i, nil
 }
-func (m *FromDataplane_WireguardStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *ToDataplane_ActivePolicyRemove) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.WireguardStatusUpdate != nil {
-		dAtA[i] = 0x4a
+	if m.ActivePolicyRemove != nil {
+		dAtA[i] = 0x42
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WireguardStatusUpdate.Size()))
-		n37, err := m.WireguardStatusUpdate.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ActivePolicyRemove.Size()))
+		n9, err := m.ActivePolicyRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n9
 	}
 	return i, nil
 }
-func (m *ConfigUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ToDataplane_HostEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.HostEndpointUpdate != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostEndpointUpdate.Size()))
+		n10, err := m.HostEndpointUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
 	}
-	return dAtA[:n], nil
+	return i, nil
 }
-
-func (m *ConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Config) > 0 {
-		for k, _ := range m.Config {
-			dAtA[i] = 0xa
-			i++
-			v := m.Config[k]
-			mapSize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
-			i = encodeVarintFelixbackend(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
+func (m *ToDataplane_HostEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.HostEndpointRemove != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostEndpointRemove.Size()))
+		n11, err := m.HostEndpointRemove.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n11
 	}
 	return i, nil
 }
-
-func (m *InSync) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ToDataplane_WorkloadEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.WorkloadEndpointUpdate != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WorkloadEndpointUpdate.Size()))
+		n12, err := m.WorkloadEndpointUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
 	}
-	return dAtA[:n], nil
-}
-
-func (m *InSync) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
 	return i, nil
 }
-
-func (m *IPSetUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *IPSetUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
+func (m *ToDataplane_WorkloadEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.WorkloadEndpointRemove != nil {
+		dAtA[i] = 0x62
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if len(m.Members) > 0 {
-		for _, s := range m.Members {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WorkloadEndpointRemove.Size()))
+		n13, err := m.WorkloadEndpointRemove.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-	}
-	if m.Type != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Type))
+		i += n13
 	}
 	return i, nil
 }
-
-func (m *IPSetDeltaUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *IPSetDeltaUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
+func (m *ToDataplane_ConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ConfigUpdate != nil {
+		dAtA[i] = 0x6a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
-	}
-	if len(m.AddedMembers) > 0 {
-		for _, s := range m.AddedMembers {
-			dAtA[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ConfigUpdate.Size()))
+		n14, err := m.ConfigUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n14
 	}
-	if len(m.RemovedMembers) > 0 {
-		for _, s := range m.RemovedMembers {
-			dAtA[i] = 0x1a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	return i, nil
+}
+func (m *ToDataplane_HostMetadataUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.HostMetadataUpdate != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostMetadataUpdate.Size()))
+		n15, err := m.HostMetadataUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n15
 	}
 	return i, nil
 }
-
-func (m *IPSetRemove) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ToDataplane_IpamPoolUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.IpamPoolUpdate != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpamPoolUpdate.Size()))
+		n16, err := m.IpamPoolUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n16
 	}
-	return dAtA[:n], nil
+	return i, nil
 }
-
-func (m *IPSetRemove) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		dAtA[i] = 0xa
+func (m *ToDataplane_IpamPoolRemove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.IpamPoolRemove != nil {
+		dAtA[i] = 0x8a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Id)))
-		i += copy(dAtA[i:], m.Id)
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpamPoolRemove.Size()))
+		n17, err := m.IpamPoolRemove.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n17
 	}
 	return i, nil
 }
-
-func (m *ActiveProfileUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ToDataplane_HostMetadataRemove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.HostMetadataRemove != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostMetadataRemove.Size()))
+		n18, err := m.HostMetadataRemove.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
 	}
-	return dAtA[:n], nil
+	return i, nil
 }
-
-func (m *ActiveProfileUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Id != nil {
-		dAtA[i] = 0xa
+func (m *ToDataplane_ServiceAccountUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ServiceAccountUpdate != nil {
+		dAtA[i] = 0x9a
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n38, err := m.Id.MarshalTo(dAtA[i:])
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ServiceAccountUpdate.Size()))
+		n19, err := m.ServiceAccountUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n19
 	}
-	if m.Profile != nil {
-		dAtA[i] = 0x12
+	return i, nil
+}
+func (m *ToDataplane_ServiceAccountRemove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ServiceAccountRemove != nil {
+		dAtA[i] = 0xa2
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Profile.Size()))
-		n39, err := m.Profile.MarshalTo(dAtA[i:])
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ServiceAccountRemove.Size()))
+		n20, err := m.ServiceAccountRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n20
 	}
 	return i, nil
 }
-
-func (m *ActiveProfileRemove) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ToDataplane_NamespaceUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.NamespaceUpdate != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.NamespaceUpdate.Size()))
+		n21, err := m.NamespaceUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
 	}
-	return dAtA[:n], nil
+	return i, nil
 }
-
-func (m *ActiveProfileRemove) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Id != nil {
-		dAtA[i] = 0xa
+func (m *ToDataplane_NamespaceRemove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.NamespaceRemove != nil {
+		dAtA[i] = 0xb2
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n40, err := m.Id.MarshalTo(dAtA[i:])
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.NamespaceRemove.Size()))
+		n22, err := m.NamespaceRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n22
 	}
 	return i, nil
 }
-
-func (m *ProfileID) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ToDataplane_RouteUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.RouteUpdate != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.RouteUpdate.Size()))
+		n23, err := m.RouteUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
 	}
-	return dAtA[:n], nil
+	return i, nil
 }
-
-func (m *ProfileID) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Name) > 0 {
-		dAtA[i] = 0xa
+func (m *ToDataplane_RouteRemove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.RouteRemove != nil {
+		dAtA[i] = 0xc2
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.RouteRemove.Size()))
+		n24, err := m.RouteRemove.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
 	}
 	return i, nil
 }
-
-func (m *Profile) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ToDataplane_VtepUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.VtepUpdate != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.VtepUpdate.Size()))
+		n25, err := m.VtepUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
 	}
-	return dAtA[:n], nil
+	return i, nil
 }
-
-func (m *Profile) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.InboundRules) > 0 {
-		for _, msg := range m.InboundRules {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+func (m *ToDataplane_VtepRemove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.VtepRemove != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.VtepRemove.Size()))
+		n26, err := m.VtepRemove.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n26
 	}
-	if len(m.OutboundRules) > 0 {
-		for _, msg := range m.OutboundRules {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	return i, nil
+}
+func (m *ToDataplane_WireguardEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.WireguardEndpointUpdate != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WireguardEndpointUpdate.Size()))
+		n27, err := m.WireguardEndpointUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n27
 	}
 	return i, nil
 }
-
-func (m *ActivePolicyUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ToDataplane_WireguardEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.WireguardEndpointRemove != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WireguardEndpointRemove.Size()))
+		n28, err := m.WireguardEndpointRemove.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
 	}
-	return dAtA[:n], nil
+	return i, nil
 }
-
-func (m *ActivePolicyUpdate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Id != nil {
-		dAtA[i] = 0xa
+func (m *ToDataplane_GlobalBgpConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GlobalBgpConfigUpdate != nil {
+		dAtA[i] = 0xea
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n41, err := m.Id.MarshalTo(dAtA[i:])
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.GlobalBgpConfigUpdate.Size()))
+		n29, err := m.GlobalBgpConfigUpdate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n29
 	}
-	if m.Policy != nil {
-		dAtA[i] = 0x12
+	return i, nil
+}
+func (m *ToDataplane_Encapsulation) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Encapsulation != nil {
+		dAtA[i] = 0xf2
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Policy.Size()))
-		n42, err := m.Policy.MarshalTo(dAtA[i:])
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Encapsulation.Size()))
+		n30, err := m.Encapsulation.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n30
 	}
 	return i, nil
 }
-
-func (m *ActivePolicyRemove) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ToDataplane_PacketCaptureUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.PacketCaptureUpdate != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.PacketCaptureUpdate.Size()))
+		n31, err := m.PacketCaptureUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
 	}
-	return dAtA[:n], nil
+	return i, nil
 }
-
-func (m *ActivePolicyRemove) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Id != nil {
-		dAtA[i] = 0xa
+func (m *ToDataplane_PacketCaptureRemove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.PacketCaptureRemove != nil {
+		dAtA[i] = 0x82
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n43, err := m.Id.MarshalTo(dAtA[i:])
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.PacketCaptureRemove.Size()))
+		n32, err := m.PacketCaptureRemove.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n32
 	}
 	return i, nil
 }
-
-func (m *PolicyID) Marshal() (dAtA []byte, err error) {
+func (m *ToDataplane_WireguardEndpointV6Update) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.WireguardEndpointV6Update != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WireguardEndpointV6Update.Size()))
+		n33, err := m.WireguardEndpointV6Update.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	return i, nil
+}
+func (m *ToDataplane_WireguardEndpointV6Remove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.WireguardEndpointV6Remove != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WireguardEndpointV6Remove.Size()))
+		n34, err := m.WireguardEndpointV6Remove.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	return i, nil
+}
+func (m *ToDataplane_HostMetadataV6Update) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.HostMetadataV6Update != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostMetadataV6Update.Size()))
+		n35, err := m.HostMetadataV6Update.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
+	return i, nil
+}
+func (m *ToDataplane_HostMetadataV6Remove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.HostMetadataV6Remove != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostMetadataV6Remove.Size()))
+		n36, err := m.HostMetadataV6Remove.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	return i, nil
+}
+func (m *FromDataplane) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4804,27 +5139,125 @@ func (m *PolicyID) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PolicyID) MarshalTo(dAtA []byte) (int, error) {
+func (m *FromDataplane) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tier) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Tier)))
-		i += copy(dAtA[i:], m.Tier)
+	if m.Payload != nil {
+		nn37, err := m.Payload.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn37
 	}
-	if len(m.Name) > 0 {
-		dAtA[i] = 0x12
+	if m.SequenceNumber != 0 {
+		dAtA[i] = 0x40
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
-		i += copy(dAtA[i:], m.Name)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.SequenceNumber))
 	}
 	return i, nil
 }
 
-func (m *Policy) Marshal() (dAtA []byte, err error) {
+func (m *FromDataplane_ProcessStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ProcessStatusUpdate != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.ProcessStatusUpdate.Size()))
+		n38, err := m.ProcessStatusUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
+	return i, nil
+}
+func (m *FromDataplane_HostEndpointStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.HostEndpointStatusUpdate != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostEndpointStatusUpdate.Size()))
+		n39, err := m.HostEndpointStatusUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	return i, nil
+}
+func (m *FromDataplane_HostEndpointStatusRemove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.HostEndpointStatusRemove != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HostEndpointStatusRemove.Size()))
+		n40, err := m.HostEndpointStatusRemove.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	return i, nil
+}
+func (m *FromDataplane_WorkloadEndpointStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.WorkloadEndpointStatusUpdate != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WorkloadEndpointStatusUpdate.Size()))
+		n41, err := m.WorkloadEndpointStatusUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n41
+	}
+	return i, nil
+}
+func (m *FromDataplane_WorkloadEndpointStatusRemove) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.WorkloadEndpointStatusRemove != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WorkloadEndpointStatusRemove.Size()))
+		n42, err := m.WorkloadEndpointStatusRemove.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n42
+	}
+	return i, nil
+}
+func (m *FromDataplane_WireguardStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.WireguardStatusUpdate != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.WireguardStatusUpdate.Size()))
+		n43, err := m.WireguardStatusUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n43
+	}
+	return i, nil
+}
+func (m *FromDataplane_PacketCaptureStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.PacketCaptureStatusUpdate != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.PacketCaptureStatusUpdate.Size()))
+		n44, err := m.PacketCaptureStatusUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n44
+	}
+	return i, nil
+}
+func (m *ConfigUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4834,65 +5267,94 @@ func (m *Policy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Policy) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.InboundRules) > 0 {
-		for _, msg := range m.InboundRules {
+	if len(m.Config) > 0 {
+		for k, _ := range m.Config {
 			dAtA[i] = 0xa
 			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.OutboundRules) > 0 {
-		for _, msg := range m.OutboundRules {
+			v := m.Config[k]
+			mapSize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			i = encodeVarintFelixbackend(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
 			dAtA[i] = 0x12
 			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+			i = encodeVarintFelixbackend(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
 		}
 	}
-	if m.Untracked {
-		dAtA[i] = 0x18
-		i++
-		if m.Untracked {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+	return i, nil
+}
+
+func (m *InSync) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.PreDnat {
-		dAtA[i] = 0x20
+	return dAtA[:n], nil
+}
+
+func (m *InSync) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *IPSetUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IPSetUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
 		i++
-		if m.PreDnat {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
 		}
-		i++
 	}
-	if len(m.Namespace) > 0 {
-		dAtA[i] = 0x2a
+	if m.Type != 0 {
+		dAtA[i] = 0x18
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Namespace)))
-		i += copy(dAtA[i:], m.Namespace)
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Type))
 	}
 	return i, nil
 }
 
-func (m *Rule) Marshal() (dAtA []byte, err error) {
+func (m *IPSetDeltaUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4902,35 +5364,20 @@ func (m *Rule) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
+func (m *IPSetDeltaUpdate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Action) > 0 {
+	if len(m.Id) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Action)))
-		i += copy(dAtA[i:], m.Action)
-	}
-	if m.IpVersion != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpVersion))
-	}
-	if m.Protocol != nil {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Protocol.Size()))
-		n44, err := m.Protocol.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n44
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
 	}
-	if len(m.SrcNet) > 0 {
-		for _, s := range m.SrcNet {
-			dAtA[i] = 0x22
+	if len(m.AddedMembers) > 0 {
+		for _, s := range m.AddedMembers {
+			dAtA[i] = 0x12
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -4943,21 +5390,9 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.SrcPorts) > 0 {
-		for _, msg := range m.SrcPorts {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.DstNet) > 0 {
-		for _, s := range m.DstNet {
-			dAtA[i] = 0x32
+	if len(m.RemovedMembers) > 0 {
+		for _, s := range m.RemovedMembers {
+			dAtA[i] = 0x1a
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -4970,134 +5405,141 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.DstPorts) > 0 {
-		for _, msg := range m.DstPorts {
-			dAtA[i] = 0x3a
-			i++
-			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.Icmp != nil {
-		nn45, err := m.Icmp.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn45
+	return i, nil
+}
+
+func (m *IPSetRemove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.SrcIpSetIds) > 0 {
-		for _, s := range m.SrcIpSetIds {
-			dAtA[i] = 0x52
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	return dAtA[:n], nil
+}
+
+func (m *IPSetRemove) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Id)))
+		i += copy(dAtA[i:], m.Id)
 	}
-	if len(m.DstIpSetIds) > 0 {
-		for _, s := range m.DstIpSetIds {
-			dAtA[i] = 0x5a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	return i, nil
+}
+
+func (m *ActiveProfileUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.SrcNamedPortIpSetIds) > 0 {
-		for _, s := range m.SrcNamedPortIpSetIds {
-			dAtA[i] = 0x62
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	return dAtA[:n], nil
+}
+
+func (m *ActiveProfileUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n45, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n45
 	}
-	if len(m.DstNamedPortIpSetIds) > 0 {
-		for _, s := range m.DstNamedPortIpSetIds {
-			dAtA[i] = 0x6a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
+	if m.Profile != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Profile.Size()))
+		n46, err := m.Profile.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n46
 	}
-	if len(m.DstIpPortSetIds) > 0 {
-		for _, s := range m.DstIpPortSetIds {
-			dAtA[i] = 0x7a
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	return i, nil
+}
+
+func (m *ActiveProfileRemove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if m.NotProtocol != nil {
-		dAtA[i] = 0xb2
-		i++
-		dAtA[i] = 0x6
+	return dAtA[:n], nil
+}
+
+func (m *ActiveProfileRemove) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintFelixbackend(dAtA, i, uint64(m.NotProtocol.Size()))
-		n46, err := m.NotProtocol.MarshalTo(dAtA[i:])
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n47, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n47
 	}
-	if len(m.NotSrcNet) > 0 {
-		for _, s := range m.NotSrcNet {
-			dAtA[i] = 0xba
-			i++
-			dAtA[i] = 0x6
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
+	return i, nil
+}
+
+func (m *ProfileID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.NotSrcPorts) > 0 {
-		for _, msg := range m.NotSrcPorts {
-			dAtA[i] = 0xc2
-			i++
-			dAtA[i] = 0x6
+	return dAtA[:n], nil
+}
+
+func (m *ProfileID) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	return i, nil
+}
+
+func (m *Profile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Profile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.InboundRules) > 0 {
+		for _, msg := range m.InboundRules {
+			dAtA[i] = 0xa
 			i++
 			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -5107,28 +5549,9 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if len(m.NotDstNet) > 0 {
-		for _, s := range m.NotDstNet {
-			dAtA[i] = 0xca
-			i++
-			dAtA[i] = 0x6
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			dAtA[i] = uint8(l)
-			i++
-			i += copy(dAtA[i:], s)
-		}
-	}
-	if len(m.NotDstPorts) > 0 {
-		for _, msg := range m.NotDstPorts {
-			dAtA[i] = 0xd2
-			i++
-			dAtA[i] = 0x6
+	if len(m.OutboundRules) > 0 {
+		for _, msg := range m.OutboundRules {
+			dAtA[i] = 0x12
 			i++
 			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -5138,18 +5561,212 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
-	if m.NotIcmp != nil {
-		nn47, err := m.NotIcmp.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn47
-	}
-	if len(m.NotSrcIpSetIds) > 0 {
-		for _, s := range m.NotSrcIpSetIds {
-			dAtA[i] = 0xea
+	return i, nil
+}
+
+func (m *ActivePolicyUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivePolicyUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n48, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n48
+	}
+	if m.Policy != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Policy.Size()))
+		n49, err := m.Policy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n49
+	}
+	return i, nil
+}
+
+func (m *ActivePolicyRemove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActivePolicyRemove) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Id != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n50, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n50
+	}
+	return i, nil
+}
+
+func (m *PolicyID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicyID) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tier) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Tier)))
+		i += copy(dAtA[i:], m.Tier)
+	}
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	return i, nil
+}
+
+func (m *Policy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Policy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.InboundRules) > 0 {
+		for _, msg := range m.InboundRules {
+			dAtA[i] = 0xa
 			i++
-			dAtA[i] = 0x6
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.OutboundRules) > 0 {
+		for _, msg := range m.OutboundRules {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Untracked {
+		dAtA[i] = 0x18
+		i++
+		if m.Untracked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.PreDnat {
+		dAtA[i] = 0x20
+		i++
+		if m.PreDnat {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
+	}
+	return i, nil
+}
+
+func (m *Rule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Action) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Action)))
+		i += copy(dAtA[i:], m.Action)
+	}
+	if m.IpVersion != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpVersion))
+	}
+	if m.Protocol != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Protocol.Size()))
+		n51, err := m.Protocol.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n51
+	}
+	if len(m.SrcNet) > 0 {
+		for _, s := range m.SrcNet {
+			dAtA[i] = 0x22
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -5162,11 +5779,21 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.NotDstIpSetIds) > 0 {
-		for _, s := range m.NotDstIpSetIds {
-			dAtA[i] = 0xf2
+	if len(m.SrcPorts) > 0 {
+		for _, msg := range m.SrcPorts {
+			dAtA[i] = 0x2a
 			i++
-			dAtA[i] = 0x6
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.DstNet) > 0 {
+		for _, s := range m.DstNet {
+			dAtA[i] = 0x32
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -5179,11 +5806,28 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.NotSrcNamedPortIpSetIds) > 0 {
-		for _, s := range m.NotSrcNamedPortIpSetIds {
-			dAtA[i] = 0x82
+	if len(m.DstPorts) > 0 {
+		for _, msg := range m.DstPorts {
+			dAtA[i] = 0x3a
 			i++
-			dAtA[i] = 0x7
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Icmp != nil {
+		nn52, err := m.Icmp.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn52
+	}
+	if len(m.SrcIpSetIds) > 0 {
+		for _, s := range m.SrcIpSetIds {
+			dAtA[i] = 0x52
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -5196,11 +5840,9 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.NotDstNamedPortIpSetIds) > 0 {
-		for _, s := range m.NotDstNamedPortIpSetIds {
-			dAtA[i] = 0x8a
-			i++
-			dAtA[i] = 0x7
+	if len(m.DstIpSetIds) > 0 {
+		for _, s := range m.DstIpSetIds {
+			dAtA[i] = 0x5a
 			i++
 			l = len(s)
 			for l >= 1<<7 {
@@ -5213,11 +5855,205 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
-	if len(m.OriginalSrcSelector) > 0 {
-		dAtA[i] = 0x92
-		i++
-		dAtA[i] = 0x7
-		i++
+	if len(m.SrcNamedPortIpSetIds) > 0 {
+		for _, s := range m.SrcNamedPortIpSetIds {
+			dAtA[i] = 0x62
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.DstNamedPortIpSetIds) > 0 {
+		for _, s := range m.DstNamedPortIpSetIds {
+			dAtA[i] = 0x6a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.DstIpPortSetIds) > 0 {
+		for _, s := range m.DstIpPortSetIds {
+			dAtA[i] = 0x7a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.NotProtocol != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.NotProtocol.Size()))
+		n53, err := m.NotProtocol.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n53
+	}
+	if len(m.NotSrcNet) > 0 {
+		for _, s := range m.NotSrcNet {
+			dAtA[i] = 0xba
+			i++
+			dAtA[i] = 0x6
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.NotSrcPorts) > 0 {
+		for _, msg := range m.NotSrcPorts {
+			dAtA[i] = 0xc2
+			i++
+			dAtA[i] = 0x6
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.NotDstNet) > 0 {
+		for _, s := range m.NotDstNet {
+			dAtA[i] = 0xca
+			i++
+			dAtA[i] = 0x6
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.NotDstPorts) > 0 {
+		for _, msg := range m.NotDstPorts {
+			dAtA[i] = 0xd2
+			i++
+			dAtA[i] = 0x6
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.NotIcmp != nil {
+		nn54, err := m.NotIcmp.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn54
+	}
+	if len(m.NotSrcIpSetIds) > 0 {
+		for _, s := range m.NotSrcIpSetIds {
+			dAtA[i] = 0xea
+			i++
+			dAtA[i] = 0x6
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.NotDstIpSetIds) > 0 {
+		for _, s := range m.NotDstIpSetIds {
+			dAtA[i] = 0xf2
+			i++
+			dAtA[i] = 0x6
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.NotSrcNamedPortIpSetIds) > 0 {
+		for _, s := range m.NotSrcNamedPortIpSetIds {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x7
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.NotDstNamedPortIpSetIds) > 0 {
+		for _, s := range m.NotDstNamedPortIpSetIds {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x7
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.OriginalSrcSelector) > 0 {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x7
+		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.OriginalSrcSelector)))
 		i += copy(dAtA[i:], m.OriginalSrcSelector)
 	}
@@ -5267,11 +6103,11 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x7
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.SrcServiceAccountMatch.Size()))
-		n48, err := m.SrcServiceAccountMatch.MarshalTo(dAtA[i:])
+		n55, err := m.SrcServiceAccountMatch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n55
 	}
 	if m.DstServiceAccountMatch != nil {
 		dAtA[i] = 0xca
@@ -5279,11 +6115,11 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x7
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.DstServiceAccountMatch.Size()))
-		n49, err := m.DstServiceAccountMatch.MarshalTo(dAtA[i:])
+		n56, err := m.DstServiceAccountMatch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n56
 	}
 	if m.HttpMatch != nil {
 		dAtA[i] = 0xd2
@@ -5291,11 +6127,11 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x7
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.HttpMatch.Size()))
-		n50, err := m.HttpMatch.MarshalTo(dAtA[i:])
+		n57, err := m.HttpMatch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n57
 	}
 	if m.Metadata != nil {
 		dAtA[i] = 0xda
@@ -5303,11 +6139,11 @@ func (m *Rule) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x7
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Metadata.Size()))
-		n51, err := m.Metadata.MarshalTo(dAtA[i:])
+		n58, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n58
 	}
 	if len(m.OriginalDstService) > 0 {
 		dAtA[i] = 0x92
@@ -5365,11 +6201,11 @@ func (m *Rule_IcmpTypeCode) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IcmpTypeCode.Size()))
-		n52, err := m.IcmpTypeCode.MarshalTo(dAtA[i:])
+		n59, err := m.IcmpTypeCode.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n59
 	}
 	return i, nil
 }
@@ -5390,11 +6226,11 @@ func (m *Rule_NotIcmpTypeCode) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.NotIcmpTypeCode.Size()))
-		n53, err := m.NotIcmpTypeCode.MarshalTo(dAtA[i:])
+		n60, err := m.NotIcmpTypeCode.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n60
 	}
 	return i, nil
 }
@@ -5498,11 +6334,11 @@ func (m *HTTPMatch_PathMatch) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.PathMatch != nil {
-		nn54, err := m.PathMatch.MarshalTo(dAtA[i:])
+		nn61, err := m.PathMatch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn54
+		i += nn61
 	}
 	return i, nil
 }
@@ -5602,11 +6438,11 @@ func (m *Protocol) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.NumberOrName != nil {
-		nn55, err := m.NumberOrName.MarshalTo(dAtA[i:])
+		nn62, err := m.NumberOrName.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn55
+		i += nn62
 	}
 	return i, nil
 }
@@ -5709,21 +6545,21 @@ func (m *WorkloadEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n56, err := m.Id.MarshalTo(dAtA[i:])
+		n63, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n63
 	}
 	if m.Endpoint != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Endpoint.Size()))
-		n57, err := m.Endpoint.MarshalTo(dAtA[i:])
+		n64, err := m.Endpoint.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n64
 	}
 	return i, nil
 }
@@ -5842,6 +6678,16 @@ func (m *WorkloadEndpoint) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.QosControls != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.QosControls.Size()))
+		n65, err := m.QosControls.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n65
+	}
 	return i, nil
 }
 
@@ -5864,11 +6710,11 @@ func (m *WorkloadEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n58, err := m.Id.MarshalTo(dAtA[i:])
+		n66, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n66
 	}
 	return i, nil
 }
@@ -5916,21 +6762,21 @@ func (m *HostEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n59, err := m.Id.MarshalTo(dAtA[i:])
+		n67, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n67
 	}
 	if m.Endpoint != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Endpoint.Size()))
-		n60, err := m.Endpoint.MarshalTo(dAtA[i:])
+		n68, err := m.Endpoint.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n60
+		i += n68
 	}
 	return i, nil
 }
@@ -6071,11 +6917,11 @@ func (m *HostEndpointRemove) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n61, err := m.Id.MarshalTo(dAtA[i:])
+		n69, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n61
+		i += n69
 	}
 	return i, nil
 }
@@ -6131,6 +6977,12 @@ func (m *TierInfo) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if len(m.DefaultAction) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.DefaultAction)))
+		i += copy(dAtA[i:], m.DefaultAction)
+	}
 	return i, nil
 }
 
@@ -6213,21 +7065,21 @@ func (m *HostEndpointStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n62, err := m.Id.MarshalTo(dAtA[i:])
+		n70, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n62
+		i += n70
 	}
 	if m.Status != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Status.Size()))
-		n63, err := m.Status.MarshalTo(dAtA[i:])
+		n71, err := m.Status.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i += n71
 	}
 	return i, nil
 }
@@ -6275,11 +7127,11 @@ func (m *HostEndpointStatusRemove) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n64, err := m.Id.MarshalTo(dAtA[i:])
+		n72, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i += n72
 	}
 	return i, nil
 }
@@ -6303,21 +7155,21 @@ func (m *WorkloadEndpointStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n65, err := m.Id.MarshalTo(dAtA[i:])
+		n73, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n65
+		i += n73
 	}
 	if m.Status != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Status.Size()))
-		n66, err := m.Status.MarshalTo(dAtA[i:])
+		n74, err := m.Status.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n74
 	}
 	return i, nil
 }
@@ -6341,11 +7193,11 @@ func (m *WorkloadEndpointStatusRemove) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n67, err := m.Id.MarshalTo(dAtA[i:])
+		n75, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n67
+		i += n75
 	}
 	return i, nil
 }
@@ -6371,15 +7223,41 @@ func (m *WireguardStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.PublicKey)))
 		i += copy(dAtA[i:], m.PublicKey)
 	}
-	return i, nil
-}
-
-func (m *HostMetadataUpdate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if m.IpVersion != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IpVersion))
+	}
+	if len(m.PendingPublicKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.PendingPublicKey)))
+		i += copy(dAtA[i:], m.PendingPublicKey)
+	}
+	if len(m.AppliedPendingPublicKeys) > 0 {
+		for _, s := range m.AppliedPendingPublicKeys {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *HostMetadataUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
@@ -6459,11 +7337,11 @@ func (m *IPAMPoolUpdate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Pool.Size()))
-		n68, err := m.Pool.MarshalTo(dAtA[i:])
+		n76, err := m.Pool.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n68
+		i += n76
 	}
 	return i, nil
 }
@@ -6561,6 +7439,16 @@ func (m *Encapsulation) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.VxlanEnabledV6 {
+		dAtA[i] = 0x18
+		i++
+		if m.VxlanEnabledV6 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -6583,11 +7471,11 @@ func (m *ServiceAccountUpdate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n69, err := m.Id.MarshalTo(dAtA[i:])
+		n77, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n69
+		i += n77
 	}
 	if len(m.Labels) > 0 {
 		for k, _ := range m.Labels {
@@ -6628,11 +7516,11 @@ func (m *ServiceAccountRemove) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n70, err := m.Id.MarshalTo(dAtA[i:])
+		n78, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n70
+		i += n78
 	}
 	return i, nil
 }
@@ -6686,11 +7574,11 @@ func (m *NamespaceUpdate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n71, err := m.Id.MarshalTo(dAtA[i:])
+		n79, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
+		i += n79
 	}
 	if len(m.Labels) > 0 {
 		for k, _ := range m.Labels {
@@ -6731,11 +7619,11 @@ func (m *NamespaceRemove) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
-		n72, err := m.Id.MarshalTo(dAtA[i:])
+		n80, err := m.Id.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n72
+		i += n80
 	}
 	return i, nil
 }
@@ -6889,11 +7777,11 @@ func (m *RouteUpdate) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintFelixbackend(dAtA, i, uint64(m.TunnelType.Size()))
-		n73, err := m.TunnelType.MarshalTo(dAtA[i:])
+		n81, err := m.TunnelType.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n73
+		i += n81
 	}
 	return i, nil
 }
@@ -6961,6 +7849,24 @@ func (m *VXLANTunnelEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.ParentDeviceIp)))
 		i += copy(dAtA[i:], m.ParentDeviceIp)
 	}
+	if len(m.Ipv6Addr) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv6Addr)))
+		i += copy(dAtA[i:], m.Ipv6Addr)
+	}
+	if len(m.ParentDeviceIpv6) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.ParentDeviceIpv6)))
+		i += copy(dAtA[i:], m.ParentDeviceIpv6)
+	}
+	if len(m.MacV6) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.MacV6)))
+		i += copy(dAtA[i:], m.MacV6)
+	}
 	return i, nil
 }
 
@@ -7021,6 +7927,27 @@ func (m *WireguardEndpointUpdate) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.InterfaceIpv4Addr)))
 		i += copy(dAtA[i:], m.InterfaceIpv4Addr)
 	}
+	if len(m.PendingPublicKey) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.PendingPublicKey)))
+		i += copy(dAtA[i:], m.PendingPublicKey)
+	}
+	if len(m.AppliedPendingPublicKeys) > 0 {
+		for _, s := range m.AppliedPendingPublicKeys {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -7111,295 +8038,484 @@ func (m *GlobalBGPConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintFelixbackend(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QoSControls) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *SyncRequest) Size() (n int) {
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ToDataplane) Size() (n int) {
+func (m *QoSControls) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Payload != nil {
-		n += m.Payload.Size()
+	if m.IngressBandwidth != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IngressBandwidth))
 	}
-	if m.SequenceNumber != 0 {
-		n += 1 + sovFelixbackend(uint64(m.SequenceNumber))
+	if m.EgressBandwidth != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.EgressBandwidth))
 	}
-	return n
+	if m.IngressBurst != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IngressBurst))
+	}
+	if m.EgressBurst != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.EgressBurst))
+	}
+	if m.IngressPacketRate != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.IngressPacketRate))
+	}
+	if m.EgressPacketRate != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.EgressPacketRate))
+	}
+	return i, nil
 }
 
-func (m *ToDataplane_InSync) Size() (n int) {
-	var l int
-	_ = l
-	if m.InSync != nil {
-		l = m.InSync.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+func (m *PacketCaptureID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_IpsetUpdate) Size() (n int) {
+
+func (m *PacketCaptureID) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.IpsetUpdate != nil {
-		l = m.IpsetUpdate.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.Namespace) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Namespace)))
+		i += copy(dAtA[i:], m.Namespace)
 	}
-	return n
-}
-func (m *ToDataplane_IpsetDeltaUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.IpsetDeltaUpdate != nil {
-		l = m.IpsetDeltaUpdate.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	return n
+	return i, nil
 }
-func (m *ToDataplane_IpsetRemove) Size() (n int) {
-	var l int
-	_ = l
-	if m.IpsetRemove != nil {
-		l = m.IpsetRemove.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+
+func (m *PacketCaptureUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_ActiveProfileUpdate) Size() (n int) {
+
+func (m *PacketCaptureUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ActiveProfileUpdate != nil {
-		l = m.ActiveProfileUpdate.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.Id != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n82, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n82
 	}
-	return n
-}
-func (m *ToDataplane_ActiveProfileRemove) Size() (n int) {
-	var l int
-	_ = l
-	if m.ActiveProfileRemove != nil {
-		l = m.ActiveProfileRemove.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.Filter) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Filter)))
+		i += copy(dAtA[i:], m.Filter)
 	}
-	return n
+	if len(m.Endpoints) > 0 {
+		for _, msg := range m.Endpoints {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
 }
-func (m *ToDataplane_ActivePolicyUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.ActivePolicyUpdate != nil {
-		l = m.ActivePolicyUpdate.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+
+func (m *PacketCaptureEndpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_ActivePolicyRemove) Size() (n int) {
+
+func (m *PacketCaptureEndpoint) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ActivePolicyRemove != nil {
-		l = m.ActivePolicyRemove.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.Id != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n83, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n83
 	}
-	return n
+	if len(m.Name) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.InterfaceName) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.InterfaceName)))
+		i += copy(dAtA[i:], m.InterfaceName)
+	}
+	return i, nil
 }
-func (m *ToDataplane_HostEndpointUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.HostEndpointUpdate != nil {
-		l = m.HostEndpointUpdate.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+
+func (m *PacketCaptureRemove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_HostEndpointRemove) Size() (n int) {
+
+func (m *PacketCaptureRemove) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.HostEndpointRemove != nil {
-		l = m.HostEndpointRemove.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.Id != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n84, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n84
 	}
-	return n
+	return i, nil
 }
-func (m *ToDataplane_WorkloadEndpointUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.WorkloadEndpointUpdate != nil {
-		l = m.WorkloadEndpointUpdate.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+
+func (m *PacketCaptureStatusUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_WorkloadEndpointRemove) Size() (n int) {
+
+func (m *PacketCaptureStatusUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.WorkloadEndpointRemove != nil {
-		l = m.WorkloadEndpointRemove.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.Id != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.Id.Size()))
+		n85, err := m.Id.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n85
 	}
-	return n
-}
-func (m *ToDataplane_ConfigUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.ConfigUpdate != nil {
-		l = m.ConfigUpdate.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.Directory) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Directory)))
+		i += copy(dAtA[i:], m.Directory)
 	}
-	return n
-}
-func (m *ToDataplane_HostMetadataUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.HostMetadataUpdate != nil {
-		l = m.HostMetadataUpdate.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.Endpoints) > 0 {
+		for _, msg := range m.Endpoints {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	return n
+	return i, nil
 }
-func (m *ToDataplane_IpamPoolUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.IpamPoolUpdate != nil {
-		l = m.IpamPoolUpdate.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+
+func (m *PacketCaptureEndpointStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_IpamPoolRemove) Size() (n int) {
+
+func (m *PacketCaptureEndpointStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.IpamPoolRemove != nil {
-		l = m.IpamPoolRemove.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	return n
-}
-func (m *ToDataplane_HostMetadataRemove) Size() (n int) {
-	var l int
-	_ = l
-	if m.HostMetadataRemove != nil {
-		l = m.HostMetadataRemove.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.InterfaceName) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.InterfaceName)))
+		i += copy(dAtA[i:], m.InterfaceName)
 	}
-	return n
+	if len(m.State) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if len(m.Files) > 0 {
+		for _, msg := range m.Files {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintFelixbackend(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
 }
-func (m *ToDataplane_ServiceAccountUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.ServiceAccountUpdate != nil {
-		l = m.ServiceAccountUpdate.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+
+func (m *PacketCaptureFile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_ServiceAccountRemove) Size() (n int) {
+
+func (m *PacketCaptureFile) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ServiceAccountRemove != nil {
-		l = m.ServiceAccountRemove.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
 	}
-	return n
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(m.SizeBytes))
+	}
+	return i, nil
 }
-func (m *ToDataplane_NamespaceUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.NamespaceUpdate != nil {
-		l = m.NamespaceUpdate.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+
+func (m *WireguardEndpointV6Update) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_NamespaceRemove) Size() (n int) {
+
+func (m *WireguardEndpointV6Update) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.NamespaceRemove != nil {
-		l = m.NamespaceRemove.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
 	}
-	return n
+	if len(m.PublicKeyV6) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.PublicKeyV6)))
+		i += copy(dAtA[i:], m.PublicKeyV6)
+	}
+	if len(m.InterfaceIpv6Addr) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.InterfaceIpv6Addr)))
+		i += copy(dAtA[i:], m.InterfaceIpv6Addr)
+	}
+	if len(m.PendingPublicKeyV6) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.PendingPublicKeyV6)))
+		i += copy(dAtA[i:], m.PendingPublicKeyV6)
+	}
+	if len(m.AppliedPendingPublicKeysV6) > 0 {
+		for _, s := range m.AppliedPendingPublicKeysV6 {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
 }
-func (m *ToDataplane_RouteUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.RouteUpdate != nil {
-		l = m.RouteUpdate.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+
+func (m *WireguardEndpointV6Remove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_RouteRemove) Size() (n int) {
+
+func (m *WireguardEndpointV6Remove) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.RouteRemove != nil {
-		l = m.RouteRemove.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
 	}
-	return n
+	return i, nil
 }
-func (m *ToDataplane_VtepUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.VtepUpdate != nil {
-		l = m.VtepUpdate.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+
+func (m *HostMetadataV6Update) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_VtepRemove) Size() (n int) {
+
+func (m *HostMetadataV6Update) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.VtepRemove != nil {
-		l = m.VtepRemove.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
 	}
-	return n
+	if len(m.Ipv6Addr) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv6Addr)))
+		i += copy(dAtA[i:], m.Ipv6Addr)
+	}
+	return i, nil
 }
-func (m *ToDataplane_WireguardEndpointUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.WireguardEndpointUpdate != nil {
-		l = m.WireguardEndpointUpdate.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+
+func (m *HostMetadataV6Remove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ToDataplane_WireguardEndpointRemove) Size() (n int) {
+
+func (m *HostMetadataV6Remove) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.WireguardEndpointRemove != nil {
-		l = m.WireguardEndpointRemove.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+	if len(m.Hostname) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Hostname)))
+		i += copy(dAtA[i:], m.Hostname)
 	}
-	return n
+	if len(m.Ipv6Addr) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintFelixbackend(dAtA, i, uint64(len(m.Ipv6Addr)))
+		i += copy(dAtA[i:], m.Ipv6Addr)
+	}
+	return i, nil
 }
-func (m *ToDataplane_GlobalBgpConfigUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.GlobalBgpConfigUpdate != nil {
-		l = m.GlobalBgpConfigUpdate.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
+
+func encodeVarintFelixbackend(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	return n
+	dAtA[offset] = uint8(v)
+	return offset + 1
 }
-func (m *ToDataplane_Encapsulation) Size() (n int) {
+func (m *SyncRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.Encapsulation != nil {
-		l = m.Encapsulation.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
 	return n
 }
-func (m *FromDataplane) Size() (n int) {
+
+func (m *ToDataplane) Size() (n int) {
 	var l int
 	_ = l
 	if m.Payload != nil {
@@ -7411,758 +8527,534 @@ func (m *FromDataplane) Size() (n int) {
 	return n
 }
 
-func (m *FromDataplane_ProcessStatusUpdate) Size() (n int) {
+func (m *ToDataplane_InSync) Size() (n int) {
 	var l int
 	_ = l
-	if m.ProcessStatusUpdate != nil {
-		l = m.ProcessStatusUpdate.Size()
+	if m.InSync != nil {
+		l = m.InSync.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-func (m *FromDataplane_HostEndpointStatusUpdate) Size() (n int) {
+func (m *ToDataplane_IpsetUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if m.HostEndpointStatusUpdate != nil {
-		l = m.HostEndpointStatusUpdate.Size()
+	if m.IpsetUpdate != nil {
+		l = m.IpsetUpdate.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-func (m *FromDataplane_HostEndpointStatusRemove) Size() (n int) {
+func (m *ToDataplane_IpsetDeltaUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if m.HostEndpointStatusRemove != nil {
-		l = m.HostEndpointStatusRemove.Size()
+	if m.IpsetDeltaUpdate != nil {
+		l = m.IpsetDeltaUpdate.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-func (m *FromDataplane_WorkloadEndpointStatusUpdate) Size() (n int) {
+func (m *ToDataplane_IpsetRemove) Size() (n int) {
 	var l int
 	_ = l
-	if m.WorkloadEndpointStatusUpdate != nil {
-		l = m.WorkloadEndpointStatusUpdate.Size()
+	if m.IpsetRemove != nil {
+		l = m.IpsetRemove.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-func (m *FromDataplane_WorkloadEndpointStatusRemove) Size() (n int) {
+func (m *ToDataplane_ActiveProfileUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if m.WorkloadEndpointStatusRemove != nil {
-		l = m.WorkloadEndpointStatusRemove.Size()
+	if m.ActiveProfileUpdate != nil {
+		l = m.ActiveProfileUpdate.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-func (m *FromDataplane_WireguardStatusUpdate) Size() (n int) {
+func (m *ToDataplane_ActiveProfileRemove) Size() (n int) {
 	var l int
 	_ = l
-	if m.WireguardStatusUpdate != nil {
-		l = m.WireguardStatusUpdate.Size()
+	if m.ActiveProfileRemove != nil {
+		l = m.ActiveProfileRemove.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-func (m *ConfigUpdate) Size() (n int) {
+func (m *ToDataplane_ActivePolicyUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Config) > 0 {
-		for k, v := range m.Config {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
-			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
-		}
+	if m.ActivePolicyUpdate != nil {
+		l = m.ActivePolicyUpdate.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *InSync) Size() (n int) {
+func (m *ToDataplane_ActivePolicyRemove) Size() (n int) {
 	var l int
 	_ = l
+	if m.ActivePolicyRemove != nil {
+		l = m.ActivePolicyRemove.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
 	return n
 }
-
-func (m *IPSetUpdate) Size() (n int) {
+func (m *ToDataplane_HostEndpointUpdate) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
+	if m.HostEndpointUpdate != nil {
+		l = m.HostEndpointUpdate.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if len(m.Members) > 0 {
-		for _, s := range m.Members {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if m.Type != 0 {
-		n += 1 + sovFelixbackend(uint64(m.Type))
-	}
 	return n
 }
-
-func (m *IPSetDeltaUpdate) Size() (n int) {
+func (m *ToDataplane_HostEndpointRemove) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
+	if m.HostEndpointRemove != nil {
+		l = m.HostEndpointRemove.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if len(m.AddedMembers) > 0 {
-		for _, s := range m.AddedMembers {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.RemovedMembers) > 0 {
-		for _, s := range m.RemovedMembers {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
 	return n
 }
-
-func (m *IPSetRemove) Size() (n int) {
+func (m *ToDataplane_WorkloadEndpointUpdate) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
+	if m.WorkloadEndpointUpdate != nil {
+		l = m.WorkloadEndpointUpdate.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *ActiveProfileUpdate) Size() (n int) {
+func (m *ToDataplane_WorkloadEndpointRemove) Size() (n int) {
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	if m.Profile != nil {
-		l = m.Profile.Size()
+	if m.WorkloadEndpointRemove != nil {
+		l = m.WorkloadEndpointRemove.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *ActiveProfileRemove) Size() (n int) {
+func (m *ToDataplane_ConfigUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
+	if m.ConfigUpdate != nil {
+		l = m.ConfigUpdate.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *ProfileID) Size() (n int) {
+func (m *ToDataplane_HostMetadataUpdate) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
+	if m.HostMetadataUpdate != nil {
+		l = m.HostMetadataUpdate.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *Profile) Size() (n int) {
+func (m *ToDataplane_IpamPoolUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.InboundRules) > 0 {
-		for _, e := range m.InboundRules {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.OutboundRules) > 0 {
-		for _, e := range m.OutboundRules {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
+	if m.IpamPoolUpdate != nil {
+		l = m.IpamPoolUpdate.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *ActivePolicyUpdate) Size() (n int) {
+func (m *ToDataplane_IpamPoolRemove) Size() (n int) {
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.IpamPoolRemove != nil {
+		l = m.IpamPoolRemove.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	if m.Policy != nil {
-		l = m.Policy.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	return n
+}
+func (m *ToDataplane_HostMetadataRemove) Size() (n int) {
+	var l int
+	_ = l
+	if m.HostMetadataRemove != nil {
+		l = m.HostMetadataRemove.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *ActivePolicyRemove) Size() (n int) {
+func (m *ToDataplane_ServiceAccountUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.ServiceAccountUpdate != nil {
+		l = m.ServiceAccountUpdate.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *PolicyID) Size() (n int) {
+func (m *ToDataplane_ServiceAccountRemove) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Tier)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.ServiceAccountRemove != nil {
+		l = m.ServiceAccountRemove.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	return n
+}
+func (m *ToDataplane_NamespaceUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.NamespaceUpdate != nil {
+		l = m.NamespaceUpdate.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *Policy) Size() (n int) {
+func (m *ToDataplane_NamespaceRemove) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.InboundRules) > 0 {
-		for _, e := range m.InboundRules {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.OutboundRules) > 0 {
-		for _, e := range m.OutboundRules {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if m.Untracked {
-		n += 2
-	}
-	if m.PreDnat {
-		n += 2
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.NamespaceRemove != nil {
+		l = m.NamespaceRemove.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *Rule) Size() (n int) {
+func (m *ToDataplane_RouteUpdate) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Action)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	if m.IpVersion != 0 {
-		n += 1 + sovFelixbackend(uint64(m.IpVersion))
-	}
-	if m.Protocol != nil {
-		l = m.Protocol.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	if len(m.SrcNet) > 0 {
-		for _, s := range m.SrcNet {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.SrcPorts) > 0 {
-		for _, e := range m.SrcPorts {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.DstNet) > 0 {
-		for _, s := range m.DstNet {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.DstPorts) > 0 {
-		for _, e := range m.DstPorts {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if m.Icmp != nil {
-		n += m.Icmp.Size()
-	}
-	if len(m.SrcIpSetIds) > 0 {
-		for _, s := range m.SrcIpSetIds {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.DstIpSetIds) > 0 {
-		for _, s := range m.DstIpSetIds {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.SrcNamedPortIpSetIds) > 0 {
-		for _, s := range m.SrcNamedPortIpSetIds {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.DstNamedPortIpSetIds) > 0 {
-		for _, s := range m.DstNamedPortIpSetIds {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.DstIpPortSetIds) > 0 {
-		for _, s := range m.DstIpPortSetIds {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if m.NotProtocol != nil {
-		l = m.NotProtocol.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
-	if len(m.NotSrcNet) > 0 {
-		for _, s := range m.NotSrcNet {
-			l = len(s)
-			n += 2 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.NotSrcPorts) > 0 {
-		for _, e := range m.NotSrcPorts {
-			l = e.Size()
-			n += 2 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.NotDstNet) > 0 {
-		for _, s := range m.NotDstNet {
-			l = len(s)
-			n += 2 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.NotDstPorts) > 0 {
-		for _, e := range m.NotDstPorts {
-			l = e.Size()
-			n += 2 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if m.NotIcmp != nil {
-		n += m.NotIcmp.Size()
-	}
-	if len(m.NotSrcIpSetIds) > 0 {
-		for _, s := range m.NotSrcIpSetIds {
-			l = len(s)
-			n += 2 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.NotDstIpSetIds) > 0 {
-		for _, s := range m.NotDstIpSetIds {
-			l = len(s)
-			n += 2 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.NotSrcNamedPortIpSetIds) > 0 {
-		for _, s := range m.NotSrcNamedPortIpSetIds {
-			l = len(s)
-			n += 2 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.NotDstNamedPortIpSetIds) > 0 {
-		for _, s := range m.NotDstNamedPortIpSetIds {
-			l = len(s)
-			n += 2 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	l = len(m.OriginalSrcSelector)
-	if l > 0 {
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.OriginalDstSelector)
-	if l > 0 {
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.OriginalSrcNamespaceSelector)
-	if l > 0 {
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.OriginalDstNamespaceSelector)
-	if l > 0 {
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.OriginalNotSrcSelector)
-	if l > 0 {
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.OriginalNotDstSelector)
-	if l > 0 {
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
-	if m.SrcServiceAccountMatch != nil {
-		l = m.SrcServiceAccountMatch.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
-	if m.DstServiceAccountMatch != nil {
-		l = m.DstServiceAccountMatch.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
-	if m.HttpMatch != nil {
-		l = m.HttpMatch.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.OriginalDstService)
-	if l > 0 {
-		n += 2 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.OriginalDstServiceNamespace)
-	if l > 0 {
+	if m.RouteUpdate != nil {
+		l = m.RouteUpdate.Size()
 		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.OriginalSrcService)
-	if l > 0 {
+	return n
+}
+func (m *ToDataplane_RouteRemove) Size() (n int) {
+	var l int
+	_ = l
+	if m.RouteRemove != nil {
+		l = m.RouteRemove.Size()
 		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.OriginalSrcServiceNamespace)
-	if l > 0 {
+	return n
+}
+func (m *ToDataplane_VtepUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.VtepUpdate != nil {
+		l = m.VtepUpdate.Size()
 		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.RuleId)
-	if l > 0 {
+	return n
+}
+func (m *ToDataplane_VtepRemove) Size() (n int) {
+	var l int
+	_ = l
+	if m.VtepRemove != nil {
+		l = m.VtepRemove.Size()
 		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *Rule_IcmpType) Size() (n int) {
+func (m *ToDataplane_WireguardEndpointUpdate) Size() (n int) {
 	var l int
 	_ = l
-	n += 1 + sovFelixbackend(uint64(m.IcmpType))
+	if m.WireguardEndpointUpdate != nil {
+		l = m.WireguardEndpointUpdate.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
+	}
 	return n
 }
-func (m *Rule_IcmpTypeCode) Size() (n int) {
+func (m *ToDataplane_WireguardEndpointRemove) Size() (n int) {
 	var l int
 	_ = l
-	if m.IcmpTypeCode != nil {
-		l = m.IcmpTypeCode.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.WireguardEndpointRemove != nil {
+		l = m.WireguardEndpointRemove.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-func (m *Rule_NotIcmpType) Size() (n int) {
+func (m *ToDataplane_GlobalBgpConfigUpdate) Size() (n int) {
 	var l int
 	_ = l
-	n += 2 + sovFelixbackend(uint64(m.NotIcmpType))
+	if m.GlobalBgpConfigUpdate != nil {
+		l = m.GlobalBgpConfigUpdate.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
+	}
 	return n
 }
-func (m *Rule_NotIcmpTypeCode) Size() (n int) {
+func (m *ToDataplane_Encapsulation) Size() (n int) {
 	var l int
 	_ = l
-	if m.NotIcmpTypeCode != nil {
-		l = m.NotIcmpTypeCode.Size()
+	if m.Encapsulation != nil {
+		l = m.Encapsulation.Size()
 		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-func (m *ServiceAccountMatch) Size() (n int) {
+func (m *ToDataplane_PacketCaptureUpdate) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Selector)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	if len(m.Names) > 0 {
-		for _, s := range m.Names {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
+	if m.PacketCaptureUpdate != nil {
+		l = m.PacketCaptureUpdate.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *HTTPMatch) Size() (n int) {
+func (m *ToDataplane_PacketCaptureRemove) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Methods) > 0 {
-		for _, s := range m.Methods {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.Paths) > 0 {
-		for _, e := range m.Paths {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
+	if m.PacketCaptureRemove != nil {
+		l = m.PacketCaptureRemove.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *HTTPMatch_PathMatch) Size() (n int) {
+func (m *ToDataplane_WireguardEndpointV6Update) Size() (n int) {
 	var l int
 	_ = l
-	if m.PathMatch != nil {
-		n += m.PathMatch.Size()
+	if m.WireguardEndpointV6Update != nil {
+		l = m.WireguardEndpointV6Update.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *HTTPMatch_PathMatch_Exact) Size() (n int) {
+func (m *ToDataplane_WireguardEndpointV6Remove) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Exact)
-	n += 1 + l + sovFelixbackend(uint64(l))
+	if m.WireguardEndpointV6Remove != nil {
+		l = m.WireguardEndpointV6Remove.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
+	}
 	return n
 }
-func (m *HTTPMatch_PathMatch_Prefix) Size() (n int) {
+func (m *ToDataplane_HostMetadataV6Update) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Prefix)
-	n += 1 + l + sovFelixbackend(uint64(l))
+	if m.HostMetadataV6Update != nil {
+		l = m.HostMetadataV6Update.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
+	}
 	return n
 }
-func (m *RuleMetadata) Size() (n int) {
+func (m *ToDataplane_HostMetadataV6Remove) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
-			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
-		}
+	if m.HostMetadataV6Remove != nil {
+		l = m.HostMetadataV6Remove.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *IcmpTypeAndCode) Size() (n int) {
+func (m *FromDataplane) Size() (n int) {
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovFelixbackend(uint64(m.Type))
+	if m.Payload != nil {
+		n += m.Payload.Size()
 	}
-	if m.Code != 0 {
-		n += 1 + sovFelixbackend(uint64(m.Code))
+	if m.SequenceNumber != 0 {
+		n += 1 + sovFelixbackend(uint64(m.SequenceNumber))
 	}
 	return n
 }
 
-func (m *Protocol) Size() (n int) {
+func (m *FromDataplane_ProcessStatusUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if m.NumberOrName != nil {
-		n += m.NumberOrName.Size()
+	if m.ProcessStatusUpdate != nil {
+		l = m.ProcessStatusUpdate.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *Protocol_Number) Size() (n int) {
+func (m *FromDataplane_HostEndpointStatusUpdate) Size() (n int) {
 	var l int
 	_ = l
-	n += 1 + sovFelixbackend(uint64(m.Number))
+	if m.HostEndpointStatusUpdate != nil {
+		l = m.HostEndpointStatusUpdate.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
 	return n
 }
-func (m *Protocol_Name) Size() (n int) {
+func (m *FromDataplane_HostEndpointStatusRemove) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovFelixbackend(uint64(l))
+	if m.HostEndpointStatusRemove != nil {
+		l = m.HostEndpointStatusRemove.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
 	return n
 }
-func (m *PortRange) Size() (n int) {
+func (m *FromDataplane_WorkloadEndpointStatusUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if m.First != 0 {
-		n += 1 + sovFelixbackend(uint64(m.First))
-	}
-	if m.Last != 0 {
-		n += 1 + sovFelixbackend(uint64(m.Last))
+	if m.WorkloadEndpointStatusUpdate != nil {
+		l = m.WorkloadEndpointStatusUpdate.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *WorkloadEndpointID) Size() (n int) {
+func (m *FromDataplane_WorkloadEndpointStatusRemove) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.OrchestratorId)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.WorkloadId)
-	if l > 0 {
+	if m.WorkloadEndpointStatusRemove != nil {
+		l = m.WorkloadEndpointStatusRemove.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.EndpointId)
-	if l > 0 {
+	return n
+}
+func (m *FromDataplane_WireguardStatusUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.WireguardStatusUpdate != nil {
+		l = m.WireguardStatusUpdate.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *WorkloadEndpointUpdate) Size() (n int) {
+func (m *FromDataplane_PacketCaptureStatusUpdate) Size() (n int) {
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
+	if m.PacketCaptureStatusUpdate != nil {
+		l = m.PacketCaptureStatusUpdate.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if m.Endpoint != nil {
-		l = m.Endpoint.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	return n
+}
+func (m *ConfigUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Config) > 0 {
+		for k, v := range m.Config {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
+		}
 	}
 	return n
 }
 
-func (m *WorkloadEndpoint) Size() (n int) {
+func (m *InSync) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.Mac)
+	return n
+}
+
+func (m *IPSetUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if len(m.ProfileIds) > 0 {
-		for _, s := range m.ProfileIds {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.Ipv4Nets) > 0 {
-		for _, s := range m.Ipv4Nets {
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
 			l = len(s)
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	if len(m.Ipv6Nets) > 0 {
-		for _, s := range m.Ipv6Nets {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
+	if m.Type != 0 {
+		n += 1 + sovFelixbackend(uint64(m.Type))
 	}
-	if len(m.Tiers) > 0 {
-		for _, e := range m.Tiers {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
+	return n
+}
+
+func (m *IPSetDeltaUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if len(m.Ipv4Nat) > 0 {
-		for _, e := range m.Ipv4Nat {
-			l = e.Size()
+	if len(m.AddedMembers) > 0 {
+		for _, s := range m.AddedMembers {
+			l = len(s)
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	if len(m.Ipv6Nat) > 0 {
-		for _, e := range m.Ipv6Nat {
-			l = e.Size()
+	if len(m.RemovedMembers) > 0 {
+		for _, s := range m.RemovedMembers {
+			l = len(s)
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *WorkloadEndpointRemove) Size() (n int) {
+func (m *IPSetRemove) Size() (n int) {
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
+	l = len(m.Id)
+	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
 
-func (m *HostEndpointID) Size() (n int) {
+func (m *ActiveProfileUpdate) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.EndpointId)
-	if l > 0 {
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.Profile != nil {
+		l = m.Profile.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
 
-func (m *HostEndpointUpdate) Size() (n int) {
+func (m *ActiveProfileRemove) Size() (n int) {
 	var l int
 	_ = l
 	if m.Id != nil {
 		l = m.Id.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if m.Endpoint != nil {
-		l = m.Endpoint.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
 	return n
 }
 
-func (m *HostEndpoint) Size() (n int) {
+func (m *ProfileID) Size() (n int) {
 	var l int
 	_ = l
 	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if len(m.ProfileIds) > 0 {
-		for _, s := range m.ProfileIds {
-			l = len(s)
+	return n
+}
+
+func (m *Profile) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.InboundRules) > 0 {
+		for _, e := range m.InboundRules {
+			l = e.Size()
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	if len(m.Tiers) > 0 {
-		for _, e := range m.Tiers {
+	if len(m.OutboundRules) > 0 {
+		for _, e := range m.OutboundRules {
 			l = e.Size()
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	if len(m.ExpectedIpv4Addrs) > 0 {
-		for _, s := range m.ExpectedIpv4Addrs {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
+	return n
+}
+
+func (m *ActivePolicyUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	if len(m.ExpectedIpv6Addrs) > 0 {
-		for _, s := range m.ExpectedIpv6Addrs {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.UntrackedTiers) > 0 {
-		for _, e := range m.UntrackedTiers {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.PreDnatTiers) > 0 {
-		for _, e := range m.PreDnatTiers {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.ForwardTiers) > 0 {
-		for _, e := range m.ForwardTiers {
-			l = e.Size()
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
 
-func (m *HostEndpointRemove) Size() (n int) {
+func (m *ActivePolicyRemove) Size() (n int) {
 	var l int
 	_ = l
 	if m.Id != nil {
@@ -8172,521 +9064,2319 @@ func (m *HostEndpointRemove) Size() (n int) {
 	return n
 }
 
-func (m *TierInfo) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	if len(m.IngressPolicies) > 0 {
-		for _, s := range m.IngressPolicies {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	if len(m.EgressPolicies) > 0 {
-		for _, s := range m.EgressPolicies {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
-	}
-	return n
-}
-
-func (m *NatInfo) Size() (n int) {
+func (m *PolicyID) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ExtIp)
+	l = len(m.Tier)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.IntIp)
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
 
-func (m *ProcessStatusUpdate) Size() (n int) {
+func (m *Policy) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.IsoTimestamp)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.InboundRules) > 0 {
+		for _, e := range m.InboundRules {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	if m.Uptime != 0 {
-		n += 9
+	if len(m.OutboundRules) > 0 {
+		for _, e := range m.OutboundRules {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *HostEndpointStatusUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.Untracked {
+		n += 2
 	}
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.PreDnat {
+		n += 2
 	}
-	return n
-}
-
-func (m *EndpointStatus) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Status)
+	l = len(m.Namespace)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
 
-func (m *HostEndpointStatusRemove) Size() (n int) {
+func (m *Rule) Size() (n int) {
 	var l int
 	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
+	l = len(m.Action)
+	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	return n
-}
-
-func (m *WorkloadEndpointStatusUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.IpVersion != 0 {
+		n += 1 + sovFelixbackend(uint64(m.IpVersion))
 	}
-	if m.Status != nil {
-		l = m.Status.Size()
+	if m.Protocol != nil {
+		l = m.Protocol.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	return n
-}
-
-func (m *WorkloadEndpointStatusRemove) Size() (n int) {
-	var l int
-	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.SrcNet) > 0 {
+		for _, s := range m.SrcNet {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *WireguardStatusUpdate) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.SrcPorts) > 0 {
+		for _, e := range m.SrcPorts {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *HostMetadataUpdate) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Hostname)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.DstNet) > 0 {
+		for _, s := range m.DstNet {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	l = len(m.Ipv4Addr)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.DstPorts) > 0 {
+		for _, e := range m.DstPorts {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *HostMetadataRemove) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Hostname)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.Icmp != nil {
+		n += m.Icmp.Size()
 	}
-	l = len(m.Ipv4Addr)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.SrcIpSetIds) > 0 {
+		for _, s := range m.SrcIpSetIds {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *IPAMPoolUpdate) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.DstIpSetIds) > 0 {
+		for _, s := range m.DstIpSetIds {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	if m.Pool != nil {
-		l = m.Pool.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.SrcNamedPortIpSetIds) > 0 {
+		for _, s := range m.SrcNamedPortIpSetIds {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *IPAMPoolRemove) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.DstNamedPortIpSetIds) > 0 {
+		for _, s := range m.DstNamedPortIpSetIds {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *IPAMPool) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Cidr)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.DstIpPortSetIds) > 0 {
+		for _, s := range m.DstIpPortSetIds {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	if m.Masquerade {
-		n += 2
+	if m.NotProtocol != nil {
+		l = m.NotProtocol.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	return n
-}
-
-func (m *Encapsulation) Size() (n int) {
-	var l int
-	_ = l
-	if m.IpipEnabled {
-		n += 2
+	if len(m.NotSrcNet) > 0 {
+		for _, s := range m.NotSrcNet {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	if m.VxlanEnabled {
-		n += 2
+	if len(m.NotSrcPorts) > 0 {
+		for _, e := range m.NotSrcPorts {
+			l = e.Size()
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *ServiceAccountUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
-			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
+	if len(m.NotDstNet) > 0 {
+		for _, s := range m.NotDstNet {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *ServiceAccountRemove) Size() (n int) {
-	var l int
-	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.NotDstPorts) > 0 {
+		for _, e := range m.NotDstPorts {
+			l = e.Size()
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *ServiceAccountID) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.NotIcmp != nil {
+		n += m.NotIcmp.Size()
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.NotSrcIpSetIds) > 0 {
+		for _, s := range m.NotSrcIpSetIds {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *NamespaceUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.NotDstIpSetIds) > 0 {
+		for _, s := range m.NotDstIpSetIds {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
-			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
+	if len(m.NotSrcNamedPortIpSetIds) > 0 {
+		for _, s := range m.NotSrcNamedPortIpSetIds {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *NamespaceRemove) Size() (n int) {
-	var l int
-	_ = l
-	if m.Id != nil {
-		l = m.Id.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if len(m.NotDstNamedPortIpSetIds) > 0 {
+		for _, s := range m.NotDstNamedPortIpSetIds {
+			l = len(s)
+			n += 2 + l + sovFelixbackend(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *NamespaceID) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Name)
+	l = len(m.OriginalSrcSelector)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	return n
-}
-
-func (m *TunnelType) Size() (n int) {
-	var l int
-	_ = l
-	if m.Ipip {
-		n += 2
-	}
-	if m.Vxlan {
-		n += 2
-	}
-	if m.Wireguard {
-		n += 2
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	return n
-}
-
-func (m *RouteUpdate) Size() (n int) {
-	var l int
-	_ = l
-	if m.Type != 0 {
-		n += 1 + sovFelixbackend(uint64(m.Type))
+	l = len(m.OriginalDstSelector)
+	if l > 0 {
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	if m.IpPoolType != 0 {
-		n += 1 + sovFelixbackend(uint64(m.IpPoolType))
+	l = len(m.OriginalSrcNamespaceSelector)
+	if l > 0 {
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.Dst)
+	l = len(m.OriginalDstNamespaceSelector)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.DstNodeName)
+	l = len(m.OriginalNotSrcSelector)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.DstNodeIp)
+	l = len(m.OriginalNotDstSelector)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	if m.SameSubnet {
-		n += 2
+	if m.SrcServiceAccountMatch != nil {
+		l = m.SrcServiceAccountMatch.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	if m.NatOutgoing {
-		n += 2
+	if m.DstServiceAccountMatch != nil {
+		l = m.DstServiceAccountMatch.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	if m.LocalWorkload {
-		n += 2
+	if m.HttpMatch != nil {
+		l = m.HttpMatch.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	if m.TunnelType != nil {
-		l = m.TunnelType.Size()
-		n += 1 + l + sovFelixbackend(uint64(l))
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	return n
-}
-
-func (m *RouteRemove) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Dst)
+	l = len(m.OriginalDstService)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	return n
-}
-
-func (m *VXLANTunnelEndpointUpdate) Size() (n int) {
-	var l int
-	_ = l
-	l = len(m.Node)
+	l = len(m.OriginalDstServiceNamespace)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.Mac)
+	l = len(m.OriginalSrcService)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.Ipv4Addr)
+	l = len(m.OriginalSrcServiceNamespace)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.ParentDeviceIp)
+	l = len(m.RuleId)
 	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
 
-func (m *VXLANTunnelEndpointRemove) Size() (n int) {
+func (m *Rule_IcmpType) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Node)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
+	n += 1 + sovFelixbackend(uint64(m.IcmpType))
 	return n
 }
-
-func (m *WireguardEndpointUpdate) Size() (n int) {
+func (m *Rule_IcmpTypeCode) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Hostname)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
-	}
-	l = len(m.PublicKey)
-	if l > 0 {
+	if m.IcmpTypeCode != nil {
+		l = m.IcmpTypeCode.Size()
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
-	l = len(m.InterfaceIpv4Addr)
-	if l > 0 {
-		n += 1 + l + sovFelixbackend(uint64(l))
+	return n
+}
+func (m *Rule_NotIcmpType) Size() (n int) {
+	var l int
+	_ = l
+	n += 2 + sovFelixbackend(uint64(m.NotIcmpType))
+	return n
+}
+func (m *Rule_NotIcmpTypeCode) Size() (n int) {
+	var l int
+	_ = l
+	if m.NotIcmpTypeCode != nil {
+		l = m.NotIcmpTypeCode.Size()
+		n += 2 + l + sovFelixbackend(uint64(l))
 	}
 	return n
 }
-
-func (m *WireguardEndpointRemove) Size() (n int) {
+func (m *ServiceAccountMatch) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Hostname)
+	l = len(m.Selector)
 	if l > 0 {
 		n += 1 + l + sovFelixbackend(uint64(l))
 	}
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *GlobalBGPConfigUpdate) Size() (n int) {
+func (m *HTTPMatch) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.ServiceClusterCidrs) > 0 {
-		for _, s := range m.ServiceClusterCidrs {
+	if len(m.Methods) > 0 {
+		for _, s := range m.Methods {
 			l = len(s)
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	if len(m.ServiceExternalCidrs) > 0 {
-		for _, s := range m.ServiceExternalCidrs {
-			l = len(s)
+	if len(m.Paths) > 0 {
+		for _, e := range m.Paths {
+			l = e.Size()
 			n += 1 + l + sovFelixbackend(uint64(l))
 		}
 	}
-	if len(m.ServiceLoadbalancerCidrs) > 0 {
-		for _, s := range m.ServiceLoadbalancerCidrs {
-			l = len(s)
-			n += 1 + l + sovFelixbackend(uint64(l))
-		}
+	return n
+}
+
+func (m *HTTPMatch_PathMatch) Size() (n int) {
+	var l int
+	_ = l
+	if m.PathMatch != nil {
+		n += m.PathMatch.Size()
 	}
 	return n
 }
 
-func sovFelixbackend(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *HTTPMatch_PathMatch_Exact) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Exact)
+	n += 1 + l + sovFelixbackend(uint64(l))
+	return n
+}
+func (m *HTTPMatch_PathMatch_Prefix) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	n += 1 + l + sovFelixbackend(uint64(l))
+	return n
+}
+func (m *RuleMetadata) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
 		}
 	}
 	return n
 }
-func sozFelixbackend(x uint64) (n int) {
-	return sovFelixbackend(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *IcmpTypeAndCode) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovFelixbackend(uint64(m.Type))
+	}
+	if m.Code != 0 {
+		n += 1 + sovFelixbackend(uint64(m.Code))
+	}
+	return n
 }
-func (m *SyncRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
+
+func (m *Protocol) Size() (n int) {
+	var l int
+	_ = l
+	if m.NumberOrName != nil {
+		n += m.NumberOrName.Size()
+	}
+	return n
+}
+
+func (m *Protocol_Number) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovFelixbackend(uint64(m.Number))
+	return n
+}
+func (m *Protocol_Name) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovFelixbackend(uint64(l))
+	return n
+}
+func (m *PortRange) Size() (n int) {
+	var l int
+	_ = l
+	if m.First != 0 {
+		n += 1 + sovFelixbackend(uint64(m.First))
+	}
+	if m.Last != 0 {
+		n += 1 + sovFelixbackend(uint64(m.Last))
+	}
+	return n
+}
+
+func (m *WorkloadEndpointID) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.OrchestratorId)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.WorkloadId)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.EndpointId)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkloadEndpointUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.Endpoint != nil {
+		l = m.Endpoint.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkloadEndpoint) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Mac)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if len(m.ProfileIds) > 0 {
+		for _, s := range m.ProfileIds {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.Ipv4Nets) > 0 {
+		for _, s := range m.Ipv4Nets {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.Ipv6Nets) > 0 {
+		for _, s := range m.Ipv6Nets {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.Ipv4Nat) > 0 {
+		for _, e := range m.Ipv4Nat {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.Ipv6Nat) > 0 {
+		for _, e := range m.Ipv6Nat {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if m.QosControls != nil {
+		l = m.QosControls.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkloadEndpointRemove) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *HostEndpointID) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.EndpointId)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *HostEndpointUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.Endpoint != nil {
+		l = m.Endpoint.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *HostEndpoint) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if len(m.ProfileIds) > 0 {
+		for _, s := range m.ProfileIds {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.ExpectedIpv4Addrs) > 0 {
+		for _, s := range m.ExpectedIpv4Addrs {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.ExpectedIpv6Addrs) > 0 {
+		for _, s := range m.ExpectedIpv6Addrs {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.UntrackedTiers) > 0 {
+		for _, e := range m.UntrackedTiers {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.PreDnatTiers) > 0 {
+		for _, e := range m.PreDnatTiers {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.ForwardTiers) > 0 {
+		for _, e := range m.ForwardTiers {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HostEndpointRemove) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *TierInfo) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if len(m.IngressPolicies) > 0 {
+		for _, s := range m.IngressPolicies {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.EgressPolicies) > 0 {
+		for _, s := range m.EgressPolicies {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	l = len(m.DefaultAction)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *NatInfo) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ExtIp)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.IntIp)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *ProcessStatusUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.IsoTimestamp)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.Uptime != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *HostEndpointStatusUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *EndpointStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *HostEndpointStatusRemove) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkloadEndpointStatusUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *WorkloadEndpointStatusRemove) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *WireguardStatusUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.IpVersion != 0 {
+		n += 1 + sovFelixbackend(uint64(m.IpVersion))
+	}
+	l = len(m.PendingPublicKey)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if len(m.AppliedPendingPublicKeys) > 0 {
+		for _, s := range m.AppliedPendingPublicKeys {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HostMetadataUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Ipv4Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *HostMetadataRemove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Ipv4Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *IPAMPoolUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.Pool != nil {
+		l = m.Pool.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *IPAMPoolRemove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *IPAMPool) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Cidr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.Masquerade {
+		n += 2
+	}
+	return n
+}
+
+func (m *Encapsulation) Size() (n int) {
+	var l int
+	_ = l
+	if m.IpipEnabled {
+		n += 2
+	}
+	if m.VxlanEnabled {
+		n += 2
+	}
+	if m.VxlanEnabledV6 {
+		n += 2
+	}
+	return n
+}
+
+func (m *ServiceAccountUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ServiceAccountRemove) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *ServiceAccountID) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *NamespaceUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovFelixbackend(uint64(len(k))) + 1 + len(v) + sovFelixbackend(uint64(len(v)))
+			n += mapEntrySize + 1 + sovFelixbackend(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *NamespaceRemove) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *NamespaceID) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *TunnelType) Size() (n int) {
+	var l int
+	_ = l
+	if m.Ipip {
+		n += 2
+	}
+	if m.Vxlan {
+		n += 2
+	}
+	if m.Wireguard {
+		n += 2
+	}
+	return n
+}
+
+func (m *RouteUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovFelixbackend(uint64(m.Type))
+	}
+	if m.IpPoolType != 0 {
+		n += 1 + sovFelixbackend(uint64(m.IpPoolType))
+	}
+	l = len(m.Dst)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.DstNodeName)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.DstNodeIp)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.SameSubnet {
+		n += 2
+	}
+	if m.NatOutgoing {
+		n += 2
+	}
+	if m.LocalWorkload {
+		n += 2
+	}
+	if m.TunnelType != nil {
+		l = m.TunnelType.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *RouteRemove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Dst)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *VXLANTunnelEndpointUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Mac)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Ipv4Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.ParentDeviceIp)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Ipv6Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.ParentDeviceIpv6)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.MacV6)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *VXLANTunnelEndpointRemove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *WireguardEndpointUpdate) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.InterfaceIpv4Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.PendingPublicKey)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if len(m.AppliedPendingPublicKeys) > 0 {
+		for _, s := range m.AppliedPendingPublicKeys {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WireguardEndpointRemove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *GlobalBGPConfigUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.ServiceClusterCidrs) > 0 {
+		for _, s := range m.ServiceClusterCidrs {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.ServiceExternalCidrs) > 0 {
+		for _, s := range m.ServiceExternalCidrs {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	if len(m.ServiceLoadbalancerCidrs) > 0 {
+		for _, s := range m.ServiceLoadbalancerCidrs {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QoSControls) Size() (n int) {
+	var l int
+	_ = l
+	if m.IngressBandwidth != 0 {
+		n += 1 + sovFelixbackend(uint64(m.IngressBandwidth))
+	}
+	if m.EgressBandwidth != 0 {
+		n += 1 + sovFelixbackend(uint64(m.EgressBandwidth))
+	}
+	if m.IngressBurst != 0 {
+		n += 1 + sovFelixbackend(uint64(m.IngressBurst))
+	}
+	if m.EgressBurst != 0 {
+		n += 1 + sovFelixbackend(uint64(m.EgressBurst))
+	}
+	if m.IngressPacketRate != 0 {
+		n += 1 + sovFelixbackend(uint64(m.IngressPacketRate))
+	}
+	if m.EgressPacketRate != 0 {
+		n += 1 + sovFelixbackend(uint64(m.EgressPacketRate))
+	}
+	return n
+}
+
+func (m *PacketCaptureID) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketCaptureUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Filter)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketCaptureEndpoint) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.InterfaceName)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketCaptureRemove) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketCaptureStatusUpdate) Size() (n int) {
+	var l int
+	_ = l
+	if m.Id != nil {
+		l = m.Id.Size()
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Directory)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketCaptureEndpointStatus) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.InterfaceName)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if len(m.Files) > 0 {
+		for _, e := range m.Files {
+			l = e.Size()
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketCaptureFile) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovFelixbackend(uint64(m.SizeBytes))
+	}
+	return n
+}
+
+func (m *WireguardEndpointV6Update) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.PublicKeyV6)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.InterfaceIpv6Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.PendingPublicKeyV6)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	if len(m.AppliedPendingPublicKeysV6) > 0 {
+		for _, s := range m.AppliedPendingPublicKeysV6 {
+			l = len(s)
+			n += 1 + l + sovFelixbackend(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WireguardEndpointV6Remove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *HostMetadataV6Update) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Ipv6Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func (m *HostMetadataV6Remove) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Hostname)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	l = len(m.Ipv6Addr)
+	if l > 0 {
+		n += 1 + l + sovFelixbackend(uint64(l))
+	}
+	return n
+}
+
+func sovFelixbackend(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozFelixbackend(x uint64) (n int) {
+	return sovFelixbackend(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SyncRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ToDataplane) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ToDataplane: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ToDataplane: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InSync", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &InSync{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_InSync{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpsetUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IPSetUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_IpsetUpdate{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpsetDeltaUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IPSetDeltaUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_IpsetDeltaUpdate{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpsetRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IPSetRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_IpsetRemove{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveProfileUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActiveProfileUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_ActiveProfileUpdate{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveProfileRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActiveProfileRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_ActiveProfileRemove{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePolicyUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActivePolicyUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_ActivePolicyUpdate{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePolicyRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ActivePolicyRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_ActivePolicyRemove{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEndpointUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HostEndpointUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_HostEndpointUpdate{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEndpointRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HostEndpointRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_HostEndpointRemove{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadEndpointUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkloadEndpointUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_WorkloadEndpointUpdate{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadEndpointRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WorkloadEndpointRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_WorkloadEndpointRemove{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ConfigUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_ConfigUpdate{v}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostMetadataUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HostMetadataUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_HostMetadataUpdate{v}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			}
+			m.SequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SequenceNumber |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpamPoolUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IPAMPoolUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_IpamPoolUpdate{v}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpamPoolRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &IPAMPoolRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_IpamPoolRemove{v}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostMetadataRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HostMetadataRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_HostMetadataRemove{v}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ServiceAccountUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_ServiceAccountUpdate{v}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ServiceAccountRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_ServiceAccountRemove{v}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NamespaceUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_NamespaceUpdate{v}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NamespaceRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_NamespaceRemove{v}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RouteUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_RouteUpdate{v}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &RouteRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_RouteRemove{v}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VtepUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &VXLANTunnelEndpointUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_VtepUpdate{v}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VtepRemove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &VXLANTunnelEndpointRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_VtepRemove{v}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WireguardEndpointUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &WireguardEndpointUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_WireguardEndpointUpdate{v}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WireguardEndpointRemove", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &WireguardEndpointRemove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SyncRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SyncRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
+			m.Payload = &ToDataplane_WireguardEndpointRemove{v}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalBgpConfigUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &GlobalBGPConfigUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			m.Payload = &ToDataplane_GlobalBgpConfigUpdate{v}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encapsulation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ToDataplane) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
+			v := &Encapsulation{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			m.Payload = &ToDataplane_Encapsulation{v}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketCaptureUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &PacketCaptureUpdate{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ToDataplane: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ToDataplane: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Payload = &ToDataplane_PacketCaptureUpdate{v}
+			iNdEx = postIndex
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InSync", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketCaptureRemove", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8710,15 +11400,15 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &InSync{}
+			v := &PacketCaptureRemove{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_InSync{v}
+			m.Payload = &ToDataplane_PacketCaptureRemove{v}
 			iNdEx = postIndex
-		case 2:
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpsetUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WireguardEndpointV6Update", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8742,15 +11432,15 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IPSetUpdate{}
+			v := &WireguardEndpointV6Update{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_IpsetUpdate{v}
+			m.Payload = &ToDataplane_WireguardEndpointV6Update{v}
 			iNdEx = postIndex
-		case 3:
+		case 34:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpsetDeltaUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WireguardEndpointV6Remove", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8774,15 +11464,15 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IPSetDeltaUpdate{}
+			v := &WireguardEndpointV6Remove{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_IpsetDeltaUpdate{v}
+			m.Payload = &ToDataplane_WireguardEndpointV6Remove{v}
 			iNdEx = postIndex
-		case 4:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpsetRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostMetadataV6Update", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8806,15 +11496,97 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IPSetRemove{}
+			v := &HostMetadataV6Update{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_IpsetRemove{v}
+			m.Payload = &ToDataplane_HostMetadataV6Update{v}
 			iNdEx = postIndex
-		case 5:
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostMetadataV6Remove", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &HostMetadataV6Remove{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &ToDataplane_HostMetadataV6Remove{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FromDataplane) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FromDataplane: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FromDataplane: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveProfileUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessStatusUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8838,15 +11610,15 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ActiveProfileUpdate{}
+			v := &ProcessStatusUpdate{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_ActiveProfileUpdate{v}
+			m.Payload = &FromDataplane_ProcessStatusUpdate{v}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActiveProfileRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEndpointStatusUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8870,15 +11642,15 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ActiveProfileRemove{}
+			v := &HostEndpointStatusUpdate{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_ActiveProfileRemove{v}
+			m.Payload = &FromDataplane_HostEndpointStatusUpdate{v}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivePolicyUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEndpointStatusRemove", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8902,15 +11674,15 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ActivePolicyUpdate{}
+			v := &HostEndpointStatusRemove{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_ActivePolicyUpdate{v}
+			m.Payload = &FromDataplane_HostEndpointStatusRemove{v}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivePolicyRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadEndpointStatusUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8934,15 +11706,15 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ActivePolicyRemove{}
+			v := &WorkloadEndpointStatusUpdate{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_ActivePolicyRemove{v}
+			m.Payload = &FromDataplane_WorkloadEndpointStatusUpdate{v}
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostEndpointUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadEndpointStatusRemove", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8966,17 +11738,17 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &HostEndpointUpdate{}
+			v := &WorkloadEndpointStatusRemove{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_HostEndpointUpdate{v}
+			m.Payload = &FromDataplane_WorkloadEndpointStatusRemove{v}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostEndpointRemove", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
 			}
-			var msglen int
+			m.SequenceNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -8986,27 +11758,14 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.SequenceNumber |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &HostEndpointRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &ToDataplane_HostEndpointRemove{v}
-			iNdEx = postIndex
-		case 11:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadEndpointUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WireguardStatusUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9030,15 +11789,15 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WorkloadEndpointUpdate{}
+			v := &WireguardStatusUpdate{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_WorkloadEndpointUpdate{v}
+			m.Payload = &FromDataplane_WireguardStatusUpdate{v}
 			iNdEx = postIndex
-		case 12:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadEndpointRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketCaptureStatusUpdate", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9062,15 +11821,65 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WorkloadEndpointRemove{}
+			v := &PacketCaptureStatusUpdate{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_WorkloadEndpointRemove{v}
+			m.Payload = &FromDataplane_PacketCaptureStatusUpdate{v}
 			iNdEx = postIndex
-		case 13:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConfigUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConfigUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConfigUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9090,72 +11899,207 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFelixbackend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFelixbackend(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Config[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InSync) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ConfigUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &ToDataplane_ConfigUpdate{v}
-			iNdEx = postIndex
-		case 14:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostMetadataUpdate", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InSync: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InSync: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &HostMetadataUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IPSetUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
 			}
-			m.Payload = &ToDataplane_HostMetadataUpdate{v}
-			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.SequenceNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SequenceNumber |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 16:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IPSetUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IPSetUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpamPoolUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -9165,29 +12109,26 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IPAMPoolUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &ToDataplane_IpamPoolUpdate{v}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpamPoolRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -9197,29 +12138,26 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IPAMPoolRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &ToDataplane_IpamPoolRemove{v}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostMetadataRemove", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -9229,29 +12167,66 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Type |= (IPSetUpdate_IPSetType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &HostMetadataRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IPSetDeltaUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
 			}
-			m.Payload = &ToDataplane_HostMetadataRemove{v}
-			iNdEx = postIndex
-		case 19:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IPSetDeltaUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IPSetDeltaUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -9261,29 +12236,26 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ServiceAccountUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &ToDataplane_ServiceAccountUpdate{v}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccountRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedMembers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -9293,29 +12265,26 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ServiceAccountRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &ToDataplane_ServiceAccountRemove{v}
+			m.AddedMembers = append(m.AddedMembers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 21:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedMembers", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -9325,29 +12294,76 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &NamespaceUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RemovedMembers = append(m.RemovedMembers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_NamespaceUpdate{v}
-			iNdEx = postIndex
-		case 22:
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IPSetRemove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IPSetRemove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IPSetRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -9357,27 +12373,74 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &NamespaceRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_NamespaceRemove{v}
-			iNdEx = postIndex
-		case 23:
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveProfileUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveProfileUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveProfileUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RouteUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9401,15 +12464,16 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RouteUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Id == nil {
+				m.Id = &ProfileID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_RouteUpdate{v}
 			iNdEx = postIndex
-		case 24:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RouteRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9433,15 +12497,66 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &RouteRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Profile == nil {
+				m.Profile = &Profile{}
+			}
+			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_RouteRemove{v}
 			iNdEx = postIndex
-		case 25:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActiveProfileRemove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActiveProfileRemove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActiveProfileRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VtepUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9465,49 +12580,68 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &VXLANTunnelEndpointUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Id == nil {
+				m.Id = &ProfileID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_VtepUpdate{v}
 			iNdEx = postIndex
-		case 26:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VtepRemove", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfileID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &VXLANTunnelEndpointRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Payload = &ToDataplane_VtepRemove{v}
-			iNdEx = postIndex
-		case 27:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfileID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfileID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WireguardEndpointUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -9517,59 +12651,74 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WireguardEndpointUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_WireguardEndpointUpdate{v}
-			iNdEx = postIndex
-		case 28:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WireguardEndpointRemove", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Profile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WireguardEndpointRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Payload = &ToDataplane_WireguardEndpointRemove{v}
-			iNdEx = postIndex
-		case 29:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Profile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Profile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalBgpConfigUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundRules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9593,15 +12742,14 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &GlobalBGPConfigUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.InboundRules = append(m.InboundRules, &Rule{})
+			if err := m.InboundRules[len(m.InboundRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_GlobalBgpConfigUpdate{v}
 			iNdEx = postIndex
-		case 30:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Encapsulation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundRules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9625,11 +12773,10 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &Encapsulation{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OutboundRules = append(m.OutboundRules, &Rule{})
+			if err := m.OutboundRules[len(m.OutboundRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &ToDataplane_Encapsulation{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9652,7 +12799,7 @@ func (m *ToDataplane) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FromDataplane) Unmarshal(dAtA []byte) error {
+func (m *ActivePolicyUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9675,15 +12822,15 @@ func (m *FromDataplane) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FromDataplane: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActivePolicyUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FromDataplane: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActivePolicyUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessStatusUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9707,15 +12854,16 @@ func (m *FromDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ProcessStatusUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Id == nil {
+				m.Id = &PolicyID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &FromDataplane_ProcessStatusUpdate{v}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostEndpointStatusUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9739,15 +12887,66 @@ func (m *FromDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &HostEndpointStatusUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Policy == nil {
+				m.Policy = &Policy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &FromDataplane_HostEndpointStatusUpdate{v}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActivePolicyRemove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActivePolicyRemove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActivePolicyRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostEndpointStatusRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9771,49 +12970,68 @@ func (m *FromDataplane) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &HostEndpointStatusRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Id == nil {
+				m.Id = &PolicyID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Payload = &FromDataplane_HostEndpointStatusRemove{v}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadEndpointStatusUpdate", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicyID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WorkloadEndpointStatusUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Payload = &FromDataplane_WorkloadEndpointStatusUpdate{v}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadEndpointStatusRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -9823,48 +13041,26 @@ func (m *FromDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WorkloadEndpointStatusRemove{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &FromDataplane_WorkloadEndpointStatusRemove{v}
+			m.Tier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SequenceNumber", wireType)
-			}
-			m.SequenceNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SequenceNumber |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WireguardStatusUpdate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -9874,23 +13070,20 @@ func (m *FromDataplane) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &WireguardStatusUpdate{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Payload = &FromDataplane_WireguardStatusUpdate{v}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9913,7 +13106,7 @@ func (m *FromDataplane) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConfigUpdate) Unmarshal(dAtA []byte) error {
+func (m *Policy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9936,15 +13129,15 @@ func (m *ConfigUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConfigUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: Policy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConfigUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Policy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundRules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9968,97 +13161,110 @@ func (m *ConfigUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = make(map[string]string)
+			m.InboundRules = append(m.InboundRules, &Rule{})
+			if err := m.InboundRules[len(m.InboundRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowFelixbackend
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundRules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFelixbackend
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFelixbackend
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipFelixbackend(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutboundRules = append(m.OutboundRules, &Rule{})
+			if err := m.OutboundRules[len(m.OutboundRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Untracked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Untracked = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreDnat", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PreDnat = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Config[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10081,7 +13287,7 @@ func (m *ConfigUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InSync) Unmarshal(dAtA []byte) error {
+func (m *Rule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10104,67 +13310,238 @@ func (m *InSync) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InSync: wiretype end group for non-group")
+			return fmt.Errorf("proto: Rule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InSync: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Rule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpVersion", wireType)
+			}
+			m.IpVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IpVersion |= (IPVersion(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Protocol == nil {
+				m.Protocol = &Protocol{}
+			}
+			if err := m.Protocol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IPSetUpdate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
+			m.SrcNet = append(m.SrcNet, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPorts", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcPorts = append(m.SrcPorts, &PortRange{})
+			if err := m.SrcPorts[len(m.SrcPorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstNet = append(m.DstNet, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstPorts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DstPorts = append(m.DstPorts, &PortRange{})
+			if err := m.DstPorts[len(m.DstPorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcmpType", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IPSetUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPSetUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Icmp = &Rule_IcmpType{v}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IcmpTypeCode", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -10174,24 +13551,27 @@ func (m *IPSetUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			v := &IcmpTypeAndCode{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Icmp = &Rule_IcmpTypeCode{v}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcIpSetIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10216,13 +13596,13 @@ func (m *IPSetUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			m.SrcIpSetIds = append(m.SrcIpSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstIpSetIds", wireType)
 			}
-			m.Type = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -10232,64 +13612,24 @@ func (m *IPSetUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= (IPSetUpdate_IPSetType(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IPSetDeltaUpdate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IPSetDeltaUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPSetDeltaUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.DstIpSetIds = append(m.DstIpSetIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcNamedPortIpSetIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10314,11 +13654,11 @@ func (m *IPSetDeltaUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.SrcNamedPortIpSetIds = append(m.SrcNamedPortIpSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstNamedPortIpSetIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10343,11 +13683,11 @@ func (m *IPSetDeltaUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddedMembers = append(m.AddedMembers, string(dAtA[iNdEx:postIndex]))
+			m.DstNamedPortIpSetIds = append(m.DstNamedPortIpSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemovedMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstIpPortSetIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10372,63 +13712,13 @@ func (m *IPSetDeltaUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemovedMembers = append(m.RemovedMembers, string(dAtA[iNdEx:postIndex]))
+			m.DstIpPortSetIds = append(m.DstIpPortSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IPSetRemove) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IPSetRemove: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPSetRemove: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotProtocol", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -10438,76 +13728,30 @@ func (m *IPSetRemove) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ActiveProfileUpdate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.NotProtocol == nil {
+				m.NotProtocol = &Protocol{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.NotProtocol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveProfileUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveProfileUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotSrcNet", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -10517,28 +13761,24 @@ func (m *ActiveProfileUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &ProfileID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NotSrcNet = append(m.NotSrcNet, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 104:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotSrcPorts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10562,66 +13802,43 @@ func (m *ActiveProfileUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Profile == nil {
-				m.Profile = &Profile{}
-			}
-			if err := m.Profile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NotSrcPorts = append(m.NotSrcPorts, &PortRange{})
+			if err := m.NotSrcPorts[len(m.NotSrcPorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
+		case 105:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotDstNet", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ActiveProfileRemove) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveProfileRemove: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveProfileRemove: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.NotDstNet = append(m.NotDstNet, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 106:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotDstPorts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10645,66 +13862,66 @@ func (m *ActiveProfileRemove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &ProfileID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NotDstPorts = append(m.NotDstPorts, &PortRange{})
+			if err := m.NotDstPorts[len(m.NotDstPorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 107:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotIcmpType", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.NotIcmp = &Rule_NotIcmpType{v}
+		case 108:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotIcmpTypeCode", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProfileID) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &IcmpTypeAndCode{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProfileID: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProfileID: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.NotIcmp = &Rule_NotIcmpTypeCode{v}
+			iNdEx = postIndex
+		case 109:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotSrcIpSetIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10724,68 +13941,18 @@ func (m *ProfileID) Unmarshal(dAtA []byte) error {
 			intStringLen := int(stringLen)
 			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Profile) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Profile: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Profile: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotSrcIpSetIds = append(m.NotSrcIpSetIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 110:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InboundRules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotDstIpSetIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -10795,28 +13962,26 @@ func (m *Profile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InboundRules = append(m.InboundRules, &Rule{})
-			if err := m.InboundRules[len(m.InboundRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NotDstIpSetIds = append(m.NotDstIpSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 112:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutboundRules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotSrcNamedPortIpSetIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -10826,78 +13991,26 @@ func (m *Profile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutboundRules = append(m.OutboundRules, &Rule{})
-			if err := m.OutboundRules[len(m.OutboundRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NotSrcNamedPortIpSetIds = append(m.NotSrcNamedPortIpSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ActivePolicyUpdate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ActivePolicyUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivePolicyUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 113:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NotDstNamedPortIpSetIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -10907,30 +14020,26 @@ func (m *ActivePolicyUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &PolicyID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NotDstNamedPortIpSetIds = append(m.NotDstNamedPortIpSetIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 114:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSrcSelector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -10940,80 +14049,26 @@ func (m *ActivePolicyUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Policy == nil {
-				m.Policy = &Policy{}
-			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OriginalSrcSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ActivePolicyRemove) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ActivePolicyRemove: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActivePolicyRemove: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 115:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDstSelector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11023,78 +14078,24 @@ func (m *ActivePolicyRemove) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &PolicyID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OriginalDstSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PolicyID) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PolicyID: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PolicyID: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 116:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSrcNamespaceSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11119,11 +14120,11 @@ func (m *PolicyID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tier = string(dAtA[iNdEx:postIndex])
+			m.OriginalSrcNamespaceSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 117:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDstNamespaceSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11148,63 +14149,13 @@ func (m *PolicyID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.OriginalDstNamespaceSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Policy) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Policy: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Policy: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 118:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InboundRules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalNotSrcSelector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11214,28 +14165,26 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InboundRules = append(m.InboundRules, &Rule{})
-			if err := m.InboundRules[len(m.InboundRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OriginalNotSrcSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 119:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutboundRules", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalNotDstSelector", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11245,28 +14194,26 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutboundRules = append(m.OutboundRules, &Rule{})
-			if err := m.OutboundRules[len(m.OutboundRules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OriginalNotDstSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Untracked", wireType)
+		case 120:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcServiceAccountMatch", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11276,37 +14223,30 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Untracked = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreDnat", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.PreDnat = bool(v != 0)
-		case 5:
+			if m.SrcServiceAccountMatch == nil {
+				m.SrcServiceAccountMatch = &ServiceAccountMatch{}
+			}
+			if err := m.SrcServiceAccountMatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 121:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DstServiceAccountMatch", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11316,76 +14256,30 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Rule) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.DstServiceAccountMatch == nil {
+				m.DstServiceAccountMatch = &ServiceAccountMatch{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DstServiceAccountMatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Rule: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Rule: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 122:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HttpMatch", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11395,26 +14289,30 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Action = string(dAtA[iNdEx:postIndex])
+			if m.HttpMatch == nil {
+				m.HttpMatch = &HTTPMatch{}
+			}
+			if err := m.HttpMatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpVersion", wireType)
+		case 123:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			m.IpVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11424,16 +14322,30 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.IpVersion |= (IPVersion(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &RuleMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 130:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDstService", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11443,28 +14355,24 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Protocol == nil {
-				m.Protocol = &Protocol{}
-			}
-			if err := m.Protocol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OriginalDstService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 131:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcNet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDstServiceNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11489,13 +14397,13 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcNet = append(m.SrcNet, string(dAtA[iNdEx:postIndex]))
+			m.OriginalDstServiceNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 132:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcPorts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSrcService", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11505,26 +14413,24 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcPorts = append(m.SrcPorts, &PortRange{})
-			if err := m.SrcPorts[len(m.SrcPorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OriginalSrcService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 133:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstNet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSrcServiceNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11549,13 +14455,13 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstNet = append(m.DstNet, string(dAtA[iNdEx:postIndex]))
+			m.OriginalSrcServiceNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 201:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstPorts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11565,28 +14471,76 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstPorts = append(m.DstPorts, &PortRange{})
-			if err := m.DstPorts[len(m.DstPorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RuleId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IcmpType", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
 			}
-			var v int32
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceAccountMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceAccountMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceAccountMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11596,17 +14550,26 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Icmp = &Rule_IcmpType{v}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Selector = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IcmpTypeCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11616,27 +14579,74 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IcmpTypeAndCode{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Icmp = &Rule_IcmpTypeCode{v}
-			iNdEx = postIndex
-		case 10:
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcIpSetIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11661,13 +14671,13 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcIpSetIds = append(m.SrcIpSetIds, string(dAtA[iNdEx:postIndex]))
+			m.Methods = append(m.Methods, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstIpSetIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11677,24 +14687,76 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstIpSetIds = append(m.DstIpSetIds, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 12:
+			m.Paths = append(m.Paths, &HTTPMatch_PathMatch{})
+			if err := m.Paths[len(m.Paths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPMatch_PathMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PathMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PathMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcNamedPortIpSetIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11719,11 +14781,11 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcNamedPortIpSetIds = append(m.SrcNamedPortIpSetIds, string(dAtA[iNdEx:postIndex]))
+			m.PathMatch = &HTTPMatch_PathMatch_Exact{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstNamedPortIpSetIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11748,40 +14810,61 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstNamedPortIpSetIds = append(m.DstNamedPortIpSetIds, string(dAtA[iNdEx:postIndex]))
+			m.PathMatch = &HTTPMatch_PathMatch_Prefix{string(dAtA[iNdEx:postIndex])}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstIpPortSetIds", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstIpPortSetIds = append(m.DstIpPortSetIds, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 102:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuleMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuleMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuleMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotProtocol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11805,78 +14888,153 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NotProtocol == nil {
-				m.NotProtocol = &Protocol{}
-			}
-			if err := m.NotProtocol.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 103:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotSrcNet", wireType)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFelixbackend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFelixbackend(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NotSrcNet = append(m.NotSrcNet, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 104:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotSrcPorts", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IcmpTypeAndCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NotSrcPorts = append(m.NotSrcPorts, &PortRange{})
-			if err := m.NotSrcPorts[len(m.NotSrcPorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 105:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotDstNet", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IcmpTypeAndCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IcmpTypeAndCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var stringLen uint64
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11886,26 +15044,16 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Type |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NotDstNet = append(m.NotDstNet, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 106:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotDstPorts", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
 			}
-			var msglen int
+			m.Code = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11915,26 +15063,64 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Code |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NotDstPorts = append(m.NotDstPorts, &PortRange{})
-			if err := m.NotDstPorts[len(m.NotDstPorts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Protocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
 			}
-			iNdEx = postIndex
-		case 107:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Protocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Protocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotIcmpType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
 			var v int32
 			for shift := uint(0); ; shift += 7 {
@@ -11951,12 +15137,12 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.NotIcmp = &Rule_NotIcmpType{v}
-		case 108:
+			m.NumberOrName = &Protocol_Number{v}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotIcmpTypeCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11966,29 +15152,76 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &IcmpTypeAndCode{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NumberOrName = &Protocol_Name{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.NotIcmp = &Rule_NotIcmpTypeCode{v}
-			iNdEx = postIndex
-		case 109:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotSrcIpSetIds", wireType)
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
 			}
-			var stringLen uint64
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PortRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PortRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PortRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+			}
+			m.First = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -11998,26 +15231,16 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.First |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NotSrcIpSetIds = append(m.NotSrcIpSetIds, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 110:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotDstIpSetIds", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
 			}
-			var stringLen uint64
+			m.Last = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -12027,24 +15250,64 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Last |= (int32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NotDstIpSetIds = append(m.NotDstIpSetIds, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 112:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkloadEndpointID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkloadEndpointID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkloadEndpointID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotSrcNamedPortIpSetIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12069,11 +15332,11 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NotSrcNamedPortIpSetIds = append(m.NotSrcNamedPortIpSetIds, string(dAtA[iNdEx:postIndex]))
+			m.OrchestratorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 113:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NotDstNamedPortIpSetIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12098,11 +15361,11 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NotDstNamedPortIpSetIds = append(m.NotDstNamedPortIpSetIds, string(dAtA[iNdEx:postIndex]))
+			m.WorkloadId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 114:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSrcSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12127,13 +15390,63 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalSrcSelector = string(dAtA[iNdEx:postIndex])
+			m.EndpointId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 115:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkloadEndpointUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkloadEndpointUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkloadEndpointUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDstSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -12143,26 +15456,30 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalDstSelector = string(dAtA[iNdEx:postIndex])
+			if m.Id == nil {
+				m.Id = &WorkloadEndpointID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 116:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSrcNamespaceSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -12172,24 +15489,78 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalSrcNamespaceSelector = string(dAtA[iNdEx:postIndex])
+			if m.Endpoint == nil {
+				m.Endpoint = &WorkloadEndpoint{}
+			}
+			if err := m.Endpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 117:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkloadEndpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkloadEndpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkloadEndpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDstNamespaceSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12214,11 +15585,11 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalDstNamespaceSelector = string(dAtA[iNdEx:postIndex])
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 118:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalNotSrcSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12243,11 +15614,11 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalNotSrcSelector = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 119:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalNotDstSelector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mac", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12272,13 +15643,13 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalNotDstSelector = string(dAtA[iNdEx:postIndex])
+			m.Mac = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 120:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcServiceAccountMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -12288,30 +15659,26 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SrcServiceAccountMatch == nil {
-				m.SrcServiceAccountMatch = &ServiceAccountMatch{}
-			}
-			if err := m.SrcServiceAccountMatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProfileIds = append(m.ProfileIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 121:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstServiceAccountMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipv4Nets", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -12321,30 +15688,26 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DstServiceAccountMatch == nil {
-				m.DstServiceAccountMatch = &ServiceAccountMatch{}
-			}
-			if err := m.DstServiceAccountMatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Ipv4Nets = append(m.Ipv4Nets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 122:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HttpMatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipv6Nets", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -12354,28 +15717,24 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HttpMatch == nil {
-				m.HttpMatch = &HTTPMatch{}
-			}
-			if err := m.HttpMatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Ipv6Nets = append(m.Ipv6Nets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 123:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12399,18 +15758,16 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &RuleMetadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tiers = append(m.Tiers, &TierInfo{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 130:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDstService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipv4Nat", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -12420,26 +15777,28 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalDstService = string(dAtA[iNdEx:postIndex])
+			m.Ipv4Nat = append(m.Ipv4Nat, &NatInfo{})
+			if err := m.Ipv4Nat[len(m.Ipv4Nat)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 131:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalDstServiceNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipv6Nat", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -12449,26 +15808,28 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalDstServiceNamespace = string(dAtA[iNdEx:postIndex])
+			m.Ipv6Nat = append(m.Ipv6Nat, &NatInfo{})
+			if err := m.Ipv6Nat[len(m.Ipv6Nat)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 132:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSrcService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QosControls", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -12478,26 +15839,80 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalSrcService = string(dAtA[iNdEx:postIndex])
+			if m.QosControls == nil {
+				m.QosControls = &QoSControls{}
+			}
+			if err := m.QosControls.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 133:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkloadEndpointRemove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkloadEndpointRemove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkloadEndpointRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSrcServiceNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -12507,24 +15922,78 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalSrcServiceNamespace = string(dAtA[iNdEx:postIndex])
+			if m.Id == nil {
+				m.Id = &WorkloadEndpointID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 201:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostEndpointID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostEndpointID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostEndpointID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuleId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12549,7 +16018,7 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RuleId = string(dAtA[iNdEx:postIndex])
+			m.EndpointId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12572,7 +16041,7 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ServiceAccountMatch) Unmarshal(dAtA []byte) error {
+func (m *HostEndpointUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12595,17 +16064,17 @@ func (m *ServiceAccountMatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceAccountMatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostEndpointUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceAccountMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostEndpointUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -12615,26 +16084,30 @@ func (m *ServiceAccountMatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Selector = string(dAtA[iNdEx:postIndex])
+			if m.Id == nil {
+				m.Id = &HostEndpointID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -12644,20 +16117,24 @@ func (m *ServiceAccountMatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			if m.Endpoint == nil {
+				m.Endpoint = &HostEndpoint{}
+			}
+			if err := m.Endpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12680,7 +16157,7 @@ func (m *ServiceAccountMatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPMatch) Unmarshal(dAtA []byte) error {
+func (m *HostEndpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12699,19 +16176,48 @@ func (m *HTTPMatch) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPMatch: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPMatch: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostEndpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostEndpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Methods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12736,11 +16242,11 @@ func (m *HTTPMatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Methods = append(m.Methods, string(dAtA[iNdEx:postIndex]))
+			m.ProfileIds = append(m.ProfileIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12764,64 +16270,14 @@ func (m *HTTPMatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Paths = append(m.Paths, &HTTPMatch_PathMatch{})
-			if err := m.Paths[len(m.Paths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Tiers = append(m.Tiers, &TierInfo{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HTTPMatch_PathMatch) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PathMatch: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PathMatch: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedIpv4Addrs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12846,11 +16302,11 @@ func (m *HTTPMatch_PathMatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathMatch = &HTTPMatch_PathMatch_Exact{string(dAtA[iNdEx:postIndex])}
+			m.ExpectedIpv4Addrs = append(m.ExpectedIpv4Addrs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedIpv6Addrs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12875,61 +16331,42 @@ func (m *HTTPMatch_PathMatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PathMatch = &HTTPMatch_PathMatch_Prefix{string(dAtA[iNdEx:postIndex])}
+			m.ExpectedIpv6Addrs = append(m.ExpectedIpv6Addrs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UntrackedTiers", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RuleMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UntrackedTiers = append(m.UntrackedTiers, &TierInfo{})
+			if err := m.UntrackedTiers[len(m.UntrackedTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RuleMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RuleMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreDnatTiers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12953,97 +16390,41 @@ func (m *RuleMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			m.PreDnatTiers = append(m.PreDnatTiers, &TierInfo{})
+			if err := m.PreDnatTiers[len(m.PreDnatTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowFelixbackend
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFelixbackend
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFelixbackend
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipFelixbackend(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardTiers = append(m.ForwardTiers, &TierInfo{})
+			if err := m.ForwardTiers[len(m.ForwardTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13066,7 +16447,7 @@ func (m *RuleMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IcmpTypeAndCode) Unmarshal(dAtA []byte) error {
+func (m *HostEndpointRemove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13089,17 +16470,17 @@ func (m *IcmpTypeAndCode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IcmpTypeAndCode: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostEndpointRemove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IcmpTypeAndCode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostEndpointRemove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Type = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -13109,30 +16490,25 @@ func (m *IcmpTypeAndCode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Type |= (int32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
 			}
-			m.Code = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Code |= (int32(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &HostEndpointID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFelixbackend(dAtA[iNdEx:])
@@ -13154,7 +16530,7 @@ func (m *IcmpTypeAndCode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Protocol) Unmarshal(dAtA []byte) error {
+func (m *TierInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13177,17 +16553,17 @@ func (m *Protocol) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Protocol: wiretype end group for non-group")
+			return fmt.Errorf("proto: TierInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Protocol: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TierInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -13197,15 +16573,24 @@ func (m *Protocol) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NumberOrName = &Protocol_Number{v}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IngressPolicies", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13230,63 +16615,13 @@ func (m *Protocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NumberOrName = &Protocol_Name{string(dAtA[iNdEx:postIndex])}
+			m.IngressPolicies = append(m.IngressPolicies, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PortRange) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PortRange: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PortRange: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field First", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EgressPolicies", wireType)
 			}
-			m.First = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -13296,16 +16631,26 @@ func (m *PortRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.First |= (int32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Last", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
 			}
-			m.Last = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EgressPolicies = append(m.EgressPolicies, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultAction", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -13315,11 +16660,21 @@ func (m *PortRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Last |= (int32(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultAction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFelixbackend(dAtA[iNdEx:])
@@ -13341,7 +16696,7 @@ func (m *PortRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkloadEndpointID) Unmarshal(dAtA []byte) error {
+func (m *NatInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13364,15 +16719,15 @@ func (m *WorkloadEndpointID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkloadEndpointID: wiretype end group for non-group")
+			return fmt.Errorf("proto: NatInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkloadEndpointID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NatInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtIp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13397,11 +16752,11 @@ func (m *WorkloadEndpointID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrchestratorId = string(dAtA[iNdEx:postIndex])
+			m.ExtIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntIp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13426,11 +16781,61 @@ func (m *WorkloadEndpointID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkloadId = string(dAtA[iNdEx:postIndex])
+			m.IntIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProcessStatusUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProcessStatusUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProcessStatusUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndpointId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsoTimestamp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13455,8 +16860,19 @@ func (m *WorkloadEndpointID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndpointId = string(dAtA[iNdEx:postIndex])
+			m.IsoTimestamp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Uptime = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFelixbackend(dAtA[iNdEx:])
@@ -13478,7 +16894,7 @@ func (m *WorkloadEndpointID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkloadEndpointUpdate) Unmarshal(dAtA []byte) error {
+func (m *HostEndpointStatusUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13501,10 +16917,10 @@ func (m *WorkloadEndpointUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkloadEndpointUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostEndpointStatusUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkloadEndpointUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostEndpointStatusUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13534,15 +16950,15 @@ func (m *WorkloadEndpointUpdate) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Id == nil {
-				m.Id = &WorkloadEndpointID{}
+				m.Id = &HostEndpointID{}
 			}
 			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13566,10 +16982,10 @@ func (m *WorkloadEndpointUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Endpoint == nil {
-				m.Endpoint = &WorkloadEndpoint{}
+			if m.Status == nil {
+				m.Status = &EndpointStatus{}
 			}
-			if err := m.Endpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13594,7 +17010,7 @@ func (m *WorkloadEndpointUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkloadEndpoint) Unmarshal(dAtA []byte) error {
+func (m *EndpointStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13617,15 +17033,15 @@ func (m *WorkloadEndpoint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkloadEndpoint: wiretype end group for non-group")
+			return fmt.Errorf("proto: EndpointStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkloadEndpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EndpointStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13650,71 +17066,63 @@ func (m *WorkloadEndpoint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mac", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFelixbackend
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostEndpointStatusRemove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mac = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostEndpointStatusRemove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostEndpointStatusRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProfileIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -13724,113 +17132,78 @@ func (m *WorkloadEndpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProfileIds = append(m.ProfileIds, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ipv4Nets", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFelixbackend
+			if m.Id == nil {
+				m.Id = &HostEndpointID{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Ipv4Nets = append(m.Ipv4Nets, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ipv6Nets", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ipv6Nets = append(m.Ipv6Nets, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkloadEndpointStatusUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tiers = append(m.Tiers, &TierInfo{})
-			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 8:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkloadEndpointStatusUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkloadEndpointStatusUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ipv4Nat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13854,14 +17227,16 @@ func (m *WorkloadEndpoint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ipv4Nat = append(m.Ipv4Nat, &NatInfo{})
-			if err := m.Ipv4Nat[len(m.Ipv4Nat)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Id == nil {
+				m.Id = &WorkloadEndpointID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ipv6Nat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13885,8 +17260,10 @@ func (m *WorkloadEndpoint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ipv6Nat = append(m.Ipv6Nat, &NatInfo{})
-			if err := m.Ipv6Nat[len(m.Ipv6Nat)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Status == nil {
+				m.Status = &EndpointStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13911,7 +17288,7 @@ func (m *WorkloadEndpoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkloadEndpointRemove) Unmarshal(dAtA []byte) error {
+func (m *WorkloadEndpointStatusRemove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13934,10 +17311,10 @@ func (m *WorkloadEndpointRemove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkloadEndpointRemove: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkloadEndpointStatusRemove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkloadEndpointRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkloadEndpointStatusRemove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13994,7 +17371,7 @@ func (m *WorkloadEndpointRemove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostEndpointID) Unmarshal(dAtA []byte) error {
+func (m *WireguardStatusUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14017,15 +17394,63 @@ func (m *HostEndpointID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostEndpointID: wiretype end group for non-group")
+			return fmt.Errorf("proto: WireguardStatusUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostEndpointID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WireguardStatusUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpVersion", wireType)
+			}
+			m.IpVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IpVersion |= (IPVersion(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndpointId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPublicKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14050,7 +17475,36 @@ func (m *HostEndpointID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EndpointId = string(dAtA[iNdEx:postIndex])
+			m.PendingPublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedPendingPublicKeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppliedPendingPublicKeys = append(m.AppliedPendingPublicKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14073,7 +17527,7 @@ func (m *HostEndpointID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostEndpointUpdate) Unmarshal(dAtA []byte) error {
+func (m *HostMetadataUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14096,17 +17550,17 @@ func (m *HostEndpointUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostEndpointUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostMetadataUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostEndpointUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostMetadataUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14116,30 +17570,26 @@ func (m *HostEndpointUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &HostEndpointID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hostname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipv4Addr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14149,24 +17599,20 @@ func (m *HostEndpointUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Endpoint == nil {
-				m.Endpoint = &HostEndpoint{}
-			}
-			if err := m.Endpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Ipv4Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14189,7 +17635,7 @@ func (m *HostEndpointUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostEndpoint) Unmarshal(dAtA []byte) error {
+func (m *HostMetadataRemove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14212,15 +17658,15 @@ func (m *HostEndpoint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostEndpoint: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostMetadataRemove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostEndpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostMetadataRemove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14245,11 +17691,90 @@ func (m *HostEndpoint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Hostname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProfileIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipv4Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ipv4Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IPAMPoolUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IPAMPoolUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IPAMPoolUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14274,11 +17799,11 @@ func (m *HostEndpoint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProfileIds = append(m.ProfileIds, string(dAtA[iNdEx:postIndex]))
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14302,43 +17827,66 @@ func (m *HostEndpoint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tiers = append(m.Tiers, &TierInfo{})
-			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pool == nil {
+				m.Pool = &IPAMPool{}
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedIpv4Addrs", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpectedIpv4Addrs = append(m.ExpectedIpv4Addrs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IPAMPoolRemove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IPAMPoolRemove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IPAMPoolRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedIpv6Addrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14363,44 +17911,63 @@ func (m *HostEndpoint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpectedIpv6Addrs = append(m.ExpectedIpv6Addrs, string(dAtA[iNdEx:postIndex]))
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UntrackedTiers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UntrackedTiers = append(m.UntrackedTiers, &TierInfo{})
-			if err := m.UntrackedTiers[len(m.UntrackedTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IPAMPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
 			}
-			iNdEx = postIndex
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IPAMPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IPAMPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreDnatTiers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cidr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14410,28 +17977,26 @@ func (m *HostEndpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreDnatTiers = append(m.PreDnatTiers, &TierInfo{})
-			if err := m.PreDnatTiers[len(m.PreDnatTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Cidr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForwardTiers", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Masquerade", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14441,23 +18006,12 @@ func (m *HostEndpoint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ForwardTiers = append(m.ForwardTiers, &TierInfo{})
-			if err := m.ForwardTiers[len(m.ForwardTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Masquerade = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFelixbackend(dAtA[iNdEx:])
@@ -14479,7 +18033,7 @@ func (m *HostEndpoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostEndpointRemove) Unmarshal(dAtA []byte) error {
+func (m *Encapsulation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14502,17 +18056,57 @@ func (m *HostEndpointRemove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostEndpointRemove: wiretype end group for non-group")
+			return fmt.Errorf("proto: Encapsulation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostEndpointRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Encapsulation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpipEnabled", wireType)
 			}
-			var msglen int
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IpipEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VxlanEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.VxlanEnabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VxlanEnabledV6", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14522,25 +18116,12 @@ func (m *HostEndpointRemove) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Id == nil {
-				m.Id = &HostEndpointID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.VxlanEnabledV6 = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFelixbackend(dAtA[iNdEx:])
@@ -14562,7 +18143,7 @@ func (m *HostEndpointRemove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TierInfo) Unmarshal(dAtA []byte) error {
+func (m *ServiceAccountUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14585,17 +18166,17 @@ func (m *TierInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TierInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ServiceAccountUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TierInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ServiceAccountUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14605,26 +18186,30 @@ func (m *TierInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Id == nil {
+				m.Id = &ServiceAccountID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IngressPolicies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14634,49 +18219,109 @@ func (m *TierInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IngressPolicies = append(m.IngressPolicies, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EgressPolicies", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFelixbackend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFelixbackend(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EgressPolicies = append(m.EgressPolicies, string(dAtA[iNdEx:postIndex]))
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14699,7 +18344,7 @@ func (m *TierInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NatInfo) Unmarshal(dAtA []byte) error {
+func (m *ServiceAccountRemove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14722,17 +18367,17 @@ func (m *NatInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NatInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ServiceAccountRemove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NatInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ServiceAccountRemove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtIp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -14742,49 +18387,24 @@ func (m *NatInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtIp = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IntIp", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFelixbackend
+			if m.Id == nil {
+				m.Id = &ServiceAccountID{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.IntIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14807,7 +18427,7 @@ func (m *NatInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessStatusUpdate) Unmarshal(dAtA []byte) error {
+func (m *ServiceAccountID) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14830,15 +18450,15 @@ func (m *ProcessStatusUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessStatusUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: ServiceAccountID: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessStatusUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ServiceAccountID: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsoTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14863,19 +18483,37 @@ func (m *ProcessStatusUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IsoTimestamp = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Uptime = float64(math.Float64frombits(v))
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFelixbackend(dAtA[iNdEx:])
@@ -14897,7 +18535,7 @@ func (m *ProcessStatusUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostEndpointStatusUpdate) Unmarshal(dAtA []byte) error {
+func (m *NamespaceUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14920,10 +18558,10 @@ func (m *HostEndpointStatusUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostEndpointStatusUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespaceUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostEndpointStatusUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespaceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14953,7 +18591,7 @@ func (m *HostEndpointStatusUpdate) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Id == nil {
-				m.Id = &HostEndpointID{}
+				m.Id = &NamespaceID{}
 			}
 			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -14961,7 +18599,7 @@ func (m *HostEndpointStatusUpdate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14985,12 +18623,97 @@ func (m *HostEndpointStatusUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &EndpointStatus{}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFelixbackend
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFelixbackend
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipFelixbackend(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthFelixbackend
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
 			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15013,7 +18736,7 @@ func (m *HostEndpointStatusUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EndpointStatus) Unmarshal(dAtA []byte) error {
+func (m *NamespaceRemove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15036,17 +18759,17 @@ func (m *EndpointStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EndpointStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespaceRemove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EndpointStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespaceRemove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -15056,20 +18779,24 @@ func (m *EndpointStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			if m.Id == nil {
+				m.Id = &NamespaceID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15092,7 +18819,7 @@ func (m *EndpointStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostEndpointStatusRemove) Unmarshal(dAtA []byte) error {
+func (m *NamespaceID) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15115,17 +18842,17 @@ func (m *HostEndpointStatusRemove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostEndpointStatusRemove: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespaceID: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostEndpointStatusRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespaceID: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -15135,24 +18862,20 @@ func (m *HostEndpointStatusRemove) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &HostEndpointID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15175,7 +18898,7 @@ func (m *HostEndpointStatusRemove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkloadEndpointStatusUpdate) Unmarshal(dAtA []byte) error {
+func (m *TunnelType) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15198,17 +18921,17 @@ func (m *WorkloadEndpointStatusUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkloadEndpointStatusUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: TunnelType: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkloadEndpointStatusUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TunnelType: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipip", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -15218,30 +18941,17 @@ func (m *WorkloadEndpointStatusUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Id == nil {
-				m.Id = &WorkloadEndpointID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Ipip = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vxlan", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -15251,80 +18961,17 @@ func (m *WorkloadEndpointStatusUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Status == nil {
-				m.Status = &EndpointStatus{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *WorkloadEndpointStatusRemove) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WorkloadEndpointStatusRemove: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkloadEndpointStatusRemove: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			m.Vxlan = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wireguard", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -15334,25 +18981,12 @@ func (m *WorkloadEndpointStatusRemove) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Id == nil {
-				m.Id = &WorkloadEndpointID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Wireguard = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFelixbackend(dAtA[iNdEx:])
@@ -15374,7 +19008,7 @@ func (m *WorkloadEndpointStatusRemove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WireguardStatusUpdate) Unmarshal(dAtA []byte) error {
+func (m *RouteUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15397,15 +19031,53 @@ func (m *WireguardStatusUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WireguardStatusUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: RouteUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WireguardStatusUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RouteUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= (RouteType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IpPoolType", wireType)
+			}
+			m.IpPoolType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IpPoolType |= (IPPoolType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dst", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15430,7 +19102,158 @@ func (m *WireguardStatusUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			m.Dst = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstNodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstNodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstNodeIp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstNodeIp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SameSubnet", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SameSubnet = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NatOutgoing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NatOutgoing = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalWorkload", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LocalWorkload = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TunnelType", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TunnelType == nil {
+				m.TunnelType = &TunnelType{}
+			}
+			if err := m.TunnelType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15453,7 +19276,7 @@ func (m *WireguardStatusUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostMetadataUpdate) Unmarshal(dAtA []byte) error {
+func (m *RouteRemove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15476,44 +19299,15 @@ func (m *HostMetadataUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostMetadataUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: RouteRemove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostMetadataUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hostname = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			return fmt.Errorf("proto: RouteRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ipv4Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dst", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15538,7 +19332,7 @@ func (m *HostMetadataUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ipv4Addr = string(dAtA[iNdEx:postIndex])
+			m.Dst = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15561,7 +19355,7 @@ func (m *HostMetadataUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HostMetadataRemove) Unmarshal(dAtA []byte) error {
+func (m *VXLANTunnelEndpointUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15584,15 +19378,15 @@ func (m *HostMetadataRemove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HostMetadataRemove: wiretype end group for non-group")
+			return fmt.Errorf("proto: VXLANTunnelEndpointUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HostMetadataRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VXLANTunnelEndpointUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15617,11 +19411,11 @@ func (m *HostMetadataRemove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hostname = string(dAtA[iNdEx:postIndex])
+			m.Node = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ipv4Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mac", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15646,61 +19440,69 @@ func (m *HostMetadataRemove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ipv4Addr = string(dAtA[iNdEx:postIndex])
+			m.Mac = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipv4Addr", wireType)
 			}
-			if skippy < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IPAMPoolUpdate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
+			m.Ipv4Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentDeviceIp", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IPAMPoolUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPAMPoolUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentDeviceIp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipv6Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15725,13 +19527,13 @@ func (m *IPAMPoolUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Ipv6Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentDeviceIpv6", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -15741,24 +19543,49 @@ func (m *IPAMPoolUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pool == nil {
-				m.Pool = &IPAMPool{}
+			m.ParentDeviceIpv6 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MacV6", wireType)
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.MacV6 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15781,7 +19608,7 @@ func (m *IPAMPoolUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IPAMPoolRemove) Unmarshal(dAtA []byte) error {
+func (m *VXLANTunnelEndpointRemove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15804,15 +19631,15 @@ func (m *IPAMPoolRemove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IPAMPoolRemove: wiretype end group for non-group")
+			return fmt.Errorf("proto: VXLANTunnelEndpointRemove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPAMPoolRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VXLANTunnelEndpointRemove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15837,7 +19664,7 @@ func (m *IPAMPoolRemove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Node = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15860,7 +19687,7 @@ func (m *IPAMPoolRemove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IPAMPool) Unmarshal(dAtA []byte) error {
+func (m *WireguardEndpointUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15883,15 +19710,15 @@ func (m *IPAMPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IPAMPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: WireguardEndpointUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPAMPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WireguardEndpointUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cidr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15916,13 +19743,13 @@ func (m *IPAMPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cidr = string(dAtA[iNdEx:postIndex])
+			m.Hostname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Masquerade", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -15932,87 +19759,26 @@ func (m *IPAMPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Masquerade = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Encapsulation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Encapsulation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Encapsulation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpipEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
 			}
-			m.IpipEnabled = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VxlanEnabled", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceIpv4Addr", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -16022,67 +19788,26 @@ func (m *Encapsulation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.VxlanEnabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ServiceAccountUpdate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceAccountUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceAccountUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.InterfaceIpv4Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPublicKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -16092,30 +19817,26 @@ func (m *ServiceAccountUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &ServiceAccountID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PendingPublicKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedPendingPublicKeys", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -16125,109 +19846,20 @@ func (m *ServiceAccountUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowFelixbackend
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFelixbackend
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFelixbackend
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipFelixbackend(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
+			m.AppliedPendingPublicKeys = append(m.AppliedPendingPublicKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16250,7 +19882,7 @@ func (m *ServiceAccountUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ServiceAccountRemove) Unmarshal(dAtA []byte) error {
+func (m *WireguardEndpointRemove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16273,17 +19905,17 @@ func (m *ServiceAccountRemove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceAccountRemove: wiretype end group for non-group")
+			return fmt.Errorf("proto: WireguardEndpointRemove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceAccountRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WireguardEndpointRemove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -16293,24 +19925,20 @@ func (m *ServiceAccountRemove) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &ServiceAccountID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hostname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16333,7 +19961,7 @@ func (m *ServiceAccountRemove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ServiceAccountID) Unmarshal(dAtA []byte) error {
+func (m *GlobalBGPConfigUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16356,15 +19984,15 @@ func (m *ServiceAccountID) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceAccountID: wiretype end group for non-group")
+			return fmt.Errorf("proto: GlobalBGPConfigUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceAccountID: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GlobalBGPConfigUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceClusterCidrs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16389,11 +20017,40 @@ func (m *ServiceAccountID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.ServiceClusterCidrs = append(m.ServiceClusterCidrs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceExternalCidrs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceExternalCidrs = append(m.ServiceExternalCidrs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceLoadbalancerCidrs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16418,7 +20075,7 @@ func (m *ServiceAccountID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ServiceLoadbalancerCidrs = append(m.ServiceLoadbalancerCidrs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16441,7 +20098,7 @@ func (m *ServiceAccountID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamespaceUpdate) Unmarshal(dAtA []byte) error {
+func (m *QoSControls) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16464,17 +20121,17 @@ func (m *NamespaceUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamespaceUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: QoSControls: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespaceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QoSControls: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IngressBandwidth", wireType)
 			}
-			var msglen int
+			m.IngressBandwidth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -16484,30 +20141,54 @@ func (m *NamespaceUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.IngressBandwidth |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EgressBandwidth", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.EgressBandwidth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EgressBandwidth |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Id == nil {
-				m.Id = &NamespaceID{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IngressBurst", wireType)
 			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.IngressBurst = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IngressBurst |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EgressBurst", wireType)
 			}
-			var msglen int
+			m.EgressBurst = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -16517,110 +20198,49 @@ func (m *NamespaceUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.EgressBurst |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFelixbackend
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IngressPacketRate", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.IngressPacketRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IngressPacketRate |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EgressPacketRate", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowFelixbackend
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= (uint64(b) & 0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.EgressPacketRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFelixbackend
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFelixbackend
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipFelixbackend(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthFelixbackend
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EgressPacketRate |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFelixbackend(dAtA[iNdEx:])
@@ -16642,7 +20262,7 @@ func (m *NamespaceUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NamespaceRemove) Unmarshal(dAtA []byte) error {
+func (m *PacketCaptureID) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16665,17 +20285,17 @@ func (m *NamespaceRemove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NamespaceRemove: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketCaptureID: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespaceRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketCaptureID: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -16685,76 +20305,22 @@ func (m *NamespaceRemove) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Id == nil {
-				m.Id = &NamespaceID{}
-			}
-			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFelixbackend(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthFelixbackend
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NamespaceID) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFelixbackend
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NamespaceID: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NamespaceID: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -16804,7 +20370,7 @@ func (m *NamespaceID) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TunnelType) Unmarshal(dAtA []byte) error {
+func (m *PacketCaptureUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16827,17 +20393,17 @@ func (m *TunnelType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TunnelType: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketCaptureUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TunnelType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketCaptureUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ipip", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -16847,17 +20413,30 @@ func (m *TunnelType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Ipip = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &PacketCaptureID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vxlan", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -16867,17 +20446,26 @@ func (m *TunnelType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Vxlan = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wireguard", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -16887,12 +20475,23 @@ func (m *TunnelType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Wireguard = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, &PacketCaptureEndpoint{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFelixbackend(dAtA[iNdEx:])
@@ -16914,7 +20513,7 @@ func (m *TunnelType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RouteUpdate) Unmarshal(dAtA []byte) error {
+func (m *PacketCaptureEndpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16937,55 +20536,17 @@ func (m *RouteUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RouteUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketCaptureEndpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RouteUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketCaptureEndpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= (RouteType(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IpPoolType", wireType)
-			}
-			m.IpPoolType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IpPoolType |= (IPPoolType(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFelixbackend
@@ -16995,24 +20556,28 @@ func (m *RouteUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFelixbackend
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dst = string(dAtA[iNdEx:postIndex])
+			if m.Id == nil {
+				m.Id = &WorkloadEndpointID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstNodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17037,11 +20602,11 @@ func (m *RouteUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstNodeName = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstNodeIp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17066,71 +20631,61 @@ func (m *RouteUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstNodeIp = string(dAtA[iNdEx:postIndex])
+			m.InterfaceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SameSubnet", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
 			}
-			m.SameSubnet = bool(v != 0)
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NatOutgoing", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketCaptureRemove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
 			}
-			m.NatOutgoing = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalWorkload", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFelixbackend
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.LocalWorkload = bool(v != 0)
-		case 10:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketCaptureRemove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketCaptureRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TunnelType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17154,10 +20709,10 @@ func (m *RouteUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TunnelType == nil {
-				m.TunnelType = &TunnelType{}
+			if m.Id == nil {
+				m.Id = &PacketCaptureID{}
 			}
-			if err := m.TunnelType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17182,7 +20737,7 @@ func (m *RouteUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RouteRemove) Unmarshal(dAtA []byte) error {
+func (m *PacketCaptureStatusUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17205,15 +20760,48 @@ func (m *RouteRemove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RouteRemove: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketCaptureStatusUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RouteRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketCaptureStatusUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Id == nil {
+				m.Id = &PacketCaptureID{}
+			}
+			if err := m.Id.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17238,7 +20826,38 @@ func (m *RouteRemove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dst = string(dAtA[iNdEx:postIndex])
+			m.Directory = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoints = append(m.Endpoints, &PacketCaptureEndpointStatus{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17261,7 +20880,7 @@ func (m *RouteRemove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VXLANTunnelEndpointUpdate) Unmarshal(dAtA []byte) error {
+func (m *PacketCaptureEndpointStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17284,15 +20903,15 @@ func (m *VXLANTunnelEndpointUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VXLANTunnelEndpointUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketCaptureEndpointStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VXLANTunnelEndpointUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketCaptureEndpointStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17317,11 +20936,11 @@ func (m *VXLANTunnelEndpointUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Node = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mac", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17346,11 +20965,11 @@ func (m *VXLANTunnelEndpointUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mac = string(dAtA[iNdEx:postIndex])
+			m.InterfaceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ipv4Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17375,11 +20994,11 @@ func (m *VXLANTunnelEndpointUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ipv4Addr = string(dAtA[iNdEx:postIndex])
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentDeviceIp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17404,7 +21023,38 @@ func (m *VXLANTunnelEndpointUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentDeviceIp = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Files = append(m.Files, &PacketCaptureFile{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17427,7 +21077,7 @@ func (m *VXLANTunnelEndpointUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VXLANTunnelEndpointRemove) Unmarshal(dAtA []byte) error {
+func (m *PacketCaptureFile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17450,15 +21100,15 @@ func (m *VXLANTunnelEndpointRemove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VXLANTunnelEndpointRemove: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketCaptureFile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VXLANTunnelEndpointRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketCaptureFile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17483,8 +21133,27 @@ func (m *VXLANTunnelEndpointRemove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Node = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFelixbackend(dAtA[iNdEx:])
@@ -17506,7 +21175,7 @@ func (m *VXLANTunnelEndpointRemove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WireguardEndpointUpdate) Unmarshal(dAtA []byte) error {
+func (m *WireguardEndpointV6Update) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17529,10 +21198,10 @@ func (m *WireguardEndpointUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WireguardEndpointUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: WireguardEndpointV6Update: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WireguardEndpointUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WireguardEndpointV6Update: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17566,7 +21235,7 @@ func (m *WireguardEndpointUpdate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeyV6", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17591,11 +21260,11 @@ func (m *WireguardEndpointUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			m.PublicKeyV6 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceIpv4Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceIpv6Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17620,7 +21289,65 @@ func (m *WireguardEndpointUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterfaceIpv4Addr = string(dAtA[iNdEx:postIndex])
+			m.InterfaceIpv6Addr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPublicKeyV6", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPublicKeyV6 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppliedPendingPublicKeysV6", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppliedPendingPublicKeysV6 = append(m.AppliedPendingPublicKeysV6, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17643,7 +21370,7 @@ func (m *WireguardEndpointUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WireguardEndpointRemove) Unmarshal(dAtA []byte) error {
+func (m *WireguardEndpointV6Remove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17666,10 +21393,10 @@ func (m *WireguardEndpointRemove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WireguardEndpointRemove: wiretype end group for non-group")
+			return fmt.Errorf("proto: WireguardEndpointV6Remove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WireguardEndpointRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WireguardEndpointV6Remove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17722,7 +21449,7 @@ func (m *WireguardEndpointRemove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GlobalBGPConfigUpdate) Unmarshal(dAtA []byte) error {
+func (m *HostMetadataV6Update) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17745,15 +21472,15 @@ func (m *GlobalBGPConfigUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GlobalBGPConfigUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: HostMetadataV6Update: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobalBGPConfigUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HostMetadataV6Update: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceClusterCidrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17778,11 +21505,11 @@ func (m *GlobalBGPConfigUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceClusterCidrs = append(m.ServiceClusterCidrs, string(dAtA[iNdEx:postIndex]))
+			m.Hostname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceExternalCidrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipv6Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17807,11 +21534,61 @@ func (m *GlobalBGPConfigUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceExternalCidrs = append(m.ServiceExternalCidrs, string(dAtA[iNdEx:postIndex]))
+			m.Ipv6Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFelixbackend(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HostMetadataV6Remove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFelixbackend
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HostMetadataV6Remove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HostMetadataV6Remove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceLoadbalancerCidrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hostname", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17836,7 +21613,36 @@ func (m *GlobalBGPConfigUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceLoadbalancerCidrs = append(m.ServiceLoadbalancerCidrs, string(dAtA[iNdEx:postIndex]))
+			m.Hostname = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipv6Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFelixbackend
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFelixbackend
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ipv6Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17967,230 +21773,272 @@ var (
 func init() { proto1.RegisterFile("felixbackend.proto", fileDescriptorFelixbackend) }
 
 var fileDescriptorFelixbackend = []byte{
-	// 3598 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4b, 0x6f, 0xdc, 0xc8,
-	0x76, 0x16, 0x5b, 0x52, 0xab, 0xfb, 0xf4, 0x8b, 0x2e, 0xbd, 0x5a, 0xb2, 0x2d, 0xfb, 0x72, 0xc6,
-	0xb0, 0xc6, 0xc9, 0xd8, 0x8e, 0xc6, 0x96, 0xaf, 0x27, 0x17, 0xbe, 0x90, 0xd4, 0x1a, 0xab, 0xef,
-	0xc8, 0x2d, 0x81, 0xd2, 0xd8, 0xb9, 0xc1, 0x05, 0x18, 0x8a, 0x2c, 0x49, 0x8c, 0xd9, 0x24, 0x87,
-	0xac, 0xd6, 0x23, 0xd9, 0xdd, 0xdc, 0x00, 0xc9, 0x22, 0x48, 0x56, 0x41, 0x76, 0xd9, 0x04, 0x59,
-	0x04, 0xf9, 0x07, 0x59, 0x64, 0x15, 0xe0, 0xce, 0x2e, 0x7f, 0x20, 0x40, 0x30, 0xf9, 0x05, 0xf9,
-	0x07, 0x41, 0x3d, 0xf9, 0x68, 0xb6, 0x6c, 0x07, 0x41, 0x56, 0xcd, 0x3a, 0xe7, 0x3b, 0x5f, 0x9d,
-	0xaa, 0x3a, 0x55, 0x75, 0xaa, 0xaa, 0x01, 0x9d, 0x62, 0xdf, 0xbb, 0x3a, 0xb1, 0x9d, 0xf7, 0x38,
-	0x70, 0x1f, 0x47, 0x71, 0x48, 0x42, 0x34, 0xcb, 0x64, 0x46, 0x0b, 0x1a, 0x47, 0xd7, 0x81, 0x63,
-	0xe2, 0xef, 0x47, 0x38, 0x21, 0xc6, 0xdf, 0xdf, 0x82, 0xc6, 0x71, 0xd8, 0xb3, 0x89, 0x1d, 0xf9,
-	0x76, 0x80, 0xd1, 0x3a, 0xcc, 0x79, 0x81, 0x95, 0x5c, 0x07, 0x4e, 0x57, 0xbb, 0xaf, 0xad, 0x37,
-	0x36, 0x5a, 0x8f, 0x99, 0xdd, 0xe3, 0x7e, 0x40, 0xcd, 0xf6, 0xa6, 0xcc, 0xaa, 0xc7, 0xbe, 0xd0,
-	0x0b, 0x68, 0x7a, 0x51, 0x82, 0x89, 0x35, 0x8a, 0x5c, 0x9b, 0xe0, 0x6e, 0x85, 0xc1, 0x91, 0x84,
-	0x1f, 0x1e, 0x61, 0xf2, 0x1d, 0xd3, 0xec, 0x4d, 0x99, 0x0d, 0x86, 0xe4, 0x45, 0xf4, 0x1a, 0x10,
-	0x37, 0x74, 0xb1, 0x4f, 0x6c, 0x69, 0x3e, 0xcd, 0xcc, 0x97, 0xb3, 0xe6, 0x3d, 0xaa, 0x57, 0x1c,
-	0x3a, 0x33, 0xca, 0xc8, 0x52, 0x0f, 0x62, 0x3c, 0x0c, 0x2f, 0x70, 0x77, 0x66, 0xdc, 0x03, 0x93,
-	0x69, 0x94, 0x07, 0xbc, 0x88, 0x0e, 0x61, 0xd1, 0x76, 0x88, 0x77, 0x81, 0xad, 0x28, 0x0e, 0x4f,
-	0x3d, 0x1f, 0x4b, 0x27, 0x66, 0x19, 0xc3, 0xaa, 0x60, 0xd8, 0x62, 0x98, 0x43, 0x0e, 0x51, 0x7e,
-	0xcc, 0xdb, 0xe3, 0xe2, 0x12, 0x46, 0xe1, 0x53, 0x75, 0x32, 0xa3, 0xf2, 0x2d, 0xcf, 0x28, 0x7c,
-	0x7c, 0x03, 0x0b, 0x92, 0x31, 0xf4, 0x3d, 0xe7, 0x5a, 0xba, 0x38, 0xc7, 0x08, 0x57, 0xf2, 0x84,
-	0x0c, 0xa1, 0x3c, 0x44, 0xf6, 0x98, 0x74, 0x9c, 0x4e, 0xf8, 0x57, 0x9b, 0x48, 0xa7, 0xdc, 0xcb,
-	0xd1, 0xa5, 0xde, 0x9d, 0x87, 0x09, 0xb1, 0x70, 0xe0, 0x46, 0xa1, 0x17, 0xa8, 0x20, 0xa8, 0xe7,
-	0xe8, 0xf6, 0xc2, 0x84, 0xec, 0x0a, 0x44, 0xea, 0xdd, 0xf9, 0x98, 0x74, 0x9c, 0x4e, 0x78, 0x07,
-	0x13, 0xe9, 0x52, 0xef, 0xce, 0xc7, 0xa4, 0xe8, 0x97, 0xd0, 0xbd, 0x0c, 0xe3, 0xf7, 0x7e, 0x68,
-	0xbb, 0x63, 0x1e, 0x36, 0x18, 0xe5, 0x5d, 0x41, 0xf9, 0x4e, 0xc0, 0xc6, 0xbc, 0x5c, 0xba, 0x2c,
-	0xd5, 0x94, 0x53, 0x0b, 0x6f, 0x9b, 0x37, 0x52, 0x2b, 0x8f, 0xc7, 0xa8, 0x85, 0xd7, 0x5f, 0x43,
-	0xcb, 0x09, 0x83, 0x53, 0xef, 0x4c, 0xba, 0xda, 0x62, 0x7c, 0xf3, 0x82, 0x6f, 0x87, 0xe9, 0x94,
-	0x83, 0x4d, 0x27, 0x53, 0x56, 0x1d, 0x38, 0xc4, 0xc4, 0x76, 0xed, 0x74, 0x56, 0xb5, 0xc7, 0x3a,
-	0xf0, 0x8d, 0x40, 0xe4, 0xc7, 0x23, 0x2f, 0x45, 0x0f, 0xa1, 0x93, 0xd0, 0x05, 0x22, 0x70, 0xb0,
-	0x15, 0x8c, 0x86, 0x27, 0x38, 0xee, 0x76, 0xee, 0x6b, 0xeb, 0x33, 0x66, 0x5b, 0x8a, 0x07, 0x4c,
-	0x8a, 0xb6, 0x40, 0xf7, 0x22, 0x7b, 0x68, 0x45, 0x61, 0xe8, 0xcb, 0x3a, 0x75, 0x56, 0xe7, 0xa2,
-	0x9a, 0x86, 0x5b, 0x6f, 0x0e, 0xc3, 0xd0, 0x57, 0xf5, 0xb5, 0xa9, 0x41, 0x2a, 0xc9, 0x53, 0x88,
-	0x9e, 0xbc, 0x55, 0x4a, 0xa1, 0x7a, 0x50, 0x51, 0x14, 0xa2, 0x51, 0xb5, 0x5e, 0xd0, 0xa0, 0x89,
-	0xad, 0xcf, 0x87, 0x4f, 0x5e, 0x8a, 0x8e, 0x60, 0x29, 0xc1, 0xf1, 0x85, 0xe7, 0x60, 0xcb, 0x76,
-	0x9c, 0x70, 0x94, 0x06, 0xcf, 0x3c, 0x23, 0xbc, 0x2d, 0x08, 0x8f, 0x38, 0x68, 0x8b, 0x63, 0x54,
-	0x03, 0x17, 0x92, 0x12, 0x79, 0x19, 0xa9, 0xf0, 0x72, 0xe1, 0x06, 0x52, 0xe5, 0x67, 0x81, 0x54,
-	0x78, 0xba, 0x03, 0x7a, 0x60, 0x0f, 0x71, 0x12, 0xd9, 0x8e, 0x5a, 0xc3, 0x16, 0x19, 0xdd, 0x92,
-	0xa0, 0x1b, 0x48, 0xb5, 0x72, 0xaf, 0x13, 0xe4, 0x45, 0x79, 0x12, 0xe1, 0xd3, 0x52, 0x39, 0x89,
-	0x72, 0x27, 0x25, 0x11, 0x9e, 0xbc, 0x80, 0x66, 0x1c, 0x8e, 0x88, 0xf2, 0x62, 0x39, 0xb7, 0x16,
-	0x9b, 0x54, 0x95, 0xee, 0x06, 0x71, 0x5a, 0x4c, 0x0d, 0x45, 0xcd, 0xdd, 0x71, 0xc3, 0x74, 0x11,
-	0x8f, 0xd3, 0x22, 0xda, 0x81, 0xc6, 0x05, 0xc1, 0x91, 0xac, 0x70, 0x85, 0xd9, 0xdd, 0x17, 0x76,
-	0x6f, 0xff, 0x60, 0x7f, 0x6b, 0x70, 0x3c, 0x0a, 0x02, 0xec, 0x8f, 0x4d, 0x6d, 0xa0, 0x66, 0xaa,
-	0xed, 0x9c, 0x44, 0x54, 0xbe, 0xfa, 0x21, 0x12, 0xe5, 0x0a, 0x23, 0x11, 0x9e, 0xfc, 0x0a, 0x56,
-	0x2e, 0xbd, 0x18, 0x9f, 0x8d, 0xec, 0x78, 0x7c, 0xbd, 0xb9, 0xcd, 0x28, 0xd7, 0xe4, 0xa2, 0x20,
-	0x71, 0x63, 0x5e, 0x2d, 0x5f, 0x96, 0xab, 0x26, 0xb0, 0x0b, 0x87, 0xef, 0xdc, 0xcc, 0xae, 0xdc,
-	0x1d, 0x67, 0x17, 0xbe, 0xbf, 0x83, 0xee, 0x99, 0x1f, 0x9e, 0xd8, 0xbe, 0x75, 0x72, 0x16, 0x59,
-	0xf9, 0xf5, 0xe7, 0x2e, 0x23, 0xbf, 0x23, 0xc8, 0x5f, 0x33, 0xd8, 0xf6, 0xeb, 0xc3, 0xc2, 0x42,
-	0xb4, 0xc8, 0xed, 0xb7, 0xcf, 0xa2, 0xac, 0x02, 0xfd, 0x0c, 0x5a, 0x38, 0x70, 0xec, 0x28, 0x19,
-	0xf9, 0x36, 0xf1, 0xc2, 0xa0, 0xbb, 0xc6, 0xd8, 0x16, 0x04, 0xdb, 0x6e, 0x56, 0xb7, 0x37, 0x65,
-	0xe6, 0xc1, 0xdb, 0x75, 0x98, 0x8b, 0xec, 0x6b, 0xba, 0x48, 0x1a, 0x7f, 0x35, 0x0b, 0xad, 0x6f,
-	0xe2, 0x70, 0x98, 0xe6, 0x28, 0x87, 0xb0, 0x18, 0xc5, 0xa1, 0x83, 0x93, 0xc4, 0x4a, 0x88, 0x4d,
-	0x46, 0x49, 0x3e, 0x87, 0x90, 0x9b, 0xed, 0x21, 0xc7, 0x1c, 0x31, 0x48, 0xba, 0x7d, 0x47, 0xe3,
-	0x62, 0xf4, 0x47, 0x70, 0x3b, 0xbf, 0xff, 0xe4, 0x79, 0x79, 0x62, 0x71, 0xaf, 0x64, 0x1b, 0x2a,
-	0x90, 0x77, 0xcf, 0x27, 0xe8, 0x26, 0xd6, 0x20, 0xc6, 0x71, 0xf6, 0x03, 0x35, 0xa8, 0x81, 0x2c,
-	0xa9, 0x41, 0x8c, 0xa4, 0x0f, 0xf7, 0xc6, 0x77, 0xa6, 0x7c, 0x3b, 0x78, 0x32, 0xf2, 0xd9, 0x84,
-	0x0d, 0xaa, 0xd0, 0x96, 0x3b, 0x97, 0x37, 0xe8, 0x6f, 0xac, 0x4d, 0xb4, 0x69, 0xee, 0x23, 0x6a,
-	0x53, 0xed, 0x9a, 0x50, 0x9b, 0x68, 0x5b, 0xc9, 0x7e, 0x54, 0x2b, 0xdd, 0x8f, 0xde, 0x42, 0x1a,
-	0xe9, 0x85, 0xc6, 0xd7, 0x73, 0xd1, 0xac, 0xa6, 0x4a, 0xa1, 0xd5, 0x8b, 0x97, 0x65, 0x8a, 0x6c,
-	0x3c, 0xfe, 0x5a, 0x83, 0x66, 0x2e, 0xd2, 0x5f, 0x40, 0x95, 0xcf, 0x9b, 0xae, 0x76, 0x7f, 0x3a,
-	0x33, 0x8a, 0x59, 0x90, 0x28, 0xec, 0x06, 0x24, 0xbe, 0x36, 0x05, 0x7c, 0xf5, 0x25, 0x34, 0x32,
-	0x62, 0xa4, 0xc3, 0xf4, 0x7b, 0x7c, 0xcd, 0xd2, 0xee, 0xba, 0x49, 0x3f, 0xd1, 0x02, 0xcc, 0x5e,
-	0xd8, 0xfe, 0x88, 0xe7, 0xd6, 0x75, 0x93, 0x17, 0xbe, 0xae, 0xfc, 0x54, 0x33, 0x6a, 0x50, 0xe5,
-	0x09, 0xb9, 0xf1, 0x77, 0x1a, 0x34, 0x32, 0xc9, 0x36, 0x6a, 0x43, 0xc5, 0x73, 0x05, 0x49, 0xc5,
-	0x73, 0x51, 0x17, 0xe6, 0x86, 0x98, 0xf6, 0x4d, 0xd2, 0xad, 0xdc, 0x9f, 0x5e, 0xaf, 0x9b, 0xb2,
-	0x88, 0x9e, 0xc2, 0x0c, 0xb9, 0x8e, 0xf8, 0xac, 0x69, 0xab, 0x8e, 0xc9, 0x70, 0xf1, 0xef, 0xe3,
-	0xeb, 0x08, 0x9b, 0x0c, 0x69, 0x7c, 0x09, 0x75, 0x25, 0x42, 0x55, 0xa8, 0xf4, 0x0f, 0xf5, 0x29,
-	0xd4, 0xa1, 0xf5, 0x5b, 0x5b, 0x83, 0x9e, 0x75, 0x78, 0x60, 0x1e, 0xeb, 0x1a, 0x9a, 0x83, 0xe9,
-	0xc1, 0xee, 0xb1, 0x5e, 0x31, 0x22, 0xd0, 0x8b, 0x79, 0xfc, 0x98, 0x7b, 0x9f, 0x41, 0xcb, 0x76,
-	0x5d, 0xec, 0x5a, 0x79, 0x27, 0x9b, 0x4c, 0xf8, 0x46, 0x78, 0xfa, 0x10, 0x3a, 0x3c, 0xa6, 0x52,
-	0xd8, 0x34, 0x83, 0xb5, 0x85, 0x58, 0x00, 0x8d, 0xbb, 0xa2, 0x2f, 0x44, 0xd8, 0x14, 0x2a, 0x33,
-	0x6c, 0x98, 0x2f, 0xc9, 0xe9, 0xd1, 0x7d, 0x05, 0x6b, 0x6c, 0xe8, 0xe9, 0xe2, 0x41, 0x11, 0xfd,
-	0x1e, 0xf3, 0x72, 0x1d, 0xe6, 0x44, 0x5e, 0x2f, 0x8e, 0x39, 0xed, 0x3c, 0xcc, 0x94, 0x6a, 0xe3,
-	0x45, 0xa1, 0x0a, 0xe1, 0xc9, 0x07, 0xab, 0x30, 0xee, 0x41, 0x5d, 0x09, 0x10, 0x82, 0x19, 0xba,
-	0xc1, 0x0a, 0xd7, 0xd9, 0xb7, 0x11, 0xc2, 0x9c, 0x00, 0xa0, 0xa7, 0xd0, 0xf2, 0x82, 0x93, 0x70,
-	0x14, 0xb8, 0x56, 0x3c, 0xf2, 0x71, 0x22, 0x02, 0xaf, 0x21, 0x37, 0xcd, 0x91, 0x8f, 0xcd, 0xa6,
-	0x40, 0xd0, 0x42, 0x82, 0x36, 0xa0, 0x1d, 0x8e, 0x48, 0xd6, 0xa4, 0x32, 0x6e, 0xd2, 0x92, 0x10,
-	0x66, 0x63, 0xfc, 0x0a, 0xd0, 0xf8, 0xf1, 0x02, 0xdd, 0xcb, 0xb4, 0xa4, 0x23, 0x5b, 0xc2, 0x00,
-	0xa2, 0xaf, 0x1e, 0x40, 0x95, 0x1f, 0x31, 0x44, 0x57, 0xb5, 0x72, 0x20, 0x53, 0x28, 0x8d, 0xe7,
-	0x79, 0x76, 0xd1, 0x4f, 0x1f, 0x62, 0x37, 0x36, 0xa0, 0x26, 0xcb, 0xb4, 0x97, 0x88, 0x87, 0x63,
-	0xd9, 0x4b, 0xf4, 0x5b, 0xf5, 0x5c, 0x25, 0xd3, 0x73, 0xff, 0xa6, 0x41, 0x95, 0x1b, 0xfd, 0xff,
-	0xf4, 0x1c, 0xba, 0x03, 0xf5, 0x51, 0x40, 0x62, 0x7a, 0xfc, 0x76, 0xd9, 0xf4, 0xaa, 0x99, 0xa9,
-	0x00, 0xad, 0x40, 0x2d, 0x8a, 0xb1, 0xe5, 0x06, 0x36, 0x61, 0x3b, 0x4b, 0x8d, 0x46, 0x0f, 0xee,
-	0x05, 0x36, 0xa1, 0x86, 0x2a, 0xb1, 0x62, 0x7b, 0x42, 0xdd, 0x4c, 0x05, 0xc6, 0x3f, 0xe9, 0x30,
-	0x43, 0x2b, 0x40, 0x4b, 0x50, 0xa5, 0x67, 0xb2, 0x30, 0x10, 0x4d, 0x17, 0x25, 0xf4, 0x04, 0xc0,
-	0x8b, 0xac, 0x0b, 0x1c, 0x27, 0x54, 0x57, 0x61, 0xf3, 0x5a, 0x57, 0xf3, 0xfa, 0x2d, 0x97, 0x9b,
-	0x75, 0x2f, 0x12, 0x9f, 0xe8, 0x77, 0xa8, 0x2b, 0x21, 0x09, 0x9d, 0xd0, 0x17, 0x9b, 0x67, 0x27,
-	0x0d, 0x4e, 0x26, 0x36, 0x15, 0x00, 0x2d, 0xc3, 0x5c, 0x12, 0x3b, 0x56, 0x80, 0xa9, 0xdb, 0x74,
-	0xf6, 0x55, 0x93, 0xd8, 0x19, 0x60, 0x82, 0xbe, 0x84, 0x3a, 0x55, 0x44, 0x61, 0x4c, 0x92, 0xee,
-	0x2c, 0xeb, 0x1d, 0x15, 0xe3, 0x61, 0x4c, 0x4c, 0x3b, 0x38, 0xc3, 0x66, 0x2d, 0x89, 0x1d, 0x5a,
-	0x4a, 0x28, 0x8f, 0x9b, 0x10, 0xc6, 0x53, 0xe5, 0x3c, 0x6e, 0x42, 0x04, 0x0f, 0x55, 0x70, 0x9e,
-	0xb9, 0x49, 0x3c, 0x6e, 0x42, 0x38, 0xcf, 0x5d, 0xa8, 0x7b, 0xce, 0x30, 0xb2, 0xd8, 0x22, 0x46,
-	0xb7, 0x83, 0xd9, 0xbd, 0x29, 0xb3, 0x46, 0x45, 0x6c, 0x7d, 0x7a, 0x05, 0x6d, 0xa5, 0xb6, 0x9c,
-	0xd0, 0x95, 0x3b, 0x80, 0x4c, 0x6a, 0xfb, 0x02, 0xb8, 0x15, 0xb8, 0x3b, 0xa1, 0xcb, 0x8e, 0x54,
-	0xd2, 0x96, 0x96, 0xd1, 0x67, 0xd0, 0xa6, 0xad, 0xf2, 0x22, 0x2b, 0xc1, 0xc4, 0xf2, 0xdc, 0xa4,
-	0x0b, 0xcc, 0xdb, 0x46, 0x12, 0x3b, 0xfd, 0xe8, 0x08, 0x93, 0xbe, 0x9b, 0x50, 0x10, 0x75, 0x39,
-	0x03, 0x6a, 0x70, 0x90, 0x9b, 0x10, 0x05, 0x7a, 0x01, 0x2b, 0xac, 0xe3, 0xec, 0x21, 0x76, 0x59,
-	0xeb, 0xb2, 0xf8, 0x26, 0xc3, 0x2f, 0xd0, 0xae, 0xa4, 0x7a, 0xda, 0xb4, 0xac, 0x21, 0xeb, 0xa9,
-	0x52, 0xc3, 0x16, 0x37, 0xa4, 0x7d, 0x37, 0x66, 0xf8, 0xbb, 0x30, 0x2f, 0xdc, 0x62, 0x56, 0xd2,
-	0xa4, 0xc3, 0x4c, 0x3a, 0xcc, 0x37, 0x8a, 0x17, 0xe8, 0x0d, 0x68, 0x06, 0x21, 0xb1, 0x54, 0x24,
-	0x9c, 0x96, 0x47, 0x42, 0x23, 0x08, 0x89, 0x2c, 0xa0, 0x35, 0xa0, 0x45, 0x4b, 0x06, 0xc4, 0x19,
-	0x63, 0xae, 0x07, 0x21, 0x39, 0xe2, 0x31, 0xf1, 0x0c, 0x5a, 0x52, 0xcf, 0xc7, 0xf3, 0x7c, 0xc2,
-	0x78, 0x36, 0xb8, 0x0d, 0x1f, 0x52, 0xc1, 0x2a, 0xc3, 0xc3, 0x53, 0xac, 0x3d, 0x1e, 0x21, 0x82,
-	0x35, 0x8d, 0x92, 0x3f, 0xbe, 0x81, 0xb5, 0x27, 0x03, 0xe5, 0x73, 0x6e, 0x95, 0x06, 0xcb, 0x7b,
-	0x16, 0x2c, 0x1a, 0x43, 0xc9, 0x30, 0x40, 0xbb, 0x80, 0x72, 0x28, 0x1e, 0x33, 0xfe, 0x8d, 0x31,
-	0xa3, 0x99, 0x9d, 0x0c, 0x05, 0x0b, 0x9b, 0x47, 0x9c, 0xa6, 0x10, 0x3a, 0x43, 0xbe, 0x5d, 0xf1,
-	0xb6, 0xaa, 0x61, 0x12, 0xd8, 0x42, 0x04, 0x05, 0x0a, 0xdb, 0xcb, 0x04, 0xd1, 0x2b, 0xb8, 0xab,
-	0x3a, 0xbc, 0x34, 0x1e, 0x22, 0x66, 0xb6, 0x2c, 0x86, 0x60, 0x2c, 0x24, 0x84, 0xfd, 0xe4, 0x78,
-	0xfa, 0x5e, 0xd9, 0xf7, 0xca, 0x42, 0x6a, 0x03, 0x16, 0xc3, 0xd8, 0x3b, 0xf3, 0x02, 0xdb, 0x67,
-	0x4e, 0x24, 0xd8, 0xc7, 0x0e, 0x09, 0xe3, 0x6e, 0xcc, 0x96, 0xa0, 0x79, 0xa9, 0x3c, 0x8a, 0x9d,
-	0x23, 0xa1, 0xca, 0xd9, 0xd0, 0x8a, 0x95, 0x4d, 0x92, 0xb7, 0xe9, 0x25, 0x44, 0xd9, 0xec, 0xc2,
-	0xbd, 0x5c, 0x3d, 0xe9, 0xd1, 0x54, 0x59, 0x13, 0x66, 0x7d, 0x27, 0x53, 0xa3, 0x3a, 0xa0, 0x96,
-	0xd2, 0xc8, 0x36, 0x17, 0x68, 0x46, 0x79, 0x1a, 0xd1, 0xea, 0x3c, 0xcd, 0x4b, 0x58, 0x51, 0x34,
-	0xb2, 0xfb, 0x15, 0xc1, 0x05, 0x23, 0x58, 0x92, 0x80, 0x01, 0xeb, 0xf9, 0x89, 0xa6, 0xb9, 0x0e,
-	0xb8, 0x1c, 0x33, 0xcd, 0xf6, 0xc1, 0x77, 0x7c, 0xc1, 0x28, 0xde, 0x17, 0x0c, 0x6d, 0xe2, 0x9c,
-	0x77, 0xaf, 0x72, 0x87, 0x9c, 0xfc, 0x75, 0xc1, 0x1b, 0x8a, 0x30, 0x97, 0x12, 0xea, 0xc6, 0x98,
-	0x9c, 0xd2, 0x72, 0x27, 0xca, 0x68, 0xaf, 0x3f, 0x4c, 0xeb, 0x52, 0x17, 0xc7, 0x69, 0x9f, 0x00,
-	0x9c, 0x13, 0x12, 0x09, 0x9e, 0x3f, 0xc9, 0xe5, 0x38, 0x7b, 0xc7, 0xc7, 0x87, 0xdc, 0xba, 0x4e,
-	0x31, 0xd2, 0xa0, 0x26, 0x6f, 0x6a, 0xba, 0x7f, 0x9a, 0xbb, 0xe3, 0xa2, 0xbb, 0x9b, 0xba, 0x8c,
-	0x51, 0x20, 0xf4, 0x7b, 0xb0, 0x50, 0x88, 0x23, 0xe6, 0x45, 0xf7, 0xd7, 0x7c, 0xfb, 0x43, 0xb9,
-	0x38, 0x62, 0x2a, 0xd4, 0x83, 0xb5, 0x32, 0x93, 0x34, 0x0e, 0xba, 0x7f, 0xc6, 0x8d, 0x6f, 0x8f,
-	0x1b, 0xab, 0x30, 0xc8, 0x55, 0x9c, 0x19, 0x91, 0xee, 0x6f, 0x0a, 0x15, 0x1f, 0xa9, 0x0e, 0xcf,
-	0x55, 0x9c, 0x1d, 0xc4, 0xb4, 0xe2, 0x3f, 0x2f, 0x54, 0x9c, 0x1a, 0xa7, 0x15, 0x77, 0x61, 0x8e,
-	0x26, 0x1b, 0x96, 0xe7, 0x76, 0x7f, 0x10, 0x7b, 0x3c, 0x2d, 0xf7, 0xdd, 0xed, 0x2a, 0xcc, 0xd0,
-	0x25, 0x6a, 0x1b, 0xa0, 0x26, 0x97, 0xab, 0x5f, 0x54, 0x6b, 0xbf, 0xd5, 0xf4, 0x1f, 0x34, 0x13,
-	0xfc, 0xf0, 0xcc, 0x8a, 0x62, 0x7c, 0xea, 0x5d, 0x19, 0xaf, 0x61, 0xbe, 0x6c, 0xb0, 0x56, 0xa1,
-	0xa6, 0x82, 0x90, 0x13, 0xab, 0x32, 0x3d, 0x6e, 0x30, 0x2f, 0x45, 0x0e, 0xce, 0x0b, 0xc6, 0x3f,
-	0x68, 0x50, 0x57, 0xc3, 0xc8, 0x8f, 0x13, 0xe4, 0x3c, 0x74, 0x79, 0xea, 0xc4, 0x8e, 0x13, 0xac,
-	0x88, 0x9e, 0xc2, 0x6c, 0x64, 0x93, 0x73, 0x99, 0x1f, 0xad, 0x16, 0x23, 0xe0, 0xf1, 0xa1, 0x4d,
-	0xce, 0x79, 0x2c, 0x70, 0xe0, 0xea, 0xb7, 0x50, 0x57, 0x32, 0xb4, 0x04, 0xb3, 0xf8, 0xca, 0x76,
-	0x08, 0xf7, 0x6a, 0x6f, 0xca, 0xe4, 0x45, 0xd4, 0x85, 0x2a, 0x6f, 0x11, 0x4f, 0xe9, 0xf6, 0xa6,
-	0x4c, 0x51, 0xde, 0x6e, 0x02, 0x50, 0x1e, 0x1e, 0x77, 0xc6, 0xdf, 0x6a, 0xd0, 0xcc, 0x86, 0x0f,
-	0xfa, 0x06, 0x1a, 0x76, 0x10, 0x84, 0x84, 0x5d, 0x28, 0xc8, 0x44, 0xef, 0xf3, 0x92, 0x40, 0x7b,
-	0xbc, 0x95, 0xc2, 0xf8, 0x01, 0x2d, 0x6b, 0xb8, 0xfa, 0x0a, 0xf4, 0x22, 0xe0, 0x93, 0x8e, 0x6a,
-	0x2f, 0xa1, 0x53, 0xd8, 0x36, 0x58, 0xe2, 0x4a, 0xf7, 0x21, 0x6a, 0x3f, 0xcb, 0xcf, 0x56, 0x54,
-	0xc6, 0x36, 0x9c, 0x0a, 0x97, 0xd1, 0x6f, 0x63, 0x1f, 0x6a, 0x6a, 0xc3, 0xed, 0x42, 0x55, 0x9c,
-	0x7c, 0x35, 0x91, 0xea, 0x88, 0x32, 0x5a, 0xc8, 0xa6, 0xbc, 0x7b, 0x53, 0x3c, 0xe9, 0xdd, 0xd6,
-	0xa1, 0xcd, 0xf5, 0x56, 0x18, 0xb3, 0xe0, 0x33, 0x9e, 0x43, 0x5d, 0x6d, 0x90, 0xd4, 0xdf, 0x53,
-	0x2f, 0x4e, 0x88, 0xf0, 0x81, 0x17, 0xa8, 0x13, 0xbe, 0x9d, 0x10, 0xe9, 0x04, 0xfd, 0x36, 0xfe,
-	0x5a, 0x03, 0x54, 0x3c, 0xbc, 0xf7, 0x7b, 0xf4, 0x4c, 0x16, 0xc6, 0xce, 0x39, 0x4e, 0x48, 0x6c,
-	0x93, 0x30, 0xa6, 0x91, 0xca, 0x9b, 0xde, 0xce, 0x8a, 0xfb, 0x2e, 0xba, 0x07, 0x0d, 0x75, 0x53,
-	0xe0, 0xf1, 0x74, 0xb8, 0x6e, 0x82, 0x14, 0x71, 0x80, 0xba, 0x41, 0xf0, 0x5c, 0x96, 0x12, 0xd7,
-	0x4d, 0x90, 0xa2, 0xbe, 0xfb, 0x8b, 0x99, 0x9a, 0xa6, 0x57, 0xcc, 0xda, 0x79, 0x98, 0x10, 0xd6,
-	0x90, 0x2b, 0x58, 0x2a, 0xbf, 0xb7, 0x47, 0x5f, 0x64, 0x8e, 0x0f, 0x2b, 0x13, 0x2e, 0x1e, 0xc4,
-	0x31, 0xe5, 0x2b, 0xa8, 0xc9, 0x2a, 0xc4, 0xed, 0xcb, 0xf2, 0xa4, 0x8b, 0x7b, 0x05, 0x34, 0xfe,
-	0xb1, 0x02, 0x7a, 0x51, 0x4d, 0xbb, 0x32, 0x21, 0x36, 0x91, 0xa7, 0x35, 0x5e, 0x28, 0x3b, 0x88,
-	0xd0, 0xb0, 0x19, 0xda, 0x8e, 0xe8, 0x02, 0xfa, 0x49, 0xdb, 0x2e, 0x1f, 0x8c, 0xe8, 0x1e, 0xcc,
-	0xf3, 0x6a, 0x10, 0x22, 0xba, 0xed, 0xde, 0x86, 0xba, 0x17, 0x5d, 0x3c, 0xa3, 0xe9, 0x10, 0xcf,
-	0xad, 0xeb, 0x66, 0x8d, 0x0a, 0x06, 0x98, 0x48, 0xe5, 0x26, 0x57, 0x56, 0x95, 0x72, 0x93, 0x29,
-	0x1f, 0xc0, 0x2c, 0x3d, 0x11, 0xc9, 0x4c, 0x5a, 0xa6, 0x73, 0xc7, 0x1e, 0x8e, 0xfb, 0xc1, 0x69,
-	0x68, 0x72, 0x2d, 0xfa, 0x02, 0x6a, 0xbc, 0x02, 0x9b, 0x74, 0x6b, 0x0c, 0xd9, 0x56, 0xb7, 0xbe,
-	0x84, 0x01, 0xe7, 0x58, 0x7d, 0x36, 0x11, 0xd0, 0x4d, 0x06, 0xad, 0x4f, 0x84, 0x6e, 0x0e, 0x6c,
-	0x62, 0xec, 0x8c, 0x0f, 0x91, 0x38, 0xe1, 0x7d, 0xfc, 0x10, 0x19, 0x5b, 0xd0, 0xce, 0xde, 0x84,
-	0xf5, 0x7b, 0xc5, 0x50, 0xa9, 0x7c, 0x30, 0x54, 0x7c, 0x40, 0xe3, 0x8f, 0x50, 0xe8, 0x41, 0xc6,
-	0x87, 0xc5, 0x92, 0x3b, 0x37, 0x11, 0x22, 0x4f, 0x32, 0x21, 0x32, 0x9d, 0xdb, 0xa7, 0x72, 0x2f,
-	0x51, 0x69, 0x78, 0xfc, 0x77, 0x05, 0x9a, 0x59, 0x55, 0xd9, 0x39, 0xbe, 0x38, 0xe4, 0x95, 0xb1,
-	0x21, 0x57, 0x03, 0x37, 0x7d, 0xe3, 0xc0, 0x3d, 0x86, 0x79, 0x7c, 0x15, 0x61, 0x87, 0x60, 0xd7,
-	0x62, 0x23, 0x68, 0xbb, 0x6e, 0x2c, 0x43, 0xe8, 0x96, 0x54, 0xf5, 0xa3, 0x8b, 0x67, 0x5b, 0x54,
-	0x51, 0xc4, 0x6f, 0x0a, 0xfc, 0xec, 0x18, 0x7e, 0x93, 0xe3, 0x7f, 0x0a, 0x1d, 0x75, 0x66, 0xb5,
-	0xb8, 0x43, 0xd5, 0x72, 0x87, 0xda, 0x0a, 0x77, 0xcc, 0x3c, 0x7b, 0x0e, 0x6d, 0x79, 0xc0, 0xb5,
-	0x6e, 0x0c, 0xc1, 0xa6, 0x38, 0xf7, 0x72, 0xb3, 0x67, 0xd0, 0x3a, 0x0d, 0xe3, 0x4b, 0x3b, 0x96,
-	0xd5, 0xd5, 0x26, 0x58, 0x09, 0x14, 0xb3, 0x32, 0x7e, 0x3f, 0x3f, 0xc2, 0x22, 0xca, 0x3e, 0x6e,
-	0x84, 0x8d, 0x18, 0x6a, 0x92, 0xb6, 0x74, 0xac, 0xbe, 0x00, 0xdd, 0x0b, 0xce, 0x62, 0x9c, 0x24,
-	0xfc, 0xd9, 0xd4, 0x53, 0x9b, 0x63, 0x47, 0xc8, 0x0f, 0x85, 0x98, 0xae, 0x87, 0xb8, 0x80, 0x14,
-	0x77, 0x54, 0x38, 0x07, 0x34, 0x5e, 0xc0, 0x9c, 0x98, 0x2e, 0x68, 0x11, 0xaa, 0xf8, 0x8a, 0x26,
-	0xe1, 0x72, 0xe9, 0xc0, 0x57, 0xa4, 0x1f, 0x51, 0x31, 0x0b, 0xf0, 0x48, 0x6e, 0x26, 0xd4, 0xe1,
-	0xc8, 0x30, 0x61, 0xbe, 0xe4, 0x4a, 0x1b, 0x7d, 0x06, 0x2d, 0x2f, 0x09, 0x2d, 0xe2, 0x0d, 0x71,
-	0x42, 0xec, 0xa1, 0xe4, 0x6a, 0x7a, 0x49, 0x78, 0x2c, 0x65, 0x68, 0x09, 0xaa, 0xa3, 0x88, 0x42,
-	0x18, 0xa5, 0x66, 0x8a, 0x92, 0x11, 0x41, 0x77, 0xd2, 0x75, 0xf6, 0xc7, 0xce, 0x92, 0x2f, 0xa1,
-	0xca, 0x2f, 0x5a, 0xc5, 0x7d, 0xcf, 0xa2, 0xba, 0xe1, 0xcf, 0x5d, 0xe4, 0x0a, 0x90, 0xb1, 0x0e,
-	0xed, 0xbc, 0x86, 0xfa, 0x26, 0x08, 0x44, 0xa6, 0x23, 0x90, 0x5b, 0x65, 0xbe, 0x7d, 0xda, 0xf8,
-	0x5e, 0xc1, 0x9d, 0x9b, 0x6e, 0xb9, 0x3f, 0x65, 0xbf, 0xf8, 0xc4, 0x66, 0xf6, 0x27, 0xd5, 0xfc,
-	0xe9, 0xcb, 0xe0, 0x26, 0x2c, 0x96, 0xde, 0x56, 0xa3, 0xbb, 0x00, 0xd1, 0xe8, 0xc4, 0xf7, 0x1c,
-	0x2b, 0x4d, 0x46, 0xea, 0x5c, 0xf2, 0x2d, 0xbe, 0x36, 0xde, 0xf0, 0x99, 0x51, 0x78, 0xda, 0x5d,
-	0x05, 0xb5, 0x3a, 0xca, 0x04, 0x50, 0x96, 0xd5, 0x66, 0x43, 0x57, 0x06, 0x11, 0x7b, 0x6c, 0x73,
-	0xa0, 0x0b, 0x42, 0x91, 0x4e, 0xb4, 0xe3, 0x7f, 0x4d, 0xb7, 0x0b, 0xed, 0xfc, 0xd3, 0x70, 0xc9,
-	0xd5, 0xf0, 0x4c, 0x14, 0x86, 0xbe, 0xe8, 0xef, 0x4e, 0xf1, 0x31, 0x98, 0x29, 0x8d, 0xfb, 0x29,
-	0xcd, 0x84, 0x4b, 0xdf, 0x57, 0x50, 0x93, 0x08, 0x96, 0x64, 0x79, 0xae, 0xba, 0x31, 0xa4, 0xdf,
-	0x68, 0x0d, 0x60, 0x68, 0x27, 0xdf, 0x8f, 0x70, 0x6c, 0x8b, 0xf4, 0xab, 0x66, 0x66, 0x24, 0xc6,
-	0x3b, 0x68, 0xe5, 0x1e, 0xab, 0xd0, 0x4f, 0xa0, 0xe9, 0x45, 0x5e, 0x64, 0xe1, 0xc0, 0x3e, 0xf1,
-	0x31, 0xaf, 0xaa, 0x66, 0x36, 0xa8, 0x6c, 0x97, 0x8b, 0xe8, 0x9c, 0xbc, 0xb8, 0xf2, 0xed, 0x40,
-	0x61, 0x38, 0x6d, 0x93, 0x09, 0x05, 0xc8, 0xf8, 0x17, 0x0d, 0x16, 0xca, 0x9e, 0x90, 0xd1, 0xc3,
-	0x4c, 0x6c, 0x2c, 0x97, 0x1e, 0xc8, 0x44, 0x4c, 0xfe, 0x1c, 0xaa, 0xbe, 0x7d, 0x82, 0x7d, 0x99,
-	0x73, 0x3f, 0xbc, 0xe1, 0x61, 0xfa, 0xf1, 0x3e, 0x43, 0x8a, 0x17, 0x08, 0x6e, 0xb6, 0xfa, 0x12,
-	0x1a, 0x19, 0xf1, 0x27, 0xa5, 0xb5, 0x3f, 0x2f, 0x3a, 0xaf, 0x9e, 0x6a, 0x3e, 0xce, 0x79, 0xa3,
-	0x07, 0x7a, 0x51, 0x9e, 0xbf, 0xff, 0xd4, 0x0a, 0xf7, 0x9f, 0xa5, 0x77, 0xbb, 0xff, 0xac, 0x41,
-	0xa7, 0xf0, 0xc6, 0x8d, 0x8c, 0x8c, 0x0b, 0xa8, 0xf8, 0x84, 0x2d, 0xba, 0xee, 0xeb, 0x42, 0xd7,
-	0x19, 0xe5, 0xef, 0xe5, 0xff, 0xd7, 0xbd, 0xf6, 0x3c, 0xe3, 0xad, 0xe8, 0xb0, 0x8f, 0xf0, 0xd6,
-	0xf8, 0x09, 0x34, 0x32, 0xa2, 0xd2, 0xe7, 0x81, 0x63, 0x00, 0xfe, 0x54, 0x7d, 0x2c, 0x4e, 0x13,
-	0x34, 0x1e, 0x45, 0x6c, 0xb2, 0x6f, 0xe6, 0x15, 0x8d, 0x3f, 0x11, 0x8c, 0xbc, 0x40, 0xbb, 0x5c,
-	0x3d, 0x79, 0xc9, 0xbb, 0x6a, 0x25, 0x30, 0xfe, 0xa3, 0x02, 0x8d, 0xcc, 0xe3, 0x3d, 0xfa, 0x3c,
-	0x73, 0x72, 0x49, 0xef, 0x96, 0x19, 0x22, 0x7d, 0x27, 0x42, 0x5f, 0xd1, 0x19, 0xc2, 0xff, 0xd0,
-	0xc1, 0xd0, 0xfc, 0x26, 0xfa, 0x96, 0x9a, 0xc1, 0x74, 0x2e, 0x32, 0x38, 0x78, 0x91, 0xfc, 0xa6,
-	0xdd, 0xe8, 0x26, 0x44, 0x26, 0xc7, 0x6e, 0x42, 0x90, 0x01, 0x2d, 0x76, 0x75, 0x13, 0xba, 0xfc,
-	0xf8, 0x2c, 0x8e, 0x06, 0x0d, 0x37, 0x21, 0x83, 0xd0, 0x65, 0xa7, 0x65, 0xb4, 0x06, 0x0d, 0x85,
-	0xf1, 0x22, 0x79, 0x67, 0x2e, 0x10, 0xfd, 0x88, 0x66, 0x5b, 0x89, 0x3d, 0xc4, 0x56, 0x32, 0x3a,
-	0x09, 0x30, 0x61, 0x6f, 0x92, 0x35, 0x13, 0xa8, 0xe8, 0x88, 0x49, 0xe8, 0x6c, 0xa6, 0x79, 0x4a,
-	0x38, 0x22, 0x67, 0xa1, 0x17, 0x9c, 0xb1, 0x8b, 0xe4, 0x9a, 0xd9, 0x08, 0x6c, 0x72, 0x20, 0x44,
-	0xe8, 0x01, 0xb4, 0xfd, 0xd0, 0xb1, 0x7d, 0x4b, 0x1e, 0x5a, 0xd8, 0x4d, 0x72, 0xcd, 0x6c, 0x31,
-	0xa9, 0x5c, 0xb5, 0xd1, 0x06, 0x34, 0x08, 0x1b, 0x01, 0xde, 0x68, 0xfe, 0xdf, 0x25, 0xd9, 0xe8,
-	0x74, 0x6c, 0x4c, 0x20, 0xea, 0xdb, 0xb8, 0x27, 0xba, 0x57, 0xc4, 0x82, 0xe8, 0x83, 0x8a, 0xea,
-	0x03, 0xe3, 0x2f, 0x34, 0x58, 0x99, 0xf8, 0x67, 0x06, 0x16, 0x08, 0xf4, 0xd0, 0x28, 0x03, 0x81,
-	0x1e, 0x2e, 0xc5, 0x21, 0xa3, 0x92, 0x1e, 0x32, 0x72, 0xeb, 0xf0, 0x74, 0x7e, 0x1d, 0x46, 0xeb,
-	0xa0, 0x47, 0x76, 0x8c, 0x03, 0x62, 0xb9, 0x98, 0x5d, 0x54, 0x78, 0x91, 0xe8, 0xe7, 0x36, 0x97,
-	0xf7, 0x98, 0xb8, 0x1f, 0x19, 0x4f, 0x4a, 0x3d, 0x11, 0x9e, 0x97, 0x78, 0x62, 0xfc, 0x46, 0x83,
-	0xe5, 0x09, 0x7f, 0x78, 0xb8, 0x71, 0xdf, 0xc8, 0xef, 0x6b, 0x95, 0xc2, 0xbe, 0x46, 0x13, 0x59,
-	0x2f, 0x20, 0x38, 0x3e, 0xb5, 0x99, 0xb7, 0xf9, 0x86, 0xdd, 0x52, 0x2a, 0x99, 0xf9, 0x1a, 0xcf,
-	0x4b, 0xbc, 0xf8, 0xf0, 0xee, 0x65, 0xfc, 0xab, 0x06, 0x8b, 0xa5, 0xff, 0x79, 0x40, 0x1b, 0xb0,
-	0x28, 0x6f, 0x75, 0x1c, 0x7f, 0x94, 0x10, 0x1c, 0x5b, 0x74, 0x27, 0x91, 0x37, 0x22, 0xf3, 0x42,
-	0xb9, 0xc3, 0x75, 0x3b, 0x54, 0x85, 0x9e, 0xa5, 0x7f, 0xff, 0xc1, 0x57, 0x04, 0xc7, 0x81, 0xed,
-	0x0b, 0xa3, 0x8a, 0x78, 0x00, 0xe0, 0xda, 0x5d, 0xa1, 0xe4, 0x56, 0x3f, 0x83, 0x55, 0x69, 0x45,
-	0x43, 0xec, 0xc4, 0xf6, 0xed, 0xc0, 0x51, 0xd5, 0xf1, 0xfc, 0xb2, 0x2b, 0x10, 0xfb, 0x19, 0x00,
-	0xb3, 0x7e, 0xb4, 0x0e, 0x75, 0xf5, 0xea, 0x83, 0xe6, 0x60, 0x7a, 0x6b, 0xf0, 0x4b, 0x7d, 0x0a,
-	0xd5, 0x60, 0xa6, 0x7f, 0xf8, 0xf6, 0x99, 0x3e, 0x23, 0xbe, 0x36, 0xf5, 0xea, 0xa3, 0xbf, 0xd4,
-	0xa0, 0xae, 0x26, 0x31, 0x6a, 0x41, 0x7d, 0xa7, 0xdf, 0x33, 0xad, 0xfe, 0xe0, 0x9b, 0x03, 0x7d,
-	0x0a, 0xcd, 0x43, 0xc7, 0xdc, 0x7d, 0x73, 0x70, 0xbc, 0x6b, 0xbd, 0x3b, 0x30, 0xbf, 0xdd, 0x3f,
-	0xd8, 0xea, 0xe9, 0x1a, 0xea, 0x40, 0x43, 0x08, 0xf7, 0x0e, 0x8e, 0x8e, 0xf5, 0x0a, 0x42, 0xd0,
-	0xde, 0x3f, 0xd8, 0xd9, 0xda, 0x4f, 0x41, 0xd3, 0xa8, 0x0d, 0xc0, 0x65, 0x0c, 0x33, 0x83, 0x6e,
-	0x41, 0x4b, 0x18, 0x1d, 0x7f, 0x37, 0x18, 0xec, 0xee, 0xeb, 0xb3, 0x48, 0x87, 0x26, 0x87, 0x08,
-	0x49, 0xf5, 0xd1, 0x4b, 0x80, 0x74, 0x85, 0xa0, 0x3e, 0x0e, 0x0e, 0x06, 0xbb, 0xfa, 0x14, 0x6a,
-	0x42, 0x6d, 0x70, 0x60, 0xed, 0x0e, 0x76, 0xb6, 0x0e, 0x75, 0x0d, 0xd5, 0x61, 0x96, 0x05, 0xa3,
-	0x5e, 0xe1, 0xcd, 0xe8, 0x1f, 0xea, 0xd3, 0x1b, 0xaf, 0x00, 0xf8, 0x3b, 0x1f, 0xfb, 0x83, 0xea,
-	0x53, 0x98, 0x61, 0xbf, 0x72, 0x51, 0xcd, 0xfc, 0xed, 0x75, 0x55, 0xca, 0x32, 0x7f, 0x7d, 0x7d,
-	0xaa, 0x6d, 0x2f, 0xff, 0xf6, 0xc7, 0x35, 0xed, 0xdf, 0x7f, 0x5c, 0xd3, 0xfe, 0xf3, 0xc7, 0x35,
-	0xed, 0x6f, 0xfe, 0x6b, 0x6d, 0xea, 0x0f, 0x67, 0xd9, 0xa3, 0xc8, 0x49, 0x95, 0xfd, 0x7c, 0xf5,
-	0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2b, 0x0a, 0x67, 0x93, 0x58, 0x2b, 0x00, 0x00,
+	// 4271 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7b, 0xcb, 0x73, 0x1c, 0x47,
+	0x72, 0x37, 0x7a, 0x06, 0x33, 0x98, 0xc9, 0xc1, 0x0c, 0x86, 0x85, 0x07, 0x07, 0x20, 0x08, 0x52,
+	0x4d, 0xe9, 0x13, 0xa5, 0xd5, 0x52, 0x5c, 0x8a, 0x1c, 0x2e, 0xf5, 0xed, 0x72, 0x8d, 0x17, 0xc5,
+	0x59, 0x91, 0x03, 0xb8, 0x01, 0x41, 0x5e, 0xc7, 0x46, 0xb4, 0x1b, 0xdd, 0x05, 0xa0, 0xad, 0x46,
+	0x77, 0xb3, 0xbb, 0x06, 0x8f, 0x75, 0xf8, 0x60, 0xd9, 0x3e, 0x38, 0x7c, 0xb0, 0x7d, 0x71, 0x84,
+	0xcf, 0xf6, 0xc9, 0xe1, 0xa3, 0x2f, 0x0e, 0x1f, 0x7c, 0x72, 0xc4, 0xee, 0x6d, 0x0f, 0x3e, 0xf8,
+	0xe2, 0x08, 0x87, 0x74, 0xf3, 0x6d, 0xfd, 0x17, 0x38, 0xea, 0xd9, 0xcf, 0x19, 0x80, 0x0a, 0x87,
+	0x4f, 0x98, 0xca, 0xfc, 0x65, 0x56, 0x56, 0xd6, 0x2b, 0x33, 0xbb, 0x00, 0xe8, 0x08, 0x7b, 0xee,
+	0xc5, 0xa1, 0x65, 0x7f, 0x85, 0x7d, 0xe7, 0x41, 0x18, 0x05, 0x24, 0x40, 0x35, 0x46, 0xd3, 0xdb,
+	0xd0, 0xda, 0xbb, 0xf4, 0x6d, 0x03, 0xbf, 0x19, 0xe1, 0x98, 0xe8, 0xff, 0xb4, 0x08, 0xad, 0xfd,
+	0x60, 0xcb, 0x22, 0x56, 0xe8, 0x59, 0x3e, 0x46, 0xef, 0xc3, 0x5c, 0x4c, 0x59, 0xbe, 0x8d, 0x4d,
+	0x7f, 0x74, 0x7a, 0x88, 0xa3, 0xde, 0xdc, 0x5d, 0xed, 0xfe, 0xb4, 0xd1, 0x91, 0xe4, 0x21, 0xa3,
+	0xa2, 0xfb, 0x30, 0xe3, 0xfa, 0x66, 0x7c, 0xe9, 0xdb, 0x3d, 0xed, 0xae, 0x76, 0xbf, 0xf5, 0xa8,
+	0xfd, 0x80, 0x75, 0xf0, 0x60, 0xe0, 0x53, 0xfd, 0x2f, 0xa7, 0x8c, 0xba, 0xcb, 0x7e, 0xa1, 0xa7,
+	0x30, 0xeb, 0x86, 0x31, 0x26, 0xe6, 0x28, 0x74, 0x2c, 0x82, 0x7b, 0x15, 0x06, 0x47, 0x12, 0xbe,
+	0xbb, 0x87, 0xc9, 0x17, 0x8c, 0xf3, 0x72, 0xca, 0x68, 0x31, 0x24, 0x6f, 0xa2, 0xcf, 0x00, 0x71,
+	0x41, 0x07, 0x7b, 0xc4, 0x92, 0xe2, 0x55, 0x26, 0x7e, 0x33, 0x2d, 0xbe, 0x45, 0xf9, 0x4a, 0x47,
+	0x97, 0x09, 0xa5, 0x68, 0x89, 0x05, 0x11, 0x3e, 0x0d, 0xce, 0x70, 0x6f, 0xba, 0x68, 0x81, 0xc1,
+	0x38, 0xca, 0x02, 0xde, 0x44, 0xbb, 0xb0, 0x68, 0xd9, 0xc4, 0x3d, 0xc3, 0x66, 0x18, 0x05, 0x47,
+	0xae, 0x87, 0xa5, 0x11, 0x35, 0xa6, 0x61, 0x45, 0x68, 0x58, 0x67, 0x98, 0x5d, 0x0e, 0x51, 0x76,
+	0xcc, 0x5b, 0x45, 0x72, 0x89, 0x46, 0x61, 0x53, 0x7d, 0xbc, 0x46, 0x65, 0xdb, 0xbc, 0x55, 0x24,
+	0xa3, 0xd7, 0xb0, 0x20, 0x35, 0x06, 0x9e, 0x6b, 0x5f, 0x4a, 0x13, 0x67, 0x98, 0xc2, 0xe5, 0xac,
+	0x42, 0x86, 0x50, 0x16, 0x22, 0xab, 0x40, 0x2d, 0xaa, 0x13, 0xf6, 0x35, 0xc6, 0xaa, 0x53, 0xe6,
+	0x21, 0xab, 0x40, 0xa5, 0xea, 0x4e, 0x82, 0x98, 0x98, 0xd8, 0x77, 0xc2, 0xc0, 0xf5, 0xd5, 0x22,
+	0x68, 0x66, 0xd4, 0xbd, 0x0c, 0x62, 0xb2, 0x2d, 0x10, 0x89, 0x75, 0x27, 0x05, 0x6a, 0x51, 0x9d,
+	0xb0, 0x0e, 0xc6, 0xaa, 0x4b, 0xac, 0x3b, 0x29, 0x50, 0xd1, 0xcf, 0xa0, 0x77, 0x1e, 0x44, 0x5f,
+	0x79, 0x81, 0xe5, 0x14, 0x2c, 0x6c, 0x31, 0x95, 0xb7, 0x85, 0xca, 0x2f, 0x05, 0xac, 0x60, 0xe5,
+	0xd2, 0x79, 0x29, 0xa7, 0x5c, 0xb5, 0xb0, 0x76, 0x76, 0xa2, 0x6a, 0x65, 0xf1, 0xd2, 0x79, 0x29,
+	0x07, 0x7d, 0x0a, 0x6d, 0x3b, 0xf0, 0x8f, 0xdc, 0x63, 0x69, 0x6a, 0x9b, 0xe9, 0x9b, 0x17, 0xfa,
+	0x36, 0x19, 0x4f, 0x19, 0x38, 0x6b, 0xa7, 0xda, 0xca, 0x81, 0xa7, 0x98, 0x58, 0x8e, 0x95, 0xec,
+	0xaa, 0x4e, 0xc1, 0x81, 0xaf, 0x05, 0x22, 0x3b, 0x1f, 0x59, 0x6a, 0x51, 0x9d, 0x18, 0x21, 0x1a,
+	0xab, 0x2e, 0x3b, 0x1f, 0x59, 0x2a, 0x5a, 0x87, 0xae, 0x1b, 0x5a, 0xa7, 0x66, 0x18, 0x04, 0x9e,
+	0xb4, 0xac, 0xcb, 0x54, 0x2d, 0xaa, 0xcd, 0xba, 0xfe, 0x7a, 0x37, 0x08, 0x3c, 0x65, 0x55, 0x87,
+	0x0a, 0x24, 0x94, 0xac, 0x0a, 0x61, 0xcd, 0x8d, 0x52, 0x15, 0xca, 0x12, 0xa5, 0x42, 0x58, 0xb1,
+	0x07, 0x4b, 0x31, 0x8e, 0xce, 0x5c, 0x1b, 0x9b, 0x96, 0x6d, 0x07, 0xa3, 0x64, 0x4d, 0xcc, 0x33,
+	0x45, 0xb7, 0x84, 0xa2, 0x3d, 0x0e, 0x5a, 0xe7, 0x18, 0x65, 0xd1, 0x42, 0x5c, 0x42, 0x2f, 0x53,
+	0x2a, 0xac, 0x5b, 0x98, 0xa0, 0x54, 0xd9, 0xb8, 0x10, 0x97, 0xd0, 0xd1, 0x26, 0x74, 0x7d, 0xeb,
+	0x14, 0xc7, 0xa1, 0x65, 0xab, 0xa3, 0x69, 0x91, 0xa9, 0x5b, 0x12, 0xea, 0x86, 0x92, 0xad, 0xcc,
+	0x9b, 0xf3, 0xb3, 0xa4, 0xac, 0x12, 0x61, 0xd3, 0x52, 0xb9, 0x12, 0x65, 0xce, 0x9c, 0x9f, 0x25,
+	0xd1, 0x23, 0x36, 0x0a, 0x46, 0x44, 0x59, 0x71, 0x33, 0x73, 0xc4, 0x1a, 0x94, 0x95, 0x1c, 0xf2,
+	0x51, 0xd2, 0x4c, 0x04, 0x45, 0xcf, 0xbd, 0xa2, 0x60, 0x72, 0x36, 0x47, 0x49, 0x13, 0x6d, 0x42,
+	0xeb, 0x8c, 0xe0, 0x50, 0x76, 0xb8, 0xcc, 0xe4, 0xee, 0x0a, 0xb9, 0x83, 0xdf, 0x79, 0xb5, 0x3e,
+	0xdc, 0x1f, 0xf9, 0x3e, 0xf6, 0x0a, 0x3b, 0x16, 0xa8, 0x98, 0x1a, 0x3b, 0x57, 0x22, 0x3a, 0x5f,
+	0xb9, 0x4a, 0x89, 0x32, 0x85, 0x29, 0x11, 0x96, 0xfc, 0x1c, 0x96, 0xcf, 0xdd, 0x08, 0x1f, 0x8f,
+	0xac, 0xa8, 0x78, 0x8c, 0xdc, 0x62, 0x2a, 0xd7, 0xe4, 0x5e, 0x97, 0xb8, 0x82, 0x55, 0x37, 0xcf,
+	0xcb, 0x59, 0x63, 0xb4, 0x0b, 0x83, 0x57, 0x27, 0x6b, 0x57, 0xe6, 0xde, 0x3c, 0x2f, 0x67, 0xa1,
+	0x2f, 0xa1, 0x77, 0xec, 0x05, 0x87, 0x96, 0x67, 0x1e, 0x1e, 0x87, 0x66, 0xf6, 0x58, 0xb9, 0xcd,
+	0x94, 0xaf, 0x0a, 0xe5, 0x9f, 0x31, 0xd8, 0xc6, 0x67, 0xbb, 0xb9, 0xf3, 0x65, 0x91, 0xcb, 0x6f,
+	0x1c, 0x87, 0x69, 0x06, 0xfa, 0x11, 0xb4, 0xb1, 0x6f, 0x5b, 0x61, 0x3c, 0xf2, 0x2c, 0xe2, 0x06,
+	0x7e, 0x6f, 0x8d, 0x69, 0x5b, 0x10, 0xda, 0xb6, 0xd3, 0xbc, 0x97, 0x53, 0x46, 0x16, 0x4c, 0xaf,
+	0xc9, 0x90, 0x46, 0x2f, 0xc4, 0xb4, 0xad, 0x90, 0x8c, 0x22, 0xb5, 0xae, 0xee, 0x64, 0xae, 0xc9,
+	0x5d, 0x86, 0xd9, 0xe4, 0x90, 0xe4, 0xe2, 0x0d, 0x8b, 0xe4, 0x12, 0x8d, 0xc2, 0x85, 0x77, 0xc7,
+	0x6b, 0x4c, 0x2e, 0xde, 0xb0, 0x48, 0x46, 0x36, 0xac, 0x96, 0x4c, 0xcc, 0x59, 0x5f, 0x9a, 0xfa,
+	0x4e, 0x66, 0x31, 0x15, 0xe6, 0xe6, 0xa0, 0xaf, 0x0c, 0x5e, 0x3e, 0x1f, 0xc7, 0x1c, 0xdf, 0x89,
+	0xb0, 0x5e, 0xbf, 0xaa, 0x13, 0x35, 0x86, 0xe5, 0xf3, 0x71, 0x4c, 0xb4, 0x0f, 0x37, 0xb3, 0xa7,
+	0x78, 0x32, 0x88, 0x7b, 0x99, 0xc3, 0x29, 0x7d, 0x90, 0xa7, 0xec, 0x5f, 0x38, 0x29, 0xa1, 0x97,
+	0x6a, 0x15, 0x56, 0xbf, 0x3b, 0x41, 0x6b, 0x72, 0xe4, 0x9d, 0x94, 0xd0, 0x37, 0x9a, 0x30, 0x13,
+	0x5a, 0x97, 0xf4, 0x56, 0xd4, 0xff, 0xbb, 0x06, 0xed, 0x17, 0x51, 0x70, 0x3a, 0x31, 0x7a, 0x6d,
+	0x94, 0x46, 0xaf, 0x74, 0x35, 0x44, 0x81, 0x8d, 0xe3, 0xd8, 0x8c, 0x89, 0x45, 0x46, 0x71, 0x36,
+	0xba, 0x54, 0xab, 0x81, 0x63, 0xf6, 0x18, 0x24, 0xb5, 0xbe, 0x8a, 0x64, 0xf4, 0x7b, 0x70, 0x2b,
+	0x1b, 0x99, 0x64, 0xf5, 0xf2, 0x90, 0xf3, 0x4e, 0x49, 0x80, 0x92, 0x53, 0xde, 0x3b, 0x19, 0xc3,
+	0x1b, 0xdb, 0x83, 0xf0, 0x69, 0xed, 0x8a, 0x1e, 0x94, 0x5f, 0x7b, 0x27, 0x63, 0x78, 0xc8, 0x83,
+	0x3b, 0xc5, 0x98, 0x25, 0x3b, 0x0e, 0x1e, 0xa6, 0xde, 0x1b, 0x13, 0xba, 0xe4, 0xc6, 0xb2, 0x7a,
+	0x3e, 0x81, 0x3f, 0xb1, 0x37, 0x31, 0xa6, 0x99, 0x6b, 0xf4, 0xa6, 0xc6, 0xb5, 0x7a, 0x3e, 0x81,
+	0x8f, 0x0e, 0x20, 0x39, 0x03, 0x73, 0x63, 0x6a, 0x66, 0xce, 0x39, 0xb5, 0x87, 0x72, 0x83, 0x59,
+	0x3c, 0x2f, 0x63, 0xd0, 0x0d, 0x9a, 0x3b, 0x57, 0xb2, 0xca, 0x21, 0xb3, 0x41, 0x33, 0xc7, 0x4b,
+	0xae, 0x83, 0xe5, 0x70, 0x1c, 0x33, 0xbd, 0xe8, 0xbf, 0xd6, 0x60, 0x36, 0x73, 0xd0, 0x3e, 0x85,
+	0x3a, 0x3f, 0xb6, 0x7b, 0xda, 0xdd, 0x6a, 0x6a, 0x05, 0xa4, 0x41, 0xa2, 0xb1, 0xed, 0x93, 0xe8,
+	0xd2, 0x10, 0xf0, 0x95, 0x67, 0xd0, 0x4a, 0x91, 0x51, 0x17, 0xaa, 0x5f, 0xe1, 0x4b, 0x96, 0xcc,
+	0x35, 0x0d, 0xfa, 0x13, 0x2d, 0x40, 0xed, 0xcc, 0xf2, 0x46, 0x3c, 0x63, 0x6b, 0x1a, 0xbc, 0xf1,
+	0x69, 0xe5, 0x87, 0x9a, 0xde, 0x80, 0x3a, 0x4f, 0xf3, 0xf4, 0xbf, 0xd3, 0xa0, 0x95, 0x4a, 0xe1,
+	0x50, 0x07, 0x2a, 0xae, 0x23, 0x94, 0x54, 0x5c, 0x07, 0xf5, 0x60, 0xe6, 0x14, 0xd3, 0x2d, 0x17,
+	0xf7, 0x2a, 0x77, 0xab, 0xf7, 0x9b, 0x86, 0x6c, 0xa2, 0x87, 0x30, 0x4d, 0x2e, 0x43, 0xbe, 0xe3,
+	0x3a, 0xca, 0xfb, 0x29, 0x5d, 0xfc, 0xf7, 0xfe, 0x65, 0x88, 0x0d, 0x86, 0xd4, 0x9f, 0x41, 0x53,
+	0x91, 0x50, 0x1d, 0x2a, 0x83, 0xdd, 0xee, 0x14, 0x9a, 0xa3, 0xfd, 0x9b, 0xeb, 0xc3, 0x2d, 0x73,
+	0x77, 0xc7, 0xd8, 0xef, 0x6a, 0x68, 0x06, 0xaa, 0xc3, 0xed, 0xfd, 0x6e, 0x05, 0x01, 0xd4, 0xb7,
+	0x76, 0x5e, 0xaf, 0x0f, 0x86, 0xdd, 0xaa, 0x1e, 0x42, 0x37, 0x9f, 0x29, 0x16, 0x4c, 0xbd, 0x07,
+	0x6d, 0xcb, 0x71, 0xb0, 0x63, 0x66, 0x0d, 0x9e, 0x65, 0xc4, 0xd7, 0xc2, 0xea, 0xf7, 0x61, 0x8e,
+	0xaf, 0xcd, 0x04, 0x56, 0x65, 0xb0, 0x8e, 0x20, 0x0b, 0xa0, 0x7e, 0x5b, 0xf8, 0x45, 0x2c, 0xbf,
+	0x5c, 0x67, 0xba, 0x05, 0xf3, 0x25, 0x59, 0x23, 0xba, 0xab, 0x60, 0xad, 0x47, 0xdd, 0xe4, 0x10,
+	0xa2, 0x88, 0xc1, 0x16, 0xb3, 0xf2, 0x3e, 0xcc, 0x88, 0xcc, 0x51, 0x24, 0xd2, 0x9d, 0x2c, 0xcc,
+	0x90, 0x6c, 0xfd, 0x69, 0xae, 0x0b, 0x61, 0xc9, 0x95, 0x5d, 0xe8, 0x77, 0xa0, 0xa9, 0x08, 0x08,
+	0xc1, 0x34, 0x8d, 0xf5, 0x84, 0xe9, 0xec, 0xb7, 0x1e, 0xc0, 0x8c, 0x00, 0xa0, 0x87, 0xd0, 0x76,
+	0xfd, 0xc3, 0x60, 0xe4, 0x3b, 0x66, 0x34, 0xf2, 0x70, 0x2c, 0x16, 0x61, 0x4b, 0x28, 0x36, 0x46,
+	0x1e, 0x36, 0x66, 0x05, 0x82, 0x36, 0x62, 0xf4, 0x08, 0x3a, 0xc1, 0x88, 0xa4, 0x45, 0x2a, 0x45,
+	0x91, 0xb6, 0x84, 0x30, 0x19, 0xfd, 0xe7, 0x80, 0x8a, 0x09, 0x2c, 0xba, 0x93, 0x1a, 0xc9, 0x9c,
+	0x1c, 0x09, 0x03, 0x08, 0x5f, 0xbd, 0x07, 0x75, 0x9e, 0xc4, 0xf6, 0x2a, 0x99, 0x12, 0x05, 0x07,
+	0x19, 0x82, 0xa9, 0x3f, 0xc9, 0x6a, 0x17, 0x7e, 0xba, 0x4a, 0xbb, 0xfe, 0x08, 0x1a, 0xb2, 0x4d,
+	0xbd, 0x44, 0x5c, 0x1c, 0x49, 0x2f, 0xd1, 0xdf, 0xca, 0x73, 0x95, 0x94, 0xe7, 0xfe, 0x55, 0x83,
+	0x3a, 0x17, 0x42, 0xab, 0xd0, 0x54, 0x41, 0x34, 0x3b, 0xbc, 0x9b, 0x46, 0x42, 0xf8, 0xbf, 0xf1,
+	0x2b, 0xb5, 0x61, 0xe4, 0x93, 0x88, 0x9e, 0x3b, 0x0e, 0xdb, 0x88, 0x0d, 0x23, 0x21, 0xa0, 0x65,
+	0x68, 0x84, 0x11, 0x36, 0x1d, 0xdf, 0x22, 0xec, 0xfe, 0x6a, 0xd0, 0xb5, 0x85, 0xb7, 0x7c, 0x8b,
+	0xe8, 0x7f, 0xdf, 0x85, 0x69, 0xaa, 0x02, 0x2d, 0x41, 0xdd, 0xb2, 0x59, 0x7c, 0xc7, 0x87, 0x2e,
+	0x5a, 0xe8, 0x63, 0x00, 0x37, 0x34, 0xcf, 0x70, 0x14, 0x53, 0x5e, 0x85, 0xed, 0xf1, 0xae, 0xda,
+	0xe3, 0x07, 0x9c, 0x6e, 0x34, 0xdd, 0x50, 0xfc, 0x44, 0xdf, 0xa3, 0x9d, 0x05, 0x24, 0xb0, 0x03,
+	0xaf, 0x57, 0xcd, 0x3a, 0x5d, 0x90, 0x0d, 0x05, 0x40, 0x37, 0x61, 0x26, 0x8e, 0x6c, 0xd3, 0xc7,
+	0xd4, 0x30, 0xba, 0xfb, 0xea, 0x71, 0x64, 0x0f, 0x31, 0x41, 0xdf, 0x87, 0x26, 0x65, 0x84, 0x41,
+	0x44, 0xe2, 0x5e, 0x8d, 0x8d, 0x5f, 0xad, 0xf1, 0x20, 0x22, 0x86, 0xe5, 0x1f, 0x63, 0xa3, 0x11,
+	0x47, 0x36, 0x6d, 0xc5, 0xe8, 0x29, 0x2c, 0x33, 0x3d, 0xd6, 0x29, 0x76, 0x98, 0x90, 0xe9, 0x86,
+	0x26, 0x2d, 0x14, 0xb9, 0x4e, 0xdc, 0x9b, 0x65, 0x9a, 0x17, 0xa8, 0x66, 0xca, 0xa7, 0x12, 0x83,
+	0x70, 0x0f, 0x93, 0x81, 0x13, 0x53, 0x03, 0x9c, 0x98, 0x30, 0x03, 0xea, 0xdc, 0x00, 0x27, 0x26,
+	0xc2, 0x00, 0xca, 0xe0, 0x06, 0xcc, 0x8c, 0x33, 0xc0, 0x89, 0x89, 0x32, 0x80, 0xe9, 0x29, 0x35,
+	0xa0, 0xcd, 0x0d, 0xa0, 0x9a, 0x0b, 0x06, 0xdc, 0x86, 0xa6, 0x6b, 0x9f, 0x86, 0x26, 0x3b, 0x42,
+	0x69, 0x8c, 0x53, 0x7b, 0x39, 0x65, 0x34, 0x28, 0x89, 0x9d, 0x8e, 0xcf, 0xa1, 0xa3, 0xd8, 0xa6,
+	0x1d, 0x38, 0xf2, 0x92, 0x93, 0x19, 0xdd, 0x40, 0x00, 0xd7, 0x7d, 0x67, 0x33, 0x70, 0x58, 0x99,
+	0x40, 0xca, 0xd2, 0x36, 0xba, 0x07, 0x1d, 0xea, 0x98, 0x94, 0x31, 0xc0, 0x8c, 0x69, 0xc5, 0x91,
+	0xad, 0x6c, 0xb8, 0x07, 0x1d, 0x6a, 0x7c, 0x0a, 0xd4, 0xe2, 0x20, 0x27, 0x4e, 0x0c, 0xfd, 0x08,
+	0xe6, 0x05, 0x88, 0x0d, 0x4f, 0x22, 0xe7, 0x18, 0x72, 0x8e, 0x21, 0xe9, 0xc0, 0x04, 0xfa, 0x11,
+	0xcc, 0xfa, 0x01, 0x31, 0xd5, 0x4a, 0x38, 0x2a, 0x5f, 0x09, 0x2d, 0x3f, 0x20, 0xb2, 0x81, 0xd6,
+	0x80, 0x36, 0x4d, 0xb9, 0x20, 0x8e, 0x99, 0xe6, 0xa6, 0x1f, 0x90, 0x3d, 0xbe, 0x26, 0x1e, 0x43,
+	0x5b, 0xf2, 0xf9, 0xb4, 0x9c, 0x8c, 0x99, 0x96, 0x16, 0x97, 0xe1, 0x33, 0x23, 0xb4, 0xca, 0x59,
+	0x76, 0x95, 0xd6, 0xad, 0x98, 0xa4, 0xb4, 0x26, 0x93, 0xfd, 0xfb, 0x13, 0xb4, 0x6e, 0xc9, 0xf9,
+	0x7e, 0x97, 0x4b, 0x25, 0x53, 0xf7, 0x15, 0x9b, 0x3a, 0x8d, 0xa1, 0xe4, 0xa4, 0xa0, 0x6d, 0x40,
+	0x19, 0x14, 0x9f, 0x41, 0x6f, 0xe2, 0x0c, 0x6a, 0xc6, 0x5c, 0x4a, 0x05, 0x9b, 0xc4, 0x0f, 0x01,
+	0xc9, 0x81, 0xa7, 0xe6, 0xe8, 0x94, 0x5f, 0x57, 0x7c, 0xac, 0x6a, 0x9a, 0x04, 0x36, 0x37, 0x9f,
+	0xbe, 0xc2, 0x6e, 0xa5, 0xa6, 0xf4, 0x39, 0xdc, 0x56, 0x0e, 0x2f, 0x5d, 0xb8, 0x21, 0x13, 0xbb,
+	0x29, 0xa6, 0xa0, 0xb0, 0x76, 0x85, 0xfc, 0xf8, 0x85, 0xff, 0x46, 0xc9, 0x6f, 0x95, 0xad, 0xfd,
+	0x47, 0xb0, 0x18, 0x44, 0xee, 0xb1, 0xeb, 0x5b, 0x1e, 0x33, 0x22, 0xc6, 0x1e, 0xb6, 0x49, 0x10,
+	0xf5, 0x22, 0x76, 0x04, 0xcd, 0x4b, 0xe6, 0x5e, 0x64, 0xef, 0x09, 0x56, 0x46, 0x86, 0x76, 0xac,
+	0x64, 0xe2, 0xac, 0xcc, 0x56, 0x4c, 0x94, 0xcc, 0x36, 0xdc, 0xc9, 0xf4, 0x93, 0x54, 0x49, 0x94,
+	0x34, 0x61, 0xd2, 0xab, 0xa9, 0x1e, 0x55, 0xad, 0xa4, 0x54, 0x8d, 0x1c, 0x73, 0x4e, 0xcd, 0x28,
+	0xab, 0x46, 0x8c, 0x3a, 0xab, 0xe6, 0x19, 0x2c, 0x2b, 0x35, 0xd2, 0xfd, 0x4a, 0xc1, 0x19, 0x53,
+	0xb0, 0x24, 0x01, 0x43, 0xe6, 0xf9, 0xb1, 0xa2, 0x19, 0x07, 0x9c, 0x17, 0x44, 0xd3, 0x3e, 0xf8,
+	0x01, 0x2c, 0xe4, 0x7c, 0xcd, 0xca, 0x50, 0xbd, 0x3f, 0xe1, 0xc7, 0x3d, 0xca, 0xf8, 0x9a, 0xb1,
+	0xd0, 0x16, 0xac, 0x95, 0x89, 0x24, 0xe3, 0xee, 0xfd, 0x29, 0x17, 0xbe, 0x55, 0x14, 0x56, 0xc3,
+	0xce, 0x74, 0xcc, 0xed, 0xe5, 0x1d, 0x7f, 0x9d, 0xeb, 0x98, 0x19, 0x5b, 0xec, 0x38, 0x25, 0x92,
+	0xea, 0xf8, 0x8f, 0x73, 0x1d, 0x27, 0xc2, 0x49, 0xc7, 0x5f, 0xf0, 0x3b, 0x21, 0x5f, 0xac, 0x3b,
+	0xb5, 0x88, 0x7d, 0xd2, 0xbb, 0xc8, 0xa4, 0x87, 0xd9, 0x5a, 0xdd, 0x6b, 0x8a, 0x30, 0x96, 0xe2,
+	0xc8, 0x2e, 0xa1, 0x53, 0xb5, 0x69, 0x9b, 0xb2, 0x6a, 0x2f, 0xaf, 0x56, 0xeb, 0xc4, 0xa4, 0x84,
+	0x4e, 0xef, 0xd9, 0x13, 0x42, 0x42, 0xa1, 0xe7, 0x17, 0x99, 0xa8, 0xee, 0xe5, 0xfe, 0xfe, 0x2e,
+	0x97, 0x6e, 0x52, 0x8c, 0x14, 0x68, 0xc8, 0x84, 0xbc, 0xf7, 0x07, 0x99, 0xba, 0x31, 0xbd, 0xcf,
+	0x55, 0x3d, 0x56, 0x81, 0x68, 0x04, 0x4f, 0xc3, 0x09, 0xd3, 0x75, 0x7a, 0xbf, 0x12, 0x77, 0x3c,
+	0x6d, 0x0f, 0x9c, 0x8d, 0x3a, 0x4c, 0xd3, 0x23, 0x6a, 0x03, 0xa0, 0x21, 0x8f, 0xab, 0x9f, 0xd6,
+	0x1b, 0xbf, 0xd4, 0xba, 0xbf, 0xd2, 0x0c, 0xf0, 0x82, 0x63, 0x33, 0x8c, 0xf0, 0x91, 0x7b, 0xa1,
+	0x7f, 0x06, 0xf3, 0x65, 0xa6, 0xaf, 0x40, 0x43, 0x2d, 0x42, 0xae, 0x58, 0xb5, 0x69, 0xea, 0xc1,
+	0x66, 0x4d, 0xc4, 0xe0, 0xbc, 0x41, 0x93, 0x8d, 0xa6, 0x1a, 0x14, 0x4f, 0x2d, 0xc8, 0x49, 0xe0,
+	0xf0, 0xe0, 0xa8, 0x69, 0xc8, 0x26, 0x7a, 0x08, 0xb5, 0xd0, 0x22, 0x27, 0x32, 0x02, 0x5a, 0xc9,
+	0xfb, 0xe3, 0xc1, 0xae, 0x45, 0x4e, 0xd8, 0x2f, 0x83, 0x03, 0x57, 0x3e, 0x87, 0xa6, 0xa2, 0xa1,
+	0x25, 0xa8, 0xe1, 0x0b, 0xcb, 0x26, 0xdc, 0xaa, 0x97, 0x53, 0x06, 0x6f, 0xa2, 0x1e, 0xd4, 0xf9,
+	0x88, 0x78, 0x48, 0x47, 0x3f, 0x71, 0xf1, 0xf6, 0xc6, 0x2c, 0x00, 0xd5, 0xc3, 0x67, 0x41, 0xff,
+	0x6b, 0x0d, 0x66, 0xd3, 0xce, 0x44, 0x2f, 0xa0, 0x65, 0xf9, 0x7e, 0x40, 0x58, 0x6d, 0x4b, 0x86,
+	0x72, 0xef, 0x96, 0xb8, 0xfd, 0xc1, 0x7a, 0x02, 0xe3, 0xc9, 0x5a, 0x5a, 0x70, 0xe5, 0x39, 0x74,
+	0xf3, 0x80, 0xb7, 0x4a, 0xdb, 0x9e, 0xc1, 0x5c, 0xee, 0xda, 0x60, 0x81, 0x2b, 0xbd, 0x87, 0xa8,
+	0x7c, 0x8d, 0xe7, 0x59, 0x94, 0xc6, 0x2e, 0x9c, 0x0a, 0xa7, 0xd1, 0xdf, 0xfa, 0x2b, 0x68, 0xa8,
+	0x0b, 0xb7, 0x07, 0x75, 0x51, 0x5c, 0xd1, 0x44, 0xe0, 0x21, 0xda, 0x68, 0x21, 0x1d, 0xf2, 0xbe,
+	0x9c, 0xe2, 0x41, 0xef, 0x46, 0x17, 0x3a, 0x9c, 0x6f, 0x06, 0x11, 0xdb, 0x8c, 0xfa, 0x13, 0x68,
+	0xaa, 0x0b, 0x92, 0xda, 0x7b, 0xe4, 0x46, 0x31, 0x11, 0x36, 0xf0, 0x06, 0x35, 0xc2, 0xb3, 0x62,
+	0x22, 0x8d, 0xa0, 0xbf, 0xf5, 0xbf, 0xd0, 0x00, 0xe5, 0x8b, 0x00, 0x83, 0x2d, 0x9a, 0x93, 0x05,
+	0x91, 0x7d, 0x82, 0x63, 0x12, 0x59, 0x24, 0x88, 0xe8, 0x4a, 0xe5, 0x43, 0xef, 0xa4, 0xc9, 0x03,
+	0x07, 0xdd, 0x81, 0x96, 0xaa, 0x38, 0xb8, 0x3c, 0xe0, 0x6d, 0x1a, 0x20, 0x49, 0x1c, 0xa0, 0x2a,
+	0x11, 0xae, 0xc3, 0x82, 0xde, 0xa6, 0x01, 0x92, 0x34, 0x70, 0x7e, 0x3a, 0xdd, 0xd0, 0xba, 0x15,
+	0xa3, 0x41, 0x2b, 0x28, 0x6c, 0x20, 0x17, 0xb0, 0x54, 0xfe, 0x65, 0x08, 0x7d, 0x90, 0x4a, 0x1f,
+	0x96, 0xc7, 0x14, 0x30, 0x44, 0x9a, 0xf2, 0x09, 0x34, 0x64, 0x17, 0xbd, 0x5a, 0xe6, 0xeb, 0x66,
+	0x5e, 0xc0, 0x50, 0x40, 0xfd, 0x3f, 0x2a, 0xd0, 0xcd, 0xb3, 0xa9, 0x2b, 0x63, 0x62, 0x11, 0x99,
+	0xad, 0xf1, 0x46, 0x59, 0x22, 0x42, 0x97, 0xcd, 0xa9, 0x65, 0x0b, 0x17, 0xd0, 0x9f, 0x74, 0xec,
+	0xf2, 0x93, 0x24, 0xbd, 0x83, 0x79, 0x5c, 0x0d, 0x82, 0x44, 0xaf, 0xdd, 0x5b, 0xd0, 0x74, 0xc3,
+	0xb3, 0xc7, 0x34, 0x1c, 0xe2, 0xb1, 0x75, 0xd3, 0x68, 0x50, 0xc2, 0x10, 0x13, 0xc9, 0xec, 0x73,
+	0x66, 0x5d, 0x31, 0xfb, 0x8c, 0xf9, 0x1e, 0xd4, 0x88, 0x8b, 0x23, 0x19, 0x10, 0xcb, 0x70, 0x6e,
+	0xdf, 0xc5, 0xd1, 0xc0, 0x3f, 0x0a, 0x0c, 0xce, 0x45, 0x1f, 0x40, 0x83, 0x77, 0x60, 0x91, 0x5e,
+	0xe3, 0x6e, 0x35, 0x95, 0xdb, 0x0e, 0x2d, 0xc2, 0x80, 0x33, 0xac, 0x3f, 0x8b, 0x08, 0x68, 0x9f,
+	0x41, 0x9b, 0x63, 0xa1, 0x7d, 0x0a, 0x7d, 0x02, 0xb3, 0x6f, 0x82, 0x98, 0x96, 0xb6, 0x49, 0x14,
+	0x78, 0x71, 0x0f, 0x32, 0x1f, 0x18, 0x7e, 0x3b, 0xd8, 0xdb, 0x14, 0x1c, 0xa3, 0xf5, 0x26, 0x88,
+	0x65, 0x43, 0xdf, 0x2c, 0xce, 0xac, 0x48, 0x0c, 0xaf, 0x3f, 0xb3, 0xfa, 0x3a, 0x74, 0xd2, 0x85,
+	0xb8, 0xc1, 0x56, 0x7e, 0x85, 0x55, 0xae, 0x5c, 0x61, 0x1e, 0xa0, 0xe2, 0xd7, 0x51, 0xf4, 0x5e,
+	0xca, 0x86, 0xc5, 0x92, 0x92, 0x9f, 0x58, 0x59, 0x1f, 0xa7, 0x56, 0x56, 0x35, 0x73, 0xd8, 0xa7,
+	0xc1, 0xa9, 0x55, 0xf5, 0x9b, 0x0a, 0xcc, 0xa6, 0x59, 0x65, 0xe9, 0x7f, 0x7e, 0xa5, 0x54, 0x0a,
+	0x2b, 0x45, 0xcd, 0x77, 0x75, 0xe2, 0x7c, 0xff, 0x10, 0xe6, 0x54, 0xb2, 0x69, 0x72, 0x81, 0x7a,
+	0xb9, 0x40, 0x47, 0xe1, 0xf6, 0x99, 0xe4, 0x13, 0xe8, 0xc8, 0xcc, 0xd4, 0x9c, 0xb8, 0xb2, 0x66,
+	0x45, 0xc2, 0xca, 0xc5, 0x1e, 0x43, 0xfb, 0x28, 0x88, 0xce, 0x69, 0x05, 0x90, 0x4b, 0x35, 0xc6,
+	0x48, 0x09, 0x14, 0x97, 0x7a, 0x00, 0xf3, 0xf8, 0x22, 0xc4, 0x36, 0xc1, 0x8e, 0xc9, 0xd6, 0xa7,
+	0xe5, 0x38, 0x91, 0xdc, 0x20, 0x37, 0x24, 0x6b, 0x10, 0x9e, 0x3d, 0x5e, 0x77, 0x9c, 0x22, 0xbe,
+	0x2f, 0xf0, 0xb5, 0x02, 0xbe, 0xcf, 0xf0, 0xfa, 0xff, 0xcf, 0xce, 0xb0, 0x58, 0x65, 0xd7, 0x9b,
+	0x61, 0xfd, 0x6f, 0x34, 0x68, 0x48, 0xbb, 0x4b, 0x27, 0xeb, 0x03, 0xe8, 0xba, 0xfe, 0x71, 0x44,
+	0x2b, 0xdd, 0xac, 0xdc, 0xe1, 0xaa, 0x4b, 0x75, 0x4e, 0xd0, 0x77, 0x05, 0x99, 0x9e, 0xa3, 0x38,
+	0x87, 0x14, 0xb5, 0x2d, 0x9c, 0x05, 0xbe, 0x07, 0x1d, 0x07, 0x1f, 0x59, 0x23, 0x8f, 0x98, 0x22,
+	0xf9, 0xe7, 0x27, 0x65, 0x5b, 0x50, 0xd7, 0x19, 0x51, 0x7f, 0x0a, 0x33, 0x62, 0x37, 0xa2, 0x45,
+	0xa8, 0xe3, 0x0b, 0x1a, 0xe3, 0xcb, 0x93, 0x09, 0x5f, 0x90, 0x41, 0x48, 0xc9, 0x6c, 0x23, 0x84,
+	0xf2, 0xae, 0xa2, 0x03, 0x0b, 0x75, 0x03, 0xe6, 0x4b, 0x2a, 0xef, 0xb4, 0x40, 0xe7, 0xc6, 0x81,
+	0x49, 0xdc, 0x53, 0x1c, 0x13, 0xeb, 0x54, 0xea, 0x9a, 0x75, 0xe3, 0x60, 0x5f, 0xd2, 0x68, 0x41,
+	0x62, 0x14, 0x52, 0x08, 0x53, 0xa9, 0x19, 0xa2, 0xa5, 0x87, 0xd0, 0x1b, 0x57, 0x75, 0xbf, 0xee,
+	0x6e, 0xfa, 0x3e, 0xd4, 0x79, 0x6d, 0xb7, 0x57, 0xc9, 0x40, 0xb3, 0x3a, 0x0d, 0x01, 0xd2, 0xef,
+	0x43, 0x27, 0xcb, 0xa1, 0xb6, 0x09, 0x05, 0x22, 0x90, 0x12, 0xc8, 0xf5, 0x32, 0xdb, 0xde, 0x6e,
+	0x1d, 0x5c, 0xc0, 0xea, 0xa4, 0x62, 0xfc, 0xdb, 0x5c, 0x47, 0x6f, 0x39, 0xcc, 0xc1, 0xb8, 0x9e,
+	0xdf, 0xfe, 0xb8, 0xfc, 0x37, 0x0d, 0x16, 0x4b, 0xcb, 0xef, 0xe8, 0x36, 0x40, 0x38, 0x3a, 0xf4,
+	0x5c, 0xdb, 0x4c, 0x82, 0x9d, 0x26, 0xa7, 0x7c, 0x8e, 0x2f, 0xdf, 0xbe, 0xda, 0xf4, 0x11, 0xa0,
+	0x10, 0xfb, 0x8e, 0xeb, 0x1f, 0x9b, 0x29, 0xbd, 0xfc, 0x36, 0xec, 0x0a, 0xce, 0xae, 0x52, 0xff,
+	0x63, 0xb8, 0x65, 0x85, 0xa1, 0xe7, 0xd2, 0x54, 0xb5, 0x20, 0x25, 0x4f, 0x82, 0x9e, 0x80, 0xec,
+	0xe6, 0xa4, 0x63, 0xfd, 0x35, 0xdf, 0xe0, 0xb9, 0xa7, 0x13, 0x2b, 0xa0, 0x0e, 0x79, 0x19, 0xfe,
+	0xca, 0xb6, 0xba, 0x6a, 0xe9, 0xc9, 0x21, 0xb6, 0x06, 0xbb, 0x1a, 0xe9, 0x81, 0x91, 0x57, 0x27,
+	0xdc, 0xfc, 0x9d, 0xd5, 0xed, 0xc0, 0x42, 0xd9, 0x67, 0xbd, 0x6b, 0x28, 0xec, 0xe7, 0x15, 0xf6,
+	0xcb, 0x15, 0x5e, 0xdb, 0xc2, 0x31, 0x0a, 0xb7, 0xa1, 0x93, 0x7d, 0xf6, 0x51, 0x52, 0xba, 0x9f,
+	0xa6, 0xef, 0x3d, 0xc4, 0x82, 0x9d, 0xcb, 0x3f, 0xf4, 0x60, 0x4c, 0xfd, 0x6e, 0xa2, 0x66, 0x4c,
+	0x51, 0xfe, 0x39, 0x34, 0x24, 0x82, 0x05, 0xc1, 0xae, 0xa3, 0x2a, 0xba, 0xf4, 0x37, 0x5a, 0x03,
+	0x38, 0xb5, 0xe2, 0x37, 0x23, 0x1c, 0x59, 0x22, 0x3c, 0x6e, 0x18, 0x29, 0x8a, 0xfe, 0x47, 0x1a,
+	0xb4, 0x33, 0x1f, 0xb6, 0xd1, 0x3b, 0xf4, 0xe5, 0x99, 0x1b, 0x9a, 0xd8, 0xb7, 0x0e, 0x3d, 0xcc,
+	0xfb, 0x6a, 0xd0, 0x37, 0x66, 0x6e, 0xb8, 0xcd, 0x49, 0xf4, 0x54, 0x3b, 0xbb, 0xf0, 0x2c, 0x5f,
+	0x61, 0xb8, 0xde, 0x59, 0x46, 0x94, 0xa0, 0xfb, 0xd0, 0xcd, 0x80, 0xcc, 0xb3, 0xbe, 0xa8, 0xd7,
+	0x76, 0xd2, 0xb8, 0x83, 0xbe, 0xfe, 0xcf, 0x1a, 0x2c, 0x94, 0x3d, 0x4c, 0x41, 0xef, 0xa7, 0xf6,
+	0xe1, 0xcd, 0xd2, 0x4c, 0x53, 0xec, 0xff, 0x9f, 0x40, 0xdd, 0xb3, 0x0e, 0xb1, 0x27, 0xd3, 0xa7,
+	0xf7, 0x27, 0x3c, 0x77, 0x79, 0xf0, 0x8a, 0x21, 0xc5, 0x87, 0x25, 0x2e, 0x46, 0x3f, 0x2c, 0xa5,
+	0xc8, 0x6f, 0x95, 0xa1, 0xfc, 0x24, 0x6f, 0xbc, 0x98, 0xa9, 0xeb, 0x1a, 0xaf, 0x6f, 0x41, 0x37,
+	0x4f, 0xcf, 0x56, 0xda, 0xb5, 0x7c, 0xa5, 0xbd, 0xac, 0x4c, 0xff, 0x0f, 0x1a, 0xcc, 0xe5, 0x5e,
+	0xce, 0x20, 0x3d, 0x65, 0x02, 0xca, 0x3f, 0x8c, 0x11, 0xae, 0xfb, 0x34, 0xe7, 0x3a, 0xbd, 0xfc,
+	0x15, 0xce, 0xff, 0xb6, 0xd7, 0x9e, 0xa4, 0xac, 0x15, 0x0e, 0xbb, 0x86, 0xb5, 0xfa, 0x3b, 0xd0,
+	0x4a, 0x91, 0x4a, 0xbf, 0xf4, 0xec, 0x03, 0xf0, 0x07, 0x30, 0xfb, 0x22, 0x31, 0xa4, 0x2b, 0x57,
+	0xac, 0x62, 0xf6, 0x9b, 0x59, 0x45, 0x57, 0xa0, 0x58, 0xb6, 0xbc, 0x41, 0x5d, 0xae, 0x3e, 0x97,
+	0xca, 0x0f, 0x0b, 0x8a, 0x40, 0x73, 0x97, 0x56, 0xea, 0x49, 0x10, 0x7a, 0x37, 0x95, 0x84, 0x26,
+	0x07, 0x37, 0x43, 0x24, 0x9f, 0xff, 0xd0, 0x27, 0x74, 0x2f, 0xf1, 0x77, 0x5d, 0x0c, 0xcd, 0x8f,
+	0xf9, 0x1b, 0x6a, 0xb3, 0xd3, 0x6d, 0xcb, 0xe0, 0xe0, 0x86, 0xf2, 0x37, 0x75, 0xa3, 0x13, 0x13,
+	0x99, 0xe7, 0x38, 0x31, 0x41, 0x3a, 0xb4, 0x59, 0x15, 0x2e, 0x70, 0x78, 0x65, 0x48, 0xc4, 0x2e,
+	0xb4, 0x68, 0x3d, 0x0c, 0x1c, 0x56, 0x08, 0xa2, 0xc5, 0x5f, 0x85, 0x71, 0x43, 0xf9, 0x75, 0x46,
+	0x20, 0x06, 0x21, 0x8d, 0x80, 0x63, 0xeb, 0x14, 0x9b, 0xf1, 0xe8, 0x90, 0x16, 0x87, 0x67, 0xf8,
+	0x49, 0x40, 0x49, 0x7b, 0x8c, 0x42, 0xf7, 0x3d, 0x8d, 0x4d, 0x83, 0x11, 0x39, 0x0e, 0x5c, 0xff,
+	0x98, 0x55, 0xe8, 0x1b, 0x46, 0xcb, 0xb7, 0xc8, 0x8e, 0x20, 0xd1, 0x20, 0xca, 0x0b, 0x6c, 0xcb,
+	0x33, 0x65, 0xfe, 0xc9, 0x4a, 0xf4, 0x0d, 0xa3, 0xcd, 0xa8, 0xf2, 0x86, 0x44, 0x8f, 0xa0, 0x45,
+	0xd8, 0x0c, 0xf0, 0x41, 0xf3, 0xec, 0x45, 0x0e, 0x3a, 0x99, 0x1b, 0x03, 0x88, 0xfa, 0xad, 0xdf,
+	0x11, 0xee, 0x15, 0x6b, 0x41, 0xf8, 0xa0, 0xa2, 0x7c, 0xa0, 0xff, 0x97, 0x06, 0xcb, 0x63, 0x9f,
+	0x48, 0xb1, 0x85, 0x10, 0x38, 0x7c, 0x3a, 0xe8, 0x42, 0x08, 0x1c, 0x95, 0x2f, 0x56, 0x92, 0x7c,
+	0x31, 0x73, 0xa9, 0x54, 0xb3, 0x97, 0x0a, 0x3d, 0xaf, 0x42, 0x2b, 0xc2, 0x3e, 0x31, 0x1d, 0xcc,
+	0xea, 0x5d, 0x6e, 0x28, 0xfc, 0xdc, 0xe1, 0xf4, 0x2d, 0x46, 0x1e, 0x84, 0xd9, 0x93, 0xbf, 0x96,
+	0x3d, 0xf9, 0xd9, 0x35, 0x9d, 0x53, 0x73, 0xd6, 0xef, 0xd5, 0xc5, 0x35, 0x9d, 0x51, 0x74, 0xd6,
+	0xa7, 0xd1, 0xe4, 0xa9, 0x65, 0xd3, 0xa3, 0x71, 0x86, 0xef, 0x90, 0x53, 0xcb, 0x3e, 0xe8, 0xeb,
+	0x1f, 0x97, 0x8e, 0x55, 0xf8, 0xa6, 0x64, 0xac, 0xfa, 0x6f, 0x34, 0xb8, 0x39, 0xe6, 0xa1, 0xd6,
+	0xc4, 0x4b, 0x2c, 0x1b, 0xa4, 0x54, 0xf2, 0x41, 0xca, 0x03, 0x98, 0x77, 0x7d, 0x82, 0xa3, 0x23,
+	0x8b, 0x0f, 0x24, 0xe3, 0xba, 0x1b, 0x8a, 0x25, 0x13, 0x89, 0x31, 0x31, 0xca, 0xf4, 0x77, 0x8b,
+	0x51, 0x6a, 0x57, 0xc4, 0x28, 0x4f, 0x4a, 0x86, 0x7c, 0xf5, 0xbd, 0xad, 0x7f, 0x5d, 0x81, 0xe5,
+	0xb1, 0x2f, 0x9b, 0x26, 0x3a, 0x4b, 0x87, 0x76, 0x62, 0x1f, 0x9d, 0x33, 0xee, 0xaf, 0x96, 0xf2,
+	0xd7, 0x41, 0xbf, 0xe0, 0xb1, 0xfe, 0x58, 0x8f, 0xf1, 0xe5, 0xf2, 0x03, 0x58, 0x2c, 0x8e, 0x9d,
+	0xea, 0xe6, 0x4e, 0x43, 0x79, 0xa7, 0x1d, 0xf4, 0xd1, 0x06, 0xac, 0x4d, 0x70, 0x1b, 0x95, 0xe5,
+	0x9e, 0x5b, 0x19, 0xe7, 0xb9, 0x83, 0xbe, 0xfe, 0xb4, 0xd4, 0x07, 0xd7, 0xf0, 0xde, 0xbf, 0x68,
+	0xb0, 0x58, 0xfa, 0xac, 0x8e, 0x7e, 0xae, 0x90, 0x95, 0x62, 0xdb, 0x1b, 0xc5, 0x04, 0x47, 0x26,
+	0x8d, 0x40, 0x64, 0xa5, 0x73, 0x5e, 0x30, 0x37, 0x39, 0x6f, 0x93, 0xb2, 0xd0, 0xe3, 0xe4, 0x85,
+	0x29, 0xbe, 0x20, 0x38, 0xa2, 0x65, 0x70, 0x2e, 0x54, 0x11, 0x5f, 0x32, 0x39, 0x77, 0x5b, 0x30,
+	0xb9, 0xd4, 0x8f, 0x60, 0x45, 0x4a, 0xd1, 0xf3, 0xe6, 0xd0, 0xf2, 0x2c, 0xdf, 0x56, 0xdd, 0xf1,
+	0xfc, 0xaf, 0x27, 0x10, 0xaf, 0x52, 0x00, 0x26, 0xad, 0xff, 0x79, 0x05, 0x5a, 0xa9, 0x12, 0x0a,
+	0xfa, 0x1e, 0xdc, 0x90, 0xd9, 0xe6, 0xa1, 0xe5, 0x3b, 0xe7, 0xae, 0x43, 0x4e, 0xd8, 0xb0, 0xab,
+	0x86, 0x4c, 0x43, 0x37, 0x24, 0x9d, 0xa6, 0xa6, 0x38, 0x8f, 0xad, 0x30, 0xec, 0x1c, 0xce, 0x41,
+	0x69, 0xea, 0x27, 0xf5, 0x8e, 0x22, 0x71, 0xa0, 0x57, 0x8d, 0x59, 0x41, 0xdc, 0xa0, 0x34, 0x7a,
+	0xe8, 0xe2, 0x34, 0x66, 0x9a, 0x61, 0x5a, 0x38, 0x05, 0x61, 0x2b, 0x8a, 0x63, 0xc4, 0xab, 0x9d,
+	0x48, 0x3e, 0xe7, 0xaf, 0x1a, 0xd2, 0x74, 0xfe, 0x48, 0xc7, 0xa0, 0xf3, 0xf0, 0x11, 0x20, 0x5c,
+	0x84, 0xd7, 0xf9, 0x80, 0x70, 0x0e, 0xad, 0x6f, 0xc2, 0x5c, 0xe6, 0x81, 0xcf, 0x77, 0x8a, 0x3d,
+	0xfe, 0x4a, 0x83, 0xf9, 0x92, 0x77, 0x8d, 0xe8, 0xff, 0xa5, 0x6e, 0xf4, 0xa5, 0xb2, 0xe7, 0x44,
+	0x22, 0x06, 0x59, 0x82, 0xfa, 0x91, 0xeb, 0x11, 0x2c, 0xe3, 0x68, 0xd1, 0x42, 0x9f, 0x42, 0x53,
+	0x96, 0x79, 0x64, 0x61, 0x66, 0xb5, 0x4c, 0x8d, 0xda, 0xfd, 0x09, 0x5c, 0xff, 0x43, 0x58, 0x2c,
+	0xc5, 0xbc, 0x4d, 0x5a, 0x59, 0x32, 0x56, 0x7a, 0x07, 0x26, 0x1b, 0x9c, 0x71, 0xf9, 0xde, 0x6e,
+	0x2b, 0x2a, 0xbd, 0x8e, 0xf5, 0x1f, 0xe7, 0x3c, 0x22, 0xb6, 0xd6, 0x35, 0x3d, 0xa2, 0xff, 0xad,
+	0x06, 0xcb, 0x63, 0x1f, 0x5e, 0x5d, 0xdb, 0xaf, 0xab, 0xd0, 0x74, 0xdc, 0x88, 0x7d, 0x9e, 0x50,
+	0x87, 0xbb, 0x22, 0xa0, 0xdf, 0x2a, 0x7a, 0x57, 0x9f, 0xe4, 0x5d, 0x91, 0x22, 0xa7, 0x7c, 0xfc,
+	0x8f, 0x1a, 0xdc, 0x9a, 0x00, 0x2d, 0x2d, 0xee, 0x14, 0xfd, 0x57, 0x29, 0xf1, 0x5f, 0x52, 0x16,
+	0xae, 0xa6, 0xcb, 0xc2, 0x0b, 0x50, 0xc3, 0x51, 0x14, 0x44, 0xe2, 0x74, 0xe4, 0x0d, 0xf4, 0x80,
+	0x56, 0xe3, 0xe9, 0xeb, 0x11, 0xfe, 0x7a, 0xa2, 0x57, 0x36, 0x88, 0x17, 0xae, 0x87, 0x0d, 0x0e,
+	0xd3, 0x5f, 0xc0, 0x8d, 0x02, 0xaf, 0xd4, 0xd6, 0xdb, 0x00, 0xb1, 0xfb, 0x0b, 0x6c, 0x1e, 0x5e,
+	0x12, 0x1c, 0x8b, 0x7d, 0xde, 0xa4, 0x94, 0x0d, 0x4a, 0xf8, 0xf0, 0x3e, 0x7d, 0xdc, 0x25, 0xd3,
+	0xf3, 0x19, 0xa8, 0xae, 0x0f, 0x7f, 0xd6, 0x9d, 0x42, 0x0d, 0x98, 0x1e, 0xec, 0x1e, 0x3c, 0xee,
+	0x4e, 0x8b, 0x5f, 0xfd, 0x6e, 0xfd, 0xc3, 0x3f, 0xd3, 0xa0, 0xa9, 0x62, 0x43, 0xd4, 0x86, 0xe6,
+	0xe6, 0x60, 0xcb, 0x30, 0x07, 0xc3, 0x17, 0x3b, 0xdd, 0x29, 0x34, 0x0f, 0x73, 0xc6, 0xf6, 0xeb,
+	0x9d, 0xfd, 0x6d, 0xf3, 0xcb, 0x1d, 0xe3, 0xf3, 0x57, 0x3b, 0xeb, 0x5b, 0x5d, 0x8d, 0xbe, 0x11,
+	0x13, 0xc4, 0x97, 0x3b, 0x7b, 0xf4, 0x69, 0x18, 0x82, 0xce, 0xab, 0x9d, 0xcd, 0xf5, 0x57, 0x09,
+	0xa8, 0x8a, 0x3a, 0x00, 0x9c, 0xc6, 0x30, 0xd3, 0xe8, 0x06, 0xb4, 0x85, 0xd0, 0xfe, 0x17, 0xc3,
+	0xe1, 0xf6, 0xab, 0x6e, 0x0d, 0x75, 0x61, 0x96, 0x43, 0x04, 0xa5, 0xfe, 0xe1, 0x33, 0x80, 0x24,
+	0xf0, 0xa4, 0x36, 0x0e, 0x77, 0x86, 0xdb, 0xdd, 0x29, 0x34, 0x0b, 0x8d, 0xe1, 0x8e, 0xb9, 0x3d,
+	0xdc, 0x5c, 0xdf, 0xed, 0x6a, 0xa8, 0x09, 0x35, 0x16, 0x81, 0x74, 0x2b, 0x7c, 0x18, 0x83, 0xdd,
+	0x6e, 0xf5, 0xd1, 0x73, 0x00, 0xfe, 0x12, 0x88, 0xfd, 0x93, 0xd4, 0x43, 0x98, 0x66, 0x7f, 0x65,
+	0xac, 0x9e, 0xfa, 0x1f, 0xad, 0x15, 0x49, 0x4b, 0xfd, 0x9f, 0xd6, 0x43, 0x6d, 0xe3, 0xce, 0x2f,
+	0xbf, 0x59, 0xd3, 0x7e, 0xfd, 0xcd, 0x9a, 0xf6, 0x9f, 0xdf, 0xac, 0x69, 0x7f, 0xf9, 0xed, 0xda,
+	0xd4, 0xaf, 0xbf, 0x5d, 0x9b, 0xfa, 0xf7, 0x6f, 0xd7, 0xa6, 0x7e, 0xb7, 0xc6, 0x9e, 0x4f, 0x1c,
+	0xd6, 0xd9, 0x9f, 0x4f, 0xfe, 0x67, 0x00, 0x5c, 0x8d, 0x3b, 0x68, 0x0d, 0x36, 0x00, 0x00,
 }
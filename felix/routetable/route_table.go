@@ -55,6 +55,10 @@ var (
 
 	ipV6LinkLocalCIDR = ip.MustParseCIDROrIP("fe80::/64")
 
+	// The kernel omits the destination of default routes when listing them.
+	defaultCIDRV4 = ip.MustParseCIDROrIP("0.0.0.0/0")
+	defaultCIDRV6 = ip.MustParseCIDROrIP("::/0")
+
 	listIfaceTime = cprometheus.NewSummary(prometheus.SummaryOpts{
 		Name: "felix_route_table_list_seconds",
 		Help: "Time taken to list all the interfaces during a resync.",
@@ -106,6 +110,18 @@ type Target struct {
 	CIDR    ip.CIDR
 	GW      ip.Addr
 	DestMAC net.HardwareAddr
+
+	// MultiPath, if non-empty, makes the route an ECMP route over the given next hops.  Multipath
+	// targets should be used with InterfaceNone.
+	MultiPath []NextHop
+}
+
+// NextHop is one of the next hops of a multipath route.
+type NextHop struct {
+	GW        ip.Addr
+	IfaceName string
+	// Weight is the relative weight of the next hop; zero is treated as one.
+	Weight int
 }
 
 func (t Target) Equal(t2 Target) bool {
@@ -807,9 +823,64 @@ func (r *RouteTable) createL3Route(linkAttrs *netlink.LinkAttrs, target Target)
 		route.SetFlag(syscall.RTNH_F_ONLINK)
 	}
 
+	if len(target.MultiPath) > 0 {
+		route.Scope = netlink.SCOPE_UNIVERSE
+		route.MultiPath = r.createNextHops(target.MultiPath)
+	}
+
 	return route
 }
 
+// createNextHops converts the next hops of a multipath target to their netlink form.  Next hops
+// whose interface cannot be found are skipped.
+func (r *RouteTable) createNextHops(nextHops []NextHop) []*netlink.NexthopInfo {
+	nl, err := r.getNetlink()
+	if err != nil {
+		r.logCxt.WithError(err).Error("Failed to connect to netlink, can't resolve next hops")
+		return nil
+	}
+	var nhs []*netlink.NexthopInfo
+	for _, nh := range nextHops {
+		link, err := nl.LinkByName(nh.IfaceName)
+		if err != nil {
+			r.logCxt.WithError(err).WithField("nextHop", nh).Warn("Failed to get interface for next hop")
+			continue
+		}
+		hops := 0
+		if nh.Weight > 1 {
+			hops = nh.Weight - 1
+		}
+		nhs = append(nhs, &netlink.NexthopInfo{
+			LinkIndex: link.Attrs().Index,
+			Gw:        nh.GW.AsNetIP(),
+			Flags:     syscall.RTNH_F_ONLINK,
+			Hops:      hops,
+		})
+	}
+	return nhs
+}
+
+// nextHopsEqual returns true if the programmed next hops match the expected ones, irrespective of
+// their order.
+func nextHopsEqual(programmed, expected []*netlink.NexthopInfo) bool {
+	if len(programmed) != len(expected) {
+		return false
+	}
+	for _, e := range expected {
+		found := false
+		for _, p := range programmed {
+			if p.LinkIndex == e.LinkIndex && p.Gw.Equal(e.Gw) && p.Hops == e.Hops {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 // fullResyncRoutesForLink performs a full resync of the routes by first listing current routes and correlating against
 // the expected set. After correlation, it will create a set of routes to delete and update the delta routes to add
 // back any missing routes.
@@ -877,6 +948,10 @@ func (r *RouteTable) fullResyncRoutesForLink(logCxt *log.Entry, ifaceName string
 		var dest ip.CIDR
 		if route.Dst != nil {
 			dest = ip.CIDRFromIPNet(route.Dst)
+		} else if r.ipVersion == 6 {
+			dest = defaultCIDRV6
+		} else {
+			dest = defaultCIDRV4
 		}
 		logCxt := logCxt.WithField("dest", dest)
 		// Check if we should remove routes not added by us
@@ -906,6 +981,10 @@ func (r *RouteTable) fullResyncRoutesForLink(logCxt *log.Entry, ifaceName string
 				(route.Gw != nil && expectedTarget.GW != nil && !route.Gw.Equal(expectedTarget.GW.AsNetIP())) {
 				routeProblems = append(routeProblems, "incorrect gateway")
 			}
+			if (len(route.MultiPath) > 0 || len(expectedTarget.MultiPath) > 0) &&
+				!nextHopsEqual(route.MultiPath, r.createNextHops(expectedTarget.MultiPath)) {
+				routeProblems = append(routeProblems, "incorrect next hops")
+			}
 		}
 		if len(routeProblems) == 0 {
 			logCxt.Debug("Route is correct")
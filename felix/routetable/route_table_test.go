@@ -1275,6 +1275,70 @@ var _ = Describe("RouteTable (table 100)", func() {
 				Expect(dataplane.DeletedRouteKeys.Contains("100-0-10.10.10.10/32")).To(BeTrue())
 			})
 		})
+
+		Describe("after configuring a multipath default route", func() {
+			var ecmpRoute netlink.Route
+			JustBeforeEach(func() {
+				rt.RouteUpdate(InterfaceNone, Target{
+					CIDR: ip.MustParseCIDROrIP("0.0.0.0/0"),
+					MultiPath: []NextHop{
+						{GW: ip.FromString("10.0.0.1"), IfaceName: "cali"},
+						{GW: ip.FromString("12.0.0.1"), IfaceName: "eth0", Weight: 2},
+					},
+				})
+				err := rt.Apply()
+				Expect(err).ToNot(HaveOccurred())
+				ecmpRoute = netlink.Route{
+					Dst:      mustParseCIDR("0.0.0.0/0"),
+					Type:     syscall.RTN_UNICAST,
+					Protocol: FelixRouteProtocol,
+					Scope:    netlink.SCOPE_UNIVERSE,
+					Table:    100,
+					MultiPath: []*netlink.NexthopInfo{
+						{LinkIndex: cali.LinkAttrs.Index, Gw: net.ParseIP("10.0.0.1").To4(), Flags: syscall.RTNH_F_ONLINK},
+						{LinkIndex: eth0.LinkAttrs.Index, Gw: net.ParseIP("12.0.0.1").To4(), Flags: syscall.RTNH_F_ONLINK, Hops: 1},
+					},
+				}
+			})
+
+			It("should program the route with its next hops", func() {
+				Expect(dataplane.RouteKeyToRoute).To(ConsistOf(caliRoute, gatewayRoute, ecmpRoute))
+			})
+
+			It("should leave the route alone on resync", func() {
+				dataplane.ResetDeltas()
+				rt.QueueResync()
+				err := rt.Apply()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dataplane.RouteKeyToRoute).To(ConsistOf(caliRoute, gatewayRoute, ecmpRoute))
+				Expect(dataplane.AddedRouteKeys).To(BeEmpty())
+				Expect(dataplane.DeletedRouteKeys).To(BeEmpty())
+			})
+
+			It("should replace the route when a next hop is removed", func() {
+				rt.RouteUpdate(InterfaceNone, Target{
+					CIDR: ip.MustParseCIDROrIP("0.0.0.0/0"),
+					MultiPath: []NextHop{
+						{GW: ip.FromString("10.0.0.1"), IfaceName: "cali"},
+					},
+				})
+				err := rt.Apply()
+				Expect(err).ToNot(HaveOccurred())
+				ecmpRoute.MultiPath = ecmpRoute.MultiPath[:1]
+				Expect(dataplane.RouteKeyToRoute).To(ConsistOf(caliRoute, gatewayRoute, ecmpRoute))
+			})
+
+			It("should fix a route whose next hops were changed externally", func() {
+				key := mocknetlink.KeyForRoute(&ecmpRoute)
+				modified := dataplane.RouteKeyToRoute[key]
+				modified.MultiPath = modified.MultiPath[1:]
+				dataplane.RouteKeyToRoute[key] = modified
+				rt.QueueResync()
+				err := rt.Apply()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(dataplane.RouteKeyToRoute).To(ConsistOf(caliRoute, gatewayRoute, ecmpRoute))
+			})
+		})
 	})
 })
 
@@ -17,9 +17,12 @@ package rules
 import (
 	log "github.com/sirupsen/logrus"
 
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
 	"github.com/projectcalico/calico/felix/hashutils"
 	. "github.com/projectcalico/calico/felix/iptables"
 	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 )
 
 const (
@@ -31,17 +34,17 @@ func (r *DefaultRuleRenderer) WorkloadEndpointToIptablesChains(
 	ifaceName string,
 	epMarkMapper EndpointMarkMapper,
 	adminUp bool,
-	ingressPolicies []string,
-	egressPolicies []string,
+	tiers []*proto.TierInfo,
 	profileIDs []string,
+	qosControls *proto.QoSControls,
 ) []*Chain {
 	allowVXLANEncapFromWorkloads := r.Config.AllowVXLANPacketsFromWorkloads
 	allowIPIPEncapFromWorkloads := r.Config.AllowIPIPPacketsFromWorkloads
 	result := []*Chain{}
 	result = append(result,
 		// Chain for traffic _to_ the endpoint.
-		r.endpointIptablesChain(
-			ingressPolicies,
+		r.prependPacketRateLimit(qosControls.GetIngressPacketRate(), r.endpointIptablesChain(
+			tiers,
 			profileIDs,
 			ifaceName,
 			PolicyInboundPfx,
@@ -53,12 +56,12 @@ func (r *DefaultRuleRenderer) WorkloadEndpointToIptablesChains(
 			r.filterAllowAction, // Workload endpoint chains are only used in the filter table
 			alwaysAllowVXLANEncap,
 			alwaysAllowIPIPEncap,
-		),
+		)),
 		// Chain for traffic _from_ the endpoint.
 		// Encap traffic is blocked by default from workload endpoints
 		// unless explicitly overridden.
-		r.endpointIptablesChain(
-			egressPolicies,
+		r.prependPacketRateLimit(qosControls.GetEgressPacketRate(), r.endpointIptablesChain(
+			tiers,
 			profileIDs,
 			ifaceName,
 			PolicyOutboundPfx,
@@ -70,7 +73,7 @@ func (r *DefaultRuleRenderer) WorkloadEndpointToIptablesChains(
 			r.filterAllowAction, // Workload endpoint chains are only used in the filter table
 			allowVXLANEncapFromWorkloads,
 			allowIPIPEncapFromWorkloads,
-		),
+		)),
 	)
 
 	if r.KubeIPVSSupportEnabled {
@@ -87,13 +90,36 @@ func (r *DefaultRuleRenderer) WorkloadEndpointToIptablesChains(
 	return result
 }
 
+// prependPacketRateLimit adds rules to the start of the given workload endpoint chain to drop
+// packets above the given rate (in packets per second).  The rules come before the conntrack
+// rules so that the limit also applies to established connections.  A rate of zero means no limit.
+func (r *DefaultRuleRenderer) prependPacketRateLimit(rate int64, chain *Chain) *Chain {
+	if rate == 0 {
+		return chain
+	}
+	chain.Rules = append([]Rule{
+		{
+			Action: ClearMarkAction{Mark: r.IptablesMarkScratch0},
+		},
+		{
+			// Allow a burst of one second's worth of packets.
+			Match:  Match().LimitPacketRate(rate, rate),
+			Action: SetMarkAction{Mark: r.IptablesMarkScratch0},
+		},
+		{
+			Match:   Match().MarkClear(r.IptablesMarkScratch0),
+			Action:  DropAction{},
+			Comment: []string{"Drop packets over rate limit"},
+		},
+	}, chain.Rules...)
+	return chain
+}
+
 func (r *DefaultRuleRenderer) HostEndpointToFilterChains(
 	ifaceName string,
 	epMarkMapper EndpointMarkMapper,
-	ingressPolicyNames []string,
-	egressPolicyNames []string,
-	ingressForwardPolicyNames []string,
-	egressForwardPolicyNames []string,
+	tiers []*proto.TierInfo,
+	forwardTiers []*proto.TierInfo,
 	profileIDs []string,
 ) []*Chain {
 	log.WithField("ifaceName", ifaceName).Debug("Rendering filter host endpoint chain.")
@@ -101,7 +127,7 @@ func (r *DefaultRuleRenderer) HostEndpointToFilterChains(
 	result = append(result,
 		// Chain for output traffic _to_ the endpoint.
 		r.endpointIptablesChain(
-			egressPolicyNames,
+			tiers,
 			profileIDs,
 			ifaceName,
 			PolicyOutboundPfx,
@@ -116,7 +142,7 @@ func (r *DefaultRuleRenderer) HostEndpointToFilterChains(
 		),
 		// Chain for input traffic _from_ the endpoint.
 		r.endpointIptablesChain(
-			ingressPolicyNames,
+			tiers,
 			profileIDs,
 			ifaceName,
 			PolicyInboundPfx,
@@ -131,7 +157,7 @@ func (r *DefaultRuleRenderer) HostEndpointToFilterChains(
 		),
 		// Chain for forward traffic _to_ the endpoint.
 		r.endpointIptablesChain(
-			egressForwardPolicyNames,
+			forwardTiers,
 			profileIDs,
 			ifaceName,
 			PolicyOutboundPfx,
@@ -146,7 +172,7 @@ func (r *DefaultRuleRenderer) HostEndpointToFilterChains(
 		),
 		// Chain for forward traffic _from_ the endpoint.
 		r.endpointIptablesChain(
-			ingressForwardPolicyNames,
+			forwardTiers,
 			profileIDs,
 			ifaceName,
 			PolicyInboundPfx,
@@ -177,7 +203,7 @@ func (r *DefaultRuleRenderer) HostEndpointToFilterChains(
 
 func (r *DefaultRuleRenderer) HostEndpointToMangleEgressChains(
 	ifaceName string,
-	egressPolicyNames []string,
+	tiers []*proto.TierInfo,
 	profileIDs []string,
 ) []*Chain {
 	log.WithField("ifaceName", ifaceName).Debug("Render host endpoint mangle egress chain.")
@@ -186,7 +212,7 @@ func (r *DefaultRuleRenderer) HostEndpointToMangleEgressChains(
 		// ACCEPT because the mangle table is typically used, if at all, for packet
 		// manipulations that might need to apply to our allowed traffic.
 		r.endpointIptablesChain(
-			egressPolicyNames,
+			tiers,
 			profileIDs,
 			ifaceName,
 			PolicyOutboundPfx,
@@ -204,11 +230,11 @@ func (r *DefaultRuleRenderer) HostEndpointToMangleEgressChains(
 
 func (r *DefaultRuleRenderer) HostEndpointToRawEgressChain(
 	ifaceName string,
-	egressPolicyNames []string,
+	untrackedTiers []*proto.TierInfo,
 ) *Chain {
 	log.WithField("ifaceName", ifaceName).Debug("Rendering raw (untracked) host endpoint egress chain.")
 	return r.endpointIptablesChain(
-		egressPolicyNames,
+		untrackedTiers,
 		nil, // We don't render profiles into the raw table.
 		ifaceName,
 		PolicyOutboundPfx,
@@ -225,16 +251,15 @@ func (r *DefaultRuleRenderer) HostEndpointToRawEgressChain(
 
 func (r *DefaultRuleRenderer) HostEndpointToRawChains(
 	ifaceName string,
-	ingressPolicyNames []string,
-	egressPolicyNames []string,
+	untrackedTiers []*proto.TierInfo,
 ) []*Chain {
 	log.WithField("ifaceName", ifaceName).Debug("Rendering raw (untracked) host endpoint chain.")
 	return []*Chain{
 		// Chain for traffic _to_ the endpoint.
-		r.HostEndpointToRawEgressChain(ifaceName, egressPolicyNames),
+		r.HostEndpointToRawEgressChain(ifaceName, untrackedTiers),
 		// Chain for traffic _from_ the endpoint.
 		r.endpointIptablesChain(
-			ingressPolicyNames,
+			untrackedTiers,
 			nil, // We don't render profiles into the raw table.
 			ifaceName,
 			PolicyInboundPfx,
@@ -252,14 +277,14 @@ func (r *DefaultRuleRenderer) HostEndpointToRawChains(
 
 func (r *DefaultRuleRenderer) HostEndpointToMangleIngressChains(
 	ifaceName string,
-	preDNATPolicyNames []string,
+	preDNATTiers []*proto.TierInfo,
 ) []*Chain {
 	log.WithField("ifaceName", ifaceName).Debug("Rendering pre-DNAT host endpoint chain.")
 	return []*Chain{
 		// Chain for traffic _from_ the endpoint.  Pre-DNAT policy does not apply to
 		// outgoing traffic through a host endpoint.
 		r.endpointIptablesChain(
-			preDNATPolicyNames,
+			preDNATTiers,
 			nil, // We don't render profiles into the raw table.
 			ifaceName,
 			PolicyInboundPfx,
@@ -307,7 +332,7 @@ func (r *DefaultRuleRenderer) endpointSetMarkChain(
 }
 
 func (r *DefaultRuleRenderer) endpointIptablesChain(
-	policyNames []string,
+	tiers []*proto.TierInfo,
 	profileIds []string,
 	name string,
 	policyPrefix PolicyChainNamePrefix,
@@ -373,11 +398,40 @@ func (r *DefaultRuleRenderer) endpointIptablesChain(
 		})
 	}
 
-	if len(policyNames) > 0 {
-		// Clear the "pass" mark.  If a policy sets that mark, we'll skip the rest of the policies and
-		// continue processing the profiles, if there are any.
+	flowLogDirection := FlowLogDirectionEgress
+	if policyPrefix == PolicyInboundPfx {
+		flowLogDirection = FlowLogDirectionIngress
+	}
+
+	policiesRendered := false
+	for _, tier := range tiers {
+		var policyNames []string
+		if policyPrefix == PolicyInboundPfx {
+			policyNames = tier.IngressPolicies
+		} else {
+			policyNames = tier.EgressPolicies
+		}
+		if len(policyNames) == 0 {
+			continue
+		}
+
+		// Staged policies only count packets, so a tier that contains nothing but staged
+		// policies must not drop traffic at its end.
+		enforcedPoliciesInTier := false
+		for _, polID := range policyNames {
+			if !model.PolicyIsStaged(polID) {
+				enforcedPoliciesInTier = true
+				break
+			}
+		}
+		if enforcedPoliciesInTier {
+			policiesRendered = true
+		}
+
+		// Clear the "pass" mark.  If a policy sets that mark, we'll skip the rest of the policies in
+		// this tier and continue processing the next tier, or the profiles, if there are any.
 		rules = append(rules, Rule{
-			Comment: []string{"Start of policies"},
+			Comment: []string{"Start of tier " + tier.Name},
 			Action: ClearMarkAction{
 				Mark: r.IptablesMarkPass,
 			},
@@ -387,7 +441,7 @@ func (r *DefaultRuleRenderer) endpointIptablesChain(
 		for _, polID := range policyNames {
 			polChainName := PolicyChainName(
 				policyPrefix,
-				&proto.PolicyID{Name: polID},
+				&proto.PolicyID{Tier: tier.Name, Name: polID},
 			)
 
 			// If a previous policy didn't set the "pass" mark, jump to the policy.
@@ -395,6 +449,10 @@ func (r *DefaultRuleRenderer) endpointIptablesChain(
 				Match:  Match().MarkClear(r.IptablesMarkPass),
 				Action: JumpAction{Target: polChainName},
 			})
+			if model.PolicyIsStaged(polID) {
+				// Staged policy chains never set the accept or pass marks.
+				continue
+			}
 			// If policy marked packet as accepted, it returns, setting the accept
 			// mark bit.
 			if chainType == chainTypeUntracked {
@@ -413,20 +471,30 @@ func (r *DefaultRuleRenderer) endpointIptablesChain(
 			})
 		}
 
-		if chainType == chainTypeNormal || chainType == chainTypeForward {
-			// When rendering normal and forward rules, if no policy marked the packet as "pass", drop the
-			// packet.
+		if (chainType == chainTypeNormal || chainType == chainTypeForward) && tier.DefaultAction != string(v3.Pass) && enforcedPoliciesInTier {
+			// When rendering normal and forward rules, if no policy in the tier marked the packet
+			// as "pass", drop the packet.  Tiers with a "Pass" default action fall through to the
+			// next tier instead.
 			//
 			// For untracked and pre-DNAT rules, we don't do that because there may be
 			// normal rules still to be applied to the packet in the filter table.
+			if r.FlowLogsEnabled {
+				rules = append(rules, r.flowLogNflogRule(Match().MarkClear(r.IptablesMarkPass), FlowLogRule{
+					Action:    FlowLogActionDeny,
+					Direction: flowLogDirection,
+					Index:     -1,
+					Tier:      tier.Name,
+				}))
+			}
 			rules = append(rules, Rule{
 				Match:   Match().MarkClear(r.IptablesMarkPass),
 				Action:  DropAction{},
-				Comment: []string{"Drop if no policies passed packet"},
+				Comment: []string{"End of tier " + tier.Name + ". Drop if no policies passed packet"},
 			})
 		}
+	}
 
-	} else if chainType == chainTypeForward {
+	if !policiesRendered && chainType == chainTypeForward {
 		// Forwarded traffic is allowed when there are no policies with
 		// applyOnForward that apply to this endpoint (and in this direction).
 		rules = append(rules, Rule{
@@ -460,6 +528,14 @@ func (r *DefaultRuleRenderer) endpointIptablesChain(
 		// For untracked rules, we don't do that because there may be tracked rules
 		// still to be applied to the packet in the filter table.
 		//if dropIfNoProfilesMatched {
+		if r.FlowLogsEnabled {
+			rules = append(rules, r.flowLogNflogRule(Match(), FlowLogRule{
+				Action:    FlowLogActionDeny,
+				Direction: flowLogDirection,
+				Index:     -1,
+				Tier:      FlowLogProfileTier,
+			}))
+		}
 		rules = append(rules, Rule{
 			Match:   Match(),
 			Action:  DropAction{},
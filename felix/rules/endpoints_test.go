@@ -24,6 +24,7 @@ import (
 
 	"github.com/projectcalico/calico/felix/ipsets"
 	. "github.com/projectcalico/calico/felix/iptables"
+	"github.com/projectcalico/calico/felix/proto"
 )
 
 var _ = Describe("Endpoints", func() {
@@ -170,14 +171,47 @@ var _ = Describe("Endpoints", func() {
 				})))
 			})
 
+			It("should render packet rate limits at the start of a workload endpoint's chains", func() {
+				chains := renderer.WorkloadEndpointToIptablesChains(
+					"cali1234", epMarkMapper,
+					true,
+					nil,
+					nil,
+					&proto.QoSControls{IngressPacketRate: 100, EgressPacketRate: 200},
+				)
+				rateLimitRules := func(rate int64) []Rule {
+					return []Rule{
+						{Action: ClearMarkAction{Mark: 0x20}},
+						{
+							Match:  Match().LimitPacketRate(rate, rate),
+							Action: SetMarkAction{Mark: 0x20},
+						},
+						{
+							Match:   Match().MarkClear(0x20),
+							Action:  DropAction{},
+							Comment: []string{"Drop packets over rate limit"},
+						},
+					}
+				}
+				Expect(chains[0].Name).To(Equal("cali-tw-cali1234"))
+				Expect(chains[0].Rules[:3]).To(Equal(rateLimitRules(100)))
+				Expect(chains[0].Rules[3].Match).To(Equal(Match().ConntrackState("RELATED,ESTABLISHED")))
+				Expect(chains[1].Name).To(Equal("cali-fw-cali1234"))
+				Expect(chains[1].Rules[:3]).To(Equal(rateLimitRules(200)))
+			})
+
 			It("should render a fully-loaded workload endpoint", func() {
 				Expect(renderer.WorkloadEndpointToIptablesChains(
 					"cali1234",
 					epMarkMapper,
 					true,
-					[]string{"ai", "bi"},
-					[]string{"ae", "be"},
+					[]*proto.TierInfo{{
+						Name:            "default",
+						IngressPolicies: []string{"ai", "bi"},
+						EgressPolicies:  []string{"ae", "be"},
+					}},
 					[]string{"prof1", "prof2"},
+					nil,
 				)).To(Equal(trimSMChain(kubeIPVSEnabled, []*Chain{
 					{
 						Name: "cali-tw-cali1234",
@@ -190,7 +224,7 @@ var _ = Describe("Endpoints", func() {
 
 							{Action: ClearMarkAction{Mark: 0x8}},
 
-							{Comment: []string{"Start of policies"},
+							{Comment: []string{"Start of tier default"},
 								Action: ClearMarkAction{Mark: 0x10}},
 							{Match: Match().MarkClear(0x10),
 								Action: JumpAction{Target: "cali-pi-ai"}},
@@ -204,7 +238,7 @@ var _ = Describe("Endpoints", func() {
 								Comment: []string{"Return if policy accepted"}},
 							{Match: Match().MarkClear(0x10),
 								Action:  DropAction{},
-								Comment: []string{"Drop if no policies passed packet"}},
+								Comment: []string{"End of tier default. Drop if no policies passed packet"}},
 
 							{Action: JumpAction{Target: "cali-pri-prof1"}},
 							{Match: Match().MarkSingleBitSet(0x8),
@@ -232,7 +266,7 @@ var _ = Describe("Endpoints", func() {
 							dropVXLANRule,
 							dropIPIPRule,
 
-							{Comment: []string{"Start of policies"},
+							{Comment: []string{"Start of tier default"},
 								Action: ClearMarkAction{Mark: 0x10}},
 							{Match: Match().MarkClear(0x10),
 								Action: JumpAction{Target: "cali-po-ae"}},
@@ -246,7 +280,7 @@ var _ = Describe("Endpoints", func() {
 								Comment: []string{"Return if policy accepted"}},
 							{Match: Match().MarkClear(0x10),
 								Action:  DropAction{},
-								Comment: []string{"Drop if no policies passed packet"}},
+								Comment: []string{"End of tier default. Drop if no policies passed packet"}},
 
 							{Action: JumpAction{Target: "cali-pro-prof1"}},
 							{Match: Match().MarkSingleBitSet(0x8),
@@ -270,11 +304,158 @@ var _ = Describe("Endpoints", func() {
 				})))
 			})
 
+			It("should render a workload endpoint with multiple tiers", func() {
+				Expect(renderer.WorkloadEndpointToIptablesChains(
+					"cali1234",
+					epMarkMapper,
+					true,
+					[]*proto.TierInfo{
+						{
+							Name:            "tier1",
+							DefaultAction:   "Pass",
+							IngressPolicies: []string{"tier1.ai"},
+						},
+						{
+							Name:            "default",
+							DefaultAction:   "Deny",
+							IngressPolicies: []string{"default.bi"},
+						},
+					},
+					nil,
+					nil,
+				)).To(Equal(trimSMChain(kubeIPVSEnabled, []*Chain{
+					{
+						Name: "cali-tw-cali1234",
+						Rules: []Rule{
+							// conntrack rules.
+							{Match: Match().ConntrackState("RELATED,ESTABLISHED"),
+								Action: AcceptAction{}},
+							{Match: Match().ConntrackState("INVALID"),
+								Action: DropAction{}},
+
+							{Action: ClearMarkAction{Mark: 0x8}},
+
+							{Comment: []string{"Start of tier tier1"},
+								Action: ClearMarkAction{Mark: 0x10}},
+							{Match: Match().MarkClear(0x10),
+								Action: JumpAction{Target: "cali-pi-tier1.ai"}},
+							{Match: Match().MarkSingleBitSet(0x8),
+								Action:  ReturnAction{},
+								Comment: []string{"Return if policy accepted"}},
+
+							{Comment: []string{"Start of tier default"},
+								Action: ClearMarkAction{Mark: 0x10}},
+							{Match: Match().MarkClear(0x10),
+								Action: JumpAction{Target: "cali-pi-default.bi"}},
+							{Match: Match().MarkSingleBitSet(0x8),
+								Action:  ReturnAction{},
+								Comment: []string{"Return if policy accepted"}},
+							{Match: Match().MarkClear(0x10),
+								Action:  DropAction{},
+								Comment: []string{"End of tier default. Drop if no policies passed packet"}},
+
+							{Action: DropAction{},
+								Comment: []string{"Drop if no profiles matched"}},
+						},
+					},
+					{
+						Name: "cali-fw-cali1234",
+						Rules: []Rule{
+							// conntrack rules.
+							{Match: Match().ConntrackState("RELATED,ESTABLISHED"),
+								Action: AcceptAction{}},
+							{Match: Match().ConntrackState("INVALID"),
+								Action: DropAction{}},
+
+							{Action: ClearMarkAction{Mark: 0x8}},
+							dropVXLANRule,
+							dropIPIPRule,
+							{Action: DropAction{},
+								Comment: []string{"Drop if no profiles matched"}},
+						},
+					},
+					{
+						Name: "cali-sm-cali1234",
+						Rules: []Rule{
+							{Action: SetMaskedMarkAction{Mark: 0xd400, Mask: 0xff00}},
+						},
+					},
+				})))
+			})
+
+			It("should not drop at the end of a tier that only contains staged policies", func() {
+				Expect(renderer.WorkloadEndpointToIptablesChains(
+					"cali1234",
+					epMarkMapper,
+					true,
+					[]*proto.TierInfo{
+						{
+							Name:            "tier1",
+							DefaultAction:   "Deny",
+							IngressPolicies: []string{"staged:tier1.ai"},
+						},
+					},
+					nil,
+					nil,
+				)).To(Equal(trimSMChain(kubeIPVSEnabled, []*Chain{
+					{
+						Name: "cali-tw-cali1234",
+						Rules: []Rule{
+							// conntrack rules.
+							{Match: Match().ConntrackState("RELATED,ESTABLISHED"),
+								Action: AcceptAction{}},
+							{Match: Match().ConntrackState("INVALID"),
+								Action: DropAction{}},
+
+							{Action: ClearMarkAction{Mark: 0x8}},
+
+							{Comment: []string{"Start of tier tier1"},
+								Action: ClearMarkAction{Mark: 0x10}},
+							{Match: Match().MarkClear(0x10),
+								Action: JumpAction{Target: "cali-pi-staged:tier1.ai"}},
+
+							{Action: DropAction{},
+								Comment: []string{"Drop if no profiles matched"}},
+						},
+					},
+					{
+						Name: "cali-fw-cali1234",
+						Rules: []Rule{
+							// conntrack rules.
+							{Match: Match().ConntrackState("RELATED,ESTABLISHED"),
+								Action: AcceptAction{}},
+							{Match: Match().ConntrackState("INVALID"),
+								Action: DropAction{}},
+
+							{Action: ClearMarkAction{Mark: 0x8}},
+							dropVXLANRule,
+							dropIPIPRule,
+							{Action: DropAction{},
+								Comment: []string{"Drop if no profiles matched"}},
+						},
+					},
+					{
+						Name: "cali-sm-cali1234",
+						Rules: []Rule{
+							{Action: SetMaskedMarkAction{Mark: 0xd400, Mask: 0xff00}},
+						},
+					},
+				})))
+			})
+
 			It("should render a host endpoint", func() {
 				Expect(renderer.HostEndpointToFilterChains("eth0",
 					epMarkMapper,
-					[]string{"ai", "bi"}, []string{"ae", "be"},
-					[]string{"afi", "bfi"}, []string{"afe", "bfe"},
+					[]*proto.TierInfo{{
+						Name:            "default",
+						IngressPolicies: []string{"ai", "bi"},
+						EgressPolicies:  []string{"ae", "be"},
+					}},
+					[]*proto.TierInfo{{
+						Name:            "default",
+						IngressPolicies: []string{"afi", "bfi"},
+						EgressPolicies:  []string{"afe", "bfe"},
+					}},
 					[]string{"prof1", "prof2"})).To(Equal(trimSMChain(kubeIPVSEnabled, []*Chain{
 					{
 						Name: "cali-th-eth0",
@@ -290,7 +471,7 @@ var _ = Describe("Endpoints", func() {
 
 							{Action: ClearMarkAction{Mark: 0x8}},
 
-							{Comment: []string{"Start of policies"},
+							{Comment: []string{"Start of tier default"},
 								Action: ClearMarkAction{Mark: 0x10}},
 							{Match: Match().MarkClear(0x10),
 								Action: JumpAction{Target: "cali-po-ae"}},
@@ -304,7 +485,7 @@ var _ = Describe("Endpoints", func() {
 								Comment: []string{"Return if policy accepted"}},
 							{Match: Match().MarkClear(0x10),
 								Action:  DropAction{},
-								Comment: []string{"Drop if no policies passed packet"}},
+								Comment: []string{"End of tier default. Drop if no policies passed packet"}},
 
 							{Action: JumpAction{Target: "cali-pro-prof1"}},
 							{Match: Match().MarkSingleBitSet(0x8),
@@ -333,7 +514,7 @@ var _ = Describe("Endpoints", func() {
 
 							{Action: ClearMarkAction{Mark: 0x8}},
 
-							{Comment: []string{"Start of policies"},
+							{Comment: []string{"Start of tier default"},
 								Action: ClearMarkAction{Mark: 0x10}},
 							{Match: Match().MarkClear(0x10),
 								Action: JumpAction{Target: "cali-pi-ai"}},
@@ -347,7 +528,7 @@ var _ = Describe("Endpoints", func() {
 								Comment: []string{"Return if policy accepted"}},
 							{Match: Match().MarkClear(0x10),
 								Action:  DropAction{},
-								Comment: []string{"Drop if no policies passed packet"}},
+								Comment: []string{"End of tier default. Drop if no policies passed packet"}},
 
 							{Action: JumpAction{Target: "cali-pri-prof1"}},
 							{Match: Match().MarkSingleBitSet(0x8),
@@ -373,7 +554,7 @@ var _ = Describe("Endpoints", func() {
 
 							{Action: ClearMarkAction{Mark: 0x8}},
 
-							{Comment: []string{"Start of policies"},
+							{Comment: []string{"Start of tier default"},
 								Action: ClearMarkAction{Mark: 0x10}},
 							{Match: Match().MarkClear(0x10),
 								Action: JumpAction{Target: "cali-po-afe"}},
@@ -387,7 +568,7 @@ var _ = Describe("Endpoints", func() {
 								Comment: []string{"Return if policy accepted"}},
 							{Match: Match().MarkClear(0x10),
 								Action:  DropAction{},
-								Comment: []string{"Drop if no policies passed packet"}},
+								Comment: []string{"End of tier default. Drop if no policies passed packet"}},
 						},
 					},
 					{
@@ -401,7 +582,7 @@ var _ = Describe("Endpoints", func() {
 
 							{Action: ClearMarkAction{Mark: 0x8}},
 
-							{Comment: []string{"Start of policies"},
+							{Comment: []string{"Start of tier default"},
 								Action: ClearMarkAction{Mark: 0x10}},
 							{Match: Match().MarkClear(0x10),
 								Action: JumpAction{Target: "cali-pi-afi"}},
@@ -415,7 +596,7 @@ var _ = Describe("Endpoints", func() {
 								Comment: []string{"Return if policy accepted"}},
 							{Match: Match().MarkClear(0x10),
 								Action:  DropAction{},
-								Comment: []string{"Drop if no policies passed packet"}},
+								Comment: []string{"End of tier default. Drop if no policies passed packet"}},
 						},
 					},
 					{
@@ -428,7 +609,11 @@ var _ = Describe("Endpoints", func() {
 			})
 
 			It("should render host endpoint raw chains with untracked policies", func() {
-				Expect(renderer.HostEndpointToRawChains("eth0", []string{"c"}, []string{"c"})).To(Equal([]*Chain{
+				Expect(renderer.HostEndpointToRawChains("eth0", []*proto.TierInfo{{
+					Name:            "default",
+					IngressPolicies: []string{"c"},
+					EgressPolicies:  []string{"c"},
+				}})).To(Equal([]*Chain{
 					{
 						Name: "cali-th-eth0",
 						Rules: []Rule{
@@ -437,7 +622,7 @@ var _ = Describe("Endpoints", func() {
 
 							{Action: ClearMarkAction{Mark: 0x8}},
 
-							{Comment: []string{"Start of policies"},
+							{Comment: []string{"Start of tier default"},
 								Action: ClearMarkAction{Mark: 0x10}},
 							{Match: Match().MarkClear(0x10),
 								Action: JumpAction{Target: "cali-po-c"}},
@@ -459,7 +644,7 @@ var _ = Describe("Endpoints", func() {
 
 							{Action: ClearMarkAction{Mark: 0x8}},
 
-							{Comment: []string{"Start of policies"},
+							{Comment: []string{"Start of tier default"},
 								Action: ClearMarkAction{Mark: 0x10}},
 							{Match: Match().MarkClear(0x10),
 								Action: JumpAction{Target: "cali-pi-c"}},
@@ -479,7 +664,7 @@ var _ = Describe("Endpoints", func() {
 			It("should render host endpoint mangle chains with pre-DNAT policies", func() {
 				Expect(renderer.HostEndpointToMangleIngressChains(
 					"eth0",
-					[]string{"c"},
+					[]*proto.TierInfo{{Name: "default", IngressPolicies: []string{"c"}}},
 				)).To(Equal([]*Chain{
 					{
 						Name: "cali-fh-eth0",
@@ -497,7 +682,7 @@ var _ = Describe("Endpoints", func() {
 
 							{Action: ClearMarkAction{Mark: 0x8}},
 
-							{Comment: []string{"Start of policies"},
+							{Comment: []string{"Start of tier default"},
 								Action: ClearMarkAction{Mark: 0x10}},
 							{Match: Match().MarkClear(0x10),
 								Action: JumpAction{Target: "cali-pi-c"}},
@@ -572,7 +757,7 @@ var _ = Describe("Endpoints", func() {
 			It("should render host endpoint mangle chains with pre-DNAT policies", func() {
 				Expect(renderer.HostEndpointToMangleIngressChains(
 					"eth0",
-					[]string{"c"},
+					[]*proto.TierInfo{{Name: "default", IngressPolicies: []string{"c"}}},
 				)).To(Equal([]*Chain{
 					{
 						Name: "cali-fh-eth0",
@@ -586,7 +771,7 @@ var _ = Describe("Endpoints", func() {
 
 							{Action: ClearMarkAction{Mark: 0x8}},
 
-							{Comment: []string{"Start of policies"},
+							{Comment: []string{"Start of tier default"},
 								Action: ClearMarkAction{Mark: 0x10}},
 							{Match: Match().MarkClear(0x10),
 								Action: JumpAction{Target: "cali-pi-c"}},
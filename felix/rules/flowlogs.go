@@ -0,0 +1,192 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"github.com/projectcalico/calico/felix/iptables"
+)
+
+type FlowLogAction byte
+
+const (
+	FlowLogActionAllow FlowLogAction = 'A'
+	FlowLogActionDeny  FlowLogAction = 'D'
+)
+
+func (a FlowLogAction) String() string {
+	if a == FlowLogActionAllow {
+		return "allow"
+	}
+	return "deny"
+}
+
+type FlowLogDirection byte
+
+const (
+	FlowLogDirectionIngress FlowLogDirection = 'I'
+	FlowLogDirectionEgress  FlowLogDirection = 'E'
+)
+
+func (d FlowLogDirection) String() string {
+	if d == FlowLogDirectionIngress {
+		return "ingress"
+	}
+	return "egress"
+}
+
+// NFLOGGroup returns the NFLOG group that verdicts in this direction are logged to.
+func (d FlowLogDirection) NFLOGGroup() uint16 {
+	if d == FlowLogDirectionIngress {
+		return NFLOGInboundGroup
+	}
+	return NFLOGOutboundGroup
+}
+
+const (
+	// FlowLogProfileTier is the tier name used in a FlowLogRule for a rule in a profile.
+	FlowLogProfileTier = "__PROFILE__"
+
+	// flowLogNoRuleIndex is the encoded index of a FlowLogRule that doesn't refer to a rule, such
+	// as the implicit drop at the end of a tier.
+	flowLogNoRuleIndex = "-"
+
+	// maxNflogPrefixLen is the longest prefix that the kernel passes through with an NFLOG'd
+	// packet, excluding the terminating NUL.
+	maxNflogPrefixLen = 63
+
+	// flowLogIDPrefixMarker starts an NFLOG prefix that holds a rule ID rather than the rule's
+	// encoding.  It can't start an encoding because that starts with the action.
+	flowLogIDPrefixMarker = "#"
+)
+
+// FlowLogRule identifies the policy rule, or implicit end-of-tier or end-of-profiles drop, that
+// decided the verdict for a flow.
+type FlowLogRule struct {
+	Action    FlowLogAction
+	Direction FlowLogDirection
+	// Index is the index of the rule within its policy or profile, or -1 for an implicit drop.
+	Index int
+	// Tier is the name of the policy's tier, or FlowLogProfileTier for a profile.
+	Tier string
+	// Name is the name of the policy or profile; empty for an implicit drop.
+	Name string
+}
+
+// String returns the canonical encoding of the rule, for example "AI|0|default|default.allow-web".
+func (r FlowLogRule) String() string {
+	idx := flowLogNoRuleIndex
+	if r.Index >= 0 {
+		idx = strconv.Itoa(r.Index)
+	}
+	return fmt.Sprintf("%c%c|%s|%s|%s", r.Action, r.Direction, idx, r.Tier, r.Name)
+}
+
+// NflogPrefix returns the --nflog-prefix to use for the rule.  The kernel limits the length of
+// the prefix so, if the encoding is too long, the prefix holds the rule's ID instead and the
+// rule must be registered so that the collector can look the ID up.
+func (r FlowLogRule) NflogPrefix() string {
+	s := r.String()
+	if len(s) > maxNflogPrefixLen {
+		s = fmt.Sprintf("%s%016x", flowLogIDPrefixMarker, r.ID())
+	}
+	return s
+}
+
+// NeedsRegistration returns true if the rule's NFLOG prefix holds its ID rather than its
+// encoding.
+func (r FlowLogRule) NeedsRegistration() bool {
+	return len(r.String()) > maxNflogPrefixLen
+}
+
+// ID returns a non-zero 64-bit identifier for the rule, which the BPF dataplane records in place
+// of the NFLOG prefix.
+func (r FlowLogRule) ID() uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(r.String()))
+	id := h.Sum64()
+	if id == 0 {
+		// Zero means "no rule" to the BPF programs.
+		id = 1
+	}
+	return id
+}
+
+// ParseFlowLogRule parses the canonical encoding of a FlowLogRule, as returned by String or
+// NflogPrefix.  It returns an error for a prefix that holds a rule ID; see ParseFlowLogRuleID.
+func ParseFlowLogRule(s string) (FlowLogRule, error) {
+	parts := strings.SplitN(s, "|", 4)
+	if len(parts) != 4 || len(parts[0]) != 2 {
+		return FlowLogRule{}, fmt.Errorf("malformed flow log rule %q", s)
+	}
+	r := FlowLogRule{
+		Action:    FlowLogAction(parts[0][0]),
+		Direction: FlowLogDirection(parts[0][1]),
+		Index:     -1,
+		Tier:      parts[2],
+		Name:      parts[3],
+	}
+	if r.Action != FlowLogActionAllow && r.Action != FlowLogActionDeny {
+		return FlowLogRule{}, fmt.Errorf("unknown action in flow log rule %q", s)
+	}
+	if r.Direction != FlowLogDirectionIngress && r.Direction != FlowLogDirectionEgress {
+		return FlowLogRule{}, fmt.Errorf("unknown direction in flow log rule %q", s)
+	}
+	if parts[1] != flowLogNoRuleIndex {
+		idx, err := strconv.Atoi(parts[1])
+		if err != nil || idx < 0 {
+			return FlowLogRule{}, fmt.Errorf("bad rule index in flow log rule %q", s)
+		}
+		r.Index = idx
+	}
+	return r, nil
+}
+
+// ParseFlowLogRuleID parses an NFLOG prefix that holds a rule ID, as returned by NflogPrefix for
+// a rule whose encoding is too long.  It returns false if the prefix holds an encoding instead.
+func ParseFlowLogRuleID(prefix string) (uint64, bool) {
+	if !strings.HasPrefix(prefix, flowLogIDPrefixMarker) {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(prefix[len(flowLogIDPrefixMarker):], 16, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+// FlowLogRuleRegistry records the rules that the flow log collector may need to look up by ID.
+type FlowLogRuleRegistry interface {
+	Register(rule FlowLogRule) uint64
+}
+
+// flowLogNflogRule returns a rule that logs packets that match the given criteria to the flow log
+// collector, attributing the verdict to fr.
+func (r *DefaultRuleRenderer) flowLogNflogRule(match iptables.MatchCriteria, fr FlowLogRule) iptables.Rule {
+	if fr.NeedsRegistration() && r.FlowLogRuleIDs != nil {
+		r.FlowLogRuleIDs.Register(fr)
+	}
+	return iptables.Rule{
+		Match: match,
+		Action: iptables.NflogAction{
+			Group:  fr.Direction.NFLOGGroup(),
+			Prefix: fr.NflogPrefix(),
+		},
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/felix/proto"
+	. "github.com/projectcalico/calico/felix/rules"
+)
+
+var _ = DescribeTable("FlowLogRule encoding round trip",
+	func(rule FlowLogRule, expected string) {
+		Expect(rule.String()).To(Equal(expected))
+		parsed, err := ParseFlowLogRule(expected)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsed).To(Equal(rule))
+		Expect(rule.ID()).NotTo(BeZero())
+	},
+	Entry("policy allow",
+		FlowLogRule{Action: FlowLogActionAllow, Direction: FlowLogDirectionIngress, Index: 2, Tier: "default", Name: "default.pol"},
+		"AI|2|default|default.pol"),
+	Entry("profile deny",
+		FlowLogRule{Action: FlowLogActionDeny, Direction: FlowLogDirectionEgress, Index: 0, Tier: FlowLogProfileTier, Name: "kns.default"},
+		"DE|0|__PROFILE__|kns.default"),
+	Entry("end of tier drop",
+		FlowLogRule{Action: FlowLogActionDeny, Direction: FlowLogDirectionIngress, Index: -1, Tier: "security"},
+		"DI|-|security|"),
+)
+
+var _ = DescribeTable("FlowLogRule parsing failures",
+	func(s string) {
+		_, err := ParseFlowLogRule(s)
+		Expect(err).To(HaveOccurred())
+	},
+	Entry("empty", ""),
+	Entry("too few fields", "AI|0|default"),
+	Entry("bad action", "XI|0|default|default.pol"),
+	Entry("bad direction", "AX|0|default|default.pol"),
+	Entry("bad index", "AI|x|default|default.pol"),
+)
+
+var _ = Describe("FlowLogRule NFLOG prefix", func() {
+	longRule := FlowLogRule{
+		Action:    FlowLogActionAllow,
+		Direction: FlowLogDirectionIngress,
+		Index:     0,
+		Tier:      "default",
+		Name:      "default." + strings.Repeat("x", 100),
+	}
+
+	It("should hold the encoding of a short rule", func() {
+		rule := FlowLogRule{Action: FlowLogActionDeny, Direction: FlowLogDirectionEgress, Index: 1, Tier: "default", Name: "default.pol"}
+		Expect(rule.NeedsRegistration()).To(BeFalse())
+		Expect(rule.NflogPrefix()).To(Equal(rule.String()))
+		_, ok := ParseFlowLogRuleID(rule.NflogPrefix())
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should hold the ID of a rule whose encoding doesn't fit the kernel's limit", func() {
+		Expect(longRule.NeedsRegistration()).To(BeTrue())
+		prefix := longRule.NflogPrefix()
+		Expect(len(prefix)).To(BeNumerically("<=", 63))
+		id, ok := ParseFlowLogRuleID(prefix)
+		Expect(ok).To(BeTrue())
+		Expect(id).To(Equal(longRule.ID()))
+		_, err := ParseFlowLogRule(prefix)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should register the rules with long names when rendering policy", func() {
+		registry := &recordingRuleRegistry{}
+		renderer := NewRenderer(Config{
+			IptablesMarkAccept:   0x8,
+			IptablesMarkPass:     0x10,
+			IptablesMarkScratch0: 0x20,
+			IptablesMarkScratch1: 0x40,
+			IptablesMarkEndpoint: 0xff00,
+			FlowLogsEnabled:      true,
+			FlowLogRuleIDs:       registry,
+		})
+		renderer.PolicyToIptablesChains(
+			&proto.PolicyID{Tier: "default", Name: longRule.Name},
+			&proto.Policy{
+				InboundRules: []*proto.Rule{{Action: "allow"}},
+			},
+			4,
+		)
+		renderer.PolicyToIptablesChains(
+			&proto.PolicyID{Tier: "default", Name: "default.short"},
+			&proto.Policy{
+				InboundRules: []*proto.Rule{{Action: "allow"}},
+			},
+			4,
+		)
+		Expect(registry.rules).To(Equal([]FlowLogRule{longRule}))
+	})
+})
+
+type recordingRuleRegistry struct {
+	rules []FlowLogRule
+}
+
+func (r *recordingRuleRegistry) Register(rule FlowLogRule) uint64 {
+	r.rules = append(r.rules, rule)
+	return rule.ID()
+}
@@ -100,6 +100,15 @@ func (r *DefaultRuleRenderer) NATOutgoingChain(natOutgoingActive bool, ipVersion
 				r.MakeNatOutgoingRule("", defaultSnatRule, ipVersion),
 			}
 		}
+		if r.Config.EgressGatewayEnabled && ipVersion == 4 {
+			// Traffic from workloads that use egress gateways is source-NATted by the gateways
+			// instead.
+			rules = append([]iptables.Rule{{
+				Match: iptables.Match().SourceIPSet(
+					r.IPSetConfigV4.NameForMainIPSet(IPSetIDEgressGatewayClients)),
+				Action: iptables.ReturnAction{},
+			}}, rules...)
+		}
 	}
 	return &iptables.Chain{
 		Name:  ChainNATOutgoing,
@@ -217,6 +217,28 @@ var _ = Describe("NAT", func() {
 			},
 		}))
 	})
+	It("should exclude egress gateway clients when egress gateways are enabled", func() {
+		localConfig := rrConfigNormal
+		localConfig.EgressGatewayEnabled = true
+		renderer = NewRenderer(localConfig)
+
+		Expect(renderer.NATOutgoingChain(true, 4)).To(Equal(&Chain{
+			Name: "cali-nat-outgoing",
+			Rules: []Rule{
+				{
+					Action: ReturnAction{},
+					Match:  Match().SourceIPSet("cali40egress-gw-clients"),
+				},
+				{
+					Action: MasqAction{},
+					Match: Match().
+						SourceIPSet("cali40masq-ipam-pools").
+						NotDestIPSet("cali40all-ipam-pools"),
+				},
+			},
+		}))
+		Expect(renderer.NATOutgoingChain(true, 6).Rules).To(HaveLen(1))
+	})
 	It("should render nothing when inactive", func() {
 		Expect(renderer.NATOutgoingChain(false, 4)).To(Equal(&Chain{
 			Name:  "cali-nat-outgoing",
@@ -24,38 +24,128 @@ import (
 	"github.com/projectcalico/calico/felix/ipsets"
 	"github.com/projectcalico/calico/felix/iptables"
 	"github.com/projectcalico/calico/felix/proto"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 )
 
 // ruleRenderer defined in rules_defs.go.
 
 func (r *DefaultRuleRenderer) PolicyToIptablesChains(policyID *proto.PolicyID, policy *proto.Policy, ipVersion uint8) []*iptables.Chain {
+	if model.PolicyIsStaged(policyID.Name) {
+		// Staged policies are rendered so that they count matching packets but never
+		// change the verdict.
+		inbound := iptables.Chain{
+			Name: PolicyChainName(PolicyInboundPfx, policyID),
+			Rules: r.stagedProtoRulesToIptablesRules(policy.InboundRules, ipVersion,
+				r.ruleOwner(FlowLogDirectionIngress, policyID.Tier, policyID.Name),
+				fmt.Sprintf("Policy %s ingress", policyID.Name)),
+		}
+		outbound := iptables.Chain{
+			Name: PolicyChainName(PolicyOutboundPfx, policyID),
+			Rules: r.stagedProtoRulesToIptablesRules(policy.OutboundRules, ipVersion,
+				r.ruleOwner(FlowLogDirectionEgress, policyID.Tier, policyID.Name),
+				fmt.Sprintf("Policy %s egress", policyID.Name)),
+		}
+		return []*iptables.Chain{&inbound, &outbound}
+	}
 	inbound := iptables.Chain{
-		Name:  PolicyChainName(PolicyInboundPfx, policyID),
-		Rules: r.ProtoRulesToIptablesRules(policy.InboundRules, ipVersion, fmt.Sprintf("Policy %s ingress", policyID.Name)),
+		Name: PolicyChainName(PolicyInboundPfx, policyID),
+		Rules: r.protoRulesToIptablesRules(policy.InboundRules, ipVersion,
+			r.ruleOwner(FlowLogDirectionIngress, policyID.Tier, policyID.Name),
+			fmt.Sprintf("Policy %s ingress", policyID.Name)),
 	}
 	outbound := iptables.Chain{
-		Name:  PolicyChainName(PolicyOutboundPfx, policyID),
-		Rules: r.ProtoRulesToIptablesRules(policy.OutboundRules, ipVersion, fmt.Sprintf("Policy %s egress", policyID.Name)),
+		Name: PolicyChainName(PolicyOutboundPfx, policyID),
+		Rules: r.protoRulesToIptablesRules(policy.OutboundRules, ipVersion,
+			r.ruleOwner(FlowLogDirectionEgress, policyID.Tier, policyID.Name),
+			fmt.Sprintf("Policy %s egress", policyID.Name)),
 	}
 	return []*iptables.Chain{&inbound, &outbound}
 }
 
 func (r *DefaultRuleRenderer) ProfileToIptablesChains(profileID *proto.ProfileID, profile *proto.Profile, ipVersion uint8) (inbound, outbound *iptables.Chain) {
 	inbound = &iptables.Chain{
-		Name:  ProfileChainName(ProfileInboundPfx, profileID),
-		Rules: r.ProtoRulesToIptablesRules(profile.InboundRules, ipVersion, fmt.Sprintf("Profile %s ingress", profileID.Name)),
+		Name: ProfileChainName(ProfileInboundPfx, profileID),
+		Rules: r.protoRulesToIptablesRules(profile.InboundRules, ipVersion,
+			r.ruleOwner(FlowLogDirectionIngress, FlowLogProfileTier, profileID.Name),
+			fmt.Sprintf("Profile %s ingress", profileID.Name)),
 	}
 	outbound = &iptables.Chain{
-		Name:  ProfileChainName(ProfileOutboundPfx, profileID),
-		Rules: r.ProtoRulesToIptablesRules(profile.OutboundRules, ipVersion, fmt.Sprintf("Profile %s egress", profileID.Name)),
+		Name: ProfileChainName(ProfileOutboundPfx, profileID),
+		Rules: r.protoRulesToIptablesRules(profile.OutboundRules, ipVersion,
+			r.ruleOwner(FlowLogDirectionEgress, FlowLogProfileTier, profileID.Name),
+			fmt.Sprintf("Profile %s egress", profileID.Name)),
 	}
 	return
 }
 
+// ruleOwner returns the policy or profile to attribute flow log verdicts and rule counters to,
+// or nil if both are disabled.
+func (r *DefaultRuleRenderer) ruleOwner(direction FlowLogDirection, tier, name string) *ruleOwner {
+	if !r.FlowLogsEnabled && !r.PolicyRuleCountersEnabled {
+		return nil
+	}
+	return &ruleOwner{
+		direction: direction,
+		tier:      tier,
+		name:      name,
+		flowLogs:  r.FlowLogsEnabled,
+		counters:  r.PolicyRuleCountersEnabled,
+	}
+}
+
+// ruleOwner is the policy or profile that a chain of rules is being rendered for.
+type ruleOwner struct {
+	direction FlowLogDirection
+	tier      string
+	name      string
+
+	// flowLogs and counters record whether to render the flow log NFLOG rules and the rule
+	// counter comments.
+	flowLogs bool
+	counters bool
+}
+
+func (o *ruleOwner) flowLogRuleAt(idx int, action FlowLogAction) FlowLogRule {
+	return FlowLogRule{
+		Action:    action,
+		Direction: o.direction,
+		Index:     idx,
+		Tier:      o.tier,
+		Name:      o.name,
+	}
+}
+
+func (o *ruleOwner) policyRuleIDAt(idx int) PolicyRuleID {
+	return PolicyRuleID{
+		Direction: o.direction,
+		Index:     idx,
+		Tier:      o.tier,
+		Name:      o.name,
+	}
+}
+
 func (r *DefaultRuleRenderer) ProtoRulesToIptablesRules(protoRules []*proto.Rule, ipVersion uint8, chainComments ...string) []iptables.Rule {
+	return r.protoRulesToIptablesRules(protoRules, ipVersion, nil, chainComments...)
+}
+
+func (r *DefaultRuleRenderer) protoRulesToIptablesRules(protoRules []*proto.Rule, ipVersion uint8, owner *ruleOwner, chainComments ...string) []iptables.Rule {
+	var rules []iptables.Rule
+	for i, protoRule := range protoRules {
+		rules = append(rules, r.protoRuleToIptablesRules(protoRule, ipVersion, false, owner, i)...)
+	}
+	if len(chainComments) > 0 {
+		if len(rules) == 0 {
+			rules = append(rules, iptables.Rule{})
+		}
+		rules[0].Comment = append(rules[0].Comment, chainComments...)
+	}
+	return rules
+}
+
+func (r *DefaultRuleRenderer) stagedProtoRulesToIptablesRules(protoRules []*proto.Rule, ipVersion uint8, owner *ruleOwner, chainComments ...string) []iptables.Rule {
 	var rules []iptables.Rule
-	for _, protoRule := range protoRules {
-		rules = append(rules, r.ProtoRuleToIptablesRules(protoRule, ipVersion)...)
+	for i, protoRule := range protoRules {
+		rules = append(rules, r.protoRuleToIptablesRules(protoRule, ipVersion, true, owner, i)...)
 	}
 	if len(chainComments) > 0 {
 		if len(rules) == 0 {
@@ -65,6 +155,7 @@ func (r *DefaultRuleRenderer) ProtoRulesToIptablesRules(protoRules []*proto.Rule
 	}
 	return rules
 }
+
 func filterNets(mixedCIDRs []string, ipVersion uint8) (filtered []string, filteredAll bool) {
 	if len(mixedCIDRs) == 0 {
 		return nil, false
@@ -134,6 +225,16 @@ func FilterRuleToIPVersion(ipVersion uint8, pRule *proto.Rule) *proto.Rule {
 }
 
 func (r *DefaultRuleRenderer) ProtoRuleToIptablesRules(pRule *proto.Rule, ipVersion uint8) []iptables.Rule {
+	return r.protoRuleToIptablesRules(pRule, ipVersion, false, nil, 0)
+}
+
+func (r *DefaultRuleRenderer) protoRuleToIptablesRules(
+	pRule *proto.Rule,
+	ipVersion uint8,
+	staged bool,
+	owner *ruleOwner,
+	ruleIdx int,
+) []iptables.Rule {
 
 	ruleCopy := FilterRuleToIPVersion(ipVersion, pRule)
 	if ruleCopy == nil {
@@ -267,6 +368,24 @@ func (r *DefaultRuleRenderer) ProtoRuleToIptablesRules(pRule *proto.Rule, ipVers
 	}
 	markBit, actions := r.CalculateActions(ruleCopy, ipVersion)
 	rs := matchBlockBuilder.Rules
+	// The first rule that uses the full match counts the packets that match the policy rule.
+	counterRuleIdx := len(rs)
+	if staged {
+		// A staged rule must not change the verdict so, rather than rendering its
+		// actions, render a single rule that returns from the policy chain without
+		// setting a mark.  iptables still counts the packets that hit it, which shows
+		// what the policy would have done.
+		stagedRule := iptables.Rule{
+			Match:   match,
+			Comment: []string{fmt.Sprintf("Staged action %s", stagedActionName(ruleCopy.Action))},
+		}
+		if ruleCopy.Action != "log" {
+			// Like the enforced policy, only the first matching rule has an effect.
+			stagedRule.Action = iptables.ReturnAction{}
+		}
+		rs = append(rs, stagedRule)
+		markBit, actions = 0, nil
+	}
 	if markBit != 0 {
 		// The rule needs to do more than one action. Render a rule that
 		// executes the match criteria and sets the given mark bit if it
@@ -277,6 +396,10 @@ func (r *DefaultRuleRenderer) ProtoRuleToIptablesRules(pRule *proto.Rule, ipVers
 		})
 		match = iptables.Match().MarkSingleBitSet(markBit)
 	}
+	if flAction, ok := FlowLogActionForRule(ruleCopy.Action); ok && owner != nil && owner.flowLogs && !staged {
+		// Report the verdict to the flow log collector before acting on it.
+		rs = append(rs, r.flowLogNflogRule(match, owner.flowLogRuleAt(ruleIdx, flAction)))
+	}
 	for _, action := range actions {
 		rs = append(rs, iptables.Rule{
 			Match:  match,
@@ -284,6 +407,13 @@ func (r *DefaultRuleRenderer) ProtoRuleToIptablesRules(pRule *proto.Rule, ipVers
 		})
 	}
 
+	if owner != nil && owner.counters && counterRuleIdx < len(rs) {
+		rs[counterRuleIdx].Comment = append(rs[counterRuleIdx].Comment, r.ruleCounterComment(owner.policyRuleIDAt(ruleIdx)))
+		if staged && ruleCopy.Action == "deny" {
+			rs[counterRuleIdx].Comment = append(rs[counterRuleIdx].Comment, r.stagedDenyCounterComment(owner.policyRuleIDAt(ruleIdx)))
+		}
+	}
+
 	// Render rule annotations as comments on each rule.
 	for i := range rs {
 		for k, v := range pRule.GetMetadata().GetAnnotations() {
@@ -536,6 +666,25 @@ func (r *DefaultRuleRenderer) CalculateActions(pRule *proto.Rule, ipVersion uint
 	return
 }
 
+// FlowLogActionForRule returns the flow log action for a rule with the given action, and false if
+// the action doesn't decide the fate of the packet (for example, pass or log).
+func FlowLogActionForRule(action string) (FlowLogAction, bool) {
+	switch action {
+	case "", "allow":
+		return FlowLogActionAllow, true
+	case "deny":
+		return FlowLogActionDeny, true
+	}
+	return 0, false
+}
+
+func stagedActionName(action string) string {
+	if action == "" {
+		return "allow"
+	}
+	return action
+}
+
 func appendProtocolMatch(match iptables.MatchCriteria, protocol *proto.Protocol, logCxt *log.Entry) iptables.MatchCriteria {
 	if protocol == nil {
 		return match
@@ -15,6 +15,9 @@
 package rules_test
 
 import (
+	"fmt"
+	"strings"
+
 	. "github.com/projectcalico/calico/felix/rules"
 
 	. "github.com/onsi/ginkgo"
@@ -1063,6 +1066,76 @@ var _ = Describe("rule metadata tests", func() {
 		}
 	})
 
+	It("should mark the counting rule of each policy rule when rule counters are enabled", func() {
+		registry := &recordingPolicyRuleRegistry{}
+		rrConfigCounters := rrConfigNormal
+		rrConfigCounters.PolicyRuleCountersEnabled = true
+		rrConfigCounters.PolicyRuleIDs = registry
+		renderer := NewRenderer(rrConfigCounters)
+		chains := renderer.PolicyToIptablesChains(
+			&proto.PolicyID{
+				Tier: "default",
+				Name: "default.pol",
+			},
+			&proto.Policy{
+				InboundRules:  []*proto.Rule{{Action: "next-tier"}, {Action: "allow"}},
+				OutboundRules: []*proto.Rule{{Action: "deny"}},
+			},
+			4,
+		)
+		Expect(chains).To(ConsistOf(
+			&iptables.Chain{
+				Name: "cali-pi-default.pol",
+				Rules: []iptables.Rule{
+					{
+						Action:  iptables.SetMarkAction{Mark: 0x100},
+						Comment: []string{ctrComment(PolicyRuleID{Direction: FlowLogDirectionIngress, Index: 0, Tier: "default", Name: "default.pol"}), "Policy default.pol ingress"},
+					},
+					{
+						Match:  iptables.Match().MarkSingleBitSet(0x100),
+						Action: iptables.ReturnAction{},
+					},
+					{
+						Action:  iptables.SetMarkAction{Mark: 0x80},
+						Comment: []string{ctrComment(PolicyRuleID{Direction: FlowLogDirectionIngress, Index: 1, Tier: "default", Name: "default.pol"})},
+					},
+					{
+						Match:  iptables.Match().MarkSingleBitSet(0x80),
+						Action: iptables.ReturnAction{},
+					},
+				},
+			},
+			&iptables.Chain{
+				Name: "cali-po-default.pol",
+				Rules: []iptables.Rule{
+					{
+						Match:   iptables.Match(),
+						Action:  iptables.DropAction{},
+						Comment: []string{ctrComment(PolicyRuleID{Direction: FlowLogDirectionEgress, Index: 0, Tier: "default", Name: "default.pol"}), "Policy default.pol egress"},
+					},
+				},
+			},
+		))
+		Expect(registry.ids).To(ConsistOf(
+			PolicyRuleID{Direction: FlowLogDirectionIngress, Index: 0, Tier: "default", Name: "default.pol"},
+			PolicyRuleID{Direction: FlowLogDirectionIngress, Index: 1, Tier: "default", Name: "default.pol"},
+			PolicyRuleID{Direction: FlowLogDirectionEgress, Index: 0, Tier: "default", Name: "default.pol"},
+		))
+	})
+	It("should keep the counter comment short for a policy with a long name", func() {
+		rrConfigCounters := rrConfigNormal
+		rrConfigCounters.PolicyRuleCountersEnabled = true
+		renderer := NewRenderer(rrConfigCounters)
+		name := "default." + strings.Repeat("x", 250)
+		chains := renderer.PolicyToIptablesChains(
+			&proto.PolicyID{Tier: "default", Name: name},
+			&proto.Policy{InboundRules: []*proto.Rule{{Action: "allow"}}},
+			4,
+		)
+		id := PolicyRuleID{Direction: FlowLogDirectionIngress, Index: 0, Tier: "default", Name: name}
+		Expect(chains[0].Rules[0].Comment).To(ContainElement(ctrComment(id)))
+		Expect(len(ctrComment(id))).To(BeNumerically("<", 32))
+	})
 	It("should include a chain name comment", func() {
 		renderer := NewRenderer(rrConfigNormal)
 		chains := renderer.PolicyToIptablesChains(
@@ -1103,6 +1176,151 @@ var _ = Describe("rule metadata tests", func() {
 			},
 		))
 	})
+	It("should render staged policy rules that return without a verdict", func() {
+		renderer := NewRenderer(rrConfigNormal)
+		chains := renderer.PolicyToIptablesChains(
+			&proto.PolicyID{
+				Tier: "default",
+				Name: "staged:default.pol",
+			},
+			&proto.Policy{
+				InboundRules:  []*proto.Rule{{Action: "allow", Protocol: &proto.Protocol{NumberOrName: &proto.Protocol_Name{Name: "tcp"}}}},
+				OutboundRules: []*proto.Rule{{Action: "deny"}},
+			},
+			4,
+		)
+		Expect(chains).To(ConsistOf(
+			&iptables.Chain{
+				Name: "cali-pi-staged:default.pol",
+				Rules: []iptables.Rule{
+					{
+						Match:  iptables.Match().Protocol("tcp"),
+						Action: iptables.ReturnAction{},
+						Comment: []string{
+							"Staged action allow",
+							"Policy staged:default.pol ingress",
+						},
+					},
+				},
+			},
+			&iptables.Chain{
+				Name: "cali-po-staged:default.pol",
+				Rules: []iptables.Rule{
+					{
+						Match:  iptables.Match(),
+						Action: iptables.ReturnAction{},
+						Comment: []string{
+							"Staged action deny",
+							"Policy staged:default.pol egress",
+						},
+					},
+				},
+			},
+		))
+	})
+	It("should count the packets that staged deny rules would have denied", func() {
+		rrConfigCounters := rrConfigNormal
+		rrConfigCounters.PolicyRuleCountersEnabled = true
+		rrConfigCounters.FlowLogsEnabled = true
+		renderer := NewRenderer(rrConfigCounters)
+		chains := renderer.PolicyToIptablesChains(
+			&proto.PolicyID{
+				Tier: "default",
+				Name: "staged:default.pol",
+			},
+			&proto.Policy{
+				OutboundRules: []*proto.Rule{{Action: "allow", Protocol: &proto.Protocol{NumberOrName: &proto.Protocol_Name{Name: "tcp"}}}, {Action: "deny"}},
+			},
+			4,
+		)
+		Expect(chains).To(ConsistOf(
+			&iptables.Chain{
+				Name: "cali-pi-staged:default.pol",
+				Rules: []iptables.Rule{
+					{Comment: []string{"Policy staged:default.pol ingress"}},
+				},
+			},
+			&iptables.Chain{
+				Name: "cali-po-staged:default.pol",
+				Rules: []iptables.Rule{
+					{
+						Match:  iptables.Match().Protocol("tcp"),
+						Action: iptables.ReturnAction{},
+						Comment: []string{
+							"Staged action allow",
+							ctrComment(PolicyRuleID{Direction: FlowLogDirectionEgress, Index: 0, Tier: "default", Name: "staged:default.pol"}),
+							"Policy staged:default.pol egress",
+						},
+					},
+					{
+						Match:  iptables.Match(),
+						Action: iptables.ReturnAction{},
+						Comment: []string{
+							"Staged action deny",
+							ctrComment(PolicyRuleID{Direction: FlowLogDirectionEgress, Index: 1, Tier: "default", Name: "staged:default.pol"}),
+							stgDenyComment(PolicyRuleID{Direction: FlowLogDirectionEgress, Index: 1, Tier: "default", Name: "staged:default.pol"}),
+						},
+					},
+				},
+			},
+		))
+	})
+	It("should log verdicts to NFLOG when flow logs are enabled", func() {
+		rrConfigFlowLogs := rrConfigNormal
+		rrConfigFlowLogs.FlowLogsEnabled = true
+		renderer := NewRenderer(rrConfigFlowLogs)
+		chains := renderer.PolicyToIptablesChains(
+			&proto.PolicyID{
+				Tier: "default",
+				Name: "default.pol",
+			},
+			&proto.Policy{
+				InboundRules:  []*proto.Rule{{Action: "next-tier"}, {Action: "allow"}},
+				OutboundRules: []*proto.Rule{{Action: "deny"}},
+			},
+			4,
+		)
+		Expect(chains).To(ConsistOf(
+			&iptables.Chain{
+				Name: "cali-pi-default.pol",
+				Rules: []iptables.Rule{
+					{
+						Action:  iptables.SetMarkAction{Mark: 0x100},
+						Comment: []string{"Policy default.pol ingress"},
+					},
+					{
+						Match:  iptables.Match().MarkSingleBitSet(0x100),
+						Action: iptables.ReturnAction{},
+					},
+					{
+						Action: iptables.SetMarkAction{Mark: 0x80},
+					},
+					{
+						Match:  iptables.Match().MarkSingleBitSet(0x80),
+						Action: iptables.NflogAction{Group: 1, Prefix: "AI|1|default|default.pol"},
+					},
+					{
+						Match:  iptables.Match().MarkSingleBitSet(0x80),
+						Action: iptables.ReturnAction{},
+					},
+				},
+			},
+			&iptables.Chain{
+				Name: "cali-po-default.pol",
+				Rules: []iptables.Rule{
+					{
+						Match:   iptables.Match(),
+						Action:  iptables.NflogAction{Group: 2, Prefix: "DE|0|default|default.pol"},
+						Comment: []string{"Policy default.pol egress"},
+					},
+					{
+						Match:  iptables.Match(),
+						Action: iptables.DropAction{},
+					},
+				},
+			},
+		))
+	})
 	It("should include a chain name comment", func() {
 		renderer := NewRenderer(rrConfigNormal)
 		inbound, outbound := renderer.ProfileToIptablesChains(
@@ -1144,3 +1362,20 @@ var _ = Describe("rule metadata tests", func() {
 		))
 	})
 })
+
+func ctrComment(id PolicyRuleID) string {
+	return fmt.Sprintf("%s%016x", RuleCounterCommentPrefix, id.ID())
+}
+
+func stgDenyComment(id PolicyRuleID) string {
+	return fmt.Sprintf("%s%016x", StagedDenyCounterCommentPrefix, id.ID())
+}
+
+type recordingPolicyRuleRegistry struct {
+	ids []PolicyRuleID
+}
+
+func (r *recordingPolicyRuleRegistry) Register(id PolicyRuleID) uint64 {
+	r.ids = append(r.ids, id)
+	return id.ID()
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+)
+
+// RuleCounterCommentPrefix prefixes the iptables comment that identifies the rule whose packet
+// and byte counters count the packets that match a policy rule.
+const RuleCounterCommentPrefix = "cali-ctr:"
+
+// StagedDenyCounterCommentPrefix prefixes the iptables comment that identifies the rule whose
+// counters count the packets that a staged policy's deny rule would have denied.
+const StagedDenyCounterCommentPrefix = "cali-stg-deny:"
+
+// PolicyRuleID identifies a rule within a policy or profile, for the per-rule counters.
+type PolicyRuleID struct {
+	Direction FlowLogDirection
+	// Index is the index of the rule within its policy or profile.
+	Index int
+	// Tier is the name of the policy's tier, or FlowLogProfileTier for a profile.
+	Tier string
+	Name string
+}
+
+// String returns the canonical encoding of the ID, for example "I,0,default,default.allow-web".
+// It only uses characters that are allowed in an iptables comment.
+func (r PolicyRuleID) String() string {
+	return fmt.Sprintf("%c,%d,%s,%s", r.Direction, r.Index, r.Tier, r.Name)
+}
+
+// ID returns a non-zero 64-bit identifier for the rule, which the BPF dataplane uses as the key
+// of the rule's counters.
+func (r PolicyRuleID) ID() uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(r.String()))
+	id := h.Sum64()
+	if id == 0 {
+		id = 1
+	}
+	return id
+}
+
+// ParsePolicyRuleID parses the canonical encoding of a PolicyRuleID.
+func ParsePolicyRuleID(s string) (PolicyRuleID, error) {
+	parts := strings.SplitN(s, ",", 4)
+	if len(parts) != 4 || len(parts[0]) != 1 {
+		return PolicyRuleID{}, fmt.Errorf("malformed policy rule ID %q", s)
+	}
+	r := PolicyRuleID{
+		Direction: FlowLogDirection(parts[0][0]),
+		Tier:      parts[2],
+		Name:      parts[3],
+	}
+	if r.Direction != FlowLogDirectionIngress && r.Direction != FlowLogDirectionEgress {
+		return PolicyRuleID{}, fmt.Errorf("unknown direction in policy rule ID %q", s)
+	}
+	idx, err := strconv.Atoi(parts[1])
+	if err != nil || idx < 0 {
+		return PolicyRuleID{}, fmt.Errorf("bad rule index in policy rule ID %q", s)
+	}
+	r.Index = idx
+	return r, nil
+}
+
+// PolicyRuleRegistry records the rules whose counters the dataplane may need to look up by ID.
+type PolicyRuleRegistry interface {
+	Register(id PolicyRuleID) uint64
+}
+
+// ruleCounterComment returns the comment that marks the iptables rule that counts the packets
+// matching the given policy rule.  The comment holds the rule's ID rather than its encoding,
+// which could be longer than an iptables comment.
+func (r *DefaultRuleRenderer) ruleCounterComment(id PolicyRuleID) string {
+	return RuleCounterCommentPrefix + r.registerPolicyRule(id)
+}
+
+// stagedDenyCounterComment returns the comment that marks the iptables rule that counts the
+// packets that the given staged policy deny rule would have denied.
+func (r *DefaultRuleRenderer) stagedDenyCounterComment(id PolicyRuleID) string {
+	return StagedDenyCounterCommentPrefix + r.registerPolicyRule(id)
+}
+
+func (r *DefaultRuleRenderer) registerPolicyRule(id PolicyRuleID) string {
+	if r.PolicyRuleIDs != nil {
+		r.PolicyRuleIDs.Register(id)
+	}
+	return fmt.Sprintf("%016x", id.ID())
+}
+
+// ParseRuleCounterID parses the rule ID that follows the prefix of a rule counter comment.
+func ParseRuleCounterID(s string) (uint64, error) {
+	id, err := strconv.ParseUint(s, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("malformed rule counter ID %q", s)
+	}
+	return id, nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules_test
+
+import (
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+
+	. "github.com/projectcalico/calico/felix/rules"
+)
+
+var _ = DescribeTable("PolicyRuleID encoding round trip",
+	func(id PolicyRuleID, expected string) {
+		Expect(id.String()).To(Equal(expected))
+		parsed, err := ParsePolicyRuleID(expected)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(parsed).To(Equal(id))
+		Expect(id.ID()).NotTo(BeZero())
+	},
+	Entry("policy",
+		PolicyRuleID{Direction: FlowLogDirectionIngress, Index: 2, Tier: "default", Name: "default.pol"},
+		"I,2,default,default.pol"),
+	Entry("profile",
+		PolicyRuleID{Direction: FlowLogDirectionEgress, Index: 0, Tier: FlowLogProfileTier, Name: "kns.default"},
+		"E,0,__PROFILE__,kns.default"),
+)
+
+var _ = DescribeTable("PolicyRuleID parsing failures",
+	func(s string) {
+		_, err := ParsePolicyRuleID(s)
+		Expect(err).To(HaveOccurred())
+	},
+	Entry("empty", ""),
+	Entry("too few fields", "I,0,default"),
+	Entry("bad direction", "X,0,default,default.pol"),
+	Entry("bad index", "I,-1,default,default.pol"),
+)
+
+var _ = DescribeTable("Rule counter ID parsing",
+	func(s string, expected uint64, expectSuccess bool) {
+		id, err := ParseRuleCounterID(s)
+		if expectSuccess {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).To(Equal(expected))
+		} else {
+			Expect(err).To(HaveOccurred())
+		}
+	},
+	Entry("ID", "00000000deadbeef", uint64(0xdeadbeef), true),
+	Entry("empty", "", uint64(0), false),
+	Entry("encoding", "I,0,default,default.pol", uint64(0), false),
+)
@@ -63,11 +63,15 @@ const (
 	IPSetIDAllVXLANSourceNets = "all-vxlan-net"
 	IPSetIDThisHostIPs        = "this-host"
 
+	IPSetIDEgressGatewayClients = "egress-gw-clients"
+
 	ChainFIPDnat = ChainNamePrefix + "fip-dnat"
 	ChainFIPSnat = ChainNamePrefix + "fip-snat"
 
 	ChainCIDRBlock = ChainNamePrefix + "cidr-block"
 
+	ChainDNSSnoop = ChainNamePrefix + "dns-snoop"
+
 	PolicyInboundPfx   PolicyChainNamePrefix  = ChainNamePrefix + "pi-"
 	PolicyOutboundPfx  PolicyChainNamePrefix  = ChainNamePrefix + "po-"
 	ProfileInboundPfx  ProfileChainNamePrefix = ChainNamePrefix + "pri-"
@@ -102,6 +106,17 @@ const (
 
 	RuleHashPrefix = "cali:"
 
+	// NFLOGInboundGroup and NFLOGOutboundGroup are the NFLOG groups that policy verdicts are
+	// logged to, for flow logs, when a packet is allowed or denied by ingress or egress policy
+	// respectively.
+	NFLOGInboundGroup  = 1
+	NFLOGOutboundGroup = 2
+	// NFLOGDomainGroup is the NFLOG group that Felix uses to receive the DNS responses sent to
+	// local workloads, from which it learns the IPs for the domain names in policy.
+	NFLOGDomainGroup = 3
+	// DNSPort is the port that DNS responses are sent from.
+	DNSPort = 53
+
 	// HistoricNATRuleInsertRegex is a regex pattern to match to match
 	// special-case rules inserted by old versions of felix.  Specifically,
 	// Python felix used to insert a masquerade rule directly into the
@@ -176,9 +191,9 @@ type RuleRenderer interface {
 		ifaceName string,
 		epMarkMapper EndpointMarkMapper,
 		adminUp bool,
-		ingressPolicies []string,
-		egressPolicies []string,
+		tiers []*proto.TierInfo,
 		profileIDs []string,
+		qosControls *proto.QoSControls,
 	) []*iptables.Chain
 
 	WorkloadInterfaceAllowChains(endpoints map[proto.WorkloadEndpointID]*proto.WorkloadEndpoint) []*iptables.Chain
@@ -195,29 +210,26 @@ type RuleRenderer interface {
 	HostEndpointToFilterChains(
 		ifaceName string,
 		epMarkMapper EndpointMarkMapper,
-		ingressPolicyNames []string,
-		egressPolicyNames []string,
-		ingressForwardPolicyNames []string,
-		egressForwardPolicyNames []string,
+		tiers []*proto.TierInfo,
+		forwardTiers []*proto.TierInfo,
 		profileIDs []string,
 	) []*iptables.Chain
 	HostEndpointToMangleEgressChains(
 		ifaceName string,
-		egressPolicyNames []string,
+		tiers []*proto.TierInfo,
 		profileIDs []string,
 	) []*iptables.Chain
 	HostEndpointToRawEgressChain(
 		ifaceName string,
-		egressPolicyNames []string,
+		untrackedTiers []*proto.TierInfo,
 	) *iptables.Chain
 	HostEndpointToRawChains(
 		ifaceName string,
-		ingressPolicyNames []string,
-		egressPolicyNames []string,
+		untrackedTiers []*proto.TierInfo,
 	) []*iptables.Chain
 	HostEndpointToMangleIngressChains(
 		ifaceName string,
-		preDNATPolicyNames []string,
+		preDNATTiers []*proto.TierInfo,
 	) []*iptables.Chain
 
 	PolicyToIptablesChains(policyID *proto.PolicyID, policy *proto.Policy, ipVersion uint8) []*iptables.Chain
@@ -226,12 +238,13 @@ type RuleRenderer interface {
 
 	MakeNatOutgoingRule(protocol string, action iptables.Action, ipVersion uint8) iptables.Rule
 	NATOutgoingChain(active bool, ipVersion uint8) *iptables.Chain
+	DNSSnoopChain(active bool) *iptables.Chain
 
 	DNATsToIptablesChains(dnats map[string]string) []*iptables.Chain
 	SNATsToIptablesChains(snats map[string]string) []*iptables.Chain
 	BlockedCIDRsToIptablesChains(cidrs []string, ipVersion uint8) []*iptables.Chain
 
-	WireguardIncomingMarkChain() *iptables.Chain
+	WireguardIncomingMarkChain(ipVersion uint8) *iptables.Chain
 }
 
 type DefaultRuleRenderer struct {
@@ -253,6 +266,14 @@ func (r *DefaultRuleRenderer) ipSetConfig(ipVersion uint8) *ipsets.IPVersionConf
 	}
 }
 
+// vxlanEnabled returns true if the VXLAN overlay is enabled for the given IP version.
+func (r *DefaultRuleRenderer) vxlanEnabled(ipVersion uint8) bool {
+	if ipVersion == 6 {
+		return r.VXLANEnabledV6
+	}
+	return r.VXLANEnabled
+}
+
 type Config struct {
 	IPSetConfigV4 *ipsets.IPVersionConfig
 	IPSetConfigV6 *ipsets.IPVersionConfig
@@ -275,9 +296,10 @@ type Config struct {
 	OpenStackMetadataPort        uint16
 	OpenStackSpecialCasesEnabled bool
 
-	VXLANEnabled bool
-	VXLANPort    int
-	VXLANVNI     int
+	VXLANEnabled   bool
+	VXLANEnabledV6 bool
+	VXLANPort      int
+	VXLANVNI       int
 
 	IPIPEnabled            bool
 	FelixConfigIPIPEnabled *bool
@@ -285,7 +307,8 @@ type Config struct {
 	// by the host when sending traffic to a workload over IPIP.
 	IPIPTunnelAddress net.IP
 	// Same for VXLAN.
-	VXLANTunnelAddress net.IP
+	VXLANTunnelAddress   net.IP
+	VXLANTunnelAddressV6 net.IP
 
 	AllowVXLANPacketsFromWorkloads bool
 	AllowIPIPPacketsFromWorkloads  bool
@@ -294,9 +317,14 @@ type Config struct {
 	WireguardInterfaceName      string
 	WireguardIptablesMark       uint32
 	WireguardListeningPort      int
+	WireguardEnabledV6          bool
+	WireguardInterfaceNameV6    string
+	WireguardListeningPortV6    int
 	WireguardEncryptHostTraffic bool
 	RouteSource                 string
 
+	EgressGatewayEnabled bool
+
 	IptablesLogPrefix         string
 	EndpointToHostAction      string
 	IptablesFilterAllowAction string
@@ -314,6 +342,27 @@ type Config struct {
 	BPFEnabled         bool
 
 	ServiceLoopPrevention string
+
+	// DNSSnoopingEnabled enables the rules that send DNS responses sent to local workloads to
+	// the DNS snooping chain, which copies them to Felix, via NFLOG group NFLOGDomainGroup, while
+	// there are policy rules that match on domain names.
+	DNSSnoopingEnabled bool
+
+	// FlowLogsEnabled enables the NFLOG rules that report policy verdicts to Felix's flow log
+	// collector.
+	FlowLogsEnabled bool
+
+	// FlowLogRuleIDs, if set, is told about each rule whose NFLOG prefix holds its ID because its
+	// encoding is too long, so that the collector can map the ID back to the rule.
+	FlowLogRuleIDs FlowLogRuleRegistry
+
+	// PolicyRuleCountersEnabled marks the iptables rule that counts the packets matching each
+	// policy rule with a RuleCounterCommentPrefix comment, so that the counters can be read back.
+	PolicyRuleCountersEnabled bool
+
+	// PolicyRuleIDs, if set, is told about each rule that has a counter comment, so that the
+	// dataplane can map the ID in the comment back to the rule.
+	PolicyRuleIDs PolicyRuleRegistry
 }
 
 var unusedBitsInBPFMode = map[string]bool{
@@ -228,14 +228,14 @@ func (r *DefaultRuleRenderer) filterInputChain(ipVersion uint8) *Chain {
 		)
 	}
 
-	if ipVersion == 4 && r.VXLANEnabled {
+	if r.vxlanEnabled(ipVersion) {
 		// VXLAN is enabled, filter incoming VXLAN packets that match our VXLAN port to ensure they
 		// come from a recognised host and are going to a local address on the host.
 		inputRules = append(inputRules,
 			Rule{
 				Match: Match().ProtocolNum(ProtoUDP).
 					DestPorts(uint16(r.Config.VXLANPort)).
-					SourceIPSet(r.IPSetConfigV4.NameForMainIPSet(IPSetIDAllVXLANSourceNets)).
+					SourceIPSet(r.ipSetConfig(ipVersion).NameForMainIPSet(IPSetIDAllVXLANSourceNets)).
 					DestAddrType(AddrTypeLocal),
 				Action:  r.filterAllowAction,
 				Comment: []string{"Allow VXLAN packets from whitelisted hosts"},
@@ -539,6 +539,9 @@ func (r *DefaultRuleRenderer) StaticFilterForwardChains() []*Chain {
 	// Packets will be accepted if they passed through both workload and host endpoint policy
 	// and were returned.
 
+	// Copy DNS responses that are heading to workloads to Felix, if needed.
+	rules = append(rules, r.dnsSnoopingRules()...)
+
 	// Jump to from-host-endpoint dispatch chains.
 	rules = append(rules,
 		Rule{
@@ -609,6 +612,42 @@ func (r *DefaultRuleRenderer) StaticFilterForwardAppendRules() []Rule {
 	}
 }
 
+// dnsSnoopingRules returns the rules that send DNS responses heading to local workloads, over
+// UDP or TCP, to the DNS snooping chain.
+func (r *DefaultRuleRenderer) dnsSnoopingRules() []Rule {
+	if !r.DNSSnoopingEnabled {
+		return nil
+	}
+	var rules []Rule
+	for _, prefix := range r.WorkloadIfacePrefixes {
+		for _, protocol := range []string{"udp", "tcp"} {
+			rules = append(rules, Rule{
+				Match: Match().OutInterface(prefix + "+").
+					Protocol(protocol).
+					SourcePorts(DNSPort),
+				Action: JumpAction{Target: ChainDNSSnoop},
+			})
+		}
+	}
+	return rules
+}
+
+// DNSSnoopChain returns the chain that copies the DNS responses sent to it to Felix over NFLOG.
+// It's only active while there are policy rules that match on domain names.  The NFLOG target
+// doesn't terminate the chain, so the packets are then policed as normal.
+func (r *DefaultRuleRenderer) DNSSnoopChain(active bool) *Chain {
+	var rules []Rule
+	if active {
+		rules = append(rules, Rule{
+			Action: NflogAction{Group: NFLOGDomainGroup},
+		})
+	}
+	return &Chain{
+		Name:  ChainDNSSnoop,
+		Rules: rules,
+	}
+}
+
 func (r *DefaultRuleRenderer) StaticFilterOutputChains(ipVersion uint8) []*Chain {
 	result := []*Chain{}
 	result = append(result,
@@ -626,6 +665,10 @@ func (r *DefaultRuleRenderer) StaticFilterOutputChains(ipVersion uint8) []*Chain
 func (r *DefaultRuleRenderer) filterOutputChain(ipVersion uint8) *Chain {
 	var rules []Rule
 
+	// Copy DNS responses that are heading to workloads, from a DNS server on this host, to
+	// Felix.  This must come before we accept any packets below.
+	rules = append(rules, r.dnsSnoopingRules()...)
+
 	// Accept immediately if we've already accepted this packet in the raw or mangle table.
 	rules = append(rules, r.acceptAlreadyAccepted()...)
 
@@ -682,7 +725,7 @@ func (r *DefaultRuleRenderer) filterOutputChain(ipVersion uint8) *Chain {
 		)
 	}
 
-	if ipVersion == 4 && r.VXLANEnabled {
+	if r.vxlanEnabled(ipVersion) {
 		// When VXLAN is enabled, auto-allow VXLAN traffic to other Calico nodes.  Without this,
 		// it's too easy to make a host policy that blocks VXLAN traffic, resulting in very confusing
 		// connectivity problems.
@@ -691,7 +734,7 @@ func (r *DefaultRuleRenderer) filterOutputChain(ipVersion uint8) *Chain {
 				Match: Match().ProtocolNum(ProtoUDP).
 					DestPorts(uint16(r.Config.VXLANPort)).
 					SrcAddrType(AddrTypeLocal, false).
-					DestIPSet(r.IPSetConfigV4.NameForMainIPSet(IPSetIDAllVXLANSourceNets)),
+					DestIPSet(r.ipSetConfig(ipVersion).NameForMainIPSet(IPSetIDAllVXLANSourceNets)),
 				Action:  r.filterAllowAction,
 				Comment: []string{"Allow VXLAN packets to other whitelisted hosts"},
 			},
@@ -793,11 +836,17 @@ func (r *DefaultRuleRenderer) StaticNATPostroutingChains(ipVersion uint8) []*Cha
 	if ipVersion == 4 && r.VXLANEnabled && len(r.VXLANTunnelAddress) > 0 {
 		tunnelIfaces = append(tunnelIfaces, "vxlan.calico")
 	}
+	if ipVersion == 6 && r.VXLANEnabledV6 && len(r.VXLANTunnelAddressV6) > 0 {
+		tunnelIfaces = append(tunnelIfaces, "vxlan-v6.calico")
+	}
 	if ipVersion == 4 && r.WireguardEnabled && len(r.WireguardInterfaceName) > 0 {
 		// Wireguard is assigned an IP dynamically and without restarting Felix. Just add the interface if we have
 		// wireguard enabled.
 		tunnelIfaces = append(tunnelIfaces, r.WireguardInterfaceName)
 	}
+	if ipVersion == 6 && r.WireguardEnabledV6 && len(r.WireguardInterfaceNameV6) > 0 {
+		tunnelIfaces = append(tunnelIfaces, r.WireguardInterfaceNameV6)
+	}
 
 	for _, tunnel := range tunnelIfaces {
 		// Add a rule to catch packets that are being sent down a tunnel from an
@@ -993,7 +1042,7 @@ func (r *DefaultRuleRenderer) StaticRawTableChains(ipVersion uint8) []*Chain {
 		r.failsafeInChain("raw", ipVersion),
 		r.failsafeOutChain("raw", ipVersion),
 		r.StaticRawPreroutingChain(ipVersion),
-		r.WireguardIncomingMarkChain(),
+		r.WireguardIncomingMarkChain(ipVersion),
 		r.StaticRawOutputChain(0),
 	}
 }
@@ -1043,7 +1092,9 @@ func (r *DefaultRuleRenderer) StaticRawPreroutingChain(ipVersion uint8) *Chain {
 	)
 
 	// Set a mark on encapsulated packets coming from WireGuard to ensure the RPF check allows it
-	if ipVersion == 4 && r.WireguardEnabled && len(r.WireguardInterfaceName) > 0 && r.Config.WireguardEncryptHostTraffic {
+	wireguardEnabled := (ipVersion == 4 && r.WireguardEnabled && len(r.WireguardInterfaceName) > 0) ||
+		(ipVersion == 6 && r.WireguardEnabledV6 && len(r.WireguardInterfaceNameV6) > 0)
+	if wireguardEnabled && r.Config.WireguardEncryptHostTraffic {
 		log.Debug("Adding Wireguard iptables rule")
 		rules = append(rules, Rule{
 			Match:  nil,
@@ -1135,14 +1186,18 @@ func (r *DefaultRuleRenderer) allCalicoMarkBits() uint32 {
 		r.IptablesMarkScratch1
 }
 
-func (r *DefaultRuleRenderer) WireguardIncomingMarkChain() *Chain {
+func (r *DefaultRuleRenderer) WireguardIncomingMarkChain(ipVersion uint8) *Chain {
+	wireguardIfaceName := r.WireguardInterfaceName
+	if ipVersion == 6 {
+		wireguardIfaceName = r.WireguardInterfaceNameV6
+	}
 	rules := []Rule{
 		{
 			Match:  Match().InInterface("lo"),
 			Action: ReturnAction{},
 		},
 		{
-			Match:  Match().InInterface(r.WireguardInterfaceName),
+			Match:  Match().InInterface(wireguardIfaceName),
 			Action: ReturnAction{},
 		},
 	}
@@ -984,6 +984,32 @@ var _ = Describe("Static", func() {
 				})
 			})
 
+			Describe("with IPv6 VXLAN enabled and tunnel IP", func() {
+				BeforeEach(func() {
+					conf.VXLANEnabledV6 = true
+					conf.VXLANTunnelAddressV6 = net.ParseIP("fd00::1")
+				})
+
+				It("IPv6: Should return expected NAT postrouting chain", func() {
+					Expect(rr.StaticNATPostroutingChains(6)).To(Equal([]*Chain{
+						{
+							Name: "cali-POSTROUTING",
+							Rules: []Rule{
+								{Action: JumpAction{Target: "cali-fip-snat"}},
+								{Action: JumpAction{Target: "cali-nat-outgoing"}},
+								{
+									Match: Match().
+										OutInterface("vxlan-v6.calico").
+										NotSrcAddrType(AddrTypeLocal, true).
+										SrcAddrType(AddrTypeLocal, false),
+									Action: MasqAction{},
+								},
+							},
+						},
+					}))
+				})
+			})
+
 			It("IPv4: Should return expected NAT postrouting chain", func() {
 				Expect(rr.StaticNATPostroutingChains(6)).To(Equal([]*Chain{
 					{
@@ -1414,6 +1440,130 @@ var _ = Describe("Static", func() {
 			}))
 		})
 	})
+
+	Describe("with IPv6 WireGuard enabled", func() {
+		BeforeEach(func() {
+			conf = Config{
+				WorkloadIfacePrefixes:       []string{"cali"},
+				IPSetConfigV4:               ipsets.NewIPVersionConfig(ipsets.IPFamilyV4, "cali", nil, nil),
+				IPSetConfigV6:               ipsets.NewIPVersionConfig(ipsets.IPFamilyV6, "cali", nil, nil),
+				IptablesMarkAccept:          0x10,
+				IptablesMarkPass:            0x20,
+				IptablesMarkScratch0:        0x40,
+				IptablesMarkScratch1:        0x80,
+				IptablesMarkEndpoint:        0xff00,
+				IptablesMarkNonCaliEndpoint: 0x100,
+				WireguardEnabledV6:          true,
+				WireguardInterfaceNameV6:    "wg-v6.cali",
+				WireguardIptablesMark:       0x100000,
+				WireguardListeningPortV6:    51821,
+				WireguardEncryptHostTraffic: true,
+				RouteSource:                 "WorkloadIPs",
+			}
+		})
+
+		It("should include the expected WireGuard PREROUTING chain in the IPv6 raw chains", func() {
+			Expect(findChain(rr.StaticRawTableChains(6), "cali-PREROUTING").Rules[:2]).To(Equal([]Rule{
+				{Match: nil,
+					Action: ClearMarkAction{Mark: 0xf0}},
+				{Match: nil,
+					Action: JumpAction{Target: "cali-wireguard-incoming-mark"}},
+			}))
+			Expect(findChain(rr.StaticRawTableChains(6), "cali-wireguard-incoming-mark")).To(Equal(&Chain{
+				Name: "cali-wireguard-incoming-mark",
+				Rules: []Rule{
+					{Match: Match().InInterface("lo"),
+						Action: ReturnAction{}},
+					{Match: Match().InInterface("wg-v6.cali"),
+						Action: ReturnAction{}},
+					{Match: Match().InInterface("cali+"),
+						Action: ReturnAction{}},
+					{Match: nil,
+						Action: SetMarkAction{Mark: 0x100000}},
+				},
+			}))
+		})
+
+		It("should not jump to the WireGuard chain in the IPv4 raw chains", func() {
+			Expect(findChain(rr.StaticRawTableChains(4), "cali-PREROUTING").Rules).NotTo(ContainElement(
+				Rule{Action: JumpAction{Target: "cali-wireguard-incoming-mark"}}))
+		})
+	})
+
+	Describe("with DNS snooping enabled", func() {
+		BeforeEach(func() {
+			conf = Config{
+				WorkloadIfacePrefixes:       []string{"cali", "tap"},
+				IPSetConfigV4:               ipsets.NewIPVersionConfig(ipsets.IPFamilyV4, "cali", nil, nil),
+				IPSetConfigV6:               ipsets.NewIPVersionConfig(ipsets.IPFamilyV6, "cali", nil, nil),
+				IptablesMarkAccept:          0x10,
+				IptablesMarkPass:            0x20,
+				IptablesMarkScratch0:        0x40,
+				IptablesMarkScratch1:        0x80,
+				IptablesMarkEndpoint:        0xff00,
+				IptablesMarkNonCaliEndpoint: 0x100,
+				DNSSnoopingEnabled:          true,
+			}
+		})
+
+		expSnoopRules := []Rule{
+			{Match: Match().OutInterface("cali+").Protocol("udp").SourcePorts(53),
+				Action: JumpAction{Target: "cali-dns-snoop"}},
+			{Match: Match().OutInterface("cali+").Protocol("tcp").SourcePorts(53),
+				Action: JumpAction{Target: "cali-dns-snoop"}},
+			{Match: Match().OutInterface("tap+").Protocol("udp").SourcePorts(53),
+				Action: JumpAction{Target: "cali-dns-snoop"}},
+			{Match: Match().OutInterface("tap+").Protocol("tcp").SourcePorts(53),
+				Action: JumpAction{Target: "cali-dns-snoop"}},
+		}
+
+		for _, ipVersion := range []uint8{4, 6} {
+			ipVersion := ipVersion
+			It("should snoop on DNS responses to workloads at the start of the forward chain", func() {
+				chain := findChain(rr.StaticFilterTableChains(ipVersion), "cali-FORWARD")
+				Expect(chain.Rules[:4]).To(Equal(expSnoopRules))
+				Expect(chain.Rules[4]).To(Equal(Rule{Action: ClearMarkAction{Mark: 0xe0}}))
+			})
+			It("should snoop on DNS responses to workloads at the start of the output chain", func() {
+				chain := findChain(rr.StaticFilterTableChains(ipVersion), "cali-OUTPUT")
+				Expect(chain.Rules[:4]).To(Equal(expSnoopRules))
+			})
+		}
+
+		It("should only copy DNS responses to Felix while the snooping chain is active", func() {
+			Expect(rr.DNSSnoopChain(true)).To(Equal(&Chain{
+				Name:  "cali-dns-snoop",
+				Rules: []Rule{{Action: NflogAction{Group: 3}}},
+			}))
+			Expect(rr.DNSSnoopChain(false)).To(Equal(&Chain{
+				Name: "cali-dns-snoop",
+			}))
+		})
+	})
+
+	Describe("with DNS snooping disabled", func() {
+		BeforeEach(func() {
+			conf = Config{
+				WorkloadIfacePrefixes:       []string{"cali"},
+				IPSetConfigV4:               ipsets.NewIPVersionConfig(ipsets.IPFamilyV4, "cali", nil, nil),
+				IPSetConfigV6:               ipsets.NewIPVersionConfig(ipsets.IPFamilyV6, "cali", nil, nil),
+				IptablesMarkAccept:          0x10,
+				IptablesMarkPass:            0x20,
+				IptablesMarkScratch0:        0x40,
+				IptablesMarkScratch1:        0x80,
+				IptablesMarkEndpoint:        0xff00,
+				IptablesMarkNonCaliEndpoint: 0x100,
+			}
+		})
+
+		It("should not snoop on DNS responses", func() {
+			for _, chain := range rr.StaticFilterTableChains(4) {
+				for _, rule := range chain.Rules {
+					Expect(rule.Action).NotTo(Equal(JumpAction{Target: "cali-dns-snoop"}))
+				}
+			}
+		})
+	})
 })
 
 func findChain(chains []*Chain, name string) *Chain {
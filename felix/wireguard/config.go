@@ -5,13 +5,20 @@ import "time"
 type Config struct {
 	// Wireguard configuration
 	Enabled             bool
+	EnabledV6           bool
 	ListeningPort       int
+	ListeningPortV6     int
 	FirewallMark        int
 	RoutingRulePriority int
 	RoutingTableIndex   int
 	InterfaceName       string
+	InterfaceNameV6     string
 	MTU                 int
+	MTUV6               int
 	RouteSource         string
 	EncryptHostTraffic  bool
 	PersistentKeepAlive time.Duration
+	KeyRotationInterval time.Duration
+	PendingKeyFile      string
+	PendingKeyFileV6    string
 }
@@ -18,11 +18,17 @@ package wireguard
 
 import (
 	"errors"
+	"hash/fnv"
+	"io/ioutil"
 	"net"
 	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 	"github.com/vishvananda/netlink"
 	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
@@ -48,11 +54,34 @@ const (
 	wireguardClientRetryInterval = 10
 
 	wireguardType       = "wireguard"
-	ipVersion           = 4
-	ipPrefixLen         = 32
 	allSrcValidMarkPath = "/proc/sys/net/ipv4/conf/all/src_valid_mark"
+
+	// The minimum delay returned by RescheduleAfter.  Prevents a busy loop if generating our next key is overdue.
+	minKeyRotationReschedule = 100 * time.Millisecond
+
+	// The longest we wait for all of our peers to apply our next key before we switch over to it anyway.
+	keyRotationSwitchTimeout = 10 * time.Minute
+
+	// The minimum interval between status updates for changes to the pending keys of our peers that we have applied.
+	// Every node publishes these for the key rotation of every other node, so this limits the number of writes to the
+	// node resources when many nodes are rotating their keys.
+	appliedPendingKeysPublishInterval = time.Minute
+
+	// While a peer's pending key is programmed we keep trying to handshake with it, which only succeeds once the peer
+	// has switched over to it, and we check for a completed handshake this often.
+	pendingPeerKeepAlive    = 5 * time.Second
+	pendingPeerPollInterval = time.Second
 )
 
+var countForcedKeyRotations = prometheus.NewCounter(prometheus.CounterOpts{
+	Name: "felix_wireguard_forced_key_rotations",
+	Help: "Number of key rotations that switched to the next key before all peers had applied it.",
+})
+
+func init() {
+	prometheus.MustRegister(countForcedKeyRotations)
+}
+
 var (
 	ErrUpdateFailed                = errors.New("netlink update operation failed")
 	ErrNotSupportedTooManyFailures = errors.New("operation not supported (too many failures)")
@@ -68,7 +97,7 @@ type noOpConnTrack struct{}
 func (*noOpConnTrack) RemoveConntrackFlows(ipVersion uint8, ipAddr net.IP) {}
 
 type nodeData struct {
-	ipv4EndpointAddr      ip.Addr
+	endpointAddr          ip.Addr
 	publicKey             wgtypes.Key
 	cidrs                 set.Set
 	programmedInWireguard bool
@@ -96,9 +125,9 @@ type nodeUpdateData struct {
 	cidrsDeleted set.Set
 
 	// Only used for nodes.
-	deleted          bool
-	ipv4EndpointAddr *ip.Addr
-	publicKey        *wgtypes.Key
+	deleted      bool
+	endpointAddr *ip.Addr
+	publicKey    *wgtypes.Key
 }
 
 func newNodeUpdateData() *nodeUpdateData {
@@ -110,8 +139,9 @@ func newNodeUpdateData() *nodeUpdateData {
 
 type Wireguard struct {
 	// Wireguard configuration (this will not change without a restart).
-	hostname string
-	config   *Config
+	hostname  string
+	config    *Config
+	ipVersion uint8
 
 	// Clients, client factories and testing shims.
 	newNetlinkClient                     func() (netlinkshim.Interface, error)
@@ -129,9 +159,26 @@ type Wireguard struct {
 	ifaceUp                            bool
 	wireguardNotSupported              bool
 	ourPublicKey                       *wgtypes.Key
-	ourIPv4InterfaceAddr               ip.Addr
+	ourInterfaceAddr                   ip.Addr
 	ourPublicKeyAgreesWithDataplaneMsg bool
 
+	// Key rotation information.
+	// - Our next private key (persisted so that it survives a restart), when we started waiting for our peers to
+	//   apply it, and the scheduled time to generate it if there is no key rotation in progress.
+	// - The pending public keys of our peers, and which of our peers' pending public keys each peer has applied.
+	// - The pending public keys of our peers that are programmed as additional wireguard peers, mapped to the endpoint
+	//   address they are programmed with.
+	// - The applied pending keys that we last published, and when we last published a change to them.
+	ourPendingPrivateKey      *wgtypes.Key
+	ourPendingPrivateKeyRead  bool
+	ourPendingKeyTime         time.Time
+	ourNextKeyRotationTime    time.Time
+	peerPendingKeys           map[string]wgtypes.Key
+	peerAppliedPendingKeys    map[string]set.Set
+	programmedPendingPeerKeys map[wgtypes.Key]ip.Addr
+	publishedAppliedKeys      []wgtypes.Key
+	appliedKeysPublishTime    time.Time
+
 	// Local workload information
 	localIPs          set.Set
 	localCIDRs        set.Set
@@ -155,22 +202,28 @@ type Wireguard struct {
 	routetable *routetable.RouteTable
 	routerule  *routerule.RouteRules
 
-	// Callback function used to notify of public key updates for the local nodeData
-	statusCallback func(publicKey wgtypes.Key) error
+	// Callback function used to notify of public key updates for the local nodeData.  The pending public key is zero
+	// unless a key rotation is in progress.  The applied pending keys are the pending public keys of our peers that
+	// are programmed in wireguard.
+	statusCallback func(publicKey, pendingPublicKey wgtypes.Key, appliedPendingKeys []wgtypes.Key) error
 	opRecorder     logutils.OpRecorder
 }
 
+// New creates a wireguard manager for the given IP version.  Each IP version uses its own wireguard device, which
+// is configured from the IPv4 or IPv6 settings in the supplied config.
 func New(
 	hostname string,
 	config *Config,
+	ipVersion uint8,
 	netlinkTimeout time.Duration,
 	deviceRouteProtocol netlink.RouteProtocol,
-	statusCallback func(publicKey wgtypes.Key) error,
+	statusCallback func(publicKey, pendingPublicKey wgtypes.Key, appliedPendingKeys []wgtypes.Key) error,
 	opRecorder logutils.OpRecorder,
 ) *Wireguard {
 	return NewWithShims(
 		hostname,
 		config,
+		ipVersion,
 		netlinkshim.NewRealNetlink,
 		netlinkshim.NewRealNetlink,
 		netlinkshim.NewRealNetlink,
@@ -187,6 +240,7 @@ func New(
 func NewWithShims(
 	hostname string,
 	config *Config,
+	ipVersion uint8,
 	newRoutetableNetlink func() (netlinkshim.Interface, error),
 	newRouteRuleNetlink func() (netlinkshim.Interface, error),
 	newWireguardNetlink func() (netlinkshim.Interface, error),
@@ -194,12 +248,36 @@ func NewWithShims(
 	netlinkTimeout time.Duration,
 	timeShim timeshim.Interface,
 	deviceRouteProtocol netlink.RouteProtocol,
-	statusCallback func(publicKey wgtypes.Key) error,
+	statusCallback func(publicKey, pendingPublicKey wgtypes.Key, appliedPendingKeys []wgtypes.Key) error,
 	opRecorder logutils.OpRecorder,
 ) *Wireguard {
+	if ipVersion != 4 && ipVersion != 6 {
+		log.WithField("ipVersion", ipVersion).Panic("Unknown IP version")
+	}
+	w := &Wireguard{
+		hostname:             hostname,
+		config:               config,
+		ipVersion:            ipVersion,
+		newNetlinkClient:     newWireguardNetlink,
+		newWireguardClient:   newWireguardDevice,
+		time:                 timeShim,
+		nodes:                map[string]*nodeData{},
+		cidrToNodeName:       map[ip.CIDR]string{},
+		publicKeyToNodeNames: map[wgtypes.Key]set.Set{},
+		nodeUpdates:          map[string]*nodeUpdateData{},
+		statusCallback:       statusCallback,
+		localIPs:             set.New(),
+		localCIDRs:           set.New(),
+		opRecorder:           opRecorder,
+
+		peerPendingKeys:           map[string]wgtypes.Key{},
+		peerAppliedPendingKeys:    map[string]set.Set{},
+		programmedPendingPeerKeys: map[wgtypes.Key]ip.Addr{},
+	}
+
 	// Create routetable. We provide dummy callbacks for ARP and conntrack processing.
-	rt := routetable.NewWithShims(
-		[]string{"^" + config.InterfaceName + "$", routetable.InterfaceNone},
+	w.routetable = routetable.NewWithShims(
+		[]string{"^" + w.interfaceName() + "$", routetable.InterfaceNone},
 		ipVersion,
 		newRoutetableNetlink,
 		false, // vxlan
@@ -215,7 +293,7 @@ func NewWithShims(
 	)
 	// Create routerule.
 	rr, err := routerule.New(
-		ipVersion,
+		int(ipVersion),
 		set.From(config.RoutingTableIndex),
 		routerule.RulesMatchSrcFWMarkTable,
 		routerule.RulesMatchSrcFWMarkTable,
@@ -225,33 +303,66 @@ func NewWithShims(
 		},
 		opRecorder,
 	)
-	if err != nil && config.Enabled {
+	if err != nil && w.enabled() {
 		// Wireguard is enabled, but could not create a routerule manager. This is unexpected.
 		log.WithError(err).Panic("Unexpected error creating rule manager")
 	}
+	w.routerule = rr
 
-	return &Wireguard{
-		hostname:             hostname,
-		config:               config,
-		newNetlinkClient:     newWireguardNetlink,
-		newWireguardClient:   newWireguardDevice,
-		time:                 timeShim,
-		nodes:                map[string]*nodeData{},
-		cidrToNodeName:       map[ip.CIDR]string{},
-		publicKeyToNodeNames: map[wgtypes.Key]set.Set{},
-		nodeUpdates:          map[string]*nodeUpdateData{},
-		routetable:           rt,
-		routerule:            rr,
-		statusCallback:       statusCallback,
-		localIPs:             set.New(),
-		localCIDRs:           set.New(),
-		opRecorder:           opRecorder,
+	return w
+}
+
+// enabled returns whether wireguard is enabled for the IP version of this manager.
+func (w *Wireguard) enabled() bool {
+	if w.ipVersion == 6 {
+		return w.config.EnabledV6
+	}
+	return w.config.Enabled
+}
+
+// interfaceName returns the name of the wireguard device for the IP version of this manager.
+func (w *Wireguard) interfaceName() string {
+	if w.ipVersion == 6 {
+		return w.config.InterfaceNameV6
+	}
+	return w.config.InterfaceName
+}
+
+// listeningPort returns the wireguard listening port for the IP version of this manager.
+func (w *Wireguard) listeningPort() int {
+	if w.ipVersion == 6 {
+		return w.config.ListeningPortV6
+	}
+	return w.config.ListeningPort
+}
+
+// pendingKeyFile returns the file used to persist our pending private key for the IP version of this manager.
+func (w *Wireguard) pendingKeyFile() string {
+	if w.ipVersion == 6 {
+		return w.config.PendingKeyFileV6
+	}
+	return w.config.PendingKeyFile
+}
+
+// mtu returns the configured MTU of the wireguard device for the IP version of this manager.
+func (w *Wireguard) mtu() int {
+	if w.ipVersion == 6 {
+		return w.config.MTUV6
+	}
+	return w.config.MTU
+}
+
+// hostPrefixLen returns the prefix length of a single address for the IP version of this manager.
+func (w *Wireguard) hostPrefixLen() uint8 {
+	if w.ipVersion == 6 {
+		return 128
 	}
+	return 32
 }
 
 func (w *Wireguard) OnIfaceStateChanged(ifaceName string, state ifacemonitor.State) {
-	logCxt := log.WithField("wireguardIfaceName", w.config.InterfaceName)
-	if w.config.InterfaceName != ifaceName {
+	logCxt := log.WithField("wireguardIfaceName", w.interfaceName())
+	if w.interfaceName() != ifaceName {
 		logCxt.WithField("ifaceName", ifaceName).Debug("Ignoring interface state change, not the wireguard interface.")
 		return
 	}
@@ -271,10 +382,10 @@ func (w *Wireguard) OnIfaceStateChanged(ifaceName string, state ifacemonitor.Sta
 	w.routetable.OnIfaceStateChanged(ifaceName, state)
 }
 
-func (w *Wireguard) EndpointUpdate(name string, ipv4Addr ip.Addr) {
-	logCxt := log.WithFields(log.Fields{"name": name, "ipv4Addr": ipv4Addr})
+func (w *Wireguard) EndpointUpdate(name string, addr ip.Addr) {
+	logCxt := log.WithFields(log.Fields{"name": name, "addr": addr, "ipVersion": w.ipVersion})
 	logCxt.Debug("EndpointUpdate")
-	if !w.config.Enabled {
+	if !w.enabled() {
 		logCxt.Debug("Not enabled - ignoring")
 		return
 	} else if name == w.hostname {
@@ -283,12 +394,12 @@ func (w *Wireguard) EndpointUpdate(name string, ipv4Addr ip.Addr) {
 	}
 
 	update := w.getOrInitNodeUpdateData(name)
-	if existing, ok := w.nodes[name]; ok && existing.ipv4EndpointAddr == ipv4Addr {
-		logCxt.Debug("Update contains unchanged IPv4 address")
-		update.ipv4EndpointAddr = nil
+	if existing, ok := w.nodes[name]; ok && existing.endpointAddr == addr {
+		logCxt.Debug("Update contains unchanged endpoint address")
+		update.endpointAddr = nil
 	} else {
-		logCxt.Debug("Update contains new IPv4 address")
-		update.ipv4EndpointAddr = &ipv4Addr
+		logCxt.Debug("Update contains new endpoint address")
+		update.endpointAddr = &addr
 	}
 	w.setNodeUpdate(name, update)
 }
@@ -296,7 +407,7 @@ func (w *Wireguard) EndpointUpdate(name string, ipv4Addr ip.Addr) {
 func (w *Wireguard) EndpointRemove(name string) {
 	logCxt := log.WithField("name", name)
 	logCxt.Debug("EndpointRemove")
-	if !w.config.Enabled {
+	if !w.enabled() {
 		logCxt.Debug("Not enabled - ignoring")
 		return
 	} else if name == w.hostname {
@@ -322,7 +433,7 @@ func (w *Wireguard) EndpointRemove(name string) {
 func (w *Wireguard) RouteUpdate(name string, cidr ip.CIDR) {
 	logCxt := log.WithFields(log.Fields{"name": name, "cidr": cidr})
 	logCxt.Debug("RouteUpdate")
-	if !w.config.Enabled {
+	if !w.enabled() {
 		logCxt.Debug("Not enabled - ignoring")
 		return
 	}
@@ -351,7 +462,7 @@ func (w *Wireguard) RouteUpdate(name string, cidr ip.CIDR) {
 func (w *Wireguard) RouteRemove(cidr ip.CIDR) {
 	logCxt := log.WithField("cidr", cidr)
 	logCxt.Debug("RouteRemove")
-	if !w.config.Enabled {
+	if !w.enabled() {
 		log.Debug("Not enabled - ignoring")
 		return
 	}
@@ -391,7 +502,7 @@ func (w *Wireguard) localWorkloadCIDRAdd(cidr ip.CIDR) {
 	// Split the local CIDRs into actual /32 workload IPs and the CIDR blocks for the node. We assume the CIDR blocks
 	// are not overlapping, and so we add rules for each CIDR to route to wireguard, and only include the /32 workload
 	// IPs if not covered by the CIDR blocks.
-	if cidr.Prefix() == ipPrefixLen {
+	if cidr.Prefix() == w.hostPrefixLen() {
 		w.localIPs.Add(cidr.Addr())
 	} else {
 		w.localCIDRs.Add(cidr)
@@ -424,7 +535,7 @@ func (w *Wireguard) localWorkloadCIDRAdd(cidr ip.CIDR) {
 // we only need to update the local CIDRs if the CIDR being removed is one of the ones programmed.
 func (w *Wireguard) localWorkloadCIDRRemove(cidr ip.CIDR) {
 	log.WithField("cidr", cidr).Debug("localWorkloadCIDRRemove")
-	if cidr.Prefix() == ipPrefixLen {
+	if cidr.Prefix() == w.hostPrefixLen() {
 		w.localIPs.Discard(cidr.Addr())
 	} else {
 		w.localCIDRs.Discard(cidr)
@@ -475,10 +586,10 @@ func (w *Wireguard) peerAllowedCIDRRemove(name string, cidr ip.CIDR) {
 	w.setNodeUpdate(name, update)
 }
 
-func (w *Wireguard) EndpointWireguardUpdate(name string, publicKey wgtypes.Key, ipv4InterfaceAddr ip.Addr) {
-	logCxt := log.WithFields(log.Fields{"node": name, "publicKey": publicKey, "ipv4InterfaceAddr": ipv4InterfaceAddr})
+func (w *Wireguard) EndpointWireguardUpdate(name string, publicKey wgtypes.Key, interfaceAddr ip.Addr) {
+	logCxt := log.WithFields(log.Fields{"node": name, "publicKey": publicKey, "interfaceAddr": interfaceAddr, "ipVersion": w.ipVersion})
 	logCxt.Debug("EndpointWireguardUpdate")
-	if !w.config.Enabled {
+	if !w.enabled() {
 		log.Debug("Not enabled - ignoring")
 		return
 	}
@@ -491,9 +602,9 @@ func (w *Wireguard) EndpointWireguardUpdate(name string, publicKey wgtypes.Key,
 			logCxt.Debug("Stored public key does not match key queried from dataplane")
 			w.ourPublicKeyAgreesWithDataplaneMsg = false
 		}
-		if w.ourIPv4InterfaceAddr != ipv4InterfaceAddr {
+		if w.ourInterfaceAddr != interfaceAddr {
 			logCxt.Debug("Local interface addr updated")
-			w.ourIPv4InterfaceAddr = ipv4InterfaceAddr
+			w.ourInterfaceAddr = interfaceAddr
 			w.inSyncInterfaceAddr = false
 		}
 		return
@@ -517,7 +628,7 @@ func (w *Wireguard) EndpointWireguardUpdate(name string, publicKey wgtypes.Key,
 func (w *Wireguard) EndpointWireguardRemove(name string) {
 	logCxt := log.WithField("node", name)
 	logCxt.Debug("EndpointWireguardRemove")
-	if !w.config.Enabled {
+	if !w.enabled() {
 		logCxt.Debug("Not enabled - ignoring")
 		return
 	}
@@ -525,6 +636,8 @@ func (w *Wireguard) EndpointWireguardRemove(name string) {
 		w.EndpointWireguardUpdate(name, zeroKey, nil)
 		return
 	}
+	delete(w.peerPendingKeys, name)
+	delete(w.peerAppliedPendingKeys, name)
 
 	// If there is no existing peer and no existing update then exit.
 	if _, ok := w.nodes[name]; ok {
@@ -540,6 +653,91 @@ func (w *Wireguard) EndpointWireguardRemove(name string) {
 	w.setNodeUpdate(name, update)
 }
 
+// EndpointWireguardKeyRotationUpdate stores the public key that a node will switch over to once all of its peers have
+// applied it, and the pending public keys of other nodes that the node has applied.  A zero pending key indicates the
+// node does not have a key rotation in progress.
+func (w *Wireguard) EndpointWireguardKeyRotationUpdate(
+	name string, pendingPublicKey wgtypes.Key, appliedPendingKeys []wgtypes.Key,
+) {
+	logCxt := log.WithFields(log.Fields{
+		"node": name, "pendingPublicKey": pendingPublicKey, "appliedPendingKeys": appliedPendingKeys,
+		"ipVersion": w.ipVersion,
+	})
+	logCxt.Debug("EndpointWireguardKeyRotationUpdate")
+	if !w.enabled() {
+		log.Debug("Not enabled - ignoring")
+		return
+	}
+
+	if name == w.hostname {
+		if w.ourPendingKey() != pendingPublicKey || !keySetMatches(w.publishedAppliedKeys, appliedPendingKeys) {
+			// Our pending key or applied keys do not match those stored. Flag as not in-sync so that we publish our
+			// current state.
+			logCxt.Debug("Stored pending public key or applied pending keys do not match our state")
+			w.ourPublicKeyAgreesWithDataplaneMsg = false
+		}
+		return
+	}
+
+	if pendingPublicKey == zeroKey {
+		delete(w.peerPendingKeys, name)
+	} else {
+		logCxt.Debug("Storing pending public key")
+		w.peerPendingKeys[name] = pendingPublicKey
+	}
+	if len(appliedPendingKeys) == 0 {
+		delete(w.peerAppliedPendingKeys, name)
+	} else {
+		applied := set.New()
+		for _, key := range appliedPendingKeys {
+			applied.Add(key)
+		}
+		w.peerAppliedPendingKeys[name] = applied
+	}
+}
+
+// RescheduleAfter returns the time until we next need to apply to progress a key rotation: to generate our next key,
+// to switch over to it if our peers have not all applied it in time, to publish a change to the pending keys of our
+// peers that we have applied, or to check whether a peer has switched over to its pending key.  Returns 0 if there is
+// nothing scheduled.
+func (w *Wireguard) RescheduleAfter() time.Duration {
+	if !w.enabled() || w.wireguardNotSupported {
+		return 0
+	}
+	appliedKeysChanged := !keySetMatches(w.ourAppliedPendingKeys(), w.publishedAppliedKeys)
+	if w.config.KeyRotationInterval <= 0 && !appliedKeysChanged && len(w.programmedPendingPeerKeys) == 0 {
+		return 0
+	}
+
+	now := w.time.Now()
+	var next time.Time
+	earliest := func(t time.Time) {
+		if next.IsZero() || t.Before(next) {
+			next = t
+		}
+	}
+	if w.config.KeyRotationInterval > 0 {
+		switch {
+		case w.ourPendingPrivateKey != nil:
+			earliest(w.ourPendingKeyTime.Add(keyRotationSwitchTimeout))
+		case !w.ourNextKeyRotationTime.IsZero():
+			earliest(w.ourNextKeyRotationTime)
+		default:
+			earliest(w.nextKeyRotationTime(now))
+		}
+	}
+	if appliedKeysChanged {
+		earliest(w.appliedKeysPublishTime.Add(appliedPendingKeysPublishInterval))
+	}
+	if len(w.programmedPendingPeerKeys) > 0 {
+		earliest(now.Add(pendingPeerPollInterval))
+	}
+	if delay := next.Sub(now); delay > minKeyRotationReschedule {
+		return delay
+	}
+	return minKeyRotationReschedule
+}
+
 func (w *Wireguard) QueueResync() {
 	log.Debug("Queueing a resync of wireguard configuration")
 	if w.opRecorder != nil {
@@ -566,16 +764,33 @@ func (w *Wireguard) QueueResync() {
 func (w *Wireguard) Apply() (err error) {
 	// If the key is not in-sync and is known then send as a status update.
 	defer func() {
-		// If we need to send the key then send on the callback method.
-		if !w.ourPublicKeyAgreesWithDataplaneMsg && w.ourPublicKey != nil {
-			log.WithField("ourPublicKey", *w.ourPublicKey).Info("Public key out of sync or updated")
-			if errKey := w.statusCallback(*w.ourPublicKey); errKey != nil {
+		// If we need to send the key then send on the callback method.  Changes to the pending keys of our peers that
+		// we have applied are only sent once every appliedPendingKeysPublishInterval, unless sent with another update.
+		ourPendingPublicKey, ourAppliedPendingKeys := w.ourPendingKey(), w.ourAppliedPendingKeys()
+		appliedKeysChanged := !keySetMatches(ourAppliedPendingKeys, w.publishedAppliedKeys)
+		var now time.Time
+		appliedKeysDue := false
+		if appliedKeysChanged {
+			now = w.time.Now()
+			appliedKeysDue = !now.Before(w.appliedKeysPublishTime.Add(appliedPendingKeysPublishInterval))
+		}
+		if (!w.ourPublicKeyAgreesWithDataplaneMsg || appliedKeysDue) && w.ourPublicKey != nil {
+			log.WithFields(log.Fields{
+				"ourPublicKey":          *w.ourPublicKey,
+				"ourPendingPublicKey":   ourPendingPublicKey,
+				"ourAppliedPendingKeys": ourAppliedPendingKeys,
+			}).Info("Public key out of sync or updated")
+			if errKey := w.statusCallback(*w.ourPublicKey, ourPendingPublicKey, ourAppliedPendingKeys); errKey != nil {
 				err = errKey
 				return
 			}
 
 			// We have sent the key status update.
 			w.ourPublicKeyAgreesWithDataplaneMsg = true
+			w.publishedAppliedKeys = ourAppliedPendingKeys
+			if appliedKeysChanged {
+				w.appliedKeysPublishTime = now
+			}
 		}
 	}()
 
@@ -587,7 +802,7 @@ func (w *Wireguard) Apply() (err error) {
 	}
 
 	// If wireguard is not enabled, then short-circuit the processing - ensure config is deleted.
-	if !w.config.Enabled {
+	if !w.enabled() {
 		log.Debug("Wireguard is not enabled, skipping sync")
 		if !w.inSyncWireguard {
 			log.Debug("Wireguard is not in-sync - verifying wireguard configuration is removed")
@@ -595,8 +810,10 @@ func (w *Wireguard) Apply() (err error) {
 				return err
 			}
 
-			// Zero out the public key.
+			// Zero out the public key and discard any pending key.
 			w.ourPublicKey = &zeroKey
+			w.discardPendingPrivateKey()
+			w.programmedPendingPeerKeys = map[wgtypes.Key]ip.Addr{}
 			w.inSyncWireguard = true
 		}
 		return nil
@@ -609,6 +826,10 @@ func (w *Wireguard) Apply() (err error) {
 
 	// --- Wireguard is enabled ---
 
+	// Handle key rotation. This may load or generate our next key, or hand over to the next key of a peer.
+	w.updateKeyRotation()
+	w.handOverSwitchedPeerKeys()
+
 	// Process local CIDR updates. This may result in node deltas for the local node.
 	if w.localCIDRsUpdated {
 		w.nodeUpdates[w.hostname] = w.getLocalNodeCIDRUpdates()
@@ -697,7 +918,7 @@ func (w *Wireguard) Apply() (err error) {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			if errLink = w.ensureLinkAddressV4(netlinkClient); errLink == nil {
+			if errLink = w.ensureLinkAddress(netlinkClient); errLink == nil {
 				w.inSyncInterfaceAddr = true
 			}
 		}()
@@ -750,6 +971,33 @@ func (w *Wireguard) Apply() (err error) {
 				w.ourPublicKeyAgreesWithDataplaneMsg = false
 			}
 		}
+
+		// Program our peers' pending keys as additional peers so that they can switch over to them without breaking
+		// their connection to us.
+		if errWireguard = w.applyPendingPeerKeys(wireguardClient); errWireguard != nil {
+			log.WithError(errWireguard).Info("Failed to update wireguard pending peer keys")
+			return
+		}
+
+		// Switch over to our next private key once all of our peers have applied it.
+		if w.ourPendingPrivateKey != nil && w.ourPublicKey != nil && *w.ourPublicKey == w.ourPendingPrivateKey.PublicKey() {
+			// The device is already using our next private key - we must have switched over before a restart.
+			log.Info("Wireguard device is already using our next private key")
+			w.discardPendingPrivateKey()
+			w.ourPublicKeyAgreesWithDataplaneMsg = false
+		} else if w.ourPendingPrivateKey != nil && w.shouldSwitchToPendingKey() {
+			if errWireguard = w.applyWireguardConfig(wireguardClient, &wgtypes.Config{
+				PrivateKey: w.ourPendingPrivateKey,
+			}); errWireguard != nil {
+				log.WithError(errWireguard).Info("Failed to update wireguard private key")
+				return
+			}
+			publicKey = w.ourPendingPrivateKey.PublicKey()
+			log.WithField("publicKey", publicKey).Info("Private key rotated, send status notification")
+			w.ourPublicKey = &publicKey
+			w.discardPendingPrivateKey()
+			w.ourPublicKeyAgreesWithDataplaneMsg = false
+		}
 		w.inSyncWireguard = true
 	}()
 
@@ -785,6 +1033,302 @@ func (w *Wireguard) Apply() (err error) {
 	return nil
 }
 
+// updateKeyRotation loads our next private key if one was persisted before a restart, and generates our next private
+// key once a scheduled key rotation is due.  We switch over to our next key once all of our peers have applied it,
+// which is handled when the wireguard device is programmed.
+func (w *Wireguard) updateKeyRotation() {
+	if !w.ourPendingPrivateKeyRead {
+		w.ourPendingPrivateKey = w.readPendingPrivateKey()
+		w.ourPendingPrivateKeyRead = true
+		if w.ourPendingPrivateKey != nil {
+			// We don't know how long we waited for our peers before the restart, so start waiting again.
+			w.ourPendingKeyTime = w.time.Now()
+			log.WithField("pendingPublicKey", w.ourPendingPrivateKey.PublicKey()).Info("Loaded next private key for key rotation")
+			w.ourPublicKeyAgreesWithDataplaneMsg = false
+		}
+	}
+
+	if w.config.KeyRotationInterval <= 0 {
+		w.ourNextKeyRotationTime = time.Time{}
+		if w.ourPendingPrivateKey != nil {
+			log.Info("Key rotation disabled, discarding next private key")
+			w.discardPendingPrivateKey()
+			w.ourPublicKeyAgreesWithDataplaneMsg = false
+		}
+		return
+	} else if w.ourPendingPrivateKey != nil {
+		return
+	}
+
+	now := w.time.Now()
+	if w.ourNextKeyRotationTime.IsZero() {
+		w.ourNextKeyRotationTime = w.nextKeyRotationTime(now)
+	}
+	if now.Before(w.ourNextKeyRotationTime) {
+		return
+	}
+	key, err := wgtypes.GeneratePrivateKey()
+	if err != nil {
+		log.WithError(err).Error("error generating next private-key for key rotation")
+		return
+	}
+	if err := w.writePendingPrivateKey(key); err != nil {
+		// We don't publish a key that we may lose on restart, so retry next time.
+		log.WithError(err).Error("error persisting next private-key for key rotation")
+		return
+	}
+	log.WithField("pendingPublicKey", key.PublicKey()).Info("Generated next private key for key rotation")
+	w.ourPendingPrivateKey = &key
+	w.ourPendingKeyTime = now
+	w.ourNextKeyRotationTime = time.Time{}
+	w.ourPublicKeyAgreesWithDataplaneMsg = false
+}
+
+// nextKeyRotationTime returns the first scheduled key rotation time after now. Rotation times are offset by a hash of
+// the hostname so that they are stable across restarts but are spread across the nodes in the cluster.
+func (w *Wireguard) nextKeyRotationTime(now time.Time) time.Time {
+	interval := w.config.KeyRotationInterval
+	var offset time.Duration
+	if secs := uint64(interval / time.Second); secs > 0 {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(w.hostname))
+		offset = time.Duration(uint64(h.Sum32())%secs) * time.Second
+	}
+	return now.Add(-offset).Truncate(interval).Add(interval + offset)
+}
+
+// ourPendingKey returns our pending public key, or a zero key if there is no key rotation in progress.
+func (w *Wireguard) ourPendingKey() wgtypes.Key {
+	if w.ourPendingPrivateKey == nil {
+		return zeroKey
+	}
+	return w.ourPendingPrivateKey.PublicKey()
+}
+
+// ourAppliedPendingKeys returns the pending public keys of our peers that are programmed in wireguard, sorted so that
+// the published status is stable.
+func (w *Wireguard) ourAppliedPendingKeys() []wgtypes.Key {
+	var keys []wgtypes.Key
+	for key := range w.programmedPendingPeerKeys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+	return keys
+}
+
+// shouldSwitchToPendingKey returns true if we should switch over to our next private key: once every peer that is
+// programmed in wireguard has applied our pending public key, or once we have waited keyRotationSwitchTimeout for
+// them.  Peers that have not applied our pending key can't reach us, or be reached by us, until they see our new
+// public key.
+func (w *Wireguard) shouldSwitchToPendingKey() bool {
+	notApplied := w.peersNotAppliedPendingKey(w.ourPendingPrivateKey.PublicKey())
+	if len(notApplied) == 0 {
+		log.Info("All peers have applied our next key, switching to next private key")
+		return true
+	} else if w.time.Now().Before(w.ourPendingKeyTime.Add(keyRotationSwitchTimeout)) {
+		log.WithField("peers", notApplied).Debug("Waiting for peers to apply our next key")
+		return false
+	}
+	log.WithFields(log.Fields{
+		"peers":   notApplied,
+		"timeout": keyRotationSwitchTimeout,
+	}).Warn("Timed out waiting for peers to apply our next key, switching to next private key anyway; traffic " +
+		"with these peers is dropped until they see our new public key")
+	countForcedKeyRotations.Inc()
+	return true
+}
+
+// peersNotAppliedPendingKey returns the names of the peers that are programmed in wireguard but that have not applied
+// the supplied pending public key, sorted.
+func (w *Wireguard) peersNotAppliedPendingKey(key wgtypes.Key) []string {
+	var names []string
+	for name, node := range w.nodes {
+		if name == w.hostname || !w.shouldProgramWireguardPeer(name, node) {
+			continue
+		}
+		if applied := w.peerAppliedPendingKeys[name]; applied == nil || !applied.Contains(key) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// expectedPendingPeerKeys returns the pending public keys of our peers that should be programmed as additional peers,
+// mapped to the endpoint address of the peer.  These peers have no allowed IPs, so traffic is still routed using the
+// current key of the peer - they just allow the peer to complete a handshake using its next key.  Once it does, the
+// peer has switched over to its next key and we move its allowed IPs over (see handOverSwitchedPeerKeys).
+func (w *Wireguard) expectedPendingPeerKeys() map[wgtypes.Key]ip.Addr {
+	expected := map[wgtypes.Key]ip.Addr{}
+	conflicting := set.New()
+	for name, key := range w.peerPendingKeys {
+		node := w.nodes[name]
+		if node == nil || !w.shouldProgramWireguardPeer(name, node) {
+			continue
+		} else if key == node.publicKey || w.publicKeyToNodeNames[key] != nil {
+			// The key is already programmed as the current key of a node.
+			continue
+		} else if _, ok := expected[key]; ok {
+			log.WithField("pendingPublicKey", key).Info("Multiple nodes are claiming the same pending key")
+			conflicting.Add(key)
+			continue
+		}
+		expected[key] = node.endpointAddr
+	}
+	conflicting.Iter(func(item interface{}) error {
+		delete(expected, item.(wgtypes.Key))
+		return nil
+	})
+	return expected
+}
+
+// applyPendingPeerKeys programs the pending public keys of our peers as additional peers, and removes those that are
+// no longer required.
+func (w *Wireguard) applyPendingPeerKeys(wireguardClient netlinkshim.Wireguard) error {
+	expected := w.expectedPendingPeerKeys()
+	keepAlive := pendingPeerKeepAlive
+	var wireguardUpdate wgtypes.Config
+	for key, endpointAddr := range expected {
+		if programmedAddr, ok := w.programmedPendingPeerKeys[key]; ok && programmedAddr == endpointAddr {
+			continue
+		}
+		log.WithFields(log.Fields{"pendingPublicKey": key, "endpointAddr": endpointAddr}).Info("Add pending peer key to wireguard")
+		wireguardUpdate.Peers = append(wireguardUpdate.Peers, wgtypes.PeerConfig{
+			PublicKey:                   key,
+			Endpoint:                    w.endpointUDPAddr(endpointAddr.AsNetIP()),
+			ReplaceAllowedIPs:           true,
+			PersistentKeepaliveInterval: &keepAlive,
+		})
+	}
+	for key := range w.programmedPendingPeerKeys {
+		if _, ok := expected[key]; ok {
+			continue
+		} else if w.getNodeFromKey(key) != nil {
+			// The peer has switched over to this key, and the peer is now programmed as the current key of the node.
+			log.WithField("publicKey", key).Debug("Pending peer key is now the current key of a node")
+			continue
+		}
+		log.WithField("pendingPublicKey", key).Info("Remove pending peer key from wireguard")
+		wireguardUpdate.Peers = append(wireguardUpdate.Peers, wgtypes.PeerConfig{
+			PublicKey: key,
+			Remove:    true,
+		})
+	}
+	if len(wireguardUpdate.Peers) > 0 {
+		if err := w.applyWireguardConfig(wireguardClient, &wireguardUpdate); err != nil {
+			return err
+		}
+	}
+	w.programmedPendingPeerKeys = expected
+	return nil
+}
+
+// handOverSwitchedPeerKeys checks whether any of our peers has switched over to its pending key.  Only the peer has
+// the private key, so a completed handshake with the additional peer for a pending key tells us that it has.  The
+// public key of the peer is then updated straight away, which moves its allowed IPs over to its new key, rather than
+// waiting for the datastore to tell us about the switch.  Until then, its traffic would be dropped.
+func (w *Wireguard) handOverSwitchedPeerKeys() {
+	if !w.inSyncWireguard || len(w.programmedPendingPeerKeys) == 0 {
+		return
+	}
+	wireguardClient, err := w.getWireguardClient()
+	if err != nil {
+		log.WithError(err).Debug("Unable to check pending peer keys for handshakes")
+		return
+	}
+	device, err := wireguardClient.DeviceByName(w.interfaceName())
+	if err != nil {
+		log.WithError(err).Info("Unable to check pending peer keys for handshakes")
+		return
+	}
+	for _, peer := range device.Peers {
+		if _, ok := w.programmedPendingPeerKeys[peer.PublicKey]; !ok || peer.LastHandshakeTime.IsZero() {
+			continue
+		}
+		for name, key := range w.peerPendingKeys {
+			if key != peer.PublicKey {
+				continue
+			}
+			log.WithFields(log.Fields{"node": name, "publicKey": key}).Info("Peer has switched over to its pending key")
+			publicKey := key
+			update := w.getOrInitNodeUpdateData(name)
+			update.publicKey = &publicKey
+			w.setNodeUpdate(name, update)
+		}
+	}
+}
+
+// readPendingPrivateKey reads our persisted next private key. Returns nil if there is no persisted key.
+func (w *Wireguard) readPendingPrivateKey() *wgtypes.Key {
+	filename := w.pendingKeyFile()
+	if filename == "" {
+		return nil
+	}
+	data, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		log.WithError(err).WithField("file", filename).Error("error reading next private-key for key rotation")
+		return nil
+	}
+	key, err := wgtypes.ParseKey(strings.TrimSpace(string(data)))
+	if err != nil {
+		log.WithError(err).WithField("file", filename).Warn("Discarding invalid next private-key for key rotation")
+		w.removePendingPrivateKey()
+		return nil
+	}
+	return &key
+}
+
+// writePendingPrivateKey persists our next private key so that a key rotation in progress survives a restart.
+func (w *Wireguard) writePendingPrivateKey(key wgtypes.Key) error {
+	filename := w.pendingKeyFile()
+	if filename == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, []byte(key.String()), 0600)
+}
+
+// removePendingPrivateKey removes our persisted next private key.
+func (w *Wireguard) removePendingPrivateKey() {
+	filename := w.pendingKeyFile()
+	if filename == "" {
+		return
+	}
+	if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+		log.WithError(err).WithField("file", filename).Warn("Failed to remove next private-key for key rotation")
+	}
+}
+
+// discardPendingPrivateKey discards our next private key, including the persisted copy.
+func (w *Wireguard) discardPendingPrivateKey() {
+	w.ourPendingPrivateKey = nil
+	w.ourPendingPrivateKeyRead = true
+	w.removePendingPrivateKey()
+}
+
+// keySetMatches returns true if the two slices contain the same set of keys.
+func keySetMatches(a, b []wgtypes.Key) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	keys := set.New()
+	for _, key := range a {
+		keys.Add(key)
+	}
+	for _, key := range b {
+		if !keys.Contains(key) {
+			return false
+		}
+	}
+	return true
+}
+
 // setNotSupported is called when we determine wireguard is not supported.
 func (w *Wireguard) setNotSupported() {
 	// Publish a zero-key back to the calc graph.
@@ -899,7 +1443,7 @@ func (w *Wireguard) handlePeerAndRouteDeletionFromNodeUpdates(conflictingKeys se
 			// takes care of its own kernel-cache synchronization.
 			node.cidrs.Iter(func(item interface{}) error {
 				cidr := item.(ip.CIDR)
-				w.routetable.RouteRemove(w.config.InterfaceName, cidr)
+				w.routetable.RouteRemove(w.interfaceName(), cidr)
 				delete(w.cidrToNodeName, cidr)
 				logCxt.WithField("cidr", cidr).Debug("Deleting route")
 				return nil
@@ -960,9 +1504,9 @@ func (w *Wireguard) updateCacheFromNodeUpdates(conflictingKeys set.Set) {
 		logCxt := log.WithField("node", name)
 		logCxt.Debug("Updating cache from update for peer")
 		updated := false
-		if update.ipv4EndpointAddr != nil {
-			logCxt.WithField("ipv4EndpointAddr", *update.ipv4EndpointAddr).Debug("Store IPv4 address")
-			node.ipv4EndpointAddr = *update.ipv4EndpointAddr
+		if update.endpointAddr != nil {
+			logCxt.WithField("endpointAddr", *update.endpointAddr).Debug("Store endpoint address")
+			node.endpointAddr = *update.endpointAddr
 			updated = true
 		}
 		if update.publicKey != nil {
@@ -1017,7 +1561,7 @@ func (w *Wireguard) updateRouteTableFromNodeUpdates() {
 		node := w.getOrInitNodeData(name)
 		ifaceName := routetable.InterfaceNone
 		if node != nil && node.programmedInWireguard {
-			ifaceName = w.config.InterfaceName
+			ifaceName = w.interfaceName()
 		}
 		logCxt := log.WithFields(log.Fields{"node": name, "ifaceName": ifaceName})
 		update.cidrsDeleted.Iter(func(item interface{}) error {
@@ -1055,12 +1599,12 @@ func (w *Wireguard) updateRouteTableFromNodeUpdates() {
 			logCxt.Debug("Not routing to wireguard - set route type to throw")
 			targetType = routetable.TargetTypeThrow
 			ifaceName = routetable.InterfaceNone
-			deleteIfaceName = w.config.InterfaceName
+			deleteIfaceName = w.interfaceName()
 		} else {
 			// If we should route to wireguard then route to the wireguard interface. We may also need to delete the
 			// existing throw route that was used to circumvent wireguard routing.
 			logCxt.Debug("Routing to wireguard interface")
-			ifaceName = w.config.InterfaceName
+			ifaceName = w.interfaceName()
 			deleteIfaceName = routetable.InterfaceNone
 		}
 
@@ -1127,9 +1671,9 @@ func (w *Wireguard) constructWireguardDeltaFromNodeUpdates(conflictingKeys set.S
 					updatePeer = true
 				}
 
-				if update.ipv4EndpointAddr != nil || !peer.programmedInWireguard {
-					logCxt.WithField("ipv4EndpointAddr", update.ipv4EndpointAddr).Info("Peer endpoint address is updated")
-					wgpeer.Endpoint = w.endpointUDPAddr(peer.ipv4EndpointAddr.AsNetIP())
+				if update.endpointAddr != nil || !peer.programmedInWireguard {
+					logCxt.WithField("endpointAddr", update.endpointAddr).Info("Peer endpoint address is updated")
+					wgpeer.Endpoint = w.endpointUDPAddr(peer.endpointAddr.AsNetIP())
 					updatePeer = true
 				}
 
@@ -1177,7 +1721,7 @@ func (w *Wireguard) constructWireguardDeltaFromNodeUpdates(conflictingKeys set.S
 					nodeLogCxt.Debug("Not programmed in wireguard, needs to be added now")
 					wireguardUpdate.Peers = append(wireguardUpdate.Peers, wgtypes.PeerConfig{
 						PublicKey:                   peer.publicKey,
-						Endpoint:                    w.endpointUDPAddr(peer.ipv4EndpointAddr.AsNetIP()),
+						Endpoint:                    w.endpointUDPAddr(peer.endpointAddr.AsNetIP()),
 						AllowedIPs:                  peer.allowedCidrsForWireguard(),
 						PersistentKeepaliveInterval: &w.config.PersistentKeepAlive,
 					})
@@ -1200,8 +1744,8 @@ func (w *Wireguard) constructWireguardDeltaFromNodeUpdates(conflictingKeys set.S
 // update to correct any discrepancies.
 func (w *Wireguard) constructWireguardDeltaForResync(wireguardClient netlinkshim.Wireguard) (wgtypes.Key, *wgtypes.Config, error) {
 	// Get the wireguard device configuration.
-	logCxt := log.WithField("ifaceName", w.config.InterfaceName)
-	device, err := wireguardClient.DeviceByName(w.config.InterfaceName)
+	logCxt := log.WithField("ifaceName", w.interfaceName())
+	device, err := wireguardClient.DeviceByName(w.interfaceName())
 	if err != nil {
 		logCxt.WithError(err).Error("error querying wireguard configuration")
 		return zeroKey, nil, err
@@ -1215,9 +1759,10 @@ func (w *Wireguard) constructWireguardDeltaForResync(wireguardClient netlinkshim
 		wireguardUpdate.FirewallMark = &w.config.FirewallMark
 		wireguardUpdateRequired = true
 	}
-	if device.ListenPort != w.config.ListeningPort {
-		logCxt.WithFields(log.Fields{"existing": device.ListenPort, "required": w.config.ListeningPort}).Info("Update listening port")
-		wireguardUpdate.ListenPort = &w.config.ListeningPort
+	if device.ListenPort != w.listeningPort() {
+		logCxt.WithFields(log.Fields{"existing": device.ListenPort, "required": w.listeningPort()}).Info("Update listening port")
+		listeningPort := w.listeningPort()
+		wireguardUpdate.ListenPort = &listeningPort
 		wireguardUpdateRequired = true
 	}
 
@@ -1241,6 +1786,11 @@ func (w *Wireguard) constructWireguardDeltaForResync(wireguardClient netlinkshim
 	// Track which keys we have processed.
 	processedKeys := set.New()
 
+	// The pending peer keys are checked against the device below, and any discrepancies are fixed up when the pending
+	// peer keys are applied.
+	expectedPendingPeerKeys := w.expectedPendingPeerKeys()
+	w.programmedPendingPeerKeys = map[wgtypes.Key]ip.Addr{}
+
 	// Handle nodes that are configured
 	for peerIdx := range device.Peers {
 		key := device.Peers[peerIdx].PublicKey
@@ -1252,6 +1802,17 @@ func (w *Wireguard) constructWireguardDeltaForResync(wireguardClient netlinkshim
 
 		logCxt := log.WithFields(log.Fields{"publicKey": key, "node": node})
 		if node == nil {
+			if _, ok := expectedPendingPeerKeys[key]; ok && len(device.Peers[peerIdx].AllowedIPs) == 0 {
+				// This is the pending key of a peer. Store the programmed endpoint address so that it is updated if it
+				// is not correct.
+				logCxt.Debug("Peer key is a pending key")
+				var endpointAddr ip.Addr
+				if endpoint := device.Peers[peerIdx].Endpoint; endpoint != nil && endpoint.Port == w.listeningPort() {
+					endpointAddr = ip.FromNetIP(endpoint.IP)
+				}
+				w.programmedPendingPeerKeys[key] = endpointAddr
+				continue
+			}
 			logCxt.Info("Peer key is not expected or is associated with multiple nodes")
 			wireguardUpdate.Peers = append(wireguardUpdate.Peers, wgtypes.PeerConfig{
 				PublicKey: key,
@@ -1294,9 +1855,9 @@ func (w *Wireguard) constructWireguardDeltaForResync(wireguardClient netlinkshim
 		}
 
 		// If the CIDRs need replacing or the endpoint address needs updating then update the entry.
-		expectedEndpointIP := node.ipv4EndpointAddr.AsNetIP()
+		expectedEndpointIP := node.endpointAddr.AsNetIP()
 		replaceEndpointAddr := expectedEndpointIP != nil &&
-			(configuredAddr == nil || configuredAddr.Port != w.config.ListeningPort || !configuredAddr.IP.Equal(expectedEndpointIP))
+			(configuredAddr == nil || configuredAddr.Port != w.listeningPort() || !configuredAddr.IP.Equal(expectedEndpointIP))
 		if replaceEndpointAddr || allowedCidrsForUpdateMsg != nil {
 			peer := wgtypes.PeerConfig{
 				PublicKey:                   key,
@@ -1328,10 +1889,10 @@ func (w *Wireguard) constructWireguardDeltaForResync(wireguardClient netlinkshim
 			continue
 		}
 
-		logCxt.WithField("ipv4EndpointAddr", node.ipv4EndpointAddr).Info("Add peer to wireguard")
+		logCxt.WithField("endpointAddr", node.endpointAddr).Info("Add peer to wireguard")
 		wireguardUpdate.Peers = append(wireguardUpdate.Peers, wgtypes.PeerConfig{
 			PublicKey:                   node.publicKey,
-			Endpoint:                    w.endpointUDPAddr(node.ipv4EndpointAddr.AsNetIP()),
+			Endpoint:                    w.endpointUDPAddr(node.endpointAddr.AsNetIP()),
 			AllowedIPs:                  node.allowedCidrsForWireguard(),
 			PersistentKeepaliveInterval: &w.config.PersistentKeepAlive,
 		})
@@ -1347,21 +1908,21 @@ func (w *Wireguard) constructWireguardDeltaForResync(wireguardClient netlinkshim
 
 // ensureLink checks that the wireguard link is configured correctly. Returns true if the link is oper up.
 func (w *Wireguard) ensureLink(netlinkClient netlinkshim.Interface) (bool, error) {
-	logCxt := log.WithField("ifaceName", w.config.InterfaceName)
+	logCxt := log.WithField("ifaceName", w.interfaceName())
 
-	if w.config.EncryptHostTraffic {
+	if w.config.EncryptHostTraffic && w.ipVersion == 4 {
 		log.Info("Enabling src valid mark for WireGuard")
 		if err := writeProcSys(allSrcValidMarkPath, "1"); err != nil {
 			return false, err
 		}
 	}
 
-	link, err := netlinkClient.LinkByName(w.config.InterfaceName)
+	link, err := netlinkClient.LinkByName(w.interfaceName())
 	if netlinkshim.IsNotExist(err) {
 		// Create the wireguard device.
 		logCxt.Info("Wireguard device needs to be created")
 		attr := netlink.NewLinkAttrs()
-		attr.Name = w.config.InterfaceName
+		attr.Name = w.interfaceName()
 		lwg := netlink.GenericLink{
 			LinkAttrs: attr,
 			LinkType:  wireguardType,
@@ -1371,7 +1932,7 @@ func (w *Wireguard) ensureLink(netlinkClient netlinkshim.Interface) (bool, error
 			return false, err
 		}
 
-		link, err = netlinkClient.LinkByName(w.config.InterfaceName)
+		link, err = netlinkClient.LinkByName(w.interfaceName())
 		if err != nil {
 			log.WithError(err).Error("error querying wireguard device")
 			return false, err
@@ -1392,9 +1953,9 @@ func (w *Wireguard) ensureLink(netlinkClient netlinkshim.Interface) (bool, error
 	logCxt.Debug("Wireguard device exists, checking settings")
 	attrs := link.Attrs()
 	oldMTU := attrs.MTU
-	if w.config.MTU != 0 && oldMTU != w.config.MTU {
+	if w.mtu() != 0 && oldMTU != w.mtu() {
 		logCxt.WithField("oldMTU", oldMTU).Info("Wireguard device MTU needs to be updated")
-		if err := netlinkClient.LinkSetMTU(link, w.config.MTU); err != nil {
+		if err := netlinkClient.LinkSetMTU(link, w.mtu()); err != nil {
 			log.WithError(err).Warn("failed to set tunnel device MTU")
 			return false, err
 		}
@@ -1408,7 +1969,7 @@ func (w *Wireguard) ensureLink(netlinkClient netlinkshim.Interface) (bool, error
 		}
 		log.Info("Set wireguard admin up")
 
-		if link, err = netlinkClient.LinkByName(w.config.InterfaceName); err != nil {
+		if link, err = netlinkClient.LinkByName(w.interfaceName()); err != nil {
 			log.WithError(err).Warn("failed to get link device after creating link")
 			return false, err
 		}
@@ -1420,8 +1981,8 @@ func (w *Wireguard) ensureLink(netlinkClient netlinkshim.Interface) (bool, error
 
 // ensureNoLink checks that the wireguard link is not present.
 func (w *Wireguard) ensureNoLink(netlinkClient netlinkshim.Interface) error {
-	logCxt := log.WithField("ifaceName", w.config.InterfaceName)
-	link, err := netlinkClient.LinkByName(w.config.InterfaceName)
+	logCxt := log.WithField("ifaceName", w.interfaceName())
+	link, err := netlinkClient.LinkByName(w.interfaceName())
 	if err == nil {
 		// Wireguard device exists.
 		logCxt.Info("Wireguard is disabled, deleting device")
@@ -1439,26 +2000,30 @@ func (w *Wireguard) ensureNoLink(netlinkClient netlinkshim.Interface) error {
 	return nil
 }
 
-// ensureLinkAddressV4 ensures the wireguard link to set to the required local IP address.  It removes any other
-// addresses.
-func (w *Wireguard) ensureLinkAddressV4(netlinkClient netlinkshim.Interface) error {
-	logCxt := log.WithField("ifaceName", w.config.InterfaceName)
-	logCxt.Debug("Setting local IPv4 address on link.")
-	link, err := netlinkClient.LinkByName(w.config.InterfaceName)
+// ensureLinkAddress ensures the wireguard link to set to the required local IP address.  It removes any other
+// addresses of the same IP family, apart from IPv6 link local addresses which are managed by the kernel.
+func (w *Wireguard) ensureLinkAddress(netlinkClient netlinkshim.Interface) error {
+	logCxt := log.WithFields(log.Fields{"ifaceName": w.interfaceName(), "ipVersion": w.ipVersion})
+	logCxt.Debug("Setting local IP address on link.")
+	link, err := netlinkClient.LinkByName(w.interfaceName())
 	if err != nil {
 		logCxt.WithError(err).Warn("Failed to get device")
 		return err
 	}
 
-	addrs, err := netlinkClient.AddrList(link, netlink.FAMILY_V4)
+	family := netlink.FAMILY_V4
+	if w.ipVersion == 6 {
+		family = netlink.FAMILY_V6
+	}
+	addrs, err := netlinkClient.AddrList(link, family)
 	if err != nil {
 		logCxt.WithError(err).Warn("failed to list interface addresses")
 		return err
 	}
 
 	var address net.IP
-	if w.ourIPv4InterfaceAddr != nil {
-		address = w.ourIPv4InterfaceAddr.AsNetIP()
+	if w.ourInterfaceAddr != nil {
+		address = w.ourInterfaceAddr.AsNetIP()
 	}
 
 	found := false
@@ -1469,6 +2034,10 @@ func (w *Wireguard) ensureLinkAddressV4(netlinkClient netlinkshim.Interface) err
 			found = true
 			continue
 		}
+		if w.ipVersion == 6 && oldAddr.IP.IsLinkLocalUnicast() {
+			addrLogCxt.Debug("Ignoring link local address.")
+			continue
+		}
 		addrLogCxt.Info("Removing old address")
 		if err := netlinkClient.AddrDel(link, &oldAddr); err != nil {
 			addrLogCxt.WithError(err).Warn("failed to delete address from wireguard device")
@@ -1480,7 +2049,8 @@ func (w *Wireguard) ensureLinkAddressV4(netlinkClient netlinkshim.Interface) err
 		addrLogCxt := logCxt.WithField("addr", address)
 		if !found {
 			addrLogCxt.Info("address not present on wireguard device, adding it")
-			mask := net.CIDRMask(32, 32)
+			bits := int(w.hostPrefixLen())
+			mask := net.CIDRMask(bits, bits)
 			ipNet := net.IPNet{
 				IP:   address.Mask(mask), // Mask the IP to match ParseCIDR()'s behaviour.
 				Mask: mask,
@@ -1505,7 +2075,7 @@ func (w *Wireguard) addRouteRule() {
 	// The netlink library has a bug where it returns -1 for the mark on a rule instead of 0.
 	// To work around this issue, the rule below was re-written to no longer use a mark of 0x0,
 	// instead matching the NOT of the actual wireguard mark.
-	w.routerule.SetRule(routerule.NewRule(ipVersion, w.config.RoutingRulePriority).
+	w.routerule.SetRule(routerule.NewRule(int(w.ipVersion), w.config.RoutingRulePriority).
 		GoToTable(w.config.RoutingTableIndex).
 		Not().MatchFWMarkWithMask(uint32(w.config.FirewallMark), uint32(w.config.FirewallMark)))
 }
@@ -1553,12 +2123,12 @@ func (w *Wireguard) ensureDisabled(netlinkClient netlinkshim.Interface) error {
 
 // shouldProgramWireguardPeer returns true if the peer configuration indicates the peer should be programmed in
 // wireguard. This requires:
-// -  A peer to have an IPv4 endpoint address
+// -  A peer to have an endpoint address
 // -  A peer to have a valid public key, and
 // -  Only a single peer to be claiming that public key
 func (w *Wireguard) shouldProgramWireguardPeer(name string, node *nodeData) bool {
 	logCxt := log.WithField("node", name)
-	if node.ipv4EndpointAddr == nil {
+	if node.endpointAddr == nil {
 		logCxt.Debug("Peer should not be programmed, no endpoint address")
 		return false
 	} else if node.publicKey == zeroKey {
@@ -1662,7 +2232,7 @@ func (w *Wireguard) applyWireguardConfig(wireguardClient netlinkshim.Wireguard,
 		// No config to apply.
 		return nil
 	}
-	return wireguardClient.ConfigureDevice(w.config.InterfaceName, *c)
+	return wireguardClient.ConfigureDevice(w.interfaceName(), *c)
 }
 
 // endpointUDPAddr converts the net IP and the configured listening port to a net UDP address.
@@ -1672,7 +2242,7 @@ func (w *Wireguard) endpointUDPAddr(ip net.IP) *net.UDPAddr {
 	}
 	return &net.UDPAddr{
 		IP:   ip,
-		Port: w.config.ListeningPort,
+		Port: w.listeningPort(),
 	}
 }
 
@@ -20,7 +20,10 @@ import (
 
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net"
+	"os"
+	"path/filepath"
 	"syscall"
 	"time"
 
@@ -120,18 +123,22 @@ func (a *applyWithErrors) LastError() error {
 }
 
 type mockStatus struct {
-	numCallbacks int
-	err          error
-	key          wgtypes.Key
+	numCallbacks       int
+	err                error
+	key                wgtypes.Key
+	pendingKey         wgtypes.Key
+	appliedPendingKeys []wgtypes.Key
 }
 
-func (m *mockStatus) status(publicKey wgtypes.Key) error {
-	log.Debugf("Status update with public key: %s", publicKey)
+func (m *mockStatus) status(publicKey, pendingPublicKey wgtypes.Key, appliedPendingKeys []wgtypes.Key) error {
+	log.Debugf("Status update with public key: %s; pending public key: %s", publicKey, pendingPublicKey)
 	m.numCallbacks++
 	if m.err != nil {
 		return m.err
 	}
 	m.key = publicKey
+	m.pendingKey = pendingPublicKey
+	m.appliedPendingKeys = appliedPendingKeys
 
 	log.Debugf("Num callbacks: %d", m.numCallbacks)
 	return nil
@@ -165,6 +172,7 @@ var _ = Describe("Enable wireguard", func() {
 				InterfaceName:       ifaceName,
 				MTU:                 mtu,
 			},
+			4,
 			rtDataplane.NewMockNetlink,
 			rrDataplane.NewMockNetlink,
 			wgDataplane.NewMockNetlink,
@@ -1266,6 +1274,705 @@ var _ = Describe("Enable wireguard", func() {
 	}
 })
 
+var _ = Describe("Enable wireguard (IPv6)", func() {
+	var wgDataplane, rtDataplane, rrDataplane *mocknetlink.MockNetlinkDataplane
+	var t *mocktime.MockTime
+	var s *mockStatus
+	var wg *Wireguard
+	var rule *netlink.Rule
+
+	ifaceNameV6 := "wireguard-if-v6"
+	listeningPortV6 := 1001
+	mtuV6 := 1980
+
+	ipv6_peer1 := ip.FromString("2001:db8::5")
+	ipv6_peer2 := ip.FromString("2001:db8::6")
+	cidrv6_local := ip.MustParseCIDROrIP("2001:db8:1::/122")
+	cidrv6_1 := ip.MustParseCIDROrIP("2001:db8:2::/122")
+	cidrv6_2 := ip.MustParseCIDROrIP("2001:db8:3::/122")
+	ipnetv6_1 := cidrv6_1.ToIPNet()
+	ipnetv6_2 := cidrv6_2.ToIPNet()
+
+	BeforeEach(func() {
+		wgDataplane = mocknetlink.New()
+		rtDataplane = mocknetlink.New()
+		rrDataplane = mocknetlink.New()
+		t = mocktime.New()
+		s = &mockStatus{}
+		// Setting an auto-increment greater than the route cleanup delay effectively
+		// disables the grace period for these tests.
+		t.SetAutoIncrement(11 * time.Second)
+
+		wg = NewWithShims(
+			hostname,
+			&Config{
+				Enabled:             true,
+				EnabledV6:           true,
+				ListeningPort:       listeningPort,
+				ListeningPortV6:     listeningPortV6,
+				FirewallMark:        firewallMark,
+				RoutingRulePriority: rulePriority,
+				RoutingTableIndex:   tableIndex,
+				InterfaceName:       ifaceName,
+				InterfaceNameV6:     ifaceNameV6,
+				MTU:                 mtu,
+				MTUV6:               mtuV6,
+			},
+			6,
+			rtDataplane.NewMockNetlink,
+			rrDataplane.NewMockNetlink,
+			wgDataplane.NewMockNetlink,
+			wgDataplane.NewMockWireguard,
+			10*time.Second,
+			t,
+			FelixRouteProtocol,
+			s.status,
+			logutils.NewSummarizer("test loop"),
+		)
+
+		rule = netlink.NewRule()
+		rule.Family = netlink.FAMILY_V6
+		rule.Priority = rulePriority
+		rule.Table = tableIndex
+		rule.Invert = true
+		rule.Mark = firewallMark
+		rule.Mask = firewallMark
+	})
+
+	It("should create the IPv6 link with the IPv6 settings", func() {
+		err := wg.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wgDataplane.AddedLinks).To(HaveKey(ifaceNameV6))
+		Expect(wgDataplane.AddedLinks).NotTo(HaveKey(ifaceName))
+		Expect(wgDataplane.NameToLink[ifaceNameV6].LinkType).To(Equal("wireguard"))
+		Expect(wgDataplane.NameToLink[ifaceNameV6].LinkAttrs.MTU).To(Equal(mtuV6))
+	})
+
+	It("should ignore interface state changes for the IPv4 device", func() {
+		err := wg.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		wgDataplane.AddIface(1919, ifaceName, true, true)
+		wg.OnIfaceStateChanged(ifaceName, ifacemonitor.StateUp)
+		err = wg.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wgDataplane.WireguardOpen).To(BeFalse())
+	})
+
+	Describe("set the link up", func() {
+		var link *mocknetlink.MockLink
+
+		BeforeEach(func() {
+			err := wg.Apply()
+			Expect(err).NotTo(HaveOccurred())
+			wgDataplane.SetIface(ifaceNameV6, true, true)
+			wg.OnIfaceStateChanged(ifaceNameV6, ifacemonitor.StateUp)
+			err = wg.Apply()
+			Expect(err).NotTo(HaveOccurred())
+			link = wgDataplane.NameToLink[ifaceNameV6]
+		})
+
+		It("should configure the device and report the public key", func() {
+			Expect(wgDataplane.WireguardOpen).To(BeTrue())
+			Expect(link.WireguardFirewallMark).To(Equal(firewallMark))
+			Expect(link.WireguardListenPort).To(Equal(listeningPortV6))
+			Expect(link.WireguardPrivateKey).NotTo(Equal(zeroKey))
+			Expect(s.numCallbacks).To(Equal(1))
+			Expect(s.key).To(Equal(link.WireguardPublicKey))
+		})
+
+		It("should add an IPv6 routing rule", func() {
+			err := wg.Apply()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rrDataplane.AddedRules).To(ConsistOf(*rule))
+		})
+
+		It("should program an IPv6 interface address", func() {
+			ipv6 := ip.FromString("2001:db8:1::1")
+			wg.EndpointWireguardUpdate(hostname, s.key, ipv6)
+			err := wg.Apply()
+			Expect(err).NotTo(HaveOccurred())
+			link = wgDataplane.NameToLink[ifaceNameV6]
+			Expect(link.Addrs).To(HaveLen(1))
+			Expect(link.Addrs[0].IP).To(Equal(ipv6.AsNetIP()))
+			Expect(link.Addrs[0].Mask).To(Equal(net.CIDRMask(128, 128)))
+		})
+
+		Describe("create two wireguard peers with routes", func() {
+			var key_peer1, key_peer2 wgtypes.Key
+			var routekey_1, routekey_2, routekey_local_throw string
+
+			BeforeEach(func() {
+				rtDataplane.NameToLink[ifaceNameV6] = link
+				routekey_1 = fmt.Sprintf("%d-%d-%s", tableIndex, link.LinkAttrs.Index, cidrv6_1)
+				routekey_2 = fmt.Sprintf("%d-%d-%s", tableIndex, link.LinkAttrs.Index, cidrv6_2)
+				routekey_local_throw = fmt.Sprintf("%d-%d-%s", tableIndex, 0, cidrv6_local)
+
+				wg.EndpointWireguardUpdate(hostname, s.key, nil)
+				key_peer1 = mustGeneratePrivateKey().PublicKey()
+				wg.EndpointWireguardUpdate(peer1, key_peer1, nil)
+				wg.EndpointUpdate(peer1, ipv6_peer1)
+				key_peer2 = mustGeneratePrivateKey().PublicKey()
+				wg.EndpointWireguardUpdate(peer2, key_peer2, nil)
+				wg.EndpointUpdate(peer2, ipv6_peer2)
+				wg.RouteUpdate(hostname, cidrv6_local)
+				wg.RouteUpdate(peer1, cidrv6_1)
+				wg.RouteUpdate(peer2, cidrv6_2)
+				err := wg.Apply()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should program the peers with IPv6 endpoints and allowed IPs", func() {
+				Expect(link.WireguardPeers).To(HaveLen(2))
+				Expect(link.WireguardPeers[key_peer1]).To(Equal(wgtypes.Peer{
+					PublicKey: key_peer1,
+					Endpoint: &net.UDPAddr{
+						IP:   ipv6_peer1.AsNetIP(),
+						Port: listeningPortV6,
+					},
+					AllowedIPs: []net.IPNet{ipnetv6_1},
+				}))
+				Expect(link.WireguardPeers[key_peer2]).To(Equal(wgtypes.Peer{
+					PublicKey: key_peer2,
+					Endpoint: &net.UDPAddr{
+						IP:   ipv6_peer2.AsNetIP(),
+						Port: listeningPortV6,
+					},
+					AllowedIPs: []net.IPNet{ipnetv6_2},
+				}))
+			})
+
+			It("should route the peer CIDRs to the IPv6 device", func() {
+				Expect(rtDataplane.AddedRouteKeys).To(HaveKey(routekey_1))
+				Expect(rtDataplane.AddedRouteKeys).To(HaveKey(routekey_2))
+				Expect(rtDataplane.AddedRouteKeys).To(HaveKey(routekey_local_throw))
+				Expect(rtDataplane.RouteKeyToRoute[routekey_1]).To(Equal(netlink.Route{
+					LinkIndex: link.LinkAttrs.Index,
+					Dst:       &ipnetv6_1,
+					Type:      syscall.RTN_UNICAST,
+					Protocol:  FelixRouteProtocol,
+					Scope:     netlink.SCOPE_LINK,
+					Table:     tableIndex,
+				}))
+			})
+		})
+	})
+})
+
+var _ = Describe("Wireguard key rotation", func() {
+	var wgDataplane, rtDataplane, rrDataplane *mocknetlink.MockNetlinkDataplane
+	var t *mocktime.MockTime
+	var s *mockStatus
+	var wg *Wireguard
+	var link *mocknetlink.MockLink
+	var key_peer1 wgtypes.Key
+	var config *Config
+	var tempDir string
+
+	newWireguard := func(status *mockStatus) *Wireguard {
+		return NewWithShims(
+			hostname,
+			config,
+			4,
+			rtDataplane.NewMockNetlink,
+			rrDataplane.NewMockNetlink,
+			wgDataplane.NewMockNetlink,
+			wgDataplane.NewMockWireguard,
+			10*time.Second,
+			t,
+			FelixRouteProtocol,
+			status.status,
+			logutils.NewSummarizer("test loop"),
+		)
+	}
+
+	BeforeEach(func() {
+		wgDataplane = mocknetlink.New()
+		rtDataplane = mocknetlink.New()
+		rrDataplane = mocknetlink.New()
+		t = mocktime.New()
+		s = &mockStatus{}
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "wireguard")
+		Expect(err).NotTo(HaveOccurred())
+		config = &Config{
+			Enabled:             true,
+			ListeningPort:       listeningPort,
+			FirewallMark:        firewallMark,
+			RoutingRulePriority: rulePriority,
+			RoutingTableIndex:   tableIndex,
+			InterfaceName:       ifaceName,
+			MTU:                 mtu,
+			KeyRotationInterval: time.Hour,
+			PendingKeyFile:      filepath.Join(tempDir, "wireguard-pending-key"),
+		}
+		wg = newWireguard(s)
+
+		err = wg.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		wgDataplane.SetIface(ifaceName, true, true)
+		wg.OnIfaceStateChanged(ifaceName, ifacemonitor.StateUp)
+		err = wg.Apply()
+		Expect(err).NotTo(HaveOccurred())
+
+		wg.EndpointWireguardUpdate(hostname, s.key, nil)
+		key_peer1 = mustGeneratePrivateKey().PublicKey()
+		wg.EndpointWireguardUpdate(peer1, key_peer1, nil)
+		wg.EndpointUpdate(peer1, ipv4_peer1)
+		err = wg.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		link = wgDataplane.NameToLink[ifaceName]
+		Expect(link.WireguardPeers).To(HaveKey(key_peer1))
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	// restartWireguard simulates a restart of felix, returning a new wireguard manager using the same dataplane.
+	restartWireguard := func(status *mockStatus) *Wireguard {
+		for _, dp := range []*mocknetlink.MockNetlinkDataplane{wgDataplane, rtDataplane, rrDataplane} {
+			dp.NetlinkOpen = false
+			dp.WireguardOpen = false
+		}
+		return newWireguard(status)
+	}
+
+	// startKeyRotation moves to the next scheduled key rotation and returns our pending public key.
+	startKeyRotation := func() wgtypes.Key {
+		t.IncrementTime(wg.RescheduleAfter())
+		err := wg.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s.pendingKey).NotTo(Equal(zeroKey))
+		return s.pendingKey
+	}
+
+	It("should publish the initial key with no key rotation in progress", func() {
+		Expect(s.numCallbacks).To(Equal(1))
+		Expect(s.key).To(Equal(link.WireguardPublicKey))
+		Expect(s.pendingKey).To(Equal(zeroKey))
+		Expect(s.appliedPendingKeys).To(BeEmpty())
+	})
+
+	It("should schedule the next key rotation within the rotation interval", func() {
+		Expect(wg.RescheduleAfter()).To(BeNumerically(">", 0))
+		Expect(wg.RescheduleAfter()).To(BeNumerically("<=", time.Hour))
+	})
+
+	It("should publish a pending key and only switch over to it once all peers have applied it", func() {
+		originalKey := link.WireguardPrivateKey
+
+		// Move to the scheduled rotation time. The next key should be published and persisted, but not yet programmed.
+		pendingKey := startKeyRotation()
+		Expect(s.numCallbacks).To(Equal(2))
+		Expect(s.key).To(Equal(originalKey.PublicKey()))
+		Expect(pendingKey).NotTo(Equal(s.key))
+		Expect(link.WireguardPrivateKey).To(Equal(originalKey))
+		Expect(wg.RescheduleAfter()).To(Equal(10 * time.Minute))
+		Expect(config.PendingKeyFile).To(BeARegularFile())
+
+		// Time passing, within the timeout, should not change anything.
+		t.IncrementTime(5 * time.Minute)
+		err := wg.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s.numCallbacks).To(Equal(2))
+		Expect(link.WireguardPrivateKey).To(Equal(originalKey))
+
+		// The peer applying a different key should not change anything.
+		wg.EndpointWireguardKeyRotationUpdate(peer1, zeroKey, []wgtypes.Key{mustGeneratePrivateKey().PublicKey()})
+		err = wg.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s.numCallbacks).To(Equal(2))
+		Expect(link.WireguardPrivateKey).To(Equal(originalKey))
+
+		// Once the peer has applied our pending key the device should switch over to it and keep its peers.
+		wg.EndpointWireguardKeyRotationUpdate(peer1, zeroKey, []wgtypes.Key{pendingKey})
+		err = wg.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s.numCallbacks).To(Equal(3))
+		Expect(link.WireguardPublicKey).To(Equal(pendingKey))
+		Expect(s.key).To(Equal(pendingKey))
+		Expect(s.pendingKey).To(Equal(zeroKey))
+		Expect(link.WireguardPeers).To(HaveKey(key_peer1))
+		Expect(config.PendingKeyFile).NotTo(BeAnExistingFile())
+
+		// The next rotation is scheduled.
+		Expect(wg.RescheduleAfter()).To(BeNumerically(">", 0))
+		Expect(wg.RescheduleAfter()).To(BeNumerically("<=", time.Hour))
+	})
+
+	It("should switch over to the pending key if its peers do not apply it in time", func() {
+		pendingKey := startKeyRotation()
+		t.IncrementTime(wg.RescheduleAfter())
+		err := wg.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(link.WireguardPublicKey).To(Equal(pendingKey))
+		Expect(s.key).To(Equal(pendingKey))
+		Expect(s.pendingKey).To(Equal(zeroKey))
+		Expect(link.WireguardPeers).To(HaveKey(key_peer1))
+		Expect(config.PendingKeyFile).NotTo(BeAnExistingFile())
+	})
+
+	It("should wait for a peer that is added during the key rotation to apply the pending key", func() {
+		originalKey := link.WireguardPrivateKey
+		pendingKey := startKeyRotation()
+
+		key_peer2 := mustGeneratePrivateKey().PublicKey()
+		wg.EndpointWireguardUpdate(peer2, key_peer2, nil)
+		wg.EndpointUpdate(peer2, ipv4_peer2)
+		wg.EndpointWireguardKeyRotationUpdate(peer1, zeroKey, []wgtypes.Key{pendingKey})
+		err := wg.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(link.WireguardPrivateKey).To(Equal(originalKey))
+
+		wg.EndpointWireguardKeyRotationUpdate(peer2, zeroKey, []wgtypes.Key{pendingKey})
+		err = wg.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(link.WireguardPublicKey).To(Equal(pendingKey))
+	})
+
+	It("should restore the pending key after a restart", func() {
+		originalKey := link.WireguardPrivateKey
+		pendingKey := startKeyRotation()
+
+		s2 := &mockStatus{}
+		wg2 := restartWireguard(s2)
+		wg2.EndpointWireguardUpdate(peer1, key_peer1, nil)
+		wg2.EndpointUpdate(peer1, ipv4_peer1)
+		err := wg2.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s2.key).To(Equal(originalKey.PublicKey()))
+		Expect(s2.pendingKey).To(Equal(pendingKey))
+		Expect(link.WireguardPrivateKey).To(Equal(originalKey))
+
+		wg2.EndpointWireguardKeyRotationUpdate(peer1, zeroKey, []wgtypes.Key{pendingKey})
+		err = wg2.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(link.WireguardPublicKey).To(Equal(pendingKey))
+		Expect(s2.key).To(Equal(pendingKey))
+		Expect(s2.pendingKey).To(Equal(zeroKey))
+	})
+
+	It("should complete a key rotation that switched over before a restart", func() {
+		nextKey := mustGeneratePrivateKey()
+		Expect(ioutil.WriteFile(config.PendingKeyFile, []byte(nextKey.String()), 0600)).To(Succeed())
+		link.WireguardPrivateKey = nextKey
+		link.WireguardPublicKey = nextKey.PublicKey()
+
+		s2 := &mockStatus{}
+		wg2 := restartWireguard(s2)
+		err := wg2.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s2.key).To(Equal(nextKey.PublicKey()))
+		Expect(s2.pendingKey).To(Equal(zeroKey))
+		Expect(config.PendingKeyFile).NotTo(BeAnExistingFile())
+	})
+
+	It("should discard the pending key when key rotation is disabled", func() {
+		startKeyRotation()
+		config.KeyRotationInterval = 0
+		err := wg.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s.pendingKey).To(Equal(zeroKey))
+		Expect(config.PendingKeyFile).NotTo(BeAnExistingFile())
+		Expect(wg.RescheduleAfter()).To(BeZero())
+	})
+
+	It("should republish our state if the stored pending key is incorrect", func() {
+		wg.EndpointWireguardKeyRotationUpdate(hostname, mustGeneratePrivateKey().PublicKey(), nil)
+		err := wg.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(s.numCallbacks).To(Equal(2))
+		Expect(s.pendingKey).To(Equal(zeroKey))
+	})
+
+	Describe("with a peer key rotation in progress", func() {
+		var key_peer1_next wgtypes.Key
+
+		BeforeEach(func() {
+			// Only the peer is rotating its key.
+			config.KeyRotationInterval = 0
+			key_peer1_next = mustGeneratePrivateKey().PublicKey()
+			wg.RouteUpdate(peer1, cidr_1)
+			wg.EndpointWireguardKeyRotationUpdate(peer1, key_peer1_next, nil)
+			err := wg.Apply()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		// publishAppliedKeys moves on to the time that a change to the applied pending keys can next be published.
+		publishAppliedKeys := func() {
+			t.IncrementTime(time.Minute)
+			err := wg.Apply()
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		It("should program the pending key alongside the current key and report it as applied", func() {
+			Expect(link.WireguardPeers).To(HaveLen(2))
+			Expect(link.WireguardPeers).To(HaveKey(key_peer1))
+			Expect(link.WireguardPeers).To(HaveKey(key_peer1_next))
+			Expect(link.WireguardPeers[key_peer1_next].AllowedIPs).To(BeEmpty())
+			Expect(link.WireguardPeers[key_peer1_next].Endpoint.IP).To(Equal(ipv4_peer1.AsNetIP()))
+			Expect(link.WireguardPeers[key_peer1_next].PersistentKeepaliveInterval).To(Equal(5 * time.Second))
+			Expect(s.numCallbacks).To(Equal(2))
+			Expect(s.appliedPendingKeys).To(Equal([]wgtypes.Key{key_peer1_next}))
+		})
+
+		It("should publish further changes to the applied pending keys at most once a minute", func() {
+			key_peer2 := mustGeneratePrivateKey().PublicKey()
+			key_peer2_next := mustGeneratePrivateKey().PublicKey()
+			wg.EndpointWireguardUpdate(peer2, key_peer2, nil)
+			wg.EndpointUpdate(peer2, ipv4_peer2)
+			wg.EndpointWireguardKeyRotationUpdate(peer2, key_peer2_next, nil)
+			err := wg.Apply()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveKey(key_peer2_next))
+			Expect(s.numCallbacks).To(Equal(2))
+			Expect(wg.RescheduleAfter()).To(BeNumerically("<=", time.Minute))
+
+			publishAppliedKeys()
+			Expect(s.numCallbacks).To(Equal(3))
+			Expect(s.appliedPendingKeys).To(ConsistOf(key_peer1_next, key_peer2_next))
+		})
+
+		It("should check for the peer switching over to its pending key every second", func() {
+			Expect(wg.RescheduleAfter()).To(Equal(time.Second))
+		})
+
+		It("should move the allowed IPs over to the pending key once the peer completes a handshake with it", func() {
+			peer := link.WireguardPeers[key_peer1_next]
+			peer.LastHandshakeTime = time.Now()
+			link.WireguardPeers[key_peer1_next] = peer
+			err := wg.Apply()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(1))
+			Expect(link.WireguardPeers).To(HaveKey(key_peer1_next))
+			Expect(link.WireguardPeers[key_peer1_next].AllowedIPs).To(ConsistOf(ipnet_1))
+
+			// The datastore catching up does not change anything.
+			wg.EndpointWireguardUpdate(peer1, key_peer1_next, nil)
+			wg.EndpointWireguardKeyRotationUpdate(peer1, zeroKey, nil)
+			err = wg.Apply()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(1))
+			Expect(link.WireguardPeers[key_peer1_next].AllowedIPs).To(ConsistOf(ipnet_1))
+			Expect(wg.RescheduleAfter()).To(BeNumerically("<=", time.Minute))
+			publishAppliedKeys()
+			Expect(s.appliedPendingKeys).To(BeEmpty())
+		})
+
+		It("should keep the pending key across a resync", func() {
+			wg.QueueResync()
+			err := wg.Apply()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(2))
+			Expect(link.WireguardPeers).To(HaveKey(key_peer1_next))
+			Expect(s.numCallbacks).To(Equal(2))
+		})
+
+		It("should replace the current key once the peer switches over to its pending key", func() {
+			wg.EndpointWireguardUpdate(peer1, key_peer1_next, nil)
+			wg.EndpointWireguardKeyRotationUpdate(peer1, zeroKey, nil)
+			err := wg.Apply()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(1))
+			Expect(link.WireguardPeers).To(HaveKey(key_peer1_next))
+			Expect(link.WireguardPeers[key_peer1_next].Endpoint.IP).To(Equal(ipv4_peer1.AsNetIP()))
+			Expect(s.numCallbacks).To(Equal(2))
+			publishAppliedKeys()
+			Expect(s.numCallbacks).To(Equal(3))
+			Expect(s.appliedPendingKeys).To(BeEmpty())
+		})
+
+		It("should remove the pending key if the peer abandons its key rotation", func() {
+			wg.EndpointWireguardKeyRotationUpdate(peer1, zeroKey, nil)
+			err := wg.Apply()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(HaveLen(1))
+			Expect(link.WireguardPeers).To(HaveKey(key_peer1))
+			publishAppliedKeys()
+			Expect(s.appliedPendingKeys).To(BeEmpty())
+		})
+
+		It("should remove the pending key when the peer is removed", func() {
+			wg.EndpointWireguardRemove(peer1)
+			err := wg.Apply()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(link.WireguardPeers).To(BeEmpty())
+			publishAppliedKeys()
+			Expect(s.appliedPendingKeys).To(BeEmpty())
+		})
+	})
+})
+
+var _ = Describe("Wireguard key rotation between two nodes", func() {
+	var t *mocktime.MockTime
+	var sA, sB *mockStatus
+	var wgA, wgB *Wireguard
+	var linkA, linkB *mocknetlink.MockLink
+	var tempDir string
+
+	// Workloads on node A (hostname) and node B (peer1).
+	ipv4_workloadA := ip.FromString("192.168.2.1")
+	ipv4_workloadB := ip.FromString("192.168.1.1")
+
+	newNode := func(name string, config *Config, s *mockStatus) (*Wireguard, *mocknetlink.MockLink) {
+		wgDataplane := mocknetlink.New()
+		rtDataplane := mocknetlink.New()
+		rrDataplane := mocknetlink.New()
+		wg := NewWithShims(
+			name,
+			config,
+			4,
+			rtDataplane.NewMockNetlink,
+			rrDataplane.NewMockNetlink,
+			wgDataplane.NewMockNetlink,
+			wgDataplane.NewMockWireguard,
+			10*time.Second,
+			t,
+			FelixRouteProtocol,
+			s.status,
+			logutils.NewSummarizer("test loop"),
+		)
+		err := wg.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		wgDataplane.SetIface(ifaceName, true, true)
+		wg.OnIfaceStateChanged(ifaceName, ifacemonitor.StateUp)
+		err = wg.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		return wg, wgDataplane.NameToLink[ifaceName]
+	}
+
+	// sync simulates the datastore: it passes the status that each node has published to the other node, and then
+	// applies both.
+	sync := func() {
+		wgA.EndpointWireguardUpdate(peer1, sB.key, nil)
+		wgA.EndpointWireguardKeyRotationUpdate(peer1, sB.pendingKey, sB.appliedPendingKeys)
+		wgB.EndpointWireguardUpdate(hostname, sA.key, nil)
+		wgB.EndpointWireguardKeyRotationUpdate(hostname, sA.pendingKey, sA.appliedPendingKeys)
+		err := wgA.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		err = wgB.Apply()
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	// handshake simulates a handshake between the two devices, which completes if each device has the current public
+	// key of the other as a peer.
+	handshake := func() {
+		peerOfA, okA := linkA.WireguardPeers[linkB.WireguardPublicKey]
+		peerOfB, okB := linkB.WireguardPeers[linkA.WireguardPublicKey]
+		if okA && okB {
+			peerOfA.LastHandshakeTime = t.Now()
+			peerOfB.LastHandshakeTime = t.Now()
+			linkA.WireguardPeers[peerOfA.PublicKey] = peerOfA
+			linkB.WireguardPeers[peerOfB.PublicKey] = peerOfB
+		}
+	}
+
+	// delivered returns true if wireguard would deliver a packet from src to dst. The sending device encrypts it for
+	// the peer whose allowed IPs contain dst, which must have the public key of the receiving device, and the
+	// receiving device only accepts it from a peer with the public key of the sender whose allowed IPs contain src.
+	delivered := func(from, to *mocknetlink.MockLink, src, dst ip.Addr) bool {
+		contains := func(ipnets []net.IPNet, addr ip.Addr) bool {
+			for _, ipnet := range ipnets {
+				if ipnet.Contains(addr.AsNetIP()) {
+					return true
+				}
+			}
+			return false
+		}
+		for _, peer := range from.WireguardPeers {
+			if !contains(peer.AllowedIPs, dst) {
+				continue
+			}
+			if peer.PublicKey != to.WireguardPublicKey {
+				return false
+			}
+			sender, ok := to.WireguardPeers[from.WireguardPublicKey]
+			return ok && contains(sender.AllowedIPs, src)
+		}
+		return false
+	}
+
+	// flowing returns true if traffic flows between the workloads in both directions.
+	flowing := func() bool {
+		handshake()
+		return delivered(linkA, linkB, ipv4_workloadA, ipv4_workloadB) &&
+			delivered(linkB, linkA, ipv4_workloadB, ipv4_workloadA)
+	}
+
+	BeforeEach(func() {
+		t = mocktime.New()
+		sA = &mockStatus{}
+		sB = &mockStatus{}
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "wireguard")
+		Expect(err).NotTo(HaveOccurred())
+		newConfig := func(keyRotationInterval time.Duration, pendingKeyFile string) *Config {
+			return &Config{
+				Enabled:             true,
+				ListeningPort:       listeningPort,
+				FirewallMark:        firewallMark,
+				RoutingRulePriority: rulePriority,
+				RoutingTableIndex:   tableIndex,
+				InterfaceName:       ifaceName,
+				MTU:                 mtu,
+				KeyRotationInterval: keyRotationInterval,
+				PendingKeyFile:      filepath.Join(tempDir, pendingKeyFile),
+			}
+		}
+		wgA, linkA = newNode(hostname, newConfig(time.Hour, "a"), sA)
+		wgB, linkB = newNode(peer1, newConfig(0, "b"), sB)
+
+		wgA.EndpointUpdate(peer1, ipv4_peer1)
+		wgA.RouteUpdate(peer1, cidr_1)
+		wgB.EndpointUpdate(hostname, ipv4_host)
+		wgB.RouteUpdate(hostname, cidr_2)
+		sync()
+		Expect(flowing()).To(BeTrue())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	It("should keep traffic flowing across the switch to the next key", func() {
+		originalKey := linkA.WireguardPublicKey
+
+		// Node A generates its next key, and node B programs it alongside the current key.
+		t.IncrementTime(wgA.RescheduleAfter())
+		err := wgA.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sA.pendingKey).NotTo(Equal(zeroKey))
+		pendingKey := sA.pendingKey
+		sync()
+		Expect(linkA.WireguardPublicKey).To(Equal(originalKey))
+		Expect(sB.appliedPendingKeys).To(Equal([]wgtypes.Key{pendingKey}))
+		Expect(flowing()).To(BeTrue())
+
+		// Node A sees that node B has applied its next key and switches over to it.  Node B has not yet seen the
+		// switch, so its traffic only flows once it notices the handshake with the next key.
+		sync()
+		Expect(linkA.WireguardPublicKey).To(Equal(pendingKey))
+		Expect(flowing()).To(BeFalse())
+		Expect(wgB.RescheduleAfter()).To(Equal(time.Second))
+		t.IncrementTime(time.Second)
+		err = wgB.Apply()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(flowing()).To(BeTrue())
+		Expect(linkB.WireguardPeers).To(HaveLen(1))
+
+		// Traffic keeps flowing once the datastore catches up.
+		sync()
+		Expect(sA.key).To(Equal(pendingKey))
+		Expect(sA.pendingKey).To(Equal(zeroKey))
+		sync()
+		Expect(flowing()).To(BeTrue())
+		Expect(linkB.WireguardPeers).To(HaveLen(1))
+		Expect(linkB.WireguardPeers).To(HaveKey(pendingKey))
+	})
+})
+
 var _ = Describe("Wireguard (disabled)", func() {
 	var wgDataplane, rtDataplane, rrDataplane *mocknetlink.MockNetlinkDataplane
 	var t *mocktime.MockTime
@@ -1292,6 +1999,7 @@ var _ = Describe("Wireguard (disabled)", func() {
 				InterfaceName:       ifaceName,
 				MTU:                 1042,
 			},
+			4,
 			rtDataplane.NewMockNetlink,
 			rrDataplane.NewMockNetlink,
 			wgDataplane.NewMockNetlink,
@@ -1475,6 +2183,7 @@ var _ = Describe("Wireguard (with no table index)", func() {
 					InterfaceName:       ifaceName,
 					MTU:                 1042,
 				},
+				4,
 				rtDataplane.NewMockNetlink,
 				rrDataplane.NewMockNetlink,
 				wgDataplane.NewMockNetlink,
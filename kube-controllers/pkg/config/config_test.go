@@ -121,10 +121,11 @@ var _ = Describe("Config", func() {
 
 				rc := runCfg.Controllers
 				Expect(rc.Node).To(Equal(&config.NodeControllerConfig{
-					SyncLabels:        true,
-					AutoHostEndpoints: false,
-					DeleteNodes:       true,
-					LeakGracePeriod:   &v1.Duration{Duration: 15 * time.Minute},
+					SyncLabels:                        true,
+					AutoHostEndpoints:                 false,
+					DeleteNodes:                       true,
+					LeakGracePeriod:                   &v1.Duration{Duration: 15 * time.Minute},
+					IPPoolUtilizationWarningThreshold: 90,
 				}))
 				Expect(rc.Policy).To(Equal(&config.GenericControllerConfig{
 					ReconcilerPeriod: time.Minute * 5,
@@ -155,10 +156,11 @@ var _ = Describe("Config", func() {
 				Expect(s.RunningConfig.EtcdV3CompactionPeriod.Duration).To(Equal(time.Minute * 10))
 				c := s.RunningConfig.Controllers
 				Expect(c.Node).To(Equal(&v3.NodeControllerConfig{
-					ReconcilerPeriod: nil,
-					SyncLabels:       v3.Enabled,
-					HostEndpoint:     &v3.AutoHostEndpointConfig{AutoCreate: v3.Disabled},
-					LeakGracePeriod:  &v1.Duration{Duration: 15 * time.Minute},
+					ReconcilerPeriod:                  nil,
+					SyncLabels:                        v3.Enabled,
+					HostEndpoint:                      &v3.AutoHostEndpointConfig{AutoCreate: v3.Disabled},
+					LeakGracePeriod:                   &v1.Duration{Duration: 15 * time.Minute},
+					IPPoolUtilizationWarningThreshold: intPtr(90),
 				}))
 				Expect(c.Policy).To(Equal(&v3.PolicyControllerConfig{
 					ReconcilerPeriod: &v1.Duration{Duration: time.Minute * 5}}))
@@ -187,10 +189,11 @@ var _ = Describe("Config", func() {
 					EtcdV3CompactionPeriod: &v1.Duration{Duration: 0},
 					Controllers: v3.ControllersConfig{
 						Node: &v3.NodeControllerConfig{
-							ReconcilerPeriod: nil,
-							SyncLabels:       v3.Disabled,
-							HostEndpoint:     &v3.AutoHostEndpointConfig{AutoCreate: v3.Enabled},
-							LeakGracePeriod:  &v1.Duration{Duration: 20 * time.Minute},
+							ReconcilerPeriod:                  nil,
+							SyncLabels:                        v3.Disabled,
+							HostEndpoint:                      &v3.AutoHostEndpointConfig{AutoCreate: v3.Enabled},
+							LeakGracePeriod:                   &v1.Duration{Duration: 20 * time.Minute},
+							IPPoolUtilizationWarningThreshold: intPtr(75),
 						},
 						Policy: &v3.PolicyControllerConfig{
 							ReconcilerPeriod: &v1.Duration{Duration: time.Second * 30}},
@@ -219,10 +222,11 @@ var _ = Describe("Config", func() {
 
 				rc := runCfg.Controllers
 				Expect(rc.Node).To(Equal(&config.NodeControllerConfig{
-					SyncLabels:        false,
-					AutoHostEndpoints: true,
-					DeleteNodes:       true,
-					LeakGracePeriod:   &v1.Duration{Duration: 20 * time.Minute},
+					SyncLabels:                        false,
+					AutoHostEndpoints:                 true,
+					DeleteNodes:                       true,
+					LeakGracePeriod:                   &v1.Duration{Duration: 20 * time.Minute},
+					IPPoolUtilizationWarningThreshold: 75,
 				}))
 				Expect(rc.Policy).To(Equal(&config.GenericControllerConfig{
 					ReconcilerPeriod: time.Second * 30,
@@ -442,10 +446,11 @@ var _ = Describe("Config", func() {
 
 				rc := runCfg.Controllers
 				Expect(rc.Node).To(Equal(&config.NodeControllerConfig{
-					SyncLabels:        false,
-					AutoHostEndpoints: true,
-					DeleteNodes:       true,
-					LeakGracePeriod:   &v1.Duration{Duration: 15 * time.Minute},
+					SyncLabels:                        false,
+					AutoHostEndpoints:                 true,
+					DeleteNodes:                       true,
+					LeakGracePeriod:                   &v1.Duration{Duration: 15 * time.Minute},
+					IPPoolUtilizationWarningThreshold: 90,
 				}))
 				Expect(rc.Policy).To(Equal(&config.GenericControllerConfig{
 					ReconcilerPeriod: time.Second * 105,
@@ -475,10 +480,11 @@ var _ = Describe("Config", func() {
 				Expect(s.RunningConfig.EtcdV3CompactionPeriod.Duration).To(Equal(time.Minute * 33))
 				c := s.RunningConfig.Controllers
 				Expect(c.Node).To(Equal(&v3.NodeControllerConfig{
-					ReconcilerPeriod: nil,
-					SyncLabels:       v3.Disabled,
-					HostEndpoint:     &v3.AutoHostEndpointConfig{AutoCreate: v3.Enabled},
-					LeakGracePeriod:  &v1.Duration{Duration: 15 * time.Minute},
+					ReconcilerPeriod:                  nil,
+					SyncLabels:                        v3.Disabled,
+					HostEndpoint:                      &v3.AutoHostEndpointConfig{AutoCreate: v3.Enabled},
+					LeakGracePeriod:                   &v1.Duration{Duration: 15 * time.Minute},
+					IPPoolUtilizationWarningThreshold: intPtr(90),
 				}))
 				Expect(c.Policy).To(Equal(&v3.PolicyControllerConfig{
 					ReconcilerPeriod: &v1.Duration{Duration: time.Second * 105}}))
@@ -535,9 +541,10 @@ var _ = Describe("Config", func() {
 
 				rc := runCfg.Controllers
 				Expect(rc.Node).To(Equal(&config.NodeControllerConfig{
-					SyncLabels:        false,
-					AutoHostEndpoints: true,
-					DeleteNodes:       true,
+					SyncLabels:                        false,
+					AutoHostEndpoints:                 true,
+					DeleteNodes:                       true,
+					IPPoolUtilizationWarningThreshold: 90,
 				}))
 				Expect(rc.Policy).To(Equal(&config.GenericControllerConfig{
 					ReconcilerPeriod: time.Second * 105,
@@ -567,9 +574,10 @@ var _ = Describe("Config", func() {
 				Expect(s.RunningConfig.EtcdV3CompactionPeriod.Duration).To(Equal(time.Minute * 33))
 				c := s.RunningConfig.Controllers
 				Expect(c.Node).To(Equal(&v3.NodeControllerConfig{
-					ReconcilerPeriod: nil,
-					SyncLabels:       v3.Disabled,
-					HostEndpoint:     &v3.AutoHostEndpointConfig{AutoCreate: v3.Enabled},
+					ReconcilerPeriod:                  nil,
+					SyncLabels:                        v3.Disabled,
+					HostEndpoint:                      &v3.AutoHostEndpointConfig{AutoCreate: v3.Enabled},
+					IPPoolUtilizationWarningThreshold: intPtr(90),
 				}))
 				Expect(c.Policy).To(Equal(&v3.PolicyControllerConfig{
 					ReconcilerPeriod: &v1.Duration{Duration: time.Second * 105}}))
@@ -712,3 +720,7 @@ func (m *mockWatch) Stop() {
 func (m *mockWatch) ResultChan() <-chan watch.Event {
 	return m.r
 }
+
+func intPtr(i int) *int {
+	return &i
+}
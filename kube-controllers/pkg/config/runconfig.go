@@ -36,9 +36,16 @@ import (
 // Export for testing purposes
 var DefaultKCC *v3.KubeControllersConfiguration
 
-const datastoreBackoff = time.Second
+const (
+	datastoreBackoff = time.Second
+
+	// The default percentage of an IP pool's addresses that can be in use, or of its blocks that
+	// can be allocated, before the node controller generates a warning event for the pool.
+	defaultIPPoolUtilizationWarningThreshold = 90
+)
 
 func init() {
+	ipPoolUtilizationWarningThreshold := defaultIPPoolUtilizationWarningThreshold
 	DefaultKCC = v3.NewKubeControllersConfiguration()
 	DefaultKCC.Name = "default"
 	DefaultKCC.Spec = v3.KubeControllersConfigurationSpec{
@@ -47,10 +54,11 @@ func init() {
 		EtcdV3CompactionPeriod: &v1.Duration{Duration: time.Minute * 10},
 		Controllers: v3.ControllersConfig{
 			Node: &v3.NodeControllerConfig{
-				ReconcilerPeriod: &v1.Duration{Duration: time.Minute * 5},
-				SyncLabels:       v3.Enabled,
-				HostEndpoint:     nil,
-				LeakGracePeriod:  &v1.Duration{Duration: time.Minute * 15},
+				ReconcilerPeriod:                  &v1.Duration{Duration: time.Minute * 5},
+				SyncLabels:                        v3.Enabled,
+				HostEndpoint:                      nil,
+				LeakGracePeriod:                   &v1.Duration{Duration: time.Minute * 15},
+				IPPoolUtilizationWarningThreshold: &ipPoolUtilizationWarningThreshold,
 			},
 			Policy: &v3.PolicyControllerConfig{
 				ReconcilerPeriod: &v1.Duration{Duration: time.Minute * 5},
@@ -104,6 +112,11 @@ type NodeControllerConfig struct {
 	// The grace period used by the controller to determine if an IP address is leaked.
 	// Set to 0 to disable IP address garbage collection.
 	LeakGracePeriod *v1.Duration
+
+	// The percentage of an IP pool's addresses that can be in use, or of its blocks that can be
+	// allocated, before the controller generates a warning event for the pool.  Set to 0 to
+	// disable the events.
+	IPPoolUtilizationWarningThreshold int
 }
 
 type RunConfigController struct {
@@ -332,6 +345,14 @@ func mergeConfig(envVars map[string]string, envCfg Config, apiCfg v3.KubeControl
 			status.RunningConfig.Controllers.Node.LeakGracePeriod = apiCfg.Controllers.Node.LeakGracePeriod
 		}
 
+		// Nor for this; use the default if it isn't set in the API config.
+		threshold := defaultIPPoolUtilizationWarningThreshold
+		if apiCfg.Controllers.Node != nil && apiCfg.Controllers.Node.IPPoolUtilizationWarningThreshold != nil {
+			threshold = *apiCfg.Controllers.Node.IPPoolUtilizationWarningThreshold
+		}
+		rc.Node.IPPoolUtilizationWarningThreshold = threshold
+		status.RunningConfig.Controllers.Node.IPPoolUtilizationWarningThreshold = &threshold
+
 		if envCfg.DatastoreType != "kubernetes" {
 			rc.Node.DeleteNodes = true
 			// This field doesn't have an equivalent in the status
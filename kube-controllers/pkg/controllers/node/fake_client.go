@@ -89,6 +89,11 @@ func (f *FakeCalicoClient) NetworkSets() clientv3.NetworkSetInterface {
 	panic("not implemented")
 }
 
+// PacketCaptures returns an interface for managing packet capture resources.
+func (f *FakeCalicoClient) PacketCaptures() clientv3.PacketCaptureInterface {
+	panic("not implemented")
+}
+
 // HostEndpoints returns an interface for managing host endpoint resources.
 func (f *FakeCalicoClient) HostEndpoints() clientv3.HostEndpointInterface {
 	panic("not implemented")
@@ -104,6 +109,11 @@ func (f *FakeCalicoClient) BGPPeers() clientv3.BGPPeerInterface {
 	panic("not implemented")
 }
 
+// BGPFilters returns an interface for managing BGP filter resources.
+func (f *FakeCalicoClient) BGPFilters() clientv3.BGPFilterInterface {
+	panic("not implemented")
+}
+
 // IPAM returns an interface for managing IP address assignment and releasing.
 func (f *FakeCalicoClient) IPAM() ipam.Interface {
 	return f.ipamClient
@@ -138,6 +148,19 @@ func (f *FakeCalicoClient) IPReservations() clientv3.IPReservationInterface {
 	panic("not implemented")
 }
 
+// Tiers returns an interface for managing tier resources.
+func (f *FakeCalicoClient) Tiers() clientv3.TierInterface {
+	panic("not implemented")
+}
+
+func (f *FakeCalicoClient) StagedGlobalNetworkPolicies() clientv3.StagedGlobalNetworkPolicyInterface {
+	panic("not implemented")
+}
+
+func (f *FakeCalicoClient) StagedNetworkPolicies() clientv3.StagedNetworkPolicyInterface {
+	panic("not implemented")
+}
+
 // EnsureInitialized is used to ensure the backend datastore is correctly
 // initialized for use by Calico.  This method may be called multiple times, and
 // will have no effect if the datastore is already correctly initialized.
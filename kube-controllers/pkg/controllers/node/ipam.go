@@ -56,6 +56,14 @@ var (
 	blocksGauges             map[string]*prometheus.GaugeVec
 	gcCandidateGauges        map[string]*prometheus.GaugeVec
 	gcReclamationCounters    map[string]*prometheus.CounterVec
+	leakedAllocationGauges   map[string]*prometheus.GaugeVec
+	blockCapacityGauges      map[string]*prometheus.GaugeVec
+
+	// Per-pool metrics.
+	poolAllocatedGauge   *prometheus.GaugeVec
+	poolInUseGauge       *prometheus.GaugeVec
+	poolUtilizationGauge *prometheus.GaugeVec
+	poolFreeBlocksGauge  *prometheus.GaugeVec
 
 	// Single dimension metrics. Legacy metrics are replaced by multidimensional equivalents above. Retain for
 	// backwards compatibility.
@@ -72,6 +80,9 @@ const (
 
 	// Used to label an allocation that does not have its node attribute set.
 	unknownNodeLabel = "unknown_node"
+
+	// Reason of the event generated when an IP pool's utilization crosses the warning threshold.
+	poolUtilizationHighReason = "IPPoolUtilizationHigh"
 )
 
 func init() {
@@ -81,6 +92,8 @@ func init() {
 	blocksGauges = make(map[string]*prometheus.GaugeVec)
 	gcCandidateGauges = make(map[string]*prometheus.GaugeVec)
 	gcReclamationCounters = make(map[string]*prometheus.CounterVec)
+	leakedAllocationGauges = make(map[string]*prometheus.GaugeVec)
+	blockCapacityGauges = make(map[string]*prometheus.GaugeVec)
 
 	// Register the unknown pool explicitly.
 	registerMetricVectorsForPool(unknownPoolLabel)
@@ -91,6 +104,30 @@ func init() {
 	}, []string{"ippool"})
 	prometheus.MustRegister(poolSizeGauge)
 
+	poolAllocatedGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ipam_ippool_allocated",
+		Help: "Number of addresses in the IP Pool that are in IPAM blocks, whether or not they are in use",
+	}, []string{"ippool"})
+	prometheus.MustRegister(poolAllocatedGauge)
+
+	poolInUseGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ipam_ippool_in_use",
+		Help: "Number of addresses in the IP Pool that are allocated to a workload or tunnel endpoint",
+	}, []string{"ippool"})
+	prometheus.MustRegister(poolInUseGauge)
+
+	poolUtilizationGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ipam_ippool_utilization",
+		Help: "Fraction of the addresses in the IP Pool that are allocated to a workload or tunnel endpoint",
+	}, []string{"ippool"})
+	prometheus.MustRegister(poolUtilizationGauge)
+
+	poolFreeBlocksGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ipam_ippool_free_blocks",
+		Help: "Number of IPAM blocks in the IP Pool that have not yet been allocated to a node",
+	}, []string{"ippool"})
+	prometheus.MustRegister(poolFreeBlocksGauge)
+
 	// Total IP allocations.
 	legacyAllocationsGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "ipam_allocations_per_node",
@@ -143,6 +180,7 @@ func NewIPAMController(cfg config.NodeControllerConfig, c client.Interface, cs k
 		blocksByNode:                make(map[string]map[string]bool),
 		emptyBlocks:                 make(map[string]string),
 		poolManager:                 newPoolManager(),
+		poolsOverThreshold:          make(map[string]bool),
 		datastoreReady:              true,
 
 		// Track blocks which we might want to release.
@@ -187,6 +225,10 @@ type ipamController struct {
 	poolManager         *poolManager
 	blockReleaseTracker *blockReleaseTracker
 
+	// The pools whose utilization is above the warning threshold, so that we only generate an
+	// event when a pool crosses it.
+	poolsOverThreshold map[string]bool
+
 	// Cache pods to avoid unnecessary API queries.
 	podCache map[string]*v1.Pod
 
@@ -573,6 +615,8 @@ func (c *ipamController) onPoolUpdated(pool *apiv3.IPPool) {
 func (c *ipamController) onPoolDeleted(poolName string) {
 	unregisterMetricVectorsForPool(poolName)
 	clearPoolSizeMetric(poolName)
+	clearPoolUtilizationMetrics(poolName)
+	delete(c.poolsOverThreshold, poolName)
 
 	c.poolManager.onPoolDeleted(poolName)
 }
@@ -590,7 +634,10 @@ func (c *ipamController) updateMetrics() {
 		inUseAllocationsByNode := c.createZeroedMapForNodeValues(poolName)
 		borrowedAllocationsByNode := c.createZeroedMapForNodeValues(poolName)
 		gcCandidatesByNode := c.createZeroedMapForNodeValues(poolName)
+		leakedAllocationsByNode := c.createZeroedMapForNodeValues(poolName)
 		blocksByNode := map[string]int{}
+		blockCapacityByNode := map[string]int{}
+		poolAllocated, poolInUse := 0, 0
 
 		for blockCIDR := range poolBlocks {
 			b := c.allBlocks[blockCIDR].Value.(*model.AllocationBlock)
@@ -602,6 +649,8 @@ func (c *ipamController) updateMetrics() {
 
 			legacyBlocksByNode[affineNode]++
 			blocksByNode[affineNode]++
+			blockCapacityByNode[affineNode] += b.NumAddresses()
+			poolAllocated += b.NumAddresses()
 
 			// Go through each IPAM allocation, check its attributes for the node it is assigned to.
 			for _, allocation := range c.allocationsByBlock[blockCIDR] {
@@ -613,6 +662,7 @@ func (c *ipamController) updateMetrics() {
 
 				// Update metrics maps with this allocation.
 				inUseAllocationsByNode[allocationNode]++
+				poolInUse++
 
 				if allocationNode != unknownNodeLabel && (b.Affinity == nil || allocationNode != affineNode) {
 					// If the allocation's node doesn't match the block's, then this is borrowed.
@@ -625,6 +675,9 @@ func (c *ipamController) updateMetrics() {
 				if allocation.isCandidateLeak() || allocation.isConfirmedLeak() {
 					gcCandidatesByNode[allocationNode]++
 				}
+				if allocation.isConfirmedLeak() {
+					leakedAllocationsByNode[allocationNode]++
+				}
 			}
 		}
 
@@ -633,6 +686,12 @@ func (c *ipamController) updateMetrics() {
 		updatePoolGaugeWithNodeValues(borrowedAllocationGauges, poolName, borrowedAllocationsByNode)
 		updatePoolGaugeWithNodeValues(blocksGauges, poolName, blocksByNode)
 		updatePoolGaugeWithNodeValues(gcCandidateGauges, poolName, gcCandidatesByNode)
+		updatePoolGaugeWithNodeValues(leakedAllocationGauges, poolName, leakedAllocationsByNode)
+		updatePoolGaugeWithNodeValues(blockCapacityGauges, poolName, blockCapacityByNode)
+
+		if pool := c.poolManager.allPools[poolName]; pool != nil {
+			c.updatePoolUtilization(pool, len(poolBlocks), poolAllocated, poolInUse)
+		}
 	}
 
 	// Update legacy gauges
@@ -1236,6 +1295,21 @@ func registerMetricVectorsForPool(poolName string) {
 		ConstLabels: prometheus.Labels{"ippool": poolName},
 	}, []string{"node"})
 	prometheus.MustRegister(gcReclamationCounters[poolName])
+
+	leakedAllocationGauges[poolName] = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ipam_allocations_leaked",
+		Help: "Allocations that the garbage collector has confirmed as leaked. Under normal operation, this metric " +
+			"should return to zero when the garbage collector reclaims them.",
+		ConstLabels: prometheus.Labels{"ippool": poolName},
+	}, []string{"node"})
+	prometheus.MustRegister(leakedAllocationGauges[poolName])
+
+	blockCapacityGauges[poolName] = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name:        "ipam_blocks_capacity",
+		Help:        "Number of addresses in the IPAM blocks of the IP pool that are affine to the node.",
+		ConstLabels: prometheus.Labels{"ippool": poolName},
+	}, []string{"node"})
+	prometheus.MustRegister(blockCapacityGauges[poolName])
 }
 
 func unregisterMetricVectorsForPool(poolName string) {
@@ -1263,6 +1337,16 @@ func unregisterMetricVectorsForPool(poolName string) {
 		prometheus.Unregister(gcReclamationCounters[poolName])
 		delete(gcReclamationCounters, poolName)
 	}
+
+	if _, ok := leakedAllocationGauges[poolName]; ok {
+		prometheus.Unregister(leakedAllocationGauges[poolName])
+		delete(leakedAllocationGauges, poolName)
+	}
+
+	if _, ok := blockCapacityGauges[poolName]; ok {
+		prometheus.Unregister(blockCapacityGauges[poolName])
+		delete(blockCapacityGauges, poolName)
+	}
 }
 
 // Creates map used to index gauge values by node, and seeds with zeroes to create explicit zero values rather than
@@ -1309,6 +1393,100 @@ func clearPoolSizeMetric(poolName string) {
 	poolSizeGauge.Delete(prometheus.Labels{"ippool": poolName})
 }
 
+// updatePoolUtilization publishes the pool's utilization metrics and generates a warning event if either
+// the fraction of the pool's addresses that are in use, or the fraction of its blocks that have been allocated,
+// has crossed the warning threshold.  Once all of a pool's blocks are allocated, new nodes can't get a block
+// from the pool even if the existing blocks have plenty of free addresses.
+func (c *ipamController) updatePoolUtilization(pool *apiv3.IPPool, numBlocks, allocated, inUse int) {
+	_, poolNet, err := cnet.ParseCIDR(pool.Spec.CIDR)
+	if err != nil {
+		log.WithError(err).Warnf("Unable to parse CIDR for IP Pool %s", pool.Name)
+		return
+	}
+	ones, bits := poolNet.Mask.Size()
+	poolSize := math.Pow(2, float64(bits-ones))
+	utilization := float64(inUse) / poolSize
+
+	blockSize := pool.Spec.BlockSize
+	if blockSize == 0 {
+		// Pools are normally defaulted when they are created, but use the same defaults just in case.
+		blockSize = 26
+		if poolNet.Version() == 6 {
+			blockSize = 122
+		}
+	}
+	totalBlocks := math.Pow(2, float64(blockSize-ones))
+	freeBlocks := math.Max(totalBlocks-float64(numBlocks), 0)
+	blockUtilization := (totalBlocks - freeBlocks) / totalBlocks
+
+	labels := prometheus.Labels{"ippool": pool.Name}
+	poolAllocatedGauge.With(labels).Set(float64(allocated))
+	poolInUseGauge.With(labels).Set(float64(inUse))
+	poolUtilizationGauge.With(labels).Set(utilization)
+	poolFreeBlocksGauge.With(labels).Set(freeBlocks)
+
+	threshold := c.config.IPPoolUtilizationWarningThreshold
+	addressesOver := utilization*100 >= float64(threshold)
+	blocksOver := blockUtilization*100 >= float64(threshold)
+	if threshold <= 0 || (!addressesOver && !blocksOver) {
+		if c.poolsOverThreshold[pool.Name] {
+			log.Infof("IP Pool %s utilization is back below %d%%", pool.Name, threshold)
+			delete(c.poolsOverThreshold, pool.Name)
+		}
+		return
+	}
+	if c.poolsOverThreshold[pool.Name] {
+		// We've already generated an event for this pool.
+		return
+	}
+
+	var msg string
+	if addressesOver {
+		msg = fmt.Sprintf("IP Pool %s has %d of its %.0f addresses in use (%.0f%%), which is above the warning threshold of %d%%",
+			pool.Name, inUse, poolSize, utilization*100, threshold)
+	} else {
+		msg = fmt.Sprintf("IP Pool %s has %.0f of its %.0f blocks free (%.0f%% allocated), which is above the warning threshold of %d%%",
+			pool.Name, freeBlocks, totalBlocks, blockUtilization*100, threshold)
+	}
+	log.Warn(msg)
+	now := metav1.Now()
+	event := &v1.Event{
+		// Name the event in the same way as the client-go event recorder.
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%v.%x", pool.Name, now.UnixNano()),
+			Namespace: metav1.NamespaceDefault,
+		},
+		InvolvedObject: v1.ObjectReference{
+			APIVersion: apiv3.GroupVersionCurrent,
+			Kind:       apiv3.KindIPPool,
+			Name:       pool.Name,
+			UID:        pool.UID,
+		},
+		Reason:         poolUtilizationHighReason,
+		Message:        msg,
+		Type:           v1.EventTypeWarning,
+		Source:         v1.EventSource{Component: "calico-kube-controllers"},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	_, err = c.clientset.CoreV1().Events(metav1.NamespaceDefault).Create(context.Background(), event, metav1.CreateOptions{})
+	if err != nil {
+		// Leave the pool out of poolsOverThreshold so that we try again on the next metrics update.
+		log.WithError(err).Warnf("Failed to create event for IP Pool %s utilization", pool.Name)
+		return
+	}
+	c.poolsOverThreshold[pool.Name] = true
+}
+
+func clearPoolUtilizationMetrics(poolName string) {
+	labels := prometheus.Labels{"ippool": poolName}
+	poolAllocatedGauge.Delete(labels)
+	poolInUseGauge.Delete(labels)
+	poolUtilizationGauge.Delete(labels)
+	poolFreeBlocksGauge.Delete(labels)
+}
+
 // When we stop tracking a node, clear counters to prevent accumulation of stale metrics.
 func clearReclaimedIPCountForNode(node string) {
 	for _, reclamationCounter := range gcReclamationCounters {
@@ -225,7 +225,8 @@ func (a *allocation) isTunnelAddress() bool {
 	ipip := a.attrs[ipam.AttributeType] == ipam.AttributeTypeIPIP
 	vxlan := a.attrs[ipam.AttributeType] == ipam.AttributeTypeVXLAN
 	wg := a.attrs[ipam.AttributeType] == ipam.AttributeTypeWireguard
-	return ipip || vxlan || wg
+	wgV6 := a.attrs[ipam.AttributeType] == ipam.AttributeTypeWireguardV6
+	return ipip || vxlan || wg || wgV6
 }
 
 func (a *allocation) isWindowsReserved() bool {
@@ -24,10 +24,14 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	"github.com/projectcalico/calico/kube-controllers/pkg/config"
 	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
@@ -637,6 +641,108 @@ var _ = Describe("IPAM controller UTs", func() {
 		}, 1*time.Second, 100*time.Millisecond).Should(Equal(false), "Cache not updated after DELETE")
 	})
 
+	It("should publish pool utilization and generate an event when it crosses the threshold", func() {
+		// Start the controller.
+		c.Start(stopChan)
+
+		// A /28 pool with /30 blocks has 16 addresses in 4 blocks.
+		pool := &apiv3.IPPool{}
+		pool.Name = "utilization-pool"
+		pool.Spec.CIDR = "192.168.0.0/28"
+		pool.Spec.BlockSize = 30
+		labels := prometheus.Labels{"ippool": pool.Name}
+		utilizationEvents := func() []v1.Event {
+			events, err := cs.CoreV1().Events(metav1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			var matching []v1.Event
+			for _, e := range events.Items {
+				if e.InvolvedObject.Name == pool.Name && e.Reason == poolUtilizationHighReason {
+					matching = append(matching, e)
+				}
+			}
+			return matching
+		}
+		updatePoolUtilization := func(numBlocks, inUse int) {
+			done := c.pause()
+			defer done()
+			c.config.IPPoolUtilizationWarningThreshold = 50
+			c.updatePoolUtilization(pool, numBlocks, numBlocks*4, inUse)
+		}
+
+		// Below the threshold: metrics but no event.
+		updatePoolUtilization(1, 2)
+		Expect(testutil.ToFloat64(poolAllocatedGauge.With(labels))).To(Equal(4.0))
+		Expect(testutil.ToFloat64(poolInUseGauge.With(labels))).To(Equal(2.0))
+		Expect(testutil.ToFloat64(poolUtilizationGauge.With(labels))).To(Equal(0.125))
+		Expect(testutil.ToFloat64(poolFreeBlocksGauge.With(labels))).To(Equal(3.0))
+		Expect(utilizationEvents()).To(BeEmpty())
+
+		// Crossing the threshold generates a single warning event.
+		updatePoolUtilization(2, 8)
+		updatePoolUtilization(2, 9)
+		Expect(testutil.ToFloat64(poolUtilizationGauge.With(labels))).To(Equal(9.0 / 16))
+		events := utilizationEvents()
+		Expect(events).To(HaveLen(1))
+		Expect(events[0].Type).To(Equal(v1.EventTypeWarning))
+		Expect(events[0].InvolvedObject.Kind).To(Equal(apiv3.KindIPPool))
+		Expect(events[0].Message).To(ContainSubstring("has 8 of its 16 addresses in use (50%)"))
+
+		// Dropping below the threshold and then running out of free blocks generates another event, even
+		// though few of the addresses are in use.
+		updatePoolUtilization(1, 1)
+		updatePoolUtilization(3, 1)
+		Expect(testutil.ToFloat64(poolFreeBlocksGauge.With(labels))).To(Equal(1.0))
+		events = utilizationEvents()
+		Expect(events).To(HaveLen(2))
+		Expect(events[0].Message + events[1].Message).To(ContainSubstring("has 1 of its 4 blocks free (75% allocated)"))
+
+		// Deleting the pool clears its metrics.  Other tests may have left metrics for their pools.
+		numPools := testutil.CollectAndCount(poolFreeBlocksGauge)
+		func() {
+			done := c.pause()
+			defer done()
+			c.onPoolDeleted(pool.Name)
+		}()
+		Expect(testutil.CollectAndCount(poolFreeBlocksGauge)).To(Equal(numPools - 1))
+	})
+
+	It("should retry the utilization event if it fails to create it", func() {
+		// Start the controller.
+		c.Start(stopChan)
+
+		pool := &apiv3.IPPool{}
+		pool.Name = "utilization-retry-pool"
+		pool.Spec.CIDR = "192.168.0.0/30"
+		pool.Spec.BlockSize = 30
+
+		failCreates := true
+		cs.(*fake.Clientset).PrependReactor("create", "events", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			if failCreates {
+				return true, nil, fmt.Errorf("injected failure")
+			}
+			return false, nil, nil
+		})
+		updatePoolUtilization := func() {
+			done := c.pause()
+			defer done()
+			c.config.IPPoolUtilizationWarningThreshold = 50
+			c.updatePoolUtilization(pool, 1, 4, 4)
+		}
+
+		updatePoolUtilization()
+		events, err := cs.CoreV1().Events(metav1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(events.Items).To(BeEmpty())
+
+		// The next update, with the pool still over the threshold, creates the event.
+		failCreates = false
+		updatePoolUtilization()
+		events, err = cs.CoreV1().Events(metav1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(events.Items).To(HaveLen(1))
+		Expect(events.Items[0].InvolvedObject.Name).To(Equal(pool.Name))
+	})
+
 	It("should clean up leaked IP addresses", func() {
 		// Add a new block with one allocation - on a valid node but no corresponding pod.
 		n := libapiv3.Node{}
@@ -22,6 +22,8 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
 	api "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/conversion"
 
@@ -36,6 +38,8 @@ type WorkloadEndpointData struct {
 	Namespace      string
 	Labels         map[string]string
 	ServiceAccount string
+	QoSControls    *api.QoSControls
+	EgressGateway  *apiv3.EgressGatewaySpec
 }
 
 type PodConverter interface {
@@ -57,6 +61,8 @@ func BuildWorkloadEndpointData(weps ...api.WorkloadEndpoint) []WorkloadEndpointD
 			Namespace:      wep.Namespace,
 			Labels:         wep.Labels,
 			ServiceAccount: wep.Spec.ServiceAccountName,
+			QoSControls:    wep.Spec.QoSControls,
+			EgressGateway:  wep.Spec.EgressGateway,
 		})
 	}
 
@@ -71,6 +77,8 @@ func MergeWorkloadEndpointData(wep *api.WorkloadEndpoint, upd WorkloadEndpointDa
 	}
 	wep.Labels = upd.Labels
 	wep.Spec.ServiceAccountName = upd.ServiceAccount
+	wep.Spec.QoSControls = upd.QoSControls
+	wep.Spec.EgressGateway = upd.EgressGateway
 }
 
 // NewPodConverter Constructor for podConverter
@@ -21,6 +21,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
 	"github.com/projectcalico/calico/kube-controllers/pkg/converter"
 	api "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
 )
@@ -188,4 +190,73 @@ var _ = Describe("PodConverter", func() {
 			Expect(wep.Labels).To(Equal(expectedLabels))
 		})
 	})
+
+	It("should convert and merge a Pod's QoS controls", func() {
+		pod := v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "podA",
+				Namespace: "default",
+				Annotations: map[string]string{
+					"kubernetes.io/ingress-bandwidth": "10M",
+					"kubernetes.io/egress-bandwidth":  "20M",
+				},
+			},
+			Spec: v1.PodSpec{
+				NodeName: "nodeA",
+			},
+		}
+
+		wepDatas, err := c.Convert(&pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(len(wepDatas)).Should(Equal(1))
+		wepData := wepDatas[0]
+
+		expectedQoS := &api.QoSControls{
+			IngressBandwidth: 10000000,
+			EgressBandwidth:  20000000,
+		}
+		By("returning a WorkloadEndpointData with the pod's QoS controls", func() {
+			Expect(wepData.QoSControls).To(Equal(expectedQoS))
+		})
+
+		wep := api.NewWorkloadEndpoint()
+		wep.Name = "nodename-k8s-podA-eth0"
+		wep.Namespace = "default"
+		wep.Spec.Pod = "podA"
+		converter.MergeWorkloadEndpointData(wep, wepData)
+
+		By("updating the wep's QoS controls", func() {
+			Expect(wep.Spec.QoSControls).To(Equal(expectedQoS))
+		})
+	})
+
+	It("should convert and merge a Pod's egress gateway selection", func() {
+		pod := v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "podA",
+				Namespace: "default",
+				Annotations: map[string]string{
+					"egress.projectcalico.org/selector": "egress-code == 'red'",
+				},
+			},
+			Spec: v1.PodSpec{
+				NodeName: "nodeA",
+			},
+		}
+
+		wepDatas, err := c.Convert(&pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(len(wepDatas)).Should(Equal(1))
+		wepData := wepDatas[0]
+
+		expectedEgress := &apiv3.EgressGatewaySpec{Selector: "egress-code == 'red'"}
+		Expect(wepData.EgressGateway).To(Equal(expectedEgress))
+
+		wep := api.NewWorkloadEndpoint()
+		wep.Name = "nodename-k8s-podA-eth0"
+		wep.Namespace = "default"
+		wep.Spec.Pod = "podA"
+		converter.MergeWorkloadEndpointData(wep, wepData)
+		Expect(wep.Spec.EgressGateway).To(Equal(expectedEgress))
+	})
 })
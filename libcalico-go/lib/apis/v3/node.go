@@ -55,6 +55,12 @@ type NodeSpec struct {
 	// VXLANTunnelMACAddr is the MAC address of the VXLAN tunnel.
 	VXLANTunnelMACAddr string `json:"vxlanTunnelMACAddr,omitempty" validate:"omitempty,mac"`
 
+	// IPv6VXLANTunnelAddr is the address of the IPv6 VXLAN tunnel.
+	IPv6VXLANTunnelAddr string `json:"ipv6VXLANTunnelAddr,omitempty" validate:"omitempty,ipv6"`
+
+	// VXLANTunnelMACAddrV6 is the MAC address of the IPv6 VXLAN tunnel.
+	VXLANTunnelMACAddrV6 string `json:"vxlanTunnelMACAddrV6,omitempty" validate:"omitempty,mac"`
+
 	// OrchRefs for this node.
 	OrchRefs []OrchRef `json:"orchRefs,omitempty" validate:"omitempty"`
 
@@ -79,6 +85,26 @@ type NodeStatus struct {
 	// wireguardPublicKey validates if the string is a valid base64 encoded key.
 	WireguardPublicKey string `json:"wireguardPublicKey,omitempty" validate:"omitempty,wireguardPublicKey"`
 
+	// WireguardPublicKeyV6 is the Wireguard public-key for this node's IPv6 wireguard device.
+	// wireguardPublicKey validates if the string is a valid base64 encoded key.
+	WireguardPublicKeyV6 string `json:"wireguardPublicKeyV6,omitempty" validate:"omitempty,wireguardPublicKey"`
+
+	// WireguardPendingPublicKey is the next Wireguard public-key for this node.  It is published ahead of a
+	// key rotation; the node switches over to it once all of its peers list it in their
+	// WireguardAppliedPendingPublicKeys.
+	WireguardPendingPublicKey string `json:"wireguardPendingPublicKey,omitempty" validate:"omitempty,wireguardPublicKey"`
+
+	// WireguardPendingPublicKeyV6 is the next Wireguard public-key for this node's IPv6 wireguard device.
+	WireguardPendingPublicKeyV6 string `json:"wireguardPendingPublicKeyV6,omitempty" validate:"omitempty,wireguardPublicKey"`
+
+	// WireguardAppliedPendingPublicKeys are the pending Wireguard public-keys of other nodes that this node has
+	// programmed alongside their current public-keys.
+	WireguardAppliedPendingPublicKeys []string `json:"wireguardAppliedPendingPublicKeys,omitempty" validate:"omitempty,dive,wireguardPublicKey"`
+
+	// WireguardAppliedPendingPublicKeysV6 are the pending Wireguard public-keys of other nodes that this node's IPv6
+	// wireguard device has programmed alongside their current public-keys.
+	WireguardAppliedPendingPublicKeysV6 []string `json:"wireguardAppliedPendingPublicKeysV6,omitempty" validate:"omitempty,dive,wireguardPublicKey"`
+
 	// PodCIDR is a reflection of the Kubernetes node's spec.PodCIDRs field.
 	PodCIDRs []string `json:"podCIDRs,omitempty" validate:"omitempty"`
 }
@@ -113,6 +139,9 @@ type NodeBGPSpec struct {
 type NodeWireguardSpec struct {
 	// InterfaceIPv4Address is the IPv4 address for the Wireguard interface.
 	InterfaceIPv4Address string `json:"interfaceIPv4Address,omitempty" validate:"omitempty,ipv4"`
+
+	// InterfaceIPv6Address is the IPv6 address for the Wireguard interface.
+	InterfaceIPv6Address string `json:"interfaceIPv6Address,omitempty" validate:"omitempty,ipv6"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -91,6 +91,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/projectcalico/calico/libcalico-go/lib/apis/v3.NodeStatus":               schema_libcalico_go_lib_apis_v3_NodeStatus(ref),
 		"github.com/projectcalico/calico/libcalico-go/lib/apis/v3.NodeWireguardSpec":        schema_libcalico_go_lib_apis_v3_NodeWireguardSpec(ref),
 		"github.com/projectcalico/calico/libcalico-go/lib/apis/v3.OrchRef":                  schema_libcalico_go_lib_apis_v3_OrchRef(ref),
+		"github.com/projectcalico/calico/libcalico-go/lib/apis/v3.QoSControls":              schema_libcalico_go_lib_apis_v3_QoSControls(ref),
 		"github.com/projectcalico/calico/libcalico-go/lib/apis/v3.WorkloadEndpoint":         schema_libcalico_go_lib_apis_v3_WorkloadEndpoint(ref),
 		"github.com/projectcalico/calico/libcalico-go/lib/apis/v3.WorkloadEndpointList":     schema_libcalico_go_lib_apis_v3_WorkloadEndpointList(ref),
 		"github.com/projectcalico/calico/libcalico-go/lib/apis/v3.WorkloadEndpointPort":     schema_libcalico_go_lib_apis_v3_WorkloadEndpointPort(ref),
@@ -2801,6 +2802,20 @@ func schema_libcalico_go_lib_apis_v3_NodeSpec(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"ipv6VXLANTunnelAddr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPv6VXLANTunnelAddr is the address of the IPv6 VXLAN tunnel.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vxlanTunnelMACAddrV6": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VXLANTunnelMACAddrV6 is the MAC address of the IPv6 VXLAN tunnel.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"orchRefs": {
 						SchemaProps: spec.SchemaProps{
 							Description: "OrchRefs for this node.",
@@ -2856,6 +2871,57 @@ func schema_libcalico_go_lib_apis_v3_NodeStatus(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"wireguardPublicKeyV6": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WireguardPublicKeyV6 is the Wireguard public-key for this node's IPv6 wireguard device. wireguardPublicKey validates if the string is a valid base64 encoded key.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"wireguardPendingPublicKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WireguardPendingPublicKey is the next Wireguard public-key for this node.  It is published ahead of a key rotation; the node switches over to it once all of its peers list it in their WireguardAppliedPendingPublicKeys.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"wireguardPendingPublicKeyV6": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WireguardPendingPublicKeyV6 is the next Wireguard public-key for this node's IPv6 wireguard device.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"wireguardAppliedPendingPublicKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WireguardAppliedPendingPublicKeys are the pending Wireguard public-keys of other nodes that this node has programmed alongside their current public-keys.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"wireguardAppliedPendingPublicKeysV6": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WireguardAppliedPendingPublicKeysV6 are the pending Wireguard public-keys of other nodes that this node's IPv6 wireguard device has programmed alongside their current public-keys.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 					"podCIDRs": {
 						SchemaProps: spec.SchemaProps{
 							Description: "PodCIDR is a reflection of the Kubernetes node's spec.PodCIDRs field.",
@@ -2891,6 +2957,13 @@ func schema_libcalico_go_lib_apis_v3_NodeWireguardSpec(ref common.ReferenceCallb
 							Format:      "",
 						},
 					},
+					"interfaceIPv6Address": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InterfaceIPv6Address is the IPv6 address for the Wireguard interface.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -2926,6 +2999,61 @@ func schema_libcalico_go_lib_apis_v3_OrchRef(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_libcalico_go_lib_apis_v3_QoSControls(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QoSControls contains the limits that Felix applies to a workload endpoint's traffic.  Ingress and egress are from the point of view of the workload.  A zero value means no limit.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ingressBandwidth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IngressBandwidth is the bandwidth limit, in bits per second, for traffic to the workload.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"egressBandwidth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EgressBandwidth is the bandwidth limit, in bits per second, for traffic from the workload.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"ingressBurst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IngressBurst is the burst size, in bits, allowed for traffic to the workload.  If not specified, a default burst is used.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"egressBurst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EgressBurst is the burst size, in bits, allowed for traffic from the workload.  If not specified, a default burst is used.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"ingressPacketRate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IngressPacketRate is the packet rate limit, in packets per second, for traffic to the workload.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"egressPacketRate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EgressPacketRate is the packet rate limit, in packets per second, for traffic from the workload.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_libcalico_go_lib_apis_v3_WorkloadEndpoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3210,10 +3338,22 @@ func schema_libcalico_go_lib_apis_v3_WorkloadEndpointSpec(ref common.ReferenceCa
 							},
 						},
 					},
+					"qosControls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QoSControls contains the bandwidth and packet rate limits to apply to the endpoint.",
+							Ref:         ref("github.com/projectcalico/calico/libcalico-go/lib/apis/v3.QoSControls"),
+						},
+					},
+					"egressGateway": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EgressGateway selects the egress gateways that the endpoint's off-cluster traffic is routed through.  If not specified, the egress gateways of the endpoint's profiles are used.",
+							Ref:         ref("github.com/projectcalico/api/pkg/apis/projectcalico/v3.EgressGatewaySpec"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/projectcalico/calico/libcalico-go/lib/apis/v3.IPNAT", "github.com/projectcalico/calico/libcalico-go/lib/apis/v3.WorkloadEndpointPort"},
+			"github.com/projectcalico/api/pkg/apis/projectcalico/v3.EgressGatewaySpec", "github.com/projectcalico/calico/libcalico-go/lib/apis/v3.IPNAT", "github.com/projectcalico/calico/libcalico-go/lib/apis/v3.QoSControls", "github.com/projectcalico/calico/libcalico-go/lib/apis/v3.WorkloadEndpointPort"},
 	}
 }
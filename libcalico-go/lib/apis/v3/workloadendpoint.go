@@ -79,6 +79,30 @@ type WorkloadEndpointSpec struct {
 	MAC string `json:"mac,omitempty" validate:"omitempty,mac"`
 	// Ports contains the endpoint's named ports, which may be referenced in security policy rules.
 	Ports []WorkloadEndpointPort `json:"ports,omitempty" validate:"dive,omitempty"`
+	// QoSControls contains the bandwidth and packet rate limits to apply to the endpoint.
+	QoSControls *QoSControls `json:"qosControls,omitempty"`
+	// EgressGateway selects the egress gateways that the endpoint's off-cluster traffic is routed
+	// through.  If not specified, the egress gateways of the endpoint's profiles are used.
+	EgressGateway *apiv3.EgressGatewaySpec `json:"egressGateway,omitempty" validate:"omitempty"`
+}
+
+// QoSControls contains the limits that Felix applies to a workload endpoint's traffic.  Ingress
+// and egress are from the point of view of the workload.  A zero value means no limit.
+type QoSControls struct {
+	// IngressBandwidth is the bandwidth limit, in bits per second, for traffic to the workload.
+	IngressBandwidth int64 `json:"ingressBandwidth,omitempty" validate:"omitempty,gte=1000,lte=1000000000000000"`
+	// EgressBandwidth is the bandwidth limit, in bits per second, for traffic from the workload.
+	EgressBandwidth int64 `json:"egressBandwidth,omitempty" validate:"omitempty,gte=1000,lte=1000000000000000"`
+	// IngressBurst is the burst size, in bits, allowed for traffic to the workload.  If not
+	// specified, a default burst is used.
+	IngressBurst int64 `json:"ingressBurst,omitempty" validate:"omitempty,gte=0,lte=34359738360"`
+	// EgressBurst is the burst size, in bits, allowed for traffic from the workload.  If not
+	// specified, a default burst is used.
+	EgressBurst int64 `json:"egressBurst,omitempty" validate:"omitempty,gte=0,lte=34359738360"`
+	// IngressPacketRate is the packet rate limit, in packets per second, for traffic to the workload.
+	IngressPacketRate int64 `json:"ingressPacketRate,omitempty" validate:"omitempty,gte=1,lte=10000"`
+	// EgressPacketRate is the packet rate limit, in packets per second, for traffic from the workload.
+	EgressPacketRate int64 `json:"egressPacketRate,omitempty" validate:"omitempty,gte=1,lte=10000"`
 }
 
 // WorkloadEndpointPort represents one endpoint's named or mapped port
@@ -20,6 +20,7 @@
 package v3
 
 import (
+	projectcalicov3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 	numorstring "github.com/projectcalico/api/pkg/lib/numorstring"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -551,6 +552,16 @@ func (in *NodeSpec) DeepCopy() *NodeSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeStatus) DeepCopyInto(out *NodeStatus) {
 	*out = *in
+	if in.WireguardAppliedPendingPublicKeys != nil {
+		in, out := &in.WireguardAppliedPendingPublicKeys, &out.WireguardAppliedPendingPublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.WireguardAppliedPendingPublicKeysV6 != nil {
+		in, out := &in.WireguardAppliedPendingPublicKeysV6, &out.WireguardAppliedPendingPublicKeysV6
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.PodCIDRs != nil {
 		in, out := &in.PodCIDRs, &out.PodCIDRs
 		*out = make([]string, len(*in))
@@ -601,6 +612,22 @@ func (in *OrchRef) DeepCopy() *OrchRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QoSControls) DeepCopyInto(out *QoSControls) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QoSControls.
+func (in *QoSControls) DeepCopy() *QoSControls {
+	if in == nil {
+		return nil
+	}
+	out := new(QoSControls)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadEndpoint) DeepCopyInto(out *WorkloadEndpoint) {
 	*out = *in
@@ -701,6 +728,16 @@ func (in *WorkloadEndpointSpec) DeepCopyInto(out *WorkloadEndpointSpec) {
 		*out = make([]WorkloadEndpointPort, len(*in))
 		copy(*out, *in)
 	}
+	if in.QoSControls != nil {
+		in, out := &in.QoSControls, &out.QoSControls
+		*out = new(QoSControls)
+		**out = **in
+	}
+	if in.EgressGateway != nil {
+		in, out := &in.EgressGateway, &out.EgressGateway
+		*out = new(projectcalicov3.EgressGatewaySpec)
+		**out = **in
+	}
 	return
 }
 
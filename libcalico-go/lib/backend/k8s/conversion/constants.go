@@ -41,6 +41,28 @@ const (
 	// on older Pods.
 	AnnotationContainerID = "cni.projectcalico.org/containerID"
 
+	// AnnotationIngressBandwidth and AnnotationEgressBandwidth are the standard Kubernetes pod
+	// annotations used to limit a pod's bandwidth, in bits per second (e.g. "10M").  Ingress and
+	// egress are from the point of view of the pod.
+	AnnotationIngressBandwidth = "kubernetes.io/ingress-bandwidth"
+	AnnotationEgressBandwidth  = "kubernetes.io/egress-bandwidth"
+
+	// AnnotationIngressBurst and AnnotationEgressBurst override the burst size, in bits, used with
+	// the bandwidth limits above.
+	AnnotationIngressBurst = "qos.projectcalico.org/ingressBurst"
+	AnnotationEgressBurst  = "qos.projectcalico.org/egressBurst"
+
+	// AnnotationIngressPacketRate and AnnotationEgressPacketRate limit a pod's packet rate, in
+	// packets per second.
+	AnnotationIngressPacketRate = "qos.projectcalico.org/ingressPacketRate"
+	AnnotationEgressPacketRate  = "qos.projectcalico.org/egressPacketRate"
+
+	// AnnotationEgressSelector and AnnotationEgressNamespaceSelector select the egress gateway pods
+	// that a pod's off-cluster traffic is routed through.  They may be set on a pod or, to apply to
+	// all pods that don't have their own, on its namespace.
+	AnnotationEgressSelector          = "egress.projectcalico.org/selector"
+	AnnotationEgressNamespaceSelector = "egress.projectcalico.org/namespaceSelector"
+
 	// NameLabel is a label that can be used to match a serviceaccount or namespace
 	// name exactly.
 	NameLabel = "projectcalico.org/name"
@@ -112,6 +112,14 @@ func (c converter) NamespaceToProfile(ns *kapiv1.Namespace) (*model.KVPair, erro
 		LabelsToApply: labels,
 	}
 
+	// Namespace-wide egress gateway selection, used by pods that don't have their own.
+	egressGateway, err := AnnotationsToEgressGateway(ns.Annotations)
+	if err != nil {
+		log.WithError(err).WithField("namespace", ns.Name).Warn("Ignoring invalid egress gateway annotations on namespace")
+	} else {
+		profile.Spec.EgressGateway = egressGateway
+	}
+
 	// Embed the profile in a KVPair.
 	kvp := model.KVPair{
 		Key: model.ResourceKey{
@@ -559,6 +559,96 @@ var _ = Describe("Test Pod conversion", func() {
 
 	})
 
+	It("should parse the bandwidth and packet rate annotations", func() {
+		pod := kapiv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "podA",
+				Namespace: "default",
+				Annotations: map[string]string{
+					"cni.projectcalico.org/podIP":             "192.168.0.1",
+					"kubernetes.io/ingress-bandwidth":         "10M",
+					"kubernetes.io/egress-bandwidth":          "1G",
+					"qos.projectcalico.org/egressBurst":       "2M",
+					"qos.projectcalico.org/ingressPacketRate": "1k",
+				},
+				ResourceVersion: "1234",
+			},
+			Spec: kapiv1.PodSpec{
+				NodeName:   "nodeA",
+				Containers: []kapiv1.Container{},
+			},
+		}
+
+		wep, err := podToWorkloadEndpoint(c, &pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wep.Value.(*libapiv3.WorkloadEndpoint).Spec.QoSControls).To(Equal(&libapiv3.QoSControls{
+			IngressBandwidth:  10000000,
+			EgressBandwidth:   1000000000,
+			EgressBurst:       2000000,
+			IngressPacketRate: 1000,
+		}))
+
+		By("ignoring invalid annotations")
+		pod.Annotations["kubernetes.io/ingress-bandwidth"] = "1"
+		wep, err = podToWorkloadEndpoint(c, &pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wep.Value.(*libapiv3.WorkloadEndpoint).Spec.QoSControls).To(BeNil())
+		Expect(wep.Value.(*libapiv3.WorkloadEndpoint).Spec.IPNetworks).To(ConsistOf("192.168.0.1/32"))
+	})
+
+	DescribeTable("PodAnnotationsToQoSControls",
+		func(annotations map[string]string, expected *libapiv3.QoSControls, expectErr bool) {
+			qos, err := PodAnnotationsToQoSControls(annotations)
+			if expectErr {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(qos).To(Equal(expected))
+		},
+		Entry("no annotations", map[string]string{"arbitrary": "annotation"}, nil, false),
+		Entry("egress bandwidth", map[string]string{"kubernetes.io/egress-bandwidth": "100k"},
+			&libapiv3.QoSControls{EgressBandwidth: 100000}, false),
+		Entry("egress packet rate", map[string]string{"qos.projectcalico.org/egressPacketRate": "500"},
+			&libapiv3.QoSControls{EgressPacketRate: 500}, false),
+		Entry("unparseable bandwidth", map[string]string{"kubernetes.io/ingress-bandwidth": "lots"}, nil, true),
+		Entry("bandwidth too high", map[string]string{"kubernetes.io/ingress-bandwidth": "2P"}, nil, true),
+		Entry("packet rate too high", map[string]string{"qos.projectcalico.org/ingressPacketRate": "20k"}, nil, true),
+		Entry("burst without bandwidth", map[string]string{"qos.projectcalico.org/ingressBurst": "1M"}, nil, true),
+	)
+
+	It("should parse the egress gateway annotations", func() {
+		pod := kapiv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "podA",
+				Namespace: "default",
+				Annotations: map[string]string{
+					"cni.projectcalico.org/podIP":                "192.168.0.1",
+					"egress.projectcalico.org/selector":          "egress-code == 'red'",
+					"egress.projectcalico.org/namespaceSelector": "projectcalico.org/name == 'egress'",
+				},
+				ResourceVersion: "1234",
+			},
+			Spec: kapiv1.PodSpec{
+				NodeName:   "nodeA",
+				Containers: []kapiv1.Container{},
+			},
+		}
+
+		wep, err := podToWorkloadEndpoint(c, &pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wep.Value.(*libapiv3.WorkloadEndpoint).Spec.EgressGateway).To(Equal(&apiv3.EgressGatewaySpec{
+			Selector:          "egress-code == 'red'",
+			NamespaceSelector: "projectcalico.org/name == 'egress'",
+		}))
+
+		By("ignoring an invalid selector")
+		pod.Annotations["egress.projectcalico.org/selector"] = "egress-code == "
+		wep, err = podToWorkloadEndpoint(c, &pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(wep.Value.(*libapiv3.WorkloadEndpoint).Spec.EgressGateway).To(BeNil())
+	})
+
 	It("should return an error for a bad pod IP", func() {
 		pod := kapiv1.Pod{
 			ObjectMeta: metav1.ObjectMeta{
@@ -3042,6 +3132,24 @@ var _ = Describe("Test Namespace conversion", func() {
 		Expect(labels["pcns.roger"]).To(Equal("rabbit"))
 	})
 
+	It("should parse a Namespace's egress gateway annotations", func() {
+		ns := kapiv1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "default",
+				Annotations: map[string]string{
+					"egress.projectcalico.org/selector": "egress-code == 'blue'",
+				},
+			},
+			Spec: kapiv1.NamespaceSpec{},
+		}
+
+		p, err := c.NamespaceToProfile(&ns)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.Value.(*apiv3.Profile).Spec.EgressGateway).To(Equal(&apiv3.EgressGatewaySpec{
+			Selector: "egress-code == 'blue'",
+		}))
+	})
+
 	It("should parse a Namespace to a Profile with no labels", func() {
 		ns := kapiv1.Namespace{
 			ObjectMeta: metav1.ObjectMeta{
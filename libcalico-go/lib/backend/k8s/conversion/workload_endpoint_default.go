@@ -24,6 +24,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	kapiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
@@ -33,6 +34,7 @@ import (
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
 	"github.com/projectcalico/calico/libcalico-go/lib/names"
 	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+	"github.com/projectcalico/calico/libcalico-go/lib/selector"
 )
 
 type defaultWorkloadEndpointConverter struct{}
@@ -218,6 +220,20 @@ func (wc defaultWorkloadEndpointConverter) podToDefaultWorkloadEndpoint(pod *kap
 	// the same name.  For example, restarted stateful set pods.
 	containerID := pod.Annotations[AnnotationContainerID]
 
+	// Pull out the bandwidth and packet rate limits.  An invalid annotation shouldn't cut the pod
+	// off from the network so we log and ignore it here; the CNI plugin rejects it at pod creation.
+	qosControls, err := PodAnnotationsToQoSControls(pod.Annotations)
+	if err != nil {
+		log.WithError(err).WithField("pod", pod.Name).Warn("Ignoring invalid QoS annotations on pod")
+		qosControls = nil
+	}
+
+	egressGateway, err := AnnotationsToEgressGateway(pod.Annotations)
+	if err != nil {
+		log.WithError(err).WithField("pod", pod.Name).Warn("Ignoring invalid egress gateway annotations on pod")
+		egressGateway = nil
+	}
+
 	// Create the workload endpoint.
 	wep := libapiv3.NewWorkloadEndpoint()
 	wep.ObjectMeta = metav1.ObjectMeta{
@@ -240,6 +256,8 @@ func (wc defaultWorkloadEndpointConverter) podToDefaultWorkloadEndpoint(pod *kap
 		Ports:              endpointPorts,
 		IPNATs:             floatingIPs,
 		ServiceAccountName: pod.Spec.ServiceAccountName,
+		QoSControls:        qosControls,
+		EgressGateway:      egressGateway,
 	}
 
 	// Embed the workload endpoint into a KVPair.
@@ -254,3 +272,75 @@ func (wc defaultWorkloadEndpointConverter) podToDefaultWorkloadEndpoint(pod *kap
 	}
 	return &kvp, nil
 }
+
+// Limits on the QoS annotations, matching the validation of the WorkloadEndpoint QoSControls.
+const (
+	minBandwidth  = 1000
+	maxBandwidth  = 1000000000000000
+	maxBurst      = 34359738360
+	minPacketRate = 1
+	maxPacketRate = 10000
+)
+
+// PodAnnotationsToQoSControls parses the bandwidth and packet rate annotations of a pod.  It
+// returns nil if the pod has none of the annotations.
+func PodAnnotationsToQoSControls(annotations map[string]string) (*libapiv3.QoSControls, error) {
+	qos := &libapiv3.QoSControls{}
+	found := false
+	for _, a := range []struct {
+		name     string
+		min, max int64
+		value    *int64
+	}{
+		{AnnotationIngressBandwidth, minBandwidth, maxBandwidth, &qos.IngressBandwidth},
+		{AnnotationEgressBandwidth, minBandwidth, maxBandwidth, &qos.EgressBandwidth},
+		{AnnotationIngressBurst, 0, maxBurst, &qos.IngressBurst},
+		{AnnotationEgressBurst, 0, maxBurst, &qos.EgressBurst},
+		{AnnotationIngressPacketRate, minPacketRate, maxPacketRate, &qos.IngressPacketRate},
+		{AnnotationEgressPacketRate, minPacketRate, maxPacketRate, &qos.EgressPacketRate},
+	} {
+		str, ok := annotations[a.name]
+		if !ok {
+			continue
+		}
+		q, err := resource.ParseQuantity(str)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse annotation %s=%q: %s", a.name, str, err)
+		}
+		v := q.Value()
+		if v < a.min || v > a.max {
+			return nil, fmt.Errorf("annotation %s=%q is out of range, must be between %d and %d", a.name, str, a.min, a.max)
+		}
+		*a.value = v
+		found = true
+	}
+	if !found {
+		return nil, nil
+	}
+	if (qos.IngressBurst != 0 && qos.IngressBandwidth == 0) || (qos.EgressBurst != 0 && qos.EgressBandwidth == 0) {
+		return nil, fmt.Errorf("burst annotations require the matching bandwidth annotation")
+	}
+	return qos, nil
+}
+
+// AnnotationsToEgressGateway parses the egress gateway annotations of a pod or namespace.  It
+// returns nil if neither annotation is present.
+func AnnotationsToEgressGateway(annotations map[string]string) (*apiv3.EgressGatewaySpec, error) {
+	sel, hasSel := annotations[AnnotationEgressSelector]
+	nsSel, hasNSSel := annotations[AnnotationEgressNamespaceSelector]
+	if !hasSel && !hasNSSel {
+		return nil, nil
+	}
+	for _, a := range []struct{ name, value string }{
+		{AnnotationEgressSelector, sel},
+		{AnnotationEgressNamespaceSelector, nsSel},
+	} {
+		if _, err := selector.Parse(a.value); err != nil {
+			return nil, fmt.Errorf("failed to parse annotation %s=%q: %s", a.name, a.value, err)
+		}
+	}
+	return &apiv3.EgressGatewaySpec{
+		Selector:          sel,
+		NamespaceSelector: nsSel,
+	}, nil
+}
@@ -109,6 +109,12 @@ func NewKubeClient(ca *apiconfig.CalicoAPIConfigSpec) (api.Client, error) {
 		apiv3.KindIPReservation,
 		resources.NewIPReservationClient(cs, crdClientV1),
 	)
+	kubeClient.registerResourceClient(
+		reflect.TypeOf(model.ResourceKey{}),
+		reflect.TypeOf(model.ResourceListOptions{}),
+		apiv3.KindTier,
+		resources.NewTierClient(cs, crdClientV1),
+	)
 	kubeClient.registerResourceClient(
 		reflect.TypeOf(model.ResourceKey{}),
 		reflect.TypeOf(model.ResourceListOptions{}),
@@ -127,6 +133,18 @@ func NewKubeClient(ca *apiconfig.CalicoAPIConfigSpec) (api.Client, error) {
 		apiv3.KindNetworkPolicy,
 		resources.NewNetworkPolicyClient(cs, crdClientV1),
 	)
+	kubeClient.registerResourceClient(
+		reflect.TypeOf(model.ResourceKey{}),
+		reflect.TypeOf(model.ResourceListOptions{}),
+		apiv3.KindStagedGlobalNetworkPolicy,
+		resources.NewStagedGlobalNetworkPolicyClient(cs, crdClientV1),
+	)
+	kubeClient.registerResourceClient(
+		reflect.TypeOf(model.ResourceKey{}),
+		reflect.TypeOf(model.ResourceListOptions{}),
+		apiv3.KindStagedNetworkPolicy,
+		resources.NewStagedNetworkPolicyClient(cs, crdClientV1),
+	)
 	kubeClient.registerResourceClient(
 		reflect.TypeOf(model.ResourceKey{}),
 		reflect.TypeOf(model.ResourceListOptions{}),
@@ -145,12 +163,24 @@ func NewKubeClient(ca *apiconfig.CalicoAPIConfigSpec) (api.Client, error) {
 		apiv3.KindNetworkSet,
 		resources.NewNetworkSetClient(cs, crdClientV1),
 	)
+	kubeClient.registerResourceClient(
+		reflect.TypeOf(model.ResourceKey{}),
+		reflect.TypeOf(model.ResourceListOptions{}),
+		apiv3.KindPacketCapture,
+		resources.NewPacketCaptureClient(cs, crdClientV1),
+	)
 	kubeClient.registerResourceClient(
 		reflect.TypeOf(model.ResourceKey{}),
 		reflect.TypeOf(model.ResourceListOptions{}),
 		apiv3.KindBGPPeer,
 		resources.NewBGPPeerClient(cs, crdClientV1),
 	)
+	kubeClient.registerResourceClient(
+		reflect.TypeOf(model.ResourceKey{}),
+		reflect.TypeOf(model.ResourceListOptions{}),
+		apiv3.KindBGPFilter,
+		resources.NewBGPFilterClient(cs, crdClientV1),
+	)
 	kubeClient.registerResourceClient(
 		reflect.TypeOf(model.ResourceKey{}),
 		reflect.TypeOf(model.ResourceListOptions{}),
@@ -392,16 +422,21 @@ func (c *KubeClient) Clean() error {
 	kinds := []string{
 		apiv3.KindBGPConfiguration,
 		apiv3.KindBGPPeer,
+		apiv3.KindBGPFilter,
 		apiv3.KindClusterInformation,
 		apiv3.KindCalicoNodeStatus,
 		apiv3.KindFelixConfiguration,
 		apiv3.KindGlobalNetworkPolicy,
 		apiv3.KindNetworkPolicy,
+		apiv3.KindStagedGlobalNetworkPolicy,
+		apiv3.KindStagedNetworkPolicy,
 		apiv3.KindGlobalNetworkSet,
 		apiv3.KindNetworkSet,
+		apiv3.KindPacketCapture,
 		apiv3.KindIPPool,
 		apiv3.KindIPReservation,
 		apiv3.KindHostEndpoint,
+		apiv3.KindTier,
 		apiv3.KindKubeControllersConfiguration,
 	}
 	ctx := context.Background()
@@ -496,8 +531,12 @@ func buildCRDClientV1(cfg rest.Config) (*rest.RESTClient, error) {
 					&apiv3.IPPoolList{},
 					&apiv3.IPReservation{},
 					&apiv3.IPReservationList{},
+					&apiv3.Tier{},
+					&apiv3.TierList{},
 					&apiv3.BGPPeer{},
 					&apiv3.BGPPeerList{},
+					&apiv3.BGPFilter{},
+					&apiv3.BGPFilterList{},
 					&apiv3.BGPConfiguration{},
 					&apiv3.BGPConfigurationList{},
 					&apiv3.ClusterInformation{},
@@ -508,8 +547,14 @@ func buildCRDClientV1(cfg rest.Config) (*rest.RESTClient, error) {
 					&apiv3.GlobalNetworkPolicyList{},
 					&apiv3.NetworkPolicy{},
 					&apiv3.NetworkPolicyList{},
+					&apiv3.StagedGlobalNetworkPolicy{},
+					&apiv3.StagedGlobalNetworkPolicyList{},
+					&apiv3.StagedNetworkPolicy{},
+					&apiv3.StagedNetworkPolicyList{},
 					&apiv3.NetworkSet{},
 					&apiv3.NetworkSetList{},
+					&apiv3.PacketCapture{},
+					&apiv3.PacketCaptureList{},
 					&apiv3.HostEndpoint{},
 					&apiv3.HostEndpointList{},
 					&libapiv3.BlockAffinity{},
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 
 	log "github.com/sirupsen/logrus"
 	kapiv1 "k8s.io/api/core/v1"
@@ -41,16 +42,26 @@ import (
 )
 
 const (
-	nodeBgpIpv4AddrAnnotation            = "projectcalico.org/IPv4Address"
-	nodeBgpIpv4IPIPTunnelAddrAnnotation  = "projectcalico.org/IPv4IPIPTunnelAddr"
-	nodeBgpIpv4VXLANTunnelAddrAnnotation = "projectcalico.org/IPv4VXLANTunnelAddr"
-	nodeBgpVXLANTunnelMACAddrAnnotation  = "projectcalico.org/VXLANTunnelMACAddr"
-	nodeBgpIpv6AddrAnnotation            = "projectcalico.org/IPv6Address"
-	nodeBgpAsnAnnotation                 = "projectcalico.org/ASNumber"
-	nodeBgpCIDAnnotation                 = "projectcalico.org/RouteReflectorClusterID"
-	nodeK8sLabelAnnotation               = "projectcalico.org/kube-labels"
-	nodeWireguardIpv4IfaceAddrAnnotation = "projectcalico.org/IPv4WireguardInterfaceAddr"
-	nodeWireguardPublicKeyAnnotation     = "projectcalico.org/WireguardPublicKey"
+	nodeBgpIpv4AddrAnnotation             = "projectcalico.org/IPv4Address"
+	nodeBgpIpv4IPIPTunnelAddrAnnotation   = "projectcalico.org/IPv4IPIPTunnelAddr"
+	nodeBgpIpv4VXLANTunnelAddrAnnotation  = "projectcalico.org/IPv4VXLANTunnelAddr"
+	nodeBgpVXLANTunnelMACAddrAnnotation   = "projectcalico.org/VXLANTunnelMACAddr"
+	nodeBgpIpv6VXLANTunnelAddrAnnotation  = "projectcalico.org/IPv6VXLANTunnelAddr"
+	nodeBgpVXLANTunnelMACAddrV6Annotation = "projectcalico.org/VXLANTunnelMACAddrV6"
+	nodeBgpIpv6AddrAnnotation             = "projectcalico.org/IPv6Address"
+	nodeBgpAsnAnnotation                  = "projectcalico.org/ASNumber"
+	nodeBgpCIDAnnotation                  = "projectcalico.org/RouteReflectorClusterID"
+	nodeK8sLabelAnnotation                = "projectcalico.org/kube-labels"
+	nodeWireguardIpv4IfaceAddrAnnotation  = "projectcalico.org/IPv4WireguardInterfaceAddr"
+	nodeWireguardPublicKeyAnnotation      = "projectcalico.org/WireguardPublicKey"
+	nodeWireguardIpv6IfaceAddrAnnotation  = "projectcalico.org/IPv6WireguardInterfaceAddr"
+	nodeWireguardPublicKeyV6Annotation    = "projectcalico.org/WireguardPublicKeyV6"
+
+	// Annotations used while a Wireguard key rotation is in progress.
+	nodeWireguardPendingPublicKeyAnnotation     = "projectcalico.org/WireguardPendingPublicKey"
+	nodeWireguardPendingPublicKeyV6Annotation   = "projectcalico.org/WireguardPendingPublicKeyV6"
+	nodeWireguardAppliedPendingKeysAnnotation   = "projectcalico.org/WireguardAppliedPendingPublicKeys"
+	nodeWireguardAppliedPendingKeysV6Annotation = "projectcalico.org/WireguardAppliedPendingPublicKeysV6"
 )
 
 func NewNodeClient(c *kubernetes.Clientset, usePodCIDR bool) K8sResourceClient {
@@ -263,6 +274,10 @@ func K8sNodeToCalico(k8sNode *kapiv1.Node, usePodCIDR bool) (*model.KVPair, erro
 		wireguardSpec.InterfaceIPv4Address = annotations[nodeWireguardIpv4IfaceAddrAnnotation]
 	}
 
+	// Host-local IPAM only provides IPv4 tunnel addresses, so the IPv6 wireguard address always comes from the
+	// annotation.
+	wireguardSpec.InterfaceIPv6Address = annotations[nodeWireguardIpv6IfaceAddrAnnotation]
+
 	// Only set the BGP spec if it is not empty.
 	if !reflect.DeepEqual(*bgpSpec, libapiv3.NodeBGPSpec{}) {
 		calicoNode.Spec.BGP = bgpSpec
@@ -276,10 +291,21 @@ func K8sNodeToCalico(k8sNode *kapiv1.Node, usePodCIDR bool) (*model.KVPair, erro
 	// Set the VXLAN tunnel address based on annotation.
 	calicoNode.Spec.IPv4VXLANTunnelAddr = annotations[nodeBgpIpv4VXLANTunnelAddrAnnotation]
 	calicoNode.Spec.VXLANTunnelMACAddr = annotations[nodeBgpVXLANTunnelMACAddrAnnotation]
+	calicoNode.Spec.IPv6VXLANTunnelAddr = annotations[nodeBgpIpv6VXLANTunnelAddrAnnotation]
+	calicoNode.Spec.VXLANTunnelMACAddrV6 = annotations[nodeBgpVXLANTunnelMACAddrV6Annotation]
 
 	// Set the node status
 	nodeStatus := libapiv3.NodeStatus{}
 	nodeStatus.WireguardPublicKey = annotations[nodeWireguardPublicKeyAnnotation]
+	nodeStatus.WireguardPublicKeyV6 = annotations[nodeWireguardPublicKeyV6Annotation]
+	nodeStatus.WireguardPendingPublicKey = annotations[nodeWireguardPendingPublicKeyAnnotation]
+	nodeStatus.WireguardPendingPublicKeyV6 = annotations[nodeWireguardPendingPublicKeyV6Annotation]
+	if keys := annotations[nodeWireguardAppliedPendingKeysAnnotation]; keys != "" {
+		nodeStatus.WireguardAppliedPendingPublicKeys = strings.Split(keys, ",")
+	}
+	if keys := annotations[nodeWireguardAppliedPendingKeysV6Annotation]; keys != "" {
+		nodeStatus.WireguardAppliedPendingPublicKeysV6 = strings.Split(keys, ",")
+	}
 	if !reflect.DeepEqual(nodeStatus, libapiv3.NodeStatus{}) {
 		calicoNode.Status = nodeStatus
 	}
@@ -357,6 +383,20 @@ func mergeCalicoNodeIntoK8sNode(calicoNode *libapiv3.Node, k8sNode *kapiv1.Node)
 		delete(k8sNode.Annotations, nodeBgpVXLANTunnelMACAddrAnnotation)
 	}
 
+	// Handle IPv6 VXLAN address.
+	if calicoNode.Spec.IPv6VXLANTunnelAddr != "" {
+		k8sNode.Annotations[nodeBgpIpv6VXLANTunnelAddrAnnotation] = calicoNode.Spec.IPv6VXLANTunnelAddr
+	} else {
+		delete(k8sNode.Annotations, nodeBgpIpv6VXLANTunnelAddrAnnotation)
+	}
+
+	// Handle IPv6 VXLAN MAC address.
+	if calicoNode.Spec.VXLANTunnelMACAddrV6 != "" {
+		k8sNode.Annotations[nodeBgpVXLANTunnelMACAddrV6Annotation] = calicoNode.Spec.VXLANTunnelMACAddrV6
+	} else {
+		delete(k8sNode.Annotations, nodeBgpVXLANTunnelMACAddrV6Annotation)
+	}
+
 	if calicoNode.Spec.BGP == nil {
 		// If it is a empty NodeBGPSpec, remove all annotations.
 		delete(k8sNode.Annotations, nodeBgpIpv4AddrAnnotation)
@@ -399,13 +439,19 @@ func mergeCalicoNodeIntoK8sNode(calicoNode *libapiv3.Node, k8sNode *kapiv1.Node)
 
 	if calicoNode.Spec.Wireguard == nil {
 		delete(k8sNode.Annotations, nodeWireguardIpv4IfaceAddrAnnotation)
+		delete(k8sNode.Annotations, nodeWireguardIpv6IfaceAddrAnnotation)
 	} else {
-		// Handle Wireguard interface address.
+		// Handle Wireguard interface addresses.
 		if calicoNode.Spec.Wireguard.InterfaceIPv4Address != "" {
 			k8sNode.Annotations[nodeWireguardIpv4IfaceAddrAnnotation] = calicoNode.Spec.Wireguard.InterfaceIPv4Address
 		} else {
 			delete(k8sNode.Annotations, nodeWireguardIpv4IfaceAddrAnnotation)
 		}
+		if calicoNode.Spec.Wireguard.InterfaceIPv6Address != "" {
+			k8sNode.Annotations[nodeWireguardIpv6IfaceAddrAnnotation] = calicoNode.Spec.Wireguard.InterfaceIPv6Address
+		} else {
+			delete(k8sNode.Annotations, nodeWireguardIpv6IfaceAddrAnnotation)
+		}
 	}
 
 	// Handle Wireguard public-key.
@@ -414,6 +460,33 @@ func mergeCalicoNodeIntoK8sNode(calicoNode *libapiv3.Node, k8sNode *kapiv1.Node)
 	} else {
 		delete(k8sNode.Annotations, nodeWireguardPublicKeyAnnotation)
 	}
+	if calicoNode.Status.WireguardPublicKeyV6 != "" {
+		k8sNode.Annotations[nodeWireguardPublicKeyV6Annotation] = calicoNode.Status.WireguardPublicKeyV6
+	} else {
+		delete(k8sNode.Annotations, nodeWireguardPublicKeyV6Annotation)
+	}
+
+	// Handle any in-progress Wireguard key rotation.
+	if calicoNode.Status.WireguardPendingPublicKey != "" {
+		k8sNode.Annotations[nodeWireguardPendingPublicKeyAnnotation] = calicoNode.Status.WireguardPendingPublicKey
+	} else {
+		delete(k8sNode.Annotations, nodeWireguardPendingPublicKeyAnnotation)
+	}
+	if calicoNode.Status.WireguardPendingPublicKeyV6 != "" {
+		k8sNode.Annotations[nodeWireguardPendingPublicKeyV6Annotation] = calicoNode.Status.WireguardPendingPublicKeyV6
+	} else {
+		delete(k8sNode.Annotations, nodeWireguardPendingPublicKeyV6Annotation)
+	}
+	if len(calicoNode.Status.WireguardAppliedPendingPublicKeys) > 0 {
+		k8sNode.Annotations[nodeWireguardAppliedPendingKeysAnnotation] = strings.Join(calicoNode.Status.WireguardAppliedPendingPublicKeys, ",")
+	} else {
+		delete(k8sNode.Annotations, nodeWireguardAppliedPendingKeysAnnotation)
+	}
+	if len(calicoNode.Status.WireguardAppliedPendingPublicKeysV6) > 0 {
+		k8sNode.Annotations[nodeWireguardAppliedPendingKeysV6Annotation] = strings.Join(calicoNode.Status.WireguardAppliedPendingPublicKeysV6, ",")
+	} else {
+		delete(k8sNode.Annotations, nodeWireguardAppliedPendingKeysV6Annotation)
+	}
 
 	return k8sNode, nil
 }
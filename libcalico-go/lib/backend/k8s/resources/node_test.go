@@ -285,6 +285,75 @@ var _ = Describe("Test Node conversion", func() {
 		Expect(newCalicoNode.Value).To(Equal(calicoNodeWithMergedLabels))
 	})
 
+	It("Should round-trip IPv6 VXLAN tunnel fields through annotations", func() {
+		k8sNode := &k8sapi.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "TestNode",
+				ResourceVersion: "1234",
+				Annotations: map[string]string{
+					nodeBgpIpv6VXLANTunnelAddrAnnotation:  "fd00::1",
+					nodeBgpVXLANTunnelMACAddrV6Annotation: "00:11:22:33:44:66",
+				},
+			},
+		}
+
+		By("Converting the k8s node into a calico node")
+		n, err := K8sNodeToCalico(k8sNode, false)
+		Expect(err).NotTo(HaveOccurred())
+		calicoNode := n.Value.(*libapiv3.Node)
+		Expect(calicoNode.Spec.IPv6VXLANTunnelAddr).To(Equal("fd00::1"))
+		Expect(calicoNode.Spec.VXLANTunnelMACAddrV6).To(Equal("00:11:22:33:44:66"))
+
+		By("Removing the fields from the calico node and merging back")
+		calicoNode.Spec.IPv6VXLANTunnelAddr = ""
+		calicoNode.Spec.VXLANTunnelMACAddrV6 = ""
+		newK8sNode, err := mergeCalicoNodeIntoK8sNode(calicoNode, k8sNode)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newK8sNode.Annotations).NotTo(HaveKey(nodeBgpIpv6VXLANTunnelAddrAnnotation))
+		Expect(newK8sNode.Annotations).NotTo(HaveKey(nodeBgpVXLANTunnelMACAddrV6Annotation))
+	})
+
+	It("Should round-trip Wireguard key rotation fields through annotations", func() {
+		k8sNode := &k8sapi.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "TestNode",
+				ResourceVersion: "1234",
+				Annotations: map[string]string{
+					nodeWireguardPublicKeyAnnotation:          "abcd",
+					nodeWireguardPendingPublicKeyAnnotation:   "efgh",
+					nodeWireguardPendingPublicKeyV6Annotation: "ijkl",
+					nodeWireguardAppliedPendingKeysAnnotation: "mnop,qrst",
+				},
+			},
+		}
+
+		By("Converting the k8s node into a calico node")
+		n, err := K8sNodeToCalico(k8sNode, false)
+		Expect(err).NotTo(HaveOccurred())
+		calicoNode := n.Value.(*libapiv3.Node)
+		Expect(calicoNode.Status.WireguardPendingPublicKey).To(Equal("efgh"))
+		Expect(calicoNode.Status.WireguardPendingPublicKeyV6).To(Equal("ijkl"))
+		Expect(calicoNode.Status.WireguardAppliedPendingPublicKeys).To(Equal([]string{"mnop", "qrst"}))
+		Expect(calicoNode.Status.WireguardAppliedPendingPublicKeysV6).To(BeNil())
+
+		By("Merging the calico node back into the k8s node")
+		newK8sNode, err := mergeCalicoNodeIntoK8sNode(calicoNode, k8sNode)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newK8sNode.Annotations).To(HaveKeyWithValue(nodeWireguardAppliedPendingKeysAnnotation, "mnop,qrst"))
+		Expect(newK8sNode.Annotations).NotTo(HaveKey(nodeWireguardAppliedPendingKeysV6Annotation))
+
+		By("Removing the fields from the calico node and merging back")
+		calicoNode.Status.WireguardPendingPublicKey = ""
+		calicoNode.Status.WireguardPendingPublicKeyV6 = ""
+		calicoNode.Status.WireguardAppliedPendingPublicKeys = nil
+		newK8sNode, err = mergeCalicoNodeIntoK8sNode(calicoNode, k8sNode)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newK8sNode.Annotations).NotTo(HaveKey(nodeWireguardPendingPublicKeyAnnotation))
+		Expect(newK8sNode.Annotations).NotTo(HaveKey(nodeWireguardPendingPublicKeyV6Annotation))
+		Expect(newK8sNode.Annotations).NotTo(HaveKey(nodeWireguardAppliedPendingKeysAnnotation))
+		Expect(newK8sNode.Annotations).To(HaveKeyWithValue(nodeWireguardPublicKeyAnnotation, "abcd"))
+	})
+
 	It("Should shadow labels correctly", func() {
 		kl := map[string]string{
 			"net.beta.kubernetes.io/role": "master",
@@ -29,14 +29,16 @@ import (
 
 const (
 	// Common attributes which may be set on allocations by clients.
-	IPAMBlockAttributePod           = "pod"
-	IPAMBlockAttributeNamespace     = "namespace"
-	IPAMBlockAttributeNode          = "node"
-	IPAMBlockAttributeType          = "type"
-	IPAMBlockAttributeTypeIPIP      = "ipipTunnelAddress"
-	IPAMBlockAttributeTypeVXLAN     = "vxlanTunnelAddress"
-	IPAMBlockAttributeTypeWireguard = "wireguardTunnelAddress"
-	IPAMBlockAttributeTimestamp     = "timestamp"
+	IPAMBlockAttributePod             = "pod"
+	IPAMBlockAttributeNamespace       = "namespace"
+	IPAMBlockAttributeNode            = "node"
+	IPAMBlockAttributeType            = "type"
+	IPAMBlockAttributeTypeIPIP        = "ipipTunnelAddress"
+	IPAMBlockAttributeTypeVXLAN       = "vxlanTunnelAddress"
+	IPAMBlockAttributeTypeVXLANV6     = "vxlanV6TunnelAddress"
+	IPAMBlockAttributeTypeWireguard   = "wireguardTunnelAddress"
+	IPAMBlockAttributeTypeWireguardV6 = "wireguardV6TunnelAddress"
+	IPAMBlockAttributeTimestamp       = "timestamp"
 )
 
 var (
@@ -238,8 +238,12 @@ func KeyFromDefaultPath(path string) Key {
 	} else if m := matchPolicy.FindStringSubmatch(path); m != nil {
 		log.Debugf("Path is a policy: %v", path)
 		return PolicyKey{
+			Tier: unescapeName(m[1]),
 			Name: unescapeName(m[2]),
 		}
+	} else if m := matchTier.FindStringSubmatch(path); m != nil {
+		log.Debugf("Path is a tier: %v", path)
+		return TierKey{Name: unescapeName(m[1])}
 	} else if m := matchProfile.FindStringSubmatch(path); m != nil {
 		log.Debugf("Path is a profile: %v (%v)", path, m[2])
 		pk := ProfileKey{unescapeName(m[1])}
@@ -145,7 +145,25 @@ var _ = DescribeTable(
 	Entry(
 		"policy with a /",
 		"/calico/v1/policy/tier/default/policy/biff%2fbop",
-		PolicyKey{Name: "biff/bop"},
+		PolicyKey{Tier: "default", Name: "biff/bop"},
+		false,
+	),
+	Entry(
+		"policy in a non-default tier",
+		"/calico/v1/policy/tier/tier1/policy/tier1.biff",
+		PolicyKey{Tier: "tier1", Name: "tier1.biff"},
+		false,
+	),
+	Entry(
+		"staged namespaced policy",
+		"/calico/v1/policy/tier/default/policy/ns%2fstaged:pol",
+		PolicyKey{Tier: "default", Name: "ns/staged:pol"},
+		false,
+	),
+	Entry(
+		"tier",
+		"/calico/v1/policy/tier/tier1/metadata",
+		TierKey{Name: "tier1"},
 		false,
 	),
 	Entry(
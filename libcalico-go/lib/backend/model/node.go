@@ -64,6 +64,17 @@ type OrchRef struct {
 type Wireguard struct {
 	InterfaceIPv4Addr *net.IP `json:"interfaceIPv4Addr,omitempty"`
 	PublicKey         string  `json:"publicKey,omitempty"`
+	InterfaceIPv6Addr *net.IP `json:"interfaceIPv6Addr,omitempty"`
+	PublicKeyV6       string  `json:"publicKeyV6,omitempty"`
+
+	// The public keys that the node will switch over to once all of its peers have applied them.  Only set while a
+	// key rotation is in progress.
+	PendingPublicKey   string `json:"pendingPublicKey,omitempty"`
+	PendingPublicKeyV6 string `json:"pendingPublicKeyV6,omitempty"`
+
+	// The pending public keys of other nodes that the node has applied.
+	AppliedPendingPublicKeys   []string `json:"appliedPendingPublicKeys,omitempty"`
+	AppliedPendingPublicKeysV6 []string `json:"appliedPendingPublicKeysV6,omitempty"`
 }
 
 type NodeKey struct {
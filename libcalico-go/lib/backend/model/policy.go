@@ -32,16 +32,42 @@ var (
 	typePolicy  = reflect.TypeOf(Policy{})
 )
 
+// PolicyNamePrefixStaged is the prefix added to the (unqualified) name of a staged policy in the
+// v1 data model.  Staged policies are evaluated by the dataplane but never change the verdict
+// for a packet.
+const PolicyNamePrefixStaged = "staged:"
+
+// StagedPolicyName returns the v1 policy name for a staged policy.  The namespace is empty for
+// a staged global network policy.
+func StagedPolicyName(namespace, name string) string {
+	if namespace == "" {
+		return PolicyNamePrefixStaged + name
+	}
+	return namespace + "/" + PolicyNamePrefixStaged + name
+}
+
+// PolicyIsStaged returns true if the supplied v1 policy name is the name of a staged policy.
+func PolicyIsStaged(name string) bool {
+	if i := strings.Index(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.HasPrefix(name, PolicyNamePrefixStaged)
+}
+
 type PolicyKey struct {
+	Tier string `json:"-" validate:"required,name"`
 	Name string `json:"-" validate:"required,name"`
 }
 
 func (key PolicyKey) defaultPath() (string, error) {
+	if key.Tier == "" {
+		return "", errors.ErrorInsufficientIdentifiers{Name: "tier"}
+	}
 	if key.Name == "" {
 		return "", errors.ErrorInsufficientIdentifiers{Name: "name"}
 	}
-	e := fmt.Sprintf("/calico/v1/policy/tier/default/policy/%s",
-		escapeName(key.Name))
+	e := fmt.Sprintf("/calico/v1/policy/tier/%s/policy/%s",
+		escapeName(key.Tier), escapeName(key.Name))
 	return e, nil
 }
 
@@ -58,15 +84,20 @@ func (key PolicyKey) valueType() (reflect.Type, error) {
 }
 
 func (key PolicyKey) String() string {
-	return fmt.Sprintf("Policy(name=%s)", key.Name)
+	return fmt.Sprintf("Policy(tier=%s, name=%s)", key.Tier, key.Name)
 }
 
 type PolicyListOptions struct {
 	Name string
+	Tier string
 }
 
 func (options PolicyListOptions) defaultPathRoot() string {
-	k := "/calico/v1/policy/tier/default/policy"
+	k := "/calico/v1/policy/tier"
+	if options.Tier == "" {
+		return k
+	}
+	k = k + fmt.Sprintf("/%s/policy", escapeName(options.Tier))
 	if options.Name == "" {
 		return k
 	}
@@ -81,12 +112,17 @@ func (options PolicyListOptions) KeyFromDefaultPath(path string) Key {
 		log.Debugf("Didn't match regex")
 		return nil
 	}
+	tier := unescapeName(r[0][1])
 	name := unescapeName(r[0][2])
+	if options.Tier != "" && tier != options.Tier {
+		log.Debugf("Didn't match tier %s != %s", options.Tier, tier)
+		return nil
+	}
 	if options.Name != "" && name != options.Name {
 		log.Debugf("Didn't match name %s != %s", options.Name, name)
 		return nil
 	}
-	return PolicyKey{Name: name}
+	return PolicyKey{Tier: tier, Name: name}
 }
 
 type Policy struct {
@@ -36,4 +36,13 @@ var _ = Describe("Policy functions", func() {
 		}
 		Expect(p.String()).To(Equal(`order:10.5,selector:"apples=='oranges'",inbound:Deny,outbound:Allow,untracked:false,pre_dnat:true,apply_on_forward:true,types:Ingress;Egress`))
 	})
+
+	It("should name and identify staged policies", func() {
+		Expect(model.StagedPolicyName("", "tier1.pol")).To(Equal("staged:tier1.pol"))
+		Expect(model.StagedPolicyName("ns", "pol")).To(Equal("ns/staged:pol"))
+		Expect(model.PolicyIsStaged("staged:tier1.pol")).To(BeTrue())
+		Expect(model.PolicyIsStaged("ns/staged:pol")).To(BeTrue())
+		Expect(model.PolicyIsStaged("tier1.pol")).To(BeFalse())
+		Expect(model.PolicyIsStaged("ns/pol")).To(BeFalse())
+	})
 })
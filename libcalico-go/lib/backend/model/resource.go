@@ -64,6 +64,11 @@ func init() {
 		"bgppeers",
 		reflect.TypeOf(apiv3.BGPPeer{}),
 	)
+	registerResourceInfo(
+		apiv3.KindBGPFilter,
+		"bgpfilters",
+		reflect.TypeOf(apiv3.BGPFilter{}),
+	)
 	registerResourceInfo(
 		apiv3.KindBGPConfiguration,
 		"bgpconfigurations",
@@ -109,6 +114,16 @@ func init() {
 		"networkpolicies",
 		reflect.TypeOf(apiv3.NetworkPolicy{}),
 	)
+	registerResourceInfo(
+		apiv3.KindStagedGlobalNetworkPolicy,
+		"stagedglobalnetworkpolicies",
+		reflect.TypeOf(apiv3.StagedGlobalNetworkPolicy{}),
+	)
+	registerResourceInfo(
+		apiv3.KindStagedNetworkPolicy,
+		"stagednetworkpolicies",
+		reflect.TypeOf(apiv3.StagedNetworkPolicy{}),
+	)
 	registerResourceInfo(
 		KindKubernetesNetworkPolicy,
 		"kubernetesnetworkpolicies",
@@ -124,6 +139,11 @@ func init() {
 		"networksets",
 		reflect.TypeOf(apiv3.NetworkSet{}),
 	)
+	registerResourceInfo(
+		apiv3.KindPacketCapture,
+		"packetcaptures",
+		reflect.TypeOf(apiv3.PacketCapture{}),
+	)
 	registerResourceInfo(
 		libapiv3.KindNode,
 		"nodes",
@@ -144,6 +164,11 @@ func init() {
 		"workloadendpoints",
 		reflect.TypeOf(libapiv3.WorkloadEndpoint{}),
 	)
+	registerResourceInfo(
+		apiv3.KindTier,
+		"tiers",
+		reflect.TypeOf(apiv3.Tier{}),
+	)
 	registerResourceInfo(
 		apiv3.KindKubeControllersConfiguration,
 		"kubecontrollersconfigurations",
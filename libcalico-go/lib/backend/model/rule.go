@@ -55,6 +55,7 @@ type Rule struct {
 	DstPorts            []numorstring.Port `json:"dst_ports,omitempty" validate:"omitempty,dive"`
 	DstService          string             `json:"dst_service,omitempty" validate:"omitempty"`
 	DstServiceNamespace string             `json:"dst_service_ns,omitempty" validate:"omitempty"`
+	DstDomains          []string           `json:"dst_domains,omitempty" validate:"omitempty"`
 
 	NotSrcTag      string             `json:"!src_tag,omitempty" validate:"omitempty,tag"`
 	NotSrcNet      *net.IPNet         `json:"!src_net,omitempty" validate:"omitempty"`
@@ -230,6 +231,9 @@ func (r Rule) String() string {
 		if len(dstNets) != 0 {
 			toParts = append(toParts, "cidr", joinNets(dstNets))
 		}
+		if len(r.DstDomains) != 0 {
+			toParts = append(toParts, "domains", strings.Join(r.DstDomains, ","))
+		}
 		if len(r.NotDstPorts) > 0 {
 			notDstPorts := make([]string, len(r.NotDstPorts))
 			for ii, port := range r.NotDstPorts {
@@ -164,6 +164,19 @@ type WorkloadEndpoint struct {
 	IPv6Gateway      *net.IP           `json:"ipv6_gateway,omitempty" validate:"omitempty,ipv6"`
 	Ports            []EndpointPort    `json:"ports,omitempty" validate:"dive"`
 	GenerateName     string            `json:"generate_name,omitempty"`
+	QoSControls      *QoSControls      `json:"qos_controls,omitempty"`
+	EgressSelector   string            `json:"egress_selector,omitempty"`
+}
+
+// QoSControls contains the bandwidth and packet rate limits for a workload endpoint.  A zero
+// value means no limit.
+type QoSControls struct {
+	IngressBandwidth  int64 `json:"ingress_bandwidth,omitempty"`
+	EgressBandwidth   int64 `json:"egress_bandwidth,omitempty"`
+	IngressBurst      int64 `json:"ingress_burst,omitempty"`
+	EgressBurst       int64 `json:"egress_burst,omitempty"`
+	IngressPacketRate int64 `json:"ingress_packet_rate,omitempty"`
+	EgressPacketRate  int64 `json:"egress_packet_rate,omitempty"`
 }
 
 type EndpointPort struct {
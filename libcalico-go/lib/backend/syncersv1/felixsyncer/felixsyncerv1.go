@@ -42,10 +42,18 @@ func New(client api.Client, cfg apiconfig.CalicoAPIConfigSpec, callbacks api.Syn
 	if isLeader {
 		// These resources are only required if this is the active Felix instance on the node.
 		additionalTypes := []watchersyncer.ResourceType{
+			{
+				ListInterface:   model.ResourceListOptions{Kind: apiv3.KindTier},
+				UpdateProcessor: updateprocessors.NewTierUpdateProcessor(),
+			},
 			{
 				ListInterface:   model.ResourceListOptions{Kind: apiv3.KindGlobalNetworkPolicy},
 				UpdateProcessor: updateprocessors.NewGlobalNetworkPolicyUpdateProcessor(),
 			},
+			{
+				ListInterface:   model.ResourceListOptions{Kind: apiv3.KindStagedGlobalNetworkPolicy},
+				UpdateProcessor: updateprocessors.NewStagedGlobalNetworkPolicyUpdateProcessor(),
+			},
 			{
 				ListInterface:   model.ResourceListOptions{Kind: apiv3.KindGlobalNetworkSet},
 				UpdateProcessor: updateprocessors.NewGlobalNetworkSetUpdateProcessor(),
@@ -70,6 +78,10 @@ func New(client api.Client, cfg apiconfig.CalicoAPIConfigSpec, callbacks api.Syn
 				ListInterface:   model.ResourceListOptions{Kind: apiv3.KindNetworkPolicy},
 				UpdateProcessor: updateprocessors.NewNetworkPolicyUpdateProcessor(),
 			},
+			{
+				ListInterface:   model.ResourceListOptions{Kind: apiv3.KindStagedNetworkPolicy},
+				UpdateProcessor: updateprocessors.NewStagedNetworkPolicyUpdateProcessor(),
+			},
 			{
 				ListInterface:   model.ResourceListOptions{Kind: apiv3.KindNetworkSet},
 				UpdateProcessor: updateprocessors.NewNetworkSetUpdateProcessor(),
@@ -81,6 +93,9 @@ func New(client api.Client, cfg apiconfig.CalicoAPIConfigSpec, callbacks api.Syn
 			{
 				ListInterface: model.ResourceListOptions{Kind: apiv3.KindBGPConfiguration},
 			},
+			{
+				ListInterface: model.ResourceListOptions{Kind: apiv3.KindPacketCapture},
+			},
 		}
 
 		// If running in kdd mode, also watch Kubernetes network policies directly.
@@ -45,7 +45,7 @@ const (
 )
 
 const (
-	numBaseFelixConfigs = 106
+	numBaseFelixConfigs = 131
 )
 
 var _ = Describe("Test the generic configuration update processor and the concrete implementations", func() {
@@ -217,6 +217,7 @@ var _ = Describe("Test the generic configuration update processor and the concre
 			},
 		}
 		res.Spec.ExternalNodesCIDRList = &[]string{"1.1.1.1", "2.2.2.2"}
+		res.Spec.DNSTrustedServers = &[]string{}
 		res.Spec.IptablesNATOutgoingInterfaceFilter = "cali-123"
 		res.Spec.RouteTableRanges = &apiv3.RouteTableRanges{{Min: 43, Max: 211}}
 		expected := map[string]interface{}{
@@ -230,6 +231,7 @@ var _ = Describe("Test the generic configuration update processor and the concre
 			"FailsafeInboundHostPorts":           "none",
 			"FailsafeOutboundHostPorts":          "tcp:0.0.0.0/0:1234,udp:0.0.0.0/0:22,tcp:0.0.0.0/0:65535",
 			"ExternalNodesCIDRList":              "1.1.1.1,2.2.2.2",
+			"DNSTrustedServers":                  "none",
 			"IptablesNATOutgoingInterfaceFilter": "cali-123",
 			"RouteTableRanges":                   "43-211",
 		}
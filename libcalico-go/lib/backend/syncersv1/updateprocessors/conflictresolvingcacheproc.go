@@ -77,12 +77,16 @@ type conflictResolvingCache struct {
 }
 
 func (c *conflictResolvingCache) Process(kvp *model.KVPair) ([]*model.KVPair, error) {
-	// Extract the name of the resource.
+	// Extract the name of the resource.  For namespaced resources the name is qualified with the
+	// namespace so that resources with the same name in different namespaces do not collide.
 	rk, ok := kvp.Key.(model.ResourceKey)
 	if !ok || rk.Kind != c.v3Kind {
 		return nil, fmt.Errorf("Incorrect key type - expecting resource of kind %s", c.v3Kind)
 	}
 	name := rk.Name
+	if rk.Namespace != "" {
+		name = rk.Namespace + "/" + rk.Name
+	}
 
 	// If there is no value then this is a delete.
 	if kvp.Value == nil {
@@ -41,6 +41,7 @@ func NewFelixConfigUpdateProcessor() watchersyncer.SyncerUpdateProcessor {
 			"FailsafeOutboundHostPorts": protoPortSliceToString,
 			"RouteTableRange":           routeTableRangeToString,
 			"RouteTableRanges":          routeTableRangeListToString,
+			"DNSTrustedServers":         stringSliceToStringOrNone,
 		},
 	)
 }
@@ -70,6 +71,16 @@ var protoPortSliceToString = func(value interface{}) interface{} {
 	return strings.Join(parts, ",")
 }
 
+// Convert a slice of strings to a comma-delimited string, or to "none" if the slice is empty,
+// so that an empty list overrides Felix's default rather than falling back to it.
+var stringSliceToStringOrNone = func(value interface{}) interface{} {
+	strs := value.([]string)
+	if len(strs) == 0 {
+		return "none"
+	}
+	return strings.Join(strs, ",")
+}
+
 // Converts multiple route table ranges to its string config representation.
 // e.g. RouteTableRanges{{Min: 0, Max: 250}, {Min: 255, Max: 3000}} => "0-250,255-3000"
 var routeTableRangeListToString = func(value interface{}) interface{} {
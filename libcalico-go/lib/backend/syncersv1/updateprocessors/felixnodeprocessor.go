@@ -57,7 +57,7 @@ func (c *FelixNodeUpdateProcessor) Process(kvp *model.KVPair) ([]*model.KVPair,
 	// v1 model.  For a delete these will all be nil.  If we fail to convert any value then
 	// just treat that as a delete on the underlying key and return the error alongside
 	// the updates.
-	var ipv4, ipv4Tunl, vxlanTunlIp, vxlanTunlMac, wgConfig interface{}
+	var ipv4, ipv4Tunl, vxlanTunlIp, vxlanTunlMac, vxlanV6TunlIp, vxlanV6TunlMac, wgConfig interface{}
 	var node *libapiv3.Node
 	var ok bool
 	if kvp.Value != nil {
@@ -135,8 +135,27 @@ func (c *FelixNodeUpdateProcessor) Process(kvp *model.KVPair) ([]*model.KVPair,
 			}
 		}
 
-		var wgIfaceIpv4Addr *cnet.IP
-		var wgPubKey string
+		// Parse the IPv6 VXLAN tunnel address, Felix expects this as a HostConfigKey.  If we fail to parse then
+		// treat as a delete (i.e. leave vxlanV6TunlIp as nil).
+		if len(node.Spec.IPv6VXLANTunnelAddr) != 0 {
+			ip := cnet.ParseIP(node.Spec.IPv6VXLANTunnelAddr)
+			if ip != nil {
+				log.WithField("ip", ip).Debug("Parsed IPv6 VXLAN tunnel address")
+				vxlanV6TunlIp = ip.String()
+			} else {
+				log.WithField("IPv6VXLANTunnelAddr", node.Spec.IPv6VXLANTunnelAddr).Warn("Failed to parse IPv6VXLANTunnelAddr")
+				err = fmt.Errorf("failed to parsed IPv6VXLANTunnelAddr as an IP address")
+			}
+		}
+
+		// Parse the IPv6 VXLAN tunnel MAC address, Felix expects this as a HostConfigKey.
+		if len(node.Spec.VXLANTunnelMACAddrV6) != 0 {
+			log.WithField("mac addr", node.Spec.VXLANTunnelMACAddrV6).Debug("Parsed IPv6 VXLAN tunnel MAC address")
+			vxlanV6TunlMac = node.Spec.VXLANTunnelMACAddrV6
+		}
+
+		var wgIfaceIpv4Addr, wgIfaceIpv6Addr *cnet.IP
+		var wgPubKey, wgPubKeyV6 string
 		if wgSpec := node.Spec.Wireguard; wgSpec != nil {
 			if len(wgSpec.InterfaceIPv4Address) != 0 {
 				wgIfaceIpv4Addr = cnet.ParseIP(wgSpec.InterfaceIPv4Address)
@@ -147,6 +166,15 @@ func (c *FelixNodeUpdateProcessor) Process(kvp *model.KVPair) ([]*model.KVPair,
 					err = fmt.Errorf("failed to parse InterfaceIPv4Address as an IP address")
 				}
 			}
+			if len(wgSpec.InterfaceIPv6Address) != 0 {
+				wgIfaceIpv6Addr = cnet.ParseIP(wgSpec.InterfaceIPv6Address)
+				if wgIfaceIpv6Addr != nil {
+					log.WithField("InterfaceIPv6Addr", wgIfaceIpv6Addr).Debug("Parsed IPv6 Wireguard interface address")
+				} else {
+					log.WithField("InterfaceIPv6Addr", wgSpec.InterfaceIPv6Address).Warn("Failed to parse InterfaceIPv6Address")
+					err = fmt.Errorf("failed to parse InterfaceIPv6Address as an IP address")
+				}
+			}
 		}
 		if wgPubKey = node.Status.WireguardPublicKey; wgPubKey != "" {
 			_, err := wg.ParseKey(wgPubKey)
@@ -158,10 +186,47 @@ func (c *FelixNodeUpdateProcessor) Process(kvp *model.KVPair) ([]*model.KVPair,
 			}
 		}
 
-		// If either of interface address or public-key is set, set the WireguardKey value.
-		// If we failed to parse both the values, leave the WireguardKey value empty.
-		if wgIfaceIpv4Addr != nil || wgPubKey != "" {
-			wgConfig = &model.Wireguard{InterfaceIPv4Addr: wgIfaceIpv4Addr, PublicKey: wgPubKey}
+		if wgPubKeyV6 = node.Status.WireguardPublicKeyV6; wgPubKeyV6 != "" {
+			_, err := wg.ParseKey(wgPubKeyV6)
+			if err == nil {
+				log.WithField("public-key-v6", wgPubKeyV6).Debug("Parsed IPv6 Wireguard public-key")
+			} else {
+				log.WithField("WireguardPublicKeyV6", wgPubKeyV6).Warn("Failed to parse IPv6 Wireguard public-key")
+				err = fmt.Errorf("failed to parse PublicKeyV6 as Wireguard public-key")
+			}
+		}
+
+		// If any of the interface addresses or public-keys are set, set the WireguardKey value.
+		// If we failed to parse all of the values, leave the WireguardKey value empty.
+		if wgIfaceIpv4Addr != nil || wgPubKey != "" || wgIfaceIpv6Addr != nil || wgPubKeyV6 != "" {
+			wgc := &model.Wireguard{
+				InterfaceIPv4Addr: wgIfaceIpv4Addr,
+				PublicKey:         wgPubKey,
+				InterfaceIPv6Addr: wgIfaceIpv6Addr,
+				PublicKeyV6:       wgPubKeyV6,
+			}
+
+			// Include the details of any in-progress key rotation: the node's own pending keys and the pending keys
+			// of its peers that it has applied.
+			for _, pending := range []struct {
+				key   string
+				field *string
+			}{
+				{node.Status.WireguardPendingPublicKey, &wgc.PendingPublicKey},
+				{node.Status.WireguardPendingPublicKeyV6, &wgc.PendingPublicKeyV6},
+			} {
+				if pending.key == "" {
+					continue
+				}
+				if _, err := wg.ParseKey(pending.key); err != nil {
+					log.WithField("WireguardPendingPublicKey", pending.key).Warn("Failed to parse pending Wireguard public-key")
+					continue
+				}
+				*pending.field = pending.key
+			}
+			wgc.AppliedPendingPublicKeys = validWireguardKeys(node.Status.WireguardAppliedPendingPublicKeys)
+			wgc.AppliedPendingPublicKeysV6 = validWireguardKeys(node.Status.WireguardAppliedPendingPublicKeysV6)
+			wgConfig = wgc
 		}
 	}
 
@@ -197,6 +262,22 @@ func (c *FelixNodeUpdateProcessor) Process(kvp *model.KVPair) ([]*model.KVPair,
 			Value:    vxlanTunlMac,
 			Revision: kvp.Revision,
 		},
+		{
+			Key: model.HostConfigKey{
+				Hostname: name,
+				Name:     "IPv6VXLANTunnelAddr",
+			},
+			Value:    vxlanV6TunlIp,
+			Revision: kvp.Revision,
+		},
+		{
+			Key: model.HostConfigKey{
+				Hostname: name,
+				Name:     "VXLANTunnelMACAddrV6",
+			},
+			Value:    vxlanV6TunlMac,
+			Revision: kvp.Revision,
+		},
 		{
 			// Include the original node KVP info as a separate update. Note we do not use the node value here because
 			// a nil interface is different to a nil pointer. Felix and other code assumes a nil Value is a delete, so
@@ -275,3 +356,16 @@ func (c *FelixNodeUpdateProcessor) extractName(k model.Key) (string, error) {
 	}
 	return rk.Name, nil
 }
+
+// validWireguardKeys returns the keys that parse as Wireguard public-keys, logging any that don't.
+func validWireguardKeys(keys []string) []string {
+	var valid []string
+	for _, key := range keys {
+		if _, err := wg.ParseKey(key); err != nil {
+			log.WithField("WireguardPublicKey", key).Warn("Failed to parse applied pending Wireguard public-key")
+			continue
+		}
+		valid = append(valid, key)
+	}
+	return valid
+}
@@ -34,7 +34,7 @@ var _ = Describe("Test the (Felix) Node update processor", func() {
 		Kind: libapiv3.KindNode,
 		Name: "mynode",
 	}
-	numFelixConfigs := 6
+	numFelixConfigs := 8
 	up := updateprocessors.NewFelixNodeUpdateProcessor(false)
 
 	BeforeEach(func() {
@@ -205,6 +205,82 @@ var _ = Describe("Test the (Felix) Node update processor", func() {
 			expected,
 		)
 
+		By("converting a Node with IPv6 Wireguard interface address and public-key")
+		res = libapiv3.NewNode()
+		res.Name = "mynode"
+		res.Spec.Wireguard = &libapiv3.NodeWireguardSpec{
+			InterfaceIPv6Address: "fd00::1",
+		}
+		res.Status = libapiv3.NodeStatus{
+			WireguardPublicKeyV6: key,
+		}
+		ipv6 := net.MustParseIP("fd00::1")
+		expected = map[string]interface{}{
+			nodeMarker: res,
+			wireguardMarker: &model.Wireguard{
+				InterfaceIPv6Addr: &ipv6,
+				PublicKeyV6:       key,
+			},
+		}
+		kvps, err = up.Process(&model.KVPair{
+			Key:   v3NodeKey1,
+			Value: res,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		checkExpectedConfigs(
+			kvps,
+			isNodeFelixConfig,
+			numFelixConfigs,
+			expected,
+		)
+
+		By("converting a Node with a Wireguard key rotation in progress")
+		pendingKey := "/7yo/4KeodP3pL+KOHzbSxB7vJFG5xcDI5p5tdj0w2o="
+		res = libapiv3.NewNode()
+		res.Name = "mynode"
+		res.Status = libapiv3.NodeStatus{
+			WireguardPublicKey:                key,
+			WireguardPendingPublicKey:         pendingKey,
+			WireguardAppliedPendingPublicKeys: []string{key, "not-a-key"},
+		}
+		expected = map[string]interface{}{
+			nodeMarker: res,
+			wireguardMarker: &model.Wireguard{
+				PublicKey:                key,
+				PendingPublicKey:         pendingKey,
+				AppliedPendingPublicKeys: []string{key},
+			},
+		}
+		kvps, err = up.Process(&model.KVPair{
+			Key:   v3NodeKey1,
+			Value: res,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		checkExpectedConfigs(
+			kvps,
+			isNodeFelixConfig,
+			numFelixConfigs,
+			expected,
+		)
+
+		By("ignoring invalid Wireguard pending and applied public-keys")
+		res.Status.WireguardPendingPublicKey = "not-a-key"
+		res.Status.WireguardAppliedPendingPublicKeys = []string{"not-a-key"}
+		expected[wireguardMarker] = &model.Wireguard{
+			PublicKey: key,
+		}
+		kvps, err = up.Process(&model.KVPair{
+			Key:   v3NodeKey1,
+			Value: res,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		checkExpectedConfigs(
+			kvps,
+			isNodeFelixConfig,
+			numFelixConfigs,
+			expected,
+		)
+
 		By("converting a Node with IPv4 and IPv6 networks and no other config")
 		res = libapiv3.NewNode()
 		res.Name = "mynode"
@@ -253,6 +329,28 @@ var _ = Describe("Test the (Felix) Node update processor", func() {
 			numFelixConfigs,
 			expected,
 		)
+
+		By("converting a Node with IPv6 VXLAN tunnel address and MAC")
+		res = libapiv3.NewNode()
+		res.Name = "mynode"
+		res.Spec.IPv6VXLANTunnelAddr = "fd00::0001"
+		res.Spec.VXLANTunnelMACAddrV6 = "00:11:22:33:44:66"
+		expected = map[string]interface{}{
+			nodeMarker:             res,
+			"IPv6VXLANTunnelAddr":  "fd00::1",
+			"VXLANTunnelMACAddrV6": "00:11:22:33:44:66",
+		}
+		kvps, err = up.Process(&model.KVPair{
+			Key:   v3NodeKey1,
+			Value: res,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		checkExpectedConfigs(
+			kvps,
+			isNodeFelixConfig,
+			numFelixConfigs,
+			expected,
+		)
 	})
 
 	It("should fail to convert an invalid resource", func() {
@@ -95,7 +95,7 @@ var _ = Describe("Test the GlobalNetworkPolicy update processor", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(kvps).To(HaveLen(1))
 
-			v1Key := model.PolicyKey{Name: "minimal"}
+			v1Key := model.PolicyKey{Tier: "default", Name: "minimal"}
 			Expect(kvps[0]).To(Equal(&model.KVPair{
 				Key: v1Key,
 				Value: &model.Policy{
@@ -112,7 +112,7 @@ var _ = Describe("Test the GlobalNetworkPolicy update processor", func() {
 
 			policy := fullGNPv1()
 			policy.Selector = `mylabel == 'selectme'`
-			v1Key := model.PolicyKey{Name: "full"}
+			v1Key := model.PolicyKey{Tier: "default", Name: "full"}
 			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Value: &policy, Revision: testRev}}))
 
 			By("should be able to delete the full network policy")
@@ -121,6 +121,49 @@ var _ = Describe("Test the GlobalNetworkPolicy update processor", func() {
 			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Value: nil}}))
 		})
 
+		It("should use the tier from the spec and handle the tier being changed", func() {
+			tieredKey := model.ResourceKey{Kind: apiv3.KindGlobalNetworkPolicy, Name: "tier1.tiered"}
+			tiered := apiv3.NewGlobalNetworkPolicy()
+			tiered.Name = "tier1.tiered"
+			tiered.Spec.Tier = "tier1"
+			kvps, err := up.Process(&model.KVPair{Key: tieredKey, Value: tiered, Revision: testRev})
+			Expect(err).NotTo(HaveOccurred())
+			v1Key := model.PolicyKey{Tier: "tier1", Name: "tier1.tiered"}
+			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Value: &model.Policy{}, Revision: testRev}}))
+
+			By("moving the policy to a different tier")
+			moved := tiered.DeepCopy()
+			moved.Spec.Tier = "tier2"
+			kvps, err = up.Process(&model.KVPair{Key: tieredKey, Value: moved, Revision: testRev})
+			Expect(err).NotTo(HaveOccurred())
+			v1KeyMoved := model.PolicyKey{Tier: "tier2", Name: "tier1.tiered"}
+			Expect(kvps).To(Equal([]*model.KVPair{
+				{Key: v1Key},
+				{Key: v1KeyMoved, Value: &model.Policy{}, Revision: testRev},
+			}))
+
+			By("deleting the policy")
+			kvps, err = up.Process(&model.KVPair{Key: tieredKey})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1KeyMoved}}))
+		})
+
+		It("should treat a wrong Value type as a delete of a policy in a non-default tier", func() {
+			tieredKey := model.ResourceKey{Kind: apiv3.KindGlobalNetworkPolicy, Name: "tier1.tiered"}
+			tiered := apiv3.NewGlobalNetworkPolicy()
+			tiered.Name = "tier1.tiered"
+			tiered.Spec.Tier = "tier1"
+			_, err := up.Process(&model.KVPair{Key: tieredKey, Value: tiered, Revision: testRev})
+			Expect(err).NotTo(HaveOccurred())
+
+			kvps, err := up.Process(&model.KVPair{Key: tieredKey, Value: apiv3.NewHostEndpoint(), Revision: testRev})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(kvps).To(Equal([]*model.KVPair{
+				{Key: model.PolicyKey{Tier: "tier1", Name: "tier1.tiered"}},
+				{Key: model.PolicyKey{Tier: "default", Name: "tier1.tiered"}},
+			}))
+		})
+
 		It("should NOT accept a GlobalNetworkPolicy with the wrong Key type", func() {
 			_, err := up.Process(&model.KVPair{
 				Key:      model.GlobalBGPPeerKey{PeerIP: cnet.MustParseIP("1.2.3.4")},
@@ -134,7 +177,7 @@ var _ = Describe("Test the GlobalNetworkPolicy update processor", func() {
 			kvps, err := up.Process(&model.KVPair{Key: emptyGNPKey, Value: apiv3.NewHostEndpoint(), Revision: testRev})
 			Expect(err).NotTo(HaveOccurred())
 
-			v1Key := model.PolicyKey{Name: "empty"}
+			v1Key := model.PolicyKey{Tier: "default", Name: "empty"}
 			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Value: nil}}))
 		})
 
@@ -145,7 +188,7 @@ var _ = Describe("Test the GlobalNetworkPolicy update processor", func() {
 
 			policy := fullGNPv1()
 			policy.Selector = `(mylabel == 'selectme') && pcsa.role == "development"`
-			v1Key := model.PolicyKey{Name: "valid-sa-selector"}
+			v1Key := model.PolicyKey{Tier: "default", Name: "valid-sa-selector"}
 			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Value: &policy, Revision: testRev}}))
 		})
 
@@ -155,7 +198,7 @@ var _ = Describe("Test the GlobalNetworkPolicy update processor", func() {
 
 			policy := fullGNPv1()
 			policy.Selector = `mylabel == 'selectme'`
-			v1Key := model.PolicyKey{Name: "invalid-sa-selector"}
+			v1Key := model.PolicyKey{Tier: "default", Name: "invalid-sa-selector"}
 			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Value: &policy, Revision: testRev}}))
 		})
 
@@ -165,7 +208,7 @@ var _ = Describe("Test the GlobalNetworkPolicy update processor", func() {
 
 			policy := fullGNPv1()
 			policy.Selector = `(mylabel == 'selectme') && has(projectcalico.org/serviceaccount)`
-			v1Key := model.PolicyKey{Name: "all-sa-selector"}
+			v1Key := model.PolicyKey{Tier: "default", Name: "all-sa-selector"}
 			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Value: &policy, Revision: testRev}}))
 		})
 
@@ -176,7 +219,7 @@ var _ = Describe("Test the GlobalNetworkPolicy update processor", func() {
 
 			policy := fullGNPv1()
 			policy.Selector = `(mylabel == 'selectme') && pcns.name == "testing"`
-			v1Key := model.PolicyKey{Name: "valid-ns-selector"}
+			v1Key := model.PolicyKey{Tier: "default", Name: "valid-ns-selector"}
 			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Value: &policy, Revision: testRev}}))
 		})
 
@@ -186,7 +229,7 @@ var _ = Describe("Test the GlobalNetworkPolicy update processor", func() {
 
 			policy := fullGNPv1()
 			policy.Selector = `mylabel == 'selectme'`
-			v1Key := model.PolicyKey{Name: "invalid-ns-selector"}
+			v1Key := model.PolicyKey{Tier: "default", Name: "invalid-ns-selector"}
 			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Value: &policy, Revision: testRev}}))
 		})
 
@@ -196,7 +239,7 @@ var _ = Describe("Test the GlobalNetworkPolicy update processor", func() {
 
 			policy := fullGNPv1()
 			policy.Selector = `(mylabel == 'selectme') && has(projectcalico.org/namespace)`
-			v1Key := model.PolicyKey{Name: "all-ns-selector"}
+			v1Key := model.PolicyKey{Tier: "default", Name: "all-ns-selector"}
 			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Value: &policy, Revision: testRev}}))
 		})
 
@@ -207,7 +250,7 @@ var _ = Describe("Test the GlobalNetworkPolicy update processor", func() {
 
 			policy := fullGNPv1()
 			policy.Selector = `((mylabel == 'selectme') && pcns.name == "testing") && pcsa.role == "development"`
-			v1Key := model.PolicyKey{Name: "sa-and-ns-selector"}
+			v1Key := model.PolicyKey{Tier: "default", Name: "sa-and-ns-selector"}
 			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Value: &policy, Revision: testRev}}))
 		})
 
@@ -219,7 +262,7 @@ var _ = Describe("Test the GlobalNetworkPolicy update processor", func() {
 
 			policy := fullGNPv1()
 			policy.Selector = `pcsa.role == "development"`
-			v1Key := model.PolicyKey{Name: "no-sel-with-sa-selector"}
+			v1Key := model.PolicyKey{Tier: "default", Name: "no-sel-with-sa-selector"}
 			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Value: &policy, Revision: testRev}}))
 		})
 
@@ -230,7 +273,7 @@ var _ = Describe("Test the GlobalNetworkPolicy update processor", func() {
 
 			policy := fullGNPv1()
 			policy.Selector = `pcns.name == "testing"`
-			v1Key := model.PolicyKey{Name: "no-sel-with-ns-selector"}
+			v1Key := model.PolicyKey{Tier: "default", Name: "no-sel-with-ns-selector"}
 			Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Value: &policy, Revision: testRev}}))
 		})
 
@@ -242,7 +285,7 @@ var _ = Describe("Test the GlobalNetworkPolicy update processor", func() {
 
 				policy := fullGNPv1()
 				policy.Selector = `(pcns.name == "testing") && pcsa.role == "development"`
-				v1Key := model.PolicyKey{Name: "no-sel-with-ns-and-sa-selector"}
+				v1Key := model.PolicyKey{Tier: "default", Name: "no-sel-with-ns-and-sa-selector"}
 				Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Value: &policy, Revision: testRev}}))
 			})
 	})
@@ -19,6 +19,8 @@ import (
 	"fmt"
 	"strings"
 
+	log "github.com/sirupsen/logrus"
+
 	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
 
 	"github.com/projectcalico/calico/libcalico-go/lib/backend/k8s/conversion"
@@ -27,24 +29,37 @@ import (
 )
 
 // Create a new SyncerUpdateProcessor to sync NetworkPolicy data in v1 format for
-// consumption by Felix.
+// consumption by Felix.  The v1 key includes the tier, which is part of the v3 spec, so a cached
+// processor is used to handle the key changing when the tier is updated.
 func NewNetworkPolicyUpdateProcessor() watchersyncer.SyncerUpdateProcessor {
-	return NewSimpleUpdateProcessor(apiv3.KindNetworkPolicy, convertNetworkPolicyV2ToV1Key, convertNetworkPolicyV2ToV1Value)
+	return NewConflictResolvingCacheUpdateProcessor(apiv3.KindNetworkPolicy, convertNetworkPolicyV2ToV1)
 }
 
-func convertNetworkPolicyV2ToV1Key(v3key model.ResourceKey) (model.Key, error) {
+// Convert v3 KVPair to the equivalent v1 KVPair.
+func convertNetworkPolicyV2ToV1(kvp *model.KVPair) (*model.KVPair, error) {
+	// Validate against incorrect key/value kinds.  This indicates a code bug rather
+	// than a user error.
+	v3key, ok := kvp.Key.(model.ResourceKey)
+	if !ok || v3key.Kind != apiv3.KindNetworkPolicy {
+		return nil, errors.New("Key is not a valid NetworkPolicy resource key")
+	}
 	if v3key.Name == "" || v3key.Namespace == "" {
-		return model.PolicyKey{}, errors.New("Missing Name or Namespace field to create a v1 NetworkPolicy Key")
+		return nil, errors.New("Missing Name or Namespace field to create a v1 NetworkPolicy Key")
 	}
-	return model.PolicyKey{
-		Name: v3key.Namespace + "/" + v3key.Name,
-	}, nil
-}
-
-func convertNetworkPolicyV2ToV1Value(val interface{}) (interface{}, error) {
-	v3res, ok := val.(*apiv3.NetworkPolicy)
+	v3res, ok := kvp.Value.(*apiv3.NetworkPolicy)
 	if !ok {
-		return nil, errors.New("Value is not a valid NetworkPolicy resource value")
+		// Treat any values that fail to convert as a deletion event.  The tier is not known so use the
+		// default tier; if the policy was previously in a different tier the cache sends a delete for
+		// that key first.
+		log.WithField("Resource", kvp.Key).Warn("Unable to process resource data - treating as deleted")
+		return &model.KVPair{
+			Key: model.PolicyKey{Tier: tierOrDefault(""), Name: v3key.Namespace + "/" + v3key.Name},
+		}, nil
+	}
+
+	v1key := model.PolicyKey{
+		Tier: tierOrDefault(v3res.Spec.Tier),
+		Name: v3key.Namespace + "/" + v3key.Name,
 	}
 
 	spec := v3res.Spec
@@ -71,7 +86,20 @@ func convertNetworkPolicyV2ToV1Value(val interface{}) (interface{}, error) {
 		ApplyOnForward: true,
 	}
 
-	return v1value, nil
+	return &model.KVPair{
+		Key:      v1key,
+		Value:    v1value,
+		Revision: kvp.Revision,
+	}, nil
+}
+
+// tierOrDefault returns the supplied tier name, or the name of the default tier if the
+// policy does not specify a tier.
+func tierOrDefault(tier string) string {
+	if tier == "" {
+		return apiv3.DefaultTierName
+	}
+	return tier
 }
 
 // policyTypesAPIV2ToBackend converts the policy type field value from the API
@@ -182,6 +182,7 @@ func RuleAPIV2ToBackend(ar apiv3.Rule, ns string) model.Rule {
 		DstPorts:            ar.Destination.Ports,
 		DstService:          dstService,
 		DstServiceNamespace: dstServiceNS,
+		DstDomains:          normalizeDomains(ar.Destination.Domains),
 
 		NotSrcNets:     ConvertStringsToNets(ar.Source.NotNets),
 		NotSrcSelector: ar.Source.NotSelector,
@@ -304,3 +305,16 @@ func ConvertStringsToNets(strs []string) []*cnet.IPNet {
 	}
 	return nets
 }
+
+// normalizeDomains lower-cases the given domain names and strips any trailing dot, so that they
+// can be compared directly with the names in DNS responses.
+func normalizeDomains(domains []string) []string {
+	if len(domains) == 0 {
+		return nil
+	}
+	normalized := make([]string, len(domains))
+	for i, d := range domains {
+		normalized[i] = strings.ToLower(strings.TrimSuffix(d, "."))
+	}
+	return normalized
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package updateprocessors
+
+import (
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/watchersyncer"
+)
+
+// Create a new SyncerUpdateProcessor to sync StagedNetworkPolicy data in v1 format for
+// consumption by Felix.  Staged policies are converted in the same way as the equivalent
+// enforced policy, but the v1 policy name is prefixed to mark the policy as staged.
+func NewStagedNetworkPolicyUpdateProcessor() watchersyncer.SyncerUpdateProcessor {
+	return NewConflictResolvingCacheUpdateProcessor(apiv3.KindStagedNetworkPolicy, convertStagedNetworkPolicyV2ToV1)
+}
+
+// Convert v3 KVPair to the equivalent v1 KVPair.
+func convertStagedNetworkPolicyV2ToV1(kvp *model.KVPair) (*model.KVPair, error) {
+	// Validate against incorrect key/value kinds.  This indicates a code bug rather
+	// than a user error.
+	v3key, ok := kvp.Key.(model.ResourceKey)
+	if !ok || v3key.Kind != apiv3.KindStagedNetworkPolicy {
+		return nil, errors.New("Key is not a valid StagedNetworkPolicy resource key")
+	}
+	v3res, ok := kvp.Value.(*apiv3.StagedNetworkPolicy)
+	if !ok {
+		// Treat any values that fail to convert as a deletion event, as for the enforced policy
+		// processors.  The tier is not known so use the default tier; if the policy was previously
+		// in a different tier the cache sends a delete for that key first.
+		log.WithField("Resource", kvp.Key).Warn("Unable to process resource data - treating as deleted")
+		return &model.KVPair{
+			Key: model.PolicyKey{Tier: tierOrDefault(""), Name: model.StagedPolicyName(v3key.Namespace, v3key.Name)},
+		}, nil
+	}
+
+	stagedAction, enforced := apiv3.ConvertStagedPolicyToEnforced(v3res)
+	v1kvp, err := convertNetworkPolicyV2ToV1(&model.KVPair{
+		Key: model.ResourceKey{
+			Kind:      apiv3.KindNetworkPolicy,
+			Name:      v3key.Name,
+			Namespace: v3key.Namespace,
+		},
+		Value:    enforced,
+		Revision: kvp.Revision,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	v1key := v1kvp.Key.(model.PolicyKey)
+	v1key.Name = model.StagedPolicyName(v3key.Namespace, v3key.Name)
+	v1kvp.Key = v1key
+
+	if stagedAction == apiv3.StagedActionDelete {
+		// A staged delete has no rules to evaluate, so it is sent to Felix as a deletion of
+		// the staged policy.
+		v1kvp.Value = nil
+	}
+	return v1kvp, nil
+}
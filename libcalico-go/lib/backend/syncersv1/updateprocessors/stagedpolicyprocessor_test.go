@@ -0,0 +1,124 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package updateprocessors_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/syncersv1/updateprocessors"
+)
+
+var _ = Describe("Test the StagedNetworkPolicy update processor", func() {
+	v3Key := model.ResourceKey{Kind: apiv3.KindStagedNetworkPolicy, Name: "tier1.pol", Namespace: "ns"}
+	v1Key := model.PolicyKey{Tier: "tier1", Name: "ns/staged:tier1.pol"}
+
+	It("should convert a staged policy to a v1 policy with a staged name", func() {
+		up := updateprocessors.NewStagedNetworkPolicyUpdateProcessor()
+		order := 10.0
+		res := apiv3.NewStagedNetworkPolicy()
+		res.Name = "tier1.pol"
+		res.Namespace = "ns"
+		res.Spec.Tier = "tier1"
+		res.Spec.Order = &order
+		res.Spec.Selector = "a == 'b'"
+		res.Spec.Types = []apiv3.PolicyType{apiv3.PolicyTypeIngress}
+
+		kvps, err := up.Process(&model.KVPair{Key: v3Key, Value: res, Revision: "abcde"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kvps).To(Equal([]*model.KVPair{{
+			Key: v1Key,
+			Value: &model.Policy{
+				Namespace:      "ns",
+				Order:          &order,
+				Selector:       "(a == 'b') && projectcalico.org/namespace == 'ns'",
+				Types:          []string{"ingress"},
+				ApplyOnForward: true,
+			},
+			Revision: "abcde",
+		}}))
+
+		By("deleting the staged policy")
+		kvps, err = up.Process(&model.KVPair{Key: v3Key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key}}))
+	})
+
+	It("should send a staged Delete as a deletion", func() {
+		up := updateprocessors.NewStagedNetworkPolicyUpdateProcessor()
+		res := apiv3.NewStagedNetworkPolicy()
+		res.Name = "tier1.pol"
+		res.Namespace = "ns"
+		res.Spec.Tier = "tier1"
+		res.Spec.StagedAction = apiv3.StagedActionDelete
+
+		kvps, err := up.Process(&model.KVPair{Key: v3Key, Value: res, Revision: "abcde"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key, Revision: "abcde"}}))
+	})
+
+	It("should treat a wrong Value type as a delete", func() {
+		up := updateprocessors.NewStagedNetworkPolicyUpdateProcessor()
+		res := apiv3.NewStagedNetworkPolicy()
+		res.Name = "tier1.pol"
+		res.Namespace = "ns"
+		res.Spec.Tier = "tier1"
+		_, err := up.Process(&model.KVPair{Key: v3Key, Value: res, Revision: "abcde"})
+		Expect(err).NotTo(HaveOccurred())
+
+		kvps, err := up.Process(&model.KVPair{Key: v3Key, Value: apiv3.NewHostEndpoint(), Revision: "abcde"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kvps).To(Equal([]*model.KVPair{
+			{Key: v1Key},
+			{Key: model.PolicyKey{Tier: "default", Name: "ns/staged:tier1.pol"}},
+		}))
+	})
+})
+
+var _ = Describe("Test the StagedGlobalNetworkPolicy update processor", func() {
+	v3Key := model.ResourceKey{Kind: apiv3.KindStagedGlobalNetworkPolicy, Name: "pol"}
+	v1Key := model.PolicyKey{Tier: "default", Name: "staged:pol"}
+
+	It("should convert a staged global policy to a v1 policy with a staged name", func() {
+		up := updateprocessors.NewStagedGlobalNetworkPolicyUpdateProcessor()
+		res := apiv3.NewStagedGlobalNetworkPolicy()
+		res.Name = "pol"
+		res.Spec.Selector = "a == 'b'"
+		res.Spec.DoNotTrack = true
+		res.Spec.ApplyOnForward = true
+
+		kvps, err := up.Process(&model.KVPair{Key: v3Key, Value: res, Revision: "abcde"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kvps).To(Equal([]*model.KVPair{{
+			Key: v1Key,
+			Value: &model.Policy{
+				Selector:       "a == 'b'",
+				DoNotTrack:     true,
+				ApplyOnForward: true,
+			},
+			Revision: "abcde",
+		}}))
+	})
+
+	It("should treat a wrong Value type as a delete", func() {
+		up := updateprocessors.NewStagedGlobalNetworkPolicyUpdateProcessor()
+		kvps, err := up.Process(&model.KVPair{Key: v3Key, Value: apiv3.NewHostEndpoint(), Revision: "abcde"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kvps).To(Equal([]*model.KVPair{{Key: v1Key}}))
+	})
+})
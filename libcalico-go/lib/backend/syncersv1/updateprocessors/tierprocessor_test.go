@@ -0,0 +1,67 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package updateprocessors_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/syncersv1/updateprocessors"
+)
+
+var _ = Describe("Test the Tier update processor", func() {
+	v3TierKey := model.ResourceKey{Kind: apiv3.KindTier, Name: "tier1"}
+	v1TierKey := model.TierKey{Name: "tier1"}
+
+	It("should convert a Tier with no order or default action", func() {
+		up := updateprocessors.NewTierUpdateProcessor()
+		tier := apiv3.NewTier()
+		tier.Name = "tier1"
+
+		kvps, err := up.Process(&model.KVPair{Key: v3TierKey, Value: tier, Revision: "abcde"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kvps).To(Equal([]*model.KVPair{{
+			Key:      v1TierKey,
+			Value:    &model.Tier{DefaultAction: "Deny"},
+			Revision: "abcde",
+		}}))
+	})
+
+	It("should convert a Tier with an order and a Pass default action", func() {
+		up := updateprocessors.NewTierUpdateProcessor()
+		order := 10.5
+		pass := apiv3.Action(apiv3.Pass)
+		tier := apiv3.NewTier()
+		tier.Name = "tier1"
+		tier.Spec.Order = &order
+		tier.Spec.DefaultAction = &pass
+
+		kvps, err := up.Process(&model.KVPair{Key: v3TierKey, Value: tier, Revision: "abcde"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kvps).To(Equal([]*model.KVPair{{
+			Key:      v1TierKey,
+			Value:    &model.Tier{Order: &order, DefaultAction: "Pass"},
+			Revision: "abcde",
+		}}))
+
+		By("deleting the Tier")
+		kvps, err = up.Process(&model.KVPair{Key: v3TierKey})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kvps).To(Equal([]*model.KVPair{{Key: v1TierKey}}))
+	})
+})
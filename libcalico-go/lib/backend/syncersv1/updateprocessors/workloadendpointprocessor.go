@@ -156,6 +156,18 @@ func convertWorkloadEndpointV2ToV1Value(val interface{}) (interface{}, error) {
 		labels[apiv3.LabelServiceAccount] = v3res.Spec.ServiceAccountName
 	}
 
+	var qosControls *model.QoSControls
+	if q := v3res.Spec.QoSControls; q != nil {
+		qosControls = &model.QoSControls{
+			IngressBandwidth:  q.IngressBandwidth,
+			EgressBandwidth:   q.EgressBandwidth,
+			IngressBurst:      q.IngressBurst,
+			EgressBurst:       q.EgressBurst,
+			IngressPacketRate: q.IngressPacketRate,
+			EgressPacketRate:  q.EgressPacketRate,
+		}
+	}
+
 	v1value := &model.WorkloadEndpoint{
 		State:        "active",
 		Name:         v3res.Spec.InterfaceName,
@@ -170,11 +182,22 @@ func convertWorkloadEndpointV2ToV1Value(val interface{}) (interface{}, error) {
 		IPv6Gateway:  ipv6Gateway,
 		Ports:        ports,
 		GenerateName: v3res.GenerateName,
+		QoSControls:  qosControls,
+	}
+	if v3res.Spec.EgressGateway != nil {
+		v1value.EgressSelector = GetEgressGatewaySelector(v3res.Spec.EgressGateway, v3res.Namespace)
 	}
 
 	return v1value, nil
 }
 
+// GetEgressGatewaySelector returns a single selector expression for the egress gateways chosen by
+// the given spec, for a workload in namespace ns.  As for policy rules, the gateways are limited to
+// the workload's own namespace unless a namespace selector is given.
+func GetEgressGatewaySelector(spec *apiv3.EgressGatewaySpec, ns string) string {
+	return getEndpointSelector(spec.NamespaceSelector, spec.Selector, "", "", ns, "egress gateway")
+}
+
 func ConvertV2ToV1IPNAT(ipnat libapiv3.IPNAT) *model.IPNAT {
 	internalip := cnet.ParseIP(ipnat.InternalIP)
 	externalip := cnet.ParseIP(ipnat.ExternalIP)
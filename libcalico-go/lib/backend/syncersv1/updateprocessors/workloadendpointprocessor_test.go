@@ -370,4 +370,73 @@ var _ = Describe("Test the WorkloadEndpoint update processor", func() {
 			Revision: "abcde",
 		}))
 	})
+
+	It("should pass through the QoS controls", func() {
+		up := updateprocessors.NewWorkloadEndpointUpdateProcessor()
+
+		res := libapiv3.NewWorkloadEndpoint()
+		res.Namespace = ns1
+		res.Spec.Node = hn1
+		res.Spec.Orchestrator = oid1
+		res.Spec.Workload = wid1
+		res.Spec.Endpoint = eid1
+		res.Spec.InterfaceName = iface1
+		res.Spec.IPNetworks = []string{"10.100.10.1"}
+		res.Spec.QoSControls = &libapiv3.QoSControls{
+			IngressBandwidth: 10000000,
+			EgressPacketRate: 1000,
+		}
+
+		kvps, err := up.Process(&model.KVPair{
+			Key:      v3WorkloadEndpointKey1,
+			Value:    res,
+			Revision: "abcde",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kvps).To(HaveLen(1))
+		Expect(kvps[0].Value.(*model.WorkloadEndpoint).QoSControls).To(Equal(&model.QoSControls{
+			IngressBandwidth: 10000000,
+			EgressPacketRate: 1000,
+		}))
+	})
+
+	It("should convert the egress gateway selector", func() {
+		up := updateprocessors.NewWorkloadEndpointUpdateProcessor()
+
+		res := libapiv3.NewWorkloadEndpoint()
+		res.Namespace = ns1
+		res.Spec.Node = hn1
+		res.Spec.Orchestrator = oid1
+		res.Spec.Workload = wid1
+		res.Spec.Endpoint = eid1
+		res.Spec.InterfaceName = iface1
+		res.Spec.IPNetworks = []string{"10.100.10.1"}
+
+		By("limiting the gateways to the workload's namespace by default")
+		res.Spec.EgressGateway = &apiv3.EgressGatewaySpec{
+			Selector: "egress-code == 'red'",
+		}
+		kvps, err := up.Process(&model.KVPair{
+			Key:      v3WorkloadEndpointKey1,
+			Value:    res,
+			Revision: "abcde",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kvps[0].Value.(*model.WorkloadEndpoint).EgressSelector).To(Equal(
+			"(projectcalico.org/namespace == 'namespace1') && (egress-code == 'red')"))
+
+		By("selecting the gateways' namespaces with the namespace selector")
+		res.Spec.EgressGateway = &apiv3.EgressGatewaySpec{
+			Selector:          "egress-code == 'red'",
+			NamespaceSelector: "projectcalico.org/name == 'egress'",
+		}
+		kvps, err = up.Process(&model.KVPair{
+			Key:      v3WorkloadEndpointKey1,
+			Value:    res,
+			Revision: "abcde",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kvps[0].Value.(*model.WorkloadEndpoint).EgressSelector).To(Equal(
+			"(pcns.projectcalico.org/name == \"egress\") && (egress-code == 'red')"))
+	})
 })
@@ -89,6 +89,16 @@ func (c client) GlobalNetworkPolicies() GlobalNetworkPolicyInterface {
 	return globalNetworkPolicies{client: c}
 }
 
+// StagedNetworkPolicies returns an interface for managing staged policy resources.
+func (c client) StagedNetworkPolicies() StagedNetworkPolicyInterface {
+	return stagedNetworkPolicies{client: c}
+}
+
+// StagedGlobalNetworkPolicies returns an interface for managing staged global policy resources.
+func (c client) StagedGlobalNetworkPolicies() StagedGlobalNetworkPolicyInterface {
+	return stagedGlobalNetworkPolicies{client: c}
+}
+
 // IPPools returns an interface for managing IP pool resources.
 func (c client) IPPools() IPPoolInterface {
 	return ipPools{client: c}
@@ -99,6 +109,11 @@ func (c client) IPReservations() IPReservationInterface {
 	return ipReservations{client: c}
 }
 
+// Tiers returns an interface for managing tier resources.
+func (c client) Tiers() TierInterface {
+	return tiers{client: c}
+}
+
 // Profiles returns an interface for managing profile resources.
 func (c client) Profiles() ProfileInterface {
 	return profiles{client: c}
@@ -114,6 +129,11 @@ func (c client) NetworkSets() NetworkSetInterface {
 	return networkSets{client: c}
 }
 
+// PacketCaptures returns an interface for managing packet capture resources.
+func (c client) PacketCaptures() PacketCaptureInterface {
+	return packetCaptures{client: c}
+}
+
 // HostEndpoints returns an interface for managing host endpoint resources.
 func (c client) HostEndpoints() HostEndpointInterface {
 	return hostEndpoints{client: c}
@@ -129,6 +149,11 @@ func (c client) BGPPeers() BGPPeerInterface {
 	return bgpPeers{client: c}
 }
 
+// BGPFilters returns an interface for managing BGP filter resources.
+func (c client) BGPFilters() BGPFilterInterface {
+	return bgpFilters{client: c}
+}
+
 // IPAM returns an interface for managing IP address assignment and releasing.
 func (c client) IPAM() ipam.Interface {
 	return ipam.NewIPAMClient(c.backend, poolAccessor{client: &c}, c.IPReservations())
@@ -220,6 +245,23 @@ func (c client) EnsureInitialized(ctx context.Context, calicoVersion, clusterTyp
 		return err
 	}
 
+	if err := c.ensureDefaultTierExists(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ensureDefaultTierExists ensures that the default tier exists, creating it if necessary.
+func (c client) ensureDefaultTierExists(ctx context.Context) error {
+	t := v3.NewTier()
+	t.Name = v3.DefaultTierName
+	if _, err := c.Tiers().Create(ctx, t, options.SetOptions{}); err != nil {
+		if _, ok := err.(cerrors.ErrorResourceAlreadyExists); !ok {
+			log.WithError(err).Error("Error creating the default tier")
+			return err
+		}
+	}
 	return nil
 }
 
@@ -55,6 +55,11 @@ func (r globalNetworkPolicies) Create(ctx context.Context, res *apiv3.GlobalNetw
 		return nil, err
 	}
 
+	// The tier that the policy belongs to must exist.
+	if err := r.client.checkTierExists(ctx, res.Spec.Tier); err != nil {
+		return nil, err
+	}
+
 	// Properly prefix the name
 	res.GetObjectMeta().SetName(convertPolicyNameForStorage(res.GetObjectMeta().GetName()))
 	out, err := r.client.resources.Create(ctx, opts, apiv3.KindGlobalNetworkPolicy, res)
@@ -84,6 +89,11 @@ func (r globalNetworkPolicies) Update(ctx context.Context, res *apiv3.GlobalNetw
 		return nil, err
 	}
 
+	// The tier that the policy belongs to must exist.
+	if err := r.client.checkTierExists(ctx, res.Spec.Tier); err != nil {
+		return nil, err
+	}
+
 	// Properly prefix the name
 	res.GetObjectMeta().SetName(convertPolicyNameForStorage(res.GetObjectMeta().GetName()))
 	out, err := r.client.resources.Update(ctx, opts, apiv3.KindGlobalNetworkPolicy, res)
@@ -183,7 +193,11 @@ func convertPolicyNameForStorage(name string) string {
 	if strings.HasPrefix(name, "ossg.") {
 		return name
 	}
-	return "default." + name
+	// Policies in a non-default tier are already prefixed with the tier name.
+	if strings.Contains(name, ".") {
+		return name
+	}
+	return apiv3.DefaultTierName + "." + name
 }
 
 func convertPolicyNameFromStorage(name string) string {
@@ -195,8 +209,8 @@ func convertPolicyNameFromStorage(name string) string {
 	if strings.HasPrefix(name, "ossg.") {
 		return name
 	}
-	parts := strings.SplitN(name, ".", 2)
-	return parts[len(parts)-1]
+	// Only policies in the default tier have the tier prefix added for storage.
+	return strings.TrimPrefix(name, apiv3.DefaultTierName+".")
 }
 
 type policyConverter struct{}
@@ -27,22 +27,32 @@ type Interface interface {
 	GlobalNetworkPolicies() GlobalNetworkPolicyInterface
 	// NetworkPolicies returns an interface for managing namespaced network policy resources.
 	NetworkPolicies() NetworkPolicyInterface
+	// StagedGlobalNetworkPolicies returns an interface for managing staged global network policy resources.
+	StagedGlobalNetworkPolicies() StagedGlobalNetworkPolicyInterface
+	// StagedNetworkPolicies returns an interface for managing staged namespaced network policy resources.
+	StagedNetworkPolicies() StagedNetworkPolicyInterface
 	// IPPools returns an interface for managing IP pool resources.
 	IPPools() IPPoolInterface
 	// IPReservations returns an interface for managing IP reservation resources.
 	IPReservations() IPReservationInterface
+	// Tiers returns an interface for managing tier resources.
+	Tiers() TierInterface
 	// Profiles returns an interface for managing profile resources.
 	Profiles() ProfileInterface
 	// GlobalNetworkSets returns an interface for managing global network sets resources.
 	GlobalNetworkSets() GlobalNetworkSetInterface
 	// NetworkSets returns an interface for managing network sets resources.
 	NetworkSets() NetworkSetInterface
+	// PacketCaptures returns an interface for managing packet capture resources.
+	PacketCaptures() PacketCaptureInterface
 	// HostEndpoints returns an interface for managing host endpoint resources.
 	HostEndpoints() HostEndpointInterface
 	// WorkloadEndpoints returns an interface for managing workload endpoint resources.
 	WorkloadEndpoints() WorkloadEndpointInterface
 	// BGPPeers returns an interface for managing BGP peer resources.
 	BGPPeers() BGPPeerInterface
+	// BGPFilters returns an interface for managing BGP filter resources.
+	BGPFilters() BGPFilterInterface
 	// IPAM returns an interface for managing IP address assignment and releasing.
 	IPAM() ipam.Interface
 	// BGPConfigurations returns an interface for managing the BGP configuration resources.
@@ -54,6 +54,11 @@ func (r networkPolicies) Create(ctx context.Context, res *apiv3.NetworkPolicy, o
 		return nil, err
 	}
 
+	// The tier that the policy belongs to must exist.
+	if err := r.client.checkTierExists(ctx, res.Spec.Tier); err != nil {
+		return nil, err
+	}
+
 	// Properly prefix the name
 	res.GetObjectMeta().SetName(convertPolicyNameForStorage(res.GetObjectMeta().GetName()))
 	out, err := r.client.resources.Create(ctx, opts, apiv3.KindNetworkPolicy, res)
@@ -83,6 +88,11 @@ func (r networkPolicies) Update(ctx context.Context, res *apiv3.NetworkPolicy, o
 		return nil, err
 	}
 
+	// The tier that the policy belongs to must exist.
+	if err := r.client.checkTierExists(ctx, res.Spec.Tier); err != nil {
+		return nil, err
+	}
+
 	// Properly prefix the name
 	res.GetObjectMeta().SetName(convertPolicyNameForStorage(res.GetObjectMeta().GetName()))
 	out, err := r.client.resources.Update(ctx, opts, apiv3.KindNetworkPolicy, res)
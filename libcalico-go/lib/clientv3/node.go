@@ -140,6 +140,14 @@ func (r nodes) Delete(ctx context.Context, name string, opts options.DeleteOptio
 				log.WithError(err).Warnf("Failed to parse VXLAN tunnel address CIDR: %s", n.Spec.IPv4VXLANTunnelAddr)
 			}
 		}
+		if n.Spec.IPv6VXLANTunnelAddr != "" {
+			ipAddr, _, err := cnet.ParseCIDROrIP(n.Spec.IPv6VXLANTunnelAddr)
+			if err == nil {
+				ips = append(ips, *ipAddr)
+			} else {
+				log.WithError(err).Warnf("Failed to parse IPv6 VXLAN tunnel address CIDR: %s", n.Spec.IPv6VXLANTunnelAddr)
+			}
+		}
 		if n.Spec.Wireguard != nil && n.Spec.Wireguard.InterfaceIPv4Address != "" {
 			ipAddr, _, err := cnet.ParseCIDROrIP(n.Spec.Wireguard.InterfaceIPv4Address)
 			if err == nil {
@@ -148,6 +156,14 @@ func (r nodes) Delete(ctx context.Context, name string, opts options.DeleteOptio
 				log.WithError(err).Warnf("Failed to parse Wireguard tunnel address CIDR: %s", n.Spec.Wireguard.InterfaceIPv4Address)
 			}
 		}
+		if n.Spec.Wireguard != nil && n.Spec.Wireguard.InterfaceIPv6Address != "" {
+			ipAddr, _, err := cnet.ParseCIDROrIP(n.Spec.Wireguard.InterfaceIPv6Address)
+			if err == nil {
+				ips = append(ips, *ipAddr)
+			} else {
+				log.WithError(err).Warnf("Failed to parse IPv6 Wireguard tunnel address CIDR: %s", n.Spec.Wireguard.InterfaceIPv6Address)
+			}
+		}
 	}
 
 	ropts := []ipam.ReleaseOptions{}
@@ -0,0 +1,199 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+
+	apiv3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/options"
+	validator "github.com/projectcalico/calico/libcalico-go/lib/validator/v3"
+	"github.com/projectcalico/calico/libcalico-go/lib/watch"
+)
+
+// TierInterface has methods to work with Tier resources.
+type TierInterface interface {
+	Create(ctx context.Context, res *apiv3.Tier, opts options.SetOptions) (*apiv3.Tier, error)
+	Update(ctx context.Context, res *apiv3.Tier, opts options.SetOptions) (*apiv3.Tier, error)
+	Delete(ctx context.Context, name string, opts options.DeleteOptions) (*apiv3.Tier, error)
+	Get(ctx context.Context, name string, opts options.GetOptions) (*apiv3.Tier, error)
+	List(ctx context.Context, opts options.ListOptions) (*apiv3.TierList, error)
+	Watch(ctx context.Context, opts options.ListOptions) (watch.Interface, error)
+}
+
+// tiers implements TierInterface
+type tiers struct {
+	client client
+}
+
+// Create takes the representation of a Tier and creates it.  Returns the stored
+// representation of the Tier, and an error, if there is any.
+func (r tiers) Create(ctx context.Context, res *apiv3.Tier, opts options.SetOptions) (*apiv3.Tier, error) {
+	if err := validator.Validate(res); err != nil {
+		return nil, err
+	}
+
+	out, err := r.client.resources.Create(ctx, opts, apiv3.KindTier, res)
+	if out != nil {
+		return out.(*apiv3.Tier), err
+	}
+	return nil, err
+}
+
+// Update takes the representation of a Tier and updates it. Returns the stored
+// representation of the Tier, and an error, if there is any.
+func (r tiers) Update(ctx context.Context, res *apiv3.Tier, opts options.SetOptions) (*apiv3.Tier, error) {
+	if err := validator.Validate(res); err != nil {
+		return nil, err
+	}
+
+	out, err := r.client.resources.Update(ctx, opts, apiv3.KindTier, res)
+	if out != nil {
+		return out.(*apiv3.Tier), err
+	}
+	return nil, err
+}
+
+// Delete takes name of the Tier and deletes it. Returns an error if one occurs.  The default
+// tier, and tiers that still contain policies, may not be deleted.
+func (r tiers) Delete(ctx context.Context, name string, opts options.DeleteOptions) (*apiv3.Tier, error) {
+	if name == apiv3.DefaultTierName {
+		return nil, cerrors.ErrorOperationNotSupported{
+			Operation:  "delete",
+			Identifier: name,
+			Reason:     "the default tier cannot be deleted",
+		}
+	}
+
+	// Check that there are no policies in the tier.
+	if inUse, err := r.tierHasPolicies(ctx, name); err != nil {
+		return nil, err
+	} else if inUse {
+		return nil, cerrors.ErrorOperationNotSupported{
+			Operation:  "delete",
+			Identifier: name,
+			Reason:     "cannot delete a tier that contains policies",
+		}
+	}
+
+	log.WithField("name", name).Info("Deleting tier")
+	out, err := r.client.resources.Delete(ctx, opts, apiv3.KindTier, noNamespace, name)
+	if out != nil {
+		return out.(*apiv3.Tier), err
+	}
+	return nil, err
+}
+
+// Get takes name of the Tier, and returns the corresponding Tier object,
+// and an error if there is any.
+func (r tiers) Get(ctx context.Context, name string, opts options.GetOptions) (*apiv3.Tier, error) {
+	out, err := r.client.resources.Get(ctx, opts, apiv3.KindTier, noNamespace, name)
+	if out != nil {
+		return out.(*apiv3.Tier), err
+	}
+	return nil, err
+}
+
+// List returns the list of Tier objects that match the supplied options.
+func (r tiers) List(ctx context.Context, opts options.ListOptions) (*apiv3.TierList, error) {
+	res := &apiv3.TierList{}
+	if err := r.client.resources.List(ctx, opts, apiv3.KindTier, apiv3.KindTierList, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Watch returns a watch.Interface that watches the Tiers that match the
+// supplied options.
+func (r tiers) Watch(ctx context.Context, opts options.ListOptions) (watch.Interface, error) {
+	return r.client.resources.Watch(ctx, opts, apiv3.KindTier, nil)
+}
+
+// tierHasPolicies returns true if any GlobalNetworkPolicy or NetworkPolicy, or any staged version of
+// either, is in the named tier.
+func (r tiers) tierHasPolicies(ctx context.Context, name string) (bool, error) {
+	gnps, err := r.client.GlobalNetworkPolicies().List(ctx, options.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+	for _, p := range gnps.Items {
+		if policyTier(p.Spec.Tier) == name {
+			return true, nil
+		}
+	}
+	nps, err := r.client.NetworkPolicies().List(ctx, options.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+	for _, p := range nps.Items {
+		if policyTier(p.Spec.Tier) == name {
+			return true, nil
+		}
+	}
+	sgnps, err := r.client.StagedGlobalNetworkPolicies().List(ctx, options.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+	for _, p := range sgnps.Items {
+		if policyTier(p.Spec.Tier) == name {
+			return true, nil
+		}
+	}
+	snps, err := r.client.StagedNetworkPolicies().List(ctx, options.ListOptions{})
+	if err != nil {
+		return false, err
+	}
+	for _, p := range snps.Items {
+		if policyTier(p.Spec.Tier) == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkTierExists returns an error if the tier referenced by a policy does not exist.  The
+// default tier is always assumed to exist.
+func (c client) checkTierExists(ctx context.Context, tier string) error {
+	tier = policyTier(tier)
+	if tier == apiv3.DefaultTierName {
+		return nil
+	}
+	if _, err := c.Tiers().Get(ctx, tier, options.GetOptions{}); err != nil {
+		if _, ok := err.(cerrors.ErrorResourceDoesNotExist); ok {
+			return cerrors.ErrorValidation{
+				ErroredFields: []cerrors.ErroredField{{
+					Name:   "Spec.Tier",
+					Reason: "the tier does not exist",
+					Value:  tier,
+				}},
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// policyTier returns the name of the tier that a policy belongs to, given the tier field
+// from the policy spec.
+func policyTier(tier string) string {
+	if tier == "" {
+		return apiv3.DefaultTierName
+	}
+	return tier
+}
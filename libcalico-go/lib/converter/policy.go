@@ -28,6 +28,7 @@ type PolicyConverter struct{}
 func (p PolicyConverter) ConvertMetadataToKey(m unversioned.ResourceMetadata) (model.Key, error) {
 	pm := m.(api.PolicyMetadata)
 	k := model.PolicyKey{
+		Tier: "default",
 		Name: pm.Name,
 	}
 	return k, nil
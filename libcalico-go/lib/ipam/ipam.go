@@ -45,14 +45,16 @@ const (
 
 	// Common attributes which may be set on allocations by clients.  Moved to the model package so they can be used
 	// by the AllocationBlock code too.
-	AttributePod           = model.IPAMBlockAttributePod
-	AttributeNamespace     = model.IPAMBlockAttributeNamespace
-	AttributeNode          = model.IPAMBlockAttributeNode
-	AttributeTimestamp     = model.IPAMBlockAttributeTimestamp
-	AttributeType          = model.IPAMBlockAttributeType
-	AttributeTypeIPIP      = model.IPAMBlockAttributeTypeIPIP
-	AttributeTypeVXLAN     = model.IPAMBlockAttributeTypeVXLAN
-	AttributeTypeWireguard = model.IPAMBlockAttributeTypeWireguard
+	AttributePod             = model.IPAMBlockAttributePod
+	AttributeNamespace       = model.IPAMBlockAttributeNamespace
+	AttributeNode            = model.IPAMBlockAttributeNode
+	AttributeTimestamp       = model.IPAMBlockAttributeTimestamp
+	AttributeType            = model.IPAMBlockAttributeType
+	AttributeTypeIPIP        = model.IPAMBlockAttributeTypeIPIP
+	AttributeTypeVXLAN       = model.IPAMBlockAttributeTypeVXLAN
+	AttributeTypeVXLANV6     = model.IPAMBlockAttributeTypeVXLANV6
+	AttributeTypeWireguard   = model.IPAMBlockAttributeTypeWireguard
+	AttributeTypeWireguardV6 = model.IPAMBlockAttributeTypeWireguardV6
 )
 
 var (
@@ -662,6 +664,15 @@ func (c ipamClient) autoAssign(ctx context.Context, num int, handleID *string, a
 
 	logCtx.Debugf("Found %d affine IPv%d blocks for host: %v", len(affBlocks), version, affBlocks)
 
+	// Only assign workload IPs from the pools where the namespace and node are within their quotas.
+	if use == v3.IPPoolAllowedUseWorkload {
+		pools, affBlocks, err = c.applyQuotas(ctx, pools, affBlocks, num, attrs[AttributeNamespace], host)
+		if err != nil {
+			logCtx.WithError(err).Warn("Unable to assign IPs")
+			return &IPAMAssignments{IPVersion: version, NumRequested: num, HostReservedAttr: rsvdAttr}, err
+		}
+	}
+
 	// Record how many blocks we own so we can check against the limit later.
 	numBlocksOwned := len(affBlocks)
 
@@ -865,6 +876,18 @@ func (c ipamClient) AssignIP(ctx context.Context, args AssignIPArgs) error {
 		return errors.New("The provided IP address is not in a configured pool\n")
 	}
 
+	// Workload IPs are subject to the pool's quotas, as for AutoAssign.
+	if _, ok := args.Attrs[AttributeType]; !ok && hasQuota(*pool) {
+		affBlocks, err := c.blockReaderWriter.getAffineBlocks(ctx, hostname, args.IP.Version())
+		if err != nil {
+			return err
+		}
+		if _, _, err := c.applyQuotas(ctx, []v3.IPPool{*pool}, affBlocks, 1, args.Attrs[AttributeNamespace], hostname); err != nil {
+			log.WithError(err).Warnf("Unable to assign IP %s", args.IP)
+			return err
+		}
+	}
+
 	cfg, err := c.GetIPAMConfig(ctx)
 	if err != nil {
 		log.Errorf("Error getting IPAM Config: %v", err)
@@ -2003,14 +2026,21 @@ func (c ipamClient) GetUtilization(ctx context.Context, args GetUtilizationArgs)
 	// Identify the ones we want and create a PoolUtilization for each of those.
 	wantAllPools := len(args.Pools) == 0
 	wantedPools := set.FromArray(args.Pools)
+	quotas := map[*PoolUtilization]*quotaUsage{}
 	for _, pool := range allPools {
 		if wantAllPools ||
 			wantedPools.Contains(pool.Name) ||
 			wantedPools.Contains(pool.Spec.CIDR) {
-			usage = append(usage, &PoolUtilization{
-				Name: pool.Name,
-				CIDR: net.MustParseNetwork(pool.Spec.CIDR).IPNet,
-			})
+			poolUse := &PoolUtilization{
+				Name:               pool.Name,
+				CIDR:               net.MustParseNetwork(pool.Spec.CIDR).IPNet,
+				MaxIPsPerNamespace: pool.Spec.MaxIPsPerNamespace,
+				MaxIPsPerNode:      pool.Spec.MaxIPsPerNode,
+			}
+			if hasQuota(pool) {
+				quotas[poolUse] = newQuotaUsage()
+			}
+			usage = append(usage, poolUse)
 		}
 	}
 
@@ -2043,10 +2073,23 @@ func (c ipamClient) GetUtilization(ctx context.Context, args GetUtilizationArgs)
 					Capacity:  b.NumAddresses(),
 					Available: len(b.Unallocated),
 				})
+				if u := quotas[poolUse]; u != nil {
+					u.addBlock(b)
+				}
 				break
 			}
 		}
 	}
+
+	// Report the usage for the quotas that are configured.
+	for poolUse, u := range quotas {
+		if poolUse.MaxIPsPerNamespace > 0 {
+			poolUse.NamespaceIPsInUse = u.namespaces
+		}
+		if poolUse.MaxIPsPerNode > 0 {
+			poolUse.NodeIPsInUse = u.nodes
+		}
+	}
 	return usage, nil
 }
 
@@ -71,3 +71,19 @@ type errStaleAffinity string
 func (e errStaleAffinity) Error() string {
 	return string(e)
 }
+
+// ErrQuotaExceeded indicates that assigning the requested IPs would take a namespace or a node
+// over its quota in every IP pool that the IPs could be assigned from.  It describes the quota
+// of the first of those pools.
+type ErrQuotaExceeded struct {
+	Pool  string // Name of the IP pool.
+	Scope string // "namespace" or "node".
+	Name  string // Name of the namespace or node.
+	InUse int    // Number of the pool's IPs in use by the namespace or node.
+	Limit int    // The pool's per-namespace or per-node quota.
+}
+
+func (e ErrQuotaExceeded) Error() string {
+	return fmt.Sprintf("IP quota exceeded: %s '%s' is using %d of the %d IPs allowed per %s from IP pool %s",
+		e.Scope, e.Name, e.InUse, e.Limit, e.Scope, e.Pool)
+}
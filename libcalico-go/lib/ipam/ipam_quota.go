@@ -0,0 +1,231 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipam
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	"github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+const (
+	QuotaScopeNamespace = "namespace"
+	QuotaScopeNode      = "node"
+)
+
+// hasQuota returns true if the pool limits the number of IPs per namespace or per node.
+func hasQuota(pool v3.IPPool) bool {
+	return pool.Spec.MaxIPsPerNamespace > 0 || pool.Spec.MaxIPsPerNode > 0
+}
+
+// quotaUsage counts the workload IPs of a pool that are in use by each namespace and on each node.
+type quotaUsage struct {
+	namespaces map[string]int
+	nodes      map[string]int
+}
+
+func newQuotaUsage() *quotaUsage {
+	return &quotaUsage{
+		namespaces: map[string]int{},
+		nodes:      map[string]int{},
+	}
+}
+
+// addBlock counts the workload IPs that are allocated from the given block.  Workload IPs are
+// the ones without an allocation type; the IPs of tunnel devices have one.  IPs without a namespace
+// or node attribute aren't counted towards the quota of any namespace or node.
+func (u *quotaUsage) addBlock(b *model.AllocationBlock) {
+	for _, idx := range b.Allocations {
+		if idx == nil || *idx >= len(b.Attributes) {
+			continue
+		}
+		attrs := b.Attributes[*idx].AttrSecondary
+		if _, ok := attrs[AttributeType]; ok {
+			continue
+		}
+		if ns := attrs[AttributeNamespace]; ns != "" {
+			u.namespaces[ns]++
+		}
+		if node := attrs[AttributeNode]; node != "" {
+			u.nodes[node]++
+		}
+	}
+}
+
+// checkQuota returns an error if assigning num more IPs from the pool to the given namespace and node
+// would exceed the pool's quotas.  An empty namespace isn't subject to the per-namespace quota.
+func (u *quotaUsage) checkQuota(pool v3.IPPool, num int, namespace, node string) error {
+	if limit := pool.Spec.MaxIPsPerNamespace; limit > 0 && namespace != "" && u.namespaces[namespace]+num > limit {
+		return ErrQuotaExceeded{
+			Pool:  pool.Name,
+			Scope: QuotaScopeNamespace,
+			Name:  namespace,
+			InUse: u.namespaces[namespace],
+			Limit: limit,
+		}
+	}
+	if limit := pool.Spec.MaxIPsPerNode; limit > 0 && u.nodes[node]+num > limit {
+		return ErrQuotaExceeded{
+			Pool:  pool.Name,
+			Scope: QuotaScopeNode,
+			Name:  node,
+			InUse: u.nodes[node],
+			Limit: limit,
+		}
+	}
+	return nil
+}
+
+// applyQuotas removes the pools whose quotas don't leave room for num more workload IPs in the given
+// namespace and on the given node, along with the affine blocks in those pools.  It returns an
+// ErrQuotaExceeded if that leaves no pools.
+//
+// The usage is counted from the blocks before the IPs are assigned, so concurrent assignments may
+// take a namespace slightly over its quota; the quota stops a misbehaving workload from exhausting
+// the pool rather than enforcing an exact count.  See quotaUsage for which blocks are read.
+func (c ipamClient) applyQuotas(ctx context.Context, pools []v3.IPPool, affBlocks []net.IPNet, num int, namespace, host string) ([]v3.IPPool, []net.IPNet, error) {
+	var quotaPools []v3.IPPool
+	for _, p := range pools {
+		if hasQuota(p) {
+			quotaPools = append(quotaPools, p)
+		}
+	}
+	if len(quotaPools) == 0 {
+		return pools, affBlocks, nil
+	}
+
+	usage, err := c.quotaUsage(ctx, quotaPools, affBlocks, namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var allowedPools []v3.IPPool
+	var firstErr error
+	for _, p := range pools {
+		if u, ok := usage[p.Name]; ok {
+			if err := u.checkQuota(p, num, namespace, host); err != nil {
+				log.WithError(err).Info("Not assigning from IP pool")
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+		}
+		allowedPools = append(allowedPools, p)
+	}
+	if len(allowedPools) == 0 {
+		return nil, nil, firstErr
+	}
+
+	var allowedBlocks []net.IPNet
+	for _, b := range affBlocks {
+		for _, p := range allowedPools {
+			if net.MustParseNetwork(p.Spec.CIDR).Covers(b.IPNet) {
+				allowedBlocks = append(allowedBlocks, b)
+				break
+			}
+		}
+	}
+	return allowedPools, allowedBlocks, nil
+}
+
+// quotaUsage counts the workload IPs in use in each of the given pools, keyed by pool name, by the given
+// namespace and by the node that affBlocks belong to.
+//
+// A namespace's IPs can be in any of the pool's blocks so, rather than reading all the blocks, the namespace's
+// usage is counted from the IPs of its workload endpoints, which the datastore lists by namespace.  IPs that
+// are allocated to workloads that haven't been, or are no longer, written to the datastore don't count.
+// Per-node quotas only need the blocks that are affine to the node, which are given by affBlocks; the IPs
+// that the node has borrowed from other nodes' blocks don't count towards its quota.
+func (c ipamClient) quotaUsage(ctx context.Context, pools []v3.IPPool, affBlocks []net.IPNet, namespace string) (map[string]*quotaUsage, error) {
+	usage := map[string]*quotaUsage{}
+	var namespacePools, nodePools []v3.IPPool
+	for _, p := range pools {
+		usage[p.Name] = newQuotaUsage()
+		if p.Spec.MaxIPsPerNamespace > 0 {
+			namespacePools = append(namespacePools, p)
+		}
+		if p.Spec.MaxIPsPerNode > 0 {
+			nodePools = append(nodePools, p)
+		}
+	}
+
+	if len(namespacePools) > 0 && namespace != "" {
+		kvps, err := c.client.List(ctx, model.ResourceListOptions{Kind: libapiv3.KindWorkloadEndpoint, Namespace: namespace}, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, kvp := range kvps.KVPairs {
+			for _, ipNet := range kvp.Value.(*libapiv3.WorkloadEndpoint).Spec.IPNetworks {
+				ip, _, err := net.ParseCIDROrIP(ipNet)
+				if err != nil {
+					log.WithError(err).WithField("endpoint", kvp.Key).Warn("Ignoring invalid workload endpoint IP")
+					continue
+				}
+				if pool, _ := findContainingPool(namespacePools, ip.IP); pool != nil {
+					usage[pool.Name].namespaces[namespace]++
+				}
+			}
+		}
+	}
+
+	if len(nodePools) > 0 {
+		var blocks []*model.AllocationBlock
+		for _, cidr := range affBlocks {
+			if pool, _ := findContainingPool(nodePools, cidr.IP); pool == nil {
+				continue
+			}
+			kvp, err := c.client.Get(ctx, model.BlockKey{CIDR: cidr}, "")
+			if err != nil {
+				if _, ok := err.(cerrors.ErrorResourceDoesNotExist); ok {
+					// The affinity has been claimed but the block hasn't been written yet.
+					continue
+				}
+				return nil, err
+			}
+			blocks = append(blocks, kvp.Value.(*model.AllocationBlock))
+		}
+		// The node's blocks hold IPs of other namespaces too, so only take the node counts from them.
+		blockUsage := map[string]*quotaUsage{}
+		for _, p := range nodePools {
+			blockUsage[p.Name] = newQuotaUsage()
+		}
+		addBlocksToUsage(blockUsage, nodePools, blocks)
+		for name, u := range blockUsage {
+			usage[name].nodes = u.nodes
+		}
+	}
+	return usage, nil
+}
+
+// addBlocksToUsage adds the IPs of each block to the usage of the pool that contains it, if that is one of
+// the given pools.
+func addBlocksToUsage(usage map[string]*quotaUsage, pools []v3.IPPool, blocks []*model.AllocationBlock) {
+	for _, b := range blocks {
+		for _, p := range pools {
+			if net.MustParseNetwork(p.Spec.CIDR).Covers(b.CIDR.IPNet) {
+				usage[p.Name].addBlock(b)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipam
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	v3 "github.com/projectcalico/api/pkg/apis/projectcalico/v3"
+
+	libapiv3 "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	cerrors "github.com/projectcalico/calico/libcalico-go/lib/errors"
+	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+)
+
+// quotaPoolAccessor returns a fixed set of pools.
+type quotaPoolAccessor struct {
+	pools []v3.IPPool
+}
+
+func (a quotaPoolAccessor) GetEnabledPools(ipVersion int) ([]v3.IPPool, error) {
+	return a.pools, nil
+}
+
+func (a quotaPoolAccessor) GetAllPools() ([]v3.IPPool, error) {
+	return a.pools, nil
+}
+
+// quotaTestBlock returns a block with an allocation for each of the given sets of attributes.
+func quotaTestBlock(cidr string, attrs ...map[string]string) *model.KVPair {
+	b := newBlock(cnet.MustParseNetwork(cidr), nil)
+	for i, a := range attrs {
+		idx := i
+		b.Allocations[i] = &idx
+		b.Attributes = append(b.Attributes, model.AllocationAttribute{AttrSecondary: a})
+	}
+	b.Unallocated = b.Unallocated[len(attrs):]
+	return &model.KVPair{Key: model.BlockKey{CIDR: b.CIDR}, Value: b.AllocationBlock}
+}
+
+// quotaTestEndpoint returns a workload endpoint in the given namespace with the given IPs.
+func quotaTestEndpoint(namespace, name string, ips ...string) *model.KVPair {
+	wep := libapiv3.NewWorkloadEndpoint()
+	wep.Namespace = namespace
+	wep.Name = name
+	wep.Spec.IPNetworks = ips
+	return &model.KVPair{
+		Key:   model.ResourceKey{Kind: libapiv3.KindWorkloadEndpoint, Namespace: namespace, Name: name},
+		Value: wep,
+	}
+}
+
+func podAttrs(namespace, node string) map[string]string {
+	return map[string]string{
+		AttributeNamespace: namespace,
+		AttributePod:       "pod",
+		AttributeNode:      node,
+	}
+}
+
+var _ = Describe("IPAM quotas", func() {
+	var (
+		ctx    context.Context
+		client *fakeClient
+		ic     ipamClient
+		pools  []v3.IPPool
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		client = newFakeClient()
+		blocks := []*model.KVPair{
+			quotaTestBlock("10.0.0.0/30",
+				podAttrs("ns1", "node1"),
+				podAttrs("ns1", "node1"),
+				podAttrs("ns2", "node2"),
+				map[string]string{AttributeNode: "node1", AttributeType: AttributeTypeVXLAN},
+			),
+			quotaTestBlock("10.1.0.0/30", podAttrs("ns1", "node2")),
+		}
+		endpoints := []*model.KVPair{
+			quotaTestEndpoint("ns1", "pod1", "10.0.0.0/32"),
+			quotaTestEndpoint("ns1", "pod2", "10.0.0.1/32", "10.1.0.0/32"),
+			quotaTestEndpoint("ns2", "pod3", "10.0.0.2/32"),
+		}
+		client.listFuncs["default"] = func(ctx context.Context, list model.ListInterface, revision string) (*model.KVPairList, error) {
+			if opts, ok := list.(model.BlockAffinityListOptions); ok {
+				Expect(opts.Host).To(Equal("node1"))
+				return &model.KVPairList{KVPairs: []*model.KVPair{{
+					Key: model.BlockAffinityKey{CIDR: cnet.MustParseNetwork("10.0.0.0/30"), Host: "node1"},
+				}}}, nil
+			}
+			opts, ok := list.(model.ResourceListOptions)
+			Expect(ok).To(BeTrue(), "Quotas should only list workload endpoints, not blocks")
+			Expect(opts.Kind).To(Equal(libapiv3.KindWorkloadEndpoint))
+			Expect(opts.Namespace).NotTo(BeEmpty())
+			var kvps []*model.KVPair
+			for _, kvp := range endpoints {
+				if kvp.Key.(model.ResourceKey).Namespace == opts.Namespace {
+					kvps = append(kvps, kvp)
+				}
+			}
+			return &model.KVPairList{KVPairs: kvps}, nil
+		}
+		client.getFuncs["default"] = func(ctx context.Context, key model.Key, revision string) (*model.KVPair, error) {
+			for _, kvp := range blocks {
+				if kvp.Key.String() == key.String() {
+					return kvp, nil
+				}
+			}
+			return nil, cerrors.ErrorResourceDoesNotExist{Identifier: key}
+		}
+		ic = ipamClient{client: client}
+		pools = []v3.IPPool{
+			{Spec: v3.IPPoolSpec{CIDR: "10.0.0.0/24", BlockSize: 30}},
+			{Spec: v3.IPPoolSpec{CIDR: "10.1.0.0/24", BlockSize: 30}},
+		}
+		pools[0].Name = "pool0"
+		pools[1].Name = "pool1"
+	})
+
+	It("should count the namespace's IPs from its workload endpoints and the node's from its blocks", func() {
+		pools[0].Spec.MaxIPsPerNamespace = 10
+		pools[0].Spec.MaxIPsPerNode = 10
+		pools[1].Spec.MaxIPsPerNamespace = 10
+		affBlocks := []cnet.IPNet{cnet.MustParseNetwork("10.0.0.0/30")}
+		usage, err := ic.quotaUsage(ctx, pools, affBlocks, "ns1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(usage["pool0"].namespaces).To(Equal(map[string]int{"ns1": 2}))
+		Expect(usage["pool0"].nodes).To(Equal(map[string]int{"node1": 2, "node2": 1}))
+		Expect(usage["pool1"].namespaces).To(Equal(map[string]int{"ns1": 1}))
+		Expect(usage["pool1"].nodes).To(BeEmpty())
+	})
+
+	It("should not list blocks if no pool has a quota", func() {
+		client.listFuncs = map[string]func(ctx context.Context, list model.ListInterface, revision string) (*model.KVPairList, error){}
+		affBlocks := []cnet.IPNet{cnet.MustParseNetwork("10.0.0.0/30")}
+		allowedPools, allowedBlocks, err := ic.applyQuotas(ctx, pools, affBlocks, 1, "ns1", "node1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowedPools).To(Equal(pools))
+		Expect(allowedBlocks).To(Equal(affBlocks))
+	})
+
+	It("should skip pools where the namespace is at its quota", func() {
+		pools[0].Spec.MaxIPsPerNamespace = 2
+		affBlocks := []cnet.IPNet{cnet.MustParseNetwork("10.0.0.0/30"), cnet.MustParseNetwork("10.1.0.0/30")}
+		allowedPools, allowedBlocks, err := ic.applyQuotas(ctx, pools, affBlocks, 1, "ns1", "node1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowedPools).To(Equal(pools[1:]))
+		Expect(allowedBlocks).To(Equal(affBlocks[1:]))
+
+		// Another namespace is still within its quota.
+		allowedPools, _, err = ic.applyQuotas(ctx, pools, affBlocks, 1, "ns2", "node1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowedPools).To(Equal(pools))
+	})
+
+	It("should not count tunnel IPs towards the node quota", func() {
+		pools[0].Spec.MaxIPsPerNode = 3
+		affBlocks := []cnet.IPNet{cnet.MustParseNetwork("10.0.0.0/30")}
+		allowedPools, _, err := ic.applyQuotas(ctx, pools[:1], affBlocks, 1, "ns3", "node1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowedPools).To(Equal(pools[:1]))
+	})
+
+	It("should only read the node's affine blocks for a per-node quota", func() {
+		pools[0].Spec.MaxIPsPerNode = 2
+		client.listFuncs = map[string]func(ctx context.Context, list model.ListInterface, revision string) (*model.KVPairList, error){}
+		affBlocks := []cnet.IPNet{
+			cnet.MustParseNetwork("10.0.0.0/30"),
+			// Claimed but not yet written.
+			cnet.MustParseNetwork("10.0.0.4/30"),
+		}
+		_, _, err := ic.applyQuotas(ctx, pools[:1], affBlocks, 1, "ns3", "node1")
+		Expect(err).To(Equal(ErrQuotaExceeded{Pool: "pool0", Scope: QuotaScopeNode, Name: "node1", InUse: 2, Limit: 2}))
+
+		// Without the affine block, nothing is counted.
+		_, _, err = ic.applyQuotas(ctx, pools[:1], nil, 1, "ns3", "node1")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should apply the quotas to specific IPs that are assigned to workloads", func() {
+		pools[0].Spec.MaxIPsPerNode = 2
+		ic.blockReaderWriter = blockReaderWriter{client: client, pools: quotaPoolAccessor{pools}}
+		err := ic.AssignIP(ctx, AssignIPArgs{
+			IP:       cnet.MustParseIP("10.0.0.5"),
+			Attrs:    podAttrs("ns3", "node1"),
+			Hostname: "node1",
+		})
+		Expect(err).To(Equal(ErrQuotaExceeded{Pool: "pool0", Scope: QuotaScopeNode, Name: "node1", InUse: 2, Limit: 2}))
+	})
+
+	It("should return ErrQuotaExceeded if every pool is over quota", func() {
+		pools[0].Spec.MaxIPsPerNode = 3
+		pools[1].Spec.MaxIPsPerNamespace = 1
+		affBlocks := []cnet.IPNet{cnet.MustParseNetwork("10.0.0.0/30")}
+		_, _, err := ic.applyQuotas(ctx, pools, affBlocks, 2, "ns1", "node1")
+		Expect(err).To(Equal(ErrQuotaExceeded{Pool: "pool0", Scope: QuotaScopeNode, Name: "node1", InUse: 2, Limit: 3}))
+		Expect(err.Error()).To(Equal("IP quota exceeded: node 'node1' is using 2 of the 3 IPs allowed per node from IP pool pool0"))
+	})
+
+	It("should not apply the namespace quota to IPs without a namespace", func() {
+		pools[0].Spec.MaxIPsPerNamespace = 1
+		allowedPools, _, err := ic.applyQuotas(ctx, pools[:1], nil, 1, "", "node1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allowedPools).To(Equal(pools[:1]))
+	})
+})
@@ -136,6 +136,15 @@ type PoolUtilization struct {
 
 	// Utilization for each of this pool's blocks.
 	Blocks []BlockUtilization
+
+	// This pool's per-namespace and per-node IP quotas, or zero if there is no quota.
+	MaxIPsPerNamespace int
+	MaxIPsPerNode      int
+
+	// Number of this pool's workload IPs in use by each namespace and on each node.  These are only
+	// filled in when the corresponding quota is configured.
+	NamespaceIPsInUse map[string]int
+	NodeIPsInUse      map[string]int
 }
 
 type HostReservedAttr struct {
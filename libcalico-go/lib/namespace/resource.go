@@ -29,7 +29,7 @@ const (
 
 func IsNamespaced(kind string) bool {
 	switch kind {
-	case libapiv3.KindWorkloadEndpoint, apiv3.KindNetworkPolicy, apiv3.KindNetworkSet:
+	case libapiv3.KindWorkloadEndpoint, apiv3.KindNetworkPolicy, apiv3.KindStagedNetworkPolicy, apiv3.KindNetworkSet:
 		return true
 	case KindKubernetesNetworkPolicy:
 		// KindKubernetesNetworkPolicy is a special-case resource. We don't expose it over the
@@ -0,0 +1,368 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pcapfilter
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"golang.org/x/net/bpf"
+)
+
+// Offsets within an Ethernet frame.  As for tcpdump, IPv6 extension headers aren't followed.
+const (
+	offEtherType = 12
+
+	offIPv4Proto    = 14 + 9
+	offIPv4Frag     = 14 + 6
+	offIPv4Src      = 14 + 12
+	offIPv4Dst      = 14 + 16
+	offIPv4IHL      = 14
+	offIPv4L4       = 14 // Relative to the IPv4 header length loaded into X.
+	offIPv6NextHdr  = 14 + 6
+	offIPv6Src      = 14 + 8
+	offIPv6Dst      = 14 + 24
+	offIPv6L4       = 14 + 40
+	offL4SrcPort    = 0
+	offL4DstPort    = 2
+	ipv4FragOffMask = 0x1fff
+
+	etherTypeIPv4 = 0x0800
+	etherTypeIPv6 = 0x86dd
+	etherTypeARP  = 0x0806
+
+	protoICMP   = 1
+	protoTCP    = 6
+	protoUDP    = 17
+	protoICMPv6 = 58
+	protoSCTP   = 132
+)
+
+var protocolNumbers = map[string]uint32{
+	"tcp":   protoTCP,
+	"udp":   protoUDP,
+	"sctp":  protoSCTP,
+	"icmp":  protoICMP,
+	"icmp6": protoICMPv6,
+}
+
+// Compile compiles the expression to a classic BPF program for a socket that receives Ethernet
+// frames.  The program accepts up to snapLen bytes of the matching packets.  A nil expression
+// compiles to a program that accepts every packet.
+func Compile(expr Expr, snapLen uint32) ([]bpf.RawInstruction, error) {
+	c := &compiler{}
+	accept, reject := c.newLabel(), c.newLabel()
+	if expr != nil {
+		if err := c.compile(expr, accept, reject); err != nil {
+			return nil, err
+		}
+	}
+	c.place(accept)
+	c.emit(bpf.RetConstant{Val: snapLen})
+	c.place(reject)
+	c.emit(bpf.RetConstant{Val: 0})
+
+	insns, err := c.resolve()
+	if err != nil {
+		return nil, err
+	}
+	return bpf.Assemble(insns)
+}
+
+// label identifies a position in the program that jumps can target before it is known.
+type label int
+
+type instruction struct {
+	insn bpf.Instruction
+
+	// For jumps, insn is nil and the following hold the condition and the targets.  An
+	// unconditional jump only uses ifTrue.
+	cond        bpf.JumpTest
+	val         uint32
+	ifTrue      label
+	ifFalse     label
+	jump        bool
+	conditional bool
+}
+
+type compiler struct {
+	insns []instruction
+	// labelPos maps from label to the index of the instruction that follows it, or -1 if the
+	// label hasn't been placed yet.
+	labelPos []int
+}
+
+func (c *compiler) newLabel() label {
+	c.labelPos = append(c.labelPos, -1)
+	return label(len(c.labelPos) - 1)
+}
+
+func (c *compiler) place(l label) {
+	c.labelPos[l] = len(c.insns)
+}
+
+func (c *compiler) emit(insn bpf.Instruction) {
+	c.insns = append(c.insns, instruction{insn: insn})
+}
+
+// branch emits a conditional jump that compares the accumulator with the value.
+func (c *compiler) branch(cond bpf.JumpTest, val uint32, ifTrue, ifFalse label) {
+	c.insns = append(c.insns, instruction{
+		cond:        cond,
+		val:         val,
+		ifTrue:      ifTrue,
+		ifFalse:     ifFalse,
+		jump:        true,
+		conditional: true,
+	})
+}
+
+// jump emits an unconditional jump.
+func (c *compiler) jump(target label) {
+	c.insns = append(c.insns, instruction{ifTrue: target, jump: true})
+}
+
+// resolve converts the jumps to labels into relative jumps.
+func (c *compiler) resolve() ([]bpf.Instruction, error) {
+	out := make([]bpf.Instruction, len(c.insns))
+	for i, insn := range c.insns {
+		if !insn.jump {
+			out[i] = insn.insn
+			continue
+		}
+		if !insn.conditional {
+			out[i] = bpf.Jump{Skip: uint32(c.labelPos[insn.ifTrue] - (i + 1))}
+			continue
+		}
+		skipTrue := c.labelPos[insn.ifTrue] - (i + 1)
+		skipFalse := c.labelPos[insn.ifFalse] - (i + 1)
+		if skipTrue < 0 || skipFalse < 0 {
+			return nil, fmt.Errorf("BUG: jump to a label that hasn't been placed")
+		}
+		if skipTrue > 255 || skipFalse > 255 {
+			return nil, fmt.Errorf("filter is too complex")
+		}
+		out[i] = bpf.JumpIf{Cond: insn.cond, Val: insn.val, SkipTrue: uint8(skipTrue), SkipFalse: uint8(skipFalse)}
+	}
+	return out, nil
+}
+
+// compile emits code that jumps to ifTrue if the packet matches the expression and to ifFalse
+// otherwise.
+func (c *compiler) compile(expr Expr, ifTrue, ifFalse label) error {
+	switch e := expr.(type) {
+	case And:
+		right := c.newLabel()
+		if err := c.compile(e.Left, right, ifFalse); err != nil {
+			return err
+		}
+		c.place(right)
+		return c.compile(e.Right, ifTrue, ifFalse)
+	case Or:
+		right := c.newLabel()
+		if err := c.compile(e.Left, ifTrue, right); err != nil {
+			return err
+		}
+		c.place(right)
+		return c.compile(e.Right, ifTrue, ifFalse)
+	case Not:
+		return c.compile(e.Expr, ifFalse, ifTrue)
+	case Primitive:
+		switch e.Kind {
+		case "":
+			c.compileProto(e.Proto, ifTrue, ifFalse)
+		case "host", "net":
+			c.compileAddr(e, ifTrue, ifFalse)
+		case "port", "portrange":
+			c.compilePorts(e, ifTrue, ifFalse)
+		default:
+			return fmt.Errorf("unsupported filter primitive %q", e.Kind)
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported filter expression %v", expr)
+}
+
+func (c *compiler) loadEtherType() {
+	c.emit(bpf.LoadAbsolute{Off: offEtherType, Size: 2})
+}
+
+func (c *compiler) compileProto(proto string, ifTrue, ifFalse label) {
+	c.loadEtherType()
+	switch proto {
+	case "ip":
+		c.branch(bpf.JumpEqual, etherTypeIPv4, ifTrue, ifFalse)
+	case "ip6":
+		c.branch(bpf.JumpEqual, etherTypeIPv6, ifTrue, ifFalse)
+	case "arp":
+		c.branch(bpf.JumpEqual, etherTypeARP, ifTrue, ifFalse)
+	case "icmp":
+		isIPv4 := c.newLabel()
+		c.branch(bpf.JumpEqual, etherTypeIPv4, isIPv4, ifFalse)
+		c.place(isIPv4)
+		c.emit(bpf.LoadAbsolute{Off: offIPv4Proto, Size: 1})
+		c.branch(bpf.JumpEqual, protoICMP, ifTrue, ifFalse)
+	case "icmp6":
+		isIPv6 := c.newLabel()
+		c.branch(bpf.JumpEqual, etherTypeIPv6, isIPv6, ifFalse)
+		c.place(isIPv6)
+		c.emit(bpf.LoadAbsolute{Off: offIPv6NextHdr, Size: 1})
+		c.branch(bpf.JumpEqual, protoICMPv6, ifTrue, ifFalse)
+	default:
+		// TCP, UDP or SCTP, over either IP version.
+		isIPv4, notIPv4, isIPv6 := c.newLabel(), c.newLabel(), c.newLabel()
+		c.branch(bpf.JumpEqual, etherTypeIPv4, isIPv4, notIPv4)
+		c.place(isIPv4)
+		c.emit(bpf.LoadAbsolute{Off: offIPv4Proto, Size: 1})
+		c.branch(bpf.JumpEqual, protocolNumbers[proto], ifTrue, ifFalse)
+		c.place(notIPv4)
+		c.branch(bpf.JumpEqual, etherTypeIPv6, isIPv6, ifFalse)
+		c.place(isIPv6)
+		c.emit(bpf.LoadAbsolute{Off: offIPv6NextHdr, Size: 1})
+		c.branch(bpf.JumpEqual, protocolNumbers[proto], ifTrue, ifFalse)
+	}
+}
+
+func (c *compiler) compileAddr(p Primitive, ifTrue, ifFalse label) {
+	etherType := uint32(etherTypeIPv4)
+	srcOff, dstOff := uint32(offIPv4Src), uint32(offIPv4Dst)
+	addr, mask := []byte(p.Net.IP.To4()), []byte(p.Net.Mask)
+	if addr == nil {
+		etherType = etherTypeIPv6
+		srcOff, dstOff = offIPv6Src, offIPv6Dst
+		addr = p.Net.IP.To16()
+	}
+	var offsets []uint32
+	switch p.Dir {
+	case "src":
+		offsets = []uint32{srcOff}
+	case "dst":
+		offsets = []uint32{dstOff}
+	default:
+		offsets = []uint32{srcOff, dstOff}
+	}
+
+	isIP := c.newLabel()
+	c.loadEtherType()
+	c.branch(bpf.JumpEqual, etherType, isIP, ifFalse)
+	c.place(isIP)
+	for i, off := range offsets {
+		noMatch := ifFalse
+		if i < len(offsets)-1 {
+			noMatch = c.newLabel()
+		}
+		// Compare the address a 32-bit word at a time, skipping the words that the mask
+		// excludes entirely.
+		for w := 0; w < len(addr); w += 4 {
+			wordMask := binary.BigEndian.Uint32(mask[w:])
+			if wordMask == 0 {
+				continue
+			}
+			c.emit(bpf.LoadAbsolute{Off: off + uint32(w), Size: 4})
+			if wordMask != 0xffffffff {
+				c.emit(bpf.ALUOpConstant{Op: bpf.ALUOpAnd, Val: wordMask})
+			}
+			matched := c.newLabel()
+			c.branch(bpf.JumpEqual, binary.BigEndian.Uint32(addr[w:])&wordMask, matched, noMatch)
+			c.place(matched)
+		}
+		c.jump(ifTrue)
+		if i < len(offsets)-1 {
+			c.place(noMatch)
+		}
+	}
+}
+
+func (c *compiler) compilePorts(p Primitive, ifTrue, ifFalse label) {
+	protos := []uint32{protoTCP, protoUDP, protoSCTP}
+	if p.Proto != "" {
+		protos = []uint32{protocolNumbers[p.Proto]}
+	}
+	var offsets []uint32
+	switch p.Dir {
+	case "src":
+		offsets = []uint32{offL4SrcPort}
+	case "dst":
+		offsets = []uint32{offL4DstPort}
+	default:
+		offsets = []uint32{offL4SrcPort, offL4DstPort}
+	}
+
+	// checkPorts emits the comparison of the ports, which it loads with the given function.
+	checkPorts := func(load func(off uint32)) {
+		for i, off := range offsets {
+			noMatch := ifFalse
+			if i < len(offsets)-1 {
+				noMatch = c.newLabel()
+			}
+			load(off)
+			if p.PortMin == p.PortMax {
+				c.branch(bpf.JumpEqual, uint32(p.PortMin), ifTrue, noMatch)
+			} else {
+				aboveMin := c.newLabel()
+				c.branch(bpf.JumpGreaterOrEqual, uint32(p.PortMin), aboveMin, noMatch)
+				c.place(aboveMin)
+				c.branch(bpf.JumpGreaterThan, uint32(p.PortMax), noMatch, ifTrue)
+			}
+			if i < len(offsets)-1 {
+				c.place(noMatch)
+			}
+		}
+	}
+	// checkProtos emits the comparison of the protocol number in the accumulator.
+	checkProtos := func(matched, notMatched label) {
+		for i, proto := range protos {
+			next := notMatched
+			if i < len(protos)-1 {
+				next = c.newLabel()
+			}
+			c.branch(bpf.JumpEqual, proto, matched, next)
+			if i < len(protos)-1 {
+				c.place(next)
+			}
+		}
+	}
+
+	isIPv4, notIPv4, isIPv6 := c.newLabel(), c.newLabel(), c.newLabel()
+	c.loadEtherType()
+	c.branch(bpf.JumpEqual, etherTypeIPv4, isIPv4, notIPv4)
+
+	// IPv4: the protocol must match and the packet must be the first fragment, which is the only
+	// one that has the ports.
+	c.place(isIPv4)
+	protoMatchedV4, firstFragment := c.newLabel(), c.newLabel()
+	c.emit(bpf.LoadAbsolute{Off: offIPv4Proto, Size: 1})
+	checkProtos(protoMatchedV4, ifFalse)
+	c.place(protoMatchedV4)
+	c.emit(bpf.LoadAbsolute{Off: offIPv4Frag, Size: 2})
+	c.branch(bpf.JumpBitsSet, ipv4FragOffMask, ifFalse, firstFragment)
+	c.place(firstFragment)
+	c.emit(bpf.LoadMemShift{Off: offIPv4IHL})
+	checkPorts(func(off uint32) {
+		c.emit(bpf.LoadIndirect{Off: offIPv4L4 + off, Size: 2})
+	})
+
+	// IPv6.
+	c.place(notIPv4)
+	protoMatchedV6 := c.newLabel()
+	c.branch(bpf.JumpEqual, etherTypeIPv6, isIPv6, ifFalse)
+	c.place(isIPv6)
+	c.emit(bpf.LoadAbsolute{Off: offIPv6NextHdr, Size: 1})
+	checkProtos(protoMatchedV6, ifFalse)
+	c.place(protoMatchedV6)
+	checkPorts(func(off uint32) {
+		c.emit(bpf.LoadAbsolute{Off: offIPv6L4 + off, Size: 2})
+	})
+}
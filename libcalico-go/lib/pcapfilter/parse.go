@@ -0,0 +1,350 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pcapfilter parses packet filter expressions, in a subset of the pcap-filter syntax
+// used by tcpdump, and compiles them to classic BPF programs that can be attached to a packet
+// socket.
+//
+// The supported primitives are:
+//
+//   - A protocol on its own: "ip", "ip6", "arp", "tcp", "udp", "sctp", "icmp" or "icmp6".
+//   - "host ADDR" and "net CIDR", optionally qualified by "ip" or "ip6".  A bare address or
+//     CIDR is accepted after "src" or "dst".
+//   - "port N" and "portrange N-M", optionally qualified by "tcp", "udp" or "sctp".
+//
+// The host, net, port and portrange primitives match either the source or the destination
+// unless qualified by "src" or "dst".  Primitives are combined with "and" (or "&&"), "or" (or
+// "||"), "not" (or "!") and parentheses, with the usual precedence.
+package pcapfilter
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Expr is a parsed filter expression.
+type Expr interface {
+	String() string
+}
+
+// And matches packets that match both of its operands.
+type And struct {
+	Left, Right Expr
+}
+
+func (e And) String() string {
+	return "(" + e.Left.String() + " and " + e.Right.String() + ")"
+}
+
+// Or matches packets that match either of its operands.
+type Or struct {
+	Left, Right Expr
+}
+
+func (e Or) String() string {
+	return "(" + e.Left.String() + " or " + e.Right.String() + ")"
+}
+
+// Not matches packets that don't match its operand.
+type Not struct {
+	Expr Expr
+}
+
+func (e Not) String() string {
+	return "not " + e.Expr.String()
+}
+
+// Primitive is a single test on a packet.
+type Primitive struct {
+	// Proto is the protocol qualifier, or "" if there is none.
+	Proto string
+	// Dir is "src", "dst" or "", for either.
+	Dir string
+	// Kind is "host", "net", "port", "portrange" or "", for a test of the protocol alone.
+	Kind string
+	// Net is the address or CIDR of a host or net primitive.
+	Net *net.IPNet
+	// PortMin and PortMax are the (inclusive) range of a port or portrange primitive.
+	PortMin, PortMax uint16
+}
+
+func (p Primitive) String() string {
+	var parts []string
+	for _, s := range []string{p.Proto, p.Dir, p.Kind} {
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+	switch p.Kind {
+	case "host":
+		parts = append(parts, p.Net.IP.String())
+	case "net":
+		parts = append(parts, p.Net.String())
+	case "port":
+		parts = append(parts, strconv.Itoa(int(p.PortMin)))
+	case "portrange":
+		parts = append(parts, fmt.Sprintf("%d-%d", p.PortMin, p.PortMax))
+	}
+	return strings.Join(parts, " ")
+}
+
+var (
+	protocols = map[string]bool{
+		"ip": true, "ip6": true, "arp": true,
+		"tcp": true, "udp": true, "sctp": true,
+		"icmp": true, "icmp6": true,
+	}
+	addrProtocols = map[string]bool{"": true, "ip": true, "ip6": true}
+	portProtocols = map[string]bool{"": true, "tcp": true, "udp": true, "sctp": true}
+)
+
+// Parse parses a filter expression.  It returns nil, and no error, for an empty expression,
+// which matches every packet.
+func Parse(filter string) (Expr, error) {
+	p := &parser{tokens: tokenize(filter)}
+	if len(p.tokens) == 0 {
+		return nil, nil
+	}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok != "" {
+		return nil, fmt.Errorf("unexpected %q in filter", tok)
+	}
+	return expr, nil
+}
+
+// tokenize splits the filter into words, parentheses and the symbolic operators.
+func tokenize(filter string) []string {
+	var tokens []string
+	var word strings.Builder
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, word.String())
+			word.Reset()
+		}
+	}
+	for i := 0; i < len(filter); i++ {
+		c := filter[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			flush()
+		case c == '(' || c == ')' || c == '!':
+			flush()
+			tokens = append(tokens, string(c))
+		case (c == '&' || c == '|') && i+1 < len(filter) && filter[i+1] == c:
+			flush()
+			tokens = append(tokens, filter[i:i+2])
+			i++
+		default:
+			word.WriteByte(c)
+		}
+	}
+	flush()
+	return tokens
+}
+
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *parser) peek() string {
+	if p.pos >= len(p.tokens) {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() string {
+	tok := p.peek()
+	if tok != "" {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for tok := p.peek(); tok == "or" || tok == "||"; tok = p.peek() {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = Or{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for tok := p.peek(); tok == "and" || tok == "&&"; tok = p.peek() {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = And{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (Expr, error) {
+	switch p.peek() {
+	case "not", "!":
+		p.next()
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return Not{Expr: expr}, nil
+	case "(":
+		p.next()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if tok := p.next(); tok != ")" {
+			return nil, fmt.Errorf("expected \")\" in filter but found %q", tok)
+		}
+		return expr, nil
+	case "":
+		return nil, fmt.Errorf("unexpected end of filter")
+	}
+	return p.parsePrimitive()
+}
+
+func (p *parser) parsePrimitive() (Expr, error) {
+	var prim Primitive
+	if protocols[p.peek()] {
+		prim.Proto = p.next()
+	}
+	if tok := p.peek(); tok == "src" || tok == "dst" {
+		prim.Dir = p.next()
+	}
+
+	switch tok := p.peek(); tok {
+	case "host", "net":
+		prim.Kind = p.next()
+		if err := p.parseAddr(&prim); err != nil {
+			return nil, err
+		}
+	case "port", "portrange":
+		prim.Kind = p.next()
+		if err := p.parsePorts(&prim); err != nil {
+			return nil, err
+		}
+	default:
+		if prim.Dir != "" && tok != "" && (net.ParseIP(tok) != nil || strings.Contains(tok, "/")) {
+			// "src ADDR" is short for "src host ADDR" (or "src net CIDR").
+			prim.Kind = "host"
+			if strings.Contains(tok, "/") {
+				prim.Kind = "net"
+			}
+			if err := p.parseAddr(&prim); err != nil {
+				return nil, err
+			}
+		} else if prim.Dir != "" {
+			return nil, fmt.Errorf("%q must be followed by host, net, port or portrange", prim.Dir)
+		} else if prim.Proto == "" {
+			return nil, fmt.Errorf("unsupported filter primitive %q", tok)
+		}
+	}
+	return prim, nil
+}
+
+func (p *parser) parseAddr(prim *Primitive) error {
+	if !addrProtocols[prim.Proto] {
+		return fmt.Errorf("%q can't qualify %q", prim.Proto, prim.Kind)
+	}
+	value := p.next()
+	if value == "" {
+		return fmt.Errorf("%s requires an address", prim.Kind)
+	}
+	if prim.Kind == "host" {
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return fmt.Errorf("invalid host address %q", value)
+		}
+		bits := 128
+		if ip4 := ip.To4(); ip4 != nil {
+			ip, bits = ip4, 32
+		}
+		prim.Net = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+	} else {
+		_, cidr, err := net.ParseCIDR(value)
+		if err != nil {
+			return fmt.Errorf("invalid net %q", value)
+		}
+		prim.Net = cidr
+	}
+	isV6 := prim.Net.IP.To4() == nil
+	if (prim.Proto == "ip" && isV6) || (prim.Proto == "ip6" && !isV6) {
+		return fmt.Errorf("address %q doesn't match the %q qualifier", value, prim.Proto)
+	}
+	return nil
+}
+
+func (p *parser) parsePorts(prim *Primitive) error {
+	if !portProtocols[prim.Proto] {
+		return fmt.Errorf("%q can't qualify %q", prim.Proto, prim.Kind)
+	}
+	value := p.next()
+	if value == "" {
+		return fmt.Errorf("%s requires a port", prim.Kind)
+	}
+	if prim.Kind == "port" {
+		port, err := parsePort(value)
+		if err != nil {
+			return err
+		}
+		prim.PortMin, prim.PortMax = port, port
+		return nil
+	}
+	parts := strings.Split(value, "-")
+	if len(parts) != 2 {
+		return fmt.Errorf("invalid port range %q", value)
+	}
+	min, err := parsePort(parts[0])
+	if err != nil {
+		return err
+	}
+	max, err := parsePort(parts[1])
+	if err != nil {
+		return err
+	}
+	if min > max {
+		return fmt.Errorf("invalid port range %q", value)
+	}
+	prim.PortMin, prim.PortMax = min, max
+	return nil
+}
+
+func parsePort(value string) (uint16, error) {
+	port, err := strconv.ParseUint(value, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("invalid port %q", value)
+	}
+	return uint16(port), nil
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pcapfilter_test
+
+import (
+	"net"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"golang.org/x/net/bpf"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/pcapfilter"
+)
+
+const snapLen = 65535
+
+// packet builds an Ethernet frame carrying an IPv4 or IPv6 packet with the given transport layer.
+// It panics on error since the table entries are built before the specs run.
+func packet(src, dst string, transport gopacket.SerializableLayer) []byte {
+	srcIP, dstIP := net.ParseIP(src), net.ParseIP(dst)
+	eth := &layers.Ethernet{
+		SrcMAC: net.HardwareAddr{0xee, 0xee, 0xee, 0xee, 0xee, 0xee},
+		DstMAC: net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01},
+	}
+	var proto layers.IPProtocol
+	switch transport.(type) {
+	case *layers.TCP:
+		proto = layers.IPProtocolTCP
+	case *layers.UDP:
+		proto = layers.IPProtocolUDP
+	case *layers.ICMPv4:
+		proto = layers.IPProtocolICMPv4
+	}
+	var network interface {
+		gopacket.NetworkLayer
+		gopacket.SerializableLayer
+	}
+	if srcIP.To4() != nil {
+		eth.EthernetType = layers.EthernetTypeIPv4
+		network = &layers.IPv4{Version: 4, IHL: 5, TTL: 64, Protocol: proto, SrcIP: srcIP.To4(), DstIP: dstIP.To4()}
+	} else {
+		eth.EthernetType = layers.EthernetTypeIPv6
+		network = &layers.IPv6{Version: 6, HopLimit: 64, NextHeader: proto, SrcIP: srcIP, DstIP: dstIP}
+	}
+	if l4, ok := transport.(interface {
+		SetNetworkLayerForChecksum(gopacket.NetworkLayer) error
+	}); ok {
+		if err := l4.SetNetworkLayerForChecksum(network); err != nil {
+			panic(err)
+		}
+	}
+	buf := gopacket.NewSerializeBuffer()
+	err := gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true},
+		eth, network, transport, gopacket.Payload("hello"))
+	if err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+func tcp(src, dst string, srcPort, dstPort uint16) []byte {
+	return packet(src, dst, &layers.TCP{SrcPort: layers.TCPPort(srcPort), DstPort: layers.TCPPort(dstPort), SYN: true})
+}
+
+func udp(src, dst string, srcPort, dstPort uint16) []byte {
+	return packet(src, dst, &layers.UDP{SrcPort: layers.UDPPort(srcPort), DstPort: layers.UDPPort(dstPort)})
+}
+
+func icmp(src, dst string) []byte {
+	return packet(src, dst, &layers.ICMPv4{TypeCode: layers.CreateICMPv4TypeCode(layers.ICMPv4TypeEchoRequest, 0)})
+}
+
+func arp() []byte {
+	buf := gopacket.NewSerializeBuffer()
+	err := gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true},
+		&layers.Ethernet{
+			SrcMAC:       net.HardwareAddr{0xee, 0xee, 0xee, 0xee, 0xee, 0xee},
+			DstMAC:       layers.EthernetBroadcast,
+			EthernetType: layers.EthernetTypeARP,
+		},
+		&layers.ARP{
+			AddrType:          layers.LinkTypeEthernet,
+			Protocol:          layers.EthernetTypeIPv4,
+			HwAddressSize:     6,
+			ProtAddressSize:   4,
+			Operation:         layers.ARPRequest,
+			SourceHwAddress:   []byte{0xee, 0xee, 0xee, 0xee, 0xee, 0xee},
+			SourceProtAddress: []byte{10, 65, 0, 1},
+			DstHwAddress:      []byte{0, 0, 0, 0, 0, 0},
+			DstProtAddress:    []byte{10, 65, 0, 2},
+		})
+	if err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// matches compiles the filter and runs the program over the packet.
+func matches(filter string, pkt []byte) bool {
+	expr, err := pcapfilter.Parse(filter)
+	Expect(err).NotTo(HaveOccurred())
+	raw, err := pcapfilter.Compile(expr, snapLen)
+	Expect(err).NotTo(HaveOccurred())
+	insns, allDecoded := bpf.Disassemble(raw)
+	Expect(allDecoded).To(BeTrue())
+	vm, err := bpf.NewVM(insns)
+	Expect(err).NotTo(HaveOccurred())
+	n, err := vm.Run(pkt)
+	Expect(err).NotTo(HaveOccurred())
+	return n > 0
+}
+
+var _ = Describe("Packet capture filters", func() {
+	DescribeTable("parsing",
+		func(filter, expected string) {
+			expr, err := pcapfilter.Parse(filter)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expr.String()).To(Equal(expected))
+		},
+		Entry("protocol", "tcp", "tcp"),
+		Entry("host", "host 10.0.0.1", "host 10.0.0.1"),
+		Entry("qualified port", "udp dst port 53", "udp dst port 53"),
+		Entry("bare address", "src 10.0.0.0/8", "src net 10.0.0.0/8"),
+		Entry("precedence", "tcp and port 80 or not udp", "((tcp and port 80) or not udp)"),
+		Entry("symbols", "!(tcp||udp)&&ip6", "(not (tcp or udp) and ip6)"),
+	)
+
+	DescribeTable("rejecting invalid filters",
+		func(filter string) {
+			_, err := pcapfilter.Parse(filter)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("unknown primitive", "vlan 100"),
+		Entry("missing operand", "tcp and"),
+		Entry("unbalanced parentheses", "(tcp or udp"),
+		Entry("bad address", "host 10.0.0.300"),
+		Entry("bad port", "port 70000"),
+		Entry("inverted port range", "portrange 90-80"),
+		Entry("protocol that can't qualify a port", "icmp port 80"),
+		Entry("address of the wrong family", "ip6 host 10.0.0.1"),
+		Entry("dangling direction", "src and tcp"),
+	)
+
+	It("should accept everything when there is no filter", func() {
+		Expect(matches("", arp())).To(BeTrue())
+		Expect(matches("", tcp("10.0.0.1", "10.0.0.2", 1234, 80))).To(BeTrue())
+	})
+
+	DescribeTable("matching packets",
+		func(filter string, pkt []byte, expected bool) {
+			Expect(matches(filter, pkt)).To(Equal(expected))
+		},
+		Entry("ip matches IPv4", "ip", tcp("10.0.0.1", "10.0.0.2", 1234, 80), true),
+		Entry("ip doesn't match IPv6", "ip", tcp("fd00::1", "fd00::2", 1234, 80), false),
+		Entry("ip6 matches IPv6", "ip6", udp("fd00::1", "fd00::2", 1234, 53), true),
+		Entry("arp", "arp", arp(), true),
+		Entry("tcp over IPv4", "tcp", tcp("10.0.0.1", "10.0.0.2", 1234, 80), true),
+		Entry("tcp over IPv6", "tcp", tcp("fd00::1", "fd00::2", 1234, 80), true),
+		Entry("tcp doesn't match udp", "tcp", udp("10.0.0.1", "10.0.0.2", 1234, 80), false),
+		Entry("icmp", "icmp", icmp("10.0.0.1", "10.0.0.2"), true),
+		Entry("icmp doesn't match tcp", "icmp", tcp("10.0.0.1", "10.0.0.2", 1234, 80), false),
+
+		Entry("host matches source", "host 10.0.0.1", tcp("10.0.0.1", "10.0.0.2", 1234, 80), true),
+		Entry("host matches destination", "host 10.0.0.2", tcp("10.0.0.1", "10.0.0.2", 1234, 80), true),
+		Entry("host doesn't match others", "host 10.0.0.3", tcp("10.0.0.1", "10.0.0.2", 1234, 80), false),
+		Entry("src host", "src host 10.0.0.2", tcp("10.0.0.1", "10.0.0.2", 1234, 80), false),
+		Entry("dst host", "dst host 10.0.0.2", tcp("10.0.0.1", "10.0.0.2", 1234, 80), true),
+		Entry("net", "net 10.0.0.0/24", tcp("10.0.0.1", "192.168.0.1", 1234, 80), true),
+		Entry("dst net", "dst net 10.0.0.0/24", tcp("10.0.0.1", "192.168.0.1", 1234, 80), false),
+		Entry("IPv6 host", "host fd00::2", tcp("fd00::1", "fd00::2", 1234, 80), true),
+		Entry("IPv6 net", "src net fd00:0:0:1::/64", tcp("fd00:0:0:1::5", "fd00::2", 1234, 80), true),
+		Entry("IPv6 net mismatch", "net fd00:0:0:1::/64", tcp("fd00:0:0:2::5", "fd00::2", 1234, 80), false),
+		Entry("IPv4 host doesn't match IPv6", "host 10.0.0.1", tcp("fd00::1", "fd00::2", 1234, 80), false),
+
+		Entry("port matches destination", "port 80", tcp("10.0.0.1", "10.0.0.2", 1234, 80), true),
+		Entry("port matches source", "port 1234", udp("10.0.0.1", "10.0.0.2", 1234, 80), true),
+		Entry("port doesn't match others", "port 443", tcp("10.0.0.1", "10.0.0.2", 1234, 80), false),
+		Entry("src port", "src port 80", tcp("10.0.0.1", "10.0.0.2", 1234, 80), false),
+		Entry("IPv6 port", "dst port 80", tcp("fd00::1", "fd00::2", 1234, 80), true),
+		Entry("qualified port", "udp port 80", tcp("10.0.0.1", "10.0.0.2", 1234, 80), false),
+		Entry("port doesn't match icmp", "port 80", icmp("10.0.0.1", "10.0.0.2"), false),
+		Entry("portrange inside", "portrange 8000-8080", tcp("10.0.0.1", "10.0.0.2", 1234, 8080), true),
+		Entry("portrange outside", "portrange 8000-8080", tcp("10.0.0.1", "10.0.0.2", 1234, 8081), false),
+		Entry("portrange below", "dst portrange 8000-8080", tcp("10.0.0.1", "10.0.0.2", 8000, 7999), false),
+
+		Entry("and", "host 10.0.0.2 and tcp port 80", tcp("10.0.0.1", "10.0.0.2", 1234, 80), true),
+		Entry("and mismatch", "host 10.0.0.2 and tcp port 443", tcp("10.0.0.1", "10.0.0.2", 1234, 80), false),
+		Entry("or", "port 443 or icmp", icmp("10.0.0.1", "10.0.0.2"), true),
+		Entry("not", "not arp", arp(), false),
+		Entry("not with and", "tcp and not port 22", tcp("10.0.0.1", "10.0.0.2", 1234, 80), true),
+		Entry("nested", "(udp or tcp) and (dst port 53 or dst port 80)", udp("fd00::1", "fd00::2", 1234, 53), true),
+	)
+})
@@ -36,6 +36,7 @@ func (_ Policy) APIV1ToBackendV1(a unversioned.Resource) (*model.KVPair, error)
 
 	d := model.KVPair{
 		Key: model.PolicyKey{
+			Tier: "default",
 			Name: ap.Metadata.Name,
 		},
 		Value: &model.Policy{
@@ -46,6 +46,7 @@ var policyTable = []TableEntry{
 		},
 		&model.KVPair{
 			Key: model.PolicyKey{
+				Tier: "default",
 				Name: "nameyMcPolicyName",
 			},
 			Value: &model.Policy{
@@ -92,6 +93,7 @@ var policyTable = []TableEntry{
 		},
 		&model.KVPair{
 			Key: model.PolicyKey{
+				Tier: "default",
 				Name: "MaKe.-.MaKe",
 			},
 			Value: &model.Policy{
@@ -135,6 +137,7 @@ var policyTable = []TableEntry{
 		},
 		&model.KVPair{
 			Key: model.PolicyKey{
+				Tier: "default",
 				Name: "RAWR",
 			},
 			Value: &model.Policy{
@@ -177,6 +180,7 @@ var policyTable = []TableEntry{
 		},
 		&model.KVPair{
 			Key: model.PolicyKey{
+				Tier: "default",
 				Name: "RAWR",
 			},
 			Value: &model.Policy{
@@ -219,6 +223,7 @@ var policyTable = []TableEntry{
 		},
 		&model.KVPair{
 			Key: model.PolicyKey{
+				Tier: "default",
 				Name: "meow",
 			},
 			Value: &model.Policy{
@@ -264,6 +269,7 @@ var policyTable = []TableEntry{
 		},
 		&model.KVPair{
 			Key: model.PolicyKey{
+				Tier: "default",
 				Name: "MaKe.-.MaKe",
 			},
 			Value: &model.Policy{
@@ -316,6 +322,7 @@ var policyTable = []TableEntry{
 		},
 		&model.KVPair{
 			Key: model.PolicyKey{
+				Tier: "default",
 				Name: "policy1",
 			},
 			Value: &model.Policy{
@@ -375,6 +382,7 @@ var policyTableBackend = []TableEntry{
 	Entry("converting model with no Types with preDNAT to v3 API only has Ingress for Types",
 		&model.KVPair{
 			Key: model.PolicyKey{
+				Tier: "default",
 				Name: "somekey",
 			},
 			Value: &model.Policy{
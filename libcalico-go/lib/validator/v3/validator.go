@@ -35,6 +35,7 @@ import (
 	libapi "github.com/projectcalico/calico/libcalico-go/lib/apis/v3"
 	"github.com/projectcalico/calico/libcalico-go/lib/errors"
 	cnet "github.com/projectcalico/calico/libcalico-go/lib/net"
+	"github.com/projectcalico/calico/libcalico-go/lib/pcapfilter"
 	"github.com/projectcalico/calico/libcalico-go/lib/selector"
 	"github.com/projectcalico/calico/libcalico-go/lib/set"
 )
@@ -69,6 +70,10 @@ var (
 	// GlobalNetworkPolicy names must be a simple DNS1123 label format (nameLabelFmt).
 	globalNetworkPolicyNameRegex = regexp.MustCompile("^(" + nameLabelFmt + ")$")
 
+	// Tier names must be a simple DNS1123 label format (nameLabelFmt), since they are used as
+	// the prefix of the names of the policies within the tier.
+	tierNameRegex = regexp.MustCompile("^(" + nameLabelFmt + ")$")
+
 	// Hostname  have to be valid ipv4, ipv6 or strings up to 64 characters.
 	prometheusHostRegexp = regexp.MustCompile(`^[a-zA-Z0-9:._+-]{1,64}$`)
 
@@ -79,6 +84,10 @@ var (
 	interfaceRegex        = regexp.MustCompile("^[a-zA-Z0-9_.-]{1,15}$")
 	ifaceFilterRegex      = regexp.MustCompile("^[a-zA-Z0-9:._+-]{1,15}$")
 	actionRegex           = regexp.MustCompile("^(Allow|Deny|Log|Pass)$")
+	tierActionRegex       = regexp.MustCompile("^(Deny|Pass)$")
+	bgpFilterActionRegex  = regexp.MustCompile("^(Accept|Reject)$")
+	bgpFilterOpRegex      = regexp.MustCompile("^(Equal|NotEqual|In|NotIn)$")
+	stagedActionRegex     = regexp.MustCompile("^(Set|Delete)$")
 	protocolRegex         = regexp.MustCompile("^(TCP|UDP|ICMP|ICMPv6|SCTP|UDPLite)$")
 	ipipModeRegex         = regexp.MustCompile("^(Always|CrossSubnet|Never)$")
 	vxlanModeRegex        = regexp.MustCompile("^(Always|CrossSubnet|Never)$")
@@ -151,6 +160,12 @@ func init() {
 
 	// Register field validators.
 	registerFieldValidator("action", validateAction)
+	registerFieldValidator("tierDefaultAction", validateTierDefaultAction)
+	registerFieldValidator("bgpFilterAction", validateBGPFilterAction)
+	registerFieldValidator("bgpFilterMatchOperator", validateBGPFilterMatchOperator)
+	registerFieldValidator("bgpFilterPrefixLengthV4", validateBGPFilterPrefixLengthV4)
+	registerFieldValidator("bgpFilterPrefixLengthV6", validateBGPFilterPrefixLengthV6)
+	registerFieldValidator("stagedAction", validateStagedAction)
 	registerFieldValidator("interface", validateInterface)
 	registerFieldValidator("datastoreType", validateDatastoreType)
 	registerFieldValidator("name", validateName)
@@ -167,6 +182,7 @@ func init() {
 	registerFieldValidator("dropAcceptReturn", validateFelixEtoHAction)
 	registerFieldValidator("acceptReturn", validateAcceptReturn)
 	registerFieldValidator("portName", validatePortName)
+	registerFieldValidator("domain", validateDomain)
 	registerFieldValidator("mustBeNil", validateMustBeNil)
 	registerFieldValidator("mustBeFalse", validateMustBeFalse)
 	registerFieldValidator("ifaceFilter", validateIfaceFilter)
@@ -181,6 +197,7 @@ func init() {
 	registerFieldValidator("routeSource", validateRouteSource)
 	registerFieldValidator("wireguardPublicKey", validateWireguardPublicKey)
 	registerFieldValidator("IP:port", validateIPPort)
+	registerFieldValidator("dnsServer", validateDNSServer)
 
 	// Register network validators (i.e. validating a correctly masked CIDR).  Also
 	// accepts an IP address without a mask (assumes a full mask).
@@ -212,10 +229,16 @@ func init() {
 	registerStructValidator(validate, validateRule, api.Rule{})
 	registerStructValidator(validate, validateEntityRule, api.EntityRule{})
 	registerStructValidator(validate, validateBGPPeerSpec, api.BGPPeerSpec{})
+	registerStructValidator(validate, validateBGPFilterRuleV4, api.BGPFilterRuleV4{})
+	registerStructValidator(validate, validateBGPFilterRuleV6, api.BGPFilterRuleV6{})
 	registerStructValidator(validate, validateNetworkPolicy, api.NetworkPolicy{})
 	registerStructValidator(validate, validateGlobalNetworkPolicy, api.GlobalNetworkPolicy{})
+	registerStructValidator(validate, validateStagedNetworkPolicy, api.StagedNetworkPolicy{})
+	registerStructValidator(validate, validateStagedGlobalNetworkPolicy, api.StagedGlobalNetworkPolicy{})
+	registerStructValidator(validate, validateTier, api.Tier{})
 	registerStructValidator(validate, validateGlobalNetworkSet, api.GlobalNetworkSet{})
 	registerStructValidator(validate, validateNetworkSet, api.NetworkSet{})
+	registerStructValidator(validate, validatePacketCaptureSpec, api.PacketCaptureSpec{})
 	registerStructValidator(validate, validateRuleMetadata, api.RuleMetadata{})
 	registerStructValidator(validate, validateRouteTableIDRange, api.RouteTableIDRange{})
 	registerStructValidator(validate, validateRouteTableRange, api.RouteTableRange{})
@@ -258,6 +281,42 @@ func validateAction(fl validator.FieldLevel) bool {
 	return actionRegex.MatchString(s)
 }
 
+func validateTierDefaultAction(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	log.Debugf("Validate tier default action: %s", s)
+	return tierActionRegex.MatchString(s)
+}
+
+func validateBGPFilterAction(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	log.Debugf("Validate BGP filter action: %s", s)
+	return bgpFilterActionRegex.MatchString(s)
+}
+
+func validateBGPFilterMatchOperator(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	log.Debugf("Validate BGP filter match operator: %s", s)
+	return bgpFilterOpRegex.MatchString(s)
+}
+
+func validateBGPFilterPrefixLengthV4(fl validator.FieldLevel) bool {
+	l := fl.Field().Int()
+	log.Debugf("Validate BGP filter IPv4 prefix length: %d", l)
+	return l >= 0 && l <= 32
+}
+
+func validateBGPFilterPrefixLengthV6(fl validator.FieldLevel) bool {
+	l := fl.Field().Int()
+	log.Debugf("Validate BGP filter IPv6 prefix length: %d", l)
+	return l >= 0 && l <= 128
+}
+
+func validateStagedAction(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	log.Debugf("Validate staged action: %s", s)
+	return stagedActionRegex.MatchString(s)
+}
+
 func validateInterface(fl validator.FieldLevel) bool {
 	s := fl.Field().String()
 	log.Debugf("Validate interface: %s", s)
@@ -297,6 +356,26 @@ func validateWireguardPublicKey(fl validator.FieldLevel) bool {
 	return err == nil
 }
 
+// validateDNSServer validates the field is a correctly masked CIDR or IP, or a Kubernetes service
+// as "k8s-service:[<namespace>/]<name>".
+func validateDNSServer(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	log.Debugf("Validate DNS server: %s", s)
+	if !strings.HasPrefix(s, "k8s-service:") {
+		return validateIPNetwork(fl)
+	}
+	parts := strings.Split(strings.TrimPrefix(s, "k8s-service:"), "/")
+	if len(parts) > 2 {
+		return false
+	}
+	for _, part := range parts {
+		if !nameRegex.MatchString(part) {
+			return false
+		}
+	}
+	return true
+}
+
 func validateName(fl validator.FieldLevel) bool {
 	s := fl.Field().String()
 	log.Debugf("Validate name: %s", s)
@@ -321,6 +400,12 @@ func validatePortName(fl validator.FieldLevel) bool {
 	return len(s) != 0 && len(k8svalidation.IsValidPortName(s)) == 0
 }
 
+func validateDomain(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	log.Debugf("Validate domain: %s", s)
+	return len(k8svalidation.IsDNS1123Subdomain(strings.ToLower(strings.TrimSuffix(s, ".")))) == 0
+}
+
 func validateMustBeNil(fl validator.FieldLevel) bool {
 	log.WithField("field", fl.Field().String()).Debugf("Validate field must be nil")
 	return fl.Field().IsNil()
@@ -376,7 +461,8 @@ func validateMAC(fl validator.FieldLevel) bool {
 func validateIptablesBackend(fl validator.FieldLevel) bool {
 	s := fl.Field().String()
 	log.Debugf("Validate Iptables Backend: %s", s)
-	return s == "" || s == api.IptablesBackendNFTables || s == api.IptablesBackendLegacy
+	return s == "" || s == api.IptablesBackendNFTables || s == api.IptablesBackendLegacy ||
+		s == api.IptablesBackendNFTablesNative
 }
 
 func validateLogLevel(fl validator.FieldLevel) bool {
@@ -1084,6 +1170,16 @@ func validateRule(structLevel validator.StructLevel) {
 			"", reason("only valid for Allow rules"), "")
 	}
 
+	// Domains are only allowed in the destination of Allow rules.
+	if len(rule.Source.Domains) != 0 {
+		structLevel.ReportError(reflect.ValueOf(rule.Source.Domains),
+			"Source.Domains", "", reason("not allowed in rule source"), "")
+	}
+	if len(rule.Destination.Domains) != 0 && rule.Action != api.Allow {
+		structLevel.ReportError(reflect.ValueOf(rule.Destination.Domains),
+			"Destination.Domains", "", reason("only valid for Allow rules"), "")
+	}
+
 	// Check that destination service rules do not use ports.
 	// Destination service rules use ports specified on the endpoints.
 	if rule.Destination.Services != nil && len(rule.Destination.Ports) != 0 {
@@ -1142,6 +1238,20 @@ func validateEntityRule(structLevel validator.StructLevel) {
 				"Services field", "", reason("cannot specify Nets/NotNets and Services on the same rule"), "")
 		}
 	}
+	if len(rule.Domains) != 0 {
+		if rule.Selector != "" || rule.NotSelector != "" || rule.NamespaceSelector != "" {
+			structLevel.ReportError(reflect.ValueOf(rule.Domains),
+				"Domains field", "", reason("cannot specify selectors and Domains on the same rule"), "")
+		}
+		if len(rule.Nets) != 0 || len(rule.NotNets) != 0 {
+			structLevel.ReportError(reflect.ValueOf(rule.Domains),
+				"Domains field", "", reason("cannot specify Nets/NotNets and Domains on the same rule"), "")
+		}
+		if rule.ServiceAccounts != nil || rule.Services != nil {
+			structLevel.ReportError(reflect.ValueOf(rule.Domains),
+				"Domains field", "", reason("cannot specify ServiceAccounts/Services and Domains on the same rule"), "")
+		}
+	}
 }
 
 func validateNodeSpec(structLevel validator.StructLevel) {
@@ -1172,6 +1282,66 @@ func validateBGPPeerSpec(structLevel validator.StructLevel) {
 	}
 }
 
+func validateBGPFilterRuleV4(structLevel validator.StructLevel) {
+	r := structLevel.Current().Interface().(api.BGPFilterRuleV4)
+	var min, max *int32
+	if r.PrefixLength != nil {
+		min, max = r.PrefixLength.Min, r.PrefixLength.Max
+	}
+	validateBGPFilterRule(structLevel, r.CIDR, r.MatchOperator, r.PrefixLength != nil, min, max, 32)
+}
+
+func validateBGPFilterRuleV6(structLevel validator.StructLevel) {
+	r := structLevel.Current().Interface().(api.BGPFilterRuleV6)
+	var min, max *int32
+	if r.PrefixLength != nil {
+		min, max = r.PrefixLength.Min, r.PrefixLength.Max
+	}
+	validateBGPFilterRule(structLevel, r.CIDR, r.MatchOperator, r.PrefixLength != nil, min, max, 128)
+}
+
+// validateBGPFilterRule validates the fields of a BGP filter rule that are common to both IP
+// versions.  maxLen is the maximum prefix length for the IP version.
+func validateBGPFilterRule(
+	structLevel validator.StructLevel,
+	cidr string,
+	op api.BGPFilterMatchOperator,
+	hasPrefixLength bool,
+	min, max *int32,
+	maxLen int32,
+) {
+	if (cidr == "") != (op == "") {
+		structLevel.ReportError(reflect.ValueOf(cidr), "CIDR", "",
+			reason("CIDR and MatchOperator must be specified together"), "")
+		return
+	}
+	if !hasPrefixLength {
+		return
+	}
+	if op != api.MatchOperatorIn && op != api.MatchOperatorNotIn {
+		structLevel.ReportError(reflect.ValueOf(op), "PrefixLength", "",
+			reason("PrefixLength requires a CIDR with the In or NotIn MatchOperator"), "")
+		return
+	}
+	_, ipn, err := cnet.ParseCIDROrIP(cidr)
+	if err != nil {
+		// Reported by the field validator.
+		return
+	}
+	cidrLen, _ := ipn.Mask.Size()
+	lo, hi := int32(cidrLen), maxLen
+	if min != nil {
+		lo = *min
+	}
+	if max != nil {
+		hi = *max
+	}
+	if lo < int32(cidrLen) || lo > hi {
+		structLevel.ReportError(reflect.ValueOf(lo), "PrefixLength.Min", "",
+			reason("PrefixLength.Min must be between the CIDR's prefix length and PrefixLength.Max"), "")
+	}
+}
+
 func validateEndpointPort(structLevel validator.StructLevel) {
 	port := structLevel.Current().Interface().(api.EndpointPort)
 
@@ -1256,7 +1426,16 @@ func validateObjectMeta(structLevel validator.StructLevel) {
 
 func validateNetworkPolicy(structLevel validator.StructLevel) {
 	np := structLevel.Current().Interface().(api.NetworkPolicy)
-	spec := np.Spec
+	validateNetworkPolicyMetaAndSpec(structLevel, np.ObjectMeta, np.Spec)
+}
+
+func validateStagedNetworkPolicy(structLevel validator.StructLevel) {
+	staged := structLevel.Current().Interface().(api.StagedNetworkPolicy)
+	_, np := api.ConvertStagedPolicyToEnforced(&staged)
+	validateNetworkPolicyMetaAndSpec(structLevel, np.ObjectMeta, np.Spec)
+}
+
+func validateNetworkPolicyMetaAndSpec(structLevel validator.StructLevel, np metav1.ObjectMeta, spec api.NetworkPolicySpec) {
 
 	// Check (and disallow) any repeats in Types field.
 	mp := map[api.PolicyType]bool{}
@@ -1281,15 +1460,18 @@ func validateNetworkPolicy(structLevel validator.StructLevel) {
 	}
 
 	// Uses the k8s DN1123 label format for policy names (plus knp.default prefixed k8s policies).
-	matched := networkPolicyNameRegex.MatchString(np.Name)
-	if !matched {
-		structLevel.ReportError(
-			reflect.ValueOf(np.Name),
-			"Metadata.Name",
-			"",
-			reason("name must consist of lower case alphanumeric characters or '-' (regex: "+nameLabelFmt+")"),
-			"",
-		)
+	// Policies in a non-default tier must be prefixed with the tier name.
+	if !validatePolicyNameInTier(structLevel, np.Name, spec.Tier) {
+		matched := networkPolicyNameRegex.MatchString(np.Name)
+		if !matched {
+			structLevel.ReportError(
+				reflect.ValueOf(np.Name),
+				"Metadata.Name",
+				"",
+				reason("name must consist of lower case alphanumeric characters or '-' (regex: "+nameLabelFmt+")"),
+				"",
+			)
+		}
 	}
 
 	validateObjectMetaAnnotations(structLevel, np.Annotations)
@@ -1319,6 +1501,15 @@ func validateNetworkPolicy(structLevel validator.StructLevel) {
 				reason("not allowed in ingress rule destination"), "",
 			)
 		}
+
+		// Domains are resolved from DNS responses sent to local workloads, so they
+		// only make sense as an egress destination.
+		if len(r.Destination.Domains) != 0 {
+			structLevel.ReportError(
+				reflect.ValueOf(r.Destination.Domains), "Domains", "",
+				reason("not allowed in ingress rules"), "",
+			)
+		}
 	}
 
 	// Check that the selector doesn't have the global() selector which is only
@@ -1342,6 +1533,53 @@ func validateNetworkPolicy(structLevel validator.StructLevel) {
 	}
 }
 
+// validatePolicyNameInTier validates the name of a policy in a non-default tier, which must
+// be of the form "<tier>.<name>".  Returns false if the policy is in the default tier, in
+// which case the caller is responsible for validating the name.
+func validatePolicyNameInTier(structLevel validator.StructLevel, name, tier string) bool {
+	if tier == "" || tier == api.DefaultTierName {
+		return false
+	}
+	if !strings.HasPrefix(name, tier+".") || !globalNetworkPolicyNameRegex.MatchString(strings.TrimPrefix(name, tier+".")) {
+		structLevel.ReportError(
+			reflect.ValueOf(name),
+			"Metadata.Name",
+			"",
+			reason("name of a policy in tier '"+tier+"' must be of the form '"+tier+".<name>' where <name> "+
+				"consists of lower case alphanumeric characters or '-' (regex: "+nameLabelFmt+")"),
+			"",
+		)
+	}
+	return true
+}
+
+func validateTier(structLevel validator.StructLevel) {
+	tier := structLevel.Current().Interface().(api.Tier)
+
+	// Tier names are used as a prefix in the names of the policies in the tier, so they must
+	// not contain a '.'.
+	if !tierNameRegex.MatchString(tier.Name) {
+		structLevel.ReportError(
+			reflect.ValueOf(tier.Name),
+			"Metadata.Name",
+			"",
+			reason("name must consist of lower case alphanumeric characters or '-' (regex: "+nameLabelFmt+")"),
+			"",
+		)
+	}
+
+	// The default tier is always applied last.
+	if tier.Name == api.DefaultTierName && tier.Spec.Order != nil {
+		structLevel.ReportError(
+			reflect.ValueOf(tier.Spec.Order),
+			"TierSpec.Order",
+			"",
+			reason("order may not be specified on the default tier"),
+			"",
+		)
+	}
+}
+
 func validateNetworkSet(structLevel validator.StructLevel) {
 	ns := structLevel.Current().Interface().(api.NetworkSet)
 	for k := range ns.GetLabels() {
@@ -1359,6 +1597,19 @@ func validateNetworkSet(structLevel validator.StructLevel) {
 	}
 }
 
+func validatePacketCaptureSpec(structLevel validator.StructLevel) {
+	spec := structLevel.Current().Interface().(api.PacketCaptureSpec)
+	if _, err := pcapfilter.Parse(spec.Filter); err != nil {
+		structLevel.ReportError(
+			reflect.ValueOf(spec.Filter),
+			"PacketCaptureSpec.Filter",
+			"",
+			reason(err.Error()),
+			"",
+		)
+	}
+}
+
 func validateGlobalNetworkSet(structLevel validator.StructLevel) {
 	gns := structLevel.Current().Interface().(api.GlobalNetworkSet)
 	for k := range gns.GetLabels() {
@@ -1378,7 +1629,16 @@ func validateGlobalNetworkSet(structLevel validator.StructLevel) {
 
 func validateGlobalNetworkPolicy(structLevel validator.StructLevel) {
 	gnp := structLevel.Current().Interface().(api.GlobalNetworkPolicy)
-	spec := gnp.Spec
+	validateGlobalNetworkPolicyMetaAndSpec(structLevel, gnp.ObjectMeta, gnp.Spec)
+}
+
+func validateStagedGlobalNetworkPolicy(structLevel validator.StructLevel) {
+	staged := structLevel.Current().Interface().(api.StagedGlobalNetworkPolicy)
+	_, gnp := api.ConvertStagedGlobalPolicyToEnforced(&staged)
+	validateGlobalNetworkPolicyMetaAndSpec(structLevel, gnp.ObjectMeta, gnp.Spec)
+}
+
+func validateGlobalNetworkPolicyMetaAndSpec(structLevel validator.StructLevel, gnp metav1.ObjectMeta, spec api.GlobalNetworkPolicySpec) {
 
 	// Check the name is within the max length.
 	if len(gnp.Name) > k8svalidation.DNS1123SubdomainMaxLength {
@@ -1391,16 +1651,19 @@ func validateGlobalNetworkPolicy(structLevel validator.StructLevel) {
 		)
 	}
 
-	// Uses the k8s DN1123 label format for policy names.
-	matched := globalNetworkPolicyNameRegex.MatchString(gnp.Name)
-	if !matched {
-		structLevel.ReportError(
-			reflect.ValueOf(gnp.Name),
-			"Metadata.Name",
-			"",
-			reason("name must consist of lower case alphanumeric characters or '-' (regex: "+nameLabelFmt+")"),
-			"",
-		)
+	// Uses the k8s DN1123 label format for policy names.  Policies in a non-default tier must be
+	// prefixed with the tier name.
+	if !validatePolicyNameInTier(structLevel, gnp.Name, spec.Tier) {
+		matched := globalNetworkPolicyNameRegex.MatchString(gnp.Name)
+		if !matched {
+			structLevel.ReportError(
+				reflect.ValueOf(gnp.Name),
+				"Metadata.Name",
+				"",
+				reason("name must consist of lower case alphanumeric characters or '-' (regex: "+nameLabelFmt+")"),
+				"",
+			)
+		}
 	}
 
 	validateObjectMetaAnnotations(structLevel, gnp.Annotations)
@@ -1465,6 +1728,15 @@ func validateGlobalNetworkPolicy(structLevel validator.StructLevel) {
 				reason("not allowed in ingress rule destination"), "",
 			)
 		}
+
+		// Domains are resolved from DNS responses sent to local workloads, so they
+		// only make sense as an egress destination.
+		if len(r.Destination.Domains) != 0 {
+			structLevel.ReportError(
+				reflect.ValueOf(r.Destination.Domains), "Domains", "",
+				reason("not allowed in ingress rules"), "",
+			)
+		}
 	}
 
 	// If a ServiceSelector is specified by name, we also need a namespace. At a global scope,
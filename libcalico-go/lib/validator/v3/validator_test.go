@@ -84,6 +84,11 @@ func init() {
 	awsCheckbadVal = api.AWSSrcDstCheckOption("badVal")
 	awsCheckenable = api.AWSSrcDstCheckOption("enable")
 
+	tierOrder := 100.0
+	tierActionPass := api.Action(api.Pass)
+	tierActionDeny := api.Action(api.Deny)
+	tierActionAllow := api.Allow
+
 	// longLabelsValue is 63 and 64 chars long
 	maxAnnotationsLength := 256 * (1 << 10)
 	longValue := make([]byte, maxAnnotationsLength)
@@ -396,6 +401,29 @@ func init() {
 			},
 			false,
 		),
+		Entry("should accept PacketCaptureSpec with no selector or filter",
+			api.PacketCaptureSpec{},
+			true,
+		),
+		Entry("should accept PacketCaptureSpec with a selector and filter",
+			api.PacketCaptureSpec{
+				Selector: "app == 'web'",
+				Filter:   "tcp port 80 and not host 10.0.0.1",
+			},
+			true,
+		),
+		Entry("should reject PacketCaptureSpec with a bad selector",
+			api.PacketCaptureSpec{
+				Selector: "app ==",
+			},
+			false,
+		),
+		Entry("should reject PacketCaptureSpec with an unsupported filter",
+			api.PacketCaptureSpec{
+				Filter: "vlan 100",
+			},
+			false,
+		),
 		Entry("should accept NetworkSet with labels",
 			api.NetworkSet{
 				ObjectMeta: v1.ObjectMeta{
@@ -676,6 +704,12 @@ func init() {
 		Entry("should reject an invalid list of ExternalNodesCIDRList", api.FelixConfigurationSpec{ExternalNodesCIDRList: &[]string{"foobar", "1.1.1.1"}}, false),
 		Entry("should reject IPv6 list of ExternalNodesCIDRList", api.FelixConfigurationSpec{ExternalNodesCIDRList: &[]string{"abcd::1", "abef::2/128"}}, false),
 
+		Entry("should accept DNSTrustedServers IPs and services", api.FelixConfigurationSpec{DNSTrustedServers: &[]string{"10.96.0.10", "fd00::/64", "k8s-service:kube-dns", "k8s-service:dns/coredns"}},
+			true),
+		Entry("should accept an empty DNSTrustedServers", api.FelixConfigurationSpec{DNSTrustedServers: &[]string{}}, true),
+		Entry("should reject a hostname in DNSTrustedServers", api.FelixConfigurationSpec{DNSTrustedServers: &[]string{"dns.example.com"}}, false),
+		Entry("should reject an invalid DNSTrustedServers service", api.FelixConfigurationSpec{DNSTrustedServers: &[]string{"k8s-service:a/b/c"}}, false),
+
 		Entry("should accept aan empty OpenStackRegion", api.FelixConfigurationSpec{OpenstackRegion: ""}, true),
 		Entry("should accept a valid OpenStackRegion", api.FelixConfigurationSpec{OpenstackRegion: "foo"}, true),
 		Entry("should reject an invalid OpenStackRegion", api.FelixConfigurationSpec{OpenstackRegion: "FOO"}, false),
@@ -988,6 +1022,25 @@ func init() {
 					},
 				},
 			}, false),
+		Entry("should accept IP pool with IP quotas",
+			api.IPPool{
+				ObjectMeta: v1.ObjectMeta{Name: "pool.name"},
+				Spec: api.IPPoolSpec{
+					CIDR:               netv4_4,
+					MaxIPsPerNamespace: 100,
+					MaxIPsPerNode:      50,
+				},
+			}, true),
+		Entry("should reject IP pool with negative per-namespace IP quota",
+			api.IPPool{
+				ObjectMeta: v1.ObjectMeta{Name: "pool.name"},
+				Spec:       api.IPPoolSpec{CIDR: netv4_4, MaxIPsPerNamespace: -1},
+			}, false),
+		Entry("should reject IP pool with negative per-node IP quota",
+			api.IPPool{
+				ObjectMeta: v1.ObjectMeta{Name: "pool.name"},
+				Spec:       api.IPPoolSpec{CIDR: netv4_4, MaxIPsPerNode: -1},
+			}, false),
 
 		// (API) IPReservation
 		Entry("should accept IPReservation with an IP",
@@ -1553,6 +1606,67 @@ func init() {
 		Entry("should reject BGPPeerSpec with invalid IP in PeerIP (IPv6)", api.BGPPeerSpec{
 			PeerIP: "[9000::FFFFF]:170",
 		}, false),
+		Entry("should accept BGPPeerSpec with filters", api.BGPPeerSpec{
+			PeerIP:  ipv4_1,
+			Filters: []string{"filter-1", "filter-2"},
+		}, true),
+		Entry("should reject BGPPeerSpec with an invalid filter name", api.BGPPeerSpec{
+			PeerIP:  ipv4_1,
+			Filters: []string{"Filter_1"},
+		}, false),
+
+		// (API) BGPFilterSpec
+		Entry("should accept BGPFilterSpec with IPv4 and IPv6 rules", api.BGPFilterSpec{
+			ImportV4: []api.BGPFilterRuleV4{{CIDR: "10.0.0.0/8", MatchOperator: api.MatchOperatorIn, Action: api.FilterActionReject}},
+			ExportV4: []api.BGPFilterRuleV4{{CIDR: "10.10.0.0/16", MatchOperator: api.MatchOperatorEqual, Action: api.FilterActionAccept}},
+			ImportV6: []api.BGPFilterRuleV6{{Action: api.FilterActionAccept}},
+			ExportV6: []api.BGPFilterRuleV6{{CIDR: "fd00::/64", MatchOperator: api.MatchOperatorNotIn, Action: api.FilterActionReject}},
+		}, true),
+		Entry("should reject BGPFilterRuleV4 with an IPv6 CIDR", api.BGPFilterRuleV4{
+			CIDR: "fd00::/64", MatchOperator: api.MatchOperatorIn, Action: api.FilterActionAccept,
+		}, false),
+		Entry("should reject BGPFilterRuleV6 with an IPv4 CIDR", api.BGPFilterRuleV6{
+			CIDR: "10.0.0.0/8", MatchOperator: api.MatchOperatorIn, Action: api.FilterActionAccept,
+		}, false),
+		Entry("should reject BGPFilterRuleV4 with a bad action", api.BGPFilterRuleV4{
+			CIDR: "10.0.0.0/8", MatchOperator: api.MatchOperatorIn, Action: "Allow",
+		}, false),
+		Entry("should reject BGPFilterRuleV4 without an action", api.BGPFilterRuleV4{
+			CIDR: "10.0.0.0/8", MatchOperator: api.MatchOperatorIn,
+		}, false),
+		Entry("should reject BGPFilterRuleV4 with a bad match operator", api.BGPFilterRuleV4{
+			CIDR: "10.0.0.0/8", MatchOperator: "Contains", Action: api.FilterActionAccept,
+		}, false),
+		Entry("should reject BGPFilterRuleV4 with a CIDR but no match operator", api.BGPFilterRuleV4{
+			CIDR: "10.0.0.0/8", Action: api.FilterActionAccept,
+		}, false),
+		Entry("should reject BGPFilterRuleV4 with a match operator but no CIDR", api.BGPFilterRuleV4{
+			MatchOperator: api.MatchOperatorIn, Action: api.FilterActionAccept,
+		}, false),
+		Entry("should accept BGPFilterRuleV4 with a prefix length range", api.BGPFilterRuleV4{
+			CIDR: "10.0.0.0/8", MatchOperator: api.MatchOperatorIn, Action: api.FilterActionAccept,
+			PrefixLength: &api.BGPFilterPrefixLengthV4{Min: int32Ptr(16), Max: int32Ptr(24)},
+		}, true),
+		Entry("should accept BGPFilterRuleV6 with only a maximum prefix length", api.BGPFilterRuleV6{
+			CIDR: "fd00::/48", MatchOperator: api.MatchOperatorNotIn, Action: api.FilterActionAccept,
+			PrefixLength: &api.BGPFilterPrefixLengthV6{Max: int32Ptr(64)},
+		}, true),
+		Entry("should reject BGPFilterRuleV4 with a prefix length range and the Equal operator", api.BGPFilterRuleV4{
+			CIDR: "10.0.0.0/8", MatchOperator: api.MatchOperatorEqual, Action: api.FilterActionAccept,
+			PrefixLength: &api.BGPFilterPrefixLengthV4{Min: int32Ptr(16)},
+		}, false),
+		Entry("should reject BGPFilterRuleV4 with a minimum prefix length shorter than the CIDR", api.BGPFilterRuleV4{
+			CIDR: "10.0.0.0/16", MatchOperator: api.MatchOperatorIn, Action: api.FilterActionAccept,
+			PrefixLength: &api.BGPFilterPrefixLengthV4{Min: int32Ptr(8)},
+		}, false),
+		Entry("should reject BGPFilterRuleV4 with a minimum prefix length above the maximum", api.BGPFilterRuleV4{
+			CIDR: "10.0.0.0/8", MatchOperator: api.MatchOperatorIn, Action: api.FilterActionAccept,
+			PrefixLength: &api.BGPFilterPrefixLengthV4{Min: int32Ptr(24), Max: int32Ptr(16)},
+		}, false),
+		Entry("should reject BGPFilterRuleV4 with a prefix length over 32", api.BGPFilterRuleV4{
+			CIDR: "10.0.0.0/8", MatchOperator: api.MatchOperatorIn, Action: api.FilterActionAccept,
+			PrefixLength: &api.BGPFilterPrefixLengthV4{Max: int32Ptr(33)},
+		}, false),
 		Entry("should reject invalid BGPPeerSpec when port is set with empty IP in PeerIP (IPv4)", api.BGPPeerSpec{
 			PeerIP: ":8552",
 		}, false),
@@ -1624,6 +1738,56 @@ func init() {
 		Entry("allow valid name", &api.GlobalNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "thing"}}, true),
 		Entry("disallow k8s policy name", &api.GlobalNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "knp.default.thing"}}, false),
 		Entry("disallow name with dot", &api.GlobalNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "t.h.i.ng"}}, false),
+		Entry("allow tier-prefixed name in non-default tier",
+			&api.GlobalNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "tier1.thing"}, Spec: api.GlobalNetworkPolicySpec{Tier: "tier1"}}, true),
+		Entry("disallow name without tier prefix in non-default tier",
+			&api.GlobalNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "thing"}, Spec: api.GlobalNetworkPolicySpec{Tier: "tier1"}}, false),
+		Entry("disallow name with another tier's prefix",
+			&api.GlobalNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "tier2.thing"}, Spec: api.GlobalNetworkPolicySpec{Tier: "tier1"}}, false),
+		Entry("allow unprefixed name in explicit default tier",
+			&api.GlobalNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "thing"}, Spec: api.GlobalNetworkPolicySpec{Tier: "default"}}, true),
+		Entry("allow tier-prefixed NetworkPolicy name in non-default tier",
+			&api.NetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "tier1.thing", Namespace: "ns"}, Spec: api.NetworkPolicySpec{Tier: "tier1"}}, true),
+		Entry("disallow NetworkPolicy name without tier prefix in non-default tier",
+			&api.NetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "thing", Namespace: "ns"}, Spec: api.NetworkPolicySpec{Tier: "tier1"}}, false),
+
+		// StagedNetworkPolicy and StagedGlobalNetworkPolicy validation.
+		Entry("should accept StagedNetworkPolicy with no staged action",
+			&api.StagedNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "thing", Namespace: "ns"}}, true),
+		Entry("should accept StagedNetworkPolicy with Set staged action",
+			&api.StagedNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "thing", Namespace: "ns"}, Spec: api.StagedNetworkPolicySpec{StagedAction: api.StagedActionSet}}, true),
+		Entry("should accept StagedNetworkPolicy with Delete staged action",
+			&api.StagedNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "thing", Namespace: "ns"}, Spec: api.StagedNetworkPolicySpec{StagedAction: api.StagedActionDelete}}, true),
+		Entry("should reject StagedNetworkPolicy with unknown staged action",
+			&api.StagedNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "thing", Namespace: "ns"}, Spec: api.StagedNetworkPolicySpec{StagedAction: "Apply"}}, false),
+		Entry("should reject StagedNetworkPolicy in a tier without the tier prefix",
+			&api.StagedNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "thing", Namespace: "ns"}, Spec: api.StagedNetworkPolicySpec{Tier: "tier1"}}, false),
+		Entry("should reject StagedNetworkPolicy with a bad selector",
+			&api.StagedNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "thing", Namespace: "ns"}, Spec: api.StagedNetworkPolicySpec{Selector: "has(b"}}, false),
+		Entry("should accept StagedGlobalNetworkPolicy with Delete staged action",
+			&api.StagedGlobalNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "tier1.thing"}, Spec: api.StagedGlobalNetworkPolicySpec{Tier: "tier1", StagedAction: api.StagedActionDelete}}, true),
+		Entry("should reject StagedGlobalNetworkPolicy with unknown staged action",
+			&api.StagedGlobalNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "thing"}, Spec: api.StagedGlobalNetworkPolicySpec{StagedAction: "set"}}, false),
+		Entry("should reject StagedGlobalNetworkPolicy with preDNAT and egress rules",
+			&api.StagedGlobalNetworkPolicy{ObjectMeta: v1.ObjectMeta{Name: "thing"}, Spec: api.StagedGlobalNetworkPolicySpec{
+				PreDNAT:        true,
+				ApplyOnForward: true,
+				Egress:         []api.Rule{{Action: "Allow"}},
+			}}, false),
+
+		// Tier validation.
+		Entry("should accept Tier with no spec", &api.Tier{ObjectMeta: v1.ObjectMeta{Name: "tier1"}}, true),
+		Entry("should accept Tier with order and Pass default action",
+			&api.Tier{ObjectMeta: v1.ObjectMeta{Name: "tier1"}, Spec: api.TierSpec{Order: &tierOrder, DefaultAction: &tierActionPass}}, true),
+		Entry("should accept Tier with Deny default action",
+			&api.Tier{ObjectMeta: v1.ObjectMeta{Name: "tier1"}, Spec: api.TierSpec{DefaultAction: &tierActionDeny}}, true),
+		Entry("should reject Tier with Allow default action",
+			&api.Tier{ObjectMeta: v1.ObjectMeta{Name: "tier1"}, Spec: api.TierSpec{DefaultAction: &tierActionAllow}}, false),
+		Entry("should reject Tier name with dot", &api.Tier{ObjectMeta: v1.ObjectMeta{Name: "tier.1"}}, false),
+		Entry("should reject Tier name with upper case characters", &api.Tier{ObjectMeta: v1.ObjectMeta{Name: "Tier1"}}, false),
+		Entry("should accept default Tier without order", &api.Tier{ObjectMeta: v1.ObjectMeta{Name: "default"}}, true),
+		Entry("should reject default Tier with order",
+			&api.Tier{ObjectMeta: v1.ObjectMeta{Name: "default"}, Spec: api.TierSpec{Order: &tierOrder}}, false),
 		Entry("should reject GlobalNetworkPolicy with both PreDNAT and DoNotTrack",
 			&api.GlobalNetworkPolicy{
 				ObjectMeta: v1.ObjectMeta{Name: "thing"},
@@ -2111,6 +2275,113 @@ func init() {
 				},
 			}, true,
 		),
+		Entry("allow Domains in an egress Allow rule destination",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Egress: []api.Rule{
+						{
+							Action: "Allow",
+							Destination: api.EntityRule{
+								Domains: []string{"example.com", "www.projectcalico.org."},
+							},
+						},
+					},
+				},
+			}, true,
+		),
+		Entry("disallow Domains in an egress Deny rule destination",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Egress: []api.Rule{
+						{
+							Action: "Deny",
+							Destination: api.EntityRule{
+								Domains: []string{"example.com", "www.projectcalico.org."},
+							},
+						},
+					},
+				},
+			}, false,
+		),
+		Entry("disallow Domains in an ingress rule destination",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Ingress: []api.Rule{
+						{
+							Action: "Allow",
+							Destination: api.EntityRule{
+								Domains: []string{"example.com", "www.projectcalico.org."},
+							},
+						},
+					},
+				},
+			}, false,
+		),
+		Entry("disallow Domains in an egress rule source",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Egress: []api.Rule{
+						{
+							Action: "Allow",
+							Source: api.EntityRule{
+								Domains: []string{"example.com"},
+							},
+						},
+					},
+				},
+			}, false,
+		),
+		Entry("disallow Domains AND Nets in an egress rule destination",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Egress: []api.Rule{
+						{
+							Action: "Allow",
+							Destination: api.EntityRule{
+								Domains: []string{"example.com", "www.projectcalico.org."},
+								Nets:    []string{"10.0.0.0/8"},
+							},
+						},
+					},
+				},
+			}, false,
+		),
+		Entry("disallow Domains AND a Selector in an egress rule destination",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Egress: []api.Rule{
+						{
+							Action: "Allow",
+							Destination: api.EntityRule{
+								Domains:  []string{"example.com", "www.projectcalico.org."},
+								Selector: "has(foo)",
+							},
+						},
+					},
+				},
+			}, false,
+		),
+		Entry("disallow an invalid domain name",
+			&api.NetworkPolicy{
+				ObjectMeta: v1.ObjectMeta{Name: "thing"},
+				Spec: api.NetworkPolicySpec{
+					Egress: []api.Rule{
+						{
+							Action: "Allow",
+							Destination: api.EntityRule{
+								Domains: []string{"not_a_domain!"},
+							},
+						},
+					},
+				},
+			}, false,
+		),
 		Entry("allow a Service match in an egress rule destination",
 			&api.NetworkPolicy{
 				ObjectMeta: v1.ObjectMeta{Name: "thing"},
@@ -2681,6 +2952,10 @@ func init() {
 	)
 }
 
+func int32Ptr(i int32) *int32 {
+	return &i
+}
+
 func protocolFromString(s string) *numorstring.Protocol {
 	p := numorstring.ProtocolFromString(s)
 	return &p
@@ -174,12 +174,12 @@ func (r *reconciler) OnUpdates(updates []bapi.Update) {
 				log.Debugf("Updated pool resource: %s", u.Key)
 				data = v
 			case *libapi.Node:
-				// For nodes, we only care about our own node, *and* we only care about the wireguard public key.
+				// For nodes, we only care about our own node, *and* we only care about the wireguard public keys.
 				if v.Name != r.nodename {
 					continue
 				}
 				log.Debugf("Updated node resource: %s", u.Key)
-				data = v.Status.WireguardPublicKey
+				data = [2]string{v.Status.WireguardPublicKey, v.Status.WireguardPublicKeyV6}
 			default:
 				// We got an update for an unexpected resource type. Rather than ignore, just treat as updated so that
 				// we reconcile the addresses.
@@ -229,6 +229,13 @@ func reconcileTunnelAddrs(nodename string, cfg *apiconfig.CalicoAPIConfig, c cli
 		removeHostTunnelAddr(ctx, c, nodename, ipam.AttributeTypeWireguard)
 	}
 
+	// Likewise, allocate an IP for the IPv6 wireguard device if IPv6 wireguard is running on this node.
+	if cidrs := determineEnabledPoolCIDRs(*node, *ipPoolList, ipam.AttributeTypeWireguardV6); len(cidrs) > 0 {
+		ensureHostTunnelAddress(ctx, c, nodename, cidrs, ipam.AttributeTypeWireguardV6)
+	} else {
+		removeHostTunnelAddr(ctx, c, nodename, ipam.AttributeTypeWireguardV6)
+	}
+
 	// Query the IPIP enabled pools and either configure the tunnel
 	// address, or remove it.
 	if cidrs := determineEnabledPoolCIDRs(*node, *ipPoolList, ipam.AttributeTypeIPIP); len(cidrs) > 0 {
@@ -244,6 +251,14 @@ func reconcileTunnelAddrs(nodename string, cfg *apiconfig.CalicoAPIConfig, c cli
 	} else {
 		removeHostTunnelAddr(ctx, c, nodename, ipam.AttributeTypeVXLAN)
 	}
+
+	// Query the IPv6 VXLAN enabled pools and either configure the tunnel
+	// address, or remove it.
+	if cidrs := determineEnabledPoolCIDRs(*node, *ipPoolList, ipam.AttributeTypeVXLANV6); len(cidrs) > 0 {
+		ensureHostTunnelAddress(ctx, c, nodename, cidrs, ipam.AttributeTypeVXLANV6)
+	} else {
+		removeHostTunnelAddr(ctx, c, nodename, ipam.AttributeTypeVXLANV6)
+	}
 }
 
 func ensureHostTunnelAddress(ctx context.Context, c client.Interface, nodename string, cidrs []net.IPNet, attrType string) {
@@ -261,6 +276,8 @@ func ensureHostTunnelAddress(ctx context.Context, c client.Interface, nodename s
 	switch attrType {
 	case ipam.AttributeTypeVXLAN:
 		addr = node.Spec.IPv4VXLANTunnelAddr
+	case ipam.AttributeTypeVXLANV6:
+		addr = node.Spec.IPv6VXLANTunnelAddr
 	case ipam.AttributeTypeIPIP:
 		if node.Spec.BGP != nil {
 			addr = node.Spec.BGP.IPv4IPIPTunnelAddr
@@ -269,6 +286,10 @@ func ensureHostTunnelAddress(ctx context.Context, c client.Interface, nodename s
 		if node.Spec.Wireguard != nil {
 			addr = node.Spec.Wireguard.InterfaceIPv4Address
 		}
+	case ipam.AttributeTypeWireguardV6:
+		if node.Spec.Wireguard != nil {
+			addr = node.Spec.Wireguard.InterfaceIPv6Address
+		}
 	}
 
 	// Work out if we need to assign a tunnel address.
@@ -403,10 +424,14 @@ func generateHandleAndAttributes(nodename string, attrType string) (string, map[
 	switch attrType {
 	case ipam.AttributeTypeVXLAN:
 		handle = fmt.Sprintf("vxlan-tunnel-addr-%s", nodename)
+	case ipam.AttributeTypeVXLANV6:
+		handle = fmt.Sprintf("vxlan-v6-tunnel-addr-%s", nodename)
 	case ipam.AttributeTypeIPIP:
 		handle = fmt.Sprintf("ipip-tunnel-addr-%s", nodename)
 	case ipam.AttributeTypeWireguard:
 		handle = fmt.Sprintf("wireguard-tunnel-addr-%s", nodename)
+	case ipam.AttributeTypeWireguardV6:
+		handle = fmt.Sprintf("wireguard-v6-tunnel-addr-%s", nodename)
 	}
 	attrs[ipam.AttributeType] = attrType
 	return handle, attrs
@@ -421,26 +446,34 @@ func assignHostTunnelAddr(ctx context.Context, c client.Interface, nodename stri
 	logCtx := getLogger(attrType)
 
 	args := ipam.AutoAssignArgs{
-		Num4:        1,
-		Num6:        0,
 		HandleID:    &handle,
 		Attrs:       attrs,
 		Hostname:    nodename,
-		IPv4Pools:   cidrs,
 		IntendedUse: api.IPPoolAllowedUseTunnel,
 	}
+	if attrType == ipam.AttributeTypeVXLANV6 || attrType == ipam.AttributeTypeWireguardV6 {
+		args.Num6 = 1
+		args.IPv6Pools = cidrs
+	} else {
+		args.Num4 = 1
+		args.IPv4Pools = cidrs
+	}
 
-	v4Assignments, _, err := c.IPAM().AutoAssign(ctx, args)
+	v4Assignments, v6Assignments, err := c.IPAM().AutoAssign(ctx, args)
 	if err != nil {
 		logCtx.WithError(err).Fatal("Unable to autoassign an address")
 	}
 
-	if err := v4Assignments.PartialFulfillmentError(); err != nil {
+	assignments := v4Assignments
+	if attrType == ipam.AttributeTypeVXLANV6 || attrType == ipam.AttributeTypeWireguardV6 {
+		assignments = v6Assignments
+	}
+	if err := assignments.PartialFulfillmentError(); err != nil {
 		logCtx.WithError(err).Fatal("Unable to autoassign an address")
 	}
 
 	// Update the node object with the assigned address.
-	ip := v4Assignments.IPs[0].IP.String()
+	ip := assignments.IPs[0].IP.String()
 	if err = updateNodeWithAddress(ctx, c, nodename, ip, attrType); err != nil {
 		// We hit an error, so release the IP address before exiting.
 		err := c.IPAM().ReleaseByHandle(ctx, handle)
@@ -466,6 +499,8 @@ func updateNodeWithAddress(ctx context.Context, c client.Interface, nodename str
 		switch attrType {
 		case ipam.AttributeTypeVXLAN:
 			node.Spec.IPv4VXLANTunnelAddr = addr
+		case ipam.AttributeTypeVXLANV6:
+			node.Spec.IPv6VXLANTunnelAddr = addr
 		case ipam.AttributeTypeIPIP:
 			if node.Spec.BGP == nil {
 				node.Spec.BGP = &libapi.NodeBGPSpec{}
@@ -476,6 +511,11 @@ func updateNodeWithAddress(ctx context.Context, c client.Interface, nodename str
 				node.Spec.Wireguard = &libapi.NodeWireguardSpec{}
 			}
 			node.Spec.Wireguard.InterfaceIPv4Address = addr
+		case ipam.AttributeTypeWireguardV6:
+			if node.Spec.Wireguard == nil {
+				node.Spec.Wireguard = &libapi.NodeWireguardSpec{}
+			}
+			node.Spec.Wireguard.InterfaceIPv6Address = addr
 		}
 
 		_, err = c.Nodes().Update(ctx, node, options.SetOptions{})
@@ -513,6 +553,9 @@ func removeHostTunnelAddr(ctx context.Context, c client.Interface, nodename stri
 		case ipam.AttributeTypeVXLAN:
 			ipAddrStr = node.Spec.IPv4VXLANTunnelAddr
 			node.Spec.IPv4VXLANTunnelAddr = ""
+		case ipam.AttributeTypeVXLANV6:
+			ipAddrStr = node.Spec.IPv6VXLANTunnelAddr
+			node.Spec.IPv6VXLANTunnelAddr = ""
 		case ipam.AttributeTypeIPIP:
 			if node.Spec.BGP != nil {
 				ipAddrStr = node.Spec.BGP.IPv4IPIPTunnelAddr
@@ -530,6 +573,16 @@ func removeHostTunnelAddr(ctx context.Context, c client.Interface, nodename stri
 				ipAddrStr = node.Spec.Wireguard.InterfaceIPv4Address
 				node.Spec.Wireguard.InterfaceIPv4Address = ""
 
+				if reflect.DeepEqual(*node.Spec.Wireguard, libapi.NodeWireguardSpec{}) {
+					logCtx.Debug("Wireguard spec is now empty, setting to nil")
+					node.Spec.Wireguard = nil
+				}
+			}
+		case ipam.AttributeTypeWireguardV6:
+			if node.Spec.Wireguard != nil {
+				ipAddrStr = node.Spec.Wireguard.InterfaceIPv6Address
+				node.Spec.Wireguard.InterfaceIPv6Address = ""
+
 				if reflect.DeepEqual(*node.Spec.Wireguard, libapi.NodeWireguardSpec{}) {
 					logCtx.Debug("Wireguard spec is now empty, setting to nil")
 					node.Spec.Wireguard = nil
@@ -625,6 +678,10 @@ func determineEnabledPoolCIDRs(node libapi.Node, ipPoolList api.IPPoolList, attr
 		log.Debugf("Wireguard is not running on node %s", node.Name)
 		return nil
 	}
+	if attrType == ipam.AttributeTypeWireguardV6 && node.Status.WireguardPublicKeyV6 == "" {
+		log.Debugf("IPv6 wireguard is not running on node %s", node.Name)
+		return nil
+	}
 
 	var cidrs []net.IPNet
 	for _, ipPool := range ipPoolList.Items {
@@ -642,13 +699,17 @@ func determineEnabledPoolCIDRs(node libapi.Node, ipPoolList api.IPPoolList, attr
 			continue
 		}
 
-		// Check if desired encap is enabled in the IP pool, the IP pool is not disabled, and it is IPv4 pool since we
-		// don't support encap with IPv6.
+		// Check if desired encap is enabled in the IP pool, the IP pool is not disabled, and it is of the IP version
+		// used by the encap.
 		switch attrType {
 		case ipam.AttributeTypeVXLAN:
 			if (ipPool.Spec.VXLANMode == api.VXLANModeAlways || ipPool.Spec.VXLANMode == api.VXLANModeCrossSubnet) && !ipPool.Spec.Disabled && poolCidr.Version() == 4 {
 				cidrs = append(cidrs, *poolCidr)
 			}
+		case ipam.AttributeTypeVXLANV6:
+			if (ipPool.Spec.VXLANMode == api.VXLANModeAlways || ipPool.Spec.VXLANMode == api.VXLANModeCrossSubnet) && !ipPool.Spec.Disabled && poolCidr.Version() == 6 {
+				cidrs = append(cidrs, *poolCidr)
+			}
 		case ipam.AttributeTypeIPIP:
 			// Check if IPIP is enabled in the IP pool, the IP pool is not disabled, and it is IPv4 pool since we don't support IPIP with IPv6.
 			if (ipPool.Spec.IPIPMode == api.IPIPModeCrossSubnet || ipPool.Spec.IPIPMode == api.IPIPModeAlways) && !ipPool.Spec.Disabled && poolCidr.Version() == 4 {
@@ -659,6 +720,10 @@ func determineEnabledPoolCIDRs(node libapi.Node, ipPoolList api.IPPoolList, attr
 			if !ipPool.Spec.Disabled && poolCidr.Version() == 4 {
 				cidrs = append(cidrs, *poolCidr)
 			}
+		case ipam.AttributeTypeWireguardV6:
+			if !ipPool.Spec.Disabled && poolCidr.Version() == 6 {
+				cidrs = append(cidrs, *poolCidr)
+			}
 		}
 	}
 	return cidrs
@@ -679,10 +744,14 @@ func getLogger(attrType string) *log.Entry {
 	switch attrType {
 	case ipam.AttributeTypeVXLAN:
 		return log.WithField("type", "vxlanTunnelAddress")
+	case ipam.AttributeTypeVXLANV6:
+		return log.WithField("type", "vxlanV6TunnelAddress")
 	case ipam.AttributeTypeIPIP:
 		return log.WithField("type", "ipipTunnelAddress")
 	case ipam.AttributeTypeWireguard:
 		return log.WithField("type", "wireguardTunnelAddress")
+	case ipam.AttributeTypeWireguardV6:
+		return log.WithField("type", "wireguardV6TunnelAddress")
 	}
 	return nil
 }
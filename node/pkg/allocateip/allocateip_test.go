@@ -893,6 +893,53 @@ var _ = Describe("determineEnabledPoolCIDRs", func() {
 		})
 	})
 
+	Context("IPv6 VXLAN tests", func() {
+		It("should match ip-pool-2 but not ip-pool-1 or ip-pool-3", func() {
+			// Mock out the node and ip pools
+			n := libapi.Node{ObjectMeta: metav1.ObjectMeta{Name: "bee-node", Labels: map[string]string{"foo": "bar"}}}
+			pl := api.IPPoolList{
+				Items: []api.IPPool{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "ip-pool-1"},
+						Spec: api.IPPoolSpec{
+							Disabled:     false,
+							CIDR:         "172.0.0.0/9",
+							NodeSelector: `foo == "bar"`,
+							VXLANMode:    api.VXLANModeAlways,
+						},
+					}, {
+						ObjectMeta: metav1.ObjectMeta{Name: "ip-pool-2"},
+						Spec: api.IPPoolSpec{
+							Disabled:     false,
+							CIDR:         "fd00:10:244::/64",
+							NodeSelector: `foo == "bar"`,
+							VXLANMode:    api.VXLANModeAlways,
+						},
+					}, {
+						ObjectMeta: metav1.ObjectMeta{Name: "ip-pool-3"},
+						Spec: api.IPPoolSpec{
+							Disabled:     false,
+							CIDR:         "fd00:10:245::/64",
+							NodeSelector: `foo != "bar"`,
+							VXLANMode:    api.VXLANModeAlways,
+						},
+					}}}
+
+			// Execute and test assertions.
+			cidrs := determineEnabledPoolCIDRs(n, pl, ipam.AttributeTypeVXLANV6)
+			_, cidr1, _ := net.ParseCIDR("172.0.0.1/9")
+			_, cidr2, _ := net.ParseCIDR("fd00:10:244::/64")
+			_, cidr3, _ := net.ParseCIDR("fd00:10:245::/64")
+			Expect(cidrs).ToNot(ContainElement(*cidr1))
+			Expect(cidrs).To(ContainElement(*cidr2))
+			Expect(cidrs).ToNot(ContainElement(*cidr3))
+
+			// The IPv4 VXLAN tunnel address should only come from the IPv4 pool.
+			cidrs = determineEnabledPoolCIDRs(n, pl, ipam.AttributeTypeVXLAN)
+			Expect(cidrs).To(ConsistOf(*cidr1))
+		})
+	})
+
 	Context("Wireguard tests", func() {
 		It("node has public key - should match ip-pool-1 but not ip-pool-2", func() {
 			// Mock out the node and ip pools
@@ -950,6 +997,45 @@ var _ = Describe("determineEnabledPoolCIDRs", func() {
 			cidrs := determineEnabledPoolCIDRs(n, pl, ipam.AttributeTypeWireguard)
 			Expect(cidrs).To(HaveLen(0))
 		})
+		It("should only use IPv6 pools for the IPv6 wireguard address, and only once the IPv6 public key is set", func() {
+			// Mock out the node and ip pools
+			n := libapi.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "bee-node", Labels: map[string]string{"foo": "bar"}},
+				Status:     libapi.NodeStatus{WireguardPublicKey: "abcde"},
+			}
+			pl := api.IPPoolList{
+				Items: []api.IPPool{
+					{
+						ObjectMeta: metav1.ObjectMeta{Name: "ip-pool-1"},
+						Spec: api.IPPoolSpec{
+							Disabled:     false,
+							CIDR:         "172.0.0.0/9",
+							NodeSelector: `foo == "bar"`,
+						},
+					}, {
+						ObjectMeta: metav1.ObjectMeta{Name: "ip-pool-2"},
+						Spec: api.IPPoolSpec{
+							Disabled:     false,
+							CIDR:         "fd00:1::/64",
+							NodeSelector: `foo == "bar"`,
+						},
+					}}}
+
+			// Only the IPv4 public key is set, so there should be no IPv6 pools.
+			cidrs := determineEnabledPoolCIDRs(n, pl, ipam.AttributeTypeWireguardV6)
+			Expect(cidrs).To(HaveLen(0))
+
+			// Once the IPv6 public key is set, only the IPv6 pool should be returned.
+			n.Status.WireguardPublicKeyV6 = "fghij"
+			cidrs = determineEnabledPoolCIDRs(n, pl, ipam.AttributeTypeWireguardV6)
+			_, cidr1, _ := net.ParseCIDR("172.0.0.1/9")
+			_, cidr2, _ := net.ParseCIDR("fd00:1::/64")
+			Expect(cidrs).To(ConsistOf(*cidr2))
+
+			// The IPv4 wireguard address should still only come from the IPv4 pool.
+			cidrs = determineEnabledPoolCIDRs(n, pl, ipam.AttributeTypeWireguard)
+			Expect(cidrs).To(ConsistOf(*cidr1))
+		})
 	})
 })
 
@@ -980,6 +1066,18 @@ func (c shimClient) IPReservations() client.IPReservationInterface {
 	return c.client.IPReservations()
 }
 
+func (c shimClient) Tiers() client.TierInterface {
+	return c.client.Tiers()
+}
+
+func (c shimClient) StagedGlobalNetworkPolicies() client.StagedGlobalNetworkPolicyInterface {
+	return c.client.StagedGlobalNetworkPolicies()
+}
+
+func (c shimClient) StagedNetworkPolicies() client.StagedNetworkPolicyInterface {
+	return c.client.StagedNetworkPolicies()
+}
+
 func newShimClientWithPoolAccessor(c client.Interface, be bapi.Client, pool ipam.PoolAccessorInterface) shimClient {
 	return shimClient{client: c, ic: ipam.NewIPAMClient(be, pool, c.IPReservations())}
 }
@@ -1019,6 +1117,11 @@ func (c shimClient) NetworkSets() client.NetworkSetInterface {
 	return c.client.NetworkSets()
 }
 
+// PacketCaptures returns an interface for managing packet capture resources.
+func (c shimClient) PacketCaptures() client.PacketCaptureInterface {
+	return c.client.PacketCaptures()
+}
+
 // HostEndpoints returns an interface for managing host endpoint resources.
 func (c shimClient) HostEndpoints() client.HostEndpointInterface {
 	return c.client.HostEndpoints()
@@ -1034,6 +1137,10 @@ func (c shimClient) BGPPeers() client.BGPPeerInterface {
 	return c.client.BGPPeers()
 }
 
+func (c shimClient) BGPFilters() client.BGPFilterInterface {
+	return c.client.BGPFilters()
+}
+
 // IPAM returns an interface for managing IP address assignment and releasing.
 func (c shimClient) IPAM() ipam.Interface {
 	return c.ic
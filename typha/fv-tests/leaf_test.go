@@ -0,0 +1,194 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fvtests_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	. "github.com/projectcalico/calico/typha/fv-tests"
+	"github.com/projectcalico/calico/typha/pkg/snapcache"
+	"github.com/projectcalico/calico/typha/pkg/syncclient"
+	"github.com/projectcalico/calico/typha/pkg/syncproto"
+	"github.com/projectcalico/calico/typha/pkg/syncserver"
+	"github.com/projectcalico/calico/typha/pkg/upstream"
+)
+
+var _ = Describe("With a leaf Typha fed by a root Typha", func() {
+	// We'll create this pipeline for updates to flow through:
+	//
+	//    This goroutine -> root cache -> root server -> upstream syncer -> leaf cache -> leaf server -> client
+	//
+	var (
+		cacheCxt         context.Context
+		cacheCancel      context.CancelFunc
+		rootCache        *snapcache.Cache
+		rootServer       *syncserver.Server
+		rootServerCancel context.CancelFunc
+		rootPort         int
+		leafCache        *snapcache.Cache
+		upstreamSyncer   *upstream.Syncer
+		leafServer       *syncserver.Server
+		leafServerCancel context.CancelFunc
+		client           *syncclient.SyncerClient
+		clientCancel     context.CancelFunc
+		recorder         *StateRecorder
+	)
+
+	newCache := func() *snapcache.Cache {
+		return snapcache.New(snapcache.Config{
+			// Reduce the wake up interval from the default to give us faster tear down.
+			WakeUpInterval: 50 * time.Millisecond,
+		})
+	}
+
+	startServer := func(cache *snapcache.Cache, port int) (*syncserver.Server, context.CancelFunc) {
+		server := syncserver.New(
+			map[syncproto.SyncerType]syncserver.BreadcrumbProvider{syncproto.SyncerTypeFelix: cache},
+			syncserver.Config{
+				PingInterval: 10 * time.Second,
+				Port:         port,
+				DropInterval: 50 * time.Millisecond,
+			})
+		serverCxt, serverCancel := context.WithCancel(context.Background())
+		server.Start(serverCxt)
+		return server, serverCancel
+	}
+
+	stopServer := func(server *syncserver.Server, cancel context.CancelFunc) {
+		cancel()
+		server.Finished.Wait()
+	}
+
+	BeforeEach(func() {
+		cacheCxt, cacheCancel = context.WithCancel(context.Background())
+
+		rootCache = newCache()
+		rootCache.Start(cacheCxt)
+		rootServer, rootServerCancel = startServer(rootCache, syncserver.PortRandom)
+		rootPort = rootServer.Port()
+
+		leafCache = newCache()
+		leafCache.Start(cacheCxt)
+		upstreamSyncer = upstream.New(
+			upstream.Config{
+				Addr:          fmt.Sprintf("127.0.0.1:%d", rootPort),
+				MyHostname:    "leaf",
+				MyVersion:     "test-version",
+				RetryInterval: 100 * time.Millisecond,
+			},
+			syncproto.SyncerTypeFelix,
+			leafCache,
+		)
+		upstreamSyncer.Start()
+		leafServer, leafServerCancel = startServer(leafCache, syncserver.PortRandom)
+
+		var clientCxt context.Context
+		clientCxt, clientCancel = context.WithCancel(context.Background())
+		recorder = NewRecorder()
+		client = syncclient.New(
+			fmt.Sprintf("127.0.0.1:%d", leafServer.Port()),
+			"test-version",
+			"test-host",
+			"test-info",
+			recorder,
+			nil,
+		)
+		Expect(client.Start(clientCxt)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		clientCancel()
+		client.Finished.Wait()
+		stopServer(leafServer, leafServerCancel)
+		upstreamSyncer.Stop()
+		if rootServer != nil {
+			stopServer(rootServer, rootServerCancel)
+		}
+		cacheCancel()
+	})
+
+	configKV := func(name, value string) api.Update {
+		return api.Update{
+			KVPair: model.KVPair{
+				Key:      model.GlobalConfigKey{Name: name},
+				Value:    value,
+				Revision: "1",
+			},
+			UpdateType: api.UpdateTypeKVNew,
+		}
+	}
+
+	It("should pass the root's updates through to the leaf's clients", func() {
+		rootCache.OnUpdates([]api.Update{configKV("foo", "bar"), configKV("biff", "baz")})
+		rootCache.OnStatusUpdated(api.InSync)
+
+		Eventually(recorder.Status).Should(Equal(api.InSync))
+		Eventually(recorder.KVs).Should(Equal(map[string]api.Update{
+			"/calico/v1/config/foo":  configKV("foo", "bar"),
+			"/calico/v1/config/biff": configKV("biff", "baz"),
+		}))
+	})
+
+	It("should resume from where it left off after reconnecting", func() {
+		rootCache.OnUpdates([]api.Update{configKV("foo", "bar"), configKV("biff", "baz")})
+		rootCache.OnStatusUpdated(api.InSync)
+		Eventually(recorder.KVs).Should(HaveLen(2))
+
+		// Take the root server down and, while the leaf is disconnected, delete one of the KVs.
+		stopServer(rootServer, rootServerCancel)
+		rootServer = nil
+		rootCache.OnUpdates([]api.Update{{
+			KVPair: model.KVPair{
+				Key:      model.GlobalConfigKey{Name: "biff"},
+				Revision: "2",
+			},
+			UpdateType: api.UpdateTypeKVDeleted,
+		}})
+		Consistently(recorder.KVs, "500ms").Should(HaveLen(2))
+
+		// When the root comes back, the leaf should notice that the KV has gone.
+		rootServer, rootServerCancel = startServer(rootCache, rootPort)
+		Eventually(recorder.KVs, "5s").Should(Equal(map[string]api.Update{
+			"/calico/v1/config/foo": configKV("foo", "bar"),
+		}))
+		Eventually(recorder.Status).Should(Equal(api.InSync))
+	})
+	It("should resync with a new root that can't resume the connection", func() {
+		rootCache.OnUpdates([]api.Update{configKV("foo", "bar"), configKV("biff", "baz")})
+		rootCache.OnStatusUpdated(api.InSync)
+		Eventually(recorder.KVs).Should(HaveLen(2))
+
+		// Replace the root with one that has a new cache, which doesn't have the leaf's resume point and
+		// is missing one of the KVs.
+		stopServer(rootServer, rootServerCancel)
+		rootCache = newCache()
+		rootCache.Start(cacheCxt)
+		rootCache.OnUpdates([]api.Update{configKV("foo", "bar")})
+		rootCache.OnStatusUpdated(api.InSync)
+		rootServer, rootServerCancel = startServer(rootCache, rootPort)
+
+		Eventually(recorder.KVs, "5s").Should(Equal(map[string]api.Update{
+			"/calico/v1/config/foo": configKV("foo", "bar"),
+		}))
+		Eventually(recorder.Status).Should(Equal(api.InSync))
+	})
+})
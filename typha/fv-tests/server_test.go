@@ -248,9 +248,10 @@ var _ = Describe("With an in-process Server", func() {
 				syncproto.SyncerTypeBGP:   bgpCache,
 			},
 			syncserver.Config{
-				PingInterval: 10 * time.Second,
-				Port:         syncserver.PortRandom,
-				DropInterval: 50 * time.Millisecond,
+				PingInterval:      10 * time.Second,
+				Port:              syncserver.PortRandom,
+				DropInterval:      50 * time.Millisecond,
+				RebalanceInterval: 50 * time.Millisecond,
 			})
 		felixCache.Start(cacheCxt)
 		bgpCache.Start(cacheCxt)
@@ -307,6 +308,163 @@ var _ = Describe("With an in-process Server", func() {
 		return expectedEndState
 	}
 
+	Describe("resuming connections", func() {
+		startClient := func(resumeFrom syncproto.ResumePoint, disableCompression bool) (*syncclient.SyncerClient, *StateRecorder, context.CancelFunc) {
+			clientCxt, clientCancel := context.WithCancel(context.Background())
+			recorder := NewRecorder()
+			client := syncclient.New(
+				fmt.Sprintf("127.0.0.1:%d", server.Port()),
+				"test-version",
+				"test-host-resume",
+				"test-info",
+				recorder,
+				&syncclient.Options{
+					DisableCompression: disableCompression,
+					ResumeFrom:         resumeFrom,
+				},
+			)
+			Expect(client.Start(clientCxt)).To(Succeed())
+			clientStates = append(clientStates, clientState{
+				clientCxt:    clientCxt,
+				client:       client,
+				clientCancel: clientCancel,
+				recorder:     recorder,
+				syncerType:   syncproto.SyncerTypeFelix,
+			})
+			return client, recorder, clientCancel
+		}
+
+		configUpdate := func(name string) api.Update {
+			return api.Update{
+				KVPair: model.KVPair{
+					Key:      model.GlobalConfigKey{Name: name},
+					Value:    "resumed",
+					Revision: "1",
+				},
+				UpdateType: api.UpdateTypeKVNew,
+			}
+		}
+
+		for _, disableCompression := range []bool{false, true} {
+			disableCompression := disableCompression
+			It(fmt.Sprintf("should send only the missed updates to a resuming client (compression disabled: %v)",
+				disableCompression), func() {
+				expectedEndState := sendNUpdatesThenInSync(25)
+				client, recorder, clientCancel := startClient(syncproto.ResumePoint{}, disableCompression)
+				Eventually(recorder.Status).Should(Equal(api.InSync))
+				Eventually(recorder.KVs).Should(Equal(expectedEndState))
+				Eventually(client.ResumePoint).Should(Equal(syncproto.ResumePoint{
+					CacheID:        felixCache.ID(),
+					SequenceNumber: felixCache.CurrentBreadcrumb().SequenceNumber,
+				}))
+
+				// Disconnect and send some updates while the client is away.
+				clientCancel()
+				client.Finished.Wait()
+				decoupler.OnUpdates([]api.Update{configUpdate("missed1"), configUpdate("missed2")})
+
+				resumedClient, resumedRecorder, _ := startClient(client.ResumePoint(), disableCompression)
+				Eventually(resumedRecorder.KVs).Should(Equal(map[string]api.Update{
+					"/calico/v1/config/missed1": configUpdate("missed1"),
+					"/calico/v1/config/missed2": configUpdate("missed2"),
+				}))
+
+				// Updates should continue to flow after the resume.
+				decoupler.OnUpdates([]api.Update{configUpdate("later")})
+				Eventually(resumedRecorder.KVs).Should(HaveKey("/calico/v1/config/later"))
+				Consistently(resumedRecorder.KVs).Should(HaveLen(3))
+				Expect(resumedClient.ResumeRejected()).To(BeFalse())
+			})
+		}
+
+		It("should reject a resume point from a different cache", func() {
+			sendNUpdatesThenInSync(10)
+			client, recorder, _ := startClient(syncproto.ResumePoint{CacheID: "another-cache", SequenceNumber: 1}, false)
+			client.Finished.Wait()
+			Expect(client.ResumeRejected()).To(BeTrue())
+			Expect(recorder.KVs()).To(BeEmpty())
+		})
+
+		It("should refuse to resume when it already has its share of connections", func() {
+			sendNUpdatesThenInSync(10)
+			server.SetRebalanceTarget(1)
+			client, recorder, clientCancel := startClient(syncproto.ResumePoint{}, false)
+			Eventually(recorder.Status).Should(Equal(api.InSync))
+			Eventually(client.ResumePoint).ShouldNot(BeZero())
+			clientCancel()
+			client.Finished.Wait()
+
+			// Another client takes our place.
+			_, otherRecorder, _ := startClient(syncproto.ResumePoint{}, false)
+			Eventually(otherRecorder.Status).Should(Equal(api.InSync))
+
+			resumedClient, resumedRecorder, _ := startClient(client.ResumePoint(), false)
+			resumedClient.Finished.Wait()
+			Expect(resumedClient.ResumeRejected()).To(BeTrue())
+			Expect(resumedRecorder.KVs()).To(BeEmpty())
+		})
+
+		It("should reject a resume point that is no longer retained", func() {
+			sendNUpdatesThenInSync(10)
+			client, recorder, _ := startClient(syncproto.ResumePoint{CacheID: felixCache.ID(), SequenceNumber: 100000}, false)
+			client.Finished.Wait()
+			Expect(client.ResumeRejected()).To(BeTrue())
+			Expect(recorder.KVs()).To(BeEmpty())
+		})
+	})
+
+	Describe("with several Typha addresses", func() {
+		var deadAddr, liveAddr string
+
+		BeforeEach(func() {
+			// Find a port that nothing is listening on.
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			deadAddr = l.Addr().String()
+			Expect(l.Close()).To(Succeed())
+			liveAddr = fmt.Sprintf("127.0.0.1:%d", server.Port())
+		})
+
+		newClient := func(addrs ...string) (*syncclient.SyncerClient, *StateRecorder, context.Context, context.CancelFunc) {
+			clientCxt, clientCancel := context.WithCancel(context.Background())
+			recorder := NewRecorder()
+			client := syncclient.NewWithAddrs(
+				addrs,
+				"test-version",
+				"test-host-failover",
+				"test-info",
+				recorder,
+				&syncclient.Options{
+					MinConnectBackoff: 10 * time.Millisecond,
+				},
+			)
+			return client, recorder, clientCxt, clientCancel
+		}
+
+		It("should fail over to the next address if the first Typha is down", func() {
+			client, recorder, clientCxt, clientCancel := newClient(deadAddr, liveAddr)
+			Expect(client.Start(clientCxt)).To(Succeed())
+			clientStates = append(clientStates, clientState{
+				clientCxt:    clientCxt,
+				client:       client,
+				clientCancel: clientCancel,
+				recorder:     recorder,
+				syncerType:   syncproto.SyncerTypeFelix,
+			})
+			Expect(client.Addr()).To(Equal(liveAddr))
+
+			expectedEndState := sendNUpdatesThenInSync(10)
+			Eventually(recorder.Status).Should(Equal(api.InSync))
+			Eventually(recorder.KVs).Should(Equal(expectedEndState))
+		})
+
+		It("should return an error if none of the Typhas accept the connection", func() {
+			client, _, clientCxt, clientCancel := newClient(deadAddr, deadAddr)
+			defer clientCancel()
+			Expect(client.Start(clientCxt)).NotTo(Succeed())
+		})
+	})
+
 	Describe("with a client connection", func() {
 		var clientCancel context.CancelFunc
 		var recorder *StateRecorder
@@ -522,6 +680,50 @@ var _ = Describe("With an in-process Server", func() {
 			expectFelixClientState(api.InSync, expectedEndState)
 		})
 
+		It("should pass through many KVs to a client that doesn't support compression", func() {
+			expectedEndState := sendNUpdatesThenInSync(1000)
+
+			clientCxt, clientCancel := context.WithCancel(context.Background())
+			recorder := NewRecorder()
+			client := syncclient.New(
+				fmt.Sprintf("127.0.0.1:%d", server.Port()),
+				"test-version",
+				"test-host-uncompressed",
+				"test-info",
+				recorder,
+				&syncclient.Options{
+					DisableCompression: true,
+				},
+			)
+			Expect(client.Start(clientCxt)).To(Succeed())
+			clientStates = append(clientStates, clientState{
+				clientCxt:    clientCxt,
+				client:       client,
+				clientCancel: clientCancel,
+				recorder:     recorder,
+				syncerType:   syncproto.SyncerTypeFelix,
+			})
+
+			Eventually(recorder.Status).Should(Equal(api.InSync))
+			Eventually(recorder.KVs).Should(Equal(expectedEndState))
+
+			// Updates after the snapshot should reach both clients.
+			decoupler.OnUpdates([]api.Update{{
+				KVPair: model.KVPair{
+					Key:      model.GlobalConfigKey{Name: "foo0"},
+					Value:    "updated",
+					Revision: "1001",
+				},
+				UpdateType: api.UpdateTypeKVUpdated,
+			}})
+			Eventually(func() string {
+				return recorder.KVs()["/calico/v1/config/foo0"].Value.(string)
+			}).Should(Equal("updated"))
+			Eventually(func() string {
+				return clientStates[0].recorder.KVs()["/calico/v1/config/foo0"].Value.(string)
+			}).Should(Equal("updated"))
+		})
+
 		It("should report the correct number of connections", func() {
 			expectGaugeValue("typha_connections_active", 1.0)
 		})
@@ -584,6 +786,39 @@ var _ = Describe("With an in-process Server", func() {
 			expectGaugeValue("typha_connections_active", 60.0)
 		})
 
+		It("should shed the oldest connections to reach the rebalance target", func() {
+			// Make sure that every connection has finished its handshake, so that the server knows its
+			// syncer type.
+			for _, s := range clientStates {
+				_, err := s.client.SupportsNodeResourceUpdates(10 * time.Second)
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			// We start with 100 connections, set the target to 80 so we shed 20 connections, at one
+			// per 50ms.
+			server.SetRebalanceTarget(80)
+
+			for i, s := range clientStates {
+				finishedC := make(chan struct{})
+				go func(s clientState) {
+					s.client.Finished.Wait()
+					close(finishedC)
+				}(s)
+				if i < 20 {
+					Eventually(finishedC, 5*time.Second).Should(BeClosed())
+				} else {
+					Consistently(finishedC, "10ms").ShouldNot(BeClosed())
+				}
+			}
+			expectGaugeValue("typha_connections_active", 80.0)
+
+			// Removing the target should stop the shedding.
+			server.SetRebalanceTarget(0)
+			Consistently(func() (float64, error) {
+				return getGauge("typha_connections_active")
+			}, "500ms").Should(Equal(80.0))
+		})
+
 		It("should pass through a KV and status", func() {
 			decoupler.OnStatusUpdated(api.ResyncInProgress)
 			decoupler.OnUpdates([]api.Update{configFoobarBazzBiff})
@@ -1072,6 +1307,11 @@ var _ = Describe("With an in-process Server with short grace period", func() {
 
 	Describe("with lots of KVs", func() {
 		const initialSnapshotSize = 10000
+
+		// These tests rely on the client applying back-pressure to the server through the socket.
+		// With compression, the (highly compressible) snapshot and updates fit in the socket
+		// buffers so the server never blocks; disable it to exercise the streaming path.
+		legacyStreamingOptions := &syncclient.Options{DisableCompression: true}
 		BeforeEach(func() {
 			sendNUpdatesThenInSync(initialSnapshotSize)
 		})
@@ -1095,7 +1335,7 @@ var _ = Describe("With an in-process Server with short grace period", func() {
 				"test-host",
 				"test-info",
 				recorder,
-				nil,
+				legacyStreamingOptions,
 			)
 			err = client.Start(clientCxt)
 			Expect(err).NotTo(HaveOccurred())
@@ -1144,7 +1384,7 @@ var _ = Describe("With an in-process Server with short grace period", func() {
 				"test-host",
 				"test-info",
 				recorder,
-				nil,
+				legacyStreamingOptions,
 			)
 			err = client.Start(clientCxt)
 			Expect(err).NotTo(HaveOccurred())
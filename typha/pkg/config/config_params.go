@@ -112,7 +112,8 @@ type Config struct {
 	PrometheusGoMetricsEnabled      bool   `config:"bool;true"`
 	PrometheusProcessMetricsEnabled bool   `config:"bool;true"`
 
-	SnapshotCacheMaxBatchSize int `config:"int(1,);100"`
+	SnapshotCacheMaxBatchSize        int           `config:"int(1,);100"`
+	SnapshotCacheResumeRetentionSecs time.Duration `config:"seconds;60"`
 
 	ServerMaxMessageSize                 int           `config:"int(1,);100"`
 	ServerMaxFallBehindSecs              time.Duration `config:"seconds;90"`
@@ -145,6 +146,27 @@ type Config struct {
 	K8sServiceName             string        `config:"string;calico-typha"`
 	K8sPortName                string        `config:"string;calico-typha"`
 
+	// In kubernetes rebalancing mode, Typha also sheds its oldest connections, at most one per
+	// ConnectionRebalancingIntervalSecs, once it has meaningfully more than its fair share of the
+	// nodes' connections plus ConnectionRebalancingHeadroomPercent, and then until it is back down to
+	// that number.  This spreads the load evenly after scale-up.  The default interval is long so that
+	// the disruption is spread out.  Set ConnectionRebalancingIntervalSecs to 0 to disable.
+	ConnectionRebalancingIntervalSecs    time.Duration `config:"seconds;60"`
+	ConnectionRebalancingHeadroomPercent int           `config:"int(0,);10"`
+
+	// Leaf mode.  If UpstreamTyphaAddr or UpstreamTyphaK8sServiceName is set, Typha gets its data
+	// from an upstream ("root") Typha, instead of from the datastore, and serves it to its own
+	// clients.  The UpstreamTypha TLS parameters are the client-side equivalent of the server-side
+	// TLS parameters above; if any are specified, they _all_ must be - except that either
+	// UpstreamTyphaCN or UpstreamTyphaURISAN may be left unset.
+	UpstreamTyphaAddr           string `config:"authority;;local"`
+	UpstreamTyphaK8sServiceName string `config:"string;;local"`
+	UpstreamTyphaKeyFile        string `config:"file(must-exist);;local"`
+	UpstreamTyphaCertFile       string `config:"file(must-exist);;local"`
+	UpstreamTyphaCAFile         string `config:"file(must-exist);;local"`
+	UpstreamTyphaCN             string `config:"string;"`
+	UpstreamTyphaURISAN         string `config:"string;"`
+
 	// State tracking.
 
 	// nameToSource tracks where we loaded each config param from.
@@ -303,6 +325,18 @@ func (config *Config) requiringTLS() bool {
 	return config.ServerKeyFile+config.ServerCertFile+config.CAFile+config.ClientCN+config.ClientURISAN != ""
 }
 
+// UpstreamTyphaConfigured returns true if Typha should get its data from an upstream Typha instead of from
+// the datastore.
+func (config *Config) UpstreamTyphaConfigured() bool {
+	return config.UpstreamTyphaAddr != "" || config.UpstreamTyphaK8sServiceName != ""
+}
+
+func (config *Config) requiringUpstreamTLS() bool {
+	// True if any of the upstream TLS parameters are set.
+	return config.UpstreamTyphaKeyFile+config.UpstreamTyphaCertFile+config.UpstreamTyphaCAFile+
+		config.UpstreamTyphaCN+config.UpstreamTyphaURISAN != ""
+}
+
 // Validate() performs cross-field validation.
 func (config *Config) Validate() (err error) {
 	if config.DatastoreType == "etcdv3" && len(config.EtcdEndpoints) == 0 {
@@ -327,6 +361,24 @@ func (config *Config) Validate() (err error) {
 				" - except that either ClientCN or ClientURISAN may be left unset.")
 		}
 	}
+
+	// Likewise for the TLS config for the connection to the upstream Typha.
+	if config.requiringUpstreamTLS() {
+		if config.UpstreamTyphaKeyFile == "" ||
+			config.UpstreamTyphaCertFile == "" ||
+			config.UpstreamTyphaCAFile == "" ||
+			(config.UpstreamTyphaCN == "" && config.UpstreamTyphaURISAN == "") {
+			err = errors.New("If any upstream Typha TLS config parameters are specified," +
+				" they _all_ must be" +
+				" - except that either UpstreamTyphaCN or UpstreamTyphaURISAN may be left unset.")
+		}
+	}
+
+	// A leaf Typha that discovered its upstream through its own service would connect to itself or to
+	// its peers, neither of which has any data.
+	if config.UpstreamTyphaK8sServiceName != "" && config.UpstreamTyphaK8sServiceName == config.K8sServiceName {
+		err = errors.New("UpstreamTyphaK8sServiceName must be different from K8sServiceName")
+	}
 	return
 }
 
@@ -18,6 +18,7 @@ import (
 	"github.com/projectcalico/calico/typha/pkg/config"
 
 	"reflect"
+	"time"
 
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -81,6 +82,12 @@ var _ = DescribeTable("Config parsing",
 	Entry("PrometheusMetricsPort", "PrometheusMetricsPort", "1234", int(1234)),
 	Entry("PrometheusGoMetricsEnabled", "PrometheusGoMetricsEnabled", "false", false),
 	Entry("PrometheusProcessMetricsEnabled", "PrometheusProcessMetricsEnabled", "false", false),
+
+	Entry("SnapshotCacheResumeRetentionSecs", "SnapshotCacheResumeRetentionSecs", "30", 30*time.Second),
+
+	Entry("ConnectionRebalancingIntervalSecs", "ConnectionRebalancingIntervalSecs", "10", 10*time.Second),
+	Entry("ConnectionRebalancingIntervalSecs none", "ConnectionRebalancingIntervalSecs", "none", time.Duration(0)),
+	Entry("ConnectionRebalancingHeadroomPercent", "ConnectionRebalancingHeadroomPercent", "25", 25),
 )
 
 var _ = DescribeTable("Config validation",
@@ -125,4 +132,24 @@ var _ = DescribeTable("Config validation",
 		"ClientCN":       "typha-peer",
 		"ClientURISAN":   "spiffe://k8s.example.com/typha-peer",
 	}, true),
+	Entry("upstream Typha address", map[string]string{
+		"UpstreamTyphaAddr": "10.0.0.1:5473",
+	}, true),
+	Entry("upstream Typha service", map[string]string{
+		"UpstreamTyphaK8sServiceName": "calico-typha-root",
+	}, true),
+	Entry("upstream Typha service same as our own", map[string]string{
+		"UpstreamTyphaK8sServiceName": "calico-typha",
+	}, false),
+	Entry("just one upstream TLS setting", map[string]string{
+		"UpstreamTyphaAddr":    "10.0.0.1:5473",
+		"UpstreamTyphaKeyFile": "/usr",
+	}, false),
+	Entry("all upstream TLS params", map[string]string{
+		"UpstreamTyphaAddr":     "10.0.0.1:5473",
+		"UpstreamTyphaKeyFile":  "/usr",
+		"UpstreamTyphaCertFile": "/usr",
+		"UpstreamTyphaCAFile":   "/usr",
+		"UpstreamTyphaCN":       "typha-root",
+	}, true),
 )
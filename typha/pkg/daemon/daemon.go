@@ -53,6 +53,7 @@ import (
 	"github.com/projectcalico/calico/typha/pkg/snapcache"
 	"github.com/projectcalico/calico/typha/pkg/syncproto"
 	"github.com/projectcalico/calico/typha/pkg/syncserver"
+	"github.com/projectcalico/calico/typha/pkg/upstream"
 )
 
 const usage = `Typha, Calico's fan-out proxy.
@@ -233,6 +234,11 @@ configRetry:
 			continue configRetry
 		}
 
+		if configParams.UpstreamTyphaConfigured() {
+			// Leaf Typhas get their data from the upstream Typha so they don't need the datastore.
+			break configRetry
+		}
+
 		// We should now have enough config to connect to the datastore.
 		datastoreConfig = configParams.DatastoreConfig()
 		t.DatastoreClient, err = t.NewClientV3(datastoreConfig)
@@ -252,6 +258,15 @@ configRetry:
 	t.BuildInfoLogCxt.WithField("config", configParams).Info(
 		"Successfully loaded configuration.")
 
+	if configParams.UpstreamTyphaConfigured() {
+		log.WithFields(log.Fields{
+			"addr":    configParams.UpstreamTyphaAddr,
+			"service": configParams.UpstreamTyphaK8sServiceName,
+		}).Info("Upstream Typha configured, not connecting to the datastore.")
+		t.ConfigParams = configParams
+		return nil
+	}
+
 	if datastoreConfig.Spec.DatastoreType == apiconfig.Kubernetes {
 		// Special case: for KDD v1 datamodel to v3 datamodel upgrade, we need to ensure that the datastore migration
 		// has completed before we start serving requests.  Otherwise, we might serve partially-migrated data to
@@ -356,6 +371,7 @@ func (t *TyphaDaemon) addSyncerPipeline(
 	// Create our snapshot cache, which stores point-in-time copies of the datastore contents.
 	cache := snapcache.New(snapcache.Config{
 		MaxBatchSize:     t.ConfigParams.SnapshotCacheMaxBatchSize,
+		ResumeRetention:  t.ConfigParams.SnapshotCacheResumeRetentionSecs,
 		HealthAggregator: t.healthAggregator,
 		HealthName:       string(syncerType),
 	})
@@ -372,16 +388,54 @@ func (t *TyphaDaemon) addSyncerPipeline(
 	t.CachesBySyncerType[syncerType] = cache
 }
 
+// newUpstreamSyncerFunc returns a function that creates a Syncer, of the given type, that connects to the
+// upstream Typha.
+func (t *TyphaDaemon) newUpstreamSyncerFunc(syncerType syncproto.SyncerType) func(callbacks bapi.SyncerCallbacks) bapi.Syncer {
+	hostname, err := os.Hostname()
+	if err != nil {
+		log.WithError(err).Warn("Failed to get hostname, using 'unknown' in hello to upstream Typha.")
+		hostname = "unknown"
+	}
+	upstreamConfig := upstream.Config{
+		Addr:           t.ConfigParams.UpstreamTyphaAddr,
+		K8sNamespace:   t.ConfigParams.K8sNamespace,
+		K8sServiceName: t.ConfigParams.UpstreamTyphaK8sServiceName,
+		K8sPortName:    t.ConfigParams.K8sPortName,
+		KeyFile:        t.ConfigParams.UpstreamTyphaKeyFile,
+		CertFile:       t.ConfigParams.UpstreamTyphaCertFile,
+		CAFile:         t.ConfigParams.UpstreamTyphaCAFile,
+		CN:             t.ConfigParams.UpstreamTyphaCN,
+		URISAN:         t.ConfigParams.UpstreamTyphaURISAN,
+		MyHostname:     hostname,
+		MyVersion:      buildinfo.GitVersion,
+	}
+	return func(callbacks bapi.SyncerCallbacks) bapi.Syncer {
+		return upstream.New(upstreamConfig, syncerType, callbacks)
+	}
+}
+
 // CreateServer creates and configures (but does not start) the server components.
 func (t *TyphaDaemon) CreateServer() {
 	// Health monitoring, for liveness and readiness endpoints.
 	t.healthAggregator = health.NewHealthAggregator()
 
 	// Now create the Syncer and caching layer (one pipeline for each syncer we support).
-	t.addSyncerPipeline(syncproto.SyncerTypeFelix, t.DatastoreClient.FelixSyncerByIface)
-	t.addSyncerPipeline(syncproto.SyncerTypeBGP, t.DatastoreClient.BGPSyncerByIface)
-	t.addSyncerPipeline(syncproto.SyncerTypeTunnelIPAllocation, t.DatastoreClient.TunnelIPAllocationSyncerByIface)
-	t.addSyncerPipeline(syncproto.SyncerTypeNodeStatus, t.DatastoreClient.NodeStatusSyncerByIface)
+	if t.ConfigParams.UpstreamTyphaConfigured() {
+		// Leaf mode: each pipeline gets its data from the upstream Typha instead of the datastore.
+		for _, syncerType := range []syncproto.SyncerType{
+			syncproto.SyncerTypeFelix,
+			syncproto.SyncerTypeBGP,
+			syncproto.SyncerTypeTunnelIPAllocation,
+			syncproto.SyncerTypeNodeStatus,
+		} {
+			t.addSyncerPipeline(syncerType, t.newUpstreamSyncerFunc(syncerType))
+		}
+	} else {
+		t.addSyncerPipeline(syncproto.SyncerTypeFelix, t.DatastoreClient.FelixSyncerByIface)
+		t.addSyncerPipeline(syncproto.SyncerTypeBGP, t.DatastoreClient.BGPSyncerByIface)
+		t.addSyncerPipeline(syncproto.SyncerTypeTunnelIPAllocation, t.DatastoreClient.TunnelIPAllocationSyncerByIface)
+		t.addSyncerPipeline(syncproto.SyncerTypeNodeStatus, t.DatastoreClient.NodeStatusSyncerByIface)
+	}
 
 	// Create the server, which listens for connections from Felix.
 	t.Server = syncserver.New(
@@ -394,6 +448,7 @@ func (t *TyphaDaemon) CreateServer() {
 			PingInterval:                   t.ConfigParams.ServerPingIntervalSecs,
 			PongTimeout:                    t.ConfigParams.ServerPongTimeoutSecs,
 			DropInterval:                   t.ConfigParams.ConnectionDropIntervalSecs,
+			RebalanceInterval:              t.ConfigParams.ConnectionRebalancingIntervalSecs,
 			MaxConns:                       t.ConfigParams.MaxConnectionsUpperLimit,
 			Port:                           t.ConfigParams.ServerPort,
 			HealthAggregator:               t.healthAggregator,
@@ -187,6 +187,40 @@ var _ = Describe("Daemon", func() {
 			})
 		})
 
+		Describe("with an upstream Typha configured", func() {
+			BeforeEach(func() {
+				leafConfig := append(configContents, []byte("UpstreamTyphaAddr=127.0.0.1:5473\n")...)
+				err := ioutil.WriteFile(configFile.Name(), leafConfig, 0644)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			JustBeforeEach(func() {
+				err := d.LoadConfiguration(cxt)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(loggingConfigured).To(BeTrue())
+			})
+
+			It("should not connect to the datastore", func() {
+				Expect(d.ConfigParams.UpstreamTyphaAddr).To(Equal("127.0.0.1:5473"))
+				Expect(d.DatastoreClient).To(BeNil())
+				Consistently(datastore.getNumInitCalls, checkTime, "1s").Should(Equal(0))
+			})
+
+			It("should create the server components without datastore syncers", func() {
+				d.CreateServer()
+				Expect(d.SyncerPipelines).To(HaveLen(4))
+				for _, p := range d.SyncerPipelines {
+					Expect(p.Syncer).ToNot(BeNil())
+					Expect(p.Cache).ToNot(BeNil())
+				}
+				Expect(d.Server).ToNot(BeNil())
+				Expect(datastore.bgpSyncerCalled).To(BeFalse())
+				Expect(datastore.felixSyncerCalled).To(BeFalse())
+				Expect(datastore.allocateTunnelIpSyncerCalled).To(BeFalse())
+				Expect(datastore.nodestatusSyncerCalled).To(BeFalse())
+			})
+		})
+
 		downSecsStr := strconv.Itoa(downSecs)
 
 		Describe("with datastore down for "+downSecsStr+"s", func() {
@@ -329,6 +363,11 @@ func (b *mockDatastore) BGPPeers() clientv3.BGPPeerInterface {
 	panic("not implemented")
 }
 
+// BGPFilters returns an interface for managing BGP filter resources.
+func (b *mockDatastore) BGPFilters() clientv3.BGPFilterInterface {
+	panic("not implemented")
+}
+
 // IPAM returns an interface for managing IP address assignment and releasing.
 func (b *mockDatastore) IPAM() ipam.Interface {
 	panic("not implemented")
@@ -354,6 +393,11 @@ func (b *mockDatastore) NetworkSets() clientv3.NetworkSetInterface {
 	panic("not implemented")
 }
 
+// PacketCaptures returns an interface for managing packet capture resources.
+func (b *mockDatastore) PacketCaptures() clientv3.PacketCaptureInterface {
+	panic("not implemented")
+}
+
 // KubeControllersConfiguration returns an interface for managing the kubecontrollers configuration resources.
 func (b *mockDatastore) KubeControllersConfiguration() clientv3.KubeControllersConfigurationInterface {
 	panic("not implemented")
@@ -372,6 +416,18 @@ func (m *mockDatastore) IPReservations() clientv3.IPReservationInterface {
 	panic("not implemented") // TODO: Implement
 }
 
+func (m *mockDatastore) Tiers() clientv3.TierInterface {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockDatastore) StagedGlobalNetworkPolicies() clientv3.StagedGlobalNetworkPolicyInterface {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockDatastore) StagedNetworkPolicies() clientv3.StagedNetworkPolicyInterface {
+	panic("not implemented") // TODO: Implement
+}
+
 func (b *mockDatastore) getNumInitCalls() int {
 	b.mutex.Lock()
 	defer b.mutex.Unlock()
@@ -20,15 +20,18 @@ import (
 	"fmt"
 	"math/rand"
 	"net"
+	"sort"
 
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
-
-	"github.com/projectcalico/calico/libcalico-go/lib/set"
 )
 
+// ZoneLabel is the well-known node label that holds the node's topology zone.
+const ZoneLabel = "topology.kubernetes.io/zone"
+
 var ErrServiceNotReady = errors.New("Kubernetes service missing IP or port")
 
 type options struct {
@@ -39,6 +42,7 @@ type options struct {
 	k8sNamespace       string
 	k8sServicePortName string
 	inCluster          bool
+	nodeName           string
 }
 
 type Option func(opts *options)
@@ -76,6 +80,14 @@ func WithKubeServicePortNameOverride(portName string) Option {
 	}
 }
 
+// WithNodeName tells discovery the name of our node so that it can prefer the Typhas that are in the
+// same zone (according to the nodes' topology.kubernetes.io/zone labels).
+func WithNodeName(nodeName string) Option {
+	return func(opts *options) {
+		opts.nodeName = nodeName
+	}
+}
+
 // DiscoverTyphaAddr tries to discover the best address to use to connect to Typha.
 //
 // If an AddrOverride is supplied then that takes precedence, otherwise, DiscoverTyphaAddr will
@@ -84,6 +96,20 @@ func WithKubeServicePortNameOverride(portName string) Option {
 //
 // Returns "" if typha is not enabled (i.e. fields are empty).
 func DiscoverTyphaAddr(opts ...Option) (string, error) {
+	addrs, err := DiscoverTyphaAddrs(opts...)
+	if err != nil || len(addrs) == 0 {
+		return "", err
+	}
+	logrus.WithField("choice", addrs[0]).Info("Chose Typha to connect to.")
+	return addrs[0], nil
+}
+
+// DiscoverTyphaAddrs is like DiscoverTyphaAddr but it returns all the ready Typha addresses, in the
+// order that they should be tried.  The order is random, so that clients spread their load across
+// the Typhas, except that, if WithNodeName is used, Typhas in the same zone as our node come first.
+//
+// Returns nil if typha is not enabled (i.e. fields are empty).
+func DiscoverTyphaAddrs(opts ...Option) ([]string, error) {
 	options := options{
 		k8sServicePortName: "calico-typha",
 	}
@@ -94,12 +120,12 @@ func DiscoverTyphaAddr(opts ...Option) (string, error) {
 
 	if options.addrOverride != "" {
 		// Explicit address; trumps other sources of config.
-		return options.addrOverride, nil
+		return []string{options.addrOverride}, nil
 	}
 
 	if options.k8sServiceName == "" {
 		// No explicit address, and no service name, not using Typha.
-		return "", nil
+		return nil, nil
 	}
 
 	// If we get here, we need to look up the Typha service using the k8s API.
@@ -108,15 +134,15 @@ func DiscoverTyphaAddr(opts ...Option) (string, error) {
 		k8sConf, err := rest.InClusterConfig()
 		if err != nil {
 			logrus.WithError(err).Error("Unable to create in-cluster Kubernetes config.")
-			return "", err
+			return nil, err
 		}
 		options.k8sClient, err = kubernetes.NewForConfig(k8sConf)
 		if err != nil {
 			logrus.WithError(err).Error("Unable to create Kubernetes client set.")
-			return "", err
+			return nil, err
 		}
 	} else if options.k8sClient == nil {
-		return "", errors.New("failed to look up Typha, no Kubernetes client available")
+		return nil, errors.New("failed to look up Typha, no Kubernetes client available")
 	}
 
 	// If we get here, we need to look up the Typha service endpoints using the k8s API.
@@ -124,10 +150,11 @@ func DiscoverTyphaAddr(opts ...Option) (string, error) {
 	eps, err := epClient.Get(context.Background(), options.k8sServiceName, v1.GetOptions{})
 	if err != nil {
 		logrus.WithError(err).Error("Unable to get Typha service endpoints from Kubernetes.")
-		return "", err
+		return nil, err
 	}
 
-	candidates := set.New()
+	// Map from Typha address to the name of the node that the Typha is running on (if known).
+	candidates := map[string]string{}
 
 	for _, subset := range eps.Subsets {
 		var portForOurVersion int32
@@ -145,25 +172,77 @@ func DiscoverTyphaAddr(opts ...Option) (string, error) {
 		// If we get here, this endpoint supports the typha port we're looking for.
 		for _, h := range subset.Addresses {
 			typhaAddr := net.JoinHostPort(h.IP, fmt.Sprint(portForOurVersion))
-			candidates.Add(typhaAddr)
+			nodeName := ""
+			if h.NodeName != nil {
+				nodeName = *h.NodeName
+			}
+			candidates[typhaAddr] = nodeName
 		}
 	}
 
-	if candidates.Len() == 0 {
+	if len(candidates) == 0 {
 		logrus.Error("Didn't find any ready Typha instances.")
-		return "", ErrServiceNotReady
+		return nil, ErrServiceNotReady
 	}
 
 	var addrs []string
-	candidates.Iter(func(item interface{}) error {
-		typhaAddr := item.(string)
+	for typhaAddr := range candidates {
 		addrs = append(addrs, typhaAddr)
-		return nil
+	}
+	// Map iteration order isn't a good source of randomness; sort and then shuffle.
+	sort.Strings(addrs)
+	rand.Shuffle(len(addrs), func(i, j int) {
+		addrs[i], addrs[j] = addrs[j], addrs[i]
 	})
+
+	if options.nodeName != "" {
+		addrs = preferLocalZone(options.k8sClient, options.nodeName, addrs, candidates)
+	}
 	logrus.WithField("addrs", addrs).Info("Found ready Typha addresses.")
-	n := rand.Intn(len(addrs))
-	chosenAddr := addrs[n]
-	logrus.WithField("choice", chosenAddr).Info("Chose Typha to connect to.")
 
-	return chosenAddr, nil
+	return addrs, nil
+}
+
+// preferLocalZone reorders addrs so that the Typhas that are in the same zone as our node come first,
+// preserving the order within each group.  If our node's zone can't be determined, addrs is returned
+// unchanged.  Rather than looking up each Typha's node, it lists the nodes in our zone, in one request.
+func preferLocalZone(client kubernetes.Interface, nodeName string, addrs []string, addrToNode map[string]string) []string {
+	node, err := client.CoreV1().Nodes().Get(context.Background(), nodeName, v1.GetOptions{})
+	if err != nil {
+		logrus.WithError(err).WithField("node", nodeName).Warn(
+			"Unable to get our node to determine its zone, not ranking Typhas by zone.")
+		return addrs
+	}
+	ourZone := node.Labels[ZoneLabel]
+	if ourZone == "" {
+		logrus.WithField("node", nodeName).Debug("Our node has no zone, not ranking Typhas by zone.")
+		return addrs
+	}
+
+	nodesInZone, err := client.CoreV1().Nodes().List(context.Background(), v1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{ZoneLabel: ourZone}).String(),
+	})
+	if err != nil {
+		logrus.WithError(err).WithField("zone", ourZone).Warn(
+			"Unable to list the nodes in our zone, not ranking Typhas by zone.")
+		return addrs
+	}
+	sameZoneNodes := map[string]bool{}
+	for _, n := range nodesInZone.Items {
+		sameZoneNodes[n.Name] = true
+	}
+
+	var sameZone, otherZones []string
+	for _, addr := range addrs {
+		if sameZoneNodes[addrToNode[addr]] {
+			sameZone = append(sameZone, addr)
+		} else {
+			otherZones = append(otherZones, addr)
+		}
+	}
+	logrus.WithFields(logrus.Fields{
+		"zone":     ourZone,
+		"sameZone": sameZone,
+	}).Debug("Ranked Typhas by zone.")
+	return append(sameZone, otherZones...)
 }
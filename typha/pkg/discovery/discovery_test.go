@@ -154,4 +154,103 @@ var _ = Describe("Typha address discovery", func() {
 		}
 		Fail(fmt.Sprintf("Didn't get expected values; got %v", seenAddresses))
 	})
+	It("should return all the ready Typhas", func() {
+		addrs, err := DiscoverTyphaAddrs(
+			WithKubeService("kube-system", "calico-typha-service"),
+			WithKubeClient(k8sClient),
+			WithKubeServicePortNameOverride("calico-typha-v2"),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(addrs).To(ConsistOf("10.0.0.4:8157", "10.0.0.2:8157"))
+	})
+
+	It("should return only the override if configured", func() {
+		addrs, err := DiscoverTyphaAddrs(
+			WithAddrOverride("10.0.0.1:8080"),
+			WithKubeService("kube-system", "calico-typha-service"),
+			WithKubeClient(k8sClient),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(addrs).To(Equal([]string{"10.0.0.1:8080"}))
+	})
+
+	Describe("with nodes in different zones", func() {
+		node := func(name, zone string) *v1.Node {
+			n := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+			if zone != "" {
+				n.Labels = map[string]string{ZoneLabel: zone}
+			}
+			return n
+		}
+		nodeName := func(name string) *string {
+			return &name
+		}
+
+		BeforeEach(func() {
+			endpoints.Subsets[1].Addresses = []v1.EndpointAddress{
+				{IP: "10.0.0.2", NodeName: nodeName("node-a1")},
+				{IP: "10.0.0.6", NodeName: nodeName("node-b1")},
+				{IP: "10.0.0.7", NodeName: nodeName("node-a2")},
+				{IP: "10.0.0.8"},
+			}
+			k8sClient = fake.NewSimpleClientset(
+				endpoints,
+				node("node-a1", "zone-a"),
+				node("node-a2", "zone-a"),
+				node("node-a3", "zone-a"),
+				node("node-b1", "zone-b"),
+				node("node-none", ""),
+			)
+		})
+
+		It("should rank the Typhas in our zone first", func() {
+			for i := 0; i < 10; i++ {
+				addrs, err := DiscoverTyphaAddrs(
+					WithKubeService("kube-system", "calico-typha-service"),
+					WithKubeClient(k8sClient),
+					WithNodeName("node-a3"),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(addrs).To(HaveLen(4))
+				Expect(addrs[:2]).To(ConsistOf("10.0.0.2:8156", "10.0.0.7:8156"))
+				Expect(addrs[2:]).To(ConsistOf("10.0.0.6:8156", "10.0.0.8:8156"))
+			}
+		})
+
+		It("should look up the nodes with one get and one list", func() {
+			_, err := DiscoverTyphaAddrs(
+				WithKubeService("kube-system", "calico-typha-service"),
+				WithKubeClient(k8sClient),
+				WithNodeName("node-a3"),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			var nodeVerbs []string
+			for _, a := range k8sClient.Actions() {
+				if a.GetResource().Resource == "nodes" {
+					nodeVerbs = append(nodeVerbs, a.GetVerb())
+				}
+			}
+			Expect(nodeVerbs).To(Equal([]string{"get", "list"}))
+		})
+
+		It("should return all the Typhas if our node has no zone", func() {
+			addrs, err := DiscoverTyphaAddrs(
+				WithKubeService("kube-system", "calico-typha-service"),
+				WithKubeClient(k8sClient),
+				WithNodeName("node-none"),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(addrs).To(ConsistOf("10.0.0.2:8156", "10.0.0.6:8156", "10.0.0.7:8156", "10.0.0.8:8156"))
+		})
+
+		It("should return all the Typhas if our node doesn't exist", func() {
+			addrs, err := DiscoverTyphaAddrs(
+				WithKubeService("kube-system", "calico-typha-service"),
+				WithKubeClient(k8sClient),
+				WithNodeName("missing"),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(addrs).To(HaveLen(4))
+		})
+	})
 })
@@ -25,6 +25,7 @@ import (
 
 type MaxConnsAPI interface {
 	SetMaxConns(numConns int)
+	SetRebalanceTarget(numConnsPerSyncerType int)
 }
 
 type K8sAPI interface {
@@ -43,6 +44,7 @@ func PollK8sForConnectionLimit(
 	logCxt := log.WithField("thread", "k8s-poll")
 	logCxt.Info("Kubernetes poll goroutine started.")
 	activeTarget := configParams.MaxConnectionsUpperLimit
+	activeRebalanceTarget := 0
 	for {
 		select {
 		case <-tickerC:
@@ -78,6 +80,20 @@ func PollK8sForConnectionLimit(
 				server.SetMaxConns(target)
 				activeTarget = target
 			}
+
+			rebalanceTarget := 0
+			if tErr == nil && nErr == nil {
+				rebalanceTarget = CalculateRebalanceTarget(configParams, numTyphas, numNodes)
+			}
+			if rebalanceTarget != activeRebalanceTarget {
+				logCxt.WithFields(log.Fields{
+					"numTyphas": numTyphas,
+					"numNodes":  numNodes,
+					"newTarget": rebalanceTarget,
+				}).Info("Calculated new rebalance target.")
+				server.SetRebalanceTarget(rebalanceTarget)
+				activeRebalanceTarget = rebalanceTarget
+			}
 		case <-cxt.Done():
 			logCxt.Info("Context finished")
 			return
@@ -108,3 +124,16 @@ func CalculateMaxConnLimit(configParams *config.Config, numTyphas, numNodes, num
 	}
 	return
 }
+
+// CalculateRebalanceTarget calculates the number of connections of each syncer type that this Typha should
+// serve so that the load is spread evenly across the Typhas.  Each node makes at most one connection of each
+// syncer type so our fair share is 1/numTyphas of the nodes.  We add some headroom to avoid shedding
+// connections over small imbalances.  Returns 0 (no target) if rebalancing is disabled or if we're the only
+// Typha.
+func CalculateRebalanceTarget(configParams *config.Config, numTyphas, numNodes int) int {
+	if configParams.ConnectionRebalancingIntervalSecs <= 0 || numTyphas <= 1 || numNodes <= 0 {
+		return 0
+	}
+	fairShare := (numNodes + numTyphas - 1) / numTyphas
+	return fairShare * (100 + configParams.ConnectionRebalancingHeadroomPercent) / 100
+}
@@ -45,6 +45,28 @@ var _ = DescribeTable("CalculateMaxConnLimit tests",
 	Entry("Upper limit", 2, 500, 101, "configured upper limit"),
 )
 
+var _ = DescribeTable("CalculateRebalanceTarget tests",
+	func(numTyphas, numNodes, expectedNumber int) {
+		configParams := &config.Config{
+			ConnectionRebalancingIntervalSecs:    5 * time.Second,
+			ConnectionRebalancingHeadroomPercent: 10,
+		}
+		Expect(CalculateRebalanceTarget(configParams, numTyphas, numNodes)).To(Equal(expectedNumber))
+	},
+	Entry("Single Typha", 1, 10, 0),
+	Entry("No nodes", 3, 0, 0),
+	Entry("Even split", 4, 1000, 275),
+	Entry("Uneven split rounds up", 3, 1000, 367),
+	Entry("Scale up", 10, 1000, 110),
+)
+
+var _ = Describe("CalculateRebalanceTarget with rebalancing disabled", func() {
+	It("should return 0", func() {
+		configParams := &config.Config{ConnectionRebalancingHeadroomPercent: 10}
+		Expect(CalculateRebalanceTarget(configParams, 4, 1000)).To(Equal(0))
+	})
+})
+
 var _ = Describe("Poll loop tests", func() {
 	var tickerC chan time.Time
 	var cxt context.Context
@@ -61,6 +83,9 @@ var _ = Describe("Poll loop tests", func() {
 			K8sPortName:              "port",
 			MaxConnectionsUpperLimit: 101,
 			MaxConnectionsLowerLimit: 11,
+
+			ConnectionRebalancingIntervalSecs:    5 * time.Second,
+			ConnectionRebalancingHeadroomPercent: 10,
 		}
 		k8sAPI = &dummyK8sAPI{
 			numTyphas: 5,
@@ -95,6 +120,20 @@ var _ = Describe("Poll loop tests", func() {
 		tickerC <- time.Now()
 		Eventually(server.MaxConns).Should(Equal([]int{93, 101}))
 	})
+	It("should set the rebalance target", func() {
+		tickerC <- time.Now()
+		Eventually(server.RebalanceTargets).Should(Equal([]int{22}))
+		k8sAPI.numTyphas = 10
+		tickerC <- time.Now()
+		Eventually(server.RebalanceTargets).Should(Equal([]int{22, 11}))
+	})
+	It("should disable rebalancing on error", func() {
+		tickerC <- time.Now()
+		Eventually(server.RebalanceTargets).Should(Equal([]int{22}))
+		k8sAPI.numTyphasErr = errors.New("bad typha!")
+		tickerC <- time.Now()
+		Eventually(server.RebalanceTargets).Should(Equal([]int{22, 0}))
+	})
 	It("should increase limit to maximum on GetNumTyphas error", func() {
 		tickerC <- time.Now()
 		Eventually(server.MaxConns).Should(Equal([]int{93}))
@@ -112,8 +151,9 @@ var _ = Describe("Poll loop tests", func() {
 })
 
 type dummyServer struct {
-	L       sync.Mutex
-	targets []int
+	L                sync.Mutex
+	targets          []int
+	rebalanceTargets []int
 }
 
 func (s *dummyServer) SetMaxConns(numConns int) {
@@ -128,6 +168,18 @@ func (s *dummyServer) MaxConns() []int {
 	return s.targets
 }
 
+func (s *dummyServer) SetRebalanceTarget(numConns int) {
+	s.L.Lock()
+	defer s.L.Unlock()
+	s.rebalanceTargets = append(s.rebalanceTargets, numConns)
+}
+
+func (s *dummyServer) RebalanceTargets() []int {
+	s.L.Lock()
+	defer s.L.Unlock()
+	return s.rebalanceTargets
+}
+
 type dummyK8sAPI struct {
 	numTyphas    int
 	numTyphasErr error
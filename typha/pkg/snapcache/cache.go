@@ -15,6 +15,7 @@
 package snapcache
 
 import (
+	"bytes"
 	"context"
 	"sync"
 	"sync/atomic"
@@ -22,6 +23,7 @@ import (
 	"unsafe"
 
 	"github.com/Workiva/go-datastructures/trie/ctrie"
+	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 
@@ -34,8 +36,10 @@ import (
 )
 
 const (
-	defaultMaxBatchSize   = 100
-	defaultWakeUpInterval = time.Second
+	defaultMaxBatchSize          = 100
+	defaultWakeUpInterval        = time.Second
+	defaultMaxEncodedSnapshotAge = 10 * time.Second
+	defaultResumeRetention       = time.Minute
 )
 
 var (
@@ -63,6 +67,18 @@ var (
 		Name: "typha_updates_skipped",
 		Help: "Total number of updates skipped as duplicates.",
 	})
+	summarySnapshotEncodeTime = cprometheus.NewSummary(prometheus.SummaryOpts{
+		Name: "typha_snapshot_encode_secs",
+		Help: "How long it took to encode each shared, compressed snapshot.",
+	})
+	gaugeEncodedSnapshotSize = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "typha_snapshot_encoded_size_bytes",
+		Help: "Size of the most recent shared, compressed snapshot.",
+	})
+	gaugeRetainedBreadcrumbs = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "typha_breadcrumbs_retained",
+		Help: "Number of recent breadcrumbs retained so that reconnecting clients can resume from them.",
+	})
 )
 
 func init() {
@@ -72,6 +88,9 @@ func init() {
 	prometheus.MustRegister(counterBreadcrumbBlock)
 	prometheus.MustRegister(counterUpdatesTotal)
 	prometheus.MustRegister(counterUpdatesSkipped)
+	prometheus.MustRegister(summarySnapshotEncodeTime)
+	prometheus.MustRegister(gaugeEncodedSnapshotSize)
+	prometheus.MustRegister(gaugeRetainedBreadcrumbs)
 }
 
 // SnapshotCache consumes updates from the Syncer API and caches them in the form of a series of
@@ -109,8 +128,26 @@ func init() {
 // to one slow client) and keep track of what we'd sent to each channel.  All doable but, I think,
 // more fiddly than using a non-blocking linked list and a condition variable and letting each
 // client look after itself.
+//
+// Encoded snapshots
+//
+// Clients that support compression receive the snapshot as a compressed byte stream that doesn't
+// depend on the state of their connection.  Rather than encoding the snapshot once per client,
+// CurrentEncodedSnapshot encodes the snapshot of the current Breadcrumb and shares the result with
+// every client that asks for it until it is older than MaxEncodedSnapshotAge.  A client that receives
+// an older snapshot catches up by following the Breadcrumbs from the snapshot's Breadcrumb, just as
+// if it had taken a while to send the snapshot.
+//
+// Resuming
+//
+// The Cache retains the Breadcrumbs that were superseded less than ResumeRetention ago so that a
+// client that reconnects after a brief outage can pick up from the last Breadcrumb that it saw
+// (see RetainedBreadcrumb) instead of receiving a new snapshot.  Retaining the oldest of those
+// Breadcrumbs keeps all the later ones alive anyway, since they're linked together.  Sequence
+// numbers are only meaningful within one instance of the Cache, which is identified by ID().
 type Cache struct {
 	config Config
+	id     string
 
 	inputC chan interface{}
 
@@ -129,6 +166,16 @@ type Cache struct {
 
 	wakeUpTicker *jitter.Ticker
 	healthTicks  <-chan time.Time
+
+	// encodedSnapshotLock protects encodedSnapshot; it is held while encoding so that concurrent
+	// callers wait for, and share, the result.
+	encodedSnapshotLock sync.Mutex
+	encodedSnapshot     *EncodedSnapshot
+
+	// retainedLock protects retainedBreadcrumbs, which holds the recent Breadcrumbs, with consecutive
+	// sequence numbers, oldest first.
+	retainedLock        sync.Mutex
+	retainedBreadcrumbs []*Breadcrumb
 }
 
 const (
@@ -142,10 +189,12 @@ type healthAggregator interface {
 }
 
 type Config struct {
-	MaxBatchSize     int
-	WakeUpInterval   time.Duration
-	HealthAggregator healthAggregator
-	HealthName       string
+	MaxBatchSize          int
+	WakeUpInterval        time.Duration
+	MaxEncodedSnapshotAge time.Duration
+	ResumeRetention       time.Duration
+	HealthAggregator      healthAggregator
+	HealthName            string
 }
 
 func (config *Config) ApplyDefaults() {
@@ -163,6 +212,20 @@ func (config *Config) ApplyDefaults() {
 		}).Info("Defaulting WakeUpInterval.")
 		config.WakeUpInterval = defaultWakeUpInterval
 	}
+	if config.MaxEncodedSnapshotAge <= 0 {
+		log.WithFields(log.Fields{
+			"value":   config.MaxEncodedSnapshotAge,
+			"default": defaultMaxEncodedSnapshotAge,
+		}).Info("Defaulting MaxEncodedSnapshotAge.")
+		config.MaxEncodedSnapshotAge = defaultMaxEncodedSnapshotAge
+	}
+	if config.ResumeRetention <= 0 {
+		log.WithFields(log.Fields{
+			"value":   config.ResumeRetention,
+			"default": defaultResumeRetention,
+		}).Info("Defaulting ResumeRetention.")
+		config.ResumeRetention = defaultResumeRetention
+	}
 	if config.HealthName == "" {
 		config.HealthName = healthNameDefault
 	}
@@ -179,12 +242,15 @@ func New(config Config) *Cache {
 	}
 	c := &Cache{
 		config:            config,
+		id:                uuid.NewString(),
 		inputC:            make(chan interface{}, config.MaxBatchSize*2),
 		breadcrumbCond:    cond,
 		kvs:               kvs,
 		currentBreadcrumb: (unsafe.Pointer)(snap),
 		wakeUpTicker:      jitter.NewTicker(config.WakeUpInterval, config.WakeUpInterval/10),
 		healthTicks:       time.NewTicker(healthInterval).C,
+
+		retainedBreadcrumbs: []*Breadcrumb{snap},
 	}
 	if config.HealthAggregator != nil {
 		config.HealthAggregator.RegisterReporter(config.HealthName, &health.HealthReport{Live: true, Ready: true}, healthInterval*2)
@@ -200,6 +266,113 @@ func (c *Cache) CurrentBreadcrumb() *Breadcrumb {
 	return (*Breadcrumb)(atomic.LoadPointer(&c.currentBreadcrumb))
 }
 
+// ID returns the unique ID of this instance of the cache.  A client can only resume from a
+// Breadcrumb in the same instance.
+func (c *Cache) ID() string {
+	return c.id
+}
+
+// RetainedBreadcrumb returns the Breadcrumb with the given sequence number if it is still retained,
+// or nil otherwise.  It is safe to call from any goroutine.
+func (c *Cache) RetainedBreadcrumb(seqNo uint64) *Breadcrumb {
+	c.retainedLock.Lock()
+	defer c.retainedLock.Unlock()
+	oldest := c.retainedBreadcrumbs[0].SequenceNumber
+	if seqNo < oldest || seqNo-oldest >= uint64(len(c.retainedBreadcrumbs)) {
+		return nil
+	}
+	return c.retainedBreadcrumbs[seqNo-oldest]
+}
+
+// retainBreadcrumb adds the new Breadcrumb to the retained Breadcrumbs and drops the ones that
+// were superseded more than ResumeRetention ago.
+func (c *Cache) retainBreadcrumb(crumb *Breadcrumb) {
+	c.retainedLock.Lock()
+	defer c.retainedLock.Unlock()
+	c.retainedBreadcrumbs = append(c.retainedBreadcrumbs, crumb)
+	cutOff := crumb.Timestamp.Add(-c.config.ResumeRetention)
+	numToDrop := 0
+	for numToDrop < len(c.retainedBreadcrumbs)-1 && c.retainedBreadcrumbs[numToDrop+1].Timestamp.Before(cutOff) {
+		numToDrop++
+	}
+	if numToDrop > 0 {
+		// Copy rather than re-slicing so that the dropped Breadcrumbs can be garbage collected.
+		c.retainedBreadcrumbs = append([]*Breadcrumb(nil), c.retainedBreadcrumbs[numToDrop:]...)
+	}
+	gaugeRetainedBreadcrumbs.Set(float64(len(c.retainedBreadcrumbs)))
+}
+
+// EncodedSnapshot is the snapshot from a Breadcrumb, encoded as a compressed stream by a
+// syncproto.SnapshotEncoder.
+type EncodedSnapshot struct {
+	Breadcrumb  *Breadcrumb
+	Compression syncproto.CompressionAlgorithm
+	MaxMsgSize  int
+	NumKVs      int
+	Data        []byte
+}
+
+// CurrentEncodedSnapshot returns a recent snapshot, encoded with the given compression algorithm and
+// maximum number of KVs per message.  To avoid encoding the snapshot for every client, it returns the
+// previously encoded snapshot unless that is older than MaxEncodedSnapshotAge.  It is safe to call from
+// any goroutine.
+func (c *Cache) CurrentEncodedSnapshot(alg syncproto.CompressionAlgorithm, maxMsgSize int) (*EncodedSnapshot, error) {
+	c.encodedSnapshotLock.Lock()
+	defer c.encodedSnapshotLock.Unlock()
+
+	crumb := c.CurrentBreadcrumb()
+	if snap := c.encodedSnapshot; snap != nil &&
+		snap.Compression == alg &&
+		snap.MaxMsgSize == maxMsgSize &&
+		(snap.Breadcrumb == crumb || time.Since(snap.Breadcrumb.Timestamp) < c.config.MaxEncodedSnapshotAge) {
+		return snap, nil
+	}
+
+	snap, err := encodeSnapshot(crumb, alg, maxMsgSize)
+	if err != nil {
+		return nil, err
+	}
+	c.encodedSnapshot = snap
+	return snap, nil
+}
+
+func encodeSnapshot(crumb *Breadcrumb, alg syncproto.CompressionAlgorithm, maxMsgSize int) (*EncodedSnapshot, error) {
+	startTime := time.Now()
+	var buf bytes.Buffer
+	encoder, err := syncproto.NewSnapshotEncoder(&buf, alg, maxMsgSize)
+	if err != nil {
+		return nil, err
+	}
+	snap := &EncodedSnapshot{
+		Breadcrumb:  crumb,
+		Compression: alg,
+		MaxMsgSize:  maxMsgSize,
+	}
+	// cancelC is used to ensure that the iterator's goroutine gets cleaned up if we return early.
+	cancelC := make(chan struct{})
+	defer close(cancelC)
+	for entry := range crumb.KVs.Iterator(cancelC) {
+		if err := encoder.Add(entry.Value.(syncproto.SerializedUpdate)); err != nil {
+			return nil, err
+		}
+		snap.NumKVs++
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	snap.Data = buf.Bytes()
+
+	summarySnapshotEncodeTime.Observe(time.Since(startTime).Seconds())
+	gaugeEncodedSnapshotSize.Set(float64(len(snap.Data)))
+	log.WithFields(log.Fields{
+		"seqNo":     crumb.SequenceNumber,
+		"numKVs":    snap.NumKVs,
+		"sizeBytes": len(snap.Data),
+		"duration":  time.Since(startTime),
+	}).Info("Encoded snapshot")
+	return snap, nil
+}
+
 // OnStatusUpdated implements the SyncerCallbacks API.  It shouldn't be called directly.
 func (c *Cache) OnStatusUpdated(status api.SyncStatus) {
 	c.inputC <- status
@@ -387,6 +560,9 @@ func (c *Cache) publishBreadcrumb() {
 	// Add the new read-only snapshot to the new crumb.
 	newCrumb.KVs = c.kvs.ReadOnlySnapshot()
 
+	// Retain the new crumb before publishing it so that any client that has seen it can resume from it.
+	c.retainBreadcrumb(newCrumb)
+
 	// Replace the Breadcrumb and link the old Breadcrumb to the new so that clients can follow
 	// the trail.
 	log.WithField("seqNo", oldCrumb.SequenceNumber).Debug("Acquiring Breadcrumb lock")
@@ -15,6 +15,8 @@
 package snapcache_test
 
 import (
+	"bytes"
+	"encoding/gob"
 	"strconv"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -279,6 +281,51 @@ var _ = Describe("Snapshot cache FV tests", func() {
 			Expect(deserialiseUpdates(crumb.Deltas)).To(ConsistOf(updateBiff))
 		})
 
+		It("should share the encoded snapshot until it is too old", func() {
+			snap, err := cache.CurrentEncodedSnapshot(syncproto.CompressionDeflate, 100)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snap.Breadcrumb).To(BeIdenticalTo(crumb))
+			Expect(snap.NumKVs).To(Equal(1))
+			Expect(decodeSnapshot(snap)).To(ConsistOf(updateFooBarRev10))
+
+			// Asking again should return the same encoded snapshot.
+			Expect(cache.CurrentEncodedSnapshot(syncproto.CompressionDeflate, 100)).To(BeIdenticalTo(snap))
+
+			// Even after a new breadcrumb, the snapshot should be reused while it is recent.
+			updateBiff := api.Update{
+				KVPair: model.KVPair{
+					Key:      model.GlobalConfigKey{Name: "biff"},
+					Value:    "baz",
+					Revision: "12",
+				},
+				UpdateType: api.UpdateTypeKVNew,
+			}
+			cache.OnUpdates([]api.Update{updateBiff})
+			_, err = crumb.Next(cxt)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cache.CurrentEncodedSnapshot(syncproto.CompressionDeflate, 100)).To(BeIdenticalTo(snap))
+
+			// A different message size needs a new encoding, which should include the new KV.
+			snap2, err := cache.CurrentEncodedSnapshot(syncproto.CompressionDeflate, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(snap2).NotTo(BeIdenticalTo(snap))
+			Expect(decodeSnapshot(snap2)).To(ConsistOf(updateFooBarRev10, updateBiff))
+		})
+
+		It("should retain recent breadcrumbs for resuming", func() {
+			Expect(cache.RetainedBreadcrumb(crumb.SequenceNumber)).To(BeIdenticalTo(crumb))
+			Expect(cache.RetainedBreadcrumb(0)).NotTo(BeNil())
+			Expect(cache.RetainedBreadcrumb(crumb.SequenceNumber + 1)).To(BeNil())
+
+			Expect(cache.ID()).NotTo(BeEmpty())
+			Expect(snapcache.New(cacheConfig).ID()).NotTo(Equal(cache.ID()))
+		})
+
+		It("should reject an unknown compression algorithm", func() {
+			_, err := cache.CurrentEncodedSnapshot("unknown", 100)
+			Expect(err).To(HaveOccurred())
+		})
+
 		It("should coalesce the update types of non-idempotent updates", func() {
 			// Then send in another update with a new value.
 			kvFooUpdatedRev11 := model.KVPair{
@@ -627,6 +674,52 @@ func (f *follower) Loop(cxt context.Context) {
 	logCxt.WithError(cxt.Err()).WithField("crumb", crumb.SequenceNumber).Info("Exiting")
 }
 
+var _ = Describe("With a short resume retention", func() {
+	var cache *snapcache.Cache
+	var cxt context.Context
+	var cancel context.CancelFunc
+
+	BeforeEach(func() {
+		cache = snapcache.New(snapcache.Config{
+			WakeUpInterval:  10 * time.Second,
+			ResumeRetention: 100 * time.Millisecond,
+		})
+		cxt, cancel = context.WithCancel(context.Background())
+		cache.Start(cxt)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	sendUpdate := func(name string) *snapcache.Breadcrumb {
+		crumb := cache.CurrentBreadcrumb()
+		cache.OnUpdates([]api.Update{{
+			KVPair: model.KVPair{
+				Key:      model.GlobalConfigKey{Name: name},
+				Value:    "bar",
+				Revision: "1",
+			},
+			UpdateType: api.UpdateTypeKVNew,
+		}})
+		crumb, err := crumb.Next(cxt)
+		Expect(err).NotTo(HaveOccurred())
+		return crumb
+	}
+
+	It("should drop breadcrumbs that were superseded too long ago", func() {
+		crumb1 := sendUpdate("foo")
+		Expect(cache.RetainedBreadcrumb(0)).NotTo(BeNil())
+
+		time.Sleep(200 * time.Millisecond)
+		crumb2 := sendUpdate("biff")
+		Expect(cache.RetainedBreadcrumb(0)).To(BeNil())
+		// The first crumb was only superseded just now so a client could still resume from it.
+		Expect(cache.RetainedBreadcrumb(crumb1.SequenceNumber)).To(BeIdenticalTo(crumb1))
+		Expect(cache.RetainedBreadcrumb(crumb2.SequenceNumber)).To(BeIdenticalTo(crumb2))
+	})
+})
+
 var _ = Describe("Zero config after applying defaults", func() {
 	var config snapcache.Config
 
@@ -641,6 +734,12 @@ var _ = Describe("Zero config after applying defaults", func() {
 	It("should default the wake up interval", func() {
 		Expect(config.WakeUpInterval).To(Equal(time.Second))
 	})
+	It("should default the max encoded snapshot age", func() {
+		Expect(config.MaxEncodedSnapshotAge).To(Equal(10 * time.Second))
+	})
+	It("should default the resume retention", func() {
+		Expect(config.ResumeRetention).To(Equal(time.Minute))
+	})
 })
 
 var _ = Describe("Non-zero config after applying defaults", func() {
@@ -671,3 +770,20 @@ func deserialiseUpdates(serializedUpdates []syncproto.SerializedUpdate) []api.Up
 	}
 	return updates
 }
+
+func decodeSnapshot(snap *snapcache.EncodedSnapshot) []api.Update {
+	r, err := syncproto.NewDecompressingReader(bytes.NewReader(snap.Data), snap.Compression)
+	Expect(err).NotTo(HaveOccurred())
+	decoder := gob.NewDecoder(r)
+	var updates []api.Update
+	for {
+		var envelope syncproto.Envelope
+		Expect(decoder.Decode(&envelope)).To(Succeed())
+		if _, ok := envelope.Message.(syncproto.MsgDecoderRestart); ok {
+			return updates
+		}
+		msg := envelope.Message.(syncproto.MsgKVs)
+		Expect(len(msg.KVs)).To(BeNumerically("<=", snap.MaxMsgSize))
+		updates = append(updates, deserialiseUpdates(msg.KVs)...)
+	}
+}
@@ -15,12 +15,14 @@
 package syncclient
 
 import (
+	"bufio"
 	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/gob"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"sync"
@@ -36,8 +38,10 @@ import (
 var nextID uint64
 
 const (
-	defaultReadtimeout  = 30 * time.Second
-	defaultWriteTimeout = 10 * time.Second
+	defaultReadtimeout       = 30 * time.Second
+	defaultWriteTimeout      = 10 * time.Second
+	defaultMinConnectBackoff = 100 * time.Millisecond
+	defaultMaxConnectBackoff = 5 * time.Second
 )
 
 type Options struct {
@@ -49,6 +53,21 @@ type Options struct {
 	ServerCN     string
 	ServerURISAN string
 	SyncerType   syncproto.SyncerType
+
+	// DisableCompression stops the client from offering to receive a compressed stream.
+	DisableCompression bool
+
+	// MinConnectBackoff and MaxConnectBackoff bound the delay before trying the next Typha address
+	// after a failed connection attempt.  The delay doubles after each failure and is reset when a
+	// connection succeeds.
+	MinConnectBackoff time.Duration
+	MaxConnectBackoff time.Duration
+
+	// ResumeFrom, if set, is the ResumePoint of a previous client (see SyncerClient.ResumePoint).  The
+	// client asks the server to resume from that point instead of sending a snapshot.  If the server
+	// can't, the client disconnects and ResumeRejected returns true; the caller should then connect
+	// without ResumeFrom and handle the new snapshot.
+	ResumeFrom syncproto.ResumePoint
 }
 
 func (o *Options) readTimeout() time.Duration {
@@ -65,6 +84,20 @@ func (o *Options) writeTimeout() time.Duration {
 	return o.WriteTimeout
 }
 
+func (o *Options) minConnectBackoff() time.Duration {
+	if o == nil || o.MinConnectBackoff <= 0 {
+		return defaultMinConnectBackoff
+	}
+	return o.MinConnectBackoff
+}
+
+func (o *Options) maxConnectBackoff() time.Duration {
+	if o == nil || o.MaxConnectBackoff <= 0 {
+		return defaultMaxConnectBackoff
+	}
+	return o.MaxConnectBackoff
+}
+
 func (o *Options) requiringTLS() bool {
 	// True if any of the TLS parameters are set.
 	requiringTLS := o != nil && o.KeyFile+o.CertFile+o.CAFile+o.ServerCN+o.ServerURISAN != ""
@@ -95,6 +128,21 @@ func New(
 	cbs api.SyncerCallbacks,
 	options *Options,
 ) *SyncerClient {
+	return NewWithAddrs([]string{addr}, myVersion, myHostname, myInfo, cbs, options)
+}
+
+// NewWithAddrs creates a client that knows several Typha addresses, in order of preference, such
+// as those returned by discovery.DiscoverTyphaAddrs.  When an attempt to connect fails, the client
+// backs off and then tries the next address, cycling through the list.
+func NewWithAddrs(
+	addrs []string,
+	myVersion, myHostname, myInfo string,
+	cbs api.SyncerCallbacks,
+	options *Options,
+) *SyncerClient {
+	if len(addrs) == 0 {
+		log.Panic("No Typha addresses supplied")
+	}
 	if err := options.validate(); err != nil {
 		log.WithField("options", options).WithError(err).Fatal("Invalid options")
 	}
@@ -110,7 +158,8 @@ func New(
 			"type":   options.SyncerType,
 		}),
 		callbacks: cbs,
-		addr:      addr,
+		addrs:     addrs,
+		addr:      addrs[0],
 
 		myVersion:  myVersion,
 		myHostname: myHostname,
@@ -120,22 +169,42 @@ func New(
 		handshakeStatus: &handshakeStatus{
 			helloReceivedChan: make(chan struct{}, 1),
 		},
+		resumePoint: options.ResumeFrom,
 	}
 }
 
 type SyncerClient struct {
 	ID                            uint64
 	logCxt                        *log.Entry
-	addr                          string
 	myHostname, myVersion, myInfo string
 	options                       *Options
 
-	connection                  net.Conn
-	encoder                     *gob.Encoder
+	// addrs holds the Typha addresses that we know about, in order of preference.  nextAddrIdx is the
+	// index of the address to try next and addr is the address that we're connected to (or were last
+	// trying).
+	addrs          []string
+	nextAddrIdx    int
+	addr           string
+	connectBackoff time.Duration
+
+	connection net.Conn
+	encoder    *gob.Encoder
+	// reader buffers reads from the connection.  Since it is an io.ByteReader, neither gob nor the
+	// decompressor read past the end of their stream, so we can restart the decoder on the same reader.
+	reader                      *bufio.Reader
+	decompressor                io.ReadCloser
+	compression                 syncproto.CompressionAlgorithm
 	decoder                     *gob.Decoder
 	handshakeStatus             *handshakeStatus
 	supportsNodeResourceUpdates bool
 
+	// resumePoint is the last ResumePoint that we received from the server (and applied).  It is protected
+	// by resumePointLock.  resumeRejected is set if we asked to resume but the server couldn't resume from
+	// our ResumePoint.
+	resumePointLock sync.Mutex
+	resumePoint     syncproto.ResumePoint
+	resumeRejected  bool
+
 	callbacks api.SyncerCallbacks
 	Finished  sync.WaitGroup
 }
@@ -176,6 +245,21 @@ func (s *SyncerClient) Start(cxt context.Context) error {
 	return nil
 }
 
+// ResumePoint returns the point in the server's stream of updates that the client has reached.  It is
+// zero if the server doesn't support resuming.  After the connection finishes, it can be passed to a new
+// client as Options.ResumeFrom.
+func (s *SyncerClient) ResumePoint() syncproto.ResumePoint {
+	s.resumePointLock.Lock()
+	defer s.resumePointLock.Unlock()
+	return s.resumePoint
+}
+
+// ResumeRejected returns true if the client asked to resume but the server couldn't resume from the
+// requested point.  It should only be called after Finished is done.
+func (s *SyncerClient) ResumeRejected() bool {
+	return s.resumeRejected
+}
+
 // SupportsNodeResourceUpdates waits for the Typha server to send a hello and returns true if
 // the server supports node resource updates. If the given timeout is reached, an error is returned.
 func (s *SyncerClient) SupportsNodeResourceUpdates(timeout time.Duration) (bool, error) {
@@ -196,8 +280,49 @@ func (s *SyncerClient) SupportsNodeResourceUpdates(timeout time.Duration) (bool,
 	return false, fmt.Errorf("Timed out waiting for handshake to complete")
 }
 
+// Addr returns the address of the Typha that the client is connected to.
+func (s *SyncerClient) Addr() string {
+	return s.addr
+}
+
+// connect tries each of our Typha addresses in turn, starting from the one after the last failure, until
+// one of them accepts our connection.  It backs off before each retry.  If all the addresses fail, it
+// returns the last error.
 func (s *SyncerClient) connect(cxt context.Context) error {
 	log.Info("Starting Typha client")
+	var err error
+	for i := 0; i < len(s.addrs); i++ {
+		if i > 0 {
+			if s.connectBackoff <= 0 {
+				s.connectBackoff = s.options.minConnectBackoff()
+			}
+			s.logCxt.WithField("backoff", s.connectBackoff).Info("Backing off before trying next Typha.")
+			select {
+			case <-cxt.Done():
+				return cxt.Err()
+			case <-time.After(s.connectBackoff):
+			}
+			s.connectBackoff *= 2
+			if max := s.options.maxConnectBackoff(); s.connectBackoff > max {
+				s.connectBackoff = max
+			}
+		}
+		s.addr = s.addrs[s.nextAddrIdx]
+		err = s.connectToAddr(cxt)
+		if err == nil {
+			s.connectBackoff = 0
+			return nil
+		}
+		if cxt.Err() != nil {
+			return err
+		}
+		s.logCxt.WithError(err).WithField("address", s.addr).Warn("Failed to connect to Typha.")
+		s.nextAddrIdx = (s.nextAddrIdx + 1) % len(s.addrs)
+	}
+	return err
+}
+
+func (s *SyncerClient) connectToAddr(cxt context.Context) error {
 	var err error
 	logCxt := s.logCxt.WithField("address", s.addr)
 
@@ -301,18 +426,26 @@ func (s *SyncerClient) loop(cxt context.Context, cancelFn context.CancelFunc) {
 	logCxt.Info("Started Typha client main loop")
 
 	s.encoder = gob.NewEncoder(s.connection)
-	s.decoder = gob.NewDecoder(s.connection)
+	s.reader = bufio.NewReader(s.connection)
+	s.decoder = gob.NewDecoder(s.reader)
 
 	ourSyncerType := s.options.SyncerType
 	if ourSyncerType == "" {
 		ourSyncerType = syncproto.SyncerTypeFelix
 	}
+	var compressionAlgorithms []syncproto.CompressionAlgorithm
+	if !s.options.DisableCompression {
+		compressionAlgorithms = syncproto.SupportedCompressionAlgorithms
+	}
 	err := s.sendMessageToServer(cxt, logCxt, "send hello to server",
 		syncproto.MsgClientHello{
-			Hostname:   s.myHostname,
-			Version:    s.myVersion,
-			Info:       s.myInfo,
-			SyncerType: ourSyncerType,
+			Hostname:                       s.myHostname,
+			Version:                        s.myVersion,
+			Info:                           s.myInfo,
+			SyncerType:                     ourSyncerType,
+			SupportedCompressionAlgorithms: compressionAlgorithms,
+			SupportsResume:                 true,
+			ResumeFrom:                     s.options.ResumeFrom,
 		},
 	)
 	if err != nil {
@@ -354,6 +487,18 @@ func (s *SyncerClient) loop(cxt context.Context, cancelFn context.CancelFunc) {
 				updates = append(updates, update)
 			}
 			s.callbacks.OnUpdates(updates)
+		case syncproto.MsgResumePoint:
+			logCxt.WithField("resumePoint", msg.ResumePoint).Debug("Resume point received from Typha")
+			s.resumePointLock.Lock()
+			s.resumePoint = msg.ResumePoint
+			s.resumePointLock.Unlock()
+		case syncproto.MsgDecoderRestart:
+			// End of the encoded snapshot; the rest of the connection is a new compressed stream.
+			logCxt.WithField("msg", msg.Message).Debug("Decoder restart received from Typha")
+			if err := s.restartDecoder(); err != nil {
+				logCxt.WithError(err).Error("Failed to restart decoder")
+				return
+			}
 		case syncproto.MsgServerHello:
 			logCxt.WithField("serverVersion", msg.Version).Info("Server hello message received")
 
@@ -377,8 +522,59 @@ func (s *SyncerClient) loop(cxt context.Context, cancelFn context.CancelFunc) {
 				logCxt.Errorf("We require SyncerType %s but Typha server doesn't support it.", ourSyncerType)
 				return
 			}
+
+			// If we asked to resume, the server must have agreed; otherwise it's about to send a snapshot.
+			if !s.options.ResumeFrom.IsZero() {
+				if !msg.Resumed {
+					logCxt.WithField("resumeFrom", s.options.ResumeFrom).Info(
+						"Typha couldn't resume from our resume point, disconnecting.")
+					s.resumeRejected = true
+					return
+				}
+				logCxt.WithField("resumeFrom", s.options.ResumeFrom).Info("Typha resumed from our resume point.")
+			}
+
+			// If the server chose a compression algorithm, everything after the hello is compressed.
+			if msg.CompressionAlgorithm != "" {
+				if syncproto.ChooseCompression([]syncproto.CompressionAlgorithm{msg.CompressionAlgorithm}) == "" ||
+					s.options.DisableCompression {
+					logCxt.WithField("compression", msg.CompressionAlgorithm).Error(
+						"Server chose a compression algorithm that we didn't offer.")
+					return
+				}
+				logCxt.WithField("compression", msg.CompressionAlgorithm).Info("Server enabled compression.")
+				s.compression = msg.CompressionAlgorithm
+				if err := s.restartDecoder(); err != nil {
+					logCxt.WithError(err).Error("Failed to start decoder")
+					return
+				}
+			}
+		}
+	}
+}
+
+// restartDecoder starts a new decompressor and gob decoder for the next stream from the server.
+func (s *SyncerClient) restartDecoder() error {
+	if s.compression == "" {
+		return errors.New("decoder restart on an uncompressed connection")
+	}
+	if s.decompressor != nil {
+		// Make sure that the old decompressor has consumed the whole of its stream (and no more)
+		// before we start reading the next one.
+		if _, err := io.Copy(ioutil.Discard, s.decompressor); err != nil {
+			return err
+		}
+		if err := s.decompressor.Close(); err != nil {
+			return err
 		}
 	}
+	decompressor, err := syncproto.NewDecompressingReader(s.reader, s.compression)
+	if err != nil {
+		return err
+	}
+	s.decompressor = decompressor
+	s.decoder = gob.NewDecoder(decompressor)
+	return nil
 }
 
 // sendMessageToServer sends a single value-type MsgXYZ object to the server.  It updates the connection's
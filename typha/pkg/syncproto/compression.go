@@ -0,0 +1,122 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncproto
+
+import (
+	"compress/flate"
+	"encoding/gob"
+	"fmt"
+	"io"
+)
+
+type CompressionAlgorithm string
+
+const (
+	CompressionDeflate CompressionAlgorithm = "deflate"
+)
+
+// SupportedCompressionAlgorithms lists the compression algorithms that this version supports, in order
+// of preference.
+var SupportedCompressionAlgorithms = []CompressionAlgorithm{CompressionDeflate}
+
+// ChooseCompression returns the first of our supported algorithms that the peer also supports, or "".
+func ChooseCompression(peerAlgorithms []CompressionAlgorithm) CompressionAlgorithm {
+	for _, ours := range SupportedCompressionAlgorithms {
+		for _, theirs := range peerAlgorithms {
+			if ours == theirs {
+				return ours
+			}
+		}
+	}
+	return ""
+}
+
+// CompressedWriter is a compressing io.Writer.  Flush writes out any buffered data so that the receiver
+// can decompress everything that has been written so far; Close ends the compressed stream.
+type CompressedWriter interface {
+	io.Writer
+	Flush() error
+	Close() error
+}
+
+// NewCompressedWriter returns a CompressedWriter that writes to w using the given algorithm.
+func NewCompressedWriter(w io.Writer, alg CompressionAlgorithm) (CompressedWriter, error) {
+	switch alg {
+	case CompressionDeflate:
+		return flate.NewWriter(w, flate.DefaultCompression)
+	}
+	return nil, fmt.Errorf("unsupported compression algorithm %q", alg)
+}
+
+// NewDecompressingReader returns a reader that decompresses a stream, written by a CompressedWriter,
+// from r.  Since r is an io.ByteReader, the returned reader doesn't read past the end of the
+// compressed stream so a new stream can be read from r once this one is exhausted.
+func NewDecompressingReader(r flate.Reader, alg CompressionAlgorithm) (io.ReadCloser, error) {
+	switch alg {
+	case CompressionDeflate:
+		return flate.NewReader(r), nil
+	}
+	return nil, fmt.Errorf("unsupported compression algorithm %q", alg)
+}
+
+// SnapshotEncoder writes a snapshot as a self-contained, compressed gob stream: a series of MsgKVs,
+// each with up to maxMsgSize KVs, followed by a MsgDecoderRestart.
+type SnapshotEncoder struct {
+	w          CompressedWriter
+	encoder    *gob.Encoder
+	maxMsgSize int
+	kvs        []SerializedUpdate
+}
+
+func NewSnapshotEncoder(w io.Writer, alg CompressionAlgorithm, maxMsgSize int) (*SnapshotEncoder, error) {
+	cw, err := NewCompressedWriter(w, alg)
+	if err != nil {
+		return nil, err
+	}
+	return &SnapshotEncoder{
+		w:          cw,
+		encoder:    gob.NewEncoder(cw),
+		maxMsgSize: maxMsgSize,
+	}, nil
+}
+
+// Add adds a KV to the snapshot.
+func (e *SnapshotEncoder) Add(kv SerializedUpdate) error {
+	e.kvs = append(e.kvs, kv)
+	if len(e.kvs) >= e.maxMsgSize {
+		return e.sendKVs()
+	}
+	return nil
+}
+
+func (e *SnapshotEncoder) sendKVs() error {
+	if len(e.kvs) == 0 {
+		return nil
+	}
+	err := e.encoder.Encode(&Envelope{Message: MsgKVs{KVs: e.kvs}})
+	e.kvs = e.kvs[:0]
+	return err
+}
+
+// Close writes any remaining KVs and the end of the stream.
+func (e *SnapshotEncoder) Close() error {
+	if err := e.sendKVs(); err != nil {
+		return err
+	}
+	if err := e.encoder.Encode(&Envelope{Message: MsgDecoderRestart{Message: "end of snapshot"}}); err != nil {
+		return err
+	}
+	return e.w.Close()
+}
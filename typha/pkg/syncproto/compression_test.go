@@ -0,0 +1,99 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncproto
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/gob"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+)
+
+func TestChooseCompression(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(ChooseCompression(nil)).To(BeEmpty())
+	Expect(ChooseCompression([]CompressionAlgorithm{"unknown"})).To(BeEmpty())
+	Expect(ChooseCompression([]CompressionAlgorithm{"unknown", CompressionDeflate})).To(Equal(CompressionDeflate))
+}
+
+// TestSnapshotEncoderRoundTrip checks that a pre-encoded snapshot, followed by a second compressed stream
+// (as the server sends for the updates that follow the snapshot), can be decoded from the same connection.
+func TestSnapshotEncoderRoundTrip(t *testing.T) {
+	RegisterTestingT(t)
+
+	var conn bytes.Buffer
+	snapEnc, err := NewSnapshotEncoder(&conn, CompressionDeflate, 10)
+	Expect(err).NotTo(HaveOccurred())
+	for i := 0; i < 25; i++ {
+		Expect(snapEnc.Add(SerializedUpdate{
+			Key:   fmt.Sprintf("/calico/v1/config/foo%d", i),
+			Value: []byte("bar"),
+		})).To(Succeed())
+	}
+	Expect(snapEnc.Close()).To(Succeed())
+
+	cw, err := NewCompressedWriter(&conn, CompressionDeflate)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(gob.NewEncoder(cw).Encode(&Envelope{Message: MsgSyncStatus{SyncStatus: api.InSync}})).To(Succeed())
+	Expect(cw.Flush()).To(Succeed())
+
+	r := bufio.NewReader(&conn)
+	decompressor, err := NewDecompressingReader(r, CompressionDeflate)
+	Expect(err).NotTo(HaveOccurred())
+	decoder := gob.NewDecoder(decompressor)
+
+	var kvs []SerializedUpdate
+	for {
+		var env Envelope
+		Expect(decoder.Decode(&env)).To(Succeed())
+		if _, ok := env.Message.(MsgDecoderRestart); ok {
+			break
+		}
+		msg, ok := env.Message.(MsgKVs)
+		Expect(ok).To(BeTrue(), fmt.Sprintf("unexpected message %v", env.Message))
+		Expect(len(msg.KVs)).To(BeNumerically("<=", 10))
+		kvs = append(kvs, msg.KVs...)
+	}
+	Expect(kvs).To(HaveLen(25))
+	Expect(kvs[24].Key).To(Equal("/calico/v1/config/foo24"))
+
+	// Drain the end of the first stream and start decoding the second.
+	_, err = io.Copy(ioutil.Discard, decompressor)
+	Expect(err).NotTo(HaveOccurred())
+	decompressor, err = NewDecompressingReader(r, CompressionDeflate)
+	Expect(err).NotTo(HaveOccurred())
+	decoder = gob.NewDecoder(decompressor)
+
+	var env Envelope
+	Expect(decoder.Decode(&env)).To(Succeed())
+	Expect(env.Message).To(Equal(MsgSyncStatus{SyncStatus: api.InSync}))
+}
+
+func TestUnsupportedCompression(t *testing.T) {
+	RegisterTestingT(t)
+
+	_, err := NewCompressedWriter(&bytes.Buffer{}, "unknown")
+	Expect(err).To(HaveOccurred())
+	_, err = NewDecompressingReader(bufio.NewReader(&bytes.Buffer{}), "unknown")
+	Expect(err).To(HaveOccurred())
+}
@@ -95,6 +95,58 @@
 //         all listening clients.  (Doing this in gob is not easy because the gob
 //         connection is stateful.)
 //
+// Compression
+//
+// A client that supports compression lists the algorithms that it supports in its
+// ClientHello.  If the server picks one, it says so in its ServerHello, which is the
+// last message that is sent uncompressed.  From then on, the server sends:
+//
+//     (1) The snapshot, as a self-contained gob stream, compressed with the chosen
+//         algorithm.  The stream consists of KVs messages followed by a DecoderRestart
+//         message, which marks the end of the stream.  Since the stream doesn't depend
+//         on the state of the connection, the server encodes the snapshot once and
+//         sends the same bytes to every client that connects while it is current.
+//
+//     (2) A second compressed gob stream, which carries the rest of the messages for
+//         the lifetime of the connection, starting with the deltas since the snapshot.
+//         The server flushes the compressor after each message.
+//
+// The client therefore starts a new decompressor and gob decoder when it receives the
+// ServerHello and again when it receives the DecoderRestart message.  Messages from the
+// client are never compressed.
+//
+//	+-------+                         +-------+
+//	| Felix |                         | Typha |
+//	+-------+                         +-------+
+//	|                                 |
+//	| ClientHello(deflate)            |
+//	|-------------------------------->|
+//	|                                 |
+//	|            ServerHello(deflate) |
+//	|<--------------------------------|
+//	|                                 |
+//	|       KVs * n, DecoderRestart   | (shared, pre-encoded snapshot)
+//	|<--------------------------------|
+//	|                                 |
+//	|    KVs, SyncStatus, Ping, ...   | (per-connection stream)
+//	|<--------------------------------|
+//	|                                 |
+//
+// Resuming
+//
+// Each of Typha's snapshot caches publishes a series of numbered Breadcrumbs, each of which
+// holds the deltas since the previous one.  A client that sets SupportsResume in its
+// ClientHello receives a ResumePoint message whenever it has been sent everything up to
+// (and including) a Breadcrumb.  The ResumePoint identifies the Breadcrumb by its sequence
+// number along with the ID of the cache, since sequence numbers are local to one instance
+// of the cache.
+//
+// When such a client reconnects, it can send the last ResumePoint that it applied in
+// its ClientHello.  If the server has the same cache and still retains that Breadcrumb,
+// it sets Resumed in its ServerHello and then sends only the deltas from the following
+// Breadcrumbs (as if it had just sent the snapshot).  Otherwise, it sends the snapshot as
+// usual; a client that can't apply a second snapshot should disconnect.
+//
 // Upgrading the datamodel
 //
 // Some care needs to be taken when upgrading Felix and Typha to ensure that datamodel
@@ -143,15 +195,15 @@
 //
 // Upgrading the Typha protocol
 //
-// Currently, the Typha protocol is unversioned.  It is important that an uplevel Typha
+// The Typha protocol is unversioned.  It is important that an uplevel Typha
 // doesn't send a new uplevel message to a downlevel Felix or vice-versa since the gob
 // decoder would fail to parse the message, resulting in closing the connection.
 //
-// If we need to add new unsolicited messages in either direction, we could add a
-// ProtocolVersion field to the handshake messages.  Since gob defaults fields to
-// their zero value if they're not present on the wire, a Typha with a ProtocolVersion
-// field that receives a connection from an old Felix with no field would see 0 as the
-// value of the field and could act accordingly.
+// If we need to add new unsolicited messages in either direction, we add a field to
+// the handshake messages to negotiate the feature, as for compression.  Since gob
+// defaults fields to their zero value if they're not present on the wire, a Typha
+// that receives a connection from an old Felix sees the zero value and acts
+// accordingly.
 //
 // If a more serious upgrade is needed (such as replacing gob), we could use a second
 // port for the new protocol.
@@ -210,6 +262,14 @@ type MsgClientHello struct {
 	// SyncerType the requested syncer type.  Added in v3.3; if client doesn't provide a value, assumed to be
 	// SyncerTypeFelix.
 	SyncerType SyncerType
+
+	// SupportedCompressionAlgorithms lists the compression algorithms that the client supports, if any.
+	SupportedCompressionAlgorithms []CompressionAlgorithm
+
+	// SupportsResume is set by clients that understand MsgResumePoint.
+	SupportsResume bool
+	// ResumeFrom, if non-zero, is the last ResumePoint that the client applied on a previous connection.
+	ResumeFrom ResumePoint
 }
 type MsgServerHello struct {
 	Version string
@@ -220,6 +280,14 @@ type MsgServerHello struct {
 
 	// SupportsNodeResourceUpdates provides to the client whether this Typha supports node resource updates.
 	SupportsNodeResourceUpdates bool
+
+	// CompressionAlgorithm is the compression algorithm that the server chose from those that the client
+	// supports, or "" if the rest of the connection is uncompressed.
+	CompressionAlgorithm CompressionAlgorithm
+
+	// Resumed is true if the server is resuming from the client's ResumeFrom point, in which case it
+	// doesn't send a snapshot.
+	Resumed bool
 }
 type MsgSyncStatus struct {
 	SyncStatus api.SyncStatus
@@ -235,6 +303,28 @@ type MsgKVs struct {
 	KVs []SerializedUpdate
 }
 
+// MsgDecoderRestart marks the end of a gob stream; the receiver should start a new decompressor and
+// decoder to read the next stream.
+type MsgDecoderRestart struct {
+	Message string
+}
+
+// ResumePoint identifies a Breadcrumb in a particular instance of Typha's snapshot cache.
+type ResumePoint struct {
+	CacheID        string
+	SequenceNumber uint64
+}
+
+func (p ResumePoint) IsZero() bool {
+	return p == ResumePoint{}
+}
+
+// MsgResumePoint is sent to clients that support resuming once they've been sent everything up to the
+// Breadcrumb that it identifies.
+type MsgResumePoint struct {
+	ResumePoint ResumePoint
+}
+
 func init() {
 	// For forwards/backwards compatibility, we need to use RegisterName here to force consistent names even as
 	// code gets refactored/moved/vendored/etc. In particular, this uses the pre-monorepo paths for this package.
@@ -244,6 +334,8 @@ func init() {
 	gob.RegisterName("github.com/projectcalico/typha/pkg/syncproto.MsgPing", MsgPing{})
 	gob.RegisterName("github.com/projectcalico/typha/pkg/syncproto.MsgPong", MsgPong{})
 	gob.RegisterName("github.com/projectcalico/typha/pkg/syncproto.MsgKVs", MsgKVs{})
+	gob.RegisterName("github.com/projectcalico/typha/pkg/syncproto.MsgDecoderRestart", MsgDecoderRestart{})
+	gob.RegisterName("github.com/projectcalico/typha/pkg/syncproto.MsgResumePoint", MsgResumePoint{})
 }
 
 func SerializeUpdate(u api.Update) (su SerializedUpdate, err error) {
@@ -0,0 +1,104 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syncserver
+
+import (
+	"math/rand"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/typha/pkg/syncproto"
+)
+
+var _ = Describe("rebalancer", func() {
+	var r *rebalancer
+
+	BeforeEach(func() {
+		r = newRebalancer()
+		r.SetTarget(100)
+	})
+
+	It("should not shed when rebalancing is disabled", func() {
+		r.SetTarget(0)
+		Expect(r.SyncerTypeToShed(map[syncproto.SyncerType]int{syncproto.SyncerTypeFelix: 1000})).To(BeEmpty())
+	})
+	It("should not shed when within the hysteresis", func() {
+		Expect(r.SyncerTypeToShed(map[syncproto.SyncerType]int{syncproto.SyncerTypeFelix: 110})).To(BeEmpty())
+	})
+	It("should use the minimum hysteresis for small targets", func() {
+		r.SetTarget(5)
+		Expect(r.SyncerTypeToShed(map[syncproto.SyncerType]int{syncproto.SyncerTypeFelix: 7})).To(BeEmpty())
+		Expect(r.SyncerTypeToShed(map[syncproto.SyncerType]int{syncproto.SyncerTypeFelix: 8})).To(Equal(syncproto.SyncerTypeFelix))
+	})
+	It("should shed down to the target once over the hysteresis", func() {
+		Expect(r.SyncerTypeToShed(map[syncproto.SyncerType]int{syncproto.SyncerTypeFelix: 111})).To(Equal(syncproto.SyncerTypeFelix))
+		for n := 110; n > 100; n-- {
+			Expect(r.SyncerTypeToShed(map[syncproto.SyncerType]int{syncproto.SyncerTypeFelix: n})).To(Equal(syncproto.SyncerTypeFelix))
+		}
+		Expect(r.SyncerTypeToShed(map[syncproto.SyncerType]int{syncproto.SyncerTypeFelix: 100})).To(BeEmpty())
+		Expect(r.SyncerTypeToShed(map[syncproto.SyncerType]int{syncproto.SyncerTypeFelix: 105})).To(BeEmpty())
+	})
+	It("should choose the syncer type with the most excess", func() {
+		Expect(r.SyncerTypeToShed(map[syncproto.SyncerType]int{
+			syncproto.SyncerTypeFelix:              120,
+			syncproto.SyncerTypeBGP:                130,
+			syncproto.SyncerTypeTunnelIPAllocation: 105,
+		})).To(Equal(syncproto.SyncerTypeBGP))
+	})
+	It("should reset when the target changes", func() {
+		Expect(r.SyncerTypeToShed(map[syncproto.SyncerType]int{syncproto.SyncerTypeFelix: 111})).To(Equal(syncproto.SyncerTypeFelix))
+		r.SetTarget(101)
+		Expect(r.SyncerTypeToShed(map[syncproto.SyncerType]int{syncproto.SyncerTypeFelix: 110})).To(BeEmpty())
+	})
+
+	It("should converge the load across servers after scale-up", func() {
+		// Simulate 1000 clients connected to two servers when three more are added.  Shed clients
+		// reconnect to a server chosen at random, as they would via the Typha service.
+		const numClients = 1000
+		const numServers = 5
+		rng := rand.New(rand.NewSource(1))
+		conns := []int{numClients / 2, numClients / 2, 0, 0, 0}
+		rebalancers := make([]*rebalancer, numServers)
+		target := 220 // As calculated by CalculateRebalanceTarget with the default 10% headroom.
+		for i := range rebalancers {
+			rebalancers[i] = newRebalancer()
+			rebalancers[i].SetTarget(target)
+		}
+
+		numShed := 0
+		quietTicks := 0
+		for tick := 0; tick < 10000 && quietTicks < 100; tick++ {
+			quietTicks++
+			for i, r := range rebalancers {
+				if r.SyncerTypeToShed(map[syncproto.SyncerType]int{syncproto.SyncerTypeFelix: conns[i]}) == "" {
+					continue
+				}
+				conns[i]--
+				conns[rng.Intn(numServers)]++
+				numShed++
+				quietTicks = 0
+			}
+		}
+
+		Expect(quietTicks).To(Equal(100), "Load didn't converge")
+		for i, n := range conns {
+			Expect(n).To(BeNumerically("<=", target+rebalancers[i].hysteresis()))
+		}
+		// Shedding the 2*(500-220) excess connections is unavoidable; some of those reconnect to
+		// the shedding servers but there should be no ongoing churn.
+		Expect(numShed).To(BeNumerically("<", numClients))
+	})
+})
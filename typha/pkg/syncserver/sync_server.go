@@ -26,6 +26,7 @@ import (
 	"net"
 	"os"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -57,6 +58,14 @@ var (
 		Name: "typha_connections_dropped",
 		Help: "Total number of connections dropped due to rebalancing.",
 	})
+	counterNumConnectionsResumed = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "typha_connections_resumed",
+		Help: "Total number of connections that resumed from a retained breadcrumb instead of receiving a snapshot.",
+	})
+	counterNumResumesRefused = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "typha_connections_resumes_refused",
+		Help: "Total number of connections that asked to resume but were refused because Typha already had its share of connections.",
+	})
 	counterGracePeriodUsed = prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "typha_connections_grace_used",
 		Help: "Total number of connections that made use of the grace period to catch up after sending the initial " +
@@ -98,6 +107,8 @@ var (
 func init() {
 	prometheus.MustRegister(counterNumConnectionsAccepted)
 	prometheus.MustRegister(counterNumConnectionsDropped)
+	prometheus.MustRegister(counterNumConnectionsResumed)
+	prometheus.MustRegister(counterNumResumesRefused)
 	prometheus.MustRegister(counterGracePeriodUsed)
 	prometheus.MustRegister(gaugeNumConnections)
 	prometheus.MustRegister(summarySnapshotSendTime)
@@ -115,8 +126,19 @@ const (
 	defaultBatchingAgeThreshold           = 100 * time.Millisecond
 	defaultPingInterval                   = 10 * time.Second
 	defaultDropInterval                   = 1 * time.Second
+	defaultRebalanceInterval              = 60 * time.Second
 	defaultMaxConns                       = math.MaxInt32
 	PortRandom                            = -1
+
+	// rebalanceHysteresisPercent and rebalanceMinHysteresis control how far over the rebalance target a
+	// syncer type must be before the server starts shedding its connections.  Once it has started, it
+	// sheds down to the target.
+	rebalanceHysteresisPercent = 10
+	rebalanceMinHysteresis     = 2
+
+	// encodedSnapshotChunkSize is the size of the writes used to send an encoded snapshot.  Each write
+	// gets its own deadline.
+	encodedSnapshotChunkSize = 64 * 1024
 )
 
 type Server struct {
@@ -128,8 +150,10 @@ type Server struct {
 	listeningC chan struct{}
 
 	dropInterval     time.Duration
+	rebalanceTargetC chan int
 	connTrackingLock sync.Mutex
 	maxConns         int
+	rebalanceTarget  int
 	connIDToConn     map[uint64]*connection
 
 	Finished sync.WaitGroup
@@ -137,6 +161,9 @@ type Server struct {
 
 type BreadcrumbProvider interface {
 	CurrentBreadcrumb() *snapcache.Breadcrumb
+	CurrentEncodedSnapshot(alg syncproto.CompressionAlgorithm, maxMsgSize int) (*snapcache.EncodedSnapshot, error)
+	ID() string
+	RetainedBreadcrumb(seqNo uint64) *snapcache.Breadcrumb
 }
 
 type Config struct {
@@ -149,6 +176,7 @@ type Config struct {
 	PongTimeout                    time.Duration
 	DropInterval                   time.Duration
 	MaxConns                       int
+	RebalanceInterval              time.Duration
 	HealthAggregator               *health.HealthAggregator
 	KeyFile                        string
 	CertFile                       string
@@ -213,6 +241,13 @@ func (c *Config) ApplyDefaults() {
 		}).Info("Defaulting DropInterval.")
 		c.DropInterval = defaultDropInterval
 	}
+	if c.RebalanceInterval <= 0 {
+		log.WithFields(log.Fields{
+			"value":   c.RebalanceInterval,
+			"default": defaultRebalanceInterval,
+		}).Info("Defaulting RebalanceInterval.")
+		c.RebalanceInterval = defaultRebalanceInterval
+	}
 	if c.MaxConns <= 0 {
 		log.WithFields(log.Fields{
 			"value":   c.MaxConns,
@@ -246,13 +281,14 @@ func New(caches map[syncproto.SyncerType]BreadcrumbProvider, config Config) *Ser
 	config.ApplyDefaults()
 	log.WithField("config", config).Info("Creating server")
 	s := &Server{
-		config:       config,
-		caches:       caches,
-		maxConnsC:    make(chan int),
-		dropInterval: config.DropInterval,
-		maxConns:     config.MaxConns,
-		connIDToConn: map[uint64]*connection{},
-		listeningC:   make(chan struct{}),
+		config:           config,
+		caches:           caches,
+		maxConnsC:        make(chan int),
+		rebalanceTargetC: make(chan int),
+		dropInterval:     config.DropInterval,
+		maxConns:         config.MaxConns,
+		connIDToConn:     map[uint64]*connection{},
+		listeningC:       make(chan struct{}),
 	}
 
 	// Register that we will report liveness.
@@ -277,6 +313,16 @@ func (s *Server) SetMaxConns(numConns int) {
 	s.maxConnsC <- numConns
 }
 
+// SetRebalanceTarget sets the number of connections of each syncer type that this server should aim to
+// serve so that the load is spread evenly across the Typha instances.  Unlike the MaxConns limit, which
+// is enforced quickly, the server sheds connections of a syncer type at most once per RebalanceInterval,
+// oldest first.  It only starts shedding once it is meaningfully over the target and then sheds down to
+// the target.  It also refuses to resume connections that would take it over the target.  A target of 0
+// disables rebalancing.
+func (s *Server) SetRebalanceTarget(numConnsPerSyncerType int) {
+	s.rebalanceTargetC <- numConnsPerSyncerType
+}
+
 func (s *Server) Port() int {
 	<-s.listeningC
 	return s.chosenPort
@@ -400,6 +446,7 @@ func (s *Server) serve(cxt context.Context) {
 			ID:        connID,
 			config:    &s.config,
 			allCaches: s.caches,
+			canResume: s.canResume,
 			cxt:       connCxt,
 			cancelCxt: cancel,
 			conn:      conn,
@@ -446,7 +493,9 @@ func (s *Server) governNumberOfConnections(cxt context.Context) {
 	defer s.Finished.Done()
 	logCxt := log.WithField("thread", "numConnsGov")
 	maxConns := s.maxConns
+	rebalancer := newRebalancer()
 	ticker := jitter.NewTicker(s.dropInterval, s.dropInterval/10)
+	rebalanceTicker := jitter.NewTicker(s.config.RebalanceInterval, s.config.RebalanceInterval/10)
 	healthTicks := time.NewTicker(healthInterval).C
 	s.reportHealth()
 	for {
@@ -467,6 +516,33 @@ func (s *Server) governNumberOfConnections(cxt context.Context) {
 			s.connTrackingLock.Lock()
 			s.maxConns = maxConns
 			s.connTrackingLock.Unlock()
+		case newTarget := <-s.rebalanceTargetC:
+			if newTarget == rebalancer.target {
+				continue
+			}
+			logCxt.WithFields(log.Fields{
+				"oldTarget": rebalancer.target,
+				"newTarget": newTarget,
+			}).Info("New rebalance target number of connections per syncer type")
+			rebalancer.SetTarget(newTarget)
+			s.connTrackingLock.Lock()
+			s.rebalanceTarget = newTarget
+			s.connTrackingLock.Unlock()
+		case <-rebalanceTicker.C:
+			if rebalancer.target <= 0 {
+				continue
+			}
+			s.connTrackingLock.Lock()
+			if conn := s.chooseConnToRebalance(rebalancer); conn != nil {
+				logCxt.WithFields(log.Fields{
+					"target":     rebalancer.target,
+					"connID":     conn.ID,
+					"syncerType": conn.SyncerType(),
+				}).Info("Have more connections than our fair share, shedding the oldest.")
+				conn.cancelCxt()
+				counterNumConnectionsDropped.Inc()
+			}
+			s.connTrackingLock.Unlock()
 		case <-ticker.C:
 			s.connTrackingLock.Lock()
 			numConns := len(s.connIDToConn)
@@ -492,6 +568,108 @@ func (s *Server) governNumberOfConnections(cxt context.Context) {
 	}
 }
 
+// chooseConnToRebalance returns the oldest connection of the syncer type that the rebalancer chooses to
+// shed, or nil if no connection should be shed.  Connections that haven't finished their handshake are
+// ignored.  The caller must hold connTrackingLock.
+func (s *Server) chooseConnToRebalance(r *rebalancer) *connection {
+	numConns := map[syncproto.SyncerType]int{}
+	oldestConn := map[syncproto.SyncerType]*connection{}
+	for _, conn := range s.connIDToConn {
+		syncerType := conn.SyncerType()
+		if syncerType == "" {
+			continue
+		}
+		numConns[syncerType]++
+		if oldest := oldestConn[syncerType]; oldest == nil || conn.ID < oldest.ID {
+			oldestConn[syncerType] = conn
+		}
+	}
+	syncerType := r.SyncerTypeToShed(numConns)
+	if syncerType == "" {
+		return nil
+	}
+	return oldestConn[syncerType]
+}
+
+// canResume returns false if rebalancing is enabled and we already have more than our target number of
+// connections of the given syncer type, counting the connection that wants to resume.  Only this server
+// can resume a connection so, without this check, a client that we shed to rebalance would come straight
+// back; refusing to resume sends it back through discovery instead.
+func (s *Server) canResume(syncerType syncproto.SyncerType) bool {
+	s.connTrackingLock.Lock()
+	defer s.connTrackingLock.Unlock()
+	if s.rebalanceTarget <= 0 {
+		return true
+	}
+	num := 0
+	for _, conn := range s.connIDToConn {
+		if conn.SyncerType() == syncerType {
+			num++
+		}
+	}
+	return num <= s.rebalanceTarget
+}
+
+// rebalancer decides which syncer type, if any, the server should shed a connection of.  To avoid churning
+// connections over small imbalances, which clients reconnecting at random tend to even out anyway, it only
+// starts shedding a syncer type once that type is meaningfully over the target.  Once it has started, it
+// keeps shedding that type until it is down to the target.
+type rebalancer struct {
+	target   int
+	shedding map[syncproto.SyncerType]bool
+}
+
+func newRebalancer() *rebalancer {
+	return &rebalancer{
+		shedding: map[syncproto.SyncerType]bool{},
+	}
+}
+
+// SetTarget sets the target number of connections per syncer type; 0 disables rebalancing.  It resets
+// the hysteresis state.
+func (r *rebalancer) SetTarget(target int) {
+	r.target = target
+	r.shedding = map[syncproto.SyncerType]bool{}
+}
+
+// SyncerTypeToShed takes the current number of connections of each syncer type and returns the type that
+// has the most connections over the target out of those that are being shed, or "" if none should be.
+func (r *rebalancer) SyncerTypeToShed(numConns map[syncproto.SyncerType]int) syncproto.SyncerType {
+	if r.target <= 0 {
+		return ""
+	}
+	var chosen syncproto.SyncerType
+	maxExcess := 0
+	for syncerType, num := range numConns {
+		excess := num - r.target
+		if excess <= 0 {
+			delete(r.shedding, syncerType)
+			continue
+		}
+		if !r.shedding[syncerType] {
+			if excess <= r.hysteresis() {
+				continue
+			}
+			r.shedding[syncerType] = true
+		}
+		if excess > maxExcess || (excess == maxExcess && syncerType < chosen) {
+			maxExcess = excess
+			chosen = syncerType
+		}
+	}
+	return chosen
+}
+
+// hysteresis returns the number of connections over the target that a syncer type must exceed before the
+// rebalancer starts shedding it.
+func (r *rebalancer) hysteresis() int {
+	h := r.target * rebalanceHysteresisPercent / 100
+	if h < rebalanceMinHysteresis {
+		h = rebalanceMinHysteresis
+	}
+	return h
+}
+
 // allowedCiphers returns the set of allowed cipher suites for the server.
 // The list is taken from https://github.com/golang/go/blob/dev.boringcrypto.go1.13/src/crypto/tls/boring.go#L54
 func (s *Server) allowedCiphers() []uint16 {
@@ -529,13 +707,38 @@ type connection struct {
 	allCaches map[syncproto.SyncerType]BreadcrumbProvider
 	cache     BreadcrumbProvider
 	conn      net.Conn
-
-	encoder *gob.Encoder
-	readC   chan interface{}
+	// canResume is called once the handshake knows the syncer type to check whether the server has room
+	// to resume the connection.
+	canResume func(syncproto.SyncerType) bool
+
+	// syncerType holds the SyncerType that the client asked for, once the handshake is complete.  It is read
+	// by the server's connection governor so it is an atomic.Value.
+	syncerType atomic.Value
+
+	// compression is the compression algorithm agreed in the handshake, or "" for none.
+	compression syncproto.CompressionAlgorithm
+	// supportsResume is true if the client understands MsgResumePoint.  resumeCrumb is set if the
+	// client asked to resume from a Breadcrumb that we still have; in that case we don't send a snapshot.
+	supportsResume bool
+	resumeCrumb    *snapcache.Breadcrumb
+
+	// writeLock serialises writes to the connection.  Once the encoded snapshot has been sent to a
+	// client that supports compression, encoder writes to compressedWriter, which must be flushed
+	// after each message.
+	writeLock        sync.Mutex
+	encoder          *gob.Encoder
+	compressedWriter syncproto.CompressedWriter
+	readC            chan interface{}
 
 	logCxt *log.Entry
 }
 
+// SyncerType returns the SyncerType that the client asked for, or "" if the handshake isn't complete.
+func (h *connection) SyncerType() syncproto.SyncerType {
+	syncerType, _ := h.syncerType.Load().(syncproto.SyncerType)
+	return syncerType
+}
+
 func (h *connection) handle(finishedWG *sync.WaitGroup) (err error) {
 	// Ensure that stop gets called.  Stop will close the connection and context and wait for our background
 	// goroutines to finish.
@@ -567,9 +770,27 @@ func (h *connection) handle(finishedWG *sync.WaitGroup) (err error) {
 		return // Error already logged.
 	}
 
+	// If the client supports compression, send it the shared, pre-encoded snapshot before anything
+	// else; the kv-sender then only needs to send the deltas since that snapshot.  Otherwise, the
+	// kv-sender streams the snapshot itself.
+	// If the client is resuming, it already has everything up to the Breadcrumb it asked for.
+	startingCrumb := h.resumeCrumb
+	if startingCrumb != nil {
+		if h.compression != "" {
+			if err = h.startCompressedStream(h.logCxt); err != nil {
+				return // Error already logged.
+			}
+		}
+	} else if h.compression != "" {
+		startingCrumb, err = h.sendEncodedSnapshot(h.logCxt)
+		if err != nil {
+			return // Error already logged.
+		}
+	}
+
 	// Start a goroutine to stream the snapshot and then the deltas to the client.
 	h.shutDownWG.Add(1)
-	go h.sendSnapshotAndUpdatesToClient(h.logCxt.WithField("thread", "kv-sender"))
+	go h.sendSnapshotAndUpdatesToClient(h.logCxt.WithField("thread", "kv-sender"), startingCrumb)
 
 	// Start a goroutine to send periodic pings.  We send pings from their own goroutine so that, if the Encoder
 	// blocks, we don't prevent the main goroutine from checking the pongs.
@@ -694,6 +915,32 @@ func (h *connection) doHandshake() error {
 		return ErrUnsupportedClientFeature
 	}
 	h.cache = desiredSyncerCache
+	h.syncerType.Store(syncerType)
+
+	// Choose a compression algorithm.  Down-level clients don't send any so they get an uncompressed
+	// connection.
+	h.compression = syncproto.ChooseCompression(hello.SupportedCompressionAlgorithms)
+	if h.compression != "" {
+		h.logCxt.WithField("compression", h.compression).Info("Client supports compression.")
+	}
+
+	// If the client wants to resume from where it left off on a previous connection, check that it was
+	// connected to this cache and that we still have the Breadcrumb it got to.
+	h.supportsResume = hello.SupportsResume
+	if h.supportsResume && !hello.ResumeFrom.IsZero() {
+		logCxt := h.logCxt.WithField("resumeFrom", hello.ResumeFrom)
+		if hello.ResumeFrom.CacheID != desiredSyncerCache.ID() {
+			logCxt.Info("Client was connected to a different cache, sending snapshot.")
+		} else if !h.canResume(syncerType) {
+			logCxt.Info("Already have our share of connections, refusing to resume client.")
+			counterNumResumesRefused.Inc()
+		} else if h.resumeCrumb = desiredSyncerCache.RetainedBreadcrumb(hello.ResumeFrom.SequenceNumber); h.resumeCrumb == nil {
+			logCxt.Info("Client's breadcrumb is no longer retained, sending snapshot.")
+		} else {
+			logCxt.Info("Resuming client from retained breadcrumb.")
+			counterNumConnectionsResumed.Inc()
+		}
+	}
 
 	// Respond to client's hello.
 	err = h.sendMsg(syncproto.MsgServerHello{
@@ -702,6 +949,8 @@ func (h *connection) doHandshake() error {
 		// clients will ignore.
 		SyncerType:                  syncerType,
 		SupportsNodeResourceUpdates: true,
+		CompressionAlgorithm:        h.compression,
+		Resumed:                     h.resumeCrumb != nil,
 	})
 	if err != nil {
 		log.WithError(err).Warning("Failed to send hello to client")
@@ -710,7 +959,7 @@ func (h *connection) doHandshake() error {
 	return nil
 }
 
-// sendMsg sends a message to the client.  It may be called from multiple goroutines because the Encoder is thread-safe.
+// sendMsg sends a message to the client.  It may be called from multiple goroutines.
 func (h *connection) sendMsg(msg interface{}) error {
 	if h.cxt.Err() != nil {
 		// Optimisation, don't bother to send if we're being torn down.
@@ -720,7 +969,13 @@ func (h *connection) sendMsg(msg interface{}) error {
 		Message: msg,
 	}
 	startTime := time.Now()
+	h.writeLock.Lock()
+	defer h.writeLock.Unlock()
 	err := h.encoder.Encode(&envelope)
+	if err == nil && h.compressedWriter != nil {
+		// Flush the compressor so that the client receives the message now.
+		err = h.compressedWriter.Flush()
+	}
 	if err != nil {
 		h.logCxt.WithError(err).Info("Failed to write to client")
 		return err
@@ -729,9 +984,85 @@ func (h *connection) sendMsg(msg interface{}) error {
 	return nil
 }
 
+// sendEncodedSnapshot sends a recent, pre-encoded snapshot to the client and then switches the connection
+// to a new compressed stream for the remaining messages.  It returns the Breadcrumb that the snapshot
+// came from.  It must be called before any other goroutine sends messages to the client.
+func (h *connection) sendEncodedSnapshot(logCxt *log.Entry) (*snapcache.Breadcrumb, error) {
+	snap, err := h.cache.CurrentEncodedSnapshot(h.compression, h.config.MaxMessageSize)
+	if err != nil {
+		logCxt.WithError(err).Error("Failed to encode snapshot")
+		return nil, err
+	}
+	logCxt = logCxt.WithFields(log.Fields{
+		"seqNo":     snap.Breadcrumb.SequenceNumber,
+		"status":    snap.Breadcrumb.SyncStatus,
+		"numKeys":   snap.NumKVs,
+		"sizeBytes": len(snap.Data),
+	})
+	logCxt.Info("Starting to send encoded snapshot to client")
+	startTime := time.Now()
+
+	h.writeLock.Lock()
+	defer h.writeLock.Unlock()
+	for data := snap.Data; len(data) > 0; {
+		if h.cxt.Err() != nil {
+			logCxt.WithError(h.cxt.Err()).Info("Asked to stop by Context")
+			return nil, h.cxt.Err()
+		}
+		chunk := data
+		if len(chunk) > encodedSnapshotChunkSize {
+			chunk = chunk[:encodedSnapshotChunkSize]
+		}
+		// We don't send pings while we're sending the snapshot so use a deadline to make sure that we
+		// give up on a client that stops reading.
+		err = h.conn.SetWriteDeadline(time.Now().Add(h.config.PongTimeout))
+		if err == nil {
+			_, err = h.conn.Write(chunk)
+		}
+		if err != nil {
+			logCxt.WithError(err).Info("Failed to send snapshot to client")
+			return nil, err
+		}
+		data = data[len(chunk):]
+	}
+	if err = h.conn.SetWriteDeadline(time.Time{}); err != nil {
+		logCxt.WithError(err).Info("Failed to clear write deadline")
+		return nil, err
+	}
+
+	// Start the compressed stream for the rest of the connection.
+	if err = h.startCompressedStreamLocked(logCxt); err != nil {
+		return nil, err
+	}
+
+	logCxt.Info("Finished sending encoded snapshot to client")
+	summarySnapshotSendTime.Observe(time.Since(startTime).Seconds())
+	return snap.Breadcrumb, nil
+}
+
+// startCompressedStream starts the compressed stream that carries the rest of the messages for the
+// connection.  It must be called before any other goroutine sends messages to the client.
+func (h *connection) startCompressedStream(logCxt *log.Entry) error {
+	h.writeLock.Lock()
+	defer h.writeLock.Unlock()
+	return h.startCompressedStreamLocked(logCxt)
+}
+
+func (h *connection) startCompressedStreamLocked(logCxt *log.Entry) (err error) {
+	h.compressedWriter, err = syncproto.NewCompressedWriter(h.conn, h.compression)
+	if err != nil {
+		logCxt.WithError(err).Error("Failed to create compressor")
+		return
+	}
+	h.encoder = gob.NewEncoder(h.compressedWriter)
+	return
+}
+
 // sendSnapshotAndUpdatesToClient sends the snapshot from the current Breadcrumb and then follows the Breadcrumbs
-// sending deltas to the client.
-func (h *connection) sendSnapshotAndUpdatesToClient(logCxt *log.Entry) {
+// sending deltas to the client.  If the snapshot has already been sent, startingCrumb is the Breadcrumb that it
+// came from and only the deltas are sent.  Similarly, if the client is resuming, startingCrumb is the
+// Breadcrumb that it resumed from.
+func (h *connection) sendSnapshotAndUpdatesToClient(logCxt *log.Entry, startingCrumb *snapcache.Breadcrumb) {
 	defer func() {
 		logCxt.Info("KV-sender goroutine shutting down")
 		h.cancelCxt()
@@ -739,18 +1070,25 @@ func (h *connection) sendSnapshotAndUpdatesToClient(logCxt *log.Entry) {
 		logCxt.Info("KV-sender goroutine finished")
 	}()
 
-	// Get the current snapshot and stream it to the client...
-	breadcrumb := h.cache.CurrentBreadcrumb()
-	err := h.streamSnapshotToClient(logCxt, breadcrumb)
-	if err != nil {
-		log.WithError(err).Info("Failed to send snapshot to client, tearing down connection.")
-		return
+	breadcrumb := startingCrumb
+	if breadcrumb == nil {
+		// Get the current snapshot and stream it to the client...
+		breadcrumb = h.cache.CurrentBreadcrumb()
+		err := h.streamSnapshotToClient(logCxt, breadcrumb)
+		if err != nil {
+			log.WithError(err).Info("Failed to send snapshot to client, tearing down connection.")
+			return
+		}
 	}
 	// Finished sending the snapshot, calculate the grace time for the client to catch up to a recent breadcrumb.
 	gracePeriodEndTime := time.Now().Add(h.config.NewClientFallBehindGracePeriod)
 
-	// Track the sync status reported in each Breadcrumb so we can send an update if it changes.
+	// Track the sync status reported in each Breadcrumb so we can send an update if it changes.  A resuming
+	// client already has the status of the Breadcrumb that it resumed from.
 	var lastSentStatus api.SyncStatus
+	if h.resumeCrumb != nil {
+		lastSentStatus = h.resumeCrumb.SyncStatus
+	}
 	maybeSendStatus := func() (err error) {
 		if lastSentStatus != breadcrumb.SyncStatus {
 			logCxt.WithField("newStatus", breadcrumb.SyncStatus).Info(
@@ -767,10 +1105,30 @@ func (h *connection) sendSnapshotAndUpdatesToClient(logCxt *log.Entry) {
 		return
 	}
 
+	// If the client supports resuming, tell it which Breadcrumb it has reached after each batch.
+	maybeSendResumePoint := func() (err error) {
+		if !h.supportsResume {
+			return
+		}
+		err = h.sendMsg(syncproto.MsgResumePoint{
+			ResumePoint: syncproto.ResumePoint{
+				CacheID:        h.cache.ID(),
+				SequenceNumber: breadcrumb.SequenceNumber,
+			},
+		})
+		if err != nil {
+			logCxt.WithError(err).Info("Failed to send resume point to client")
+		}
+		return
+	}
+
 	// The first Breadcrumb may have changed the status.  Send an update if so.
 	if err := maybeSendStatus(); err != nil {
 		return
 	}
+	if err := maybeSendResumePoint(); err != nil {
+		return
+	}
 
 	loggedClientBehind := false
 	for h.cxt.Err() == nil {
@@ -875,6 +1233,9 @@ func (h *connection) sendSnapshotAndUpdatesToClient(logCxt *log.Entry) {
 		if err := maybeSendStatus(); err != nil {
 			return
 		}
+		if err := maybeSendResumePoint(); err != nil {
+			return
+		}
 	}
 }
 
@@ -41,6 +41,7 @@ var _ = Describe("With zero config", func() {
 			PongTimeout:                    60 * time.Second,
 			DropInterval:                   time.Second,
 			MaxConns:                       math.MaxInt32,
+			RebalanceInterval:              60 * time.Second,
 			Port:                           5473,
 		}))
 	})
@@ -0,0 +1,310 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package upstream contains the Syncer used by a "leaf" Typha, which gets its data from an upstream
+// ("root") Typha instead of from the datastore.
+//
+// On very large clusters, every Typha opening its own watches against the datastore puts load on the
+// datastore that grows with the number of Typha replicas.  In the hierarchical mode, only the root
+// Typhas watch the datastore; the leaf Typhas connect to a root Typha as if they were Felix and feed the
+// updates that they receive into their own snapshot caches, from which they serve their clients:
+//
+//	datastore -> root Typha (syncer) -> leaf Typha (upstream.Syncer) -> Felix
+//
+// The sequence numbers of the leaf's breadcrumbs are local to the leaf but the content is the same.
+package upstream
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	"github.com/projectcalico/calico/typha/pkg/discovery"
+	"github.com/projectcalico/calico/typha/pkg/syncclient"
+	"github.com/projectcalico/calico/typha/pkg/syncproto"
+)
+
+const defaultRetryInterval = time.Second
+
+var (
+	counterUpstreamConnections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "typha_upstream_connections",
+		Help: "Total number of connections made to the upstream Typha.",
+	}, []string{"syncer"})
+	counterUpstreamConnectionFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "typha_upstream_connection_failures",
+		Help: "Total number of failed or lost connections to the upstream Typha.",
+	}, []string{"syncer"})
+)
+
+func init() {
+	prometheus.MustRegister(counterUpstreamConnections)
+	prometheus.MustRegister(counterUpstreamConnectionFailures)
+}
+
+type Config struct {
+	// Addr is the address of the upstream Typha.  If it is empty, the upstream Typha is discovered
+	// through its Kubernetes service.
+	Addr           string
+	K8sNamespace   string
+	K8sServiceName string
+	K8sPortName    string
+
+	// Client-side TLS config for the connection to the upstream Typha, as for Felix.
+	KeyFile  string
+	CertFile string
+	CAFile   string
+	CN       string
+	URISAN   string
+
+	// MyHostname and MyVersion are sent to the upstream Typha in our hello.
+	MyHostname string
+	MyVersion  string
+
+	// RetryInterval is the time to wait before reconnecting after a failed connection.
+	RetryInterval time.Duration
+}
+
+// Syncer is an api.Syncer that gets its updates from an upstream Typha.  If the connection fails, it
+// reconnects, resuming from where it left off if the upstream Typha can, or resynchronising with the new
+// connection's snapshot otherwise.
+type Syncer struct {
+	config     Config
+	syncerType syncproto.SyncerType
+	tracker    *resyncTracker
+
+	// lastAddr and resumePoint record the upstream Typha that we were last connected to and how far we got.
+	// They are only accessed from the loop goroutine.
+	lastAddr    string
+	resumePoint syncproto.ResumePoint
+
+	cancel   context.CancelFunc
+	finished sync.WaitGroup
+}
+
+func New(config Config, syncerType syncproto.SyncerType, callbacks api.SyncerCallbacks) *Syncer {
+	if config.RetryInterval <= 0 {
+		config.RetryInterval = defaultRetryInterval
+	}
+	return &Syncer{
+		config:     config,
+		syncerType: syncerType,
+		tracker:    newResyncTracker(callbacks),
+	}
+}
+
+func (s *Syncer) Start() {
+	cxt, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.finished.Add(1)
+	go s.loop(cxt)
+}
+
+func (s *Syncer) Stop() {
+	s.cancel()
+	s.finished.Wait()
+}
+
+func (s *Syncer) loop(cxt context.Context) {
+	defer s.finished.Done()
+	logCxt := log.WithField("syncerType", s.syncerType)
+	s.tracker.OnStatusUpdated(api.WaitForDatastore)
+	for cxt.Err() == nil {
+		s.connectAndSync(cxt, logCxt)
+		if cxt.Err() != nil {
+			break
+		}
+		counterUpstreamConnectionFailures.WithLabelValues(string(s.syncerType)).Inc()
+		logCxt.WithField("retryInterval", s.config.RetryInterval).Info(
+			"Connection to upstream Typha failed, will reconnect.")
+		select {
+		case <-cxt.Done():
+		case <-time.After(s.config.RetryInterval):
+		}
+	}
+	logCxt.Info("Upstream syncer stopped.")
+}
+
+// connectAndSync connects to the upstream Typha and passes its updates to our callbacks until the connection
+// fails or the context is canceled.
+func (s *Syncer) connectAndSync(cxt context.Context, logCxt *log.Entry) {
+	addrs, err := discovery.DiscoverTyphaAddrs(
+		discovery.WithAddrOverride(s.config.Addr),
+		discovery.WithInClusterKubeClient(),
+		discovery.WithKubeService(s.config.K8sNamespace, s.config.K8sServiceName),
+		discovery.WithKubeServicePortNameOverride(s.config.K8sPortName),
+	)
+	if err != nil {
+		logCxt.WithError(err).Error("Failed to discover upstream Typha.")
+		return
+	}
+	if len(addrs) == 0 {
+		logCxt.Error("No upstream Typha configured.")
+		return
+	}
+
+	if s.resumePoint.IsZero() {
+		// If this is a reconnection, the new connection starts with a fresh snapshot, which won't include
+		// the KVs that were deleted while we were disconnected.
+		s.tracker.StartResync()
+		// Fail over to another Typha, if there is one, rather than going straight back to the one that
+		// dropped us.
+		addrs = avoidAddr(addrs, s.lastAddr)
+	} else {
+		// Only the Typha that we were connected to can resume our connection so try it first.
+		addrs = preferAddr(addrs, s.lastAddr)
+	}
+
+	client := syncclient.NewWithAddrs(
+		addrs,
+		s.config.MyVersion,
+		s.config.MyHostname,
+		"leaf Typha",
+		s.tracker,
+		&syncclient.Options{
+			SyncerType:   s.syncerType,
+			KeyFile:      s.config.KeyFile,
+			CertFile:     s.config.CertFile,
+			CAFile:       s.config.CAFile,
+			ServerCN:     s.config.CN,
+			ServerURISAN: s.config.URISAN,
+			ResumeFrom:   s.resumePoint,
+		},
+	)
+	if err := client.Start(cxt); err != nil {
+		logCxt.WithError(err).WithField("addrs", addrs).Error("Failed to connect to upstream Typha.")
+		return
+	}
+	counterUpstreamConnections.WithLabelValues(string(s.syncerType)).Inc()
+	logCxt.WithField("addr", client.Addr()).Info("Connected to upstream Typha.")
+	client.Finished.Wait()
+
+	s.lastAddr = client.Addr()
+	if client.ResumeRejected() {
+		logCxt.Info("Upstream Typha couldn't resume our connection, will resync.")
+		s.resumePoint = syncproto.ResumePoint{}
+	} else {
+		s.resumePoint = client.ResumePoint()
+	}
+}
+
+// preferAddr returns addrs with addr moved to the front, if it is present.
+func preferAddr(addrs []string, addr string) []string {
+	preferred := []string{}
+	var others []string
+	for _, a := range addrs {
+		if a == addr {
+			preferred = append(preferred, a)
+		} else {
+			others = append(others, a)
+		}
+	}
+	return append(preferred, others...)
+}
+
+// avoidAddr returns addrs with addr moved to the back, if it is present.
+func avoidAddr(addrs []string, addr string) []string {
+	var others []string
+	avoided := []string{}
+	for _, a := range addrs {
+		if a == addr {
+			avoided = append(avoided, a)
+		} else {
+			others = append(others, a)
+		}
+	}
+	return append(others, avoided...)
+}
+
+// resyncTracker passes through the updates from the upstream Typha, keeping track of the keys that it has
+// sent.  After a reconnection, it sends deletions for the keys that are missing from the new connection's
+// snapshot once the new connection is in sync.
+//
+// Its methods are called from one goroutine at a time: the connections are made one after another.
+type resyncTracker struct {
+	callbacks api.SyncerCallbacks
+
+	// keys holds the KVs that we've sent to our callbacks, indexed by their default path.
+	keys map[string]model.Key
+	// staleKeys holds, while we resync after a reconnection, the keys that we haven't yet received
+	// from the new connection.
+	staleKeys map[string]model.Key
+}
+
+func newResyncTracker(callbacks api.SyncerCallbacks) *resyncTracker {
+	return &resyncTracker{
+		callbacks: callbacks,
+		keys:      map[string]model.Key{},
+	}
+}
+
+// StartResync is called before each connection.  If we've already sent any KVs, they are marked as stale
+// until the new connection sends them again.
+func (t *resyncTracker) StartResync() {
+	if len(t.keys) == 0 && t.staleKeys == nil {
+		return
+	}
+	if t.staleKeys == nil {
+		t.staleKeys = map[string]model.Key{}
+	}
+	for path, key := range t.keys {
+		t.staleKeys[path] = key
+	}
+	t.callbacks.OnStatusUpdated(api.ResyncInProgress)
+}
+
+func (t *resyncTracker) OnUpdates(updates []api.Update) {
+	for _, upd := range updates {
+		path, err := model.KeyToDefaultPath(upd.Key)
+		if err != nil {
+			log.WithError(err).WithField("key", upd.Key).Warn("Failed to convert key to path, not tracking it.")
+			continue
+		}
+		delete(t.staleKeys, path)
+		if upd.Value == nil {
+			delete(t.keys, path)
+		} else {
+			t.keys[path] = upd.Key
+		}
+	}
+	t.callbacks.OnUpdates(updates)
+}
+
+func (t *resyncTracker) OnStatusUpdated(status api.SyncStatus) {
+	if status == api.InSync && t.staleKeys != nil {
+		// The new connection's snapshot is complete; anything that it didn't send has been deleted.
+		var deletions []api.Update
+		for path, key := range t.staleKeys {
+			deletions = append(deletions, api.Update{
+				KVPair: model.KVPair{
+					Key: key,
+				},
+				UpdateType: api.UpdateTypeKVDeleted,
+			})
+			delete(t.keys, path)
+		}
+		t.staleKeys = nil
+		if len(deletions) > 0 {
+			log.WithField("numDeletions", len(deletions)).Info(
+				"Resync with upstream Typha complete, deleting KVs that are no longer present.")
+			t.callbacks.OnUpdates(deletions)
+		}
+	}
+	t.callbacks.OnStatusUpdated(status)
+}
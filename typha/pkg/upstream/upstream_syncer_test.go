@@ -0,0 +1,121 @@
+// Copyright (c) 2022 Tigera, Inc. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upstream
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/api"
+	"github.com/projectcalico/calico/libcalico-go/lib/backend/model"
+	fvtests "github.com/projectcalico/calico/typha/fv-tests"
+)
+
+func configUpdate(name, value string) api.Update {
+	return api.Update{
+		KVPair: model.KVPair{
+			Key:   model.GlobalConfigKey{Name: name},
+			Value: value,
+		},
+		UpdateType: api.UpdateTypeKVNew,
+	}
+}
+
+var _ = Describe("resyncTracker", func() {
+	var recorder *fvtests.StateRecorder
+	var tracker *resyncTracker
+
+	BeforeEach(func() {
+		recorder = fvtests.NewRecorder()
+		tracker = newResyncTracker(recorder)
+	})
+
+	It("should pass through the first connection's updates", func() {
+		tracker.StartResync()
+		tracker.OnUpdates([]api.Update{configUpdate("a", "1"), configUpdate("b", "2")})
+		tracker.OnStatusUpdated(api.InSync)
+
+		Expect(recorder.Status()).To(Equal(api.InSync))
+		Expect(recorder.KVs()).To(HaveLen(2))
+		Expect(tracker.keys).To(HaveLen(2))
+		Expect(tracker.staleKeys).To(BeNil())
+	})
+
+	It("should stop tracking deleted keys", func() {
+		tracker.OnUpdates([]api.Update{configUpdate("a", "1"), configUpdate("b", "2")})
+		tracker.OnUpdates([]api.Update{{
+			KVPair:     model.KVPair{Key: model.GlobalConfigKey{Name: "a"}},
+			UpdateType: api.UpdateTypeKVDeleted,
+		}})
+
+		Expect(recorder.KVs()).To(HaveLen(1))
+		Expect(tracker.keys).To(HaveLen(1))
+	})
+
+	Describe("after a reconnection", func() {
+		BeforeEach(func() {
+			tracker.StartResync()
+			tracker.OnUpdates([]api.Update{configUpdate("a", "1"), configUpdate("b", "2"), configUpdate("c", "3")})
+			tracker.OnStatusUpdated(api.InSync)
+
+			tracker.StartResync()
+		})
+
+		It("should report that it is resyncing", func() {
+			Expect(recorder.Status()).To(Equal(api.ResyncInProgress))
+			Expect(tracker.staleKeys).To(HaveLen(3))
+		})
+
+		It("should delete the keys that are missing from the new snapshot once in sync", func() {
+			tracker.OnUpdates([]api.Update{configUpdate("a", "1"), configUpdate("c", "updated")})
+			Expect(recorder.KVs()).To(HaveLen(3), "Deletions should wait until the snapshot is complete")
+
+			tracker.OnStatusUpdated(api.InSync)
+			kvs := recorder.KVs()
+			Expect(kvs).To(HaveKey("/calico/v1/config/a"))
+			Expect(kvs).NotTo(HaveKey("/calico/v1/config/b"))
+			Expect(kvs["/calico/v1/config/c"].Value).To(Equal("updated"))
+			Expect(recorder.Status()).To(Equal(api.InSync))
+			Expect(tracker.keys).To(HaveLen(2))
+			Expect(tracker.staleKeys).To(BeNil())
+		})
+
+		It("should carry stale keys over to a further reconnection", func() {
+			tracker.OnUpdates([]api.Update{configUpdate("a", "1")})
+			tracker.StartResync()
+			tracker.OnUpdates([]api.Update{configUpdate("b", "2")})
+			tracker.OnStatusUpdated(api.InSync)
+
+			kvs := recorder.KVs()
+			Expect(kvs).To(HaveLen(1))
+			Expect(kvs).To(HaveKey("/calico/v1/config/b"))
+		})
+	})
+})
+
+var _ = Describe("address ordering", func() {
+	addrs := []string{"10.0.0.1:5473", "10.0.0.2:5473", "10.0.0.3:5473"}
+
+	It("should move the preferred address to the front", func() {
+		Expect(preferAddr(addrs, "10.0.0.2:5473")).To(Equal([]string{"10.0.0.2:5473", "10.0.0.1:5473", "10.0.0.3:5473"}))
+	})
+	It("should move the avoided address to the back", func() {
+		Expect(avoidAddr(addrs, "10.0.0.2:5473")).To(Equal([]string{"10.0.0.1:5473", "10.0.0.3:5473", "10.0.0.2:5473"}))
+	})
+	It("should leave the order alone if the address isn't present", func() {
+		Expect(preferAddr(addrs, "10.0.0.4:5473")).To(Equal(addrs))
+		Expect(avoidAddr(addrs, "")).To(Equal(addrs))
+	})
+})